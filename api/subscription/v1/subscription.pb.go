@@ -31,9 +31,12 @@ type Plan struct {
 	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
 	Price         float64                `protobuf:"fixed64,4,opt,name=price,proto3" json:"price,omitempty"`
 	Currency      string                 `protobuf:"bytes,5,opt,name=currency,proto3" json:"currency,omitempty"`
-	DurationDays  int32                  `protobuf:"varint,6,opt,name=durationDays,proto3" json:"durationDays,omitempty"` // 持续天数
-	Type          string                 `protobuf:"bytes,7,opt,name=type,proto3" json:"type,omitempty"`                  // free, pro, enterprise
-	AppId         string                 `protobuf:"bytes,8,opt,name=appId,proto3" json:"appId,omitempty"`                // 应用ID
+	DurationDays  int32                  `protobuf:"varint,6,opt,name=durationDays,proto3" json:"durationDays,omitempty"`    // 持续天数（按周期单位折算的名义天数）
+	Type          string                 `protobuf:"bytes,7,opt,name=type,proto3" json:"type,omitempty"`                     // free, pro, enterprise
+	AppId         string                 `protobuf:"bytes,8,opt,name=appId,proto3" json:"appId,omitempty"`                   // 应用ID
+	IntervalUnit  string                 `protobuf:"bytes,9,opt,name=intervalUnit,proto3" json:"intervalUnit,omitempty"`     // 计费周期单位: day, week, month, year
+	IntervalCount int32                  `protobuf:"varint,10,opt,name=intervalCount,proto3" json:"intervalCount,omitempty"` // 计费周期数量（如 3 month 表示季付）
+	BillingType   string                 `protobuf:"bytes,11,opt,name=billingType,proto3" json:"billingType,omitempty"`      // 计费类型: recurring-周期订阅, lifetime-终身（一次性买断，永不过期）
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -124,6 +127,27 @@ func (x *Plan) GetAppId() string {
 	return ""
 }
 
+func (x *Plan) GetIntervalUnit() string {
+	if x != nil {
+		return x.IntervalUnit
+	}
+	return ""
+}
+
+func (x *Plan) GetIntervalCount() int32 {
+	if x != nil {
+		return x.IntervalCount
+	}
+	return 0
+}
+
+func (x *Plan) GetBillingType() string {
+	if x != nil {
+		return x.BillingType
+	}
+	return ""
+}
+
 type ListPlansRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	AppId         string                 `protobuf:"bytes,1,opt,name=appId,proto3" json:"appId,omitempty"` // 应用ID（查询参数，必填）
@@ -174,8 +198,11 @@ type CreatePlanRequest struct {
 	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
 	Price         float64                `protobuf:"fixed64,3,opt,name=price,proto3" json:"price,omitempty"`
 	Currency      string                 `protobuf:"bytes,4,opt,name=currency,proto3" json:"currency,omitempty"`
-	DurationDays  int32                  `protobuf:"varint,5,opt,name=durationDays,proto3" json:"durationDays,omitempty"`
+	DurationDays  int32                  `protobuf:"varint,5,opt,name=durationDays,proto3" json:"durationDays,omitempty"` // 按天计费时的天数（周期订阅且未指定 intervalUnit 时必填）
 	Type          string                 `protobuf:"bytes,6,opt,name=type,proto3" json:"type,omitempty"`
+	IntervalUnit  string                 `protobuf:"bytes,7,opt,name=intervalUnit,proto3" json:"intervalUnit,omitempty"`    // 计费周期单位（月/年按日历计算）
+	IntervalCount int32                  `protobuf:"varint,8,opt,name=intervalCount,proto3" json:"intervalCount,omitempty"` // 计费周期数量（默认 1）
+	BillingType   string                 `protobuf:"bytes,9,opt,name=billingType,proto3" json:"billingType,omitempty"`      // 计费类型，默认 recurring
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -252,6 +279,27 @@ func (x *CreatePlanRequest) GetType() string {
 	return ""
 }
 
+func (x *CreatePlanRequest) GetIntervalUnit() string {
+	if x != nil {
+		return x.IntervalUnit
+	}
+	return ""
+}
+
+func (x *CreatePlanRequest) GetIntervalCount() int32 {
+	if x != nil {
+		return x.IntervalCount
+	}
+	return 0
+}
+
+func (x *CreatePlanRequest) GetBillingType() string {
+	if x != nil {
+		return x.BillingType
+	}
+	return ""
+}
+
 type CreatePlanReply struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Plan          *Plan                  `protobuf:"bytes,1,opt,name=plan,proto3" json:"plan,omitempty"`
@@ -305,6 +353,9 @@ type UpdatePlanRequest struct {
 	Currency      string                 `protobuf:"bytes,5,opt,name=currency,proto3" json:"currency,omitempty"`
 	DurationDays  int32                  `protobuf:"varint,6,opt,name=durationDays,proto3" json:"durationDays,omitempty"`
 	Type          string                 `protobuf:"bytes,7,opt,name=type,proto3" json:"type,omitempty"`
+	IntervalUnit  string                 `protobuf:"bytes,8,opt,name=intervalUnit,proto3" json:"intervalUnit,omitempty"`
+	IntervalCount int32                  `protobuf:"varint,9,opt,name=intervalCount,proto3" json:"intervalCount,omitempty"`
+	BillingType   string                 `protobuf:"bytes,10,opt,name=billingType,proto3" json:"billingType,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -388,6 +439,27 @@ func (x *UpdatePlanRequest) GetType() string {
 	return ""
 }
 
+func (x *UpdatePlanRequest) GetIntervalUnit() string {
+	if x != nil {
+		return x.IntervalUnit
+	}
+	return ""
+}
+
+func (x *UpdatePlanRequest) GetIntervalCount() int32 {
+	if x != nil {
+		return x.IntervalCount
+	}
+	return 0
+}
+
+func (x *UpdatePlanRequest) GetBillingType() string {
+	if x != nil {
+		return x.BillingType
+	}
+	return ""
+}
+
 type UpdatePlanReply struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Plan          *Plan                  `protobuf:"bytes,1,opt,name=plan,proto3" json:"plan,omitempty"`
@@ -613,9 +685,13 @@ type GetMySubscriptionReply struct {
 	IsActive      bool                   `protobuf:"varint,1,opt,name=isActive,proto3" json:"isActive,omitempty"`
 	PlanId        string                 `protobuf:"bytes,2,opt,name=planId,proto3" json:"planId,omitempty"`
 	StartTime     int64                  `protobuf:"varint,3,opt,name=startTime,proto3" json:"startTime,omitempty"`
-	EndTime       int64                  `protobuf:"varint,4,opt,name=endTime,proto3" json:"endTime,omitempty"`
-	Status        string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`        // active, expired, paused, cancelled
-	AutoRenew     bool                   `protobuf:"varint,6,opt,name=autoRenew,proto3" json:"autoRenew,omitempty"` // 是否自动续费
+	EndTime       int64                  `protobuf:"varint,4,opt,name=endTime,proto3" json:"endTime,omitempty"`       // 结束时间（终身订阅为 0）
+	Status        string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`          // active, expired, paused, cancelled
+	AutoRenew     bool                   `protobuf:"varint,6,opt,name=autoRenew,proto3" json:"autoRenew,omitempty"`   // 是否自动续费
+	IsLifetime    bool                   `protobuf:"varint,7,opt,name=isLifetime,proto3" json:"isLifetime,omitempty"` // 是否为终身订阅
+	IsFree        bool                   `protobuf:"varint,8,opt,name=isFree,proto3" json:"isFree,omitempty"`         // 是否为应用的默认免费套餐（订阅过期、取消或退款后自动回落）
+	PlanName      string                 `protobuf:"bytes,9,opt,name=planName,proto3" json:"planName,omitempty"`      // 当前生效套餐名称
+	PlanType      string                 `protobuf:"bytes,10,opt,name=planType,proto3" json:"planType,omitempty"`     // 当前生效套餐类型: free, pro, enterprise
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -692,12 +768,42 @@ func (x *GetMySubscriptionReply) GetAutoRenew() bool {
 	return false
 }
 
+func (x *GetMySubscriptionReply) GetIsLifetime() bool {
+	if x != nil {
+		return x.IsLifetime
+	}
+	return false
+}
+
+func (x *GetMySubscriptionReply) GetIsFree() bool {
+	if x != nil {
+		return x.IsFree
+	}
+	return false
+}
+
+func (x *GetMySubscriptionReply) GetPlanName() string {
+	if x != nil {
+		return x.PlanName
+	}
+	return ""
+}
+
+func (x *GetMySubscriptionReply) GetPlanType() string {
+	if x != nil {
+		return x.PlanType
+	}
+	return ""
+}
+
 type CreateSubscriptionOrderRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Uid           string                 `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"` // 用户ID（字符串 UUID）
 	PlanId        string                 `protobuf:"bytes,2,opt,name=planId,proto3" json:"planId,omitempty"`
 	PaymentMethod string                 `protobuf:"bytes,3,opt,name=paymentMethod,proto3" json:"paymentMethod,omitempty"` // alipay, wechatpay
-	Region        string                 `protobuf:"bytes,4,opt,name=region,proto3" json:"region,omitempty"`               // 区域代码 (e.g., "CN", "US", "EU")，可选，默认 "default"
+	Region        string                 `protobuf:"bytes,4,opt,name=region,proto3" json:"region,omitempty"`               // 国家代码 (e.g., "CN", "US") 或地区组代码 (e.g., "EU")，可选，为空时自动推断
+	QuoteToken    string                 `protobuf:"bytes,5,opt,name=quoteToken,proto3" json:"quoteToken,omitempty"`       // QuoteSubscription 返回的报价 token，传入时按报价金额下单（忽略 region）
+	VatId         string                 `protobuf:"bytes,6,opt,name=vatId,proto3" json:"vatId,omitempty"`                 // 买方 VAT ID（可选，B2B 反向征税；携带报价 token 时必须与报价一致）
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -760,13 +866,32 @@ func (x *CreateSubscriptionOrderRequest) GetRegion() string {
 	return ""
 }
 
+func (x *CreateSubscriptionOrderRequest) GetQuoteToken() string {
+	if x != nil {
+		return x.QuoteToken
+	}
+	return ""
+}
+
+func (x *CreateSubscriptionOrderRequest) GetVatId() string {
+	if x != nil {
+		return x.VatId
+	}
+	return ""
+}
+
 type CreateSubscriptionOrderReply struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	OrderId       string                 `protobuf:"bytes,1,opt,name=orderId,proto3" json:"orderId,omitempty"`     // 业务订单号
-	PaymentId     string                 `protobuf:"bytes,2,opt,name=paymentId,proto3" json:"paymentId,omitempty"` // 支付流水号
+	PaymentId     string                 `protobuf:"bytes,2,opt,name=paymentId,proto3" json:"paymentId,omitempty"` // 支付流水号（实付金额为 0 时为空，订单已直接完成）
 	PayUrl        string                 `protobuf:"bytes,3,opt,name=payUrl,proto3" json:"payUrl,omitempty"`
 	PayCode       string                 `protobuf:"bytes,4,opt,name=payCode,proto3" json:"payCode,omitempty"`
 	PayParams     string                 `protobuf:"bytes,5,opt,name=payParams,proto3" json:"payParams,omitempty"`
+	Amount        float64                `protobuf:"fixed64,6,opt,name=amount,proto3" json:"amount,omitempty"` // 实付金额
+	Currency      string                 `protobuf:"bytes,7,opt,name=currency,proto3" json:"currency,omitempty"`
+	PaymentStatus string                 `protobuf:"bytes,8,opt,name=paymentStatus,proto3" json:"paymentStatus,omitempty"` // 订单支付状态: pending, success（实付金额为 0 时直接完成）
+	TaxAmount     float64                `protobuf:"fixed64,9,opt,name=taxAmount,proto3" json:"taxAmount,omitempty"`       // 税额
+	TaxLines      []*TaxLine             `protobuf:"bytes,10,rep,name=taxLines,proto3" json:"taxLines,omitempty"`          // 税费明细
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -836,29 +961,65 @@ func (x *CreateSubscriptionOrderReply) GetPayParams() string {
 	return ""
 }
 
-type HandlePaymentSuccessRequest struct {
+func (x *CreateSubscriptionOrderReply) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *CreateSubscriptionOrderReply) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *CreateSubscriptionOrderReply) GetPaymentStatus() string {
+	if x != nil {
+		return x.PaymentStatus
+	}
+	return ""
+}
+
+func (x *CreateSubscriptionOrderReply) GetTaxAmount() float64 {
+	if x != nil {
+		return x.TaxAmount
+	}
+	return 0
+}
+
+func (x *CreateSubscriptionOrderReply) GetTaxLines() []*TaxLine {
+	if x != nil {
+		return x.TaxLines
+	}
+	return nil
+}
+
+type QuoteSubscriptionRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	OrderId       string                 `protobuf:"bytes,1,opt,name=orderId,proto3" json:"orderId,omitempty"`
-	PaymentId     string                 `protobuf:"bytes,2,opt,name=paymentId,proto3" json:"paymentId,omitempty"`
-	Amount        float64                `protobuf:"fixed64,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Uid           string                 `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"` // 用户ID（字符串 UUID）
+	PlanId        string                 `protobuf:"bytes,2,opt,name=planId,proto3" json:"planId,omitempty"`
+	Region        string                 `protobuf:"bytes,3,opt,name=region,proto3" json:"region,omitempty"` // 国家代码或地区组代码，可选，为空时自动推断
+	VatId         string                 `protobuf:"bytes,4,opt,name=vatId,proto3" json:"vatId,omitempty"`   // 买方 VAT ID（可选，B2B 反向征税）
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *HandlePaymentSuccessRequest) Reset() {
-	*x = HandlePaymentSuccessRequest{}
+func (x *QuoteSubscriptionRequest) Reset() {
+	*x = QuoteSubscriptionRequest{}
 	mi := &file_subscription_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *HandlePaymentSuccessRequest) String() string {
+func (x *QuoteSubscriptionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HandlePaymentSuccessRequest) ProtoMessage() {}
+func (*QuoteSubscriptionRequest) ProtoMessage() {}
 
-func (x *HandlePaymentSuccessRequest) ProtoReflect() protoreflect.Message {
+func (x *QuoteSubscriptionRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_subscription_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -870,55 +1031,74 @@ func (x *HandlePaymentSuccessRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HandlePaymentSuccessRequest.ProtoReflect.Descriptor instead.
-func (*HandlePaymentSuccessRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use QuoteSubscriptionRequest.ProtoReflect.Descriptor instead.
+func (*QuoteSubscriptionRequest) Descriptor() ([]byte, []int) {
 	return file_subscription_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *HandlePaymentSuccessRequest) GetOrderId() string {
+func (x *QuoteSubscriptionRequest) GetUid() string {
 	if x != nil {
-		return x.OrderId
+		return x.Uid
 	}
 	return ""
 }
 
-func (x *HandlePaymentSuccessRequest) GetPaymentId() string {
+func (x *QuoteSubscriptionRequest) GetPlanId() string {
 	if x != nil {
-		return x.PaymentId
+		return x.PlanId
 	}
 	return ""
 }
 
-func (x *HandlePaymentSuccessRequest) GetAmount() float64 {
+func (x *QuoteSubscriptionRequest) GetRegion() string {
 	if x != nil {
-		return x.Amount
+		return x.Region
 	}
-	return 0
+	return ""
 }
 
-// 取消订阅
-type CancelSubscriptionRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Uid           string                 `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`       // 用户ID（字符串 UUID）
-	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"` // 取消原因（可选）
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *QuoteSubscriptionRequest) GetVatId() string {
+	if x != nil {
+		return x.VatId
+	}
+	return ""
 }
 
-func (x *CancelSubscriptionRequest) Reset() {
-	*x = CancelSubscriptionRequest{}
+type QuoteSubscriptionReply struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Region          string                 `protobuf:"bytes,1,opt,name=region,proto3" json:"region,omitempty"`               // 定价地区
+	RegionSource    string                 `protobuf:"bytes,2,opt,name=regionSource,proto3" json:"regionSource,omitempty"`   // 地区来源: request, user_profile, geoip, passport_geoip, accept_language, x_language, default
+	PricingRegion   string                 `protobuf:"bytes,3,opt,name=pricingRegion,proto3" json:"pricingRegion,omitempty"` // 实际命中的定价（国家或地区组代码，default 表示套餐默认价格）
+	Currency        string                 `protobuf:"bytes,4,opt,name=currency,proto3" json:"currency,omitempty"`
+	Subtotal        float64                `protobuf:"fixed64,5,opt,name=subtotal,proto3" json:"subtotal,omitempty"`               // 套餐价格
+	Discount        float64                `protobuf:"fixed64,6,opt,name=discount,proto3" json:"discount,omitempty"`               // 优惠金额
+	ProrationCredit float64                `protobuf:"fixed64,7,opt,name=prorationCredit,proto3" json:"prorationCredit,omitempty"` // 切换套餐时当前周期未使用部分的抵扣金额
+	Tax             float64                `protobuf:"fixed64,8,opt,name=tax,proto3" json:"tax,omitempty"`                         // 税额
+	Total           float64                `protobuf:"fixed64,9,opt,name=total,proto3" json:"total,omitempty"`                     // 实付金额
+	PeriodStart     int64                  `protobuf:"varint,10,opt,name=periodStart,proto3" json:"periodStart,omitempty"`         // 购买后的订阅周期开始时间（按当前时间估算）
+	PeriodEnd       int64                  `protobuf:"varint,11,opt,name=periodEnd,proto3" json:"periodEnd,omitempty"`             // 购买后的订阅周期结束时间（终身套餐为 0）
+	ExpiresAt       int64                  `protobuf:"varint,12,opt,name=expiresAt,proto3" json:"expiresAt,omitempty"`             // 报价过期时间
+	QuoteToken      string                 `protobuf:"bytes,13,opt,name=quoteToken,proto3" json:"quoteToken,omitempty"`            // 报价 token，下单时传入 CreateSubscriptionOrder
+	TaxLines        []*TaxLine             `protobuf:"bytes,14,rep,name=taxLines,proto3" json:"taxLines,omitempty"`                // 税费明细
+	VatId           string                 `protobuf:"bytes,15,opt,name=vatId,proto3" json:"vatId,omitempty"`                      // 买方 VAT ID（规范化后）
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *QuoteSubscriptionReply) Reset() {
+	*x = QuoteSubscriptionReply{}
 	mi := &file_subscription_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CancelSubscriptionRequest) String() string {
+func (x *QuoteSubscriptionReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CancelSubscriptionRequest) ProtoMessage() {}
+func (*QuoteSubscriptionReply) ProtoMessage() {}
 
-func (x *CancelSubscriptionRequest) ProtoReflect() protoreflect.Message {
+func (x *QuoteSubscriptionReply) ProtoReflect() protoreflect.Message {
 	mi := &file_subscription_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -930,101 +1110,145 @@ func (x *CancelSubscriptionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CancelSubscriptionRequest.ProtoReflect.Descriptor instead.
-func (*CancelSubscriptionRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use QuoteSubscriptionReply.ProtoReflect.Descriptor instead.
+func (*QuoteSubscriptionReply) Descriptor() ([]byte, []int) {
 	return file_subscription_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *CancelSubscriptionRequest) GetUid() string {
+func (x *QuoteSubscriptionReply) GetRegion() string {
 	if x != nil {
-		return x.Uid
+		return x.Region
 	}
 	return ""
 }
 
-func (x *CancelSubscriptionRequest) GetReason() string {
+func (x *QuoteSubscriptionReply) GetRegionSource() string {
 	if x != nil {
-		return x.Reason
+		return x.RegionSource
 	}
 	return ""
 }
 
-// 暂停订阅
-type PauseSubscriptionRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Uid           string                 `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`       // 用户ID（字符串 UUID）
-	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"` // 暂停原因（可选）
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *QuoteSubscriptionReply) GetPricingRegion() string {
+	if x != nil {
+		return x.PricingRegion
+	}
+	return ""
 }
 
-func (x *PauseSubscriptionRequest) Reset() {
-	*x = PauseSubscriptionRequest{}
-	mi := &file_subscription_proto_msgTypes[15]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *QuoteSubscriptionReply) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
 }
 
-func (x *PauseSubscriptionRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *QuoteSubscriptionReply) GetSubtotal() float64 {
+	if x != nil {
+		return x.Subtotal
+	}
+	return 0
 }
 
-func (*PauseSubscriptionRequest) ProtoMessage() {}
+func (x *QuoteSubscriptionReply) GetDiscount() float64 {
+	if x != nil {
+		return x.Discount
+	}
+	return 0
+}
 
-func (x *PauseSubscriptionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_subscription_proto_msgTypes[15]
+func (x *QuoteSubscriptionReply) GetProrationCredit() float64 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.ProrationCredit
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use PauseSubscriptionRequest.ProtoReflect.Descriptor instead.
-func (*PauseSubscriptionRequest) Descriptor() ([]byte, []int) {
-	return file_subscription_proto_rawDescGZIP(), []int{15}
+func (x *QuoteSubscriptionReply) GetTax() float64 {
+	if x != nil {
+		return x.Tax
+	}
+	return 0
 }
 
-func (x *PauseSubscriptionRequest) GetUid() string {
+func (x *QuoteSubscriptionReply) GetTotal() float64 {
 	if x != nil {
-		return x.Uid
+		return x.Total
+	}
+	return 0
+}
+
+func (x *QuoteSubscriptionReply) GetPeriodStart() int64 {
+	if x != nil {
+		return x.PeriodStart
+	}
+	return 0
+}
+
+func (x *QuoteSubscriptionReply) GetPeriodEnd() int64 {
+	if x != nil {
+		return x.PeriodEnd
+	}
+	return 0
+}
+
+func (x *QuoteSubscriptionReply) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+func (x *QuoteSubscriptionReply) GetQuoteToken() string {
+	if x != nil {
+		return x.QuoteToken
 	}
 	return ""
 }
 
-func (x *PauseSubscriptionRequest) GetReason() string {
+func (x *QuoteSubscriptionReply) GetTaxLines() []*TaxLine {
 	if x != nil {
-		return x.Reason
+		return x.TaxLines
+	}
+	return nil
+}
+
+func (x *QuoteSubscriptionReply) GetVatId() string {
+	if x != nil {
+		return x.VatId
 	}
 	return ""
 }
 
-// 恢复订阅
-type ResumeSubscriptionRequest struct {
+// 税费明细
+type TaxLine struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Uid           string                 `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"` // 用户ID（字符串 UUID）
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"` // 税种名称，如 VAT、GST
+	CountryCode   string                 `protobuf:"bytes,2,opt,name=countryCode,proto3" json:"countryCode,omitempty"`
+	Rate          float64                `protobuf:"fixed64,3,opt,name=rate,proto3" json:"rate,omitempty"`                   // 税率（0.2 表示 20%）
+	Inclusive     bool                   `protobuf:"varint,4,opt,name=inclusive,proto3" json:"inclusive,omitempty"`          // 是否含税定价（税额从价格中拆出）
+	ReverseCharge bool                   `protobuf:"varint,5,opt,name=reverseCharge,proto3" json:"reverseCharge,omitempty"`  // 是否反向征税（税额为 0，由买方自行申报）
+	TaxableAmount float64                `protobuf:"fixed64,6,opt,name=taxableAmount,proto3" json:"taxableAmount,omitempty"` // 计税金额（不含税）
+	Amount        float64                `protobuf:"fixed64,7,opt,name=amount,proto3" json:"amount,omitempty"`               // 税额
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ResumeSubscriptionRequest) Reset() {
-	*x = ResumeSubscriptionRequest{}
-	mi := &file_subscription_proto_msgTypes[16]
+func (x *TaxLine) Reset() {
+	*x = TaxLine{}
+	mi := &file_subscription_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ResumeSubscriptionRequest) String() string {
+func (x *TaxLine) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ResumeSubscriptionRequest) ProtoMessage() {}
+func (*TaxLine) ProtoMessage() {}
 
-func (x *ResumeSubscriptionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_subscription_proto_msgTypes[16]
+func (x *TaxLine) ProtoReflect() protoreflect.Message {
+	mi := &file_subscription_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1035,143 +1259,84 @@ func (x *ResumeSubscriptionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ResumeSubscriptionRequest.ProtoReflect.Descriptor instead.
-func (*ResumeSubscriptionRequest) Descriptor() ([]byte, []int) {
-	return file_subscription_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use TaxLine.ProtoReflect.Descriptor instead.
+func (*TaxLine) Descriptor() ([]byte, []int) {
+	return file_subscription_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *ResumeSubscriptionRequest) GetUid() string {
+func (x *TaxLine) GetName() string {
 	if x != nil {
-		return x.Uid
+		return x.Name
 	}
 	return ""
 }
 
-// 订阅历史记录
-type SubscriptionHistoryItem struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	PlanId        string                 `protobuf:"bytes,2,opt,name=planId,proto3" json:"planId,omitempty"`
-	PlanName      string                 `protobuf:"bytes,3,opt,name=planName,proto3" json:"planName,omitempty"`
-	StartTime     int64                  `protobuf:"varint,4,opt,name=startTime,proto3" json:"startTime,omitempty"`
-	EndTime       int64                  `protobuf:"varint,5,opt,name=endTime,proto3" json:"endTime,omitempty"`
-	Status        string                 `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"` // active, expired, paused, cancelled
-	Action        string                 `protobuf:"bytes,7,opt,name=action,proto3" json:"action,omitempty"` // created, renewed, upgraded, paused, resumed, cancelled
-	CreatedAt     int64                  `protobuf:"varint,8,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *SubscriptionHistoryItem) Reset() {
-	*x = SubscriptionHistoryItem{}
-	mi := &file_subscription_proto_msgTypes[17]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *SubscriptionHistoryItem) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*SubscriptionHistoryItem) ProtoMessage() {}
-
-func (x *SubscriptionHistoryItem) ProtoReflect() protoreflect.Message {
-	mi := &file_subscription_proto_msgTypes[17]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use SubscriptionHistoryItem.ProtoReflect.Descriptor instead.
-func (*SubscriptionHistoryItem) Descriptor() ([]byte, []int) {
-	return file_subscription_proto_rawDescGZIP(), []int{17}
-}
-
-func (x *SubscriptionHistoryItem) GetId() uint64 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
-}
-
-func (x *SubscriptionHistoryItem) GetPlanId() string {
-	if x != nil {
-		return x.PlanId
-	}
-	return ""
-}
-
-func (x *SubscriptionHistoryItem) GetPlanName() string {
+func (x *TaxLine) GetCountryCode() string {
 	if x != nil {
-		return x.PlanName
+		return x.CountryCode
 	}
 	return ""
 }
 
-func (x *SubscriptionHistoryItem) GetStartTime() int64 {
+func (x *TaxLine) GetRate() float64 {
 	if x != nil {
-		return x.StartTime
+		return x.Rate
 	}
 	return 0
 }
 
-func (x *SubscriptionHistoryItem) GetEndTime() int64 {
+func (x *TaxLine) GetInclusive() bool {
 	if x != nil {
-		return x.EndTime
+		return x.Inclusive
 	}
-	return 0
+	return false
 }
 
-func (x *SubscriptionHistoryItem) GetStatus() string {
+func (x *TaxLine) GetReverseCharge() bool {
 	if x != nil {
-		return x.Status
+		return x.ReverseCharge
 	}
-	return ""
+	return false
 }
 
-func (x *SubscriptionHistoryItem) GetAction() string {
+func (x *TaxLine) GetTaxableAmount() float64 {
 	if x != nil {
-		return x.Action
+		return x.TaxableAmount
 	}
-	return ""
+	return 0
 }
 
-func (x *SubscriptionHistoryItem) GetCreatedAt() int64 {
+func (x *TaxLine) GetAmount() float64 {
 	if x != nil {
-		return x.CreatedAt
+		return x.Amount
 	}
 	return 0
 }
 
-type GetSubscriptionHistoryRequest struct {
+type HandlePaymentSuccessRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Uid           string                 `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`            // 用户ID（字符串 UUID）
-	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`         // 页码，从1开始
-	PageSize      int32                  `protobuf:"varint,3,opt,name=pageSize,proto3" json:"pageSize,omitempty"` // 每页数量，默认10
+	OrderId       string                 `protobuf:"bytes,1,opt,name=orderId,proto3" json:"orderId,omitempty"`
+	PaymentId     string                 `protobuf:"bytes,2,opt,name=paymentId,proto3" json:"paymentId,omitempty"`
+	Amount        float64                `protobuf:"fixed64,3,opt,name=amount,proto3" json:"amount,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetSubscriptionHistoryRequest) Reset() {
-	*x = GetSubscriptionHistoryRequest{}
-	mi := &file_subscription_proto_msgTypes[18]
+func (x *HandlePaymentSuccessRequest) Reset() {
+	*x = HandlePaymentSuccessRequest{}
+	mi := &file_subscription_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetSubscriptionHistoryRequest) String() string {
+func (x *HandlePaymentSuccessRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSubscriptionHistoryRequest) ProtoMessage() {}
+func (*HandlePaymentSuccessRequest) ProtoMessage() {}
 
-func (x *GetSubscriptionHistoryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_subscription_proto_msgTypes[18]
+func (x *HandlePaymentSuccessRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_subscription_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1182,57 +1347,56 @@ func (x *GetSubscriptionHistoryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSubscriptionHistoryRequest.ProtoReflect.Descriptor instead.
-func (*GetSubscriptionHistoryRequest) Descriptor() ([]byte, []int) {
-	return file_subscription_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use HandlePaymentSuccessRequest.ProtoReflect.Descriptor instead.
+func (*HandlePaymentSuccessRequest) Descriptor() ([]byte, []int) {
+	return file_subscription_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *GetSubscriptionHistoryRequest) GetUid() string {
+func (x *HandlePaymentSuccessRequest) GetOrderId() string {
 	if x != nil {
-		return x.Uid
+		return x.OrderId
 	}
 	return ""
 }
 
-func (x *GetSubscriptionHistoryRequest) GetPage() int32 {
+func (x *HandlePaymentSuccessRequest) GetPaymentId() string {
 	if x != nil {
-		return x.Page
+		return x.PaymentId
 	}
-	return 0
+	return ""
 }
 
-func (x *GetSubscriptionHistoryRequest) GetPageSize() int32 {
+func (x *HandlePaymentSuccessRequest) GetAmount() float64 {
 	if x != nil {
-		return x.PageSize
+		return x.Amount
 	}
 	return 0
 }
 
-type GetSubscriptionHistoryReply struct {
-	state         protoimpl.MessageState     `protogen:"open.v1"`
-	Items         []*SubscriptionHistoryItem `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
-	Total         int32                      `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
-	Page          int32                      `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
-	PageSize      int32                      `protobuf:"varint,4,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+type HandlePaymentRefundRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       string                 `protobuf:"bytes,1,opt,name=orderId,proto3" json:"orderId,omitempty"`
+	PaymentId     string                 `protobuf:"bytes,2,opt,name=paymentId,proto3" json:"paymentId,omitempty"`
+	Amount        float64                `protobuf:"fixed64,3,opt,name=amount,proto3" json:"amount,omitempty"` // 退款金额，0 或不小于订单金额表示全额退款
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetSubscriptionHistoryReply) Reset() {
-	*x = GetSubscriptionHistoryReply{}
-	mi := &file_subscription_proto_msgTypes[19]
+func (x *HandlePaymentRefundRequest) Reset() {
+	*x = HandlePaymentRefundRequest{}
+	mi := &file_subscription_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetSubscriptionHistoryReply) String() string {
+func (x *HandlePaymentRefundRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSubscriptionHistoryReply) ProtoMessage() {}
+func (*HandlePaymentRefundRequest) ProtoMessage() {}
 
-func (x *GetSubscriptionHistoryReply) ProtoReflect() protoreflect.Message {
-	mi := &file_subscription_proto_msgTypes[19]
+func (x *HandlePaymentRefundRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_subscription_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1243,63 +1407,56 @@ func (x *GetSubscriptionHistoryReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSubscriptionHistoryReply.ProtoReflect.Descriptor instead.
-func (*GetSubscriptionHistoryReply) Descriptor() ([]byte, []int) {
-	return file_subscription_proto_rawDescGZIP(), []int{19}
-}
-
-func (x *GetSubscriptionHistoryReply) GetItems() []*SubscriptionHistoryItem {
-	if x != nil {
-		return x.Items
-	}
-	return nil
+// Deprecated: Use HandlePaymentRefundRequest.ProtoReflect.Descriptor instead.
+func (*HandlePaymentRefundRequest) Descriptor() ([]byte, []int) {
+	return file_subscription_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *GetSubscriptionHistoryReply) GetTotal() int32 {
+func (x *HandlePaymentRefundRequest) GetOrderId() string {
 	if x != nil {
-		return x.Total
+		return x.OrderId
 	}
-	return 0
+	return ""
 }
 
-func (x *GetSubscriptionHistoryReply) GetPage() int32 {
+func (x *HandlePaymentRefundRequest) GetPaymentId() string {
 	if x != nil {
-		return x.Page
+		return x.PaymentId
 	}
-	return 0
+	return ""
 }
 
-func (x *GetSubscriptionHistoryReply) GetPageSize() int32 {
+func (x *HandlePaymentRefundRequest) GetAmount() float64 {
 	if x != nil {
-		return x.PageSize
+		return x.Amount
 	}
 	return 0
 }
 
-// 自动续费设置
-type SetAutoRenewRequest struct {
+// 取消订阅
+type CancelSubscriptionRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Uid           string                 `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`              // 用户ID（字符串 UUID）
-	AutoRenew     bool                   `protobuf:"varint,2,opt,name=autoRenew,proto3" json:"autoRenew,omitempty"` // true: 开启自动续费, false: 关闭自动续费
+	Uid           string                 `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`       // 用户ID（字符串 UUID）
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"` // 取消原因（可选）
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SetAutoRenewRequest) Reset() {
-	*x = SetAutoRenewRequest{}
-	mi := &file_subscription_proto_msgTypes[20]
+func (x *CancelSubscriptionRequest) Reset() {
+	*x = CancelSubscriptionRequest{}
+	mi := &file_subscription_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SetAutoRenewRequest) String() string {
+func (x *CancelSubscriptionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetAutoRenewRequest) ProtoMessage() {}
+func (*CancelSubscriptionRequest) ProtoMessage() {}
 
-func (x *SetAutoRenewRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_subscription_proto_msgTypes[20]
+func (x *CancelSubscriptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_subscription_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1310,50 +1467,49 @@ func (x *SetAutoRenewRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetAutoRenewRequest.ProtoReflect.Descriptor instead.
-func (*SetAutoRenewRequest) Descriptor() ([]byte, []int) {
-	return file_subscription_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use CancelSubscriptionRequest.ProtoReflect.Descriptor instead.
+func (*CancelSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return file_subscription_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *SetAutoRenewRequest) GetUid() string {
+func (x *CancelSubscriptionRequest) GetUid() string {
 	if x != nil {
 		return x.Uid
 	}
 	return ""
 }
 
-func (x *SetAutoRenewRequest) GetAutoRenew() bool {
+func (x *CancelSubscriptionRequest) GetReason() string {
 	if x != nil {
-		return x.AutoRenew
+		return x.Reason
 	}
-	return false
+	return ""
 }
 
-// 获取即将过期的订阅
-type GetExpiringSubscriptionsRequest struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	DaysBeforeExpiry int32                  `protobuf:"varint,1,opt,name=daysBeforeExpiry,proto3" json:"daysBeforeExpiry,omitempty"` // 过期前多少天，默认7天
-	Page             int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`                         // 页码，从1开始
-	PageSize         int32                  `protobuf:"varint,3,opt,name=pageSize,proto3" json:"pageSize,omitempty"`                 // 每页数量，默认10
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+// 暂停订阅
+type PauseSubscriptionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uid           string                 `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`       // 用户ID（字符串 UUID）
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"` // 暂停原因（可选）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetExpiringSubscriptionsRequest) Reset() {
-	*x = GetExpiringSubscriptionsRequest{}
-	mi := &file_subscription_proto_msgTypes[21]
+func (x *PauseSubscriptionRequest) Reset() {
+	*x = PauseSubscriptionRequest{}
+	mi := &file_subscription_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetExpiringSubscriptionsRequest) String() string {
+func (x *PauseSubscriptionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetExpiringSubscriptionsRequest) ProtoMessage() {}
+func (*PauseSubscriptionRequest) ProtoMessage() {}
 
-func (x *GetExpiringSubscriptionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_subscription_proto_msgTypes[21]
+func (x *PauseSubscriptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_subscription_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1364,60 +1520,48 @@ func (x *GetExpiringSubscriptionsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetExpiringSubscriptionsRequest.ProtoReflect.Descriptor instead.
-func (*GetExpiringSubscriptionsRequest) Descriptor() ([]byte, []int) {
-	return file_subscription_proto_rawDescGZIP(), []int{21}
-}
-
-func (x *GetExpiringSubscriptionsRequest) GetDaysBeforeExpiry() int32 {
-	if x != nil {
-		return x.DaysBeforeExpiry
-	}
-	return 0
+// Deprecated: Use PauseSubscriptionRequest.ProtoReflect.Descriptor instead.
+func (*PauseSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return file_subscription_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *GetExpiringSubscriptionsRequest) GetPage() int32 {
+func (x *PauseSubscriptionRequest) GetUid() string {
 	if x != nil {
-		return x.Page
+		return x.Uid
 	}
-	return 0
+	return ""
 }
 
-func (x *GetExpiringSubscriptionsRequest) GetPageSize() int32 {
+func (x *PauseSubscriptionRequest) GetReason() string {
 	if x != nil {
-		return x.PageSize
+		return x.Reason
 	}
-	return 0
+	return ""
 }
 
-type SubscriptionInfo struct {
+// 恢复订阅
+type ResumeSubscriptionRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Uid           string                 `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"` // 用户ID（字符串 UUID）
-	PlanId        string                 `protobuf:"bytes,2,opt,name=planId,proto3" json:"planId,omitempty"`
-	PlanName      string                 `protobuf:"bytes,3,opt,name=planName,proto3" json:"planName,omitempty"`
-	StartTime     int64                  `protobuf:"varint,4,opt,name=startTime,proto3" json:"startTime,omitempty"`
-	EndTime       int64                  `protobuf:"varint,5,opt,name=endTime,proto3" json:"endTime,omitempty"`
-	AutoRenew     bool                   `protobuf:"varint,6,opt,name=autoRenew,proto3" json:"autoRenew,omitempty"`
-	Amount        float64                `protobuf:"fixed64,7,opt,name=amount,proto3" json:"amount,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SubscriptionInfo) Reset() {
-	*x = SubscriptionInfo{}
-	mi := &file_subscription_proto_msgTypes[22]
+func (x *ResumeSubscriptionRequest) Reset() {
+	*x = ResumeSubscriptionRequest{}
+	mi := &file_subscription_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SubscriptionInfo) String() string {
+func (x *ResumeSubscriptionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SubscriptionInfo) ProtoMessage() {}
+func (*ResumeSubscriptionRequest) ProtoMessage() {}
 
-func (x *SubscriptionInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_subscription_proto_msgTypes[22]
+func (x *ResumeSubscriptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_subscription_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1428,84 +1572,249 @@ func (x *SubscriptionInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SubscriptionInfo.ProtoReflect.Descriptor instead.
-func (*SubscriptionInfo) Descriptor() ([]byte, []int) {
-	return file_subscription_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use ResumeSubscriptionRequest.ProtoReflect.Descriptor instead.
+func (*ResumeSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return file_subscription_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *SubscriptionInfo) GetUid() string {
+func (x *ResumeSubscriptionRequest) GetUid() string {
 	if x != nil {
 		return x.Uid
 	}
 	return ""
 }
 
-func (x *SubscriptionInfo) GetPlanId() string {
+// 订阅历史记录
+type SubscriptionHistoryItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	PlanId        string                 `protobuf:"bytes,2,opt,name=planId,proto3" json:"planId,omitempty"`
+	PlanName      string                 `protobuf:"bytes,3,opt,name=planName,proto3" json:"planName,omitempty"`
+	StartTime     int64                  `protobuf:"varint,4,opt,name=startTime,proto3" json:"startTime,omitempty"`
+	EndTime       int64                  `protobuf:"varint,5,opt,name=endTime,proto3" json:"endTime,omitempty"`
+	Status        string                 `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"` // active, expired, paused, cancelled
+	Action        string                 `protobuf:"bytes,7,opt,name=action,proto3" json:"action,omitempty"` // created, renewed, upgraded, paused, resumed, cancelled, extended, shortened, granted
+	CreatedAt     int64                  `protobuf:"varint,8,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	OperatorId    string                 `protobuf:"bytes,9,opt,name=operatorId,proto3" json:"operatorId,omitempty"` // 操作人ID：用户或管理员的用户ID、定时任务名、支付ID
+	Reason        string                 `protobuf:"bytes,10,opt,name=reason,proto3" json:"reason,omitempty"`        // 操作原因
+	ActorType     string                 `protobuf:"bytes,11,opt,name=actorType,proto3" json:"actorType,omitempty"`  // 操作人类型：user, admin, cron, payment, system
+	RequestId     string                 `protobuf:"bytes,12,opt,name=requestId,proto3" json:"requestId,omitempty"`  // 请求ID（同一请求或同一次定时任务执行的修改相同）
+	Changes       []*HistoryChange       `protobuf:"bytes,13,rep,name=changes,proto3" json:"changes,omitempty"`      // 变更的订阅字段
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubscriptionHistoryItem) Reset() {
+	*x = SubscriptionHistoryItem{}
+	mi := &file_subscription_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubscriptionHistoryItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscriptionHistoryItem) ProtoMessage() {}
+
+func (x *SubscriptionHistoryItem) ProtoReflect() protoreflect.Message {
+	mi := &file_subscription_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscriptionHistoryItem.ProtoReflect.Descriptor instead.
+func (*SubscriptionHistoryItem) Descriptor() ([]byte, []int) {
+	return file_subscription_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *SubscriptionHistoryItem) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *SubscriptionHistoryItem) GetPlanId() string {
 	if x != nil {
 		return x.PlanId
 	}
 	return ""
 }
 
-func (x *SubscriptionInfo) GetPlanName() string {
+func (x *SubscriptionHistoryItem) GetPlanName() string {
 	if x != nil {
 		return x.PlanName
 	}
 	return ""
 }
 
-func (x *SubscriptionInfo) GetStartTime() int64 {
+func (x *SubscriptionHistoryItem) GetStartTime() int64 {
 	if x != nil {
 		return x.StartTime
 	}
 	return 0
 }
 
-func (x *SubscriptionInfo) GetEndTime() int64 {
+func (x *SubscriptionHistoryItem) GetEndTime() int64 {
 	if x != nil {
 		return x.EndTime
 	}
 	return 0
 }
 
-func (x *SubscriptionInfo) GetAutoRenew() bool {
+func (x *SubscriptionHistoryItem) GetStatus() string {
 	if x != nil {
-		return x.AutoRenew
+		return x.Status
 	}
-	return false
+	return ""
 }
 
-func (x *SubscriptionInfo) GetAmount() float64 {
+func (x *SubscriptionHistoryItem) GetAction() string {
 	if x != nil {
-		return x.Amount
+		return x.Action
+	}
+	return ""
+}
+
+func (x *SubscriptionHistoryItem) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
 	}
 	return 0
 }
 
-type GetExpiringSubscriptionsReply struct {
+func (x *SubscriptionHistoryItem) GetOperatorId() string {
+	if x != nil {
+		return x.OperatorId
+	}
+	return ""
+}
+
+func (x *SubscriptionHistoryItem) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *SubscriptionHistoryItem) GetActorType() string {
+	if x != nil {
+		return x.ActorType
+	}
+	return ""
+}
+
+func (x *SubscriptionHistoryItem) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *SubscriptionHistoryItem) GetChanges() []*HistoryChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+// 订阅字段变更（时间为 RFC3339，空字符串表示无值）
+type HistoryChange struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Subscriptions []*SubscriptionInfo    `protobuf:"bytes,1,rep,name=subscriptions,proto3" json:"subscriptions,omitempty"`
-	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
-	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
-	PageSize      int32                  `protobuf:"varint,4,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	Field         string                 `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"` // plan_id, app_id, country_code, status, start_time, end_time, billing_anchor, order_id, is_auto_renew, source, next_plan_id
+	Before        string                 `protobuf:"bytes,2,opt,name=before,proto3" json:"before,omitempty"`
+	After         string                 `protobuf:"bytes,3,opt,name=after,proto3" json:"after,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetExpiringSubscriptionsReply) Reset() {
-	*x = GetExpiringSubscriptionsReply{}
+func (x *HistoryChange) Reset() {
+	*x = HistoryChange{}
+	mi := &file_subscription_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HistoryChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HistoryChange) ProtoMessage() {}
+
+func (x *HistoryChange) ProtoReflect() protoreflect.Message {
+	mi := &file_subscription_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HistoryChange.ProtoReflect.Descriptor instead.
+func (*HistoryChange) Descriptor() ([]byte, []int) {
+	return file_subscription_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *HistoryChange) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *HistoryChange) GetBefore() string {
+	if x != nil {
+		return x.Before
+	}
+	return ""
+}
+
+func (x *HistoryChange) GetAfter() string {
+	if x != nil {
+		return x.After
+	}
+	return ""
+}
+
+type GetSubscriptionHistoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uid           string                 `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`            // 用户ID（字符串 UUID）
+	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`         // 页码，从1开始（cursor 大于 0 时忽略）
+	PageSize      int32                  `protobuf:"varint,3,opt,name=pageSize,proto3" json:"pageSize,omitempty"` // 每页数量，默认10
+	Actions       []string               `protobuf:"bytes,4,rep,name=actions,proto3" json:"actions,omitempty"`    // 操作类型，多个时匹配任一
+	PlanId        string                 `protobuf:"bytes,5,opt,name=planId,proto3" json:"planId,omitempty"`
+	AppId         string                 `protobuf:"bytes,6,opt,name=appId,proto3" json:"appId,omitempty"`
+	StartTime     int64                  `protobuf:"varint,7,opt,name=startTime,proto3" json:"startTime,omitempty"` // 创建时间范围开始（包含），0 表示不限
+	EndTime       int64                  `protobuf:"varint,8,opt,name=endTime,proto3" json:"endTime,omitempty"`     // 创建时间范围结束（不包含），0 表示不限
+	Cursor        uint64                 `protobuf:"varint,9,opt,name=cursor,proto3" json:"cursor,omitempty"`       // 上一页返回的 nextCursor，0 表示从第一条开始
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSubscriptionHistoryRequest) Reset() {
+	*x = GetSubscriptionHistoryRequest{}
 	mi := &file_subscription_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetExpiringSubscriptionsReply) String() string {
+func (x *GetSubscriptionHistoryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetExpiringSubscriptionsReply) ProtoMessage() {}
+func (*GetSubscriptionHistoryRequest) ProtoMessage() {}
 
-func (x *GetExpiringSubscriptionsReply) ProtoReflect() protoreflect.Message {
+func (x *GetSubscriptionHistoryRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_subscription_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1517,99 +1826,6870 @@ func (x *GetExpiringSubscriptionsReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetExpiringSubscriptionsReply.ProtoReflect.Descriptor instead.
-func (*GetExpiringSubscriptionsReply) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetSubscriptionHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetSubscriptionHistoryRequest) Descriptor() ([]byte, []int) {
 	return file_subscription_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *GetExpiringSubscriptionsReply) GetSubscriptions() []*SubscriptionInfo {
+func (x *GetSubscriptionHistoryRequest) GetUid() string {
 	if x != nil {
-		return x.Subscriptions
+		return x.Uid
+	}
+	return ""
+}
+
+func (x *GetSubscriptionHistoryRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetSubscriptionHistoryRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetSubscriptionHistoryRequest) GetActions() []string {
+	if x != nil {
+		return x.Actions
 	}
 	return nil
 }
 
-func (x *GetExpiringSubscriptionsReply) GetTotal() int32 {
+func (x *GetSubscriptionHistoryRequest) GetPlanId() string {
 	if x != nil {
-		return x.Total
+		return x.PlanId
+	}
+	return ""
+}
+
+func (x *GetSubscriptionHistoryRequest) GetAppId() string {
+	if x != nil {
+		return x.AppId
+	}
+	return ""
+}
+
+func (x *GetSubscriptionHistoryRequest) GetStartTime() int64 {
+	if x != nil {
+		return x.StartTime
 	}
 	return 0
 }
 
-func (x *GetExpiringSubscriptionsReply) GetPage() int32 {
+func (x *GetSubscriptionHistoryRequest) GetEndTime() int64 {
 	if x != nil {
-		return x.Page
+		return x.EndTime
 	}
 	return 0
 }
 
-func (x *GetExpiringSubscriptionsReply) GetPageSize() int32 {
+func (x *GetSubscriptionHistoryRequest) GetCursor() uint64 {
 	if x != nil {
-		return x.PageSize
+		return x.Cursor
 	}
 	return 0
 }
 
-// 批量更新过期订阅
-type UpdateExpiredSubscriptionsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+type GetSubscriptionHistoryReply struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	Items         []*SubscriptionHistoryItem `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	Total         int32                      `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Page          int32                      `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                      `protobuf:"varint,4,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	NextCursor    uint64                     `protobuf:"varint,5,opt,name=nextCursor,proto3" json:"nextCursor,omitempty"` // 下一页游标，0 表示没有下一页
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateExpiredSubscriptionsRequest) Reset() {
-	*x = UpdateExpiredSubscriptionsRequest{}
+func (x *GetSubscriptionHistoryReply) Reset() {
+	*x = GetSubscriptionHistoryReply{}
 	mi := &file_subscription_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateExpiredSubscriptionsRequest) String() string {
+func (x *GetSubscriptionHistoryReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateExpiredSubscriptionsRequest) ProtoMessage() {}
+func (*GetSubscriptionHistoryReply) ProtoMessage() {}
+
+func (x *GetSubscriptionHistoryReply) ProtoReflect() protoreflect.Message {
+	mi := &file_subscription_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSubscriptionHistoryReply.ProtoReflect.Descriptor instead.
+func (*GetSubscriptionHistoryReply) Descriptor() ([]byte, []int) {
+	return file_subscription_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *GetSubscriptionHistoryReply) GetItems() []*SubscriptionHistoryItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *GetSubscriptionHistoryReply) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *GetSubscriptionHistoryReply) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetSubscriptionHistoryReply) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetSubscriptionHistoryReply) GetNextCursor() uint64 {
+	if x != nil {
+		return x.NextCursor
+	}
+	return 0
+}
+
+type ListAppSubscriptionHistoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uid           string                 `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`         // 用户ID，为空表示所有用户
+	Actions       []string               `protobuf:"bytes,2,rep,name=actions,proto3" json:"actions,omitempty"` // 操作类型，多个时匹配任一
+	PlanId        string                 `protobuf:"bytes,3,opt,name=planId,proto3" json:"planId,omitempty"`
+	StartTime     int64                  `protobuf:"varint,4,opt,name=startTime,proto3" json:"startTime,omitempty"` // 创建时间范围开始（包含），0 表示不限
+	EndTime       int64                  `protobuf:"varint,5,opt,name=endTime,proto3" json:"endTime,omitempty"`     // 创建时间范围结束（不包含），0 表示不限
+	Cursor        uint64                 `protobuf:"varint,6,opt,name=cursor,proto3" json:"cursor,omitempty"`       // 上一页返回的 nextCursor，0 表示从最新一条开始
+	PageSize      int32                  `protobuf:"varint,7,opt,name=pageSize,proto3" json:"pageSize,omitempty"`   // 每页数量，默认10，最大100
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAppSubscriptionHistoryRequest) Reset() {
+	*x = ListAppSubscriptionHistoryRequest{}
+	mi := &file_subscription_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAppSubscriptionHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAppSubscriptionHistoryRequest) ProtoMessage() {}
+
+func (x *ListAppSubscriptionHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_subscription_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAppSubscriptionHistoryRequest.ProtoReflect.Descriptor instead.
+func (*ListAppSubscriptionHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_subscription_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ListAppSubscriptionHistoryRequest) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+func (x *ListAppSubscriptionHistoryRequest) GetActions() []string {
+	if x != nil {
+		return x.Actions
+	}
+	return nil
+}
+
+func (x *ListAppSubscriptionHistoryRequest) GetPlanId() string {
+	if x != nil {
+		return x.PlanId
+	}
+	return ""
+}
+
+func (x *ListAppSubscriptionHistoryRequest) GetStartTime() int64 {
+	if x != nil {
+		return x.StartTime
+	}
+	return 0
+}
+
+func (x *ListAppSubscriptionHistoryRequest) GetEndTime() int64 {
+	if x != nil {
+		return x.EndTime
+	}
+	return 0
+}
+
+func (x *ListAppSubscriptionHistoryRequest) GetCursor() uint64 {
+	if x != nil {
+		return x.Cursor
+	}
+	return 0
+}
+
+func (x *ListAppSubscriptionHistoryRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type ListAppSubscriptionHistoryReply struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	Items         []*SubscriptionHistoryItem `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	NextCursor    uint64                     `protobuf:"varint,2,opt,name=nextCursor,proto3" json:"nextCursor,omitempty"` // 下一页游标，0 表示没有下一页
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAppSubscriptionHistoryReply) Reset() {
+	*x = ListAppSubscriptionHistoryReply{}
+	mi := &file_subscription_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAppSubscriptionHistoryReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAppSubscriptionHistoryReply) ProtoMessage() {}
+
+func (x *ListAppSubscriptionHistoryReply) ProtoReflect() protoreflect.Message {
+	mi := &file_subscription_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAppSubscriptionHistoryReply.ProtoReflect.Descriptor instead.
+func (*ListAppSubscriptionHistoryReply) Descriptor() ([]byte, []int) {
+	return file_subscription_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ListAppSubscriptionHistoryReply) GetItems() []*SubscriptionHistoryItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *ListAppSubscriptionHistoryReply) GetNextCursor() uint64 {
+	if x != nil {
+		return x.NextCursor
+	}
+	return 0
+}
+
+type ExportSubscriptionHistoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Format        string                 `protobuf:"bytes,1,opt,name=format,proto3" json:"format,omitempty"`   // 导出格式：csv, ndjson
+	Uid           string                 `protobuf:"bytes,2,opt,name=uid,proto3" json:"uid,omitempty"`         // 用户ID，为空表示所有用户
+	Actions       []string               `protobuf:"bytes,3,rep,name=actions,proto3" json:"actions,omitempty"` // 操作类型，多个时匹配任一
+	PlanId        string                 `protobuf:"bytes,4,opt,name=planId,proto3" json:"planId,omitempty"`
+	StartTime     int64                  `protobuf:"varint,5,opt,name=startTime,proto3" json:"startTime,omitempty"` // 创建时间范围开始（包含），0 表示不限
+	EndTime       int64                  `protobuf:"varint,6,opt,name=endTime,proto3" json:"endTime,omitempty"`     // 创建时间范围结束（不包含），0 表示不限
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportSubscriptionHistoryRequest) Reset() {
+	*x = ExportSubscriptionHistoryRequest{}
+	mi := &file_subscription_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportSubscriptionHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportSubscriptionHistoryRequest) ProtoMessage() {}
+
+func (x *ExportSubscriptionHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_subscription_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportSubscriptionHistoryRequest.ProtoReflect.Descriptor instead.
+func (*ExportSubscriptionHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_subscription_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ExportSubscriptionHistoryRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *ExportSubscriptionHistoryRequest) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+func (x *ExportSubscriptionHistoryRequest) GetActions() []string {
+	if x != nil {
+		return x.Actions
+	}
+	return nil
+}
+
+func (x *ExportSubscriptionHistoryRequest) GetPlanId() string {
+	if x != nil {
+		return x.PlanId
+	}
+	return ""
+}
+
+func (x *ExportSubscriptionHistoryRequest) GetStartTime() int64 {
+	if x != nil {
+		return x.StartTime
+	}
+	return 0
+}
+
+func (x *ExportSubscriptionHistoryRequest) GetEndTime() int64 {
+	if x != nil {
+		return x.EndTime
+	}
+	return 0
+}
+
+// 导出文件的一部分（按记录创建时间倒序，CSV 的第一块包含表头）
+type ExportSubscriptionHistoryChunk struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Content       []byte                 `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
+	ContentType   string                 `protobuf:"bytes,2,opt,name=contentType,proto3" json:"contentType,omitempty"` // 只在第一块返回
+	FileName      string                 `protobuf:"bytes,3,opt,name=fileName,proto3" json:"fileName,omitempty"`       // 只在第一块返回
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportSubscriptionHistoryChunk) Reset() {
+	*x = ExportSubscriptionHistoryChunk{}
+	mi := &file_subscription_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportSubscriptionHistoryChunk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportSubscriptionHistoryChunk) ProtoMessage() {}
+
+func (x *ExportSubscriptionHistoryChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_subscription_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportSubscriptionHistoryChunk.ProtoReflect.Descriptor instead.
+func (*ExportSubscriptionHistoryChunk) Descriptor() ([]byte, []int) {
+	return file_subscription_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ExportSubscriptionHistoryChunk) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+func (x *ExportSubscriptionHistoryChunk) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *ExportSubscriptionHistoryChunk) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+// 自动续费设置
+type SetAutoRenewRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uid           string                 `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`              // 用户ID（字符串 UUID）
+	AutoRenew     bool                   `protobuf:"varint,2,opt,name=autoRenew,proto3" json:"autoRenew,omitempty"` // true: 开启自动续费, false: 关闭自动续费
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetAutoRenewRequest) Reset() {
+	*x = SetAutoRenewRequest{}
+	mi := &file_subscription_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetAutoRenewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetAutoRenewRequest) ProtoMessage() {}
+
+func (x *SetAutoRenewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_subscription_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetAutoRenewRequest.ProtoReflect.Descriptor instead.
+func (*SetAutoRenewRequest) Descriptor() ([]byte, []int) {
+	return file_subscription_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *SetAutoRenewRequest) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+func (x *SetAutoRenewRequest) GetAutoRenew() bool {
+	if x != nil {
+		return x.AutoRenew
+	}
+	return false
+}
+
+// 获取即将过期的订阅
+type GetExpiringSubscriptionsRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	DaysBeforeExpiry int32                  `protobuf:"varint,1,opt,name=daysBeforeExpiry,proto3" json:"daysBeforeExpiry,omitempty"` // 过期前多少天，默认7天
+	Page             int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`                         // 页码，从1开始
+	PageSize         int32                  `protobuf:"varint,3,opt,name=pageSize,proto3" json:"pageSize,omitempty"`                 // 每页数量，默认10
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *GetExpiringSubscriptionsRequest) Reset() {
+	*x = GetExpiringSubscriptionsRequest{}
+	mi := &file_subscription_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetExpiringSubscriptionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetExpiringSubscriptionsRequest) ProtoMessage() {}
+
+func (x *GetExpiringSubscriptionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_subscription_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetExpiringSubscriptionsRequest.ProtoReflect.Descriptor instead.
+func (*GetExpiringSubscriptionsRequest) Descriptor() ([]byte, []int) {
+	return file_subscription_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *GetExpiringSubscriptionsRequest) GetDaysBeforeExpiry() int32 {
+	if x != nil {
+		return x.DaysBeforeExpiry
+	}
+	return 0
+}
+
+func (x *GetExpiringSubscriptionsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetExpiringSubscriptionsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type SubscriptionInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uid           string                 `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"` // 用户ID（字符串 UUID）
+	PlanId        string                 `protobuf:"bytes,2,opt,name=planId,proto3" json:"planId,omitempty"`
+	PlanName      string                 `protobuf:"bytes,3,opt,name=planName,proto3" json:"planName,omitempty"`
+	StartTime     int64                  `protobuf:"varint,4,opt,name=startTime,proto3" json:"startTime,omitempty"`
+	EndTime       int64                  `protobuf:"varint,5,opt,name=endTime,proto3" json:"endTime,omitempty"`
+	AutoRenew     bool                   `protobuf:"varint,6,opt,name=autoRenew,proto3" json:"autoRenew,omitempty"`
+	Amount        float64                `protobuf:"fixed64,7,opt,name=amount,proto3" json:"amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubscriptionInfo) Reset() {
+	*x = SubscriptionInfo{}
+	mi := &file_subscription_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubscriptionInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscriptionInfo) ProtoMessage() {}
+
+func (x *SubscriptionInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_subscription_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscriptionInfo.ProtoReflect.Descriptor instead.
+func (*SubscriptionInfo) Descriptor() ([]byte, []int) {
+	return file_subscription_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *SubscriptionInfo) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+func (x *SubscriptionInfo) GetPlanId() string {
+	if x != nil {
+		return x.PlanId
+	}
+	return ""
+}
+
+func (x *SubscriptionInfo) GetPlanName() string {
+	if x != nil {
+		return x.PlanName
+	}
+	return ""
+}
+
+func (x *SubscriptionInfo) GetStartTime() int64 {
+	if x != nil {
+		return x.StartTime
+	}
+	return 0
+}
+
+func (x *SubscriptionInfo) GetEndTime() int64 {
+	if x != nil {
+		return x.EndTime
+	}
+	return 0
+}
+
+func (x *SubscriptionInfo) GetAutoRenew() bool {
+	if x != nil {
+		return x.AutoRenew
+	}
+	return false
+}
+
+func (x *SubscriptionInfo) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+type GetExpiringSubscriptionsReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Subscriptions []*SubscriptionInfo    `protobuf:"bytes,1,rep,name=subscriptions,proto3" json:"subscriptions,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,4,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetExpiringSubscriptionsReply) Reset() {
+	*x = GetExpiringSubscriptionsReply{}
+	mi := &file_subscription_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetExpiringSubscriptionsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetExpiringSubscriptionsReply) ProtoMessage() {}
+
+func (x *GetExpiringSubscriptionsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_subscription_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetExpiringSubscriptionsReply.ProtoReflect.Descriptor instead.
+func (*GetExpiringSubscriptionsReply) Descriptor() ([]byte, []int) {
+	return file_subscription_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *GetExpiringSubscriptionsReply) GetSubscriptions() []*SubscriptionInfo {
+	if x != nil {
+		return x.Subscriptions
+	}
+	return nil
+}
+
+func (x *GetExpiringSubscriptionsReply) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *GetExpiringSubscriptionsReply) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetExpiringSubscriptionsReply) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+// 批量更新过期订阅
+type UpdateExpiredSubscriptionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateExpiredSubscriptionsRequest) Reset() {
+	*x = UpdateExpiredSubscriptionsRequest{}
+	mi := &file_subscription_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateExpiredSubscriptionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateExpiredSubscriptionsRequest) ProtoMessage() {}
+
+func (x *UpdateExpiredSubscriptionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_subscription_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateExpiredSubscriptionsRequest.ProtoReflect.Descriptor instead.
+func (*UpdateExpiredSubscriptionsRequest) Descriptor() ([]byte, []int) {
+	return file_subscription_proto_rawDescGZIP(), []int{33}
+}
+
+type UpdateExpiredSubscriptionsReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UpdatedCount  int32                  `protobuf:"varint,1,opt,name=updatedCount,proto3" json:"updatedCount,omitempty"`
+	UpdatedUids   []string               `protobuf:"bytes,2,rep,name=updatedUids,proto3" json:"updatedUids,omitempty"` // 更新的用户ID列表（字符串 UUID）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateExpiredSubscriptionsReply) Reset() {
+	*x = UpdateExpiredSubscriptionsReply{}
+	mi := &file_subscription_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateExpiredSubscriptionsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateExpiredSubscriptionsReply) ProtoMessage() {}
+
+func (x *UpdateExpiredSubscriptionsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_subscription_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateExpiredSubscriptionsReply.ProtoReflect.Descriptor instead.
+func (*UpdateExpiredSubscriptionsReply) Descriptor() ([]byte, []int) {
+	return file_subscription_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *UpdateExpiredSubscriptionsReply) GetUpdatedCount() int32 {
+	if x != nil {
+		return x.UpdatedCount
+	}
+	return 0
+}
+
+func (x *UpdateExpiredSubscriptionsReply) GetUpdatedUids() []string {
+	if x != nil {
+		return x.UpdatedUids
+	}
+	return nil
+}
+
+// 自动续费处理
+type ProcessAutoRenewalsRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	DaysBeforeExpiry int32                  `protobuf:"varint,1,opt,name=daysBeforeExpiry,proto3" json:"daysBeforeExpiry,omitempty"` // 提前多少天续费，默认3天
+	DryRun           bool                   `protobuf:"varint,2,opt,name=dryRun,proto3" json:"dryRun,omitempty"`                     // 是否为测试运行
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ProcessAutoRenewalsRequest) Reset() {
+	*x = ProcessAutoRenewalsRequest{}
+	mi := &file_subscription_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProcessAutoRenewalsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProcessAutoRenewalsRequest) ProtoMessage() {}
+
+func (x *ProcessAutoRenewalsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_subscription_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProcessAutoRenewalsRequest.ProtoReflect.Descriptor instead.
+func (*ProcessAutoRenewalsRequest) Descriptor() ([]byte, []int) {
+	return file_subscription_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ProcessAutoRenewalsRequest) GetDaysBeforeExpiry() int32 {
+	if x != nil {
+		return x.DaysBeforeExpiry
+	}
+	return 0
+}
+
+func (x *ProcessAutoRenewalsRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type AutoRenewResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uid           string                 `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"` // 用户ID（字符串 UUID）
+	PlanId        string                 `protobuf:"bytes,2,opt,name=planId,proto3" json:"planId,omitempty"`
+	Success       bool                   `protobuf:"varint,3,opt,name=success,proto3" json:"success,omitempty"`
+	OrderId       string                 `protobuf:"bytes,4,opt,name=orderId,proto3" json:"orderId,omitempty"`
+	PaymentId     string                 `protobuf:"bytes,5,opt,name=paymentId,proto3" json:"paymentId,omitempty"`
+	ErrorMessage  string                 `protobuf:"bytes,6,opt,name=errorMessage,proto3" json:"errorMessage,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AutoRenewResult) Reset() {
+	*x = AutoRenewResult{}
+	mi := &file_subscription_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AutoRenewResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AutoRenewResult) ProtoMessage() {}
+
+func (x *AutoRenewResult) ProtoReflect() protoreflect.Message {
+	mi := &file_subscription_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AutoRenewResult.ProtoReflect.Descriptor instead.
+func (*AutoRenewResult) Descriptor() ([]byte, []int) {
+	return file_subscription_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *AutoRenewResult) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+func (x *AutoRenewResult) GetPlanId() string {
+	if x != nil {
+		return x.PlanId
+	}
+	return ""
+}
+
+func (x *AutoRenewResult) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *AutoRenewResult) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *AutoRenewResult) GetPaymentId() string {
+	if x != nil {
+		return x.PaymentId
+	}
+	return ""
+}
+
+func (x *AutoRenewResult) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+type ProcessAutoRenewalsReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TotalCount    int32                  `protobuf:"varint,1,opt,name=totalCount,proto3" json:"totalCount,omitempty"`     // 总共需要处理的数量
+	SuccessCount  int32                  `protobuf:"varint,2,opt,name=successCount,proto3" json:"successCount,omitempty"` // 成功的数量
+	FailedCount   int32                  `protobuf:"varint,3,opt,name=failedCount,proto3" json:"failedCount,omitempty"`   // 失败的数量
+	Results       []*AutoRenewResult     `protobuf:"bytes,4,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProcessAutoRenewalsReply) Reset() {
+	*x = ProcessAutoRenewalsReply{}
+	mi := &file_subscription_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProcessAutoRenewalsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProcessAutoRenewalsReply) ProtoMessage() {}
+
+func (x *ProcessAutoRenewalsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_subscription_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProcessAutoRenewalsReply.ProtoReflect.Descriptor instead.
+func (*ProcessAutoRenewalsReply) Descriptor() ([]byte, []int) {
+	return file_subscription_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ProcessAutoRenewalsReply) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *ProcessAutoRenewalsReply) GetSuccessCount() int32 {
+	if x != nil {
+		return x.SuccessCount
+	}
+	return 0
+}
+
+func (x *ProcessAutoRenewalsReply) GetFailedCount() int32 {
+	if x != nil {
+		return x.FailedCount
+	}
+	return 0
+}
+
+func (x *ProcessAutoRenewalsReply) GetResults() []*AutoRenewResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type ProcessPriceChangeNoticesRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	DaysBeforeRenewal int32                  `protobuf:"varint,1,opt,name=daysBeforeRenewal,proto3" json:"daysBeforeRenewal,omitempty"` // 续费前多少天通知，默认7天
+	DryRun            bool                   `protobuf:"varint,2,opt,name=dryRun,proto3" json:"dryRun,omitempty"`                       // 是否为测试运行
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ProcessPriceChangeNoticesRequest) Reset() {
+	*x = ProcessPriceChangeNoticesRequest{}
+	mi := &file_subscription_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProcessPriceChangeNoticesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProcessPriceChangeNoticesRequest) ProtoMessage() {}
+
+func (x *ProcessPriceChangeNoticesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_subscription_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProcessPriceChangeNoticesRequest.ProtoReflect.Descriptor instead.
+func (*ProcessPriceChangeNoticesRequest) Descriptor() ([]byte, []int) {
+	return file_subscription_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ProcessPriceChangeNoticesRequest) GetDaysBeforeRenewal() int32 {
+	if x != nil {
+		return x.DaysBeforeRenewal
+	}
+	return 0
+}
+
+func (x *ProcessPriceChangeNoticesRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type PriceChangeNotice struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uid           string                 `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"` // 用户ID（字符串 UUID）
+	PlanId        string                 `protobuf:"bytes,2,opt,name=planId,proto3" json:"planId,omitempty"`
+	CountryCode   string                 `protobuf:"bytes,3,opt,name=countryCode,proto3" json:"countryCode,omitempty"`
+	OldPrice      float64                `protobuf:"fixed64,4,opt,name=oldPrice,proto3" json:"oldPrice,omitempty"`
+	OldCurrency   string                 `protobuf:"bytes,5,opt,name=oldCurrency,proto3" json:"oldCurrency,omitempty"`
+	NewPrice      float64                `protobuf:"fixed64,6,opt,name=newPrice,proto3" json:"newPrice,omitempty"`
+	NewCurrency   string                 `protobuf:"bytes,7,opt,name=newCurrency,proto3" json:"newCurrency,omitempty"`
+	RenewAt       int64                  `protobuf:"varint,8,opt,name=renewAt,proto3" json:"renewAt,omitempty"` // 按新价格续费的时间
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PriceChangeNotice) Reset() {
+	*x = PriceChangeNotice{}
+	mi := &file_subscription_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PriceChangeNotice) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PriceChangeNotice) ProtoMessage() {}
+
+func (x *PriceChangeNotice) ProtoReflect() protoreflect.Message {
+	mi := &file_subscription_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PriceChangeNotice.ProtoReflect.Descriptor instead.
+func (*PriceChangeNotice) Descriptor() ([]byte, []int) {
+	return file_subscription_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *PriceChangeNotice) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+func (x *PriceChangeNotice) GetPlanId() string {
+	if x != nil {
+		return x.PlanId
+	}
+	return ""
+}
+
+func (x *PriceChangeNotice) GetCountryCode() string {
+	if x != nil {
+		return x.CountryCode
+	}
+	return ""
+}
+
+func (x *PriceChangeNotice) GetOldPrice() float64 {
+	if x != nil {
+		return x.OldPrice
+	}
+	return 0
+}
+
+func (x *PriceChangeNotice) GetOldCurrency() string {
+	if x != nil {
+		return x.OldCurrency
+	}
+	return ""
+}
+
+func (x *PriceChangeNotice) GetNewPrice() float64 {
+	if x != nil {
+		return x.NewPrice
+	}
+	return 0
+}
+
+func (x *PriceChangeNotice) GetNewCurrency() string {
+	if x != nil {
+		return x.NewCurrency
+	}
+	return ""
+}
+
+func (x *PriceChangeNotice) GetRenewAt() int64 {
+	if x != nil {
+		return x.RenewAt
+	}
+	return 0
+}
+
+type ProcessPriceChangeNoticesReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TotalCount    int32                  `protobuf:"varint,1,opt,name=totalCount,proto3" json:"totalCount,omitempty"`       // 检查的自动续费订阅数量
+	NotifiedCount int32                  `protobuf:"varint,2,opt,name=notifiedCount,proto3" json:"notifiedCount,omitempty"` // 本次通知的数量
+	Notices       []*PriceChangeNotice   `protobuf:"bytes,3,rep,name=notices,proto3" json:"notices,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProcessPriceChangeNoticesReply) Reset() {
+	*x = ProcessPriceChangeNoticesReply{}
+	mi := &file_subscription_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProcessPriceChangeNoticesReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProcessPriceChangeNoticesReply) ProtoMessage() {}
+
+func (x *ProcessPriceChangeNoticesReply) ProtoReflect() protoreflect.Message {
+	mi := &file_subscription_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProcessPriceChangeNoticesReply.ProtoReflect.Descriptor instead.
+func (*ProcessPriceChangeNoticesReply) Descriptor() ([]byte, []int) {
+	return file_subscription_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ProcessPriceChangeNoticesReply) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *ProcessPriceChangeNoticesReply) GetNotifiedCount() int32 {
+	if x != nil {
+		return x.NotifiedCount
+	}
+	return 0
+}
+
+func (x *ProcessPriceChangeNoticesReply) GetNotices() []*PriceChangeNotice {
+	if x != nil {
+		return x.Notices
+	}
+	return nil
+}
+
+// 区域定价相关消息
+type PlanPricing struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlanPricingId uint64                 `protobuf:"varint,1,opt,name=planPricingId,proto3" json:"planPricingId,omitempty"`
+	PlanId        string                 `protobuf:"bytes,2,opt,name=planId,proto3" json:"planId,omitempty"`
+	CountryCode   string                 `protobuf:"bytes,3,opt,name=countryCode,proto3" json:"countryCode,omitempty"` // ISO 3166-1 alpha-2 国家代码或地区组代码
+	Price         float64                `protobuf:"fixed64,4,opt,name=price,proto3" json:"price,omitempty"`
+	Currency      string                 `protobuf:"bytes,5,opt,name=currency,proto3" json:"currency,omitempty"`
+	EffectiveFrom int64                  `protobuf:"varint,6,opt,name=effectiveFrom,proto3" json:"effectiveFrom,omitempty"` // 生效开始时间（0 表示不限）
+	EffectiveTo   int64                  `protobuf:"varint,7,opt,name=effectiveTo,proto3" json:"effectiveTo,omitempty"`     // 生效结束时间（0 表示不限，不包含）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlanPricing) Reset() {
+	*x = PlanPricing{}
+	mi := &file_subscription_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlanPricing) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlanPricing) ProtoMessage() {}
+
+func (x *PlanPricing) ProtoReflect() protoreflect.Message {
+	mi := &file_subscription_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlanPricing.ProtoReflect.Descriptor instead.
+func (*PlanPricing) Descriptor() ([]byte, []int) {
+	return file_subscription_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *PlanPricing) GetPlanPricingId() uint64 {
+	if x != nil {
+		return x.PlanPricingId
+	}
+	return 0
+}
+
+func (x *PlanPricing) GetPlanId() string {
+	if x != nil {
+		return x.PlanId
+	}
+	return ""
+}
+
+func (x *PlanPricing) GetCountryCode() string {
+	if x != nil {
+		return x.CountryCode
+	}
+	return ""
+}
+
+func (x *PlanPricing) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *PlanPricing) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *PlanPricing) GetEffectiveFrom() int64 {
+	if x != nil {
+		return x.EffectiveFrom
+	}
+	return 0
+}
+
+func (x *PlanPricing) GetEffectiveTo() int64 {
+	if x != nil {
+		return x.EffectiveTo
+	}
+	return 0
+}
+
+type ListPlanPricingsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlanId        string                 `protobuf:"bytes,1,opt,name=planId,proto3" json:"planId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPlanPricingsRequest) Reset() {
+	*x = ListPlanPricingsRequest{}
+	mi := &file_subscription_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPlanPricingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPlanPricingsRequest) ProtoMessage() {}
+
+func (x *ListPlanPricingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_subscription_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPlanPricingsRequest.ProtoReflect.Descriptor instead.
+func (*ListPlanPricingsRequest) Descriptor() ([]byte, []int) {
+	return file_subscription_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ListPlanPricingsRequest) GetPlanId() string {
+	if x != nil {
+		return x.PlanId
+	}
+	return ""
+}
+
+type ListPlanPricingsReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pricings      []*PlanPricing         `protobuf:"bytes,1,rep,name=pricings,proto3" json:"pricings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPlanPricingsReply) Reset() {
+	*x = ListPlanPricingsReply{}
+	mi := &file_subscription_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPlanPricingsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPlanPricingsReply) ProtoMessage() {}
+
+func (x *ListPlanPricingsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_subscription_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPlanPricingsReply.ProtoReflect.Descriptor instead.
+func (*ListPlanPricingsReply) Descriptor() ([]byte, []int) {
+	return file_subscription_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ListPlanPricingsReply) GetPricings() []*PlanPricing {
+	if x != nil {
+		return x.Pricings
+	}
+	return nil
+}
+
+type CreatePlanPricingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlanId        string                 `protobuf:"bytes,1,opt,name=planId,proto3" json:"planId,omitempty"`
+	CountryCode   string                 `protobuf:"bytes,2,opt,name=countryCode,proto3" json:"countryCode,omitempty"` // ISO 3166-1 alpha-2 国家代码或地区组代码（如 EU）
+	Price         float64                `protobuf:"fixed64,3,opt,name=price,proto3" json:"price,omitempty"`
+	Currency      string                 `protobuf:"bytes,4,opt,name=currency,proto3" json:"currency,omitempty"`
+	EffectiveFrom int64                  `protobuf:"varint,5,opt,name=effectiveFrom,proto3" json:"effectiveFrom,omitempty"` // 生效开始时间（Unix 秒，0 表示立即生效）
+	EffectiveTo   int64                  `protobuf:"varint,6,opt,name=effectiveTo,proto3" json:"effectiveTo,omitempty"`     // 生效结束时间（Unix 秒，0 表示不限）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePlanPricingRequest) Reset() {
+	*x = CreatePlanPricingRequest{}
+	mi := &file_subscription_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePlanPricingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePlanPricingRequest) ProtoMessage() {}
+
+func (x *CreatePlanPricingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_subscription_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePlanPricingRequest.ProtoReflect.Descriptor instead.
+func (*CreatePlanPricingRequest) Descriptor() ([]byte, []int) {
+	return file_subscription_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *CreatePlanPricingRequest) GetPlanId() string {
+	if x != nil {
+		return x.PlanId
+	}
+	return ""
+}
+
+func (x *CreatePlanPricingRequest) GetCountryCode() string {
+	if x != nil {
+		return x.CountryCode
+	}
+	return ""
+}
+
+func (x *CreatePlanPricingRequest) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *CreatePlanPricingRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *CreatePlanPricingRequest) GetEffectiveFrom() int64 {
+	if x != nil {
+		return x.EffectiveFrom
+	}
+	return 0
+}
+
+func (x *CreatePlanPricingRequest) GetEffectiveTo() int64 {
+	if x != nil {
+		return x.EffectiveTo
+	}
+	return 0
+}
+
+type CreatePlanPricingReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pricing       *PlanPricing           `protobuf:"bytes,1,opt,name=pricing,proto3" json:"pricing,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePlanPricingReply) Reset() {
+	*x = CreatePlanPricingReply{}
+	mi := &file_subscription_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePlanPricingReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePlanPricingReply) ProtoMessage() {}
+
+func (x *CreatePlanPricingReply) ProtoReflect() protoreflect.Message {
+	mi := &file_subscription_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePlanPricingReply.ProtoReflect.Descriptor instead.
+func (*CreatePlanPricingReply) Descriptor() ([]byte, []int) {
+	return file_subscription_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *CreatePlanPricingReply) GetPricing() *PlanPricing {
+	if x != nil {
+		return x.Pricing
+	}
+	return nil
+}
+
+type UpdatePlanPricingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlanPricingId uint64                 `protobuf:"varint,1,opt,name=planPricingId,proto3" json:"planPricingId,omitempty"`
+	Price         float64                `protobuf:"fixed64,2,opt,name=price,proto3" json:"price,omitempty"`
+	Currency      string                 `protobuf:"bytes,3,opt,name=currency,proto3" json:"currency,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdatePlanPricingRequest) Reset() {
+	*x = UpdatePlanPricingRequest{}
+	mi := &file_subscription_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePlanPricingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePlanPricingRequest) ProtoMessage() {}
+
+func (x *UpdatePlanPricingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_subscription_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePlanPricingRequest.ProtoReflect.Descriptor instead.
+func (*UpdatePlanPricingRequest) Descriptor() ([]byte, []int) {
+	return file_subscription_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *UpdatePlanPricingRequest) GetPlanPricingId() uint64 {
+	if x != nil {
+		return x.PlanPricingId
+	}
+	return 0
+}
+
+func (x *UpdatePlanPricingRequest) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *UpdatePlanPricingRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+type UpdatePlanPricingReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pricing       *PlanPricing           `protobuf:"bytes,1,opt,name=pricing,proto3" json:"pricing,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdatePlanPricingReply) Reset() {
+	*x = UpdatePlanPricingReply{}
+	mi := &file_subscription_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePlanPricingReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePlanPricingReply) ProtoMessage() {}
+
+func (x *UpdatePlanPricingReply) ProtoReflect() protoreflect.Message {
+	mi := &file_subscription_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePlanPricingReply.ProtoReflect.Descriptor instead.
+func (*UpdatePlanPricingReply) Descriptor() ([]byte, []int) {
+	return file_subscription_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *UpdatePlanPricingReply) GetPricing() *PlanPricing {
+	if x != nil {
+		return x.Pricing
+	}
+	return nil
+}
+
+type DeletePlanPricingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlanPricingId uint64                 `protobuf:"varint,1,opt,name=planPricingId,proto3" json:"planPricingId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletePlanPricingRequest) Reset() {
+	*x = DeletePlanPricingRequest{}
+	mi := &file_subscription_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePlanPricingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePlanPricingRequest) ProtoMessage() {}
+
+func (x *DeletePlanPricingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_subscription_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePlanPricingRequest.ProtoReflect.Descriptor instead.
+func (*DeletePlanPricingRequest) Descriptor() ([]byte, []int) {
+	return file_subscription_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *DeletePlanPricingRequest) GetPlanPricingId() uint64 {
+	if x != nil {
+		return x.PlanPricingId
+	}
+	return 0
+}
+
+type DeletePlanPricingReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlanPricingId uint64                 `protobuf:"varint,1,opt,name=planPricingId,proto3" json:"planPricingId,omitempty"` // 被删除的区域定价ID
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletePlanPricingReply) Reset() {
+	*x = DeletePlanPricingReply{}
+	mi := &file_subscription_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePlanPricingReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePlanPricingReply) ProtoMessage() {}
+
+func (x *DeletePlanPricingReply) ProtoReflect() protoreflect.Message {
+	mi := &file_subscription_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePlanPricingReply.ProtoReflect.Descriptor instead.
+func (*DeletePlanPricingReply) Descriptor() ([]byte, []int) {
+	return file_subscription_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *DeletePlanPricingReply) GetPlanPricingId() uint64 {
+	if x != nil {
+		return x.PlanPricingId
+	}
+	return 0
+}
+
+// 应用订阅配置
+type AppSetting struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	AppId             string                 `protobuf:"bytes,1,opt,name=appId,proto3" json:"appId,omitempty"`
+	DefaultFreePlanId string                 `protobuf:"bytes,2,opt,name=defaultFreePlanId,proto3" json:"defaultFreePlanId,omitempty"` // 默认免费套餐ID（为空表示不回落）
+	UpdatedAt         int64                  `protobuf:"varint,3,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+	RegionDetection   []string               `protobuf:"bytes,4,rep,name=regionDetection,proto3" json:"regionDetection,omitempty"`     // 地区推断顺序（为空表示使用全局配置）
+	ReportingCurrency string                 `protobuf:"bytes,5,opt,name=reportingCurrency,proto3" json:"reportingCurrency,omitempty"` // 报表币种（为空表示使用全局配置）
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *AppSetting) Reset() {
+	*x = AppSetting{}
+	mi := &file_subscription_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AppSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AppSetting) ProtoMessage() {}
+
+func (x *AppSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_subscription_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AppSetting.ProtoReflect.Descriptor instead.
+func (*AppSetting) Descriptor() ([]byte, []int) {
+	return file_subscription_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *AppSetting) GetAppId() string {
+	if x != nil {
+		return x.AppId
+	}
+	return ""
+}
+
+func (x *AppSetting) GetDefaultFreePlanId() string {
+	if x != nil {
+		return x.DefaultFreePlanId
+	}
+	return ""
+}
+
+func (x *AppSetting) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+func (x *AppSetting) GetRegionDetection() []string {
+	if x != nil {
+		return x.RegionDetection
+	}
+	return nil
+}
+
+func (x *AppSetting) GetReportingCurrency() string {
+	if x != nil {
+		return x.ReportingCurrency
+	}
+	return ""
+}
+
+type GetAppSettingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AppId         string                 `protobuf:"bytes,1,opt,name=appId,proto3" json:"appId,omitempty"` // 应用ID（从 X-App-Id Header 获取）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAppSettingRequest) Reset() {
+	*x = GetAppSettingRequest{}
+	mi := &file_subscription_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAppSettingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAppSettingRequest) ProtoMessage() {}
+
+func (x *GetAppSettingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_subscription_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAppSettingRequest.ProtoReflect.Descriptor instead.
+func (*GetAppSettingRequest) Descriptor() ([]byte, []int) {
+	return file_subscription_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *GetAppSettingRequest) GetAppId() string {
+	if x != nil {
+		return x.AppId
+	}
+	return ""
+}
+
+type GetAppSettingReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Setting       *AppSetting            `protobuf:"bytes,1,opt,name=setting,proto3" json:"setting,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAppSettingReply) Reset() {
+	*x = GetAppSettingReply{}
+	mi := &file_subscription_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAppSettingReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAppSettingReply) ProtoMessage() {}
+
+func (x *GetAppSettingReply) ProtoReflect() protoreflect.Message {
+	mi := &file_subscription_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAppSettingReply.ProtoReflect.Descriptor instead.
+func (*GetAppSettingReply) Descriptor() ([]byte, []int) {
+	return file_subscription_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *GetAppSettingReply) GetSetting() *AppSetting {
+	if x != nil {
+		return x.Setting
+	}
+	return nil
+}
+
+type UpdateAppSettingRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	DefaultFreePlanId string                 `protobuf:"bytes,1,opt,name=defaultFreePlanId,proto3" json:"defaultFreePlanId,omitempty"` // 默认免费套餐ID（价格为 0 的周期套餐），传空字符串取消回落
+	RegionDetection   []string               `protobuf:"bytes,2,rep,name=regionDetection,proto3" json:"regionDetection,omitempty"`     // 地区推断顺序，为空表示使用全局配置
+	ReportingCurrency string                 `protobuf:"bytes,3,opt,name=reportingCurrency,proto3" json:"reportingCurrency,omitempty"` // 报表币种（ISO 4217，如 USD、CNY），为空表示使用全局配置
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *UpdateAppSettingRequest) Reset() {
+	*x = UpdateAppSettingRequest{}
+	mi := &file_subscription_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateAppSettingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateAppSettingRequest) ProtoMessage() {}
+
+func (x *UpdateAppSettingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_subscription_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateAppSettingRequest.ProtoReflect.Descriptor instead.
+func (*UpdateAppSettingRequest) Descriptor() ([]byte, []int) {
+	return file_subscription_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *UpdateAppSettingRequest) GetDefaultFreePlanId() string {
+	if x != nil {
+		return x.DefaultFreePlanId
+	}
+	return ""
+}
+
+func (x *UpdateAppSettingRequest) GetRegionDetection() []string {
+	if x != nil {
+		return x.RegionDetection
+	}
+	return nil
+}
+
+func (x *UpdateAppSettingRequest) GetReportingCurrency() string {
+	if x != nil {
+		return x.ReportingCurrency
+	}
+	return ""
+}
+
+type UpdateAppSettingReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Setting       *AppSetting            `protobuf:"bytes,1,opt,name=setting,proto3" json:"setting,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateAppSettingReply) Reset() {
+	*x = UpdateAppSettingReply{}
+	mi := &file_subscription_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateAppSettingReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateAppSettingReply) ProtoMessage() {}
+
+func (x *UpdateAppSettingReply) ProtoReflect() protoreflect.Message {
+	mi := &file_subscription_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateAppSettingReply.ProtoReflect.Descriptor instead.
+func (*UpdateAppSettingReply) Descriptor() ([]byte, []int) {
+	return file_subscription_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *UpdateAppSettingReply) GetSetting() *AppSetting {
+	if x != nil {
+		return x.Setting
+	}
+	return nil
+}
+
+// 地区组（组内国家共用一套区域定价）
+type RegionGroup struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupCode     string                 `protobuf:"bytes,1,opt,name=groupCode,proto3" json:"groupCode,omitempty"` // 地区组代码，如 EU、LATAM、SEA
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Countries     []string               `protobuf:"bytes,4,rep,name=countries,proto3" json:"countries,omitempty"` // 成员国家代码（ISO 3166-1 alpha-2）
+	UpdatedAt     int64                  `protobuf:"varint,5,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegionGroup) Reset() {
+	*x = RegionGroup{}
+	mi := &file_subscription_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegionGroup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegionGroup) ProtoMessage() {}
+
+func (x *RegionGroup) ProtoReflect() protoreflect.Message {
+	mi := &file_subscription_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegionGroup.ProtoReflect.Descriptor instead.
+func (*RegionGroup) Descriptor() ([]byte, []int) {
+	return file_subscription_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *RegionGroup) GetGroupCode() string {
+	if x != nil {
+		return x.GroupCode
+	}
+	return ""
+}
+
+func (x *RegionGroup) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RegionGroup) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *RegionGroup) GetCountries() []string {
+	if x != nil {
+		return x.Countries
+	}
+	return nil
+}
+
+func (x *RegionGroup) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+type ListRegionGroupsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRegionGroupsRequest) Reset() {
+	*x = ListRegionGroupsRequest{}
+	mi := &file_subscription_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRegionGroupsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRegionGroupsRequest) ProtoMessage() {}
+
+func (x *ListRegionGroupsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_subscription_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRegionGroupsRequest.ProtoReflect.Descriptor instead.
+func (*ListRegionGroupsRequest) Descriptor() ([]byte, []int) {
+	return file_subscription_proto_rawDescGZIP(), []int{56}
+}
+
+type ListRegionGroupsReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Groups        []*RegionGroup         `protobuf:"bytes,1,rep,name=groups,proto3" json:"groups,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRegionGroupsReply) Reset() {
+	*x = ListRegionGroupsReply{}
+	mi := &file_subscription_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRegionGroupsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRegionGroupsReply) ProtoMessage() {}
+
+func (x *ListRegionGroupsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_subscription_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRegionGroupsReply.ProtoReflect.Descriptor instead.
+func (*ListRegionGroupsReply) Descriptor() ([]byte, []int) {
+	return file_subscription_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *ListRegionGroupsReply) GetGroups() []*RegionGroup {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+type GetRegionGroupRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupCode     string                 `protobuf:"bytes,1,opt,name=groupCode,proto3" json:"groupCode,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRegionGroupRequest) Reset() {
+	*x = GetRegionGroupRequest{}
+	mi := &file_subscription_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRegionGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRegionGroupRequest) ProtoMessage() {}
+
+func (x *GetRegionGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_subscription_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRegionGroupRequest.ProtoReflect.Descriptor instead.
+func (*GetRegionGroupRequest) Descriptor() ([]byte, []int) {
+	return file_subscription_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *GetRegionGroupRequest) GetGroupCode() string {
+	if x != nil {
+		return x.GroupCode
+	}
+	return ""
+}
+
+type GetRegionGroupReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Group         *RegionGroup           `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRegionGroupReply) Reset() {
+	*x = GetRegionGroupReply{}
+	mi := &file_subscription_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRegionGroupReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRegionGroupReply) ProtoMessage() {}
+
+func (x *GetRegionGroupReply) ProtoReflect() protoreflect.Message {
+	mi := &file_subscription_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRegionGroupReply.ProtoReflect.Descriptor instead.
+func (*GetRegionGroupReply) Descriptor() ([]byte, []int) {
+	return file_subscription_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *GetRegionGroupReply) GetGroup() *RegionGroup {
+	if x != nil {
+		return x.Group
+	}
+	return nil
+}
+
+type SaveRegionGroupRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupCode     string                 `protobuf:"bytes,1,opt,name=groupCode,proto3" json:"groupCode,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Countries     []string               `protobuf:"bytes,4,rep,name=countries,proto3" json:"countries,omitempty"` // 成员国家代码，每个国家只能属于一个地区组
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SaveRegionGroupRequest) Reset() {
+	*x = SaveRegionGroupRequest{}
+	mi := &file_subscription_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SaveRegionGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveRegionGroupRequest) ProtoMessage() {}
+
+func (x *SaveRegionGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_subscription_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveRegionGroupRequest.ProtoReflect.Descriptor instead.
+func (*SaveRegionGroupRequest) Descriptor() ([]byte, []int) {
+	return file_subscription_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *SaveRegionGroupRequest) GetGroupCode() string {
+	if x != nil {
+		return x.GroupCode
+	}
+	return ""
+}
+
+func (x *SaveRegionGroupRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SaveRegionGroupRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *SaveRegionGroupRequest) GetCountries() []string {
+	if x != nil {
+		return x.Countries
+	}
+	return nil
+}
+
+type SaveRegionGroupReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Group         *RegionGroup           `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SaveRegionGroupReply) Reset() {
+	*x = SaveRegionGroupReply{}
+	mi := &file_subscription_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SaveRegionGroupReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveRegionGroupReply) ProtoMessage() {}
+
+func (x *SaveRegionGroupReply) ProtoReflect() protoreflect.Message {
+	mi := &file_subscription_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveRegionGroupReply.ProtoReflect.Descriptor instead.
+func (*SaveRegionGroupReply) Descriptor() ([]byte, []int) {
+	return file_subscription_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *SaveRegionGroupReply) GetGroup() *RegionGroup {
+	if x != nil {
+		return x.Group
+	}
+	return nil
+}
+
+type DeleteRegionGroupRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupCode     string                 `protobuf:"bytes,1,opt,name=groupCode,proto3" json:"groupCode,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteRegionGroupRequest) Reset() {
+	*x = DeleteRegionGroupRequest{}
+	mi := &file_subscription_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteRegionGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRegionGroupRequest) ProtoMessage() {}
+
+func (x *DeleteRegionGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_subscription_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteRegionGroupRequest.ProtoReflect.Descriptor instead.
+func (*DeleteRegionGroupRequest) Descriptor() ([]byte, []int) {
+	return file_subscription_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *DeleteRegionGroupRequest) GetGroupCode() string {
+	if x != nil {
+		return x.GroupCode
+	}
+	return ""
+}
+
+type DeleteRegionGroupReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupCode     string                 `protobuf:"bytes,1,opt,name=groupCode,proto3" json:"groupCode,omitempty"` // 被删除的地区组代码
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteRegionGroupReply) Reset() {
+	*x = DeleteRegionGroupReply{}
+	mi := &file_subscription_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteRegionGroupReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRegionGroupReply) ProtoMessage() {}
+
+func (x *DeleteRegionGroupReply) ProtoReflect() protoreflect.Message {
+	mi := &file_subscription_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteRegionGroupReply.ProtoReflect.Descriptor instead.
+func (*DeleteRegionGroupReply) Descriptor() ([]byte, []int) {
+	return file_subscription_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *DeleteRegionGroupReply) GetGroupCode() string {
+	if x != nil {
+		return x.GroupCode
+	}
+	return ""
+}
+
+// 发票（支付成功后开具）或红字发票（退款时开具），开具后不可修改
+type Invoice struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	InvoiceId         uint64                 `protobuf:"varint,1,opt,name=invoiceId,proto3" json:"invoiceId,omitempty"`
+	InvoiceNo         string                 `protobuf:"bytes,2,opt,name=invoiceNo,proto3" json:"invoiceNo,omitempty"`     // 应用内连续编号，如 INV-000001、CN-000001
+	InvoiceType       string                 `protobuf:"bytes,3,opt,name=invoiceType,proto3" json:"invoiceType,omitempty"` // invoice-发票, credit_note-红字发票
+	Uid               string                 `protobuf:"bytes,4,opt,name=uid,proto3" json:"uid,omitempty"`
+	OrderId           string                 `protobuf:"bytes,5,opt,name=orderId,proto3" json:"orderId,omitempty"`
+	OriginalInvoiceNo string                 `protobuf:"bytes,6,opt,name=originalInvoiceNo,proto3" json:"originalInvoiceNo,omitempty"` // 红字发票冲减的原发票编号
+	PlanId            string                 `protobuf:"bytes,7,opt,name=planId,proto3" json:"planId,omitempty"`
+	PlanName          string                 `protobuf:"bytes,8,opt,name=planName,proto3" json:"planName,omitempty"`
+	CountryCode       string                 `protobuf:"bytes,9,opt,name=countryCode,proto3" json:"countryCode,omitempty"`
+	VatId             string                 `protobuf:"bytes,10,opt,name=vatId,proto3" json:"vatId,omitempty"`
+	Currency          string                 `protobuf:"bytes,11,opt,name=currency,proto3" json:"currency,omitempty"`
+	Subtotal          float64                `protobuf:"fixed64,12,opt,name=subtotal,proto3" json:"subtotal,omitempty"` // 套餐价格（红字发票为冲减的不含税金额）
+	Discount          float64                `protobuf:"fixed64,13,opt,name=discount,proto3" json:"discount,omitempty"`
+	ProrationCredit   float64                `protobuf:"fixed64,14,opt,name=prorationCredit,proto3" json:"prorationCredit,omitempty"`
+	TaxAmount         float64                `protobuf:"fixed64,15,opt,name=taxAmount,proto3" json:"taxAmount,omitempty"`
+	Total             float64                `protobuf:"fixed64,16,opt,name=total,proto3" json:"total,omitempty"` // 发票金额（红字发票为退款金额）
+	PeriodStart       int64                  `protobuf:"varint,17,opt,name=periodStart,proto3" json:"periodStart,omitempty"`
+	PeriodEnd         int64                  `protobuf:"varint,18,opt,name=periodEnd,proto3" json:"periodEnd,omitempty"` // 终身套餐为 0
+	IssuedAt          int64                  `protobuf:"varint,19,opt,name=issuedAt,proto3" json:"issuedAt,omitempty"`
+	Lines             []*InvoiceLine         `protobuf:"bytes,20,rep,name=lines,proto3" json:"lines,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *Invoice) Reset() {
+	*x = Invoice{}
+	mi := &file_subscription_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Invoice) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Invoice) ProtoMessage() {}
+
+func (x *Invoice) ProtoReflect() protoreflect.Message {
+	mi := &file_subscription_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Invoice.ProtoReflect.Descriptor instead.
+func (*Invoice) Descriptor() ([]byte, []int) {
+	return file_subscription_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *Invoice) GetInvoiceId() uint64 {
+	if x != nil {
+		return x.InvoiceId
+	}
+	return 0
+}
+
+func (x *Invoice) GetInvoiceNo() string {
+	if x != nil {
+		return x.InvoiceNo
+	}
+	return ""
+}
+
+func (x *Invoice) GetInvoiceType() string {
+	if x != nil {
+		return x.InvoiceType
+	}
+	return ""
+}
+
+func (x *Invoice) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+func (x *Invoice) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *Invoice) GetOriginalInvoiceNo() string {
+	if x != nil {
+		return x.OriginalInvoiceNo
+	}
+	return ""
+}
+
+func (x *Invoice) GetPlanId() string {
+	if x != nil {
+		return x.PlanId
+	}
+	return ""
+}
+
+func (x *Invoice) GetPlanName() string {
+	if x != nil {
+		return x.PlanName
+	}
+	return ""
+}
+
+func (x *Invoice) GetCountryCode() string {
+	if x != nil {
+		return x.CountryCode
+	}
+	return ""
+}
+
+func (x *Invoice) GetVatId() string {
+	if x != nil {
+		return x.VatId
+	}
+	return ""
+}
+
+func (x *Invoice) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *Invoice) GetSubtotal() float64 {
+	if x != nil {
+		return x.Subtotal
+	}
+	return 0
+}
+
+func (x *Invoice) GetDiscount() float64 {
+	if x != nil {
+		return x.Discount
+	}
+	return 0
+}
+
+func (x *Invoice) GetProrationCredit() float64 {
+	if x != nil {
+		return x.ProrationCredit
+	}
+	return 0
+}
+
+func (x *Invoice) GetTaxAmount() float64 {
+	if x != nil {
+		return x.TaxAmount
+	}
+	return 0
+}
+
+func (x *Invoice) GetTotal() float64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *Invoice) GetPeriodStart() int64 {
+	if x != nil {
+		return x.PeriodStart
+	}
+	return 0
+}
+
+func (x *Invoice) GetPeriodEnd() int64 {
+	if x != nil {
+		return x.PeriodEnd
+	}
+	return 0
+}
+
+func (x *Invoice) GetIssuedAt() int64 {
+	if x != nil {
+		return x.IssuedAt
+	}
+	return 0
+}
+
+func (x *Invoice) GetLines() []*InvoiceLine {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+// 发票明细行
+type InvoiceLine struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LineType      string                 `protobuf:"bytes,1,opt,name=lineType,proto3" json:"lineType,omitempty"`       // plan, discount, proration, tax, refund
+	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"` // 套餐名称或税种名称
+	PeriodStart   int64                  `protobuf:"varint,3,opt,name=periodStart,proto3" json:"periodStart,omitempty"`
+	PeriodEnd     int64                  `protobuf:"varint,4,opt,name=periodEnd,proto3" json:"periodEnd,omitempty"`
+	TaxRate       float64                `protobuf:"fixed64,5,opt,name=taxRate,proto3" json:"taxRate,omitempty"`            // 税费明细的税率
+	Inclusive     bool                   `protobuf:"varint,6,opt,name=inclusive,proto3" json:"inclusive,omitempty"`         // 税费明细是否含税定价
+	ReverseCharge bool                   `protobuf:"varint,7,opt,name=reverseCharge,proto3" json:"reverseCharge,omitempty"` // 税费明细是否反向征税
+	Amount        float64                `protobuf:"fixed64,8,opt,name=amount,proto3" json:"amount,omitempty"`              // 金额（优惠和抵扣为负数）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InvoiceLine) Reset() {
+	*x = InvoiceLine{}
+	mi := &file_subscription_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InvoiceLine) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvoiceLine) ProtoMessage() {}
+
+func (x *InvoiceLine) ProtoReflect() protoreflect.Message {
+	mi := &file_subscription_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InvoiceLine.ProtoReflect.Descriptor instead.
+func (*InvoiceLine) Descriptor() ([]byte, []int) {
+	return file_subscription_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *InvoiceLine) GetLineType() string {
+	if x != nil {
+		return x.LineType
+	}
+	return ""
+}
+
+func (x *InvoiceLine) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *InvoiceLine) GetPeriodStart() int64 {
+	if x != nil {
+		return x.PeriodStart
+	}
+	return 0
+}
+
+func (x *InvoiceLine) GetPeriodEnd() int64 {
+	if x != nil {
+		return x.PeriodEnd
+	}
+	return 0
+}
+
+func (x *InvoiceLine) GetTaxRate() float64 {
+	if x != nil {
+		return x.TaxRate
+	}
+	return 0
+}
+
+func (x *InvoiceLine) GetInclusive() bool {
+	if x != nil {
+		return x.Inclusive
+	}
+	return false
+}
+
+func (x *InvoiceLine) GetReverseCharge() bool {
+	if x != nil {
+		return x.ReverseCharge
+	}
+	return false
+}
+
+func (x *InvoiceLine) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+type GetInvoiceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	InvoiceId     uint64                 `protobuf:"varint,1,opt,name=invoiceId,proto3" json:"invoiceId,omitempty"`
+	Format        string                 `protobuf:"bytes,2,opt,name=format,proto3" json:"format,omitempty"`     // 渲染格式，为空时只返回发票数据
+	Language      string                 `protobuf:"bytes,3,opt,name=language,proto3" json:"language,omitempty"` // 渲染语言: zh-CN, en-US，为空时使用请求语言或默认语言
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetInvoiceRequest) Reset() {
+	*x = GetInvoiceRequest{}
+	mi := &file_subscription_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetInvoiceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInvoiceRequest) ProtoMessage() {}
+
+func (x *GetInvoiceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_subscription_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInvoiceRequest.ProtoReflect.Descriptor instead.
+func (*GetInvoiceRequest) Descriptor() ([]byte, []int) {
+	return file_subscription_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *GetInvoiceRequest) GetInvoiceId() uint64 {
+	if x != nil {
+		return x.InvoiceId
+	}
+	return 0
+}
+
+func (x *GetInvoiceRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *GetInvoiceRequest) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+type GetInvoiceReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Invoice       *Invoice               `protobuf:"bytes,1,opt,name=invoice,proto3" json:"invoice,omitempty"`
+	Content       []byte                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`         // 渲染后的 HTML 或 PDF 内容
+	ContentType   string                 `protobuf:"bytes,3,opt,name=contentType,proto3" json:"contentType,omitempty"` // text/html; charset=utf-8 或 application/pdf
+	FileName      string                 `protobuf:"bytes,4,opt,name=fileName,proto3" json:"fileName,omitempty"`       // 建议的下载文件名，如 INV-000001.pdf
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetInvoiceReply) Reset() {
+	*x = GetInvoiceReply{}
+	mi := &file_subscription_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetInvoiceReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInvoiceReply) ProtoMessage() {}
+
+func (x *GetInvoiceReply) ProtoReflect() protoreflect.Message {
+	mi := &file_subscription_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInvoiceReply.ProtoReflect.Descriptor instead.
+func (*GetInvoiceReply) Descriptor() ([]byte, []int) {
+	return file_subscription_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *GetInvoiceReply) GetInvoice() *Invoice {
+	if x != nil {
+		return x.Invoice
+	}
+	return nil
+}
+
+func (x *GetInvoiceReply) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+func (x *GetInvoiceReply) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *GetInvoiceReply) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+type ListInvoicesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uid           string                 `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`            // 用户ID（字符串 UUID）
+	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`         // 页码，从1开始
+	PageSize      int32                  `protobuf:"varint,3,opt,name=pageSize,proto3" json:"pageSize,omitempty"` // 每页数量，默认10
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListInvoicesRequest) Reset() {
+	*x = ListInvoicesRequest{}
+	mi := &file_subscription_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListInvoicesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInvoicesRequest) ProtoMessage() {}
+
+func (x *ListInvoicesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_subscription_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInvoicesRequest.ProtoReflect.Descriptor instead.
+func (*ListInvoicesRequest) Descriptor() ([]byte, []int) {
+	return file_subscription_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *ListInvoicesRequest) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+func (x *ListInvoicesRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListInvoicesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type ListInvoicesReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*Invoice             `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"` // 不包含明细行
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,4,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListInvoicesReply) Reset() {
+	*x = ListInvoicesReply{}
+	mi := &file_subscription_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListInvoicesReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInvoicesReply) ProtoMessage() {}
+
+func (x *ListInvoicesReply) ProtoReflect() protoreflect.Message {
+	mi := &file_subscription_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInvoicesReply.ProtoReflect.Descriptor instead.
+func (*ListInvoicesReply) Descriptor() ([]byte, []int) {
+	return file_subscription_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *ListInvoicesReply) GetItems() []*Invoice {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *ListInvoicesReply) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListInvoicesReply) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListInvoicesReply) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+// 国家税务规则
+type TaxRule struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaxRuleId     uint64                 `protobuf:"varint,1,opt,name=taxRuleId,proto3" json:"taxRuleId,omitempty"`
+	CountryCode   string                 `protobuf:"bytes,2,opt,name=countryCode,proto3" json:"countryCode,omitempty"`      // ISO 3166-1 alpha-2 国家代码
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`                    // 税种名称，如 VAT、GST
+	Rate          float64                `protobuf:"fixed64,4,opt,name=rate,proto3" json:"rate,omitempty"`                  // 税率（0.2 表示 20%）
+	Inclusive     bool                   `protobuf:"varint,5,opt,name=inclusive,proto3" json:"inclusive,omitempty"`         // 定价是否含税：含税时从价格中拆出税额，不含税时在价格之上加收
+	ReverseCharge bool                   `protobuf:"varint,6,opt,name=reverseCharge,proto3" json:"reverseCharge,omitempty"` // 是否支持 B2B 反向征税（买方提供 VAT ID 时不收税）
+	Enabled       bool                   `protobuf:"varint,7,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	UpdatedAt     int64                  `protobuf:"varint,8,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TaxRule) Reset() {
+	*x = TaxRule{}
+	mi := &file_subscription_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TaxRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaxRule) ProtoMessage() {}
+
+func (x *TaxRule) ProtoReflect() protoreflect.Message {
+	mi := &file_subscription_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaxRule.ProtoReflect.Descriptor instead.
+func (*TaxRule) Descriptor() ([]byte, []int) {
+	return file_subscription_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *TaxRule) GetTaxRuleId() uint64 {
+	if x != nil {
+		return x.TaxRuleId
+	}
+	return 0
+}
+
+func (x *TaxRule) GetCountryCode() string {
+	if x != nil {
+		return x.CountryCode
+	}
+	return ""
+}
+
+func (x *TaxRule) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TaxRule) GetRate() float64 {
+	if x != nil {
+		return x.Rate
+	}
+	return 0
+}
+
+func (x *TaxRule) GetInclusive() bool {
+	if x != nil {
+		return x.Inclusive
+	}
+	return false
+}
+
+func (x *TaxRule) GetReverseCharge() bool {
+	if x != nil {
+		return x.ReverseCharge
+	}
+	return false
+}
+
+func (x *TaxRule) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *TaxRule) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+type ListTaxRulesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CountryCode   string                 `protobuf:"bytes,1,opt,name=countryCode,proto3" json:"countryCode,omitempty"` // 国家代码，可选，为空时返回所有国家
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTaxRulesRequest) Reset() {
+	*x = ListTaxRulesRequest{}
+	mi := &file_subscription_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTaxRulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTaxRulesRequest) ProtoMessage() {}
+
+func (x *ListTaxRulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_subscription_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTaxRulesRequest.ProtoReflect.Descriptor instead.
+func (*ListTaxRulesRequest) Descriptor() ([]byte, []int) {
+	return file_subscription_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *ListTaxRulesRequest) GetCountryCode() string {
+	if x != nil {
+		return x.CountryCode
+	}
+	return ""
+}
+
+type ListTaxRulesReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rules         []*TaxRule             `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTaxRulesReply) Reset() {
+	*x = ListTaxRulesReply{}
+	mi := &file_subscription_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTaxRulesReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTaxRulesReply) ProtoMessage() {}
+
+func (x *ListTaxRulesReply) ProtoReflect() protoreflect.Message {
+	mi := &file_subscription_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTaxRulesReply.ProtoReflect.Descriptor instead.
+func (*ListTaxRulesReply) Descriptor() ([]byte, []int) {
+	return file_subscription_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *ListTaxRulesReply) GetRules() []*TaxRule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+type CreateTaxRuleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CountryCode   string                 `protobuf:"bytes,1,opt,name=countryCode,proto3" json:"countryCode,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Rate          float64                `protobuf:"fixed64,3,opt,name=rate,proto3" json:"rate,omitempty"`
+	Inclusive     bool                   `protobuf:"varint,4,opt,name=inclusive,proto3" json:"inclusive,omitempty"`
+	ReverseCharge bool                   `protobuf:"varint,5,opt,name=reverseCharge,proto3" json:"reverseCharge,omitempty"`
+	Enabled       bool                   `protobuf:"varint,6,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTaxRuleRequest) Reset() {
+	*x = CreateTaxRuleRequest{}
+	mi := &file_subscription_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTaxRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTaxRuleRequest) ProtoMessage() {}
+
+func (x *CreateTaxRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_subscription_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTaxRuleRequest.ProtoReflect.Descriptor instead.
+func (*CreateTaxRuleRequest) Descriptor() ([]byte, []int) {
+	return file_subscription_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *CreateTaxRuleRequest) GetCountryCode() string {
+	if x != nil {
+		return x.CountryCode
+	}
+	return ""
+}
+
+func (x *CreateTaxRuleRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateTaxRuleRequest) GetRate() float64 {
+	if x != nil {
+		return x.Rate
+	}
+	return 0
+}
+
+func (x *CreateTaxRuleRequest) GetInclusive() bool {
+	if x != nil {
+		return x.Inclusive
+	}
+	return false
+}
+
+func (x *CreateTaxRuleRequest) GetReverseCharge() bool {
+	if x != nil {
+		return x.ReverseCharge
+	}
+	return false
+}
+
+func (x *CreateTaxRuleRequest) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+type CreateTaxRuleReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rule          *TaxRule               `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTaxRuleReply) Reset() {
+	*x = CreateTaxRuleReply{}
+	mi := &file_subscription_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTaxRuleReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTaxRuleReply) ProtoMessage() {}
+
+func (x *CreateTaxRuleReply) ProtoReflect() protoreflect.Message {
+	mi := &file_subscription_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTaxRuleReply.ProtoReflect.Descriptor instead.
+func (*CreateTaxRuleReply) Descriptor() ([]byte, []int) {
+	return file_subscription_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *CreateTaxRuleReply) GetRule() *TaxRule {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+type UpdateTaxRuleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaxRuleId     uint64                 `protobuf:"varint,1,opt,name=taxRuleId,proto3" json:"taxRuleId,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Rate          float64                `protobuf:"fixed64,3,opt,name=rate,proto3" json:"rate,omitempty"`
+	Inclusive     bool                   `protobuf:"varint,4,opt,name=inclusive,proto3" json:"inclusive,omitempty"`
+	ReverseCharge bool                   `protobuf:"varint,5,opt,name=reverseCharge,proto3" json:"reverseCharge,omitempty"`
+	Enabled       bool                   `protobuf:"varint,6,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTaxRuleRequest) Reset() {
+	*x = UpdateTaxRuleRequest{}
+	mi := &file_subscription_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTaxRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTaxRuleRequest) ProtoMessage() {}
+
+func (x *UpdateTaxRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_subscription_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTaxRuleRequest.ProtoReflect.Descriptor instead.
+func (*UpdateTaxRuleRequest) Descriptor() ([]byte, []int) {
+	return file_subscription_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *UpdateTaxRuleRequest) GetTaxRuleId() uint64 {
+	if x != nil {
+		return x.TaxRuleId
+	}
+	return 0
+}
+
+func (x *UpdateTaxRuleRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateTaxRuleRequest) GetRate() float64 {
+	if x != nil {
+		return x.Rate
+	}
+	return 0
+}
+
+func (x *UpdateTaxRuleRequest) GetInclusive() bool {
+	if x != nil {
+		return x.Inclusive
+	}
+	return false
+}
+
+func (x *UpdateTaxRuleRequest) GetReverseCharge() bool {
+	if x != nil {
+		return x.ReverseCharge
+	}
+	return false
+}
+
+func (x *UpdateTaxRuleRequest) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+type UpdateTaxRuleReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rule          *TaxRule               `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTaxRuleReply) Reset() {
+	*x = UpdateTaxRuleReply{}
+	mi := &file_subscription_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTaxRuleReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTaxRuleReply) ProtoMessage() {}
+
+func (x *UpdateTaxRuleReply) ProtoReflect() protoreflect.Message {
+	mi := &file_subscription_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTaxRuleReply.ProtoReflect.Descriptor instead.
+func (*UpdateTaxRuleReply) Descriptor() ([]byte, []int) {
+	return file_subscription_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *UpdateTaxRuleReply) GetRule() *TaxRule {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+type DeleteTaxRuleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaxRuleId     uint64                 `protobuf:"varint,1,opt,name=taxRuleId,proto3" json:"taxRuleId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTaxRuleRequest) Reset() {
+	*x = DeleteTaxRuleRequest{}
+	mi := &file_subscription_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTaxRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTaxRuleRequest) ProtoMessage() {}
+
+func (x *DeleteTaxRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_subscription_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTaxRuleRequest.ProtoReflect.Descriptor instead.
+func (*DeleteTaxRuleRequest) Descriptor() ([]byte, []int) {
+	return file_subscription_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *DeleteTaxRuleRequest) GetTaxRuleId() uint64 {
+	if x != nil {
+		return x.TaxRuleId
+	}
+	return 0
+}
+
+type DeleteTaxRuleReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaxRuleId     uint64                 `protobuf:"varint,1,opt,name=taxRuleId,proto3" json:"taxRuleId,omitempty"` // 被删除的税务规则ID
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTaxRuleReply) Reset() {
+	*x = DeleteTaxRuleReply{}
+	mi := &file_subscription_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTaxRuleReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTaxRuleReply) ProtoMessage() {}
+
+func (x *DeleteTaxRuleReply) ProtoReflect() protoreflect.Message {
+	mi := &file_subscription_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTaxRuleReply.ProtoReflect.Descriptor instead.
+func (*DeleteTaxRuleReply) Descriptor() ([]byte, []int) {
+	return file_subscription_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *DeleteTaxRuleReply) GetTaxRuleId() uint64 {
+	if x != nil {
+		return x.TaxRuleId
+	}
+	return 0
+}
+
+// 汇率（1 fromCurrency = rate toCurrency）
+type ExchangeRate struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ExchangeRateId uint64                 `protobuf:"varint,1,opt,name=exchangeRateId,proto3" json:"exchangeRateId,omitempty"`
+	FromCurrency   string                 `protobuf:"bytes,2,opt,name=fromCurrency,proto3" json:"fromCurrency,omitempty"`
+	ToCurrency     string                 `protobuf:"bytes,3,opt,name=toCurrency,proto3" json:"toCurrency,omitempty"`
+	Rate           float64                `protobuf:"fixed64,4,opt,name=rate,proto3" json:"rate,omitempty"`
+	EffectiveAt    int64                  `protobuf:"varint,5,opt,name=effectiveAt,proto3" json:"effectiveAt,omitempty"` // 生效时间（直到同一币种对的下一条汇率生效）
+	Source         string                 `protobuf:"bytes,6,opt,name=source,proto3" json:"source,omitempty"`            // 来源：admin, file
+	CreatedAt      int64                  `protobuf:"varint,7,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ExchangeRate) Reset() {
+	*x = ExchangeRate{}
+	mi := &file_subscription_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExchangeRate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExchangeRate) ProtoMessage() {}
+
+func (x *ExchangeRate) ProtoReflect() protoreflect.Message {
+	mi := &file_subscription_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExchangeRate.ProtoReflect.Descriptor instead.
+func (*ExchangeRate) Descriptor() ([]byte, []int) {
+	return file_subscription_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *ExchangeRate) GetExchangeRateId() uint64 {
+	if x != nil {
+		return x.ExchangeRateId
+	}
+	return 0
+}
+
+func (x *ExchangeRate) GetFromCurrency() string {
+	if x != nil {
+		return x.FromCurrency
+	}
+	return ""
+}
+
+func (x *ExchangeRate) GetToCurrency() string {
+	if x != nil {
+		return x.ToCurrency
+	}
+	return ""
+}
+
+func (x *ExchangeRate) GetRate() float64 {
+	if x != nil {
+		return x.Rate
+	}
+	return 0
+}
+
+func (x *ExchangeRate) GetEffectiveAt() int64 {
+	if x != nil {
+		return x.EffectiveAt
+	}
+	return 0
+}
+
+func (x *ExchangeRate) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *ExchangeRate) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+type ListExchangeRatesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FromCurrency  string                 `protobuf:"bytes,1,opt,name=fromCurrency,proto3" json:"fromCurrency,omitempty"` // 可选
+	ToCurrency    string                 `protobuf:"bytes,2,opt,name=toCurrency,proto3" json:"toCurrency,omitempty"`     // 可选
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`                // 页码，从1开始
+	PageSize      int32                  `protobuf:"varint,4,opt,name=pageSize,proto3" json:"pageSize,omitempty"`        // 每页数量，默认10
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListExchangeRatesRequest) Reset() {
+	*x = ListExchangeRatesRequest{}
+	mi := &file_subscription_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListExchangeRatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListExchangeRatesRequest) ProtoMessage() {}
+
+func (x *ListExchangeRatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_subscription_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListExchangeRatesRequest.ProtoReflect.Descriptor instead.
+func (*ListExchangeRatesRequest) Descriptor() ([]byte, []int) {
+	return file_subscription_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *ListExchangeRatesRequest) GetFromCurrency() string {
+	if x != nil {
+		return x.FromCurrency
+	}
+	return ""
+}
+
+func (x *ListExchangeRatesRequest) GetToCurrency() string {
+	if x != nil {
+		return x.ToCurrency
+	}
+	return ""
+}
+
+func (x *ListExchangeRatesRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListExchangeRatesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type ListExchangeRatesReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*ExchangeRate        `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,4,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListExchangeRatesReply) Reset() {
+	*x = ListExchangeRatesReply{}
+	mi := &file_subscription_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListExchangeRatesReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListExchangeRatesReply) ProtoMessage() {}
+
+func (x *ListExchangeRatesReply) ProtoReflect() protoreflect.Message {
+	mi := &file_subscription_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListExchangeRatesReply.ProtoReflect.Descriptor instead.
+func (*ListExchangeRatesReply) Descriptor() ([]byte, []int) {
+	return file_subscription_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *ListExchangeRatesReply) GetItems() []*ExchangeRate {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *ListExchangeRatesReply) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListExchangeRatesReply) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListExchangeRatesReply) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type SaveExchangeRatesRequest struct {
+	state         protoimpl.MessageState           `protogen:"open.v1"`
+	Rates         []*SaveExchangeRatesRequest_Item `protobuf:"bytes,1,rep,name=rates,proto3" json:"rates,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SaveExchangeRatesRequest) Reset() {
+	*x = SaveExchangeRatesRequest{}
+	mi := &file_subscription_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SaveExchangeRatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveExchangeRatesRequest) ProtoMessage() {}
+
+func (x *SaveExchangeRatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_subscription_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveExchangeRatesRequest.ProtoReflect.Descriptor instead.
+func (*SaveExchangeRatesRequest) Descriptor() ([]byte, []int) {
+	return file_subscription_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *SaveExchangeRatesRequest) GetRates() []*SaveExchangeRatesRequest_Item {
+	if x != nil {
+		return x.Rates
+	}
+	return nil
+}
+
+type SaveExchangeRatesReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Count         int32                  `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"` // 保存的汇率数量
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SaveExchangeRatesReply) Reset() {
+	*x = SaveExchangeRatesReply{}
+	mi := &file_subscription_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SaveExchangeRatesReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveExchangeRatesReply) ProtoMessage() {}
+
+func (x *SaveExchangeRatesReply) ProtoReflect() protoreflect.Message {
+	mi := &file_subscription_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveExchangeRatesReply.ProtoReflect.Descriptor instead.
+func (*SaveExchangeRatesReply) Descriptor() ([]byte, []int) {
+	return file_subscription_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *SaveExchangeRatesReply) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type ImportExchangeRatesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Content       string                 `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"` // CSV 内容（from_currency,to_currency,rate,effective_at），为空时从配置的本地汇率文件导入
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportExchangeRatesRequest) Reset() {
+	*x = ImportExchangeRatesRequest{}
+	mi := &file_subscription_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportExchangeRatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportExchangeRatesRequest) ProtoMessage() {}
+
+func (x *ImportExchangeRatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_subscription_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportExchangeRatesRequest.ProtoReflect.Descriptor instead.
+func (*ImportExchangeRatesRequest) Descriptor() ([]byte, []int) {
+	return file_subscription_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *ImportExchangeRatesRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+type ImportExchangeRatesReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Count         int32                  `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"` // 导入的汇率数量
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportExchangeRatesReply) Reset() {
+	*x = ImportExchangeRatesReply{}
+	mi := &file_subscription_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportExchangeRatesReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportExchangeRatesReply) ProtoMessage() {}
+
+func (x *ImportExchangeRatesReply) ProtoReflect() protoreflect.Message {
+	mi := &file_subscription_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportExchangeRatesReply.ProtoReflect.Descriptor instead.
+func (*ImportExchangeRatesReply) Descriptor() ([]byte, []int) {
+	return file_subscription_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *ImportExchangeRatesReply) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type GetRevenueReportRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StartTime     int64                  `protobuf:"varint,1,opt,name=startTime,proto3" json:"startTime,omitempty"` // 开始时间（包含），为 0 时为结束时间前 30 天
+	EndTime       int64                  `protobuf:"varint,2,opt,name=endTime,proto3" json:"endTime,omitempty"`     // 结束时间（不包含），为 0 时为当前时间
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRevenueReportRequest) Reset() {
+	*x = GetRevenueReportRequest{}
+	mi := &file_subscription_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRevenueReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRevenueReportRequest) ProtoMessage() {}
+
+func (x *GetRevenueReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_subscription_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRevenueReportRequest.ProtoReflect.Descriptor instead.
+func (*GetRevenueReportRequest) Descriptor() ([]byte, []int) {
+	return file_subscription_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *GetRevenueReportRequest) GetStartTime() int64 {
+	if x != nil {
+		return x.StartTime
+	}
+	return 0
+}
+
+func (x *GetRevenueReportRequest) GetEndTime() int64 {
+	if x != nil {
+		return x.EndTime
+	}
+	return 0
+}
+
+// 单个币种的收入
+type CurrencyRevenue struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Currency       string                 `protobuf:"bytes,1,opt,name=currency,proto3" json:"currency,omitempty"`
+	OrderCount     int32                  `protobuf:"varint,2,opt,name=orderCount,proto3" json:"orderCount,omitempty"`
+	GrossAmount    float64                `protobuf:"fixed64,3,opt,name=grossAmount,proto3" json:"grossAmount,omitempty"`       // 实收金额（订单币种，含税）
+	RefundedAmount float64                `protobuf:"fixed64,4,opt,name=refundedAmount,proto3" json:"refundedAmount,omitempty"` // 退款金额（订单币种）
+	TaxAmount      float64                `protobuf:"fixed64,5,opt,name=taxAmount,proto3" json:"taxAmount,omitempty"`           // 税额（订单币种，已扣除退款冲减部分）
+	NetAmount      float64                `protobuf:"fixed64,6,opt,name=netAmount,proto3" json:"netAmount,omitempty"`           // 净收入（订单币种）
+	ConvertedNet   float64                `protobuf:"fixed64,7,opt,name=convertedNet,proto3" json:"convertedNet,omitempty"`     // 折算为报表币种的净收入
+	Estimated      bool                   `protobuf:"varint,8,opt,name=estimated,proto3" json:"estimated,omitempty"`            // 是否有订单按结束时间的汇率估算（支付时没有记录汇率或报表币种已变更）
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CurrencyRevenue) Reset() {
+	*x = CurrencyRevenue{}
+	mi := &file_subscription_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CurrencyRevenue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CurrencyRevenue) ProtoMessage() {}
+
+func (x *CurrencyRevenue) ProtoReflect() protoreflect.Message {
+	mi := &file_subscription_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CurrencyRevenue.ProtoReflect.Descriptor instead.
+func (*CurrencyRevenue) Descriptor() ([]byte, []int) {
+	return file_subscription_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *CurrencyRevenue) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *CurrencyRevenue) GetOrderCount() int32 {
+	if x != nil {
+		return x.OrderCount
+	}
+	return 0
+}
+
+func (x *CurrencyRevenue) GetGrossAmount() float64 {
+	if x != nil {
+		return x.GrossAmount
+	}
+	return 0
+}
+
+func (x *CurrencyRevenue) GetRefundedAmount() float64 {
+	if x != nil {
+		return x.RefundedAmount
+	}
+	return 0
+}
+
+func (x *CurrencyRevenue) GetTaxAmount() float64 {
+	if x != nil {
+		return x.TaxAmount
+	}
+	return 0
+}
+
+func (x *CurrencyRevenue) GetNetAmount() float64 {
+	if x != nil {
+		return x.NetAmount
+	}
+	return 0
+}
+
+func (x *CurrencyRevenue) GetConvertedNet() float64 {
+	if x != nil {
+		return x.ConvertedNet
+	}
+	return 0
+}
+
+func (x *CurrencyRevenue) GetEstimated() bool {
+	if x != nil {
+		return x.Estimated
+	}
+	return false
+}
+
+type GetRevenueReportReply struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	AppId             string                 `protobuf:"bytes,1,opt,name=appId,proto3" json:"appId,omitempty"`
+	ReportingCurrency string                 `protobuf:"bytes,2,opt,name=reportingCurrency,proto3" json:"reportingCurrency,omitempty"`
+	StartTime         int64                  `protobuf:"varint,3,opt,name=startTime,proto3" json:"startTime,omitempty"`
+	EndTime           int64                  `protobuf:"varint,4,opt,name=endTime,proto3" json:"endTime,omitempty"`
+	OrderCount        int32                  `protobuf:"varint,5,opt,name=orderCount,proto3" json:"orderCount,omitempty"`    // 计入合计的订单数量
+	GrossAmount       float64                `protobuf:"fixed64,6,opt,name=grossAmount,proto3" json:"grossAmount,omitempty"` // 以下金额均为报表币种
+	RefundedAmount    float64                `protobuf:"fixed64,7,opt,name=refundedAmount,proto3" json:"refundedAmount,omitempty"`
+	TaxAmount         float64                `protobuf:"fixed64,8,opt,name=taxAmount,proto3" json:"taxAmount,omitempty"`
+	NetAmount         float64                `protobuf:"fixed64,9,opt,name=netAmount,proto3" json:"netAmount,omitempty"` // 净收入 = 实收 - 退款 - 税额
+	ByCurrency        []*CurrencyRevenue     `protobuf:"bytes,10,rep,name=byCurrency,proto3" json:"byCurrency,omitempty"`
+	Unconverted       []string               `protobuf:"bytes,11,rep,name=unconverted,proto3" json:"unconverted,omitempty"` // 没有可用汇率、未计入合计的币种
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *GetRevenueReportReply) Reset() {
+	*x = GetRevenueReportReply{}
+	mi := &file_subscription_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRevenueReportReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRevenueReportReply) ProtoMessage() {}
+
+func (x *GetRevenueReportReply) ProtoReflect() protoreflect.Message {
+	mi := &file_subscription_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRevenueReportReply.ProtoReflect.Descriptor instead.
+func (*GetRevenueReportReply) Descriptor() ([]byte, []int) {
+	return file_subscription_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *GetRevenueReportReply) GetAppId() string {
+	if x != nil {
+		return x.AppId
+	}
+	return ""
+}
+
+func (x *GetRevenueReportReply) GetReportingCurrency() string {
+	if x != nil {
+		return x.ReportingCurrency
+	}
+	return ""
+}
+
+func (x *GetRevenueReportReply) GetStartTime() int64 {
+	if x != nil {
+		return x.StartTime
+	}
+	return 0
+}
+
+func (x *GetRevenueReportReply) GetEndTime() int64 {
+	if x != nil {
+		return x.EndTime
+	}
+	return 0
+}
+
+func (x *GetRevenueReportReply) GetOrderCount() int32 {
+	if x != nil {
+		return x.OrderCount
+	}
+	return 0
+}
+
+func (x *GetRevenueReportReply) GetGrossAmount() float64 {
+	if x != nil {
+		return x.GrossAmount
+	}
+	return 0
+}
+
+func (x *GetRevenueReportReply) GetRefundedAmount() float64 {
+	if x != nil {
+		return x.RefundedAmount
+	}
+	return 0
+}
+
+func (x *GetRevenueReportReply) GetTaxAmount() float64 {
+	if x != nil {
+		return x.TaxAmount
+	}
+	return 0
+}
+
+func (x *GetRevenueReportReply) GetNetAmount() float64 {
+	if x != nil {
+		return x.NetAmount
+	}
+	return 0
+}
+
+func (x *GetRevenueReportReply) GetByCurrency() []*CurrencyRevenue {
+	if x != nil {
+		return x.ByCurrency
+	}
+	return nil
+}
+
+func (x *GetRevenueReportReply) GetUnconverted() []string {
+	if x != nil {
+		return x.Unconverted
+	}
+	return nil
+}
+
+// 订阅指标日快照
+type MetricSnapshot struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	SnapshotDate           string                 `protobuf:"bytes,1,opt,name=snapshotDate,proto3" json:"snapshotDate,omitempty"`                      // 快照日期（UTC），如 2026-01-31
+	ReportingCurrency      string                 `protobuf:"bytes,2,opt,name=reportingCurrency,proto3" json:"reportingCurrency,omitempty"`            // MRR/ARR 的币种
+	ActiveSubscribers      int32                  `protobuf:"varint,3,opt,name=activeSubscribers,proto3" json:"activeSubscribers,omitempty"`           // 活跃订阅数（包含免费套餐和终身套餐，不包含暂停中的订阅）
+	PayingSubscribers      int32                  `protobuf:"varint,4,opt,name=payingSubscribers,proto3" json:"payingSubscribers,omitempty"`           // 付费周期订阅数（计入 MRR）
+	Mrr                    float64                `protobuf:"fixed64,5,opt,name=mrr,proto3" json:"mrr,omitempty"`                                      // 月度经常性收入（不含税，按计费周期折算为月）
+	Arr                    float64                `protobuf:"fixed64,6,opt,name=arr,proto3" json:"arr,omitempty"`                                      // 年度经常性收入 = MRR * 12
+	NewSubscribers         int32                  `protobuf:"varint,7,opt,name=newSubscribers,proto3" json:"newSubscribers,omitempty"`                 // 当天首次订阅的用户数
+	ChurnedSubscribers     int32                  `protobuf:"varint,8,opt,name=churnedSubscribers,proto3" json:"churnedSubscribers,omitempty"`         // 当天过期或取消的用户数
+	ReactivatedSubscribers int32                  `protobuf:"varint,9,opt,name=reactivatedSubscribers,proto3" json:"reactivatedSubscribers,omitempty"` // 当天过期或取消后重新付费的用户数
+	StateUnavailable       bool                   `protobuf:"varint,10,opt,name=stateUnavailable,proto3" json:"stateUnavailable,omitempty"`            // 活跃订阅数、付费订阅数和 MRR/ARR 不可用（补生成的更早日期无法还原当天的订阅状态，值为 0）
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *MetricSnapshot) Reset() {
+	*x = MetricSnapshot{}
+	mi := &file_subscription_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MetricSnapshot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MetricSnapshot) ProtoMessage() {}
+
+func (x *MetricSnapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_subscription_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MetricSnapshot.ProtoReflect.Descriptor instead.
+func (*MetricSnapshot) Descriptor() ([]byte, []int) {
+	return file_subscription_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *MetricSnapshot) GetSnapshotDate() string {
+	if x != nil {
+		return x.SnapshotDate
+	}
+	return ""
+}
+
+func (x *MetricSnapshot) GetReportingCurrency() string {
+	if x != nil {
+		return x.ReportingCurrency
+	}
+	return ""
+}
+
+func (x *MetricSnapshot) GetActiveSubscribers() int32 {
+	if x != nil {
+		return x.ActiveSubscribers
+	}
+	return 0
+}
+
+func (x *MetricSnapshot) GetPayingSubscribers() int32 {
+	if x != nil {
+		return x.PayingSubscribers
+	}
+	return 0
+}
+
+func (x *MetricSnapshot) GetMrr() float64 {
+	if x != nil {
+		return x.Mrr
+	}
+	return 0
+}
+
+func (x *MetricSnapshot) GetArr() float64 {
+	if x != nil {
+		return x.Arr
+	}
+	return 0
+}
+
+func (x *MetricSnapshot) GetNewSubscribers() int32 {
+	if x != nil {
+		return x.NewSubscribers
+	}
+	return 0
+}
+
+func (x *MetricSnapshot) GetChurnedSubscribers() int32 {
+	if x != nil {
+		return x.ChurnedSubscribers
+	}
+	return 0
+}
+
+func (x *MetricSnapshot) GetReactivatedSubscribers() int32 {
+	if x != nil {
+		return x.ReactivatedSubscribers
+	}
+	return 0
+}
+
+func (x *MetricSnapshot) GetStateUnavailable() bool {
+	if x != nil {
+		return x.StateUnavailable
+	}
+	return false
+}
+
+type GetSubscriptionMetricsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StartDate     string                 `protobuf:"bytes,1,opt,name=startDate,proto3" json:"startDate,omitempty"` // 开始日期（包含），为空时为结束日期前 29 天
+	EndDate       string                 `protobuf:"bytes,2,opt,name=endDate,proto3" json:"endDate,omitempty"`     // 结束日期（包含），为空时为昨天
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSubscriptionMetricsRequest) Reset() {
+	*x = GetSubscriptionMetricsRequest{}
+	mi := &file_subscription_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSubscriptionMetricsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSubscriptionMetricsRequest) ProtoMessage() {}
+
+func (x *GetSubscriptionMetricsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_subscription_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSubscriptionMetricsRequest.ProtoReflect.Descriptor instead.
+func (*GetSubscriptionMetricsRequest) Descriptor() ([]byte, []int) {
+	return file_subscription_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *GetSubscriptionMetricsRequest) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *GetSubscriptionMetricsRequest) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+type GetSubscriptionMetricsReply struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	AppId                  string                 `protobuf:"bytes,1,opt,name=appId,proto3" json:"appId,omitempty"`
+	StartDate              string                 `protobuf:"bytes,2,opt,name=startDate,proto3" json:"startDate,omitempty"`
+	EndDate                string                 `protobuf:"bytes,3,opt,name=endDate,proto3" json:"endDate,omitempty"`
+	ReportingCurrency      string                 `protobuf:"bytes,4,opt,name=reportingCurrency,proto3" json:"reportingCurrency,omitempty"`
+	ActiveSubscribers      int32                  `protobuf:"varint,5,opt,name=activeSubscribers,proto3" json:"activeSubscribers,omitempty"` // 结束日期的活跃订阅数
+	PayingSubscribers      int32                  `protobuf:"varint,6,opt,name=payingSubscribers,proto3" json:"payingSubscribers,omitempty"`
+	Mrr                    float64                `protobuf:"fixed64,7,opt,name=mrr,proto3" json:"mrr,omitempty"` // 结束日期的 MRR
+	Arr                    float64                `protobuf:"fixed64,8,opt,name=arr,proto3" json:"arr,omitempty"`
+	StartActiveSubscribers int32                  `protobuf:"varint,9,opt,name=startActiveSubscribers,proto3" json:"startActiveSubscribers,omitempty"` // 期初（开始日期前一天）活跃订阅数
+	NewSubscribers         int32                  `protobuf:"varint,10,opt,name=newSubscribers,proto3" json:"newSubscribers,omitempty"`
+	ChurnedSubscribers     int32                  `protobuf:"varint,11,opt,name=churnedSubscribers,proto3" json:"churnedSubscribers,omitempty"`
+	ReactivatedSubscribers int32                  `protobuf:"varint,12,opt,name=reactivatedSubscribers,proto3" json:"reactivatedSubscribers,omitempty"`
+	ChurnRate              float64                `protobuf:"fixed64,13,opt,name=churnRate,proto3" json:"churnRate,omitempty"`         // 流失率 = 流失用户数 / 期初付费订阅数
+	RetentionRate          float64                `protobuf:"fixed64,14,opt,name=retentionRate,proto3" json:"retentionRate,omitempty"` // 留存率 = (期末付费订阅数 - 新增 - 回流) / 期初付费订阅数
+	Snapshots              []*MetricSnapshot      `protobuf:"bytes,15,rep,name=snapshots,proto3" json:"snapshots,omitempty"`
+	StartPayingSubscribers int32                  `protobuf:"varint,16,opt,name=startPayingSubscribers,proto3" json:"startPayingSubscribers,omitempty"` // 期初（开始日期前一天）付费订阅数（流失率和留存率的分母）
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *GetSubscriptionMetricsReply) Reset() {
+	*x = GetSubscriptionMetricsReply{}
+	mi := &file_subscription_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSubscriptionMetricsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSubscriptionMetricsReply) ProtoMessage() {}
+
+func (x *GetSubscriptionMetricsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_subscription_proto_msgTypes[91]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSubscriptionMetricsReply.ProtoReflect.Descriptor instead.
+func (*GetSubscriptionMetricsReply) Descriptor() ([]byte, []int) {
+	return file_subscription_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *GetSubscriptionMetricsReply) GetAppId() string {
+	if x != nil {
+		return x.AppId
+	}
+	return ""
+}
+
+func (x *GetSubscriptionMetricsReply) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *GetSubscriptionMetricsReply) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+func (x *GetSubscriptionMetricsReply) GetReportingCurrency() string {
+	if x != nil {
+		return x.ReportingCurrency
+	}
+	return ""
+}
+
+func (x *GetSubscriptionMetricsReply) GetActiveSubscribers() int32 {
+	if x != nil {
+		return x.ActiveSubscribers
+	}
+	return 0
+}
+
+func (x *GetSubscriptionMetricsReply) GetPayingSubscribers() int32 {
+	if x != nil {
+		return x.PayingSubscribers
+	}
+	return 0
+}
+
+func (x *GetSubscriptionMetricsReply) GetMrr() float64 {
+	if x != nil {
+		return x.Mrr
+	}
+	return 0
+}
+
+func (x *GetSubscriptionMetricsReply) GetArr() float64 {
+	if x != nil {
+		return x.Arr
+	}
+	return 0
+}
+
+func (x *GetSubscriptionMetricsReply) GetStartActiveSubscribers() int32 {
+	if x != nil {
+		return x.StartActiveSubscribers
+	}
+	return 0
+}
+
+func (x *GetSubscriptionMetricsReply) GetNewSubscribers() int32 {
+	if x != nil {
+		return x.NewSubscribers
+	}
+	return 0
+}
+
+func (x *GetSubscriptionMetricsReply) GetChurnedSubscribers() int32 {
+	if x != nil {
+		return x.ChurnedSubscribers
+	}
+	return 0
+}
+
+func (x *GetSubscriptionMetricsReply) GetReactivatedSubscribers() int32 {
+	if x != nil {
+		return x.ReactivatedSubscribers
+	}
+	return 0
+}
+
+func (x *GetSubscriptionMetricsReply) GetChurnRate() float64 {
+	if x != nil {
+		return x.ChurnRate
+	}
+	return 0
+}
+
+func (x *GetSubscriptionMetricsReply) GetRetentionRate() float64 {
+	if x != nil {
+		return x.RetentionRate
+	}
+	return 0
+}
+
+func (x *GetSubscriptionMetricsReply) GetSnapshots() []*MetricSnapshot {
+	if x != nil {
+		return x.Snapshots
+	}
+	return nil
+}
+
+func (x *GetSubscriptionMetricsReply) GetStartPayingSubscribers() int32 {
+	if x != nil {
+		return x.StartPayingSubscribers
+	}
+	return 0
+}
+
+type ListMetricSnapshotsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StartDate     string                 `protobuf:"bytes,1,opt,name=startDate,proto3" json:"startDate,omitempty"` // 开始日期（包含），为空时为结束日期前 29 天
+	EndDate       string                 `protobuf:"bytes,2,opt,name=endDate,proto3" json:"endDate,omitempty"`     // 结束日期（包含），为空时为昨天
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMetricSnapshotsRequest) Reset() {
+	*x = ListMetricSnapshotsRequest{}
+	mi := &file_subscription_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMetricSnapshotsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMetricSnapshotsRequest) ProtoMessage() {}
+
+func (x *ListMetricSnapshotsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_subscription_proto_msgTypes[92]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMetricSnapshotsRequest.ProtoReflect.Descriptor instead.
+func (*ListMetricSnapshotsRequest) Descriptor() ([]byte, []int) {
+	return file_subscription_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *ListMetricSnapshotsRequest) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *ListMetricSnapshotsRequest) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+type ListMetricSnapshotsReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Snapshots     []*MetricSnapshot      `protobuf:"bytes,1,rep,name=snapshots,proto3" json:"snapshots,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMetricSnapshotsReply) Reset() {
+	*x = ListMetricSnapshotsReply{}
+	mi := &file_subscription_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMetricSnapshotsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMetricSnapshotsReply) ProtoMessage() {}
+
+func (x *ListMetricSnapshotsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_subscription_proto_msgTypes[93]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMetricSnapshotsReply.ProtoReflect.Descriptor instead.
+func (*ListMetricSnapshotsReply) Descriptor() ([]byte, []int) {
+	return file_subscription_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *ListMetricSnapshotsReply) GetSnapshots() []*MetricSnapshot {
+	if x != nil {
+		return x.Snapshots
+	}
+	return nil
+}
+
+type GenerateMetricSnapshotsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Date          string                 `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`   // 快照日期（UTC），为空时为昨天
+	AppId         string                 `protobuf:"bytes,2,opt,name=appId,proto3" json:"appId,omitempty"` // 应用ID，为空时生成所有应用的快照
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GenerateMetricSnapshotsRequest) Reset() {
+	*x = GenerateMetricSnapshotsRequest{}
+	mi := &file_subscription_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenerateMetricSnapshotsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateMetricSnapshotsRequest) ProtoMessage() {}
+
+func (x *GenerateMetricSnapshotsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_subscription_proto_msgTypes[94]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateMetricSnapshotsRequest.ProtoReflect.Descriptor instead.
+func (*GenerateMetricSnapshotsRequest) Descriptor() ([]byte, []int) {
+	return file_subscription_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *GenerateMetricSnapshotsRequest) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *GenerateMetricSnapshotsRequest) GetAppId() string {
+	if x != nil {
+		return x.AppId
+	}
+	return ""
+}
+
+type GenerateMetricSnapshotsReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Count         int32                  `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"` // 生成的快照数量
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GenerateMetricSnapshotsReply) Reset() {
+	*x = GenerateMetricSnapshotsReply{}
+	mi := &file_subscription_proto_msgTypes[95]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenerateMetricSnapshotsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateMetricSnapshotsReply) ProtoMessage() {}
+
+func (x *GenerateMetricSnapshotsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_subscription_proto_msgTypes[95]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateMetricSnapshotsReply.ProtoReflect.Descriptor instead.
+func (*GenerateMetricSnapshotsReply) Descriptor() ([]byte, []int) {
+	return file_subscription_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *GenerateMetricSnapshotsReply) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type GetCohortReportRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Months        int32                  `protobuf:"varint,1,opt,name=months,proto3" json:"months,omitempty"` // 统计最近几个月的同期群（包含当月），默认 12
+	Format        string                 `protobuf:"bytes,2,opt,name=format,proto3" json:"format,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCohortReportRequest) Reset() {
+	*x = GetCohortReportRequest{}
+	mi := &file_subscription_proto_msgTypes[96]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCohortReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCohortReportRequest) ProtoMessage() {}
+
+func (x *GetCohortReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_subscription_proto_msgTypes[96]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCohortReportRequest.ProtoReflect.Descriptor instead.
+func (*GetCohortReportRequest) Descriptor() ([]byte, []int) {
+	return file_subscription_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *GetCohortReportRequest) GetMonths() int32 {
+	if x != nil {
+		return x.Months
+	}
+	return 0
+}
+
+func (x *GetCohortReportRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+// 月度同期群
+type CohortRow struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	CohortMonth    string                 `protobuf:"bytes,1,opt,name=cohortMonth,proto3" json:"cohortMonth,omitempty"`           // 首次订阅月份，如 2026-01
+	Size           int32                  `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`                        // 同期群用户数
+	ActiveCounts   []int32                `protobuf:"varint,3,rep,packed,name=activeCounts,proto3" json:"activeCounts,omitempty"` // 第 N 个月仍有付费订阅周期的用户数（下标为月份偏移，0 表示首次订阅当月）
+	Retention      []float64              `protobuf:"fixed64,4,rep,packed,name=retention,proto3" json:"retention,omitempty"`      // 第 N 个月的留存率（百分比）
+	Revenue        float64                `protobuf:"fixed64,5,opt,name=revenue,proto3" json:"revenue,omitempty"`                 // 累计净收入（报表币种）
+	AverageRevenue float64                `protobuf:"fixed64,6,opt,name=averageRevenue,proto3" json:"averageRevenue,omitempty"`   // 人均累计净收入
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CohortRow) Reset() {
+	*x = CohortRow{}
+	mi := &file_subscription_proto_msgTypes[97]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CohortRow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CohortRow) ProtoMessage() {}
+
+func (x *CohortRow) ProtoReflect() protoreflect.Message {
+	mi := &file_subscription_proto_msgTypes[97]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CohortRow.ProtoReflect.Descriptor instead.
+func (*CohortRow) Descriptor() ([]byte, []int) {
+	return file_subscription_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *CohortRow) GetCohortMonth() string {
+	if x != nil {
+		return x.CohortMonth
+	}
+	return ""
+}
+
+func (x *CohortRow) GetSize() int32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *CohortRow) GetActiveCounts() []int32 {
+	if x != nil {
+		return x.ActiveCounts
+	}
+	return nil
+}
+
+func (x *CohortRow) GetRetention() []float64 {
+	if x != nil {
+		return x.Retention
+	}
+	return nil
+}
+
+func (x *CohortRow) GetRevenue() float64 {
+	if x != nil {
+		return x.Revenue
+	}
+	return 0
+}
+
+func (x *CohortRow) GetAverageRevenue() float64 {
+	if x != nil {
+		return x.AverageRevenue
+	}
+	return 0
+}
+
+type GetCohortReportReply struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	AppId             string                 `protobuf:"bytes,1,opt,name=appId,proto3" json:"appId,omitempty"`
+	ReportingCurrency string                 `protobuf:"bytes,2,opt,name=reportingCurrency,proto3" json:"reportingCurrency,omitempty"`
+	StartMonth        string                 `protobuf:"bytes,3,opt,name=startMonth,proto3" json:"startMonth,omitempty"`
+	EndMonth          string                 `protobuf:"bytes,4,opt,name=endMonth,proto3" json:"endMonth,omitempty"`
+	Cohorts           []*CohortRow           `protobuf:"bytes,5,rep,name=cohorts,proto3" json:"cohorts,omitempty"`
+	Unconverted       []string               `protobuf:"bytes,6,rep,name=unconverted,proto3" json:"unconverted,omitempty"` // 没有可用汇率、未计入收入的币种
+	Content           []byte                 `protobuf:"bytes,7,opt,name=content,proto3" json:"content,omitempty"`         // format 为 csv 时的 CSV 内容
+	ContentType       string                 `protobuf:"bytes,8,opt,name=contentType,proto3" json:"contentType,omitempty"`
+	FileName          string                 `protobuf:"bytes,9,opt,name=fileName,proto3" json:"fileName,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *GetCohortReportReply) Reset() {
+	*x = GetCohortReportReply{}
+	mi := &file_subscription_proto_msgTypes[98]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCohortReportReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCohortReportReply) ProtoMessage() {}
+
+func (x *GetCohortReportReply) ProtoReflect() protoreflect.Message {
+	mi := &file_subscription_proto_msgTypes[98]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCohortReportReply.ProtoReflect.Descriptor instead.
+func (*GetCohortReportReply) Descriptor() ([]byte, []int) {
+	return file_subscription_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *GetCohortReportReply) GetAppId() string {
+	if x != nil {
+		return x.AppId
+	}
+	return ""
+}
+
+func (x *GetCohortReportReply) GetReportingCurrency() string {
+	if x != nil {
+		return x.ReportingCurrency
+	}
+	return ""
+}
+
+func (x *GetCohortReportReply) GetStartMonth() string {
+	if x != nil {
+		return x.StartMonth
+	}
+	return ""
+}
+
+func (x *GetCohortReportReply) GetEndMonth() string {
+	if x != nil {
+		return x.EndMonth
+	}
+	return ""
+}
+
+func (x *GetCohortReportReply) GetCohorts() []*CohortRow {
+	if x != nil {
+		return x.Cohorts
+	}
+	return nil
+}
+
+func (x *GetCohortReportReply) GetUnconverted() []string {
+	if x != nil {
+		return x.Unconverted
+	}
+	return nil
+}
+
+func (x *GetCohortReportReply) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+func (x *GetCohortReportReply) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *GetCohortReportReply) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+type GetPlanLTVReportRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Months        int32                  `protobuf:"varint,1,opt,name=months,proto3" json:"months,omitempty"` // 按最近几个月支付的订单估算（包含当月），默认 12
+	Format        string                 `protobuf:"bytes,2,opt,name=format,proto3" json:"format,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPlanLTVReportRequest) Reset() {
+	*x = GetPlanLTVReportRequest{}
+	mi := &file_subscription_proto_msgTypes[99]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPlanLTVReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPlanLTVReportRequest) ProtoMessage() {}
+
+func (x *GetPlanLTVReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_subscription_proto_msgTypes[99]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPlanLTVReportRequest.ProtoReflect.Descriptor instead.
+func (*GetPlanLTVReportRequest) Descriptor() ([]byte, []int) {
+	return file_subscription_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *GetPlanLTVReportRequest) GetMonths() int32 {
+	if x != nil {
+		return x.Months
+	}
+	return 0
+}
+
+func (x *GetPlanLTVReportRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+// 套餐 LTV 估算
+type PlanLTV struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	PlanId                 string                 `protobuf:"bytes,1,opt,name=planId,proto3" json:"planId,omitempty"`
+	PlanName               string                 `protobuf:"bytes,2,opt,name=planName,proto3" json:"planName,omitempty"`
+	BillingType            string                 `protobuf:"bytes,3,opt,name=billingType,proto3" json:"billingType,omitempty"`
+	Subscribers            int32                  `protobuf:"varint,4,opt,name=subscribers,proto3" json:"subscribers,omitempty"`                         // 购买过该套餐的用户数
+	Orders                 int32                  `protobuf:"varint,5,opt,name=orders,proto3" json:"orders,omitempty"`                                   // 已支付订单数
+	AverageOrderRevenue    float64                `protobuf:"fixed64,6,opt,name=averageOrderRevenue,proto3" json:"averageOrderRevenue,omitempty"`        // 平均每单净收入（报表币种）
+	RenewalRate            float64                `protobuf:"fixed64,7,opt,name=renewalRate,proto3" json:"renewalRate,omitempty"`                        // 已结束周期中续费的比例
+	ChurnRate              float64                `protobuf:"fixed64,8,opt,name=churnRate,proto3" json:"churnRate,omitempty"`                            // 每个计费周期的流失率 = 1 - 续费率
+	ExpectedPeriods        float64                `protobuf:"fixed64,9,opt,name=expectedPeriods,proto3" json:"expectedPeriods,omitempty"`                // 预期订阅周期数 = 1 / 流失率（最长 60 个月）
+	EstimatedLtv           float64                `protobuf:"fixed64,10,opt,name=estimatedLtv,proto3" json:"estimatedLtv,omitempty"`                     // 估算 LTV = 平均每单净收入 * 预期订阅周期数（终身套餐为平均每单净收入）
+	ObservedRevenuePerUser float64                `protobuf:"fixed64,11,opt,name=observedRevenuePerUser,proto3" json:"observedRevenuePerUser,omitempty"` // 统计期内人均净收入
+	EndedPeriods           int32                  `protobuf:"varint,12,opt,name=endedPeriods,proto3" json:"endedPeriods,omitempty"`                      // 已结束的订阅周期数（续费率的样本数）
+	LtvUnknown             bool                   `protobuf:"varint,13,opt,name=ltvUnknown,proto3" json:"ltvUnknown,omitempty"`                          // 已结束周期不足 10 个或没有观察到流失，无法估算 LTV（expectedPeriods 和 estimatedLtv 为 0）
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *PlanLTV) Reset() {
+	*x = PlanLTV{}
+	mi := &file_subscription_proto_msgTypes[100]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlanLTV) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlanLTV) ProtoMessage() {}
+
+func (x *PlanLTV) ProtoReflect() protoreflect.Message {
+	mi := &file_subscription_proto_msgTypes[100]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlanLTV.ProtoReflect.Descriptor instead.
+func (*PlanLTV) Descriptor() ([]byte, []int) {
+	return file_subscription_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *PlanLTV) GetPlanId() string {
+	if x != nil {
+		return x.PlanId
+	}
+	return ""
+}
+
+func (x *PlanLTV) GetPlanName() string {
+	if x != nil {
+		return x.PlanName
+	}
+	return ""
+}
+
+func (x *PlanLTV) GetBillingType() string {
+	if x != nil {
+		return x.BillingType
+	}
+	return ""
+}
+
+func (x *PlanLTV) GetSubscribers() int32 {
+	if x != nil {
+		return x.Subscribers
+	}
+	return 0
+}
+
+func (x *PlanLTV) GetOrders() int32 {
+	if x != nil {
+		return x.Orders
+	}
+	return 0
+}
+
+func (x *PlanLTV) GetAverageOrderRevenue() float64 {
+	if x != nil {
+		return x.AverageOrderRevenue
+	}
+	return 0
+}
+
+func (x *PlanLTV) GetRenewalRate() float64 {
+	if x != nil {
+		return x.RenewalRate
+	}
+	return 0
+}
+
+func (x *PlanLTV) GetChurnRate() float64 {
+	if x != nil {
+		return x.ChurnRate
+	}
+	return 0
+}
+
+func (x *PlanLTV) GetExpectedPeriods() float64 {
+	if x != nil {
+		return x.ExpectedPeriods
+	}
+	return 0
+}
+
+func (x *PlanLTV) GetEstimatedLtv() float64 {
+	if x != nil {
+		return x.EstimatedLtv
+	}
+	return 0
+}
+
+func (x *PlanLTV) GetObservedRevenuePerUser() float64 {
+	if x != nil {
+		return x.ObservedRevenuePerUser
+	}
+	return 0
+}
+
+func (x *PlanLTV) GetEndedPeriods() int32 {
+	if x != nil {
+		return x.EndedPeriods
+	}
+	return 0
+}
+
+func (x *PlanLTV) GetLtvUnknown() bool {
+	if x != nil {
+		return x.LtvUnknown
+	}
+	return false
+}
+
+type GetPlanLTVReportReply struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	AppId             string                 `protobuf:"bytes,1,opt,name=appId,proto3" json:"appId,omitempty"`
+	ReportingCurrency string                 `protobuf:"bytes,2,opt,name=reportingCurrency,proto3" json:"reportingCurrency,omitempty"`
+	StartTime         int64                  `protobuf:"varint,3,opt,name=startTime,proto3" json:"startTime,omitempty"` // 统计的订单支付起始时间
+	Plans             []*PlanLTV             `protobuf:"bytes,4,rep,name=plans,proto3" json:"plans,omitempty"`
+	Unconverted       []string               `protobuf:"bytes,5,rep,name=unconverted,proto3" json:"unconverted,omitempty"` // 没有可用汇率、未计入收入的币种
+	Content           []byte                 `protobuf:"bytes,6,opt,name=content,proto3" json:"content,omitempty"`         // format 为 csv 时的 CSV 内容
+	ContentType       string                 `protobuf:"bytes,7,opt,name=contentType,proto3" json:"contentType,omitempty"`
+	FileName          string                 `protobuf:"bytes,8,opt,name=fileName,proto3" json:"fileName,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *GetPlanLTVReportReply) Reset() {
+	*x = GetPlanLTVReportReply{}
+	mi := &file_subscription_proto_msgTypes[101]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPlanLTVReportReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPlanLTVReportReply) ProtoMessage() {}
+
+func (x *GetPlanLTVReportReply) ProtoReflect() protoreflect.Message {
+	mi := &file_subscription_proto_msgTypes[101]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPlanLTVReportReply.ProtoReflect.Descriptor instead.
+func (*GetPlanLTVReportReply) Descriptor() ([]byte, []int) {
+	return file_subscription_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *GetPlanLTVReportReply) GetAppId() string {
+	if x != nil {
+		return x.AppId
+	}
+	return ""
+}
+
+func (x *GetPlanLTVReportReply) GetReportingCurrency() string {
+	if x != nil {
+		return x.ReportingCurrency
+	}
+	return ""
+}
+
+func (x *GetPlanLTVReportReply) GetStartTime() int64 {
+	if x != nil {
+		return x.StartTime
+	}
+	return 0
+}
+
+func (x *GetPlanLTVReportReply) GetPlans() []*PlanLTV {
+	if x != nil {
+		return x.Plans
+	}
+	return nil
+}
+
+func (x *GetPlanLTVReportReply) GetUnconverted() []string {
+	if x != nil {
+		return x.Unconverted
+	}
+	return nil
+}
+
+func (x *GetPlanLTVReportReply) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+func (x *GetPlanLTVReportReply) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *GetPlanLTVReportReply) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+// 定时任务执行记录
+type JobRun struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	JobRunId      uint64                 `protobuf:"varint,1,opt,name=jobRunId,proto3" json:"jobRunId,omitempty"`
+	JobName       string                 `protobuf:"bytes,2,opt,name=jobName,proto3" json:"jobName,omitempty"`
+	ScheduledAt   int64                  `protobuf:"varint,3,opt,name=scheduledAt,proto3" json:"scheduledAt,omitempty"` // 计划执行时间（补跑时为错过的计划时间，手动触发时为触发时间）
+	Trigger       string                 `protobuf:"bytes,4,opt,name=trigger,proto3" json:"trigger,omitempty"`          // schedule, catch_up, retry, manual
+	Status        string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`            // running, success, failed, skipped
+	Host          string                 `protobuf:"bytes,6,opt,name=host,proto3" json:"host,omitempty"`                // 执行实例
+	Attempts      int32                  `protobuf:"varint,7,opt,name=attempts,proto3" json:"attempts,omitempty"`       // 执行次数
+	DryRun        bool                   `protobuf:"varint,8,opt,name=dryRun,proto3" json:"dryRun,omitempty"`           // 测试运行（只统计不修改数据）
+	StartedAt     int64                  `protobuf:"varint,9,opt,name=startedAt,proto3" json:"startedAt,omitempty"`
+	FinishedAt    int64                  `protobuf:"varint,10,opt,name=finishedAt,proto3" json:"finishedAt,omitempty"` // 未结束时为 0
+	TotalCount    int32                  `protobuf:"varint,11,opt,name=totalCount,proto3" json:"totalCount,omitempty"`
+	SuccessCount  int32                  `protobuf:"varint,12,opt,name=successCount,proto3" json:"successCount,omitempty"`
+	FailedCount   int32                  `protobuf:"varint,13,opt,name=failedCount,proto3" json:"failedCount,omitempty"`
+	Error         string                 `protobuf:"bytes,14,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JobRun) Reset() {
+	*x = JobRun{}
+	mi := &file_subscription_proto_msgTypes[102]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JobRun) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JobRun) ProtoMessage() {}
+
+func (x *JobRun) ProtoReflect() protoreflect.Message {
+	mi := &file_subscription_proto_msgTypes[102]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JobRun.ProtoReflect.Descriptor instead.
+func (*JobRun) Descriptor() ([]byte, []int) {
+	return file_subscription_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *JobRun) GetJobRunId() uint64 {
+	if x != nil {
+		return x.JobRunId
+	}
+	return 0
+}
+
+func (x *JobRun) GetJobName() string {
+	if x != nil {
+		return x.JobName
+	}
+	return ""
+}
+
+func (x *JobRun) GetScheduledAt() int64 {
+	if x != nil {
+		return x.ScheduledAt
+	}
+	return 0
+}
+
+func (x *JobRun) GetTrigger() string {
+	if x != nil {
+		return x.Trigger
+	}
+	return ""
+}
+
+func (x *JobRun) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *JobRun) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+func (x *JobRun) GetAttempts() int32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *JobRun) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *JobRun) GetStartedAt() int64 {
+	if x != nil {
+		return x.StartedAt
+	}
+	return 0
+}
+
+func (x *JobRun) GetFinishedAt() int64 {
+	if x != nil {
+		return x.FinishedAt
+	}
+	return 0
+}
+
+func (x *JobRun) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *JobRun) GetSuccessCount() int32 {
+	if x != nil {
+		return x.SuccessCount
+	}
+	return 0
+}
+
+func (x *JobRun) GetFailedCount() int32 {
+	if x != nil {
+		return x.FailedCount
+	}
+	return 0
+}
+
+func (x *JobRun) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// 定时任务
+type CronJob struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Name           string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Spec           string                 `protobuf:"bytes,2,opt,name=spec,proto3" json:"spec,omitempty"`                      // Cron 表达式（包含秒）
+	TimeoutSeconds int64                  `protobuf:"varint,3,opt,name=timeoutSeconds,proto3" json:"timeoutSeconds,omitempty"` // 单次执行超时时间
+	CatchUpAll     bool                   `protobuf:"varint,4,opt,name=catchUpAll,proto3" json:"catchUpAll,omitempty"`         // 是否补跑所有错过的计划（否则只补跑最近一次）
+	Resumable      bool                   `protobuf:"varint,5,opt,name=resumable,proto3" json:"resumable,omitempty"`           // 失败或中途退出后是否从进度处重试
+	Paused         bool                   `protobuf:"varint,6,opt,name=paused,proto3" json:"paused,omitempty"`
+	PauseReason    string                 `protobuf:"bytes,7,opt,name=pauseReason,proto3" json:"pauseReason,omitempty"`
+	PausedAt       int64                  `protobuf:"varint,8,opt,name=pausedAt,proto3" json:"pausedAt,omitempty"`
+	NextRunAt      int64                  `protobuf:"varint,9,opt,name=nextRunAt,proto3" json:"nextRunAt,omitempty"` // 下次计划执行时间
+	LastRun        *JobRun                `protobuf:"bytes,10,opt,name=lastRun,proto3" json:"lastRun,omitempty"`     // 最近一次执行（含手动触发）
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CronJob) Reset() {
+	*x = CronJob{}
+	mi := &file_subscription_proto_msgTypes[103]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CronJob) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CronJob) ProtoMessage() {}
+
+func (x *CronJob) ProtoReflect() protoreflect.Message {
+	mi := &file_subscription_proto_msgTypes[103]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CronJob.ProtoReflect.Descriptor instead.
+func (*CronJob) Descriptor() ([]byte, []int) {
+	return file_subscription_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *CronJob) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CronJob) GetSpec() string {
+	if x != nil {
+		return x.Spec
+	}
+	return ""
+}
+
+func (x *CronJob) GetTimeoutSeconds() int64 {
+	if x != nil {
+		return x.TimeoutSeconds
+	}
+	return 0
+}
+
+func (x *CronJob) GetCatchUpAll() bool {
+	if x != nil {
+		return x.CatchUpAll
+	}
+	return false
+}
+
+func (x *CronJob) GetResumable() bool {
+	if x != nil {
+		return x.Resumable
+	}
+	return false
+}
+
+func (x *CronJob) GetPaused() bool {
+	if x != nil {
+		return x.Paused
+	}
+	return false
+}
+
+func (x *CronJob) GetPauseReason() string {
+	if x != nil {
+		return x.PauseReason
+	}
+	return ""
+}
+
+func (x *CronJob) GetPausedAt() int64 {
+	if x != nil {
+		return x.PausedAt
+	}
+	return 0
+}
+
+func (x *CronJob) GetNextRunAt() int64 {
+	if x != nil {
+		return x.NextRunAt
+	}
+	return 0
+}
+
+func (x *CronJob) GetLastRun() *JobRun {
+	if x != nil {
+		return x.LastRun
+	}
+	return nil
+}
+
+type ListJobsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListJobsRequest) Reset() {
+	*x = ListJobsRequest{}
+	mi := &file_subscription_proto_msgTypes[104]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListJobsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListJobsRequest) ProtoMessage() {}
+
+func (x *ListJobsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_subscription_proto_msgTypes[104]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListJobsRequest.ProtoReflect.Descriptor instead.
+func (*ListJobsRequest) Descriptor() ([]byte, []int) {
+	return file_subscription_proto_rawDescGZIP(), []int{104}
+}
+
+type ListJobsReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Jobs          []*CronJob             `protobuf:"bytes,1,rep,name=jobs,proto3" json:"jobs,omitempty"`
+	Leader        bool                   `protobuf:"varint,2,opt,name=leader,proto3" json:"leader,omitempty"` // 当前实例是否为主节点
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListJobsReply) Reset() {
+	*x = ListJobsReply{}
+	mi := &file_subscription_proto_msgTypes[105]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListJobsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListJobsReply) ProtoMessage() {}
+
+func (x *ListJobsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_subscription_proto_msgTypes[105]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListJobsReply.ProtoReflect.Descriptor instead.
+func (*ListJobsReply) Descriptor() ([]byte, []int) {
+	return file_subscription_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *ListJobsReply) GetJobs() []*CronJob {
+	if x != nil {
+		return x.Jobs
+	}
+	return nil
+}
+
+func (x *ListJobsReply) GetLeader() bool {
+	if x != nil {
+		return x.Leader
+	}
+	return false
+}
+
+type TriggerJobRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	DryRun        bool                   `protobuf:"varint,2,opt,name=dryRun,proto3" json:"dryRun,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TriggerJobRequest) Reset() {
+	*x = TriggerJobRequest{}
+	mi := &file_subscription_proto_msgTypes[106]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TriggerJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TriggerJobRequest) ProtoMessage() {}
+
+func (x *TriggerJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_subscription_proto_msgTypes[106]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TriggerJobRequest.ProtoReflect.Descriptor instead.
+func (*TriggerJobRequest) Descriptor() ([]byte, []int) {
+	return file_subscription_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *TriggerJobRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TriggerJobRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type TriggerJobReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Run           *JobRun                `protobuf:"bytes,1,opt,name=run,proto3" json:"run,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TriggerJobReply) Reset() {
+	*x = TriggerJobReply{}
+	mi := &file_subscription_proto_msgTypes[107]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TriggerJobReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TriggerJobReply) ProtoMessage() {}
+
+func (x *TriggerJobReply) ProtoReflect() protoreflect.Message {
+	mi := &file_subscription_proto_msgTypes[107]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TriggerJobReply.ProtoReflect.Descriptor instead.
+func (*TriggerJobReply) Descriptor() ([]byte, []int) {
+	return file_subscription_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *TriggerJobReply) GetRun() *JobRun {
+	if x != nil {
+		return x.Run
+	}
+	return nil
+}
+
+type PauseJobRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PauseJobRequest) Reset() {
+	*x = PauseJobRequest{}
+	mi := &file_subscription_proto_msgTypes[108]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PauseJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PauseJobRequest) ProtoMessage() {}
+
+func (x *PauseJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_subscription_proto_msgTypes[108]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PauseJobRequest.ProtoReflect.Descriptor instead.
+func (*PauseJobRequest) Descriptor() ([]byte, []int) {
+	return file_subscription_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *PauseJobRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PauseJobRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type ResumeJobRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResumeJobRequest) Reset() {
+	*x = ResumeJobRequest{}
+	mi := &file_subscription_proto_msgTypes[109]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResumeJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResumeJobRequest) ProtoMessage() {}
+
+func (x *ResumeJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_subscription_proto_msgTypes[109]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResumeJobRequest.ProtoReflect.Descriptor instead.
+func (*ResumeJobRequest) Descriptor() ([]byte, []int) {
+	return file_subscription_proto_rawDescGZIP(), []int{109}
+}
+
+func (x *ResumeJobRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type ListJobRunsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	JobName       string                 `protobuf:"bytes,1,opt,name=jobName,proto3" json:"jobName,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,4,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListJobRunsRequest) Reset() {
+	*x = ListJobRunsRequest{}
+	mi := &file_subscription_proto_msgTypes[110]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListJobRunsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListJobRunsRequest) ProtoMessage() {}
+
+func (x *ListJobRunsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_subscription_proto_msgTypes[110]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListJobRunsRequest.ProtoReflect.Descriptor instead.
+func (*ListJobRunsRequest) Descriptor() ([]byte, []int) {
+	return file_subscription_proto_rawDescGZIP(), []int{110}
+}
+
+func (x *ListJobRunsRequest) GetJobName() string {
+	if x != nil {
+		return x.JobName
+	}
+	return ""
+}
+
+func (x *ListJobRunsRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ListJobRunsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListJobRunsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type ListJobRunsReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Runs          []*JobRun              `protobuf:"bytes,1,rep,name=runs,proto3" json:"runs,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,4,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListJobRunsReply) Reset() {
+	*x = ListJobRunsReply{}
+	mi := &file_subscription_proto_msgTypes[111]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListJobRunsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListJobRunsReply) ProtoMessage() {}
+
+func (x *ListJobRunsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_subscription_proto_msgTypes[111]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListJobRunsReply.ProtoReflect.Descriptor instead.
+func (*ListJobRunsReply) Descriptor() ([]byte, []int) {
+	return file_subscription_proto_rawDescGZIP(), []int{111}
+}
+
+func (x *ListJobRunsReply) GetRuns() []*JobRun {
+	if x != nil {
+		return x.Runs
+	}
+	return nil
+}
+
+func (x *ListJobRunsReply) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListJobRunsReply) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListJobRunsReply) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+// 用户订阅（客服查看）
+type SupportSubscription struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	SubscriptionId uint64                 `protobuf:"varint,1,opt,name=subscriptionId,proto3" json:"subscriptionId,omitempty"`
+	Uid            string                 `protobuf:"bytes,2,opt,name=uid,proto3" json:"uid,omitempty"`
+	PlanId         string                 `protobuf:"bytes,3,opt,name=planId,proto3" json:"planId,omitempty"`
+	AppId          string                 `protobuf:"bytes,4,opt,name=appId,proto3" json:"appId,omitempty"`
+	CountryCode    string                 `protobuf:"bytes,5,opt,name=countryCode,proto3" json:"countryCode,omitempty"`
+	Status         string                 `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"` // active, expired, paused, cancelled
+	StartTime      int64                  `protobuf:"varint,7,opt,name=startTime,proto3" json:"startTime,omitempty"`
+	EndTime        int64                  `protobuf:"varint,8,opt,name=endTime,proto3" json:"endTime,omitempty"` // 终身订阅为 0
+	AutoRenew      bool                   `protobuf:"varint,9,opt,name=autoRenew,proto3" json:"autoRenew,omitempty"`
+	OrderId        string                 `protobuf:"bytes,10,opt,name=orderId,proto3" json:"orderId,omitempty"` // 最近一次生效的订单
+	Version        int32                  `protobuf:"varint,11,opt,name=version,proto3" json:"version,omitempty"`
+	CreatedAt      int64                  `protobuf:"varint,12,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	UpdatedAt      int64                  `protobuf:"varint,13,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+	Source         string                 `protobuf:"bytes,14,opt,name=source,proto3" json:"source,omitempty"`         // 当前周期的来源：purchase, comp, manual
+	NextPlanId     string                 `protobuf:"bytes,15,opt,name=nextPlanId,proto3" json:"nextPlanId,omitempty"` // 下次续费时切换到的套餐（批量迁移套餐时设置）
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SupportSubscription) Reset() {
+	*x = SupportSubscription{}
+	mi := &file_subscription_proto_msgTypes[112]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SupportSubscription) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SupportSubscription) ProtoMessage() {}
+
+func (x *SupportSubscription) ProtoReflect() protoreflect.Message {
+	mi := &file_subscription_proto_msgTypes[112]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SupportSubscription.ProtoReflect.Descriptor instead.
+func (*SupportSubscription) Descriptor() ([]byte, []int) {
+	return file_subscription_proto_rawDescGZIP(), []int{112}
+}
+
+func (x *SupportSubscription) GetSubscriptionId() uint64 {
+	if x != nil {
+		return x.SubscriptionId
+	}
+	return 0
+}
+
+func (x *SupportSubscription) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+func (x *SupportSubscription) GetPlanId() string {
+	if x != nil {
+		return x.PlanId
+	}
+	return ""
+}
+
+func (x *SupportSubscription) GetAppId() string {
+	if x != nil {
+		return x.AppId
+	}
+	return ""
+}
+
+func (x *SupportSubscription) GetCountryCode() string {
+	if x != nil {
+		return x.CountryCode
+	}
+	return ""
+}
+
+func (x *SupportSubscription) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *SupportSubscription) GetStartTime() int64 {
+	if x != nil {
+		return x.StartTime
+	}
+	return 0
+}
+
+func (x *SupportSubscription) GetEndTime() int64 {
+	if x != nil {
+		return x.EndTime
+	}
+	return 0
+}
+
+func (x *SupportSubscription) GetAutoRenew() bool {
+	if x != nil {
+		return x.AutoRenew
+	}
+	return false
+}
+
+func (x *SupportSubscription) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *SupportSubscription) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *SupportSubscription) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *SupportSubscription) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+func (x *SupportSubscription) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *SupportSubscription) GetNextPlanId() string {
+	if x != nil {
+		return x.NextPlanId
+	}
+	return ""
+}
+
+type SearchSubscriptionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uid           string                 `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	AppId         string                 `protobuf:"bytes,2,opt,name=appId,proto3" json:"appId,omitempty"`
+	PlanId        string                 `protobuf:"bytes,3,opt,name=planId,proto3" json:"planId,omitempty"`
+	Status        string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	TimeField     string                 `protobuf:"bytes,5,opt,name=timeField,proto3" json:"timeField,omitempty"`  // 时间范围作用的字段，默认 created_at
+	StartTime     int64                  `protobuf:"varint,6,opt,name=startTime,proto3" json:"startTime,omitempty"` // 时间范围 [startTime, endTime)，0 表示不限
+	EndTime       int64                  `protobuf:"varint,7,opt,name=endTime,proto3" json:"endTime,omitempty"`
+	Page          int32                  `protobuf:"varint,8,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,9,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchSubscriptionsRequest) Reset() {
+	*x = SearchSubscriptionsRequest{}
+	mi := &file_subscription_proto_msgTypes[113]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchSubscriptionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchSubscriptionsRequest) ProtoMessage() {}
+
+func (x *SearchSubscriptionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_subscription_proto_msgTypes[113]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchSubscriptionsRequest.ProtoReflect.Descriptor instead.
+func (*SearchSubscriptionsRequest) Descriptor() ([]byte, []int) {
+	return file_subscription_proto_rawDescGZIP(), []int{113}
+}
+
+func (x *SearchSubscriptionsRequest) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+func (x *SearchSubscriptionsRequest) GetAppId() string {
+	if x != nil {
+		return x.AppId
+	}
+	return ""
+}
+
+func (x *SearchSubscriptionsRequest) GetPlanId() string {
+	if x != nil {
+		return x.PlanId
+	}
+	return ""
+}
+
+func (x *SearchSubscriptionsRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *SearchSubscriptionsRequest) GetTimeField() string {
+	if x != nil {
+		return x.TimeField
+	}
+	return ""
+}
+
+func (x *SearchSubscriptionsRequest) GetStartTime() int64 {
+	if x != nil {
+		return x.StartTime
+	}
+	return 0
+}
+
+func (x *SearchSubscriptionsRequest) GetEndTime() int64 {
+	if x != nil {
+		return x.EndTime
+	}
+	return 0
+}
+
+func (x *SearchSubscriptionsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *SearchSubscriptionsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type SearchSubscriptionsReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Subscriptions []*SupportSubscription `protobuf:"bytes,1,rep,name=subscriptions,proto3" json:"subscriptions,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,4,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchSubscriptionsReply) Reset() {
+	*x = SearchSubscriptionsReply{}
+	mi := &file_subscription_proto_msgTypes[114]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchSubscriptionsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchSubscriptionsReply) ProtoMessage() {}
+
+func (x *SearchSubscriptionsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_subscription_proto_msgTypes[114]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchSubscriptionsReply.ProtoReflect.Descriptor instead.
+func (*SearchSubscriptionsReply) Descriptor() ([]byte, []int) {
+	return file_subscription_proto_rawDescGZIP(), []int{114}
+}
+
+func (x *SearchSubscriptionsReply) GetSubscriptions() []*SupportSubscription {
+	if x != nil {
+		return x.Subscriptions
+	}
+	return nil
+}
+
+func (x *SearchSubscriptionsReply) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *SearchSubscriptionsReply) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *SearchSubscriptionsReply) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+// 订单（客服查看，含支付和退款信息）
+type SupportOrder struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrderId        string                 `protobuf:"bytes,1,opt,name=orderId,proto3" json:"orderId,omitempty"`
+	PaymentId      string                 `protobuf:"bytes,2,opt,name=paymentId,proto3" json:"paymentId,omitempty"`
+	PlanId         string                 `protobuf:"bytes,3,opt,name=planId,proto3" json:"planId,omitempty"`
+	AppId          string                 `protobuf:"bytes,4,opt,name=appId,proto3" json:"appId,omitempty"`
+	Currency       string                 `protobuf:"bytes,5,opt,name=currency,proto3" json:"currency,omitempty"`
+	Amount         float64                `protobuf:"fixed64,6,opt,name=amount,proto3" json:"amount,omitempty"`
+	RefundedAmount float64                `protobuf:"fixed64,7,opt,name=refundedAmount,proto3" json:"refundedAmount,omitempty"`
+	PaymentStatus  string                 `protobuf:"bytes,8,opt,name=paymentStatus,proto3" json:"paymentStatus,omitempty"` // pending, success, failed, closed, refunded, partially_refunded
+	PeriodStart    int64                  `protobuf:"varint,9,opt,name=periodStart,proto3" json:"periodStart,omitempty"`
+	PeriodEnd      int64                  `protobuf:"varint,10,opt,name=periodEnd,proto3" json:"periodEnd,omitempty"`
+	PaidAt         int64                  `protobuf:"varint,11,opt,name=paidAt,proto3" json:"paidAt,omitempty"`
+	CreatedAt      int64                  `protobuf:"varint,12,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	Source         string                 `protobuf:"bytes,13,opt,name=source,proto3" json:"source,omitempty"` // purchase, comp, manual（赠送和手动开通为零金额记账订单）
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SupportOrder) Reset() {
+	*x = SupportOrder{}
+	mi := &file_subscription_proto_msgTypes[115]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SupportOrder) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SupportOrder) ProtoMessage() {}
+
+func (x *SupportOrder) ProtoReflect() protoreflect.Message {
+	mi := &file_subscription_proto_msgTypes[115]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SupportOrder.ProtoReflect.Descriptor instead.
+func (*SupportOrder) Descriptor() ([]byte, []int) {
+	return file_subscription_proto_rawDescGZIP(), []int{115}
+}
+
+func (x *SupportOrder) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *SupportOrder) GetPaymentId() string {
+	if x != nil {
+		return x.PaymentId
+	}
+	return ""
+}
+
+func (x *SupportOrder) GetPlanId() string {
+	if x != nil {
+		return x.PlanId
+	}
+	return ""
+}
+
+func (x *SupportOrder) GetAppId() string {
+	if x != nil {
+		return x.AppId
+	}
+	return ""
+}
+
+func (x *SupportOrder) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *SupportOrder) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *SupportOrder) GetRefundedAmount() float64 {
+	if x != nil {
+		return x.RefundedAmount
+	}
+	return 0
+}
+
+func (x *SupportOrder) GetPaymentStatus() string {
+	if x != nil {
+		return x.PaymentStatus
+	}
+	return ""
+}
+
+func (x *SupportOrder) GetPeriodStart() int64 {
+	if x != nil {
+		return x.PeriodStart
+	}
+	return 0
+}
+
+func (x *SupportOrder) GetPeriodEnd() int64 {
+	if x != nil {
+		return x.PeriodEnd
+	}
+	return 0
+}
+
+func (x *SupportOrder) GetPaidAt() int64 {
+	if x != nil {
+		return x.PaidAt
+	}
+	return 0
+}
+
+func (x *SupportOrder) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *SupportOrder) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+// 时间线事件
+type TimelineEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Time          int64                  `protobuf:"varint,1,opt,name=time,proto3" json:"time,omitempty"`
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`     // order_created, order_paid, history
+	Action        string                 `protobuf:"bytes,3,opt,name=action,proto3" json:"action,omitempty"` // 订阅历史的操作类型
+	PlanId        string                 `protobuf:"bytes,4,opt,name=planId,proto3" json:"planId,omitempty"`
+	OrderId       string                 `protobuf:"bytes,5,opt,name=orderId,proto3" json:"orderId,omitempty"`
+	Status        string                 `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"` // 订单支付状态或订阅状态
+	Amount        float64                `protobuf:"fixed64,7,opt,name=amount,proto3" json:"amount,omitempty"`
+	Currency      string                 `protobuf:"bytes,8,opt,name=currency,proto3" json:"currency,omitempty"`
+	OperatorId    string                 `protobuf:"bytes,9,opt,name=operatorId,proto3" json:"operatorId,omitempty"`
+	Reason        string                 `protobuf:"bytes,10,opt,name=reason,proto3" json:"reason,omitempty"`
+	ActorType     string                 `protobuf:"bytes,11,opt,name=actorType,proto3" json:"actorType,omitempty"` // 订阅历史的操作人类型
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TimelineEvent) Reset() {
+	*x = TimelineEvent{}
+	mi := &file_subscription_proto_msgTypes[116]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TimelineEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TimelineEvent) ProtoMessage() {}
+
+func (x *TimelineEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_subscription_proto_msgTypes[116]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TimelineEvent.ProtoReflect.Descriptor instead.
+func (*TimelineEvent) Descriptor() ([]byte, []int) {
+	return file_subscription_proto_rawDescGZIP(), []int{116}
+}
+
+func (x *TimelineEvent) GetTime() int64 {
+	if x != nil {
+		return x.Time
+	}
+	return 0
+}
+
+func (x *TimelineEvent) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *TimelineEvent) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *TimelineEvent) GetPlanId() string {
+	if x != nil {
+		return x.PlanId
+	}
+	return ""
+}
+
+func (x *TimelineEvent) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *TimelineEvent) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *TimelineEvent) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *TimelineEvent) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *TimelineEvent) GetOperatorId() string {
+	if x != nil {
+		return x.OperatorId
+	}
+	return ""
+}
+
+func (x *TimelineEvent) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *TimelineEvent) GetActorType() string {
+	if x != nil {
+		return x.ActorType
+	}
+	return ""
+}
+
+type GetUserTimelineRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uid           string                 `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserTimelineRequest) Reset() {
+	*x = GetUserTimelineRequest{}
+	mi := &file_subscription_proto_msgTypes[117]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserTimelineRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserTimelineRequest) ProtoMessage() {}
+
+func (x *GetUserTimelineRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_subscription_proto_msgTypes[117]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserTimelineRequest.ProtoReflect.Descriptor instead.
+func (*GetUserTimelineRequest) Descriptor() ([]byte, []int) {
+	return file_subscription_proto_rawDescGZIP(), []int{117}
+}
+
+func (x *GetUserTimelineRequest) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+type GetUserTimelineReply struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	Subscription  *SupportSubscription       `protobuf:"bytes,1,opt,name=subscription,proto3" json:"subscription,omitempty"` // 没有订阅时为空
+	Orders        []*SupportOrder            `protobuf:"bytes,2,rep,name=orders,proto3" json:"orders,omitempty"`             // 最近的订单，按创建时间倒序
+	History       []*SubscriptionHistoryItem `protobuf:"bytes,3,rep,name=history,proto3" json:"history,omitempty"`           // 最近的订阅历史，按时间倒序
+	Events        []*TimelineEvent           `protobuf:"bytes,4,rep,name=events,proto3" json:"events,omitempty"`             // 按时间倒序
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserTimelineReply) Reset() {
+	*x = GetUserTimelineReply{}
+	mi := &file_subscription_proto_msgTypes[118]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserTimelineReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserTimelineReply) ProtoMessage() {}
+
+func (x *GetUserTimelineReply) ProtoReflect() protoreflect.Message {
+	mi := &file_subscription_proto_msgTypes[118]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserTimelineReply.ProtoReflect.Descriptor instead.
+func (*GetUserTimelineReply) Descriptor() ([]byte, []int) {
+	return file_subscription_proto_rawDescGZIP(), []int{118}
+}
+
+func (x *GetUserTimelineReply) GetSubscription() *SupportSubscription {
+	if x != nil {
+		return x.Subscription
+	}
+	return nil
+}
+
+func (x *GetUserTimelineReply) GetOrders() []*SupportOrder {
+	if x != nil {
+		return x.Orders
+	}
+	return nil
+}
+
+func (x *GetUserTimelineReply) GetHistory() []*SubscriptionHistoryItem {
+	if x != nil {
+		return x.History
+	}
+	return nil
+}
+
+func (x *GetUserTimelineReply) GetEvents() []*TimelineEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+type AdjustSubscriptionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uid           string                 `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	Days          int32                  `protobuf:"varint,2,opt,name=days,proto3" json:"days,omitempty"` // 正数延长，负数缩短
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdjustSubscriptionRequest) Reset() {
+	*x = AdjustSubscriptionRequest{}
+	mi := &file_subscription_proto_msgTypes[119]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdjustSubscriptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdjustSubscriptionRequest) ProtoMessage() {}
+
+func (x *AdjustSubscriptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_subscription_proto_msgTypes[119]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdjustSubscriptionRequest.ProtoReflect.Descriptor instead.
+func (*AdjustSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return file_subscription_proto_rawDescGZIP(), []int{119}
+}
+
+func (x *AdjustSubscriptionRequest) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+func (x *AdjustSubscriptionRequest) GetDays() int32 {
+	if x != nil {
+		return x.Days
+	}
+	return 0
+}
+
+func (x *AdjustSubscriptionRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type AdjustSubscriptionReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Subscription  *SupportSubscription   `protobuf:"bytes,1,opt,name=subscription,proto3" json:"subscription,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdjustSubscriptionReply) Reset() {
+	*x = AdjustSubscriptionReply{}
+	mi := &file_subscription_proto_msgTypes[120]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdjustSubscriptionReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdjustSubscriptionReply) ProtoMessage() {}
+
+func (x *AdjustSubscriptionReply) ProtoReflect() protoreflect.Message {
+	mi := &file_subscription_proto_msgTypes[120]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdjustSubscriptionReply.ProtoReflect.Descriptor instead.
+func (*AdjustSubscriptionReply) Descriptor() ([]byte, []int) {
+	return file_subscription_proto_rawDescGZIP(), []int{120}
+}
+
+func (x *AdjustSubscriptionReply) GetSubscription() *SupportSubscription {
+	if x != nil {
+		return x.Subscription
+	}
+	return nil
+}
+
+type GrantSubscriptionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uid           string                 `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	PlanId        string                 `protobuf:"bytes,2,opt,name=planId,proto3" json:"planId,omitempty"`
+	Days          int32                  `protobuf:"varint,3,opt,name=days,proto3" json:"days,omitempty"` // 0 表示按套餐的一个计费周期（终身套餐只能为 0）
+	Reason        string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	Source        string                 `protobuf:"bytes,5,opt,name=source,proto3" json:"source,omitempty"`            // comp-赠送, manual-手动开通（如企业客户线下对公付款）
+	CreateOrder   bool                   `protobuf:"varint,6,opt,name=createOrder,proto3" json:"createOrder,omitempty"` // 是否创建零金额订单用于记账
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GrantSubscriptionRequest) Reset() {
+	*x = GrantSubscriptionRequest{}
+	mi := &file_subscription_proto_msgTypes[121]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GrantSubscriptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GrantSubscriptionRequest) ProtoMessage() {}
+
+func (x *GrantSubscriptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_subscription_proto_msgTypes[121]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GrantSubscriptionRequest.ProtoReflect.Descriptor instead.
+func (*GrantSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return file_subscription_proto_rawDescGZIP(), []int{121}
+}
+
+func (x *GrantSubscriptionRequest) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+func (x *GrantSubscriptionRequest) GetPlanId() string {
+	if x != nil {
+		return x.PlanId
+	}
+	return ""
+}
+
+func (x *GrantSubscriptionRequest) GetDays() int32 {
+	if x != nil {
+		return x.Days
+	}
+	return 0
+}
+
+func (x *GrantSubscriptionRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
 
-func (x *UpdateExpiredSubscriptionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_subscription_proto_msgTypes[24]
+func (x *GrantSubscriptionRequest) GetSource() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Source
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use UpdateExpiredSubscriptionsRequest.ProtoReflect.Descriptor instead.
-func (*UpdateExpiredSubscriptionsRequest) Descriptor() ([]byte, []int) {
-	return file_subscription_proto_rawDescGZIP(), []int{24}
+func (x *GrantSubscriptionRequest) GetCreateOrder() bool {
+	if x != nil {
+		return x.CreateOrder
+	}
+	return false
 }
 
-type UpdateExpiredSubscriptionsReply struct {
+type GrantSubscriptionReply struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UpdatedCount  int32                  `protobuf:"varint,1,opt,name=updatedCount,proto3" json:"updatedCount,omitempty"`
-	UpdatedUids   []string               `protobuf:"bytes,2,rep,name=updatedUids,proto3" json:"updatedUids,omitempty"` // 更新的用户ID列表（字符串 UUID）
+	Subscription  *SupportSubscription   `protobuf:"bytes,1,opt,name=subscription,proto3" json:"subscription,omitempty"`
+	Order         *SupportOrder          `protobuf:"bytes,2,opt,name=order,proto3" json:"order,omitempty"` // createOrder 为 true 时返回记账订单
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateExpiredSubscriptionsReply) Reset() {
-	*x = UpdateExpiredSubscriptionsReply{}
-	mi := &file_subscription_proto_msgTypes[25]
+func (x *GrantSubscriptionReply) Reset() {
+	*x = GrantSubscriptionReply{}
+	mi := &file_subscription_proto_msgTypes[122]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateExpiredSubscriptionsReply) String() string {
+func (x *GrantSubscriptionReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateExpiredSubscriptionsReply) ProtoMessage() {}
+func (*GrantSubscriptionReply) ProtoMessage() {}
 
-func (x *UpdateExpiredSubscriptionsReply) ProtoReflect() protoreflect.Message {
-	mi := &file_subscription_proto_msgTypes[25]
+func (x *GrantSubscriptionReply) ProtoReflect() protoreflect.Message {
+	mi := &file_subscription_proto_msgTypes[122]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1620,49 +8700,67 @@ func (x *UpdateExpiredSubscriptionsReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateExpiredSubscriptionsReply.ProtoReflect.Descriptor instead.
-func (*UpdateExpiredSubscriptionsReply) Descriptor() ([]byte, []int) {
-	return file_subscription_proto_rawDescGZIP(), []int{25}
+// Deprecated: Use GrantSubscriptionReply.ProtoReflect.Descriptor instead.
+func (*GrantSubscriptionReply) Descriptor() ([]byte, []int) {
+	return file_subscription_proto_rawDescGZIP(), []int{122}
 }
 
-func (x *UpdateExpiredSubscriptionsReply) GetUpdatedCount() int32 {
+func (x *GrantSubscriptionReply) GetSubscription() *SupportSubscription {
 	if x != nil {
-		return x.UpdatedCount
+		return x.Subscription
 	}
-	return 0
+	return nil
 }
 
-func (x *UpdateExpiredSubscriptionsReply) GetUpdatedUids() []string {
+func (x *GrantSubscriptionReply) GetOrder() *SupportOrder {
 	if x != nil {
-		return x.UpdatedUids
+		return x.Order
 	}
 	return nil
 }
 
-// 自动续费处理
-type ProcessAutoRenewalsRequest struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	DaysBeforeExpiry int32                  `protobuf:"varint,1,opt,name=daysBeforeExpiry,proto3" json:"daysBeforeExpiry,omitempty"` // 提前多少天续费，默认3天
-	DryRun           bool                   `protobuf:"varint,2,opt,name=dryRun,proto3" json:"dryRun,omitempty"`                     // 是否为测试运行
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
-}
-
-func (x *ProcessAutoRenewalsRequest) Reset() {
-	*x = ProcessAutoRenewalsRequest{}
-	mi := &file_subscription_proto_msgTypes[26]
+// 批量操作
+type BulkOperation struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type           string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`                 // extend, migrate_plan
+	AppId          string                 `protobuf:"bytes,3,opt,name=appId,proto3" json:"appId,omitempty"`               // 筛选条件：应用
+	PlanId         string                 `protobuf:"bytes,4,opt,name=planId,proto3" json:"planId,omitempty"`             // 筛选条件：套餐（迁移套餐时为原套餐）
+	FilterStatus   string                 `protobuf:"bytes,5,opt,name=filterStatus,proto3" json:"filterStatus,omitempty"` // 筛选条件：订阅状态
+	Days           int32                  `protobuf:"varint,6,opt,name=days,proto3" json:"days,omitempty"`                // 延长天数（extend）
+	TargetPlanId   string                 `protobuf:"bytes,7,opt,name=targetPlanId,proto3" json:"targetPlanId,omitempty"` // 目标套餐（migrate_plan）
+	OperatorId     string                 `protobuf:"bytes,8,opt,name=operatorId,proto3" json:"operatorId,omitempty"`
+	Reason         string                 `protobuf:"bytes,9,opt,name=reason,proto3" json:"reason,omitempty"`
+	Status         string                 `protobuf:"bytes,10,opt,name=status,proto3" json:"status,omitempty"`                  // pending, running, completed, cancelled
+	TotalCount     int32                  `protobuf:"varint,11,opt,name=totalCount,proto3" json:"totalCount,omitempty"`         // 创建时匹配的订阅数
+	ProcessedCount int32                  `protobuf:"varint,12,opt,name=processedCount,proto3" json:"processedCount,omitempty"` // 已处理数
+	SuccessCount   int32                  `protobuf:"varint,13,opt,name=successCount,proto3" json:"successCount,omitempty"`
+	SkippedCount   int32                  `protobuf:"varint,14,opt,name=skippedCount,proto3" json:"skippedCount,omitempty"` // 处理时已不满足筛选条件的订阅
+	FailedCount    int32                  `protobuf:"varint,15,opt,name=failedCount,proto3" json:"failedCount,omitempty"`
+	Error          string                 `protobuf:"bytes,16,opt,name=error,proto3" json:"error,omitempty"` // 最近一次失败的错误信息
+	StartedAt      int64                  `protobuf:"varint,17,opt,name=startedAt,proto3" json:"startedAt,omitempty"`
+	FinishedAt     int64                  `protobuf:"varint,18,opt,name=finishedAt,proto3" json:"finishedAt,omitempty"`
+	CreatedAt      int64                  `protobuf:"varint,19,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	UpdatedAt      int64                  `protobuf:"varint,20,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *BulkOperation) Reset() {
+	*x = BulkOperation{}
+	mi := &file_subscription_proto_msgTypes[123]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ProcessAutoRenewalsRequest) String() string {
+func (x *BulkOperation) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProcessAutoRenewalsRequest) ProtoMessage() {}
+func (*BulkOperation) ProtoMessage() {}
 
-func (x *ProcessAutoRenewalsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_subscription_proto_msgTypes[26]
+func (x *BulkOperation) ProtoReflect() protoreflect.Message {
+	mi := &file_subscription_proto_msgTypes[123]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1673,204 +8771,180 @@ func (x *ProcessAutoRenewalsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProcessAutoRenewalsRequest.ProtoReflect.Descriptor instead.
-func (*ProcessAutoRenewalsRequest) Descriptor() ([]byte, []int) {
-	return file_subscription_proto_rawDescGZIP(), []int{26}
+// Deprecated: Use BulkOperation.ProtoReflect.Descriptor instead.
+func (*BulkOperation) Descriptor() ([]byte, []int) {
+	return file_subscription_proto_rawDescGZIP(), []int{123}
 }
 
-func (x *ProcessAutoRenewalsRequest) GetDaysBeforeExpiry() int32 {
+func (x *BulkOperation) GetId() uint64 {
 	if x != nil {
-		return x.DaysBeforeExpiry
+		return x.Id
 	}
 	return 0
 }
 
-func (x *ProcessAutoRenewalsRequest) GetDryRun() bool {
+func (x *BulkOperation) GetType() string {
 	if x != nil {
-		return x.DryRun
+		return x.Type
 	}
-	return false
-}
-
-type AutoRenewResult struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Uid           string                 `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"` // 用户ID（字符串 UUID）
-	PlanId        string                 `protobuf:"bytes,2,opt,name=planId,proto3" json:"planId,omitempty"`
-	Success       bool                   `protobuf:"varint,3,opt,name=success,proto3" json:"success,omitempty"`
-	OrderId       string                 `protobuf:"bytes,4,opt,name=orderId,proto3" json:"orderId,omitempty"`
-	PaymentId     string                 `protobuf:"bytes,5,opt,name=paymentId,proto3" json:"paymentId,omitempty"`
-	ErrorMessage  string                 `protobuf:"bytes,6,opt,name=errorMessage,proto3" json:"errorMessage,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *AutoRenewResult) Reset() {
-	*x = AutoRenewResult{}
-	mi := &file_subscription_proto_msgTypes[27]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *AutoRenewResult) String() string {
-	return protoimpl.X.MessageStringOf(x)
+	return ""
 }
 
-func (*AutoRenewResult) ProtoMessage() {}
-
-func (x *AutoRenewResult) ProtoReflect() protoreflect.Message {
-	mi := &file_subscription_proto_msgTypes[27]
+func (x *BulkOperation) GetAppId() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.AppId
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use AutoRenewResult.ProtoReflect.Descriptor instead.
-func (*AutoRenewResult) Descriptor() ([]byte, []int) {
-	return file_subscription_proto_rawDescGZIP(), []int{27}
+func (x *BulkOperation) GetPlanId() string {
+	if x != nil {
+		return x.PlanId
+	}
+	return ""
 }
 
-func (x *AutoRenewResult) GetUid() string {
+func (x *BulkOperation) GetFilterStatus() string {
 	if x != nil {
-		return x.Uid
+		return x.FilterStatus
 	}
 	return ""
 }
 
-func (x *AutoRenewResult) GetPlanId() string {
+func (x *BulkOperation) GetDays() int32 {
 	if x != nil {
-		return x.PlanId
+		return x.Days
 	}
-	return ""
+	return 0
 }
 
-func (x *AutoRenewResult) GetSuccess() bool {
+func (x *BulkOperation) GetTargetPlanId() string {
 	if x != nil {
-		return x.Success
+		return x.TargetPlanId
 	}
-	return false
+	return ""
 }
 
-func (x *AutoRenewResult) GetOrderId() string {
+func (x *BulkOperation) GetOperatorId() string {
 	if x != nil {
-		return x.OrderId
+		return x.OperatorId
 	}
 	return ""
 }
 
-func (x *AutoRenewResult) GetPaymentId() string {
+func (x *BulkOperation) GetReason() string {
 	if x != nil {
-		return x.PaymentId
+		return x.Reason
 	}
 	return ""
 }
 
-func (x *AutoRenewResult) GetErrorMessage() string {
+func (x *BulkOperation) GetStatus() string {
 	if x != nil {
-		return x.ErrorMessage
+		return x.Status
 	}
 	return ""
 }
 
-type ProcessAutoRenewalsReply struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	TotalCount    int32                  `protobuf:"varint,1,opt,name=totalCount,proto3" json:"totalCount,omitempty"`     // 总共需要处理的数量
-	SuccessCount  int32                  `protobuf:"varint,2,opt,name=successCount,proto3" json:"successCount,omitempty"` // 成功的数量
-	FailedCount   int32                  `protobuf:"varint,3,opt,name=failedCount,proto3" json:"failedCount,omitempty"`   // 失败的数量
-	Results       []*AutoRenewResult     `protobuf:"bytes,4,rep,name=results,proto3" json:"results,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *BulkOperation) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
 }
 
-func (x *ProcessAutoRenewalsReply) Reset() {
-	*x = ProcessAutoRenewalsReply{}
-	mi := &file_subscription_proto_msgTypes[28]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *BulkOperation) GetProcessedCount() int32 {
+	if x != nil {
+		return x.ProcessedCount
+	}
+	return 0
 }
 
-func (x *ProcessAutoRenewalsReply) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *BulkOperation) GetSuccessCount() int32 {
+	if x != nil {
+		return x.SuccessCount
+	}
+	return 0
 }
 
-func (*ProcessAutoRenewalsReply) ProtoMessage() {}
+func (x *BulkOperation) GetSkippedCount() int32 {
+	if x != nil {
+		return x.SkippedCount
+	}
+	return 0
+}
 
-func (x *ProcessAutoRenewalsReply) ProtoReflect() protoreflect.Message {
-	mi := &file_subscription_proto_msgTypes[28]
+func (x *BulkOperation) GetFailedCount() int32 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.FailedCount
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use ProcessAutoRenewalsReply.ProtoReflect.Descriptor instead.
-func (*ProcessAutoRenewalsReply) Descriptor() ([]byte, []int) {
-	return file_subscription_proto_rawDescGZIP(), []int{28}
+func (x *BulkOperation) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
 }
 
-func (x *ProcessAutoRenewalsReply) GetTotalCount() int32 {
+func (x *BulkOperation) GetStartedAt() int64 {
 	if x != nil {
-		return x.TotalCount
+		return x.StartedAt
 	}
 	return 0
 }
 
-func (x *ProcessAutoRenewalsReply) GetSuccessCount() int32 {
+func (x *BulkOperation) GetFinishedAt() int64 {
 	if x != nil {
-		return x.SuccessCount
+		return x.FinishedAt
 	}
 	return 0
 }
 
-func (x *ProcessAutoRenewalsReply) GetFailedCount() int32 {
+func (x *BulkOperation) GetCreatedAt() int64 {
 	if x != nil {
-		return x.FailedCount
+		return x.CreatedAt
 	}
 	return 0
 }
 
-func (x *ProcessAutoRenewalsReply) GetResults() []*AutoRenewResult {
+func (x *BulkOperation) GetUpdatedAt() int64 {
 	if x != nil {
-		return x.Results
+		return x.UpdatedAt
 	}
-	return nil
+	return 0
 }
 
-// 区域定价相关消息
-type PlanPricing struct {
+type CreateBulkOperationRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	PlanPricingId uint64                 `protobuf:"varint,1,opt,name=planPricingId,proto3" json:"planPricingId,omitempty"`
-	PlanId        string                 `protobuf:"bytes,2,opt,name=planId,proto3" json:"planId,omitempty"`
-	CountryCode   string                 `protobuf:"bytes,3,opt,name=countryCode,proto3" json:"countryCode,omitempty"` // ISO 3166-1 alpha-2 国家代码
-	Price         float64                `protobuf:"fixed64,4,opt,name=price,proto3" json:"price,omitempty"`
-	Currency      string                 `protobuf:"bytes,5,opt,name=currency,proto3" json:"currency,omitempty"`
+	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	AppId         string                 `protobuf:"bytes,2,opt,name=appId,proto3" json:"appId,omitempty"`
+	PlanId        string                 `protobuf:"bytes,3,opt,name=planId,proto3" json:"planId,omitempty"`             // 迁移套餐时必填（原套餐）
+	Status        string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`             // 默认 active
+	Days          int32                  `protobuf:"varint,5,opt,name=days,proto3" json:"days,omitempty"`                // 延长天数（extend 必填）
+	TargetPlanId  string                 `protobuf:"bytes,6,opt,name=targetPlanId,proto3" json:"targetPlanId,omitempty"` // 目标套餐（migrate_plan 必填）
+	Reason        string                 `protobuf:"bytes,7,opt,name=reason,proto3" json:"reason,omitempty"`
+	DryRun        bool                   `protobuf:"varint,8,opt,name=dryRun,proto3" json:"dryRun,omitempty"` // 只返回匹配的订阅数，不创建操作
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PlanPricing) Reset() {
-	*x = PlanPricing{}
-	mi := &file_subscription_proto_msgTypes[29]
+func (x *CreateBulkOperationRequest) Reset() {
+	*x = CreateBulkOperationRequest{}
+	mi := &file_subscription_proto_msgTypes[124]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PlanPricing) String() string {
+func (x *CreateBulkOperationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PlanPricing) ProtoMessage() {}
+func (*CreateBulkOperationRequest) ProtoMessage() {}
 
-func (x *PlanPricing) ProtoReflect() protoreflect.Message {
-	mi := &file_subscription_proto_msgTypes[29]
+func (x *CreateBulkOperationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_subscription_proto_msgTypes[124]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1881,68 +8955,133 @@ func (x *PlanPricing) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PlanPricing.ProtoReflect.Descriptor instead.
-func (*PlanPricing) Descriptor() ([]byte, []int) {
-	return file_subscription_proto_rawDescGZIP(), []int{29}
+// Deprecated: Use CreateBulkOperationRequest.ProtoReflect.Descriptor instead.
+func (*CreateBulkOperationRequest) Descriptor() ([]byte, []int) {
+	return file_subscription_proto_rawDescGZIP(), []int{124}
 }
 
-func (x *PlanPricing) GetPlanPricingId() uint64 {
+func (x *CreateBulkOperationRequest) GetType() string {
 	if x != nil {
-		return x.PlanPricingId
+		return x.Type
 	}
-	return 0
+	return ""
 }
 
-func (x *PlanPricing) GetPlanId() string {
+func (x *CreateBulkOperationRequest) GetAppId() string {
+	if x != nil {
+		return x.AppId
+	}
+	return ""
+}
+
+func (x *CreateBulkOperationRequest) GetPlanId() string {
 	if x != nil {
 		return x.PlanId
 	}
 	return ""
 }
 
-func (x *PlanPricing) GetCountryCode() string {
+func (x *CreateBulkOperationRequest) GetStatus() string {
 	if x != nil {
-		return x.CountryCode
+		return x.Status
+	}
+	return ""
+}
+
+func (x *CreateBulkOperationRequest) GetDays() int32 {
+	if x != nil {
+		return x.Days
+	}
+	return 0
+}
+
+func (x *CreateBulkOperationRequest) GetTargetPlanId() string {
+	if x != nil {
+		return x.TargetPlanId
+	}
+	return ""
+}
+
+func (x *CreateBulkOperationRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
 	}
 	return ""
 }
 
-func (x *PlanPricing) GetPrice() float64 {
-	if x != nil {
-		return x.Price
-	}
-	return 0
+func (x *CreateBulkOperationRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type CreateBulkOperationReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Operation     *BulkOperation         `protobuf:"bytes,1,opt,name=operation,proto3" json:"operation,omitempty"` // dryRun 时 id 为 0，totalCount 为匹配的订阅数
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateBulkOperationReply) Reset() {
+	*x = CreateBulkOperationReply{}
+	mi := &file_subscription_proto_msgTypes[125]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateBulkOperationReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateBulkOperationReply) ProtoMessage() {}
+
+func (x *CreateBulkOperationReply) ProtoReflect() protoreflect.Message {
+	mi := &file_subscription_proto_msgTypes[125]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateBulkOperationReply.ProtoReflect.Descriptor instead.
+func (*CreateBulkOperationReply) Descriptor() ([]byte, []int) {
+	return file_subscription_proto_rawDescGZIP(), []int{125}
 }
 
-func (x *PlanPricing) GetCurrency() string {
+func (x *CreateBulkOperationReply) GetOperation() *BulkOperation {
 	if x != nil {
-		return x.Currency
+		return x.Operation
 	}
-	return ""
+	return nil
 }
 
-type ListPlanPricingsRequest struct {
+type GetBulkOperationRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	PlanId        string                 `protobuf:"bytes,1,opt,name=planId,proto3" json:"planId,omitempty"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListPlanPricingsRequest) Reset() {
-	*x = ListPlanPricingsRequest{}
-	mi := &file_subscription_proto_msgTypes[30]
+func (x *GetBulkOperationRequest) Reset() {
+	*x = GetBulkOperationRequest{}
+	mi := &file_subscription_proto_msgTypes[126]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListPlanPricingsRequest) String() string {
+func (x *GetBulkOperationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListPlanPricingsRequest) ProtoMessage() {}
+func (*GetBulkOperationRequest) ProtoMessage() {}
 
-func (x *ListPlanPricingsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_subscription_proto_msgTypes[30]
+func (x *GetBulkOperationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_subscription_proto_msgTypes[126]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1953,40 +9092,40 @@ func (x *ListPlanPricingsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListPlanPricingsRequest.ProtoReflect.Descriptor instead.
-func (*ListPlanPricingsRequest) Descriptor() ([]byte, []int) {
-	return file_subscription_proto_rawDescGZIP(), []int{30}
+// Deprecated: Use GetBulkOperationRequest.ProtoReflect.Descriptor instead.
+func (*GetBulkOperationRequest) Descriptor() ([]byte, []int) {
+	return file_subscription_proto_rawDescGZIP(), []int{126}
 }
 
-func (x *ListPlanPricingsRequest) GetPlanId() string {
+func (x *GetBulkOperationRequest) GetId() uint64 {
 	if x != nil {
-		return x.PlanId
+		return x.Id
 	}
-	return ""
+	return 0
 }
 
-type ListPlanPricingsReply struct {
+type GetBulkOperationReply struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Pricings      []*PlanPricing         `protobuf:"bytes,1,rep,name=pricings,proto3" json:"pricings,omitempty"`
+	Operation     *BulkOperation         `protobuf:"bytes,1,opt,name=operation,proto3" json:"operation,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListPlanPricingsReply) Reset() {
-	*x = ListPlanPricingsReply{}
-	mi := &file_subscription_proto_msgTypes[31]
+func (x *GetBulkOperationReply) Reset() {
+	*x = GetBulkOperationReply{}
+	mi := &file_subscription_proto_msgTypes[127]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListPlanPricingsReply) String() string {
+func (x *GetBulkOperationReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListPlanPricingsReply) ProtoMessage() {}
+func (*GetBulkOperationReply) ProtoMessage() {}
 
-func (x *ListPlanPricingsReply) ProtoReflect() protoreflect.Message {
-	mi := &file_subscription_proto_msgTypes[31]
+func (x *GetBulkOperationReply) ProtoReflect() protoreflect.Message {
+	mi := &file_subscription_proto_msgTypes[127]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1997,43 +9136,43 @@ func (x *ListPlanPricingsReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListPlanPricingsReply.ProtoReflect.Descriptor instead.
-func (*ListPlanPricingsReply) Descriptor() ([]byte, []int) {
-	return file_subscription_proto_rawDescGZIP(), []int{31}
+// Deprecated: Use GetBulkOperationReply.ProtoReflect.Descriptor instead.
+func (*GetBulkOperationReply) Descriptor() ([]byte, []int) {
+	return file_subscription_proto_rawDescGZIP(), []int{127}
 }
 
-func (x *ListPlanPricingsReply) GetPricings() []*PlanPricing {
+func (x *GetBulkOperationReply) GetOperation() *BulkOperation {
 	if x != nil {
-		return x.Pricings
+		return x.Operation
 	}
 	return nil
 }
 
-type CreatePlanPricingRequest struct {
+type ListBulkOperationsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	PlanId        string                 `protobuf:"bytes,1,opt,name=planId,proto3" json:"planId,omitempty"`
-	CountryCode   string                 `protobuf:"bytes,2,opt,name=countryCode,proto3" json:"countryCode,omitempty"` // ISO 3166-1 alpha-2
-	Price         float64                `protobuf:"fixed64,3,opt,name=price,proto3" json:"price,omitempty"`
-	Currency      string                 `protobuf:"bytes,4,opt,name=currency,proto3" json:"currency,omitempty"`
+	AppId         string                 `protobuf:"bytes,1,opt,name=appId,proto3" json:"appId,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,4,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreatePlanPricingRequest) Reset() {
-	*x = CreatePlanPricingRequest{}
-	mi := &file_subscription_proto_msgTypes[32]
+func (x *ListBulkOperationsRequest) Reset() {
+	*x = ListBulkOperationsRequest{}
+	mi := &file_subscription_proto_msgTypes[128]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreatePlanPricingRequest) String() string {
+func (x *ListBulkOperationsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreatePlanPricingRequest) ProtoMessage() {}
+func (*ListBulkOperationsRequest) ProtoMessage() {}
 
-func (x *CreatePlanPricingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_subscription_proto_msgTypes[32]
+func (x *ListBulkOperationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_subscription_proto_msgTypes[128]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2044,61 +9183,64 @@ func (x *CreatePlanPricingRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreatePlanPricingRequest.ProtoReflect.Descriptor instead.
-func (*CreatePlanPricingRequest) Descriptor() ([]byte, []int) {
-	return file_subscription_proto_rawDescGZIP(), []int{32}
+// Deprecated: Use ListBulkOperationsRequest.ProtoReflect.Descriptor instead.
+func (*ListBulkOperationsRequest) Descriptor() ([]byte, []int) {
+	return file_subscription_proto_rawDescGZIP(), []int{128}
 }
 
-func (x *CreatePlanPricingRequest) GetPlanId() string {
+func (x *ListBulkOperationsRequest) GetAppId() string {
 	if x != nil {
-		return x.PlanId
+		return x.AppId
 	}
 	return ""
 }
 
-func (x *CreatePlanPricingRequest) GetCountryCode() string {
+func (x *ListBulkOperationsRequest) GetStatus() string {
 	if x != nil {
-		return x.CountryCode
+		return x.Status
 	}
 	return ""
 }
 
-func (x *CreatePlanPricingRequest) GetPrice() float64 {
+func (x *ListBulkOperationsRequest) GetPage() int32 {
 	if x != nil {
-		return x.Price
+		return x.Page
 	}
 	return 0
 }
 
-func (x *CreatePlanPricingRequest) GetCurrency() string {
+func (x *ListBulkOperationsRequest) GetPageSize() int32 {
 	if x != nil {
-		return x.Currency
+		return x.PageSize
 	}
-	return ""
+	return 0
 }
 
-type CreatePlanPricingReply struct {
+type ListBulkOperationsReply struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Pricing       *PlanPricing           `protobuf:"bytes,1,opt,name=pricing,proto3" json:"pricing,omitempty"`
+	Operations    []*BulkOperation       `protobuf:"bytes,1,rep,name=operations,proto3" json:"operations,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,4,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreatePlanPricingReply) Reset() {
-	*x = CreatePlanPricingReply{}
-	mi := &file_subscription_proto_msgTypes[33]
+func (x *ListBulkOperationsReply) Reset() {
+	*x = ListBulkOperationsReply{}
+	mi := &file_subscription_proto_msgTypes[129]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreatePlanPricingReply) String() string {
+func (x *ListBulkOperationsReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreatePlanPricingReply) ProtoMessage() {}
+func (*ListBulkOperationsReply) ProtoMessage() {}
 
-func (x *CreatePlanPricingReply) ProtoReflect() protoreflect.Message {
-	mi := &file_subscription_proto_msgTypes[33]
+func (x *ListBulkOperationsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_subscription_proto_msgTypes[129]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2109,100 +9251,61 @@ func (x *CreatePlanPricingReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreatePlanPricingReply.ProtoReflect.Descriptor instead.
-func (*CreatePlanPricingReply) Descriptor() ([]byte, []int) {
-	return file_subscription_proto_rawDescGZIP(), []int{33}
+// Deprecated: Use ListBulkOperationsReply.ProtoReflect.Descriptor instead.
+func (*ListBulkOperationsReply) Descriptor() ([]byte, []int) {
+	return file_subscription_proto_rawDescGZIP(), []int{129}
 }
 
-func (x *CreatePlanPricingReply) GetPricing() *PlanPricing {
+func (x *ListBulkOperationsReply) GetOperations() []*BulkOperation {
 	if x != nil {
-		return x.Pricing
+		return x.Operations
 	}
 	return nil
 }
 
-type UpdatePlanPricingRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	PlanPricingId uint64                 `protobuf:"varint,1,opt,name=planPricingId,proto3" json:"planPricingId,omitempty"`
-	Price         float64                `protobuf:"fixed64,2,opt,name=price,proto3" json:"price,omitempty"`
-	Currency      string                 `protobuf:"bytes,3,opt,name=currency,proto3" json:"currency,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *UpdatePlanPricingRequest) Reset() {
-	*x = UpdatePlanPricingRequest{}
-	mi := &file_subscription_proto_msgTypes[34]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *UpdatePlanPricingRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*UpdatePlanPricingRequest) ProtoMessage() {}
-
-func (x *UpdatePlanPricingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_subscription_proto_msgTypes[34]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use UpdatePlanPricingRequest.ProtoReflect.Descriptor instead.
-func (*UpdatePlanPricingRequest) Descriptor() ([]byte, []int) {
-	return file_subscription_proto_rawDescGZIP(), []int{34}
-}
-
-func (x *UpdatePlanPricingRequest) GetPlanPricingId() uint64 {
+func (x *ListBulkOperationsReply) GetTotal() int32 {
 	if x != nil {
-		return x.PlanPricingId
+		return x.Total
 	}
 	return 0
 }
 
-func (x *UpdatePlanPricingRequest) GetPrice() float64 {
+func (x *ListBulkOperationsReply) GetPage() int32 {
 	if x != nil {
-		return x.Price
+		return x.Page
 	}
 	return 0
 }
 
-func (x *UpdatePlanPricingRequest) GetCurrency() string {
+func (x *ListBulkOperationsReply) GetPageSize() int32 {
 	if x != nil {
-		return x.Currency
+		return x.PageSize
 	}
-	return ""
+	return 0
 }
 
-type UpdatePlanPricingReply struct {
+type CancelBulkOperationRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Pricing       *PlanPricing           `protobuf:"bytes,1,opt,name=pricing,proto3" json:"pricing,omitempty"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdatePlanPricingReply) Reset() {
-	*x = UpdatePlanPricingReply{}
-	mi := &file_subscription_proto_msgTypes[35]
+func (x *CancelBulkOperationRequest) Reset() {
+	*x = CancelBulkOperationRequest{}
+	mi := &file_subscription_proto_msgTypes[130]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdatePlanPricingReply) String() string {
+func (x *CancelBulkOperationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdatePlanPricingReply) ProtoMessage() {}
+func (*CancelBulkOperationRequest) ProtoMessage() {}
 
-func (x *UpdatePlanPricingReply) ProtoReflect() protoreflect.Message {
-	mi := &file_subscription_proto_msgTypes[35]
+func (x *CancelBulkOperationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_subscription_proto_msgTypes[130]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2213,40 +9316,40 @@ func (x *UpdatePlanPricingReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdatePlanPricingReply.ProtoReflect.Descriptor instead.
-func (*UpdatePlanPricingReply) Descriptor() ([]byte, []int) {
-	return file_subscription_proto_rawDescGZIP(), []int{35}
+// Deprecated: Use CancelBulkOperationRequest.ProtoReflect.Descriptor instead.
+func (*CancelBulkOperationRequest) Descriptor() ([]byte, []int) {
+	return file_subscription_proto_rawDescGZIP(), []int{130}
 }
 
-func (x *UpdatePlanPricingReply) GetPricing() *PlanPricing {
+func (x *CancelBulkOperationRequest) GetId() uint64 {
 	if x != nil {
-		return x.Pricing
+		return x.Id
 	}
-	return nil
+	return 0
 }
 
-type DeletePlanPricingRequest struct {
+type CancelBulkOperationReply struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	PlanPricingId uint64                 `protobuf:"varint,1,opt,name=planPricingId,proto3" json:"planPricingId,omitempty"`
+	Operation     *BulkOperation         `protobuf:"bytes,1,opt,name=operation,proto3" json:"operation,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeletePlanPricingRequest) Reset() {
-	*x = DeletePlanPricingRequest{}
-	mi := &file_subscription_proto_msgTypes[36]
+func (x *CancelBulkOperationReply) Reset() {
+	*x = CancelBulkOperationReply{}
+	mi := &file_subscription_proto_msgTypes[131]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeletePlanPricingRequest) String() string {
+func (x *CancelBulkOperationReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeletePlanPricingRequest) ProtoMessage() {}
+func (*CancelBulkOperationReply) ProtoMessage() {}
 
-func (x *DeletePlanPricingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_subscription_proto_msgTypes[36]
+func (x *CancelBulkOperationReply) ProtoReflect() protoreflect.Message {
+	mi := &file_subscription_proto_msgTypes[131]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2257,40 +9360,43 @@ func (x *DeletePlanPricingRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeletePlanPricingRequest.ProtoReflect.Descriptor instead.
-func (*DeletePlanPricingRequest) Descriptor() ([]byte, []int) {
-	return file_subscription_proto_rawDescGZIP(), []int{36}
+// Deprecated: Use CancelBulkOperationReply.ProtoReflect.Descriptor instead.
+func (*CancelBulkOperationReply) Descriptor() ([]byte, []int) {
+	return file_subscription_proto_rawDescGZIP(), []int{131}
 }
 
-func (x *DeletePlanPricingRequest) GetPlanPricingId() uint64 {
+func (x *CancelBulkOperationReply) GetOperation() *BulkOperation {
 	if x != nil {
-		return x.PlanPricingId
+		return x.Operation
 	}
-	return 0
+	return nil
 }
 
-type DeletePlanPricingReply struct {
+type SaveExchangeRatesRequest_Item struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	PlanPricingId uint64                 `protobuf:"varint,1,opt,name=planPricingId,proto3" json:"planPricingId,omitempty"` // 被删除的区域定价ID
+	FromCurrency  string                 `protobuf:"bytes,1,opt,name=fromCurrency,proto3" json:"fromCurrency,omitempty"`
+	ToCurrency    string                 `protobuf:"bytes,2,opt,name=toCurrency,proto3" json:"toCurrency,omitempty"`
+	Rate          float64                `protobuf:"fixed64,3,opt,name=rate,proto3" json:"rate,omitempty"`
+	EffectiveAt   int64                  `protobuf:"varint,4,opt,name=effectiveAt,proto3" json:"effectiveAt,omitempty"` // 生效时间，为 0 表示当前时间
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeletePlanPricingReply) Reset() {
-	*x = DeletePlanPricingReply{}
-	mi := &file_subscription_proto_msgTypes[37]
+func (x *SaveExchangeRatesRequest_Item) Reset() {
+	*x = SaveExchangeRatesRequest_Item{}
+	mi := &file_subscription_proto_msgTypes[132]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeletePlanPricingReply) String() string {
+func (x *SaveExchangeRatesRequest_Item) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeletePlanPricingReply) ProtoMessage() {}
+func (*SaveExchangeRatesRequest_Item) ProtoMessage() {}
 
-func (x *DeletePlanPricingReply) ProtoReflect() protoreflect.Message {
-	mi := &file_subscription_proto_msgTypes[37]
+func (x *SaveExchangeRatesRequest_Item) ProtoReflect() protoreflect.Message {
+	mi := &file_subscription_proto_msgTypes[132]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2301,14 +9407,35 @@ func (x *DeletePlanPricingReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeletePlanPricingReply.ProtoReflect.Descriptor instead.
-func (*DeletePlanPricingReply) Descriptor() ([]byte, []int) {
-	return file_subscription_proto_rawDescGZIP(), []int{37}
+// Deprecated: Use SaveExchangeRatesRequest_Item.ProtoReflect.Descriptor instead.
+func (*SaveExchangeRatesRequest_Item) Descriptor() ([]byte, []int) {
+	return file_subscription_proto_rawDescGZIP(), []int{82, 0}
 }
 
-func (x *DeletePlanPricingReply) GetPlanPricingId() uint64 {
+func (x *SaveExchangeRatesRequest_Item) GetFromCurrency() string {
 	if x != nil {
-		return x.PlanPricingId
+		return x.FromCurrency
+	}
+	return ""
+}
+
+func (x *SaveExchangeRatesRequest_Item) GetToCurrency() string {
+	if x != nil {
+		return x.ToCurrency
+	}
+	return ""
+}
+
+func (x *SaveExchangeRatesRequest_Item) GetRate() float64 {
+	if x != nil {
+		return x.Rate
+	}
+	return 0
+}
+
+func (x *SaveExchangeRatesRequest_Item) GetEffectiveAt() int64 {
+	if x != nil {
+		return x.EffectiveAt
 	}
 	return 0
 }
@@ -2317,7 +9444,7 @@ var File_subscription_proto protoreflect.FileDescriptor
 
 const file_subscription_proto_rawDesc = "" +
 	"\n" +
-	"\x12subscription.proto\x12\x0fsubscription.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x17validate/validate.proto\x1a\x1bgoogle/protobuf/empty.proto\"\xd4\x01\n" +
+	"\x12subscription.proto\x12\x0fsubscription.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x17validate/validate.proto\x1a\x1bgoogle/protobuf/empty.proto\"\xc0\x02\n" +
 	"\x04Plan\x12\x16\n" +
 	"\x06planId\x18\x01 \x01(\tR\x06planId\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
@@ -2326,18 +9453,25 @@ const file_subscription_proto_rawDesc = "" +
 	"\bcurrency\x18\x05 \x01(\tR\bcurrency\x12\"\n" +
 	"\fdurationDays\x18\x06 \x01(\x05R\fdurationDays\x12\x12\n" +
 	"\x04type\x18\a \x01(\tR\x04type\x12\x14\n" +
-	"\x05appId\x18\b \x01(\tR\x05appId\"(\n" +
+	"\x05appId\x18\b \x01(\tR\x05appId\x12\"\n" +
+	"\fintervalUnit\x18\t \x01(\tR\fintervalUnit\x12$\n" +
+	"\rintervalCount\x18\n" +
+	" \x01(\x05R\rintervalCount\x12 \n" +
+	"\vbillingType\x18\v \x01(\tR\vbillingType\"(\n" +
 	"\x10ListPlansRequest\x12\x14\n" +
-	"\x05appId\x18\x01 \x01(\tR\x05appId\"\xea\x01\n" +
+	"\x05appId\x18\x01 \x01(\tR\x05appId\"\x9e\x03\n" +
 	"\x11CreatePlanRequest\x12\x1d\n" +
 	"\x04name\x18\x01 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x18dR\x04name\x12 \n" +
 	"\vdescription\x18\x02 \x01(\tR\vdescription\x12$\n" +
 	"\x05price\x18\x03 \x01(\x01B\x0e\xfaB\v\x12\t)\x00\x00\x00\x00\x00\x00\x00\x00R\x05price\x12$\n" +
 	"\bcurrency\x18\x04 \x01(\tB\b\xfaB\x05r\x03\x98\x01\x03R\bcurrency\x12+\n" +
-	"\fdurationDays\x18\x05 \x01(\x05B\a\xfaB\x04\x1a\x02 \x00R\fdurationDays\x12\x1b\n" +
-	"\x04type\x18\x06 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x04type\"<\n" +
+	"\fdurationDays\x18\x05 \x01(\x05B\a\xfaB\x04\x1a\x02(\x00R\fdurationDays\x12\x1b\n" +
+	"\x04type\x18\x06 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x04type\x12C\n" +
+	"\fintervalUnit\x18\a \x01(\tB\x1f\xfaB\x1cr\x1aR\x00R\x03dayR\x04weekR\x05monthR\x04yearR\fintervalUnit\x12-\n" +
+	"\rintervalCount\x18\b \x01(\x05B\a\xfaB\x04\x1a\x02(\x00R\rintervalCount\x12>\n" +
+	"\vbillingType\x18\t \x01(\tB\x1c\xfaB\x19r\x17R\x00R\trecurringR\blifetimeR\vbillingType\"<\n" +
 	"\x0fCreatePlanReply\x12)\n" +
-	"\x04plan\x18\x01 \x01(\v2\x15.subscription.v1.PlanR\x04plan\"\xd4\x01\n" +
+	"\x04plan\x18\x01 \x01(\v2\x15.subscription.v1.PlanR\x04plan\"\x88\x03\n" +
 	"\x11UpdatePlanRequest\x12\x1f\n" +
 	"\x06planId\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x06planId\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
@@ -2345,7 +9479,11 @@ const file_subscription_proto_rawDesc = "" +
 	"\x05price\x18\x04 \x01(\x01R\x05price\x12\x1a\n" +
 	"\bcurrency\x18\x05 \x01(\tR\bcurrency\x12\"\n" +
 	"\fdurationDays\x18\x06 \x01(\x05R\fdurationDays\x12\x12\n" +
-	"\x04type\x18\a \x01(\tR\x04type\"<\n" +
+	"\x04type\x18\a \x01(\tR\x04type\x12C\n" +
+	"\fintervalUnit\x18\b \x01(\tB\x1f\xfaB\x1cr\x1aR\x00R\x03dayR\x04weekR\x05monthR\x04yearR\fintervalUnit\x12-\n" +
+	"\rintervalCount\x18\t \x01(\x05B\a\xfaB\x04\x1a\x02(\x00R\rintervalCount\x12>\n" +
+	"\vbillingType\x18\n" +
+	" \x01(\tB\x1c\xfaB\x19r\x17R\x00R\trecurringR\blifetimeR\vbillingType\"<\n" +
 	"\x0fUpdatePlanReply\x12)\n" +
 	"\x04plan\x18\x01 \x01(\v2\x15.subscription.v1.PlanR\x04plan\"4\n" +
 	"\x11DeletePlanRequest\x12\x1f\n" +
@@ -2355,29 +9493,82 @@ const file_subscription_proto_rawDesc = "" +
 	"\x0eListPlansReply\x12+\n" +
 	"\x05plans\x18\x01 \x03(\v2\x15.subscription.v1.PlanR\x05plans\"7\n" +
 	"\x18GetMySubscriptionRequest\x12\x1b\n" +
-	"\x03uid\x18\x01 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x18$R\x03uid\"\xba\x01\n" +
+	"\x03uid\x18\x01 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x18$R\x03uid\"\xaa\x02\n" +
 	"\x16GetMySubscriptionReply\x12\x1a\n" +
 	"\bisActive\x18\x01 \x01(\bR\bisActive\x12\x16\n" +
 	"\x06planId\x18\x02 \x01(\tR\x06planId\x12\x1c\n" +
 	"\tstartTime\x18\x03 \x01(\x03R\tstartTime\x12\x18\n" +
 	"\aendTime\x18\x04 \x01(\x03R\aendTime\x12\x16\n" +
 	"\x06status\x18\x05 \x01(\tR\x06status\x12\x1c\n" +
-	"\tautoRenew\x18\x06 \x01(\bR\tautoRenew\"\xb8\x01\n" +
+	"\tautoRenew\x18\x06 \x01(\bR\tautoRenew\x12\x1e\n" +
+	"\n" +
+	"isLifetime\x18\a \x01(\bR\n" +
+	"isLifetime\x12\x16\n" +
+	"\x06isFree\x18\b \x01(\bR\x06isFree\x12\x1a\n" +
+	"\bplanName\x18\t \x01(\tR\bplanName\x12\x1a\n" +
+	"\bplanType\x18\n" +
+	" \x01(\tR\bplanType\"\x81\x02\n" +
 	"\x1eCreateSubscriptionOrderRequest\x12\x1b\n" +
 	"\x03uid\x18\x01 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x18$R\x03uid\x12!\n" +
 	"\x06planId\x18\x02 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x182R\x06planId\x12>\n" +
 	"\rpaymentMethod\x18\x03 \x01(\tB\x18\xfaB\x15r\x13R\x06alipayR\twechatpayR\rpaymentMethod\x12\x16\n" +
-	"\x06region\x18\x04 \x01(\tR\x06region\"\xa6\x01\n" +
+	"\x06region\x18\x04 \x01(\tR\x06region\x12(\n" +
+	"\n" +
+	"quoteToken\x18\x05 \x01(\tB\b\xfaB\x05r\x03\x18\x80\x10R\n" +
+	"quoteToken\x12\x1d\n" +
+	"\x05vatId\x18\x06 \x01(\tB\a\xfaB\x04r\x02\x18\x14R\x05vatId\"\xd4\x02\n" +
 	"\x1cCreateSubscriptionOrderReply\x12\x18\n" +
 	"\aorderId\x18\x01 \x01(\tR\aorderId\x12\x1c\n" +
 	"\tpaymentId\x18\x02 \x01(\tR\tpaymentId\x12\x16\n" +
 	"\x06payUrl\x18\x03 \x01(\tR\x06payUrl\x12\x18\n" +
 	"\apayCode\x18\x04 \x01(\tR\apayCode\x12\x1c\n" +
-	"\tpayParams\x18\x05 \x01(\tR\tpayParams\"\x93\x01\n" +
+	"\tpayParams\x18\x05 \x01(\tR\tpayParams\x12\x16\n" +
+	"\x06amount\x18\x06 \x01(\x01R\x06amount\x12\x1a\n" +
+	"\bcurrency\x18\a \x01(\tR\bcurrency\x12$\n" +
+	"\rpaymentStatus\x18\b \x01(\tR\rpaymentStatus\x12\x1c\n" +
+	"\ttaxAmount\x18\t \x01(\x01R\ttaxAmount\x124\n" +
+	"\btaxLines\x18\n" +
+	" \x03(\v2\x18.subscription.v1.TaxLineR\btaxLines\"\x91\x01\n" +
+	"\x18QuoteSubscriptionRequest\x12\x1b\n" +
+	"\x03uid\x18\x01 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x18$R\x03uid\x12!\n" +
+	"\x06planId\x18\x02 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x182R\x06planId\x12\x16\n" +
+	"\x06region\x18\x03 \x01(\tR\x06region\x12\x1d\n" +
+	"\x05vatId\x18\x04 \x01(\tB\a\xfaB\x04r\x02\x18\x14R\x05vatId\"\xea\x03\n" +
+	"\x16QuoteSubscriptionReply\x12\x16\n" +
+	"\x06region\x18\x01 \x01(\tR\x06region\x12\"\n" +
+	"\fregionSource\x18\x02 \x01(\tR\fregionSource\x12$\n" +
+	"\rpricingRegion\x18\x03 \x01(\tR\rpricingRegion\x12\x1a\n" +
+	"\bcurrency\x18\x04 \x01(\tR\bcurrency\x12\x1a\n" +
+	"\bsubtotal\x18\x05 \x01(\x01R\bsubtotal\x12\x1a\n" +
+	"\bdiscount\x18\x06 \x01(\x01R\bdiscount\x12(\n" +
+	"\x0fprorationCredit\x18\a \x01(\x01R\x0fprorationCredit\x12\x10\n" +
+	"\x03tax\x18\b \x01(\x01R\x03tax\x12\x14\n" +
+	"\x05total\x18\t \x01(\x01R\x05total\x12 \n" +
+	"\vperiodStart\x18\n" +
+	" \x01(\x03R\vperiodStart\x12\x1c\n" +
+	"\tperiodEnd\x18\v \x01(\x03R\tperiodEnd\x12\x1c\n" +
+	"\texpiresAt\x18\f \x01(\x03R\texpiresAt\x12\x1e\n" +
+	"\n" +
+	"quoteToken\x18\r \x01(\tR\n" +
+	"quoteToken\x124\n" +
+	"\btaxLines\x18\x0e \x03(\v2\x18.subscription.v1.TaxLineR\btaxLines\x12\x14\n" +
+	"\x05vatId\x18\x0f \x01(\tR\x05vatId\"\xd5\x01\n" +
+	"\aTaxLine\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
+	"\vcountryCode\x18\x02 \x01(\tR\vcountryCode\x12\x12\n" +
+	"\x04rate\x18\x03 \x01(\x01R\x04rate\x12\x1c\n" +
+	"\tinclusive\x18\x04 \x01(\bR\tinclusive\x12$\n" +
+	"\rreverseCharge\x18\x05 \x01(\bR\rreverseCharge\x12$\n" +
+	"\rtaxableAmount\x18\x06 \x01(\x01R\rtaxableAmount\x12\x16\n" +
+	"\x06amount\x18\a \x01(\x01R\x06amount\"\x93\x01\n" +
 	"\x1bHandlePaymentSuccessRequest\x12#\n" +
 	"\aorderId\x18\x01 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x18dR\aorderId\x12'\n" +
 	"\tpaymentId\x18\x02 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x18dR\tpaymentId\x12&\n" +
-	"\x06amount\x18\x03 \x01(\x01B\x0e\xfaB\v\x12\t!\x00\x00\x00\x00\x00\x00\x00\x00R\x06amount\"P\n" +
+	"\x06amount\x18\x03 \x01(\x01B\x0e\xfaB\v\x12\t!\x00\x00\x00\x00\x00\x00\x00\x00R\x06amount\"\x92\x01\n" +
+	"\x1aHandlePaymentRefundRequest\x12#\n" +
+	"\aorderId\x18\x01 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x18dR\aorderId\x12'\n" +
+	"\tpaymentId\x18\x02 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x18dR\tpaymentId\x12&\n" +
+	"\x06amount\x18\x03 \x01(\x01B\x0e\xfaB\v\x12\t)\x00\x00\x00\x00\x00\x00\x00\x00R\x06amount\"P\n" +
 	"\x19CancelSubscriptionRequest\x12\x1b\n" +
 	"\x03uid\x18\x01 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x18$R\x03uid\x12\x16\n" +
 	"\x06reason\x18\x02 \x01(\tR\x06reason\"O\n" +
@@ -2385,7 +9576,7 @@ const file_subscription_proto_rawDesc = "" +
 	"\x03uid\x18\x01 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x18$R\x03uid\x12\x16\n" +
 	"\x06reason\x18\x02 \x01(\tR\x06reason\"8\n" +
 	"\x19ResumeSubscriptionRequest\x12\x1b\n" +
-	"\x03uid\x18\x01 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x18$R\x03uid\"\xe3\x01\n" +
+	"\x03uid\x18\x01 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x18$R\x03uid\"\x91\x03\n" +
 	"\x17SubscriptionHistoryItem\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x16\n" +
 	"\x06planId\x18\x02 \x01(\tR\x06planId\x12\x1a\n" +
@@ -2394,16 +9585,61 @@ const file_subscription_proto_rawDesc = "" +
 	"\aendTime\x18\x05 \x01(\x03R\aendTime\x12\x16\n" +
 	"\x06status\x18\x06 \x01(\tR\x06status\x12\x16\n" +
 	"\x06action\x18\a \x01(\tR\x06action\x12\x1c\n" +
-	"\tcreatedAt\x18\b \x01(\x03R\tcreatedAt\"l\n" +
+	"\tcreatedAt\x18\b \x01(\x03R\tcreatedAt\x12\x1e\n" +
+	"\n" +
+	"operatorId\x18\t \x01(\tR\n" +
+	"operatorId\x12\x16\n" +
+	"\x06reason\x18\n" +
+	" \x01(\tR\x06reason\x12\x1c\n" +
+	"\tactorType\x18\v \x01(\tR\tactorType\x12\x1c\n" +
+	"\trequestId\x18\f \x01(\tR\trequestId\x128\n" +
+	"\achanges\x18\r \x03(\v2\x1e.subscription.v1.HistoryChangeR\achanges\"S\n" +
+	"\rHistoryChange\x12\x14\n" +
+	"\x05field\x18\x01 \x01(\tR\x05field\x12\x16\n" +
+	"\x06before\x18\x02 \x01(\tR\x06before\x12\x14\n" +
+	"\x05after\x18\x03 \x01(\tR\x05after\"\x84\x02\n" +
 	"\x1dGetSubscriptionHistoryRequest\x12\x1b\n" +
 	"\x03uid\x18\x01 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x18$R\x03uid\x12\x12\n" +
 	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x1a\n" +
-	"\bpageSize\x18\x03 \x01(\x05R\bpageSize\"\xa3\x01\n" +
+	"\bpageSize\x18\x03 \x01(\x05R\bpageSize\x12\x18\n" +
+	"\aactions\x18\x04 \x03(\tR\aactions\x12\x16\n" +
+	"\x06planId\x18\x05 \x01(\tR\x06planId\x12\x14\n" +
+	"\x05appId\x18\x06 \x01(\tR\x05appId\x12\x1c\n" +
+	"\tstartTime\x18\a \x01(\x03R\tstartTime\x12\x18\n" +
+	"\aendTime\x18\b \x01(\x03R\aendTime\x12\x16\n" +
+	"\x06cursor\x18\t \x01(\x04R\x06cursor\"\xc3\x01\n" +
 	"\x1bGetSubscriptionHistoryReply\x12>\n" +
 	"\x05items\x18\x01 \x03(\v2(.subscription.v1.SubscriptionHistoryItemR\x05items\x12\x14\n" +
 	"\x05total\x18\x02 \x01(\x05R\x05total\x12\x12\n" +
 	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1a\n" +
-	"\bpageSize\x18\x04 \x01(\x05R\bpageSize\"P\n" +
+	"\bpageSize\x18\x04 \x01(\x05R\bpageSize\x12\x1e\n" +
+	"\n" +
+	"nextCursor\x18\x05 \x01(\x04R\n" +
+	"nextCursor\"\xdc\x01\n" +
+	"!ListAppSubscriptionHistoryRequest\x12\x19\n" +
+	"\x03uid\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x18$R\x03uid\x12\x18\n" +
+	"\aactions\x18\x02 \x03(\tR\aactions\x12\x16\n" +
+	"\x06planId\x18\x03 \x01(\tR\x06planId\x12\x1c\n" +
+	"\tstartTime\x18\x04 \x01(\x03R\tstartTime\x12\x18\n" +
+	"\aendTime\x18\x05 \x01(\x03R\aendTime\x12\x16\n" +
+	"\x06cursor\x18\x06 \x01(\x04R\x06cursor\x12\x1a\n" +
+	"\bpageSize\x18\a \x01(\x05R\bpageSize\"\x81\x01\n" +
+	"\x1fListAppSubscriptionHistoryReply\x12>\n" +
+	"\x05items\x18\x01 \x03(\v2(.subscription.v1.SubscriptionHistoryItemR\x05items\x12\x1e\n" +
+	"\n" +
+	"nextCursor\x18\x02 \x01(\x04R\n" +
+	"nextCursor\"\xd3\x01\n" +
+	" ExportSubscriptionHistoryRequest\x12*\n" +
+	"\x06format\x18\x01 \x01(\tB\x12\xfaB\x0fr\rR\x03csvR\x06ndjsonR\x06format\x12\x19\n" +
+	"\x03uid\x18\x02 \x01(\tB\a\xfaB\x04r\x02\x18$R\x03uid\x12\x18\n" +
+	"\aactions\x18\x03 \x03(\tR\aactions\x12\x16\n" +
+	"\x06planId\x18\x04 \x01(\tR\x06planId\x12\x1c\n" +
+	"\tstartTime\x18\x05 \x01(\x03R\tstartTime\x12\x18\n" +
+	"\aendTime\x18\x06 \x01(\x03R\aendTime\"x\n" +
+	"\x1eExportSubscriptionHistoryChunk\x12\x18\n" +
+	"\acontent\x18\x01 \x01(\fR\acontent\x12 \n" +
+	"\vcontentType\x18\x02 \x01(\tR\vcontentType\x12\x1a\n" +
+	"\bfileName\x18\x03 \x01(\tR\bfileName\"P\n" +
 	"\x13SetAutoRenewRequest\x12\x1b\n" +
 	"\x03uid\x18\x01 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x18$R\x03uid\x12\x1c\n" +
 	"\tautoRenew\x18\x02 \x01(\bR\tautoRenew\"\x88\x01\n" +
@@ -2444,22 +9680,45 @@ const file_subscription_proto_rawDesc = "" +
 	"totalCount\x12\"\n" +
 	"\fsuccessCount\x18\x02 \x01(\x05R\fsuccessCount\x12 \n" +
 	"\vfailedCount\x18\x03 \x01(\x05R\vfailedCount\x12:\n" +
-	"\aresults\x18\x04 \x03(\v2 .subscription.v1.AutoRenewResultR\aresults\"\x9f\x01\n" +
+	"\aresults\x18\x04 \x03(\v2 .subscription.v1.AutoRenewResultR\aresults\"s\n" +
+	" ProcessPriceChangeNoticesRequest\x127\n" +
+	"\x11daysBeforeRenewal\x18\x01 \x01(\x05B\t\xfaB\x06\x1a\x04\x18<(\x01R\x11daysBeforeRenewal\x12\x16\n" +
+	"\x06dryRun\x18\x02 \x01(\bR\x06dryRun\"\xf5\x01\n" +
+	"\x11PriceChangeNotice\x12\x10\n" +
+	"\x03uid\x18\x01 \x01(\tR\x03uid\x12\x16\n" +
+	"\x06planId\x18\x02 \x01(\tR\x06planId\x12 \n" +
+	"\vcountryCode\x18\x03 \x01(\tR\vcountryCode\x12\x1a\n" +
+	"\boldPrice\x18\x04 \x01(\x01R\boldPrice\x12 \n" +
+	"\voldCurrency\x18\x05 \x01(\tR\voldCurrency\x12\x1a\n" +
+	"\bnewPrice\x18\x06 \x01(\x01R\bnewPrice\x12 \n" +
+	"\vnewCurrency\x18\a \x01(\tR\vnewCurrency\x12\x18\n" +
+	"\arenewAt\x18\b \x01(\x03R\arenewAt\"\xa4\x01\n" +
+	"\x1eProcessPriceChangeNoticesReply\x12\x1e\n" +
+	"\n" +
+	"totalCount\x18\x01 \x01(\x05R\n" +
+	"totalCount\x12$\n" +
+	"\rnotifiedCount\x18\x02 \x01(\x05R\rnotifiedCount\x12<\n" +
+	"\anotices\x18\x03 \x03(\v2\".subscription.v1.PriceChangeNoticeR\anotices\"\xe7\x01\n" +
 	"\vPlanPricing\x12$\n" +
 	"\rplanPricingId\x18\x01 \x01(\x04R\rplanPricingId\x12\x16\n" +
 	"\x06planId\x18\x02 \x01(\tR\x06planId\x12 \n" +
 	"\vcountryCode\x18\x03 \x01(\tR\vcountryCode\x12\x14\n" +
 	"\x05price\x18\x04 \x01(\x01R\x05price\x12\x1a\n" +
-	"\bcurrency\x18\x05 \x01(\tR\bcurrency\":\n" +
+	"\bcurrency\x18\x05 \x01(\tR\bcurrency\x12$\n" +
+	"\reffectiveFrom\x18\x06 \x01(\x03R\reffectiveFrom\x12 \n" +
+	"\veffectiveTo\x18\a \x01(\x03R\veffectiveTo\":\n" +
 	"\x17ListPlanPricingsRequest\x12\x1f\n" +
 	"\x06planId\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x06planId\"Q\n" +
 	"\x15ListPlanPricingsReply\x128\n" +
-	"\bpricings\x18\x01 \x03(\v2\x1c.subscription.v1.PlanPricingR\bpricings\"\xb3\x01\n" +
+	"\bpricings\x18\x01 \x03(\v2\x1c.subscription.v1.PlanPricingR\bpricings\"\x8e\x02\n" +
 	"\x18CreatePlanPricingRequest\x12\x1f\n" +
-	"\x06planId\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x06planId\x12*\n" +
-	"\vcountryCode\x18\x02 \x01(\tB\b\xfaB\x05r\x03\x98\x01\x02R\vcountryCode\x12$\n" +
+	"\x06planId\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x06planId\x12+\n" +
+	"\vcountryCode\x18\x02 \x01(\tB\t\xfaB\x06r\x04\x10\x02\x18\n" +
+	"R\vcountryCode\x12$\n" +
 	"\x05price\x18\x03 \x01(\x01B\x0e\xfaB\v\x12\t!\x00\x00\x00\x00\x00\x00\x00\x00R\x05price\x12$\n" +
-	"\bcurrency\x18\x04 \x01(\tB\b\xfaB\x05r\x03\x98\x01\x03R\bcurrency\"P\n" +
+	"\bcurrency\x18\x04 \x01(\tB\b\xfaB\x05r\x03\x98\x01\x03R\bcurrency\x12-\n" +
+	"\reffectiveFrom\x18\x05 \x01(\x03B\a\xfaB\x04\"\x02(\x00R\reffectiveFrom\x12)\n" +
+	"\veffectiveTo\x18\x06 \x01(\x03B\a\xfaB\x04\"\x02(\x00R\veffectiveTo\"P\n" +
 	"\x16CreatePlanPricingReply\x126\n" +
 	"\apricing\x18\x01 \x01(\v2\x1c.subscription.v1.PlanPricingR\apricing\"\x95\x01\n" +
 	"\x18UpdatePlanPricingRequest\x12-\n" +
@@ -2471,20 +9730,530 @@ const file_subscription_proto_rawDesc = "" +
 	"\x18DeletePlanPricingRequest\x12-\n" +
 	"\rplanPricingId\x18\x01 \x01(\x04B\a\xfaB\x042\x02 \x00R\rplanPricingId\">\n" +
 	"\x16DeletePlanPricingReply\x12$\n" +
-	"\rplanPricingId\x18\x01 \x01(\x04R\rplanPricingId2\xa1\x15\n" +
+	"\rplanPricingId\x18\x01 \x01(\x04R\rplanPricingId\"\xc6\x01\n" +
+	"\n" +
+	"AppSetting\x12\x14\n" +
+	"\x05appId\x18\x01 \x01(\tR\x05appId\x12,\n" +
+	"\x11defaultFreePlanId\x18\x02 \x01(\tR\x11defaultFreePlanId\x12\x1c\n" +
+	"\tupdatedAt\x18\x03 \x01(\x03R\tupdatedAt\x12(\n" +
+	"\x0fregionDetection\x18\x04 \x03(\tR\x0fregionDetection\x12,\n" +
+	"\x11reportingCurrency\x18\x05 \x01(\tR\x11reportingCurrency\",\n" +
+	"\x14GetAppSettingRequest\x12\x14\n" +
+	"\x05appId\x18\x01 \x01(\tR\x05appId\"K\n" +
+	"\x12GetAppSettingReply\x125\n" +
+	"\asetting\x18\x01 \x01(\v2\x1b.subscription.v1.AppSettingR\asetting\"\x83\x02\n" +
+	"\x17UpdateAppSettingRequest\x125\n" +
+	"\x11defaultFreePlanId\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x182R\x11defaultFreePlanId\x12z\n" +
+	"\x0fregionDetection\x18\x02 \x03(\tBP\xfaBM\x92\x01J\x10\x05\x18\x01\"DrBR\fuser_profileR\x05geoipR\x0epassport_geoipR\x0faccept_languageR\n" +
+	"x_languageR\x0fregionDetection\x125\n" +
+	"\x11reportingCurrency\x18\x03 \x01(\tB\a\xfaB\x04r\x02\x18\x03R\x11reportingCurrency\"N\n" +
+	"\x15UpdateAppSettingReply\x125\n" +
+	"\asetting\x18\x01 \x01(\v2\x1b.subscription.v1.AppSettingR\asetting\"\x9d\x01\n" +
+	"\vRegionGroup\x12\x1c\n" +
+	"\tgroupCode\x18\x01 \x01(\tR\tgroupCode\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x1c\n" +
+	"\tcountries\x18\x04 \x03(\tR\tcountries\x12\x1c\n" +
+	"\tupdatedAt\x18\x05 \x01(\x03R\tupdatedAt\"\x19\n" +
+	"\x17ListRegionGroupsRequest\"M\n" +
+	"\x15ListRegionGroupsReply\x124\n" +
+	"\x06groups\x18\x01 \x03(\v2\x1c.subscription.v1.RegionGroupR\x06groups\"@\n" +
+	"\x15GetRegionGroupRequest\x12'\n" +
+	"\tgroupCode\x18\x01 \x01(\tB\t\xfaB\x06r\x04\x10\x02\x18\n" +
+	"R\tgroupCode\"I\n" +
+	"\x13GetRegionGroupReply\x122\n" +
+	"\x05group\x18\x01 \x01(\v2\x1c.subscription.v1.RegionGroupR\x05group\"\xb9\x01\n" +
+	"\x16SaveRegionGroupRequest\x12'\n" +
+	"\tgroupCode\x18\x01 \x01(\tB\t\xfaB\x06r\x04\x10\x02\x18\n" +
+	"R\tgroupCode\x12\x1d\n" +
+	"\x04name\x18\x02 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x18dR\x04name\x12*\n" +
+	"\vdescription\x18\x03 \x01(\tB\b\xfaB\x05r\x03\x18\xff\x01R\vdescription\x12+\n" +
+	"\tcountries\x18\x04 \x03(\tB\r\xfaB\n" +
+	"\x92\x01\a\"\x05r\x03\x98\x01\x02R\tcountries\"J\n" +
+	"\x14SaveRegionGroupReply\x122\n" +
+	"\x05group\x18\x01 \x01(\v2\x1c.subscription.v1.RegionGroupR\x05group\"C\n" +
+	"\x18DeleteRegionGroupRequest\x12'\n" +
+	"\tgroupCode\x18\x01 \x01(\tB\t\xfaB\x06r\x04\x10\x02\x18\n" +
+	"R\tgroupCode\"6\n" +
+	"\x16DeleteRegionGroupReply\x12\x1c\n" +
+	"\tgroupCode\x18\x01 \x01(\tR\tgroupCode\"\xef\x04\n" +
+	"\aInvoice\x12\x1c\n" +
+	"\tinvoiceId\x18\x01 \x01(\x04R\tinvoiceId\x12\x1c\n" +
+	"\tinvoiceNo\x18\x02 \x01(\tR\tinvoiceNo\x12 \n" +
+	"\vinvoiceType\x18\x03 \x01(\tR\vinvoiceType\x12\x10\n" +
+	"\x03uid\x18\x04 \x01(\tR\x03uid\x12\x18\n" +
+	"\aorderId\x18\x05 \x01(\tR\aorderId\x12,\n" +
+	"\x11originalInvoiceNo\x18\x06 \x01(\tR\x11originalInvoiceNo\x12\x16\n" +
+	"\x06planId\x18\a \x01(\tR\x06planId\x12\x1a\n" +
+	"\bplanName\x18\b \x01(\tR\bplanName\x12 \n" +
+	"\vcountryCode\x18\t \x01(\tR\vcountryCode\x12\x14\n" +
+	"\x05vatId\x18\n" +
+	" \x01(\tR\x05vatId\x12\x1a\n" +
+	"\bcurrency\x18\v \x01(\tR\bcurrency\x12\x1a\n" +
+	"\bsubtotal\x18\f \x01(\x01R\bsubtotal\x12\x1a\n" +
+	"\bdiscount\x18\r \x01(\x01R\bdiscount\x12(\n" +
+	"\x0fprorationCredit\x18\x0e \x01(\x01R\x0fprorationCredit\x12\x1c\n" +
+	"\ttaxAmount\x18\x0f \x01(\x01R\ttaxAmount\x12\x14\n" +
+	"\x05total\x18\x10 \x01(\x01R\x05total\x12 \n" +
+	"\vperiodStart\x18\x11 \x01(\x03R\vperiodStart\x12\x1c\n" +
+	"\tperiodEnd\x18\x12 \x01(\x03R\tperiodEnd\x12\x1a\n" +
+	"\bissuedAt\x18\x13 \x01(\x03R\bissuedAt\x122\n" +
+	"\x05lines\x18\x14 \x03(\v2\x1c.subscription.v1.InvoiceLineR\x05lines\"\x81\x02\n" +
+	"\vInvoiceLine\x12\x1a\n" +
+	"\blineType\x18\x01 \x01(\tR\blineType\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12 \n" +
+	"\vperiodStart\x18\x03 \x01(\x03R\vperiodStart\x12\x1c\n" +
+	"\tperiodEnd\x18\x04 \x01(\x03R\tperiodEnd\x12\x18\n" +
+	"\ataxRate\x18\x05 \x01(\x01R\ataxRate\x12\x1c\n" +
+	"\tinclusive\x18\x06 \x01(\bR\tinclusive\x12$\n" +
+	"\rreverseCharge\x18\a \x01(\bR\rreverseCharge\x12\x16\n" +
+	"\x06amount\x18\b \x01(\x01R\x06amount\"\x8b\x01\n" +
+	"\x11GetInvoiceRequest\x12%\n" +
+	"\tinvoiceId\x18\x01 \x01(\x04B\a\xfaB\x042\x02 \x00R\tinvoiceId\x12*\n" +
+	"\x06format\x18\x02 \x01(\tB\x12\xfaB\x0fr\rR\x00R\x04htmlR\x03pdfR\x06format\x12#\n" +
+	"\blanguage\x18\x03 \x01(\tB\a\xfaB\x04r\x02\x18\n" +
+	"R\blanguage\"\x9d\x01\n" +
+	"\x0fGetInvoiceReply\x122\n" +
+	"\ainvoice\x18\x01 \x01(\v2\x18.subscription.v1.InvoiceR\ainvoice\x12\x18\n" +
+	"\acontent\x18\x02 \x01(\fR\acontent\x12 \n" +
+	"\vcontentType\x18\x03 \x01(\tR\vcontentType\x12\x1a\n" +
+	"\bfileName\x18\x04 \x01(\tR\bfileName\"b\n" +
+	"\x13ListInvoicesRequest\x12\x1b\n" +
+	"\x03uid\x18\x01 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x18$R\x03uid\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x1a\n" +
+	"\bpageSize\x18\x03 \x01(\x05R\bpageSize\"\x89\x01\n" +
+	"\x11ListInvoicesReply\x12.\n" +
+	"\x05items\x18\x01 \x03(\v2\x18.subscription.v1.InvoiceR\x05items\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1a\n" +
+	"\bpageSize\x18\x04 \x01(\x05R\bpageSize\"\xed\x01\n" +
+	"\aTaxRule\x12\x1c\n" +
+	"\ttaxRuleId\x18\x01 \x01(\x04R\ttaxRuleId\x12 \n" +
+	"\vcountryCode\x18\x02 \x01(\tR\vcountryCode\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x12\n" +
+	"\x04rate\x18\x04 \x01(\x01R\x04rate\x12\x1c\n" +
+	"\tinclusive\x18\x05 \x01(\bR\tinclusive\x12$\n" +
+	"\rreverseCharge\x18\x06 \x01(\bR\rreverseCharge\x12\x18\n" +
+	"\aenabled\x18\a \x01(\bR\aenabled\x12\x1c\n" +
+	"\tupdatedAt\x18\b \x01(\x03R\tupdatedAt\"@\n" +
+	"\x13ListTaxRulesRequest\x12)\n" +
+	"\vcountryCode\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x18\x02R\vcountryCode\"C\n" +
+	"\x11ListTaxRulesReply\x12.\n" +
+	"\x05rules\x18\x01 \x03(\v2\x18.subscription.v1.TaxRuleR\x05rules\"\xec\x01\n" +
+	"\x14CreateTaxRuleRequest\x12*\n" +
+	"\vcountryCode\x18\x01 \x01(\tB\b\xfaB\x05r\x03\x98\x01\x02R\vcountryCode\x12\x1d\n" +
+	"\x04name\x18\x02 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x182R\x04name\x12+\n" +
+	"\x04rate\x18\x03 \x01(\x01B\x17\xfaB\x14\x12\x12\x11\x00\x00\x00\x00\x00\x00\xf0?)\x00\x00\x00\x00\x00\x00\x00\x00R\x04rate\x12\x1c\n" +
+	"\tinclusive\x18\x04 \x01(\bR\tinclusive\x12$\n" +
+	"\rreverseCharge\x18\x05 \x01(\bR\rreverseCharge\x12\x18\n" +
+	"\aenabled\x18\x06 \x01(\bR\aenabled\"B\n" +
+	"\x12CreateTaxRuleReply\x12,\n" +
+	"\x04rule\x18\x01 \x01(\v2\x18.subscription.v1.TaxRuleR\x04rule\"\xe7\x01\n" +
+	"\x14UpdateTaxRuleRequest\x12%\n" +
+	"\ttaxRuleId\x18\x01 \x01(\x04B\a\xfaB\x042\x02 \x00R\ttaxRuleId\x12\x1d\n" +
+	"\x04name\x18\x02 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x182R\x04name\x12+\n" +
+	"\x04rate\x18\x03 \x01(\x01B\x17\xfaB\x14\x12\x12\x11\x00\x00\x00\x00\x00\x00\xf0?)\x00\x00\x00\x00\x00\x00\x00\x00R\x04rate\x12\x1c\n" +
+	"\tinclusive\x18\x04 \x01(\bR\tinclusive\x12$\n" +
+	"\rreverseCharge\x18\x05 \x01(\bR\rreverseCharge\x12\x18\n" +
+	"\aenabled\x18\x06 \x01(\bR\aenabled\"B\n" +
+	"\x12UpdateTaxRuleReply\x12,\n" +
+	"\x04rule\x18\x01 \x01(\v2\x18.subscription.v1.TaxRuleR\x04rule\"=\n" +
+	"\x14DeleteTaxRuleRequest\x12%\n" +
+	"\ttaxRuleId\x18\x01 \x01(\x04B\a\xfaB\x042\x02 \x00R\ttaxRuleId\"2\n" +
+	"\x12DeleteTaxRuleReply\x12\x1c\n" +
+	"\ttaxRuleId\x18\x01 \x01(\x04R\ttaxRuleId\"\xe6\x01\n" +
+	"\fExchangeRate\x12&\n" +
+	"\x0eexchangeRateId\x18\x01 \x01(\x04R\x0eexchangeRateId\x12\"\n" +
+	"\ffromCurrency\x18\x02 \x01(\tR\ffromCurrency\x12\x1e\n" +
+	"\n" +
+	"toCurrency\x18\x03 \x01(\tR\n" +
+	"toCurrency\x12\x12\n" +
+	"\x04rate\x18\x04 \x01(\x01R\x04rate\x12 \n" +
+	"\veffectiveAt\x18\x05 \x01(\x03R\veffectiveAt\x12\x16\n" +
+	"\x06source\x18\x06 \x01(\tR\x06source\x12\x1c\n" +
+	"\tcreatedAt\x18\a \x01(\x03R\tcreatedAt\"\xa0\x01\n" +
+	"\x18ListExchangeRatesRequest\x12+\n" +
+	"\ffromCurrency\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x18\x03R\ffromCurrency\x12'\n" +
+	"\n" +
+	"toCurrency\x18\x02 \x01(\tB\a\xfaB\x04r\x02\x18\x03R\n" +
+	"toCurrency\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1a\n" +
+	"\bpageSize\x18\x04 \x01(\x05R\bpageSize\"\x93\x01\n" +
+	"\x16ListExchangeRatesReply\x123\n" +
+	"\x05items\x18\x01 \x03(\v2\x1d.subscription.v1.ExchangeRateR\x05items\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1a\n" +
+	"\bpageSize\x18\x04 \x01(\x05R\bpageSize\"\x94\x02\n" +
+	"\x18SaveExchangeRatesRequest\x12Q\n" +
+	"\x05rates\x18\x01 \x03(\v2..subscription.v1.SaveExchangeRatesRequest.ItemB\v\xfaB\b\x92\x01\x05\b\x01\x10\xf4\x03R\x05rates\x1a\xa4\x01\n" +
+	"\x04Item\x12,\n" +
+	"\ffromCurrency\x18\x01 \x01(\tB\b\xfaB\x05r\x03\x98\x01\x03R\ffromCurrency\x12(\n" +
+	"\n" +
+	"toCurrency\x18\x02 \x01(\tB\b\xfaB\x05r\x03\x98\x01\x03R\n" +
+	"toCurrency\x12\"\n" +
+	"\x04rate\x18\x03 \x01(\x01B\x0e\xfaB\v\x12\t!\x00\x00\x00\x00\x00\x00\x00\x00R\x04rate\x12 \n" +
+	"\veffectiveAt\x18\x04 \x01(\x03R\veffectiveAt\".\n" +
+	"\x16SaveExchangeRatesReply\x12\x14\n" +
+	"\x05count\x18\x01 \x01(\x05R\x05count\"6\n" +
+	"\x1aImportExchangeRatesRequest\x12\x18\n" +
+	"\acontent\x18\x01 \x01(\tR\acontent\"0\n" +
+	"\x18ImportExchangeRatesReply\x12\x14\n" +
+	"\x05count\x18\x01 \x01(\x05R\x05count\"Q\n" +
+	"\x17GetRevenueReportRequest\x12\x1c\n" +
+	"\tstartTime\x18\x01 \x01(\x03R\tstartTime\x12\x18\n" +
+	"\aendTime\x18\x02 \x01(\x03R\aendTime\"\x95\x02\n" +
+	"\x0fCurrencyRevenue\x12\x1a\n" +
+	"\bcurrency\x18\x01 \x01(\tR\bcurrency\x12\x1e\n" +
+	"\n" +
+	"orderCount\x18\x02 \x01(\x05R\n" +
+	"orderCount\x12 \n" +
+	"\vgrossAmount\x18\x03 \x01(\x01R\vgrossAmount\x12&\n" +
+	"\x0erefundedAmount\x18\x04 \x01(\x01R\x0erefundedAmount\x12\x1c\n" +
+	"\ttaxAmount\x18\x05 \x01(\x01R\ttaxAmount\x12\x1c\n" +
+	"\tnetAmount\x18\x06 \x01(\x01R\tnetAmount\x12\"\n" +
+	"\fconvertedNet\x18\a \x01(\x01R\fconvertedNet\x12\x1c\n" +
+	"\testimated\x18\b \x01(\bR\testimated\"\x9d\x03\n" +
+	"\x15GetRevenueReportReply\x12\x14\n" +
+	"\x05appId\x18\x01 \x01(\tR\x05appId\x12,\n" +
+	"\x11reportingCurrency\x18\x02 \x01(\tR\x11reportingCurrency\x12\x1c\n" +
+	"\tstartTime\x18\x03 \x01(\x03R\tstartTime\x12\x18\n" +
+	"\aendTime\x18\x04 \x01(\x03R\aendTime\x12\x1e\n" +
+	"\n" +
+	"orderCount\x18\x05 \x01(\x05R\n" +
+	"orderCount\x12 \n" +
+	"\vgrossAmount\x18\x06 \x01(\x01R\vgrossAmount\x12&\n" +
+	"\x0erefundedAmount\x18\a \x01(\x01R\x0erefundedAmount\x12\x1c\n" +
+	"\ttaxAmount\x18\b \x01(\x01R\ttaxAmount\x12\x1c\n" +
+	"\tnetAmount\x18\t \x01(\x01R\tnetAmount\x12@\n" +
+	"\n" +
+	"byCurrency\x18\n" +
+	" \x03(\v2 .subscription.v1.CurrencyRevenueR\n" +
+	"byCurrency\x12 \n" +
+	"\vunconverted\x18\v \x03(\tR\vunconverted\"\x9e\x03\n" +
+	"\x0eMetricSnapshot\x12\"\n" +
+	"\fsnapshotDate\x18\x01 \x01(\tR\fsnapshotDate\x12,\n" +
+	"\x11reportingCurrency\x18\x02 \x01(\tR\x11reportingCurrency\x12,\n" +
+	"\x11activeSubscribers\x18\x03 \x01(\x05R\x11activeSubscribers\x12,\n" +
+	"\x11payingSubscribers\x18\x04 \x01(\x05R\x11payingSubscribers\x12\x10\n" +
+	"\x03mrr\x18\x05 \x01(\x01R\x03mrr\x12\x10\n" +
+	"\x03arr\x18\x06 \x01(\x01R\x03arr\x12&\n" +
+	"\x0enewSubscribers\x18\a \x01(\x05R\x0enewSubscribers\x12.\n" +
+	"\x12churnedSubscribers\x18\b \x01(\x05R\x12churnedSubscribers\x126\n" +
+	"\x16reactivatedSubscribers\x18\t \x01(\x05R\x16reactivatedSubscribers\x12*\n" +
+	"\x10stateUnavailable\x18\n" +
+	" \x01(\bR\x10stateUnavailable\"\x95\x01\n" +
+	"\x1dGetSubscriptionMetricsRequest\x12;\n" +
+	"\tstartDate\x18\x01 \x01(\tB\x1d\xfaB\x1ar\x182\x16^(\\d{4}-\\d{2}-\\d{2})?$R\tstartDate\x127\n" +
+	"\aendDate\x18\x02 \x01(\tB\x1d\xfaB\x1ar\x182\x16^(\\d{4}-\\d{2}-\\d{2})?$R\aendDate\"\x9c\x05\n" +
+	"\x1bGetSubscriptionMetricsReply\x12\x14\n" +
+	"\x05appId\x18\x01 \x01(\tR\x05appId\x12\x1c\n" +
+	"\tstartDate\x18\x02 \x01(\tR\tstartDate\x12\x18\n" +
+	"\aendDate\x18\x03 \x01(\tR\aendDate\x12,\n" +
+	"\x11reportingCurrency\x18\x04 \x01(\tR\x11reportingCurrency\x12,\n" +
+	"\x11activeSubscribers\x18\x05 \x01(\x05R\x11activeSubscribers\x12,\n" +
+	"\x11payingSubscribers\x18\x06 \x01(\x05R\x11payingSubscribers\x12\x10\n" +
+	"\x03mrr\x18\a \x01(\x01R\x03mrr\x12\x10\n" +
+	"\x03arr\x18\b \x01(\x01R\x03arr\x126\n" +
+	"\x16startActiveSubscribers\x18\t \x01(\x05R\x16startActiveSubscribers\x12&\n" +
+	"\x0enewSubscribers\x18\n" +
+	" \x01(\x05R\x0enewSubscribers\x12.\n" +
+	"\x12churnedSubscribers\x18\v \x01(\x05R\x12churnedSubscribers\x126\n" +
+	"\x16reactivatedSubscribers\x18\f \x01(\x05R\x16reactivatedSubscribers\x12\x1c\n" +
+	"\tchurnRate\x18\r \x01(\x01R\tchurnRate\x12$\n" +
+	"\rretentionRate\x18\x0e \x01(\x01R\rretentionRate\x12=\n" +
+	"\tsnapshots\x18\x0f \x03(\v2\x1f.subscription.v1.MetricSnapshotR\tsnapshots\x126\n" +
+	"\x16startPayingSubscribers\x18\x10 \x01(\x05R\x16startPayingSubscribers\"\x92\x01\n" +
+	"\x1aListMetricSnapshotsRequest\x12;\n" +
+	"\tstartDate\x18\x01 \x01(\tB\x1d\xfaB\x1ar\x182\x16^(\\d{4}-\\d{2}-\\d{2})?$R\tstartDate\x127\n" +
+	"\aendDate\x18\x02 \x01(\tB\x1d\xfaB\x1ar\x182\x16^(\\d{4}-\\d{2}-\\d{2})?$R\aendDate\"Y\n" +
+	"\x18ListMetricSnapshotsReply\x12=\n" +
+	"\tsnapshots\x18\x01 \x03(\v2\x1f.subscription.v1.MetricSnapshotR\tsnapshots\"r\n" +
+	"\x1eGenerateMetricSnapshotsRequest\x121\n" +
+	"\x04date\x18\x01 \x01(\tB\x1d\xfaB\x1ar\x182\x16^(\\d{4}-\\d{2}-\\d{2})?$R\x04date\x12\x1d\n" +
+	"\x05appId\x18\x02 \x01(\tB\a\xfaB\x04r\x02\x182R\x05appId\"4\n" +
+	"\x1cGenerateMetricSnapshotsReply\x12\x14\n" +
+	"\x05count\x18\x01 \x01(\x05R\x05count\"a\n" +
+	"\x16GetCohortReportRequest\x12!\n" +
+	"\x06months\x18\x01 \x01(\x05B\t\xfaB\x06\x1a\x04\x18$(\x00R\x06months\x12$\n" +
+	"\x06format\x18\x02 \x01(\tB\f\xfaB\tr\aR\x00R\x03csvR\x06format\"\xc5\x01\n" +
+	"\tCohortRow\x12 \n" +
+	"\vcohortMonth\x18\x01 \x01(\tR\vcohortMonth\x12\x12\n" +
+	"\x04size\x18\x02 \x01(\x05R\x04size\x12\"\n" +
+	"\factiveCounts\x18\x03 \x03(\x05R\factiveCounts\x12\x1c\n" +
+	"\tretention\x18\x04 \x03(\x01R\tretention\x12\x18\n" +
+	"\arevenue\x18\x05 \x01(\x01R\arevenue\x12&\n" +
+	"\x0eaverageRevenue\x18\x06 \x01(\x01R\x0eaverageRevenue\"\xc6\x02\n" +
+	"\x14GetCohortReportReply\x12\x14\n" +
+	"\x05appId\x18\x01 \x01(\tR\x05appId\x12,\n" +
+	"\x11reportingCurrency\x18\x02 \x01(\tR\x11reportingCurrency\x12\x1e\n" +
+	"\n" +
+	"startMonth\x18\x03 \x01(\tR\n" +
+	"startMonth\x12\x1a\n" +
+	"\bendMonth\x18\x04 \x01(\tR\bendMonth\x124\n" +
+	"\acohorts\x18\x05 \x03(\v2\x1a.subscription.v1.CohortRowR\acohorts\x12 \n" +
+	"\vunconverted\x18\x06 \x03(\tR\vunconverted\x12\x18\n" +
+	"\acontent\x18\a \x01(\fR\acontent\x12 \n" +
+	"\vcontentType\x18\b \x01(\tR\vcontentType\x12\x1a\n" +
+	"\bfileName\x18\t \x01(\tR\bfileName\"b\n" +
+	"\x17GetPlanLTVReportRequest\x12!\n" +
+	"\x06months\x18\x01 \x01(\x05B\t\xfaB\x06\x1a\x04\x18$(\x00R\x06months\x12$\n" +
+	"\x06format\x18\x02 \x01(\tB\f\xfaB\tr\aR\x00R\x03csvR\x06format\"\xd5\x03\n" +
+	"\aPlanLTV\x12\x16\n" +
+	"\x06planId\x18\x01 \x01(\tR\x06planId\x12\x1a\n" +
+	"\bplanName\x18\x02 \x01(\tR\bplanName\x12 \n" +
+	"\vbillingType\x18\x03 \x01(\tR\vbillingType\x12 \n" +
+	"\vsubscribers\x18\x04 \x01(\x05R\vsubscribers\x12\x16\n" +
+	"\x06orders\x18\x05 \x01(\x05R\x06orders\x120\n" +
+	"\x13averageOrderRevenue\x18\x06 \x01(\x01R\x13averageOrderRevenue\x12 \n" +
+	"\vrenewalRate\x18\a \x01(\x01R\vrenewalRate\x12\x1c\n" +
+	"\tchurnRate\x18\b \x01(\x01R\tchurnRate\x12(\n" +
+	"\x0fexpectedPeriods\x18\t \x01(\x01R\x0fexpectedPeriods\x12\"\n" +
+	"\festimatedLtv\x18\n" +
+	" \x01(\x01R\festimatedLtv\x126\n" +
+	"\x16observedRevenuePerUser\x18\v \x01(\x01R\x16observedRevenuePerUser\x12\"\n" +
+	"\fendedPeriods\x18\f \x01(\x05R\fendedPeriods\x12\x1e\n" +
+	"\n" +
+	"ltvUnknown\x18\r \x01(\bR\n" +
+	"ltvUnknown\"\xa3\x02\n" +
+	"\x15GetPlanLTVReportReply\x12\x14\n" +
+	"\x05appId\x18\x01 \x01(\tR\x05appId\x12,\n" +
+	"\x11reportingCurrency\x18\x02 \x01(\tR\x11reportingCurrency\x12\x1c\n" +
+	"\tstartTime\x18\x03 \x01(\x03R\tstartTime\x12.\n" +
+	"\x05plans\x18\x04 \x03(\v2\x18.subscription.v1.PlanLTVR\x05plans\x12 \n" +
+	"\vunconverted\x18\x05 \x03(\tR\vunconverted\x12\x18\n" +
+	"\acontent\x18\x06 \x01(\fR\acontent\x12 \n" +
+	"\vcontentType\x18\a \x01(\tR\vcontentType\x12\x1a\n" +
+	"\bfileName\x18\b \x01(\tR\bfileName\"\x94\x03\n" +
+	"\x06JobRun\x12\x1a\n" +
+	"\bjobRunId\x18\x01 \x01(\x04R\bjobRunId\x12\x18\n" +
+	"\ajobName\x18\x02 \x01(\tR\ajobName\x12 \n" +
+	"\vscheduledAt\x18\x03 \x01(\x03R\vscheduledAt\x12\x18\n" +
+	"\atrigger\x18\x04 \x01(\tR\atrigger\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\tR\x06status\x12\x12\n" +
+	"\x04host\x18\x06 \x01(\tR\x04host\x12\x1a\n" +
+	"\battempts\x18\a \x01(\x05R\battempts\x12\x16\n" +
+	"\x06dryRun\x18\b \x01(\bR\x06dryRun\x12\x1c\n" +
+	"\tstartedAt\x18\t \x01(\x03R\tstartedAt\x12\x1e\n" +
+	"\n" +
+	"finishedAt\x18\n" +
+	" \x01(\x03R\n" +
+	"finishedAt\x12\x1e\n" +
+	"\n" +
+	"totalCount\x18\v \x01(\x05R\n" +
+	"totalCount\x12\"\n" +
+	"\fsuccessCount\x18\f \x01(\x05R\fsuccessCount\x12 \n" +
+	"\vfailedCount\x18\r \x01(\x05R\vfailedCount\x12\x14\n" +
+	"\x05error\x18\x0e \x01(\tR\x05error\"\xbe\x02\n" +
+	"\aCronJob\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04spec\x18\x02 \x01(\tR\x04spec\x12&\n" +
+	"\x0etimeoutSeconds\x18\x03 \x01(\x03R\x0etimeoutSeconds\x12\x1e\n" +
+	"\n" +
+	"catchUpAll\x18\x04 \x01(\bR\n" +
+	"catchUpAll\x12\x1c\n" +
+	"\tresumable\x18\x05 \x01(\bR\tresumable\x12\x16\n" +
+	"\x06paused\x18\x06 \x01(\bR\x06paused\x12 \n" +
+	"\vpauseReason\x18\a \x01(\tR\vpauseReason\x12\x1a\n" +
+	"\bpausedAt\x18\b \x01(\x03R\bpausedAt\x12\x1c\n" +
+	"\tnextRunAt\x18\t \x01(\x03R\tnextRunAt\x121\n" +
+	"\alastRun\x18\n" +
+	" \x01(\v2\x17.subscription.v1.JobRunR\alastRun\"\x11\n" +
+	"\x0fListJobsRequest\"U\n" +
+	"\rListJobsReply\x12,\n" +
+	"\x04jobs\x18\x01 \x03(\v2\x18.subscription.v1.CronJobR\x04jobs\x12\x16\n" +
+	"\x06leader\x18\x02 \x01(\bR\x06leader\"J\n" +
+	"\x11TriggerJobRequest\x12\x1d\n" +
+	"\x04name\x18\x01 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x18@R\x04name\x12\x16\n" +
+	"\x06dryRun\x18\x02 \x01(\bR\x06dryRun\"<\n" +
+	"\x0fTriggerJobReply\x12)\n" +
+	"\x03run\x18\x01 \x01(\v2\x17.subscription.v1.JobRunR\x03run\"R\n" +
+	"\x0fPauseJobRequest\x12\x1d\n" +
+	"\x04name\x18\x01 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x18@R\x04name\x12 \n" +
+	"\x06reason\x18\x02 \x01(\tB\b\xfaB\x05r\x03\x18\xff\x01R\x06reason\"1\n" +
+	"\x10ResumeJobRequest\x12\x1d\n" +
+	"\x04name\x18\x01 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x18@R\x04name\"\xbf\x01\n" +
+	"\x12ListJobRunsRequest\x12!\n" +
+	"\ajobName\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x18@R\ajobName\x12B\n" +
+	"\x06status\x18\x02 \x01(\tB*\xfaB'r%R\x00R\arunningR\asuccessR\x06failedR\askippedR\x06status\x12\x1b\n" +
+	"\x04page\x18\x03 \x01(\x05B\a\xfaB\x04\x1a\x02(\x00R\x04page\x12%\n" +
+	"\bpageSize\x18\x04 \x01(\x05B\t\xfaB\x06\x1a\x04\x18d(\x00R\bpageSize\"\x85\x01\n" +
+	"\x10ListJobRunsReply\x12+\n" +
+	"\x04runs\x18\x01 \x03(\v2\x17.subscription.v1.JobRunR\x04runs\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1a\n" +
+	"\bpageSize\x18\x04 \x01(\x05R\bpageSize\"\xb5\x03\n" +
+	"\x13SupportSubscription\x12&\n" +
+	"\x0esubscriptionId\x18\x01 \x01(\x04R\x0esubscriptionId\x12\x10\n" +
+	"\x03uid\x18\x02 \x01(\tR\x03uid\x12\x16\n" +
+	"\x06planId\x18\x03 \x01(\tR\x06planId\x12\x14\n" +
+	"\x05appId\x18\x04 \x01(\tR\x05appId\x12 \n" +
+	"\vcountryCode\x18\x05 \x01(\tR\vcountryCode\x12\x16\n" +
+	"\x06status\x18\x06 \x01(\tR\x06status\x12\x1c\n" +
+	"\tstartTime\x18\a \x01(\x03R\tstartTime\x12\x18\n" +
+	"\aendTime\x18\b \x01(\x03R\aendTime\x12\x1c\n" +
+	"\tautoRenew\x18\t \x01(\bR\tautoRenew\x12\x18\n" +
+	"\aorderId\x18\n" +
+	" \x01(\tR\aorderId\x12\x18\n" +
+	"\aversion\x18\v \x01(\x05R\aversion\x12\x1c\n" +
+	"\tcreatedAt\x18\f \x01(\x03R\tcreatedAt\x12\x1c\n" +
+	"\tupdatedAt\x18\r \x01(\x03R\tupdatedAt\x12\x16\n" +
+	"\x06source\x18\x0e \x01(\tR\x06source\x12\x1e\n" +
+	"\n" +
+	"nextPlanId\x18\x0f \x01(\tR\n" +
+	"nextPlanId\"\x9f\x03\n" +
+	"\x1aSearchSubscriptionsRequest\x12\x19\n" +
+	"\x03uid\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x18$R\x03uid\x12\x1d\n" +
+	"\x05appId\x18\x02 \x01(\tB\a\xfaB\x04r\x02\x182R\x05appId\x12\x1f\n" +
+	"\x06planId\x18\x03 \x01(\tB\a\xfaB\x04r\x02\x182R\x06planId\x12C\n" +
+	"\x06status\x18\x04 \x01(\tB+\xfaB(r&R\x00R\x06activeR\aexpiredR\x06pausedR\tcancelledR\x06status\x12S\n" +
+	"\ttimeField\x18\x05 \x01(\tB5\xfaB2r0R\x00R\n" +
+	"start_timeR\bend_timeR\n" +
+	"created_atR\n" +
+	"updated_atR\ttimeField\x12%\n" +
+	"\tstartTime\x18\x06 \x01(\x03B\a\xfaB\x04\"\x02(\x00R\tstartTime\x12!\n" +
+	"\aendTime\x18\a \x01(\x03B\a\xfaB\x04\"\x02(\x00R\aendTime\x12\x1b\n" +
+	"\x04page\x18\b \x01(\x05B\a\xfaB\x04\x1a\x02(\x00R\x04page\x12%\n" +
+	"\bpageSize\x18\t \x01(\x05B\t\xfaB\x06\x1a\x04\x18d(\x00R\bpageSize\"\xac\x01\n" +
+	"\x18SearchSubscriptionsReply\x12J\n" +
+	"\rsubscriptions\x18\x01 \x03(\v2$.subscription.v1.SupportSubscriptionR\rsubscriptions\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1a\n" +
+	"\bpageSize\x18\x04 \x01(\x05R\bpageSize\"\x84\x03\n" +
+	"\fSupportOrder\x12\x18\n" +
+	"\aorderId\x18\x01 \x01(\tR\aorderId\x12\x1c\n" +
+	"\tpaymentId\x18\x02 \x01(\tR\tpaymentId\x12\x16\n" +
+	"\x06planId\x18\x03 \x01(\tR\x06planId\x12\x14\n" +
+	"\x05appId\x18\x04 \x01(\tR\x05appId\x12\x1a\n" +
+	"\bcurrency\x18\x05 \x01(\tR\bcurrency\x12\x16\n" +
+	"\x06amount\x18\x06 \x01(\x01R\x06amount\x12&\n" +
+	"\x0erefundedAmount\x18\a \x01(\x01R\x0erefundedAmount\x12$\n" +
+	"\rpaymentStatus\x18\b \x01(\tR\rpaymentStatus\x12 \n" +
+	"\vperiodStart\x18\t \x01(\x03R\vperiodStart\x12\x1c\n" +
+	"\tperiodEnd\x18\n" +
+	" \x01(\x03R\tperiodEnd\x12\x16\n" +
+	"\x06paidAt\x18\v \x01(\x03R\x06paidAt\x12\x1c\n" +
+	"\tcreatedAt\x18\f \x01(\x03R\tcreatedAt\x12\x16\n" +
+	"\x06source\x18\r \x01(\tR\x06source\"\xa3\x02\n" +
+	"\rTimelineEvent\x12\x12\n" +
+	"\x04time\x18\x01 \x01(\x03R\x04time\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x16\n" +
+	"\x06action\x18\x03 \x01(\tR\x06action\x12\x16\n" +
+	"\x06planId\x18\x04 \x01(\tR\x06planId\x12\x18\n" +
+	"\aorderId\x18\x05 \x01(\tR\aorderId\x12\x16\n" +
+	"\x06status\x18\x06 \x01(\tR\x06status\x12\x16\n" +
+	"\x06amount\x18\a \x01(\x01R\x06amount\x12\x1a\n" +
+	"\bcurrency\x18\b \x01(\tR\bcurrency\x12\x1e\n" +
+	"\n" +
+	"operatorId\x18\t \x01(\tR\n" +
+	"operatorId\x12\x16\n" +
+	"\x06reason\x18\n" +
+	" \x01(\tR\x06reason\x12\x1c\n" +
+	"\tactorType\x18\v \x01(\tR\tactorType\"5\n" +
+	"\x16GetUserTimelineRequest\x12\x1b\n" +
+	"\x03uid\x18\x01 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x18$R\x03uid\"\x93\x02\n" +
+	"\x14GetUserTimelineReply\x12H\n" +
+	"\fsubscription\x18\x01 \x01(\v2$.subscription.v1.SupportSubscriptionR\fsubscription\x125\n" +
+	"\x06orders\x18\x02 \x03(\v2\x1d.subscription.v1.SupportOrderR\x06orders\x12B\n" +
+	"\ahistory\x18\x03 \x03(\v2(.subscription.v1.SubscriptionHistoryItemR\ahistory\x126\n" +
+	"\x06events\x18\x04 \x03(\v2\x1e.subscription.v1.TimelineEventR\x06events\"\x87\x01\n" +
+	"\x19AdjustSubscriptionRequest\x12\x1b\n" +
+	"\x03uid\x18\x01 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x18$R\x03uid\x12)\n" +
+	"\x04days\x18\x02 \x01(\x05B\x15\xfaB\x12\x1a\x10\x18\xc2\x1c(\xbe\xe3\xff\xff\xff\xff\xff\xff\xff\x018\x00R\x04days\x12\"\n" +
+	"\x06reason\x18\x03 \x01(\tB\n" +
+	"\xfaB\ar\x05\x10\x01\x18\xff\x01R\x06reason\"c\n" +
+	"\x17AdjustSubscriptionReply\x12H\n" +
+	"\fsubscription\x18\x01 \x01(\v2$.subscription.v1.SupportSubscriptionR\fsubscription\"\xed\x01\n" +
+	"\x18GrantSubscriptionRequest\x12\x1b\n" +
+	"\x03uid\x18\x01 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x18$R\x03uid\x12!\n" +
+	"\x06planId\x18\x02 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x182R\x06planId\x12\x1e\n" +
+	"\x04days\x18\x03 \x01(\x05B\n" +
+	"\xfaB\a\x1a\x05\x18\xc2\x1c(\x00R\x04days\x12\"\n" +
+	"\x06reason\x18\x04 \x01(\tB\n" +
+	"\xfaB\ar\x05\x10\x01\x18\xff\x01R\x06reason\x12+\n" +
+	"\x06source\x18\x05 \x01(\tB\x13\xfaB\x10r\x0eR\x04compR\x06manualR\x06source\x12 \n" +
+	"\vcreateOrder\x18\x06 \x01(\bR\vcreateOrder\"\x97\x01\n" +
+	"\x16GrantSubscriptionReply\x12H\n" +
+	"\fsubscription\x18\x01 \x01(\v2$.subscription.v1.SupportSubscriptionR\fsubscription\x123\n" +
+	"\x05order\x18\x02 \x01(\v2\x1d.subscription.v1.SupportOrderR\x05order\"\xcf\x04\n" +
+	"\rBulkOperation\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x14\n" +
+	"\x05appId\x18\x03 \x01(\tR\x05appId\x12\x16\n" +
+	"\x06planId\x18\x04 \x01(\tR\x06planId\x12\"\n" +
+	"\ffilterStatus\x18\x05 \x01(\tR\ffilterStatus\x12\x12\n" +
+	"\x04days\x18\x06 \x01(\x05R\x04days\x12\"\n" +
+	"\ftargetPlanId\x18\a \x01(\tR\ftargetPlanId\x12\x1e\n" +
+	"\n" +
+	"operatorId\x18\b \x01(\tR\n" +
+	"operatorId\x12\x16\n" +
+	"\x06reason\x18\t \x01(\tR\x06reason\x12\x16\n" +
+	"\x06status\x18\n" +
+	" \x01(\tR\x06status\x12\x1e\n" +
+	"\n" +
+	"totalCount\x18\v \x01(\x05R\n" +
+	"totalCount\x12&\n" +
+	"\x0eprocessedCount\x18\f \x01(\x05R\x0eprocessedCount\x12\"\n" +
+	"\fsuccessCount\x18\r \x01(\x05R\fsuccessCount\x12\"\n" +
+	"\fskippedCount\x18\x0e \x01(\x05R\fskippedCount\x12 \n" +
+	"\vfailedCount\x18\x0f \x01(\x05R\vfailedCount\x12\x14\n" +
+	"\x05error\x18\x10 \x01(\tR\x05error\x12\x1c\n" +
+	"\tstartedAt\x18\x11 \x01(\x03R\tstartedAt\x12\x1e\n" +
+	"\n" +
+	"finishedAt\x18\x12 \x01(\x03R\n" +
+	"finishedAt\x12\x1c\n" +
+	"\tcreatedAt\x18\x13 \x01(\x03R\tcreatedAt\x12\x1c\n" +
+	"\tupdatedAt\x18\x14 \x01(\x03R\tupdatedAt\"\xdd\x02\n" +
+	"\x1aCreateBulkOperationRequest\x12/\n" +
+	"\x04type\x18\x01 \x01(\tB\x1b\xfaB\x18r\x16R\x06extendR\fmigrate_planR\x04type\x12\x1f\n" +
+	"\x05appId\x18\x02 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x182R\x05appId\x12\x1f\n" +
+	"\x06planId\x18\x03 \x01(\tB\a\xfaB\x04r\x02\x182R\x06planId\x12C\n" +
+	"\x06status\x18\x04 \x01(\tB+\xfaB(r&R\x00R\x06activeR\aexpiredR\x06pausedR\tcancelledR\x06status\x12\x1e\n" +
+	"\x04days\x18\x05 \x01(\x05B\n" +
+	"\xfaB\a\x1a\x05\x18\xc2\x1c(\x00R\x04days\x12+\n" +
+	"\ftargetPlanId\x18\x06 \x01(\tB\a\xfaB\x04r\x02\x182R\ftargetPlanId\x12\"\n" +
+	"\x06reason\x18\a \x01(\tB\n" +
+	"\xfaB\ar\x05\x10\x01\x18\xff\x01R\x06reason\x12\x16\n" +
+	"\x06dryRun\x18\b \x01(\bR\x06dryRun\"X\n" +
+	"\x18CreateBulkOperationReply\x12<\n" +
+	"\toperation\x18\x01 \x01(\v2\x1e.subscription.v1.BulkOperationR\toperation\"2\n" +
+	"\x17GetBulkOperationRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\x04B\a\xfaB\x042\x02 \x00R\x02id\"U\n" +
+	"\x15GetBulkOperationReply\x12<\n" +
+	"\toperation\x18\x01 \x01(\v2\x1e.subscription.v1.BulkOperationR\toperation\"\xc7\x01\n" +
+	"\x19ListBulkOperationsRequest\x12\x1d\n" +
+	"\x05appId\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x182R\x05appId\x12G\n" +
+	"\x06status\x18\x02 \x01(\tB/\xfaB,r*R\x00R\apendingR\arunningR\tcompletedR\tcancelledR\x06status\x12\x1b\n" +
+	"\x04page\x18\x03 \x01(\x05B\a\xfaB\x04\x1a\x02(\x00R\x04page\x12%\n" +
+	"\bpageSize\x18\x04 \x01(\x05B\t\xfaB\x06\x1a\x04\x18d(\x00R\bpageSize\"\x9f\x01\n" +
+	"\x17ListBulkOperationsReply\x12>\n" +
+	"\n" +
+	"operations\x18\x01 \x03(\v2\x1e.subscription.v1.BulkOperationR\n" +
+	"operations\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1a\n" +
+	"\bpageSize\x18\x04 \x01(\x05R\bpageSize\"5\n" +
+	"\x1aCancelBulkOperationRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\x04B\a\xfaB\x042\x02 \x00R\x02id\"X\n" +
+	"\x18CancelBulkOperationReply\x12<\n" +
+	"\toperation\x18\x01 \x01(\v2\x1e.subscription.v1.BulkOperationR\toperation2\xb93\n" +
 	"\fSubscription\x12o\n" +
 	"\tListPlans\x12!.subscription.v1.ListPlansRequest\x1a\x1f.subscription.v1.ListPlansReply\"\x1e\x82\xd3\xe4\x93\x02\x18\x12\x16/v1/subscription/plans\x12\x8a\x01\n" +
-	"\x11GetMySubscription\x12).subscription.v1.GetMySubscriptionRequest\x1a'.subscription.v1.GetMySubscriptionReply\"!\x82\xd3\xe4\x93\x02\x1b\x12\x19/v1/subscription/my/{uid}\x12\x9c\x01\n" +
+	"\x11GetMySubscription\x12).subscription.v1.GetMySubscriptionRequest\x1a'.subscription.v1.GetMySubscriptionReply\"!\x82\xd3\xe4\x93\x02\x1b\x12\x19/v1/subscription/my/{uid}\x12\x8a\x01\n" +
+	"\x11QuoteSubscription\x12).subscription.v1.QuoteSubscriptionRequest\x1a'.subscription.v1.QuoteSubscriptionReply\"!\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/v1/subscription/quote\x12\x9c\x01\n" +
 	"\x17CreateSubscriptionOrder\x12/.subscription.v1.CreateSubscriptionOrderRequest\x1a-.subscription.v1.CreateSubscriptionOrderReply\"!\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/v1/subscription/order\x12\x89\x01\n" +
-	"\x14HandlePaymentSuccess\x12,.subscription.v1.HandlePaymentSuccessRequest\x1a\x16.google.protobuf.Empty\"+\x82\xd3\xe4\x93\x02%:\x01*\" /v1/subscription/payment/success\x12|\n" +
+	"\x14HandlePaymentSuccess\x12,.subscription.v1.HandlePaymentSuccessRequest\x1a\x16.google.protobuf.Empty\"+\x82\xd3\xe4\x93\x02%:\x01*\" /v1/subscription/payment/success\x12\x86\x01\n" +
+	"\x13HandlePaymentRefund\x12+.subscription.v1.HandlePaymentRefundRequest\x1a\x16.google.protobuf.Empty\"*\x82\xd3\xe4\x93\x02$:\x01*\"\x1f/v1/subscription/payment/refund\x12|\n" +
 	"\x12CancelSubscription\x12*.subscription.v1.CancelSubscriptionRequest\x1a\x16.google.protobuf.Empty\"\"\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/v1/subscription/cancel\x12y\n" +
 	"\x11PauseSubscription\x12).subscription.v1.PauseSubscriptionRequest\x1a\x16.google.protobuf.Empty\"!\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/v1/subscription/pause\x12|\n" +
 	"\x12ResumeSubscription\x12*.subscription.v1.ResumeSubscriptionRequest\x1a\x16.google.protobuf.Empty\"\"\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/v1/subscription/resume\x12\x9e\x01\n" +
-	"\x16GetSubscriptionHistory\x12..subscription.v1.GetSubscriptionHistoryRequest\x1a,.subscription.v1.GetSubscriptionHistoryReply\"&\x82\xd3\xe4\x93\x02 \x12\x1e/v1/subscription/history/{uid}\x12t\n" +
+	"\x16GetSubscriptionHistory\x12..subscription.v1.GetSubscriptionHistoryRequest\x1a,.subscription.v1.GetSubscriptionHistoryReply\"&\x82\xd3\xe4\x93\x02 \x12\x1e/v1/subscription/history/{uid}\x12\xa8\x01\n" +
+	"\x1aListAppSubscriptionHistory\x122.subscription.v1.ListAppSubscriptionHistoryRequest\x1a0.subscription.v1.ListAppSubscriptionHistoryReply\"$\x82\xd3\xe4\x93\x02\x1e\x12\x1c/v1/subscription/app-history\x12\xae\x01\n" +
+	"\x19ExportSubscriptionHistory\x121.subscription.v1.ExportSubscriptionHistoryRequest\x1a/.subscription.v1.ExportSubscriptionHistoryChunk\"+\x82\xd3\xe4\x93\x02%\x12#/v1/subscription/app-history/export0\x01\x12t\n" +
 	"\fSetAutoRenew\x12$.subscription.v1.SetAutoRenewRequest\x1a\x16.google.protobuf.Empty\"&\x82\xd3\xe4\x93\x02 :\x01*\"\x1b/v1/subscription/auto-renew\x12\x9f\x01\n" +
 	"\x18GetExpiringSubscriptions\x120.subscription.v1.GetExpiringSubscriptionsRequest\x1a..subscription.v1.GetExpiringSubscriptionsReply\"!\x82\xd3\xe4\x93\x02\x1b\x12\x19/v1/subscription/expiring\x12\xae\x01\n" +
 	"\x1aUpdateExpiredSubscriptions\x122.subscription.v1.UpdateExpiredSubscriptionsRequest\x1a0.subscription.v1.UpdateExpiredSubscriptionsReply\"*\x82\xd3\xe4\x93\x02$:\x01*\"\x1f/v1/subscription/expired/update\x12\x9d\x01\n" +
-	"\x13ProcessAutoRenewals\x12+.subscription.v1.ProcessAutoRenewalsRequest\x1a).subscription.v1.ProcessAutoRenewalsReply\".\x82\xd3\xe4\x93\x02(:\x01*\"#/v1/subscription/auto-renew/process\x12u\n" +
+	"\x13ProcessAutoRenewals\x12+.subscription.v1.ProcessAutoRenewalsRequest\x1a).subscription.v1.ProcessAutoRenewalsReply\".\x82\xd3\xe4\x93\x02(:\x01*\"#/v1/subscription/auto-renew/process\x12\xb5\x01\n" +
+	"\x19ProcessPriceChangeNotices\x121.subscription.v1.ProcessPriceChangeNoticesRequest\x1a/.subscription.v1.ProcessPriceChangeNoticesReply\"4\x82\xd3\xe4\x93\x02.:\x01*\")/v1/subscription/pricings/notices/process\x12u\n" +
 	"\n" +
 	"CreatePlan\x12\".subscription.v1.CreatePlanRequest\x1a .subscription.v1.CreatePlanReply\"!\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/v1/subscription/plans\x12~\n" +
 	"\n" +
@@ -2494,7 +10263,45 @@ const file_subscription_proto_rawDesc = "" +
 	"\x10ListPlanPricings\x12(.subscription.v1.ListPlanPricingsRequest\x1a&.subscription.v1.ListPlanPricingsReply\"0\x82\xd3\xe4\x93\x02*\x12(/v1/subscription/plans/{planId}/pricings\x12\x9c\x01\n" +
 	"\x11CreatePlanPricing\x12).subscription.v1.CreatePlanPricingRequest\x1a'.subscription.v1.CreatePlanPricingReply\"3\x82\xd3\xe4\x93\x02-:\x01*\"(/v1/subscription/plans/{planId}/pricings\x12\x9d\x01\n" +
 	"\x11UpdatePlanPricing\x12).subscription.v1.UpdatePlanPricingRequest\x1a'.subscription.v1.UpdatePlanPricingReply\"4\x82\xd3\xe4\x93\x02.:\x01*\x1a)/v1/subscription/pricings/{planPricingId}\x12\x9a\x01\n" +
-	"\x11DeletePlanPricing\x12).subscription.v1.DeletePlanPricingRequest\x1a'.subscription.v1.DeletePlanPricingReply\"1\x82\xd3\xe4\x93\x02+*)/v1/subscription/pricings/{planPricingId}B:Z8xinyuan_tech/subscription-service/api/subscription/v1;v1b\x06proto3"
+	"\x11DeletePlanPricing\x12).subscription.v1.DeletePlanPricingRequest\x1a'.subscription.v1.DeletePlanPricingReply\"1\x82\xd3\xe4\x93\x02+*)/v1/subscription/pricings/{planPricingId}\x12\x81\x01\n" +
+	"\rGetAppSetting\x12%.subscription.v1.GetAppSettingRequest\x1a#.subscription.v1.GetAppSettingReply\"$\x82\xd3\xe4\x93\x02\x1e\x12\x1c/v1/subscription/app-setting\x12\x8d\x01\n" +
+	"\x10UpdateAppSetting\x12(.subscription.v1.UpdateAppSettingRequest\x1a&.subscription.v1.UpdateAppSettingReply\"'\x82\xd3\xe4\x93\x02!:\x01*\x1a\x1c/v1/subscription/app-setting\x12\x8c\x01\n" +
+	"\x10ListRegionGroups\x12(.subscription.v1.ListRegionGroupsRequest\x1a&.subscription.v1.ListRegionGroupsReply\"&\x82\xd3\xe4\x93\x02 \x12\x1e/v1/subscription/region-groups\x12\x92\x01\n" +
+	"\x0eGetRegionGroup\x12&.subscription.v1.GetRegionGroupRequest\x1a$.subscription.v1.GetRegionGroupReply\"2\x82\xd3\xe4\x93\x02,\x12*/v1/subscription/region-groups/{groupCode}\x12\x98\x01\n" +
+	"\x0fSaveRegionGroup\x12'.subscription.v1.SaveRegionGroupRequest\x1a%.subscription.v1.SaveRegionGroupReply\"5\x82\xd3\xe4\x93\x02/:\x01*\x1a*/v1/subscription/region-groups/{groupCode}\x12\x9b\x01\n" +
+	"\x11DeleteRegionGroup\x12).subscription.v1.DeleteRegionGroupRequest\x1a'.subscription.v1.DeleteRegionGroupReply\"2\x82\xd3\xe4\x93\x02,**/v1/subscription/region-groups/{groupCode}\x12\x81\x01\n" +
+	"\n" +
+	"GetInvoice\x12\".subscription.v1.GetInvoiceRequest\x1a .subscription.v1.GetInvoiceReply\"-\x82\xd3\xe4\x93\x02'\x12%/v1/subscription/invoices/{invoiceId}\x12{\n" +
+	"\fListInvoices\x12$.subscription.v1.ListInvoicesRequest\x1a\".subscription.v1.ListInvoicesReply\"!\x82\xd3\xe4\x93\x02\x1b\x12\x19/v1/subscription/invoices\x12|\n" +
+	"\fListTaxRules\x12$.subscription.v1.ListTaxRulesRequest\x1a\".subscription.v1.ListTaxRulesReply\"\"\x82\xd3\xe4\x93\x02\x1c\x12\x1a/v1/subscription/tax-rules\x12\x82\x01\n" +
+	"\rCreateTaxRule\x12%.subscription.v1.CreateTaxRuleRequest\x1a#.subscription.v1.CreateTaxRuleReply\"%\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/v1/subscription/tax-rules\x12\x8e\x01\n" +
+	"\rUpdateTaxRule\x12%.subscription.v1.UpdateTaxRuleRequest\x1a#.subscription.v1.UpdateTaxRuleReply\"1\x82\xd3\xe4\x93\x02+:\x01*\x1a&/v1/subscription/tax-rules/{taxRuleId}\x12\x8b\x01\n" +
+	"\rDeleteTaxRule\x12%.subscription.v1.DeleteTaxRuleRequest\x1a#.subscription.v1.DeleteTaxRuleReply\".\x82\xd3\xe4\x93\x02(*&/v1/subscription/tax-rules/{taxRuleId}\x12\x90\x01\n" +
+	"\x11ListExchangeRates\x12).subscription.v1.ListExchangeRatesRequest\x1a'.subscription.v1.ListExchangeRatesReply\"'\x82\xd3\xe4\x93\x02!\x12\x1f/v1/subscription/exchange-rates\x12\x93\x01\n" +
+	"\x11SaveExchangeRates\x12).subscription.v1.SaveExchangeRatesRequest\x1a'.subscription.v1.SaveExchangeRatesReply\"*\x82\xd3\xe4\x93\x02$:\x01*\"\x1f/v1/subscription/exchange-rates\x12\xa0\x01\n" +
+	"\x13ImportExchangeRates\x12+.subscription.v1.ImportExchangeRatesRequest\x1a).subscription.v1.ImportExchangeRatesReply\"1\x82\xd3\xe4\x93\x02+:\x01*\"&/v1/subscription/exchange-rates/import\x12\x8e\x01\n" +
+	"\x10GetRevenueReport\x12(.subscription.v1.GetRevenueReportRequest\x1a&.subscription.v1.GetRevenueReportReply\"(\x82\xd3\xe4\x93\x02\"\x12 /v1/subscription/reports/revenue\x12\x98\x01\n" +
+	"\x16GetSubscriptionMetrics\x12..subscription.v1.GetSubscriptionMetricsRequest\x1a,.subscription.v1.GetSubscriptionMetricsReply\" \x82\xd3\xe4\x93\x02\x1a\x12\x18/v1/subscription/metrics\x12\x99\x01\n" +
+	"\x13ListMetricSnapshots\x12+.subscription.v1.ListMetricSnapshotsRequest\x1a).subscription.v1.ListMetricSnapshotsReply\"*\x82\xd3\xe4\x93\x02$\x12\"/v1/subscription/metrics/snapshots\x12\xa8\x01\n" +
+	"\x17GenerateMetricSnapshots\x12/.subscription.v1.GenerateMetricSnapshotsRequest\x1a-.subscription.v1.GenerateMetricSnapshotsReply\"-\x82\xd3\xe4\x93\x02':\x01*\"\"/v1/subscription/metrics/snapshots\x12\x8b\x01\n" +
+	"\x0fGetCohortReport\x12'.subscription.v1.GetCohortReportRequest\x1a%.subscription.v1.GetCohortReportReply\"(\x82\xd3\xe4\x93\x02\"\x12 /v1/subscription/reports/cohorts\x12\x8a\x01\n" +
+	"\x10GetPlanLTVReport\x12(.subscription.v1.GetPlanLTVReportRequest\x1a&.subscription.v1.GetPlanLTVReportReply\"$\x82\xd3\xe4\x93\x02\x1e\x12\x1c/v1/subscription/reports/ltv2\xdf\x04\n" +
+	"\x11SubscriptionAdmin\x12i\n" +
+	"\bListJobs\x12 .subscription.v1.ListJobsRequest\x1a\x1e.subscription.v1.ListJobsReply\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/v1/admin/cron/jobs\x12\x81\x01\n" +
+	"\n" +
+	"TriggerJob\x12\".subscription.v1.TriggerJobRequest\x1a .subscription.v1.TriggerJobReply\"-\x82\xd3\xe4\x93\x02':\x01*\"\"/v1/admin/cron/jobs/{name}/trigger\x12q\n" +
+	"\bPauseJob\x12 .subscription.v1.PauseJobRequest\x1a\x16.google.protobuf.Empty\"+\x82\xd3\xe4\x93\x02%:\x01*\" /v1/admin/cron/jobs/{name}/pause\x12t\n" +
+	"\tResumeJob\x12!.subscription.v1.ResumeJobRequest\x1a\x16.google.protobuf.Empty\",\x82\xd3\xe4\x93\x02&:\x01*\"!/v1/admin/cron/jobs/{name}/resume\x12r\n" +
+	"\vListJobRuns\x12#.subscription.v1.ListJobRunsRequest\x1a!.subscription.v1.ListJobRunsReply\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/v1/admin/cron/runs2\xf1\t\n" +
+	"\x13SubscriptionSupport\x12\x96\x01\n" +
+	"\x13SearchSubscriptions\x12+.subscription.v1.SearchSubscriptionsRequest\x1a).subscription.v1.SearchSubscriptionsReply\"'\x82\xd3\xe4\x93\x02!\x12\x1f/v1/admin/support/subscriptions\x12\x91\x01\n" +
+	"\x0fGetUserTimeline\x12'.subscription.v1.GetUserTimelineRequest\x1a%.subscription.v1.GetUserTimelineReply\".\x82\xd3\xe4\x93\x02(\x12&/v1/admin/support/users/{uid}/timeline\x12\x9b\x01\n" +
+	"\x12AdjustSubscription\x12*.subscription.v1.AdjustSubscriptionRequest\x1a(.subscription.v1.AdjustSubscriptionReply\"/\x82\xd3\xe4\x93\x02):\x01*\"$/v1/admin/support/users/{uid}/adjust\x12\x97\x01\n" +
+	"\x11GrantSubscription\x12).subscription.v1.GrantSubscriptionRequest\x1a'.subscription.v1.GrantSubscriptionReply\".\x82\xd3\xe4\x93\x02(:\x01*\"#/v1/admin/support/users/{uid}/grant\x12\x9b\x01\n" +
+	"\x13CreateBulkOperation\x12+.subscription.v1.CreateBulkOperationRequest\x1a).subscription.v1.CreateBulkOperationReply\",\x82\xd3\xe4\x93\x02&:\x01*\"!/v1/admin/support/bulk-operations\x12\x94\x01\n" +
+	"\x10GetBulkOperation\x12(.subscription.v1.GetBulkOperationRequest\x1a&.subscription.v1.GetBulkOperationReply\".\x82\xd3\xe4\x93\x02(\x12&/v1/admin/support/bulk-operations/{id}\x12\x95\x01\n" +
+	"\x12ListBulkOperations\x12*.subscription.v1.ListBulkOperationsRequest\x1a(.subscription.v1.ListBulkOperationsReply\")\x82\xd3\xe4\x93\x02#\x12!/v1/admin/support/bulk-operations\x12\xa7\x01\n" +
+	"\x13CancelBulkOperation\x12+.subscription.v1.CancelBulkOperationRequest\x1a).subscription.v1.CancelBulkOperationReply\"8\x82\xd3\xe4\x93\x022:\x01*\"-/v1/admin/support/bulk-operations/{id}/cancelB:Z8xinyuan_tech/subscription-service/api/subscription/v1;v1b\x06proto3"
 
 var (
 	file_subscription_proto_rawDescOnce sync.Once
@@ -2508,7 +10315,7 @@ func file_subscription_proto_rawDescGZIP() []byte {
 	return file_subscription_proto_rawDescData
 }
 
-var file_subscription_proto_msgTypes = make([]protoimpl.MessageInfo, 38)
+var file_subscription_proto_msgTypes = make([]protoimpl.MessageInfo, 133)
 var file_subscription_proto_goTypes = []any{
 	(*Plan)(nil),                              // 0: subscription.v1.Plan
 	(*ListPlansRequest)(nil),                  // 1: subscription.v1.ListPlansRequest
@@ -2523,86 +10330,298 @@ var file_subscription_proto_goTypes = []any{
 	(*GetMySubscriptionReply)(nil),            // 10: subscription.v1.GetMySubscriptionReply
 	(*CreateSubscriptionOrderRequest)(nil),    // 11: subscription.v1.CreateSubscriptionOrderRequest
 	(*CreateSubscriptionOrderReply)(nil),      // 12: subscription.v1.CreateSubscriptionOrderReply
-	(*HandlePaymentSuccessRequest)(nil),       // 13: subscription.v1.HandlePaymentSuccessRequest
-	(*CancelSubscriptionRequest)(nil),         // 14: subscription.v1.CancelSubscriptionRequest
-	(*PauseSubscriptionRequest)(nil),          // 15: subscription.v1.PauseSubscriptionRequest
-	(*ResumeSubscriptionRequest)(nil),         // 16: subscription.v1.ResumeSubscriptionRequest
-	(*SubscriptionHistoryItem)(nil),           // 17: subscription.v1.SubscriptionHistoryItem
-	(*GetSubscriptionHistoryRequest)(nil),     // 18: subscription.v1.GetSubscriptionHistoryRequest
-	(*GetSubscriptionHistoryReply)(nil),       // 19: subscription.v1.GetSubscriptionHistoryReply
-	(*SetAutoRenewRequest)(nil),               // 20: subscription.v1.SetAutoRenewRequest
-	(*GetExpiringSubscriptionsRequest)(nil),   // 21: subscription.v1.GetExpiringSubscriptionsRequest
-	(*SubscriptionInfo)(nil),                  // 22: subscription.v1.SubscriptionInfo
-	(*GetExpiringSubscriptionsReply)(nil),     // 23: subscription.v1.GetExpiringSubscriptionsReply
-	(*UpdateExpiredSubscriptionsRequest)(nil), // 24: subscription.v1.UpdateExpiredSubscriptionsRequest
-	(*UpdateExpiredSubscriptionsReply)(nil),   // 25: subscription.v1.UpdateExpiredSubscriptionsReply
-	(*ProcessAutoRenewalsRequest)(nil),        // 26: subscription.v1.ProcessAutoRenewalsRequest
-	(*AutoRenewResult)(nil),                   // 27: subscription.v1.AutoRenewResult
-	(*ProcessAutoRenewalsReply)(nil),          // 28: subscription.v1.ProcessAutoRenewalsReply
-	(*PlanPricing)(nil),                       // 29: subscription.v1.PlanPricing
-	(*ListPlanPricingsRequest)(nil),           // 30: subscription.v1.ListPlanPricingsRequest
-	(*ListPlanPricingsReply)(nil),             // 31: subscription.v1.ListPlanPricingsReply
-	(*CreatePlanPricingRequest)(nil),          // 32: subscription.v1.CreatePlanPricingRequest
-	(*CreatePlanPricingReply)(nil),            // 33: subscription.v1.CreatePlanPricingReply
-	(*UpdatePlanPricingRequest)(nil),          // 34: subscription.v1.UpdatePlanPricingRequest
-	(*UpdatePlanPricingReply)(nil),            // 35: subscription.v1.UpdatePlanPricingReply
-	(*DeletePlanPricingRequest)(nil),          // 36: subscription.v1.DeletePlanPricingRequest
-	(*DeletePlanPricingReply)(nil),            // 37: subscription.v1.DeletePlanPricingReply
-	(*emptypb.Empty)(nil),                     // 38: google.protobuf.Empty
+	(*QuoteSubscriptionRequest)(nil),          // 13: subscription.v1.QuoteSubscriptionRequest
+	(*QuoteSubscriptionReply)(nil),            // 14: subscription.v1.QuoteSubscriptionReply
+	(*TaxLine)(nil),                           // 15: subscription.v1.TaxLine
+	(*HandlePaymentSuccessRequest)(nil),       // 16: subscription.v1.HandlePaymentSuccessRequest
+	(*HandlePaymentRefundRequest)(nil),        // 17: subscription.v1.HandlePaymentRefundRequest
+	(*CancelSubscriptionRequest)(nil),         // 18: subscription.v1.CancelSubscriptionRequest
+	(*PauseSubscriptionRequest)(nil),          // 19: subscription.v1.PauseSubscriptionRequest
+	(*ResumeSubscriptionRequest)(nil),         // 20: subscription.v1.ResumeSubscriptionRequest
+	(*SubscriptionHistoryItem)(nil),           // 21: subscription.v1.SubscriptionHistoryItem
+	(*HistoryChange)(nil),                     // 22: subscription.v1.HistoryChange
+	(*GetSubscriptionHistoryRequest)(nil),     // 23: subscription.v1.GetSubscriptionHistoryRequest
+	(*GetSubscriptionHistoryReply)(nil),       // 24: subscription.v1.GetSubscriptionHistoryReply
+	(*ListAppSubscriptionHistoryRequest)(nil), // 25: subscription.v1.ListAppSubscriptionHistoryRequest
+	(*ListAppSubscriptionHistoryReply)(nil),   // 26: subscription.v1.ListAppSubscriptionHistoryReply
+	(*ExportSubscriptionHistoryRequest)(nil),  // 27: subscription.v1.ExportSubscriptionHistoryRequest
+	(*ExportSubscriptionHistoryChunk)(nil),    // 28: subscription.v1.ExportSubscriptionHistoryChunk
+	(*SetAutoRenewRequest)(nil),               // 29: subscription.v1.SetAutoRenewRequest
+	(*GetExpiringSubscriptionsRequest)(nil),   // 30: subscription.v1.GetExpiringSubscriptionsRequest
+	(*SubscriptionInfo)(nil),                  // 31: subscription.v1.SubscriptionInfo
+	(*GetExpiringSubscriptionsReply)(nil),     // 32: subscription.v1.GetExpiringSubscriptionsReply
+	(*UpdateExpiredSubscriptionsRequest)(nil), // 33: subscription.v1.UpdateExpiredSubscriptionsRequest
+	(*UpdateExpiredSubscriptionsReply)(nil),   // 34: subscription.v1.UpdateExpiredSubscriptionsReply
+	(*ProcessAutoRenewalsRequest)(nil),        // 35: subscription.v1.ProcessAutoRenewalsRequest
+	(*AutoRenewResult)(nil),                   // 36: subscription.v1.AutoRenewResult
+	(*ProcessAutoRenewalsReply)(nil),          // 37: subscription.v1.ProcessAutoRenewalsReply
+	(*ProcessPriceChangeNoticesRequest)(nil),  // 38: subscription.v1.ProcessPriceChangeNoticesRequest
+	(*PriceChangeNotice)(nil),                 // 39: subscription.v1.PriceChangeNotice
+	(*ProcessPriceChangeNoticesReply)(nil),    // 40: subscription.v1.ProcessPriceChangeNoticesReply
+	(*PlanPricing)(nil),                       // 41: subscription.v1.PlanPricing
+	(*ListPlanPricingsRequest)(nil),           // 42: subscription.v1.ListPlanPricingsRequest
+	(*ListPlanPricingsReply)(nil),             // 43: subscription.v1.ListPlanPricingsReply
+	(*CreatePlanPricingRequest)(nil),          // 44: subscription.v1.CreatePlanPricingRequest
+	(*CreatePlanPricingReply)(nil),            // 45: subscription.v1.CreatePlanPricingReply
+	(*UpdatePlanPricingRequest)(nil),          // 46: subscription.v1.UpdatePlanPricingRequest
+	(*UpdatePlanPricingReply)(nil),            // 47: subscription.v1.UpdatePlanPricingReply
+	(*DeletePlanPricingRequest)(nil),          // 48: subscription.v1.DeletePlanPricingRequest
+	(*DeletePlanPricingReply)(nil),            // 49: subscription.v1.DeletePlanPricingReply
+	(*AppSetting)(nil),                        // 50: subscription.v1.AppSetting
+	(*GetAppSettingRequest)(nil),              // 51: subscription.v1.GetAppSettingRequest
+	(*GetAppSettingReply)(nil),                // 52: subscription.v1.GetAppSettingReply
+	(*UpdateAppSettingRequest)(nil),           // 53: subscription.v1.UpdateAppSettingRequest
+	(*UpdateAppSettingReply)(nil),             // 54: subscription.v1.UpdateAppSettingReply
+	(*RegionGroup)(nil),                       // 55: subscription.v1.RegionGroup
+	(*ListRegionGroupsRequest)(nil),           // 56: subscription.v1.ListRegionGroupsRequest
+	(*ListRegionGroupsReply)(nil),             // 57: subscription.v1.ListRegionGroupsReply
+	(*GetRegionGroupRequest)(nil),             // 58: subscription.v1.GetRegionGroupRequest
+	(*GetRegionGroupReply)(nil),               // 59: subscription.v1.GetRegionGroupReply
+	(*SaveRegionGroupRequest)(nil),            // 60: subscription.v1.SaveRegionGroupRequest
+	(*SaveRegionGroupReply)(nil),              // 61: subscription.v1.SaveRegionGroupReply
+	(*DeleteRegionGroupRequest)(nil),          // 62: subscription.v1.DeleteRegionGroupRequest
+	(*DeleteRegionGroupReply)(nil),            // 63: subscription.v1.DeleteRegionGroupReply
+	(*Invoice)(nil),                           // 64: subscription.v1.Invoice
+	(*InvoiceLine)(nil),                       // 65: subscription.v1.InvoiceLine
+	(*GetInvoiceRequest)(nil),                 // 66: subscription.v1.GetInvoiceRequest
+	(*GetInvoiceReply)(nil),                   // 67: subscription.v1.GetInvoiceReply
+	(*ListInvoicesRequest)(nil),               // 68: subscription.v1.ListInvoicesRequest
+	(*ListInvoicesReply)(nil),                 // 69: subscription.v1.ListInvoicesReply
+	(*TaxRule)(nil),                           // 70: subscription.v1.TaxRule
+	(*ListTaxRulesRequest)(nil),               // 71: subscription.v1.ListTaxRulesRequest
+	(*ListTaxRulesReply)(nil),                 // 72: subscription.v1.ListTaxRulesReply
+	(*CreateTaxRuleRequest)(nil),              // 73: subscription.v1.CreateTaxRuleRequest
+	(*CreateTaxRuleReply)(nil),                // 74: subscription.v1.CreateTaxRuleReply
+	(*UpdateTaxRuleRequest)(nil),              // 75: subscription.v1.UpdateTaxRuleRequest
+	(*UpdateTaxRuleReply)(nil),                // 76: subscription.v1.UpdateTaxRuleReply
+	(*DeleteTaxRuleRequest)(nil),              // 77: subscription.v1.DeleteTaxRuleRequest
+	(*DeleteTaxRuleReply)(nil),                // 78: subscription.v1.DeleteTaxRuleReply
+	(*ExchangeRate)(nil),                      // 79: subscription.v1.ExchangeRate
+	(*ListExchangeRatesRequest)(nil),          // 80: subscription.v1.ListExchangeRatesRequest
+	(*ListExchangeRatesReply)(nil),            // 81: subscription.v1.ListExchangeRatesReply
+	(*SaveExchangeRatesRequest)(nil),          // 82: subscription.v1.SaveExchangeRatesRequest
+	(*SaveExchangeRatesReply)(nil),            // 83: subscription.v1.SaveExchangeRatesReply
+	(*ImportExchangeRatesRequest)(nil),        // 84: subscription.v1.ImportExchangeRatesRequest
+	(*ImportExchangeRatesReply)(nil),          // 85: subscription.v1.ImportExchangeRatesReply
+	(*GetRevenueReportRequest)(nil),           // 86: subscription.v1.GetRevenueReportRequest
+	(*CurrencyRevenue)(nil),                   // 87: subscription.v1.CurrencyRevenue
+	(*GetRevenueReportReply)(nil),             // 88: subscription.v1.GetRevenueReportReply
+	(*MetricSnapshot)(nil),                    // 89: subscription.v1.MetricSnapshot
+	(*GetSubscriptionMetricsRequest)(nil),     // 90: subscription.v1.GetSubscriptionMetricsRequest
+	(*GetSubscriptionMetricsReply)(nil),       // 91: subscription.v1.GetSubscriptionMetricsReply
+	(*ListMetricSnapshotsRequest)(nil),        // 92: subscription.v1.ListMetricSnapshotsRequest
+	(*ListMetricSnapshotsReply)(nil),          // 93: subscription.v1.ListMetricSnapshotsReply
+	(*GenerateMetricSnapshotsRequest)(nil),    // 94: subscription.v1.GenerateMetricSnapshotsRequest
+	(*GenerateMetricSnapshotsReply)(nil),      // 95: subscription.v1.GenerateMetricSnapshotsReply
+	(*GetCohortReportRequest)(nil),            // 96: subscription.v1.GetCohortReportRequest
+	(*CohortRow)(nil),                         // 97: subscription.v1.CohortRow
+	(*GetCohortReportReply)(nil),              // 98: subscription.v1.GetCohortReportReply
+	(*GetPlanLTVReportRequest)(nil),           // 99: subscription.v1.GetPlanLTVReportRequest
+	(*PlanLTV)(nil),                           // 100: subscription.v1.PlanLTV
+	(*GetPlanLTVReportReply)(nil),             // 101: subscription.v1.GetPlanLTVReportReply
+	(*JobRun)(nil),                            // 102: subscription.v1.JobRun
+	(*CronJob)(nil),                           // 103: subscription.v1.CronJob
+	(*ListJobsRequest)(nil),                   // 104: subscription.v1.ListJobsRequest
+	(*ListJobsReply)(nil),                     // 105: subscription.v1.ListJobsReply
+	(*TriggerJobRequest)(nil),                 // 106: subscription.v1.TriggerJobRequest
+	(*TriggerJobReply)(nil),                   // 107: subscription.v1.TriggerJobReply
+	(*PauseJobRequest)(nil),                   // 108: subscription.v1.PauseJobRequest
+	(*ResumeJobRequest)(nil),                  // 109: subscription.v1.ResumeJobRequest
+	(*ListJobRunsRequest)(nil),                // 110: subscription.v1.ListJobRunsRequest
+	(*ListJobRunsReply)(nil),                  // 111: subscription.v1.ListJobRunsReply
+	(*SupportSubscription)(nil),               // 112: subscription.v1.SupportSubscription
+	(*SearchSubscriptionsRequest)(nil),        // 113: subscription.v1.SearchSubscriptionsRequest
+	(*SearchSubscriptionsReply)(nil),          // 114: subscription.v1.SearchSubscriptionsReply
+	(*SupportOrder)(nil),                      // 115: subscription.v1.SupportOrder
+	(*TimelineEvent)(nil),                     // 116: subscription.v1.TimelineEvent
+	(*GetUserTimelineRequest)(nil),            // 117: subscription.v1.GetUserTimelineRequest
+	(*GetUserTimelineReply)(nil),              // 118: subscription.v1.GetUserTimelineReply
+	(*AdjustSubscriptionRequest)(nil),         // 119: subscription.v1.AdjustSubscriptionRequest
+	(*AdjustSubscriptionReply)(nil),           // 120: subscription.v1.AdjustSubscriptionReply
+	(*GrantSubscriptionRequest)(nil),          // 121: subscription.v1.GrantSubscriptionRequest
+	(*GrantSubscriptionReply)(nil),            // 122: subscription.v1.GrantSubscriptionReply
+	(*BulkOperation)(nil),                     // 123: subscription.v1.BulkOperation
+	(*CreateBulkOperationRequest)(nil),        // 124: subscription.v1.CreateBulkOperationRequest
+	(*CreateBulkOperationReply)(nil),          // 125: subscription.v1.CreateBulkOperationReply
+	(*GetBulkOperationRequest)(nil),           // 126: subscription.v1.GetBulkOperationRequest
+	(*GetBulkOperationReply)(nil),             // 127: subscription.v1.GetBulkOperationReply
+	(*ListBulkOperationsRequest)(nil),         // 128: subscription.v1.ListBulkOperationsRequest
+	(*ListBulkOperationsReply)(nil),           // 129: subscription.v1.ListBulkOperationsReply
+	(*CancelBulkOperationRequest)(nil),        // 130: subscription.v1.CancelBulkOperationRequest
+	(*CancelBulkOperationReply)(nil),          // 131: subscription.v1.CancelBulkOperationReply
+	(*SaveExchangeRatesRequest_Item)(nil),     // 132: subscription.v1.SaveExchangeRatesRequest.Item
+	(*emptypb.Empty)(nil),                     // 133: google.protobuf.Empty
 }
 var file_subscription_proto_depIdxs = []int32{
-	0,  // 0: subscription.v1.CreatePlanReply.plan:type_name -> subscription.v1.Plan
-	0,  // 1: subscription.v1.UpdatePlanReply.plan:type_name -> subscription.v1.Plan
-	0,  // 2: subscription.v1.ListPlansReply.plans:type_name -> subscription.v1.Plan
-	17, // 3: subscription.v1.GetSubscriptionHistoryReply.items:type_name -> subscription.v1.SubscriptionHistoryItem
-	22, // 4: subscription.v1.GetExpiringSubscriptionsReply.subscriptions:type_name -> subscription.v1.SubscriptionInfo
-	27, // 5: subscription.v1.ProcessAutoRenewalsReply.results:type_name -> subscription.v1.AutoRenewResult
-	29, // 6: subscription.v1.ListPlanPricingsReply.pricings:type_name -> subscription.v1.PlanPricing
-	29, // 7: subscription.v1.CreatePlanPricingReply.pricing:type_name -> subscription.v1.PlanPricing
-	29, // 8: subscription.v1.UpdatePlanPricingReply.pricing:type_name -> subscription.v1.PlanPricing
-	1,  // 9: subscription.v1.Subscription.ListPlans:input_type -> subscription.v1.ListPlansRequest
-	9,  // 10: subscription.v1.Subscription.GetMySubscription:input_type -> subscription.v1.GetMySubscriptionRequest
-	11, // 11: subscription.v1.Subscription.CreateSubscriptionOrder:input_type -> subscription.v1.CreateSubscriptionOrderRequest
-	13, // 12: subscription.v1.Subscription.HandlePaymentSuccess:input_type -> subscription.v1.HandlePaymentSuccessRequest
-	14, // 13: subscription.v1.Subscription.CancelSubscription:input_type -> subscription.v1.CancelSubscriptionRequest
-	15, // 14: subscription.v1.Subscription.PauseSubscription:input_type -> subscription.v1.PauseSubscriptionRequest
-	16, // 15: subscription.v1.Subscription.ResumeSubscription:input_type -> subscription.v1.ResumeSubscriptionRequest
-	18, // 16: subscription.v1.Subscription.GetSubscriptionHistory:input_type -> subscription.v1.GetSubscriptionHistoryRequest
-	20, // 17: subscription.v1.Subscription.SetAutoRenew:input_type -> subscription.v1.SetAutoRenewRequest
-	21, // 18: subscription.v1.Subscription.GetExpiringSubscriptions:input_type -> subscription.v1.GetExpiringSubscriptionsRequest
-	24, // 19: subscription.v1.Subscription.UpdateExpiredSubscriptions:input_type -> subscription.v1.UpdateExpiredSubscriptionsRequest
-	26, // 20: subscription.v1.Subscription.ProcessAutoRenewals:input_type -> subscription.v1.ProcessAutoRenewalsRequest
-	2,  // 21: subscription.v1.Subscription.CreatePlan:input_type -> subscription.v1.CreatePlanRequest
-	4,  // 22: subscription.v1.Subscription.UpdatePlan:input_type -> subscription.v1.UpdatePlanRequest
-	6,  // 23: subscription.v1.Subscription.DeletePlan:input_type -> subscription.v1.DeletePlanRequest
-	30, // 24: subscription.v1.Subscription.ListPlanPricings:input_type -> subscription.v1.ListPlanPricingsRequest
-	32, // 25: subscription.v1.Subscription.CreatePlanPricing:input_type -> subscription.v1.CreatePlanPricingRequest
-	34, // 26: subscription.v1.Subscription.UpdatePlanPricing:input_type -> subscription.v1.UpdatePlanPricingRequest
-	36, // 27: subscription.v1.Subscription.DeletePlanPricing:input_type -> subscription.v1.DeletePlanPricingRequest
-	8,  // 28: subscription.v1.Subscription.ListPlans:output_type -> subscription.v1.ListPlansReply
-	10, // 29: subscription.v1.Subscription.GetMySubscription:output_type -> subscription.v1.GetMySubscriptionReply
-	12, // 30: subscription.v1.Subscription.CreateSubscriptionOrder:output_type -> subscription.v1.CreateSubscriptionOrderReply
-	38, // 31: subscription.v1.Subscription.HandlePaymentSuccess:output_type -> google.protobuf.Empty
-	38, // 32: subscription.v1.Subscription.CancelSubscription:output_type -> google.protobuf.Empty
-	38, // 33: subscription.v1.Subscription.PauseSubscription:output_type -> google.protobuf.Empty
-	38, // 34: subscription.v1.Subscription.ResumeSubscription:output_type -> google.protobuf.Empty
-	19, // 35: subscription.v1.Subscription.GetSubscriptionHistory:output_type -> subscription.v1.GetSubscriptionHistoryReply
-	38, // 36: subscription.v1.Subscription.SetAutoRenew:output_type -> google.protobuf.Empty
-	23, // 37: subscription.v1.Subscription.GetExpiringSubscriptions:output_type -> subscription.v1.GetExpiringSubscriptionsReply
-	25, // 38: subscription.v1.Subscription.UpdateExpiredSubscriptions:output_type -> subscription.v1.UpdateExpiredSubscriptionsReply
-	28, // 39: subscription.v1.Subscription.ProcessAutoRenewals:output_type -> subscription.v1.ProcessAutoRenewalsReply
-	3,  // 40: subscription.v1.Subscription.CreatePlan:output_type -> subscription.v1.CreatePlanReply
-	5,  // 41: subscription.v1.Subscription.UpdatePlan:output_type -> subscription.v1.UpdatePlanReply
-	7,  // 42: subscription.v1.Subscription.DeletePlan:output_type -> subscription.v1.DeletePlanReply
-	31, // 43: subscription.v1.Subscription.ListPlanPricings:output_type -> subscription.v1.ListPlanPricingsReply
-	33, // 44: subscription.v1.Subscription.CreatePlanPricing:output_type -> subscription.v1.CreatePlanPricingReply
-	35, // 45: subscription.v1.Subscription.UpdatePlanPricing:output_type -> subscription.v1.UpdatePlanPricingReply
-	37, // 46: subscription.v1.Subscription.DeletePlanPricing:output_type -> subscription.v1.DeletePlanPricingReply
-	28, // [28:47] is the sub-list for method output_type
-	9,  // [9:28] is the sub-list for method input_type
-	9,  // [9:9] is the sub-list for extension type_name
-	9,  // [9:9] is the sub-list for extension extendee
-	0,  // [0:9] is the sub-list for field type_name
+	0,   // 0: subscription.v1.CreatePlanReply.plan:type_name -> subscription.v1.Plan
+	0,   // 1: subscription.v1.UpdatePlanReply.plan:type_name -> subscription.v1.Plan
+	0,   // 2: subscription.v1.ListPlansReply.plans:type_name -> subscription.v1.Plan
+	15,  // 3: subscription.v1.CreateSubscriptionOrderReply.taxLines:type_name -> subscription.v1.TaxLine
+	15,  // 4: subscription.v1.QuoteSubscriptionReply.taxLines:type_name -> subscription.v1.TaxLine
+	22,  // 5: subscription.v1.SubscriptionHistoryItem.changes:type_name -> subscription.v1.HistoryChange
+	21,  // 6: subscription.v1.GetSubscriptionHistoryReply.items:type_name -> subscription.v1.SubscriptionHistoryItem
+	21,  // 7: subscription.v1.ListAppSubscriptionHistoryReply.items:type_name -> subscription.v1.SubscriptionHistoryItem
+	31,  // 8: subscription.v1.GetExpiringSubscriptionsReply.subscriptions:type_name -> subscription.v1.SubscriptionInfo
+	36,  // 9: subscription.v1.ProcessAutoRenewalsReply.results:type_name -> subscription.v1.AutoRenewResult
+	39,  // 10: subscription.v1.ProcessPriceChangeNoticesReply.notices:type_name -> subscription.v1.PriceChangeNotice
+	41,  // 11: subscription.v1.ListPlanPricingsReply.pricings:type_name -> subscription.v1.PlanPricing
+	41,  // 12: subscription.v1.CreatePlanPricingReply.pricing:type_name -> subscription.v1.PlanPricing
+	41,  // 13: subscription.v1.UpdatePlanPricingReply.pricing:type_name -> subscription.v1.PlanPricing
+	50,  // 14: subscription.v1.GetAppSettingReply.setting:type_name -> subscription.v1.AppSetting
+	50,  // 15: subscription.v1.UpdateAppSettingReply.setting:type_name -> subscription.v1.AppSetting
+	55,  // 16: subscription.v1.ListRegionGroupsReply.groups:type_name -> subscription.v1.RegionGroup
+	55,  // 17: subscription.v1.GetRegionGroupReply.group:type_name -> subscription.v1.RegionGroup
+	55,  // 18: subscription.v1.SaveRegionGroupReply.group:type_name -> subscription.v1.RegionGroup
+	65,  // 19: subscription.v1.Invoice.lines:type_name -> subscription.v1.InvoiceLine
+	64,  // 20: subscription.v1.GetInvoiceReply.invoice:type_name -> subscription.v1.Invoice
+	64,  // 21: subscription.v1.ListInvoicesReply.items:type_name -> subscription.v1.Invoice
+	70,  // 22: subscription.v1.ListTaxRulesReply.rules:type_name -> subscription.v1.TaxRule
+	70,  // 23: subscription.v1.CreateTaxRuleReply.rule:type_name -> subscription.v1.TaxRule
+	70,  // 24: subscription.v1.UpdateTaxRuleReply.rule:type_name -> subscription.v1.TaxRule
+	79,  // 25: subscription.v1.ListExchangeRatesReply.items:type_name -> subscription.v1.ExchangeRate
+	132, // 26: subscription.v1.SaveExchangeRatesRequest.rates:type_name -> subscription.v1.SaveExchangeRatesRequest.Item
+	87,  // 27: subscription.v1.GetRevenueReportReply.byCurrency:type_name -> subscription.v1.CurrencyRevenue
+	89,  // 28: subscription.v1.GetSubscriptionMetricsReply.snapshots:type_name -> subscription.v1.MetricSnapshot
+	89,  // 29: subscription.v1.ListMetricSnapshotsReply.snapshots:type_name -> subscription.v1.MetricSnapshot
+	97,  // 30: subscription.v1.GetCohortReportReply.cohorts:type_name -> subscription.v1.CohortRow
+	100, // 31: subscription.v1.GetPlanLTVReportReply.plans:type_name -> subscription.v1.PlanLTV
+	102, // 32: subscription.v1.CronJob.lastRun:type_name -> subscription.v1.JobRun
+	103, // 33: subscription.v1.ListJobsReply.jobs:type_name -> subscription.v1.CronJob
+	102, // 34: subscription.v1.TriggerJobReply.run:type_name -> subscription.v1.JobRun
+	102, // 35: subscription.v1.ListJobRunsReply.runs:type_name -> subscription.v1.JobRun
+	112, // 36: subscription.v1.SearchSubscriptionsReply.subscriptions:type_name -> subscription.v1.SupportSubscription
+	112, // 37: subscription.v1.GetUserTimelineReply.subscription:type_name -> subscription.v1.SupportSubscription
+	115, // 38: subscription.v1.GetUserTimelineReply.orders:type_name -> subscription.v1.SupportOrder
+	21,  // 39: subscription.v1.GetUserTimelineReply.history:type_name -> subscription.v1.SubscriptionHistoryItem
+	116, // 40: subscription.v1.GetUserTimelineReply.events:type_name -> subscription.v1.TimelineEvent
+	112, // 41: subscription.v1.AdjustSubscriptionReply.subscription:type_name -> subscription.v1.SupportSubscription
+	112, // 42: subscription.v1.GrantSubscriptionReply.subscription:type_name -> subscription.v1.SupportSubscription
+	115, // 43: subscription.v1.GrantSubscriptionReply.order:type_name -> subscription.v1.SupportOrder
+	123, // 44: subscription.v1.CreateBulkOperationReply.operation:type_name -> subscription.v1.BulkOperation
+	123, // 45: subscription.v1.GetBulkOperationReply.operation:type_name -> subscription.v1.BulkOperation
+	123, // 46: subscription.v1.ListBulkOperationsReply.operations:type_name -> subscription.v1.BulkOperation
+	123, // 47: subscription.v1.CancelBulkOperationReply.operation:type_name -> subscription.v1.BulkOperation
+	1,   // 48: subscription.v1.Subscription.ListPlans:input_type -> subscription.v1.ListPlansRequest
+	9,   // 49: subscription.v1.Subscription.GetMySubscription:input_type -> subscription.v1.GetMySubscriptionRequest
+	13,  // 50: subscription.v1.Subscription.QuoteSubscription:input_type -> subscription.v1.QuoteSubscriptionRequest
+	11,  // 51: subscription.v1.Subscription.CreateSubscriptionOrder:input_type -> subscription.v1.CreateSubscriptionOrderRequest
+	16,  // 52: subscription.v1.Subscription.HandlePaymentSuccess:input_type -> subscription.v1.HandlePaymentSuccessRequest
+	17,  // 53: subscription.v1.Subscription.HandlePaymentRefund:input_type -> subscription.v1.HandlePaymentRefundRequest
+	18,  // 54: subscription.v1.Subscription.CancelSubscription:input_type -> subscription.v1.CancelSubscriptionRequest
+	19,  // 55: subscription.v1.Subscription.PauseSubscription:input_type -> subscription.v1.PauseSubscriptionRequest
+	20,  // 56: subscription.v1.Subscription.ResumeSubscription:input_type -> subscription.v1.ResumeSubscriptionRequest
+	23,  // 57: subscription.v1.Subscription.GetSubscriptionHistory:input_type -> subscription.v1.GetSubscriptionHistoryRequest
+	25,  // 58: subscription.v1.Subscription.ListAppSubscriptionHistory:input_type -> subscription.v1.ListAppSubscriptionHistoryRequest
+	27,  // 59: subscription.v1.Subscription.ExportSubscriptionHistory:input_type -> subscription.v1.ExportSubscriptionHistoryRequest
+	29,  // 60: subscription.v1.Subscription.SetAutoRenew:input_type -> subscription.v1.SetAutoRenewRequest
+	30,  // 61: subscription.v1.Subscription.GetExpiringSubscriptions:input_type -> subscription.v1.GetExpiringSubscriptionsRequest
+	33,  // 62: subscription.v1.Subscription.UpdateExpiredSubscriptions:input_type -> subscription.v1.UpdateExpiredSubscriptionsRequest
+	35,  // 63: subscription.v1.Subscription.ProcessAutoRenewals:input_type -> subscription.v1.ProcessAutoRenewalsRequest
+	38,  // 64: subscription.v1.Subscription.ProcessPriceChangeNotices:input_type -> subscription.v1.ProcessPriceChangeNoticesRequest
+	2,   // 65: subscription.v1.Subscription.CreatePlan:input_type -> subscription.v1.CreatePlanRequest
+	4,   // 66: subscription.v1.Subscription.UpdatePlan:input_type -> subscription.v1.UpdatePlanRequest
+	6,   // 67: subscription.v1.Subscription.DeletePlan:input_type -> subscription.v1.DeletePlanRequest
+	42,  // 68: subscription.v1.Subscription.ListPlanPricings:input_type -> subscription.v1.ListPlanPricingsRequest
+	44,  // 69: subscription.v1.Subscription.CreatePlanPricing:input_type -> subscription.v1.CreatePlanPricingRequest
+	46,  // 70: subscription.v1.Subscription.UpdatePlanPricing:input_type -> subscription.v1.UpdatePlanPricingRequest
+	48,  // 71: subscription.v1.Subscription.DeletePlanPricing:input_type -> subscription.v1.DeletePlanPricingRequest
+	51,  // 72: subscription.v1.Subscription.GetAppSetting:input_type -> subscription.v1.GetAppSettingRequest
+	53,  // 73: subscription.v1.Subscription.UpdateAppSetting:input_type -> subscription.v1.UpdateAppSettingRequest
+	56,  // 74: subscription.v1.Subscription.ListRegionGroups:input_type -> subscription.v1.ListRegionGroupsRequest
+	58,  // 75: subscription.v1.Subscription.GetRegionGroup:input_type -> subscription.v1.GetRegionGroupRequest
+	60,  // 76: subscription.v1.Subscription.SaveRegionGroup:input_type -> subscription.v1.SaveRegionGroupRequest
+	62,  // 77: subscription.v1.Subscription.DeleteRegionGroup:input_type -> subscription.v1.DeleteRegionGroupRequest
+	66,  // 78: subscription.v1.Subscription.GetInvoice:input_type -> subscription.v1.GetInvoiceRequest
+	68,  // 79: subscription.v1.Subscription.ListInvoices:input_type -> subscription.v1.ListInvoicesRequest
+	71,  // 80: subscription.v1.Subscription.ListTaxRules:input_type -> subscription.v1.ListTaxRulesRequest
+	73,  // 81: subscription.v1.Subscription.CreateTaxRule:input_type -> subscription.v1.CreateTaxRuleRequest
+	75,  // 82: subscription.v1.Subscription.UpdateTaxRule:input_type -> subscription.v1.UpdateTaxRuleRequest
+	77,  // 83: subscription.v1.Subscription.DeleteTaxRule:input_type -> subscription.v1.DeleteTaxRuleRequest
+	80,  // 84: subscription.v1.Subscription.ListExchangeRates:input_type -> subscription.v1.ListExchangeRatesRequest
+	82,  // 85: subscription.v1.Subscription.SaveExchangeRates:input_type -> subscription.v1.SaveExchangeRatesRequest
+	84,  // 86: subscription.v1.Subscription.ImportExchangeRates:input_type -> subscription.v1.ImportExchangeRatesRequest
+	86,  // 87: subscription.v1.Subscription.GetRevenueReport:input_type -> subscription.v1.GetRevenueReportRequest
+	90,  // 88: subscription.v1.Subscription.GetSubscriptionMetrics:input_type -> subscription.v1.GetSubscriptionMetricsRequest
+	92,  // 89: subscription.v1.Subscription.ListMetricSnapshots:input_type -> subscription.v1.ListMetricSnapshotsRequest
+	94,  // 90: subscription.v1.Subscription.GenerateMetricSnapshots:input_type -> subscription.v1.GenerateMetricSnapshotsRequest
+	96,  // 91: subscription.v1.Subscription.GetCohortReport:input_type -> subscription.v1.GetCohortReportRequest
+	99,  // 92: subscription.v1.Subscription.GetPlanLTVReport:input_type -> subscription.v1.GetPlanLTVReportRequest
+	104, // 93: subscription.v1.SubscriptionAdmin.ListJobs:input_type -> subscription.v1.ListJobsRequest
+	106, // 94: subscription.v1.SubscriptionAdmin.TriggerJob:input_type -> subscription.v1.TriggerJobRequest
+	108, // 95: subscription.v1.SubscriptionAdmin.PauseJob:input_type -> subscription.v1.PauseJobRequest
+	109, // 96: subscription.v1.SubscriptionAdmin.ResumeJob:input_type -> subscription.v1.ResumeJobRequest
+	110, // 97: subscription.v1.SubscriptionAdmin.ListJobRuns:input_type -> subscription.v1.ListJobRunsRequest
+	113, // 98: subscription.v1.SubscriptionSupport.SearchSubscriptions:input_type -> subscription.v1.SearchSubscriptionsRequest
+	117, // 99: subscription.v1.SubscriptionSupport.GetUserTimeline:input_type -> subscription.v1.GetUserTimelineRequest
+	119, // 100: subscription.v1.SubscriptionSupport.AdjustSubscription:input_type -> subscription.v1.AdjustSubscriptionRequest
+	121, // 101: subscription.v1.SubscriptionSupport.GrantSubscription:input_type -> subscription.v1.GrantSubscriptionRequest
+	124, // 102: subscription.v1.SubscriptionSupport.CreateBulkOperation:input_type -> subscription.v1.CreateBulkOperationRequest
+	126, // 103: subscription.v1.SubscriptionSupport.GetBulkOperation:input_type -> subscription.v1.GetBulkOperationRequest
+	128, // 104: subscription.v1.SubscriptionSupport.ListBulkOperations:input_type -> subscription.v1.ListBulkOperationsRequest
+	130, // 105: subscription.v1.SubscriptionSupport.CancelBulkOperation:input_type -> subscription.v1.CancelBulkOperationRequest
+	8,   // 106: subscription.v1.Subscription.ListPlans:output_type -> subscription.v1.ListPlansReply
+	10,  // 107: subscription.v1.Subscription.GetMySubscription:output_type -> subscription.v1.GetMySubscriptionReply
+	14,  // 108: subscription.v1.Subscription.QuoteSubscription:output_type -> subscription.v1.QuoteSubscriptionReply
+	12,  // 109: subscription.v1.Subscription.CreateSubscriptionOrder:output_type -> subscription.v1.CreateSubscriptionOrderReply
+	133, // 110: subscription.v1.Subscription.HandlePaymentSuccess:output_type -> google.protobuf.Empty
+	133, // 111: subscription.v1.Subscription.HandlePaymentRefund:output_type -> google.protobuf.Empty
+	133, // 112: subscription.v1.Subscription.CancelSubscription:output_type -> google.protobuf.Empty
+	133, // 113: subscription.v1.Subscription.PauseSubscription:output_type -> google.protobuf.Empty
+	133, // 114: subscription.v1.Subscription.ResumeSubscription:output_type -> google.protobuf.Empty
+	24,  // 115: subscription.v1.Subscription.GetSubscriptionHistory:output_type -> subscription.v1.GetSubscriptionHistoryReply
+	26,  // 116: subscription.v1.Subscription.ListAppSubscriptionHistory:output_type -> subscription.v1.ListAppSubscriptionHistoryReply
+	28,  // 117: subscription.v1.Subscription.ExportSubscriptionHistory:output_type -> subscription.v1.ExportSubscriptionHistoryChunk
+	133, // 118: subscription.v1.Subscription.SetAutoRenew:output_type -> google.protobuf.Empty
+	32,  // 119: subscription.v1.Subscription.GetExpiringSubscriptions:output_type -> subscription.v1.GetExpiringSubscriptionsReply
+	34,  // 120: subscription.v1.Subscription.UpdateExpiredSubscriptions:output_type -> subscription.v1.UpdateExpiredSubscriptionsReply
+	37,  // 121: subscription.v1.Subscription.ProcessAutoRenewals:output_type -> subscription.v1.ProcessAutoRenewalsReply
+	40,  // 122: subscription.v1.Subscription.ProcessPriceChangeNotices:output_type -> subscription.v1.ProcessPriceChangeNoticesReply
+	3,   // 123: subscription.v1.Subscription.CreatePlan:output_type -> subscription.v1.CreatePlanReply
+	5,   // 124: subscription.v1.Subscription.UpdatePlan:output_type -> subscription.v1.UpdatePlanReply
+	7,   // 125: subscription.v1.Subscription.DeletePlan:output_type -> subscription.v1.DeletePlanReply
+	43,  // 126: subscription.v1.Subscription.ListPlanPricings:output_type -> subscription.v1.ListPlanPricingsReply
+	45,  // 127: subscription.v1.Subscription.CreatePlanPricing:output_type -> subscription.v1.CreatePlanPricingReply
+	47,  // 128: subscription.v1.Subscription.UpdatePlanPricing:output_type -> subscription.v1.UpdatePlanPricingReply
+	49,  // 129: subscription.v1.Subscription.DeletePlanPricing:output_type -> subscription.v1.DeletePlanPricingReply
+	52,  // 130: subscription.v1.Subscription.GetAppSetting:output_type -> subscription.v1.GetAppSettingReply
+	54,  // 131: subscription.v1.Subscription.UpdateAppSetting:output_type -> subscription.v1.UpdateAppSettingReply
+	57,  // 132: subscription.v1.Subscription.ListRegionGroups:output_type -> subscription.v1.ListRegionGroupsReply
+	59,  // 133: subscription.v1.Subscription.GetRegionGroup:output_type -> subscription.v1.GetRegionGroupReply
+	61,  // 134: subscription.v1.Subscription.SaveRegionGroup:output_type -> subscription.v1.SaveRegionGroupReply
+	63,  // 135: subscription.v1.Subscription.DeleteRegionGroup:output_type -> subscription.v1.DeleteRegionGroupReply
+	67,  // 136: subscription.v1.Subscription.GetInvoice:output_type -> subscription.v1.GetInvoiceReply
+	69,  // 137: subscription.v1.Subscription.ListInvoices:output_type -> subscription.v1.ListInvoicesReply
+	72,  // 138: subscription.v1.Subscription.ListTaxRules:output_type -> subscription.v1.ListTaxRulesReply
+	74,  // 139: subscription.v1.Subscription.CreateTaxRule:output_type -> subscription.v1.CreateTaxRuleReply
+	76,  // 140: subscription.v1.Subscription.UpdateTaxRule:output_type -> subscription.v1.UpdateTaxRuleReply
+	78,  // 141: subscription.v1.Subscription.DeleteTaxRule:output_type -> subscription.v1.DeleteTaxRuleReply
+	81,  // 142: subscription.v1.Subscription.ListExchangeRates:output_type -> subscription.v1.ListExchangeRatesReply
+	83,  // 143: subscription.v1.Subscription.SaveExchangeRates:output_type -> subscription.v1.SaveExchangeRatesReply
+	85,  // 144: subscription.v1.Subscription.ImportExchangeRates:output_type -> subscription.v1.ImportExchangeRatesReply
+	88,  // 145: subscription.v1.Subscription.GetRevenueReport:output_type -> subscription.v1.GetRevenueReportReply
+	91,  // 146: subscription.v1.Subscription.GetSubscriptionMetrics:output_type -> subscription.v1.GetSubscriptionMetricsReply
+	93,  // 147: subscription.v1.Subscription.ListMetricSnapshots:output_type -> subscription.v1.ListMetricSnapshotsReply
+	95,  // 148: subscription.v1.Subscription.GenerateMetricSnapshots:output_type -> subscription.v1.GenerateMetricSnapshotsReply
+	98,  // 149: subscription.v1.Subscription.GetCohortReport:output_type -> subscription.v1.GetCohortReportReply
+	101, // 150: subscription.v1.Subscription.GetPlanLTVReport:output_type -> subscription.v1.GetPlanLTVReportReply
+	105, // 151: subscription.v1.SubscriptionAdmin.ListJobs:output_type -> subscription.v1.ListJobsReply
+	107, // 152: subscription.v1.SubscriptionAdmin.TriggerJob:output_type -> subscription.v1.TriggerJobReply
+	133, // 153: subscription.v1.SubscriptionAdmin.PauseJob:output_type -> google.protobuf.Empty
+	133, // 154: subscription.v1.SubscriptionAdmin.ResumeJob:output_type -> google.protobuf.Empty
+	111, // 155: subscription.v1.SubscriptionAdmin.ListJobRuns:output_type -> subscription.v1.ListJobRunsReply
+	114, // 156: subscription.v1.SubscriptionSupport.SearchSubscriptions:output_type -> subscription.v1.SearchSubscriptionsReply
+	118, // 157: subscription.v1.SubscriptionSupport.GetUserTimeline:output_type -> subscription.v1.GetUserTimelineReply
+	120, // 158: subscription.v1.SubscriptionSupport.AdjustSubscription:output_type -> subscription.v1.AdjustSubscriptionReply
+	122, // 159: subscription.v1.SubscriptionSupport.GrantSubscription:output_type -> subscription.v1.GrantSubscriptionReply
+	125, // 160: subscription.v1.SubscriptionSupport.CreateBulkOperation:output_type -> subscription.v1.CreateBulkOperationReply
+	127, // 161: subscription.v1.SubscriptionSupport.GetBulkOperation:output_type -> subscription.v1.GetBulkOperationReply
+	129, // 162: subscription.v1.SubscriptionSupport.ListBulkOperations:output_type -> subscription.v1.ListBulkOperationsReply
+	131, // 163: subscription.v1.SubscriptionSupport.CancelBulkOperation:output_type -> subscription.v1.CancelBulkOperationReply
+	106, // [106:164] is the sub-list for method output_type
+	48,  // [48:106] is the sub-list for method input_type
+	48,  // [48:48] is the sub-list for extension type_name
+	48,  // [48:48] is the sub-list for extension extendee
+	0,   // [0:48] is the sub-list for field type_name
 }
 
 func init() { file_subscription_proto_init() }
@@ -2616,9 +10635,9 @@ func file_subscription_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_subscription_proto_rawDesc), len(file_subscription_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   38,
+			NumMessages:   133,
 			NumExtensions: 0,
-			NumServices:   1,
+			NumServices:   3,
 		},
 		GoTypes:           file_subscription_proto_goTypes,
 		DependencyIndexes: file_subscription_proto_depIdxs,
@@ -72,6 +72,12 @@ func (m *Plan) validate(all bool) error {
 
 	// no validation rules for AppId
 
+	// no validation rules for IntervalUnit
+
+	// no validation rules for IntervalCount
+
+	// no validation rules for BillingType
+
 	if len(errors) > 0 {
 		return PlanMultiError(errors)
 	}
@@ -309,10 +315,10 @@ func (m *CreatePlanRequest) validate(all bool) error {
 
 	}
 
-	if m.GetDurationDays() <= 0 {
+	if m.GetDurationDays() < 0 {
 		err := CreatePlanRequestValidationError{
 			field:  "DurationDays",
-			reason: "value must be greater than 0",
+			reason: "value must be greater than or equal to 0",
 		}
 		if !all {
 			return err
@@ -331,6 +337,39 @@ func (m *CreatePlanRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	if _, ok := _CreatePlanRequest_IntervalUnit_InLookup[m.GetIntervalUnit()]; !ok {
+		err := CreatePlanRequestValidationError{
+			field:  "IntervalUnit",
+			reason: "value must be in list [ day week month year]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetIntervalCount() < 0 {
+		err := CreatePlanRequestValidationError{
+			field:  "IntervalCount",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _CreatePlanRequest_BillingType_InLookup[m.GetBillingType()]; !ok {
+		err := CreatePlanRequestValidationError{
+			field:  "BillingType",
+			reason: "value must be in list [ recurring lifetime]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
 	if len(errors) > 0 {
 		return CreatePlanRequestMultiError(errors)
 	}
@@ -411,6 +450,20 @@ var _ interface {
 	ErrorName() string
 } = CreatePlanRequestValidationError{}
 
+var _CreatePlanRequest_IntervalUnit_InLookup = map[string]struct{}{
+	"":      {},
+	"day":   {},
+	"week":  {},
+	"month": {},
+	"year":  {},
+}
+
+var _CreatePlanRequest_BillingType_InLookup = map[string]struct{}{
+	"":          {},
+	"recurring": {},
+	"lifetime":  {},
+}
+
 // Validate checks the field values on CreatePlanReply with the rules defined
 // in the proto definition for this message. If any rules are violated, the
 // first error encountered is returned, or nil if there are no violations.
@@ -585,6 +638,39 @@ func (m *UpdatePlanRequest) validate(all bool) error {
 
 	// no validation rules for Type
 
+	if _, ok := _UpdatePlanRequest_IntervalUnit_InLookup[m.GetIntervalUnit()]; !ok {
+		err := UpdatePlanRequestValidationError{
+			field:  "IntervalUnit",
+			reason: "value must be in list [ day week month year]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetIntervalCount() < 0 {
+		err := UpdatePlanRequestValidationError{
+			field:  "IntervalCount",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _UpdatePlanRequest_BillingType_InLookup[m.GetBillingType()]; !ok {
+		err := UpdatePlanRequestValidationError{
+			field:  "BillingType",
+			reason: "value must be in list [ recurring lifetime]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
 	if len(errors) > 0 {
 		return UpdatePlanRequestMultiError(errors)
 	}
@@ -665,6 +751,20 @@ var _ interface {
 	ErrorName() string
 } = UpdatePlanRequestValidationError{}
 
+var _UpdatePlanRequest_IntervalUnit_InLookup = map[string]struct{}{
+	"":      {},
+	"day":   {},
+	"week":  {},
+	"month": {},
+	"year":  {},
+}
+
+var _UpdatePlanRequest_BillingType_InLookup = map[string]struct{}{
+	"":          {},
+	"recurring": {},
+	"lifetime":  {},
+}
+
 // Validate checks the field values on UpdatePlanReply with the rules defined
 // in the proto definition for this message. If any rules are violated, the
 // first error encountered is returned, or nil if there are no violations.
@@ -1290,6 +1390,14 @@ func (m *GetMySubscriptionReply) validate(all bool) error {
 
 	// no validation rules for AutoRenew
 
+	// no validation rules for IsLifetime
+
+	// no validation rules for IsFree
+
+	// no validation rules for PlanName
+
+	// no validation rules for PlanType
+
 	if len(errors) > 0 {
 		return GetMySubscriptionReplyMultiError(errors)
 	}
@@ -1427,6 +1535,28 @@ func (m *CreateSubscriptionOrderRequest) validate(all bool) error {
 
 	// no validation rules for Region
 
+	if utf8.RuneCountInString(m.GetQuoteToken()) > 2048 {
+		err := CreateSubscriptionOrderRequestValidationError{
+			field:  "QuoteToken",
+			reason: "value length must be at most 2048 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetVatId()) > 20 {
+		err := CreateSubscriptionOrderRequestValidationError{
+			field:  "VatId",
+			reason: "value length must be at most 20 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
 	if len(errors) > 0 {
 		return CreateSubscriptionOrderRequestMultiError(errors)
 	}
@@ -1545,6 +1675,48 @@ func (m *CreateSubscriptionOrderReply) validate(all bool) error {
 
 	// no validation rules for PayParams
 
+	// no validation rules for Amount
+
+	// no validation rules for Currency
+
+	// no validation rules for PaymentStatus
+
+	// no validation rules for TaxAmount
+
+	for idx, item := range m.GetTaxLines() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CreateSubscriptionOrderReplyValidationError{
+						field:  fmt.Sprintf("TaxLines[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CreateSubscriptionOrderReplyValidationError{
+						field:  fmt.Sprintf("TaxLines[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CreateSubscriptionOrderReplyValidationError{
+					field:  fmt.Sprintf("TaxLines[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if len(errors) > 0 {
 		return CreateSubscriptionOrderReplyMultiError(errors)
 	}
@@ -1626,32 +1798,32 @@ var _ interface {
 	ErrorName() string
 } = CreateSubscriptionOrderReplyValidationError{}
 
-// Validate checks the field values on HandlePaymentSuccessRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on QuoteSubscriptionRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *HandlePaymentSuccessRequest) Validate() error {
+func (m *QuoteSubscriptionRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on HandlePaymentSuccessRequest with the
+// ValidateAll checks the field values on QuoteSubscriptionRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// HandlePaymentSuccessRequestMultiError, or nil if none found.
-func (m *HandlePaymentSuccessRequest) ValidateAll() error {
+// QuoteSubscriptionRequestMultiError, or nil if none found.
+func (m *QuoteSubscriptionRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *HandlePaymentSuccessRequest) validate(all bool) error {
+func (m *QuoteSubscriptionRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if l := utf8.RuneCountInString(m.GetOrderId()); l < 1 || l > 100 {
-		err := HandlePaymentSuccessRequestValidationError{
-			field:  "OrderId",
-			reason: "value length must be between 1 and 100 runes, inclusive",
+	if l := utf8.RuneCountInString(m.GetUid()); l < 1 || l > 36 {
+		err := QuoteSubscriptionRequestValidationError{
+			field:  "Uid",
+			reason: "value length must be between 1 and 36 runes, inclusive",
 		}
 		if !all {
 			return err
@@ -1659,10 +1831,10 @@ func (m *HandlePaymentSuccessRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if l := utf8.RuneCountInString(m.GetPaymentId()); l < 1 || l > 100 {
-		err := HandlePaymentSuccessRequestValidationError{
-			field:  "PaymentId",
-			reason: "value length must be between 1 and 100 runes, inclusive",
+	if l := utf8.RuneCountInString(m.GetPlanId()); l < 1 || l > 50 {
+		err := QuoteSubscriptionRequestValidationError{
+			field:  "PlanId",
+			reason: "value length must be between 1 and 50 runes, inclusive",
 		}
 		if !all {
 			return err
@@ -1670,10 +1842,12 @@ func (m *HandlePaymentSuccessRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if m.GetAmount() <= 0 {
-		err := HandlePaymentSuccessRequestValidationError{
-			field:  "Amount",
-			reason: "value must be greater than 0",
+	// no validation rules for Region
+
+	if utf8.RuneCountInString(m.GetVatId()) > 20 {
+		err := QuoteSubscriptionRequestValidationError{
+			field:  "VatId",
+			reason: "value length must be at most 20 runes",
 		}
 		if !all {
 			return err
@@ -1682,19 +1856,19 @@ func (m *HandlePaymentSuccessRequest) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return HandlePaymentSuccessRequestMultiError(errors)
+		return QuoteSubscriptionRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// HandlePaymentSuccessRequestMultiError is an error wrapping multiple
-// validation errors returned by HandlePaymentSuccessRequest.ValidateAll() if
-// the designated constraints aren't met.
-type HandlePaymentSuccessRequestMultiError []error
+// QuoteSubscriptionRequestMultiError is an error wrapping multiple validation
+// errors returned by QuoteSubscriptionRequest.ValidateAll() if the designated
+// constraints aren't met.
+type QuoteSubscriptionRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m HandlePaymentSuccessRequestMultiError) Error() string {
+func (m QuoteSubscriptionRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1703,12 +1877,11 @@ func (m HandlePaymentSuccessRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m HandlePaymentSuccessRequestMultiError) AllErrors() []error { return m }
+func (m QuoteSubscriptionRequestMultiError) AllErrors() []error { return m }
 
-// HandlePaymentSuccessRequestValidationError is the validation error returned
-// by HandlePaymentSuccessRequest.Validate if the designated constraints
-// aren't met.
-type HandlePaymentSuccessRequestValidationError struct {
+// QuoteSubscriptionRequestValidationError is the validation error returned by
+// QuoteSubscriptionRequest.Validate if the designated constraints aren't met.
+type QuoteSubscriptionRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1716,24 +1889,24 @@ type HandlePaymentSuccessRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e HandlePaymentSuccessRequestValidationError) Field() string { return e.field }
+func (e QuoteSubscriptionRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e HandlePaymentSuccessRequestValidationError) Reason() string { return e.reason }
+func (e QuoteSubscriptionRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e HandlePaymentSuccessRequestValidationError) Cause() error { return e.cause }
+func (e QuoteSubscriptionRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e HandlePaymentSuccessRequestValidationError) Key() bool { return e.key }
+func (e QuoteSubscriptionRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e HandlePaymentSuccessRequestValidationError) ErrorName() string {
-	return "HandlePaymentSuccessRequestValidationError"
+func (e QuoteSubscriptionRequestValidationError) ErrorName() string {
+	return "QuoteSubscriptionRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e HandlePaymentSuccessRequestValidationError) Error() string {
+func (e QuoteSubscriptionRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1745,14 +1918,14 @@ func (e HandlePaymentSuccessRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sHandlePaymentSuccessRequest.%s: %s%s",
+		"invalid %sQuoteSubscriptionRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = HandlePaymentSuccessRequestValidationError{}
+var _ error = QuoteSubscriptionRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1760,57 +1933,106 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = HandlePaymentSuccessRequestValidationError{}
+} = QuoteSubscriptionRequestValidationError{}
 
-// Validate checks the field values on CancelSubscriptionRequest with the rules
+// Validate checks the field values on QuoteSubscriptionReply with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CancelSubscriptionRequest) Validate() error {
+func (m *QuoteSubscriptionReply) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CancelSubscriptionRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on QuoteSubscriptionReply with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CancelSubscriptionRequestMultiError, or nil if none found.
-func (m *CancelSubscriptionRequest) ValidateAll() error {
+// QuoteSubscriptionReplyMultiError, or nil if none found.
+func (m *QuoteSubscriptionReply) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CancelSubscriptionRequest) validate(all bool) error {
+func (m *QuoteSubscriptionReply) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if l := utf8.RuneCountInString(m.GetUid()); l < 1 || l > 36 {
-		err := CancelSubscriptionRequestValidationError{
-			field:  "Uid",
-			reason: "value length must be between 1 and 36 runes, inclusive",
-		}
-		if !all {
-			return err
+	// no validation rules for Region
+
+	// no validation rules for RegionSource
+
+	// no validation rules for PricingRegion
+
+	// no validation rules for Currency
+
+	// no validation rules for Subtotal
+
+	// no validation rules for Discount
+
+	// no validation rules for ProrationCredit
+
+	// no validation rules for Tax
+
+	// no validation rules for Total
+
+	// no validation rules for PeriodStart
+
+	// no validation rules for PeriodEnd
+
+	// no validation rules for ExpiresAt
+
+	// no validation rules for QuoteToken
+
+	for idx, item := range m.GetTaxLines() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, QuoteSubscriptionReplyValidationError{
+						field:  fmt.Sprintf("TaxLines[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, QuoteSubscriptionReplyValidationError{
+						field:  fmt.Sprintf("TaxLines[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return QuoteSubscriptionReplyValidationError{
+					field:  fmt.Sprintf("TaxLines[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
 		}
-		errors = append(errors, err)
+
 	}
 
-	// no validation rules for Reason
+	// no validation rules for VatId
 
 	if len(errors) > 0 {
-		return CancelSubscriptionRequestMultiError(errors)
+		return QuoteSubscriptionReplyMultiError(errors)
 	}
 
 	return nil
 }
 
-// CancelSubscriptionRequestMultiError is an error wrapping multiple validation
-// errors returned by CancelSubscriptionRequest.ValidateAll() if the
-// designated constraints aren't met.
-type CancelSubscriptionRequestMultiError []error
+// QuoteSubscriptionReplyMultiError is an error wrapping multiple validation
+// errors returned by QuoteSubscriptionReply.ValidateAll() if the designated
+// constraints aren't met.
+type QuoteSubscriptionReplyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CancelSubscriptionRequestMultiError) Error() string {
+func (m QuoteSubscriptionReplyMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1819,11 +2041,11 @@ func (m CancelSubscriptionRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CancelSubscriptionRequestMultiError) AllErrors() []error { return m }
+func (m QuoteSubscriptionReplyMultiError) AllErrors() []error { return m }
 
-// CancelSubscriptionRequestValidationError is the validation error returned by
-// CancelSubscriptionRequest.Validate if the designated constraints aren't met.
-type CancelSubscriptionRequestValidationError struct {
+// QuoteSubscriptionReplyValidationError is the validation error returned by
+// QuoteSubscriptionReply.Validate if the designated constraints aren't met.
+type QuoteSubscriptionReplyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1831,24 +2053,24 @@ type CancelSubscriptionRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e CancelSubscriptionRequestValidationError) Field() string { return e.field }
+func (e QuoteSubscriptionReplyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CancelSubscriptionRequestValidationError) Reason() string { return e.reason }
+func (e QuoteSubscriptionReplyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CancelSubscriptionRequestValidationError) Cause() error { return e.cause }
+func (e QuoteSubscriptionReplyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CancelSubscriptionRequestValidationError) Key() bool { return e.key }
+func (e QuoteSubscriptionReplyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CancelSubscriptionRequestValidationError) ErrorName() string {
-	return "CancelSubscriptionRequestValidationError"
+func (e QuoteSubscriptionReplyValidationError) ErrorName() string {
+	return "QuoteSubscriptionReplyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CancelSubscriptionRequestValidationError) Error() string {
+func (e QuoteSubscriptionReplyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1860,14 +2082,14 @@ func (e CancelSubscriptionRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCancelSubscriptionRequest.%s: %s%s",
+		"invalid %sQuoteSubscriptionReply.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CancelSubscriptionRequestValidationError{}
+var _ error = QuoteSubscriptionReplyValidationError{}
 
 var _ interface {
 	Field() string
@@ -1875,57 +2097,56 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CancelSubscriptionRequestValidationError{}
+} = QuoteSubscriptionReplyValidationError{}
 
-// Validate checks the field values on PauseSubscriptionRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *PauseSubscriptionRequest) Validate() error {
+// Validate checks the field values on TaxLine with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *TaxLine) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on PauseSubscriptionRequest with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// PauseSubscriptionRequestMultiError, or nil if none found.
-func (m *PauseSubscriptionRequest) ValidateAll() error {
+// ValidateAll checks the field values on TaxLine with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in TaxLineMultiError, or nil if none found.
+func (m *TaxLine) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *PauseSubscriptionRequest) validate(all bool) error {
+func (m *TaxLine) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if l := utf8.RuneCountInString(m.GetUid()); l < 1 || l > 36 {
-		err := PauseSubscriptionRequestValidationError{
-			field:  "Uid",
-			reason: "value length must be between 1 and 36 runes, inclusive",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for Name
 
-	// no validation rules for Reason
+	// no validation rules for CountryCode
+
+	// no validation rules for Rate
+
+	// no validation rules for Inclusive
+
+	// no validation rules for ReverseCharge
+
+	// no validation rules for TaxableAmount
+
+	// no validation rules for Amount
 
 	if len(errors) > 0 {
-		return PauseSubscriptionRequestMultiError(errors)
+		return TaxLineMultiError(errors)
 	}
 
 	return nil
 }
 
-// PauseSubscriptionRequestMultiError is an error wrapping multiple validation
-// errors returned by PauseSubscriptionRequest.ValidateAll() if the designated
-// constraints aren't met.
-type PauseSubscriptionRequestMultiError []error
+// TaxLineMultiError is an error wrapping multiple validation errors returned
+// by TaxLine.ValidateAll() if the designated constraints aren't met.
+type TaxLineMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m PauseSubscriptionRequestMultiError) Error() string {
+func (m TaxLineMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1934,11 +2155,11 @@ func (m PauseSubscriptionRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m PauseSubscriptionRequestMultiError) AllErrors() []error { return m }
+func (m TaxLineMultiError) AllErrors() []error { return m }
 
-// PauseSubscriptionRequestValidationError is the validation error returned by
-// PauseSubscriptionRequest.Validate if the designated constraints aren't met.
-type PauseSubscriptionRequestValidationError struct {
+// TaxLineValidationError is the validation error returned by TaxLine.Validate
+// if the designated constraints aren't met.
+type TaxLineValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1946,24 +2167,22 @@ type PauseSubscriptionRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e PauseSubscriptionRequestValidationError) Field() string { return e.field }
+func (e TaxLineValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e PauseSubscriptionRequestValidationError) Reason() string { return e.reason }
+func (e TaxLineValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e PauseSubscriptionRequestValidationError) Cause() error { return e.cause }
+func (e TaxLineValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e PauseSubscriptionRequestValidationError) Key() bool { return e.key }
+func (e TaxLineValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e PauseSubscriptionRequestValidationError) ErrorName() string {
-	return "PauseSubscriptionRequestValidationError"
-}
+func (e TaxLineValidationError) ErrorName() string { return "TaxLineValidationError" }
 
 // Error satisfies the builtin error interface
-func (e PauseSubscriptionRequestValidationError) Error() string {
+func (e TaxLineValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1975,14 +2194,14 @@ func (e PauseSubscriptionRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sPauseSubscriptionRequest.%s: %s%s",
+		"invalid %sTaxLine.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = PauseSubscriptionRequestValidationError{}
+var _ error = TaxLineValidationError{}
 
 var _ interface {
 	Field() string
@@ -1990,34 +2209,56 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = PauseSubscriptionRequestValidationError{}
+} = TaxLineValidationError{}
 
-// Validate checks the field values on ResumeSubscriptionRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on HandlePaymentSuccessRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ResumeSubscriptionRequest) Validate() error {
+func (m *HandlePaymentSuccessRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ResumeSubscriptionRequest with the
+// ValidateAll checks the field values on HandlePaymentSuccessRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ResumeSubscriptionRequestMultiError, or nil if none found.
-func (m *ResumeSubscriptionRequest) ValidateAll() error {
+// HandlePaymentSuccessRequestMultiError, or nil if none found.
+func (m *HandlePaymentSuccessRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ResumeSubscriptionRequest) validate(all bool) error {
+func (m *HandlePaymentSuccessRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if l := utf8.RuneCountInString(m.GetUid()); l < 1 || l > 36 {
-		err := ResumeSubscriptionRequestValidationError{
-			field:  "Uid",
-			reason: "value length must be between 1 and 36 runes, inclusive",
+	if l := utf8.RuneCountInString(m.GetOrderId()); l < 1 || l > 100 {
+		err := HandlePaymentSuccessRequestValidationError{
+			field:  "OrderId",
+			reason: "value length must be between 1 and 100 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetPaymentId()); l < 1 || l > 100 {
+		err := HandlePaymentSuccessRequestValidationError{
+			field:  "PaymentId",
+			reason: "value length must be between 1 and 100 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetAmount() <= 0 {
+		err := HandlePaymentSuccessRequestValidationError{
+			field:  "Amount",
+			reason: "value must be greater than 0",
 		}
 		if !all {
 			return err
@@ -2026,19 +2267,19 @@ func (m *ResumeSubscriptionRequest) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return ResumeSubscriptionRequestMultiError(errors)
+		return HandlePaymentSuccessRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ResumeSubscriptionRequestMultiError is an error wrapping multiple validation
-// errors returned by ResumeSubscriptionRequest.ValidateAll() if the
-// designated constraints aren't met.
-type ResumeSubscriptionRequestMultiError []error
+// HandlePaymentSuccessRequestMultiError is an error wrapping multiple
+// validation errors returned by HandlePaymentSuccessRequest.ValidateAll() if
+// the designated constraints aren't met.
+type HandlePaymentSuccessRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ResumeSubscriptionRequestMultiError) Error() string {
+func (m HandlePaymentSuccessRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2047,11 +2288,12 @@ func (m ResumeSubscriptionRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ResumeSubscriptionRequestMultiError) AllErrors() []error { return m }
+func (m HandlePaymentSuccessRequestMultiError) AllErrors() []error { return m }
 
-// ResumeSubscriptionRequestValidationError is the validation error returned by
-// ResumeSubscriptionRequest.Validate if the designated constraints aren't met.
-type ResumeSubscriptionRequestValidationError struct {
+// HandlePaymentSuccessRequestValidationError is the validation error returned
+// by HandlePaymentSuccessRequest.Validate if the designated constraints
+// aren't met.
+type HandlePaymentSuccessRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2059,24 +2301,24 @@ type ResumeSubscriptionRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ResumeSubscriptionRequestValidationError) Field() string { return e.field }
+func (e HandlePaymentSuccessRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ResumeSubscriptionRequestValidationError) Reason() string { return e.reason }
+func (e HandlePaymentSuccessRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ResumeSubscriptionRequestValidationError) Cause() error { return e.cause }
+func (e HandlePaymentSuccessRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ResumeSubscriptionRequestValidationError) Key() bool { return e.key }
+func (e HandlePaymentSuccessRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ResumeSubscriptionRequestValidationError) ErrorName() string {
-	return "ResumeSubscriptionRequestValidationError"
+func (e HandlePaymentSuccessRequestValidationError) ErrorName() string {
+	return "HandlePaymentSuccessRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ResumeSubscriptionRequestValidationError) Error() string {
+func (e HandlePaymentSuccessRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2088,14 +2330,14 @@ func (e ResumeSubscriptionRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sResumeSubscriptionRequest.%s: %s%s",
+		"invalid %sHandlePaymentSuccessRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ResumeSubscriptionRequestValidationError{}
+var _ error = HandlePaymentSuccessRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -2103,60 +2345,77 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ResumeSubscriptionRequestValidationError{}
+} = HandlePaymentSuccessRequestValidationError{}
 
-// Validate checks the field values on SubscriptionHistoryItem with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on HandlePaymentRefundRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *SubscriptionHistoryItem) Validate() error {
+func (m *HandlePaymentRefundRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SubscriptionHistoryItem with the
+// ValidateAll checks the field values on HandlePaymentRefundRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// SubscriptionHistoryItemMultiError, or nil if none found.
-func (m *SubscriptionHistoryItem) ValidateAll() error {
+// HandlePaymentRefundRequestMultiError, or nil if none found.
+func (m *HandlePaymentRefundRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SubscriptionHistoryItem) validate(all bool) error {
+func (m *HandlePaymentRefundRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
-
-	// no validation rules for PlanId
-
-	// no validation rules for PlanName
-
-	// no validation rules for StartTime
-
-	// no validation rules for EndTime
-
-	// no validation rules for Status
+	if l := utf8.RuneCountInString(m.GetOrderId()); l < 1 || l > 100 {
+		err := HandlePaymentRefundRequestValidationError{
+			field:  "OrderId",
+			reason: "value length must be between 1 and 100 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-	// no validation rules for Action
+	if l := utf8.RuneCountInString(m.GetPaymentId()); l < 1 || l > 100 {
+		err := HandlePaymentRefundRequestValidationError{
+			field:  "PaymentId",
+			reason: "value length must be between 1 and 100 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-	// no validation rules for CreatedAt
+	if m.GetAmount() < 0 {
+		err := HandlePaymentRefundRequestValidationError{
+			field:  "Amount",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
 	if len(errors) > 0 {
-		return SubscriptionHistoryItemMultiError(errors)
+		return HandlePaymentRefundRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// SubscriptionHistoryItemMultiError is an error wrapping multiple validation
-// errors returned by SubscriptionHistoryItem.ValidateAll() if the designated
-// constraints aren't met.
-type SubscriptionHistoryItemMultiError []error
+// HandlePaymentRefundRequestMultiError is an error wrapping multiple
+// validation errors returned by HandlePaymentRefundRequest.ValidateAll() if
+// the designated constraints aren't met.
+type HandlePaymentRefundRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SubscriptionHistoryItemMultiError) Error() string {
+func (m HandlePaymentRefundRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2165,11 +2424,11 @@ func (m SubscriptionHistoryItemMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SubscriptionHistoryItemMultiError) AllErrors() []error { return m }
+func (m HandlePaymentRefundRequestMultiError) AllErrors() []error { return m }
 
-// SubscriptionHistoryItemValidationError is the validation error returned by
-// SubscriptionHistoryItem.Validate if the designated constraints aren't met.
-type SubscriptionHistoryItemValidationError struct {
+// HandlePaymentRefundRequestValidationError is the validation error returned
+// by HandlePaymentRefundRequest.Validate if the designated constraints aren't met.
+type HandlePaymentRefundRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2177,24 +2436,24 @@ type SubscriptionHistoryItemValidationError struct {
 }
 
 // Field function returns field value.
-func (e SubscriptionHistoryItemValidationError) Field() string { return e.field }
+func (e HandlePaymentRefundRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SubscriptionHistoryItemValidationError) Reason() string { return e.reason }
+func (e HandlePaymentRefundRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SubscriptionHistoryItemValidationError) Cause() error { return e.cause }
+func (e HandlePaymentRefundRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SubscriptionHistoryItemValidationError) Key() bool { return e.key }
+func (e HandlePaymentRefundRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SubscriptionHistoryItemValidationError) ErrorName() string {
-	return "SubscriptionHistoryItemValidationError"
+func (e HandlePaymentRefundRequestValidationError) ErrorName() string {
+	return "HandlePaymentRefundRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e SubscriptionHistoryItemValidationError) Error() string {
+func (e HandlePaymentRefundRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2206,14 +2465,14 @@ func (e SubscriptionHistoryItemValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSubscriptionHistoryItem.%s: %s%s",
+		"invalid %sHandlePaymentRefundRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SubscriptionHistoryItemValidationError{}
+var _ error = HandlePaymentRefundRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -2221,24 +2480,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SubscriptionHistoryItemValidationError{}
+} = HandlePaymentRefundRequestValidationError{}
 
-// Validate checks the field values on GetSubscriptionHistoryRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on CancelSubscriptionRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetSubscriptionHistoryRequest) Validate() error {
+func (m *CancelSubscriptionRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetSubscriptionHistoryRequest with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// GetSubscriptionHistoryRequestMultiError, or nil if none found.
-func (m *GetSubscriptionHistoryRequest) ValidateAll() error {
+// ValidateAll checks the field values on CancelSubscriptionRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CancelSubscriptionRequestMultiError, or nil if none found.
+func (m *CancelSubscriptionRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetSubscriptionHistoryRequest) validate(all bool) error {
+func (m *CancelSubscriptionRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -2246,7 +2505,7 @@ func (m *GetSubscriptionHistoryRequest) validate(all bool) error {
 	var errors []error
 
 	if l := utf8.RuneCountInString(m.GetUid()); l < 1 || l > 36 {
-		err := GetSubscriptionHistoryRequestValidationError{
+		err := CancelSubscriptionRequestValidationError{
 			field:  "Uid",
 			reason: "value length must be between 1 and 36 runes, inclusive",
 		}
@@ -2256,24 +2515,22 @@ func (m *GetSubscriptionHistoryRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	// no validation rules for Page
-
-	// no validation rules for PageSize
+	// no validation rules for Reason
 
 	if len(errors) > 0 {
-		return GetSubscriptionHistoryRequestMultiError(errors)
+		return CancelSubscriptionRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetSubscriptionHistoryRequestMultiError is an error wrapping multiple
-// validation errors returned by GetSubscriptionHistoryRequest.ValidateAll()
-// if the designated constraints aren't met.
-type GetSubscriptionHistoryRequestMultiError []error
+// CancelSubscriptionRequestMultiError is an error wrapping multiple validation
+// errors returned by CancelSubscriptionRequest.ValidateAll() if the
+// designated constraints aren't met.
+type CancelSubscriptionRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetSubscriptionHistoryRequestMultiError) Error() string {
+func (m CancelSubscriptionRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2282,12 +2539,11 @@ func (m GetSubscriptionHistoryRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetSubscriptionHistoryRequestMultiError) AllErrors() []error { return m }
+func (m CancelSubscriptionRequestMultiError) AllErrors() []error { return m }
 
-// GetSubscriptionHistoryRequestValidationError is the validation error
-// returned by GetSubscriptionHistoryRequest.Validate if the designated
-// constraints aren't met.
-type GetSubscriptionHistoryRequestValidationError struct {
+// CancelSubscriptionRequestValidationError is the validation error returned by
+// CancelSubscriptionRequest.Validate if the designated constraints aren't met.
+type CancelSubscriptionRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2295,24 +2551,24 @@ type GetSubscriptionHistoryRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetSubscriptionHistoryRequestValidationError) Field() string { return e.field }
+func (e CancelSubscriptionRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetSubscriptionHistoryRequestValidationError) Reason() string { return e.reason }
+func (e CancelSubscriptionRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetSubscriptionHistoryRequestValidationError) Cause() error { return e.cause }
+func (e CancelSubscriptionRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetSubscriptionHistoryRequestValidationError) Key() bool { return e.key }
+func (e CancelSubscriptionRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetSubscriptionHistoryRequestValidationError) ErrorName() string {
-	return "GetSubscriptionHistoryRequestValidationError"
+func (e CancelSubscriptionRequestValidationError) ErrorName() string {
+	return "CancelSubscriptionRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetSubscriptionHistoryRequestValidationError) Error() string {
+func (e CancelSubscriptionRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2324,7 +2580,629 @@ func (e GetSubscriptionHistoryRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetSubscriptionHistoryRequest.%s: %s%s",
+		"invalid %sCancelSubscriptionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CancelSubscriptionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CancelSubscriptionRequestValidationError{}
+
+// Validate checks the field values on PauseSubscriptionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PauseSubscriptionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PauseSubscriptionRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PauseSubscriptionRequestMultiError, or nil if none found.
+func (m *PauseSubscriptionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PauseSubscriptionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetUid()); l < 1 || l > 36 {
+		err := PauseSubscriptionRequestValidationError{
+			field:  "Uid",
+			reason: "value length must be between 1 and 36 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Reason
+
+	if len(errors) > 0 {
+		return PauseSubscriptionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// PauseSubscriptionRequestMultiError is an error wrapping multiple validation
+// errors returned by PauseSubscriptionRequest.ValidateAll() if the designated
+// constraints aren't met.
+type PauseSubscriptionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PauseSubscriptionRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PauseSubscriptionRequestMultiError) AllErrors() []error { return m }
+
+// PauseSubscriptionRequestValidationError is the validation error returned by
+// PauseSubscriptionRequest.Validate if the designated constraints aren't met.
+type PauseSubscriptionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PauseSubscriptionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PauseSubscriptionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PauseSubscriptionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PauseSubscriptionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PauseSubscriptionRequestValidationError) ErrorName() string {
+	return "PauseSubscriptionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PauseSubscriptionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPauseSubscriptionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PauseSubscriptionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PauseSubscriptionRequestValidationError{}
+
+// Validate checks the field values on ResumeSubscriptionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ResumeSubscriptionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ResumeSubscriptionRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ResumeSubscriptionRequestMultiError, or nil if none found.
+func (m *ResumeSubscriptionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ResumeSubscriptionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetUid()); l < 1 || l > 36 {
+		err := ResumeSubscriptionRequestValidationError{
+			field:  "Uid",
+			reason: "value length must be between 1 and 36 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ResumeSubscriptionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ResumeSubscriptionRequestMultiError is an error wrapping multiple validation
+// errors returned by ResumeSubscriptionRequest.ValidateAll() if the
+// designated constraints aren't met.
+type ResumeSubscriptionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ResumeSubscriptionRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ResumeSubscriptionRequestMultiError) AllErrors() []error { return m }
+
+// ResumeSubscriptionRequestValidationError is the validation error returned by
+// ResumeSubscriptionRequest.Validate if the designated constraints aren't met.
+type ResumeSubscriptionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ResumeSubscriptionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ResumeSubscriptionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ResumeSubscriptionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ResumeSubscriptionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ResumeSubscriptionRequestValidationError) ErrorName() string {
+	return "ResumeSubscriptionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ResumeSubscriptionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sResumeSubscriptionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ResumeSubscriptionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ResumeSubscriptionRequestValidationError{}
+
+// Validate checks the field values on SubscriptionHistoryItem with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SubscriptionHistoryItem) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SubscriptionHistoryItem with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SubscriptionHistoryItemMultiError, or nil if none found.
+func (m *SubscriptionHistoryItem) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SubscriptionHistoryItem) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for PlanId
+
+	// no validation rules for PlanName
+
+	// no validation rules for StartTime
+
+	// no validation rules for EndTime
+
+	// no validation rules for Status
+
+	// no validation rules for Action
+
+	// no validation rules for CreatedAt
+
+	// no validation rules for OperatorId
+
+	// no validation rules for Reason
+
+	// no validation rules for ActorType
+
+	// no validation rules for RequestId
+
+	for idx, item := range m.GetChanges() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SubscriptionHistoryItemValidationError{
+						field:  fmt.Sprintf("Changes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SubscriptionHistoryItemValidationError{
+						field:  fmt.Sprintf("Changes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SubscriptionHistoryItemValidationError{
+					field:  fmt.Sprintf("Changes[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return SubscriptionHistoryItemMultiError(errors)
+	}
+
+	return nil
+}
+
+// SubscriptionHistoryItemMultiError is an error wrapping multiple validation
+// errors returned by SubscriptionHistoryItem.ValidateAll() if the designated
+// constraints aren't met.
+type SubscriptionHistoryItemMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SubscriptionHistoryItemMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SubscriptionHistoryItemMultiError) AllErrors() []error { return m }
+
+// SubscriptionHistoryItemValidationError is the validation error returned by
+// SubscriptionHistoryItem.Validate if the designated constraints aren't met.
+type SubscriptionHistoryItemValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SubscriptionHistoryItemValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SubscriptionHistoryItemValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SubscriptionHistoryItemValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SubscriptionHistoryItemValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SubscriptionHistoryItemValidationError) ErrorName() string {
+	return "SubscriptionHistoryItemValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SubscriptionHistoryItemValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSubscriptionHistoryItem.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SubscriptionHistoryItemValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SubscriptionHistoryItemValidationError{}
+
+// Validate checks the field values on HistoryChange with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *HistoryChange) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on HistoryChange with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in HistoryChangeMultiError, or
+// nil if none found.
+func (m *HistoryChange) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *HistoryChange) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Field
+
+	// no validation rules for Before
+
+	// no validation rules for After
+
+	if len(errors) > 0 {
+		return HistoryChangeMultiError(errors)
+	}
+
+	return nil
+}
+
+// HistoryChangeMultiError is an error wrapping multiple validation errors
+// returned by HistoryChange.ValidateAll() if the designated constraints
+// aren't met.
+type HistoryChangeMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m HistoryChangeMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m HistoryChangeMultiError) AllErrors() []error { return m }
+
+// HistoryChangeValidationError is the validation error returned by
+// HistoryChange.Validate if the designated constraints aren't met.
+type HistoryChangeValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e HistoryChangeValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e HistoryChangeValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e HistoryChangeValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e HistoryChangeValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e HistoryChangeValidationError) ErrorName() string { return "HistoryChangeValidationError" }
+
+// Error satisfies the builtin error interface
+func (e HistoryChangeValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sHistoryChange.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = HistoryChangeValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = HistoryChangeValidationError{}
+
+// Validate checks the field values on GetSubscriptionHistoryRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetSubscriptionHistoryRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetSubscriptionHistoryRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// GetSubscriptionHistoryRequestMultiError, or nil if none found.
+func (m *GetSubscriptionHistoryRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetSubscriptionHistoryRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetUid()); l < 1 || l > 36 {
+		err := GetSubscriptionHistoryRequestValidationError{
+			field:  "Uid",
+			reason: "value length must be between 1 and 36 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Page
+
+	// no validation rules for PageSize
+
+	// no validation rules for PlanId
+
+	// no validation rules for AppId
+
+	// no validation rules for StartTime
+
+	// no validation rules for EndTime
+
+	// no validation rules for Cursor
+
+	if len(errors) > 0 {
+		return GetSubscriptionHistoryRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetSubscriptionHistoryRequestMultiError is an error wrapping multiple
+// validation errors returned by GetSubscriptionHistoryRequest.ValidateAll()
+// if the designated constraints aren't met.
+type GetSubscriptionHistoryRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetSubscriptionHistoryRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetSubscriptionHistoryRequestMultiError) AllErrors() []error { return m }
+
+// GetSubscriptionHistoryRequestValidationError is the validation error
+// returned by GetSubscriptionHistoryRequest.Validate if the designated
+// constraints aren't met.
+type GetSubscriptionHistoryRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetSubscriptionHistoryRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetSubscriptionHistoryRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetSubscriptionHistoryRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetSubscriptionHistoryRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetSubscriptionHistoryRequestValidationError) ErrorName() string {
+	return "GetSubscriptionHistoryRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetSubscriptionHistoryRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetSubscriptionHistoryRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
@@ -2339,47 +3217,11521 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetSubscriptionHistoryRequestValidationError{}
+} = GetSubscriptionHistoryRequestValidationError{}
+
+// Validate checks the field values on GetSubscriptionHistoryReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetSubscriptionHistoryReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetSubscriptionHistoryReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetSubscriptionHistoryReplyMultiError, or nil if none found.
+func (m *GetSubscriptionHistoryReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetSubscriptionHistoryReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetItems() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetSubscriptionHistoryReplyValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetSubscriptionHistoryReplyValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetSubscriptionHistoryReplyValidationError{
+					field:  fmt.Sprintf("Items[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Total
+
+	// no validation rules for Page
+
+	// no validation rules for PageSize
+
+	// no validation rules for NextCursor
+
+	if len(errors) > 0 {
+		return GetSubscriptionHistoryReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetSubscriptionHistoryReplyMultiError is an error wrapping multiple
+// validation errors returned by GetSubscriptionHistoryReply.ValidateAll() if
+// the designated constraints aren't met.
+type GetSubscriptionHistoryReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetSubscriptionHistoryReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetSubscriptionHistoryReplyMultiError) AllErrors() []error { return m }
+
+// GetSubscriptionHistoryReplyValidationError is the validation error returned
+// by GetSubscriptionHistoryReply.Validate if the designated constraints
+// aren't met.
+type GetSubscriptionHistoryReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetSubscriptionHistoryReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetSubscriptionHistoryReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetSubscriptionHistoryReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetSubscriptionHistoryReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetSubscriptionHistoryReplyValidationError) ErrorName() string {
+	return "GetSubscriptionHistoryReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetSubscriptionHistoryReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetSubscriptionHistoryReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetSubscriptionHistoryReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetSubscriptionHistoryReplyValidationError{}
+
+// Validate checks the field values on ListAppSubscriptionHistoryRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *ListAppSubscriptionHistoryRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListAppSubscriptionHistoryRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// ListAppSubscriptionHistoryRequestMultiError, or nil if none found.
+func (m *ListAppSubscriptionHistoryRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListAppSubscriptionHistoryRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetUid()) > 36 {
+		err := ListAppSubscriptionHistoryRequestValidationError{
+			field:  "Uid",
+			reason: "value length must be at most 36 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for PlanId
+
+	// no validation rules for StartTime
+
+	// no validation rules for EndTime
+
+	// no validation rules for Cursor
+
+	// no validation rules for PageSize
+
+	if len(errors) > 0 {
+		return ListAppSubscriptionHistoryRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListAppSubscriptionHistoryRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// ListAppSubscriptionHistoryRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListAppSubscriptionHistoryRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListAppSubscriptionHistoryRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListAppSubscriptionHistoryRequestMultiError) AllErrors() []error { return m }
+
+// ListAppSubscriptionHistoryRequestValidationError is the validation error
+// returned by ListAppSubscriptionHistoryRequest.Validate if the designated
+// constraints aren't met.
+type ListAppSubscriptionHistoryRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListAppSubscriptionHistoryRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListAppSubscriptionHistoryRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListAppSubscriptionHistoryRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListAppSubscriptionHistoryRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListAppSubscriptionHistoryRequestValidationError) ErrorName() string {
+	return "ListAppSubscriptionHistoryRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListAppSubscriptionHistoryRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListAppSubscriptionHistoryRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListAppSubscriptionHistoryRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListAppSubscriptionHistoryRequestValidationError{}
+
+// Validate checks the field values on ListAppSubscriptionHistoryReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListAppSubscriptionHistoryReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListAppSubscriptionHistoryReply with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ListAppSubscriptionHistoryReplyMultiError, or nil if none found.
+func (m *ListAppSubscriptionHistoryReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListAppSubscriptionHistoryReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetItems() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListAppSubscriptionHistoryReplyValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListAppSubscriptionHistoryReplyValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListAppSubscriptionHistoryReplyValidationError{
+					field:  fmt.Sprintf("Items[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextCursor
+
+	if len(errors) > 0 {
+		return ListAppSubscriptionHistoryReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListAppSubscriptionHistoryReplyMultiError is an error wrapping multiple
+// validation errors returned by ListAppSubscriptionHistoryReply.ValidateAll()
+// if the designated constraints aren't met.
+type ListAppSubscriptionHistoryReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListAppSubscriptionHistoryReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListAppSubscriptionHistoryReplyMultiError) AllErrors() []error { return m }
+
+// ListAppSubscriptionHistoryReplyValidationError is the validation error
+// returned by ListAppSubscriptionHistoryReply.Validate if the designated
+// constraints aren't met.
+type ListAppSubscriptionHistoryReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListAppSubscriptionHistoryReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListAppSubscriptionHistoryReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListAppSubscriptionHistoryReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListAppSubscriptionHistoryReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListAppSubscriptionHistoryReplyValidationError) ErrorName() string {
+	return "ListAppSubscriptionHistoryReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListAppSubscriptionHistoryReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListAppSubscriptionHistoryReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListAppSubscriptionHistoryReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListAppSubscriptionHistoryReplyValidationError{}
+
+// Validate checks the field values on ExportSubscriptionHistoryRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *ExportSubscriptionHistoryRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExportSubscriptionHistoryRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ExportSubscriptionHistoryRequestMultiError, or nil if none found.
+func (m *ExportSubscriptionHistoryRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExportSubscriptionHistoryRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if _, ok := _ExportSubscriptionHistoryRequest_Format_InLookup[m.GetFormat()]; !ok {
+		err := ExportSubscriptionHistoryRequestValidationError{
+			field:  "Format",
+			reason: "value must be in list [csv ndjson]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetUid()) > 36 {
+		err := ExportSubscriptionHistoryRequestValidationError{
+			field:  "Uid",
+			reason: "value length must be at most 36 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for PlanId
+
+	// no validation rules for StartTime
+
+	// no validation rules for EndTime
+
+	if len(errors) > 0 {
+		return ExportSubscriptionHistoryRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ExportSubscriptionHistoryRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// ExportSubscriptionHistoryRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ExportSubscriptionHistoryRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExportSubscriptionHistoryRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExportSubscriptionHistoryRequestMultiError) AllErrors() []error { return m }
+
+// ExportSubscriptionHistoryRequestValidationError is the validation error
+// returned by ExportSubscriptionHistoryRequest.Validate if the designated
+// constraints aren't met.
+type ExportSubscriptionHistoryRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExportSubscriptionHistoryRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExportSubscriptionHistoryRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExportSubscriptionHistoryRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExportSubscriptionHistoryRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExportSubscriptionHistoryRequestValidationError) ErrorName() string {
+	return "ExportSubscriptionHistoryRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ExportSubscriptionHistoryRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExportSubscriptionHistoryRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExportSubscriptionHistoryRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExportSubscriptionHistoryRequestValidationError{}
+
+var _ExportSubscriptionHistoryRequest_Format_InLookup = map[string]struct{}{
+	"csv":    {},
+	"ndjson": {},
+}
+
+// Validate checks the field values on ExportSubscriptionHistoryChunk with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ExportSubscriptionHistoryChunk) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExportSubscriptionHistoryChunk with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ExportSubscriptionHistoryChunkMultiError, or nil if none found.
+func (m *ExportSubscriptionHistoryChunk) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExportSubscriptionHistoryChunk) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Content
+
+	// no validation rules for ContentType
+
+	// no validation rules for FileName
+
+	if len(errors) > 0 {
+		return ExportSubscriptionHistoryChunkMultiError(errors)
+	}
+
+	return nil
+}
+
+// ExportSubscriptionHistoryChunkMultiError is an error wrapping multiple
+// validation errors returned by ExportSubscriptionHistoryChunk.ValidateAll()
+// if the designated constraints aren't met.
+type ExportSubscriptionHistoryChunkMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExportSubscriptionHistoryChunkMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExportSubscriptionHistoryChunkMultiError) AllErrors() []error { return m }
+
+// ExportSubscriptionHistoryChunkValidationError is the validation error
+// returned by ExportSubscriptionHistoryChunk.Validate if the designated
+// constraints aren't met.
+type ExportSubscriptionHistoryChunkValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExportSubscriptionHistoryChunkValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExportSubscriptionHistoryChunkValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExportSubscriptionHistoryChunkValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExportSubscriptionHistoryChunkValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExportSubscriptionHistoryChunkValidationError) ErrorName() string {
+	return "ExportSubscriptionHistoryChunkValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ExportSubscriptionHistoryChunkValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExportSubscriptionHistoryChunk.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExportSubscriptionHistoryChunkValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExportSubscriptionHistoryChunkValidationError{}
+
+// Validate checks the field values on SetAutoRenewRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetAutoRenewRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetAutoRenewRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SetAutoRenewRequestMultiError, or nil if none found.
+func (m *SetAutoRenewRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetAutoRenewRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetUid()); l < 1 || l > 36 {
+		err := SetAutoRenewRequestValidationError{
+			field:  "Uid",
+			reason: "value length must be between 1 and 36 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for AutoRenew
+
+	if len(errors) > 0 {
+		return SetAutoRenewRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetAutoRenewRequestMultiError is an error wrapping multiple validation
+// errors returned by SetAutoRenewRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SetAutoRenewRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetAutoRenewRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetAutoRenewRequestMultiError) AllErrors() []error { return m }
+
+// SetAutoRenewRequestValidationError is the validation error returned by
+// SetAutoRenewRequest.Validate if the designated constraints aren't met.
+type SetAutoRenewRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetAutoRenewRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetAutoRenewRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetAutoRenewRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetAutoRenewRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetAutoRenewRequestValidationError) ErrorName() string {
+	return "SetAutoRenewRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetAutoRenewRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetAutoRenewRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetAutoRenewRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetAutoRenewRequestValidationError{}
+
+// Validate checks the field values on GetExpiringSubscriptionsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetExpiringSubscriptionsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetExpiringSubscriptionsRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// GetExpiringSubscriptionsRequestMultiError, or nil if none found.
+func (m *GetExpiringSubscriptionsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetExpiringSubscriptionsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if val := m.GetDaysBeforeExpiry(); val < 1 || val > 30 {
+		err := GetExpiringSubscriptionsRequestValidationError{
+			field:  "DaysBeforeExpiry",
+			reason: "value must be inside range [1, 30]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Page
+
+	// no validation rules for PageSize
+
+	if len(errors) > 0 {
+		return GetExpiringSubscriptionsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetExpiringSubscriptionsRequestMultiError is an error wrapping multiple
+// validation errors returned by GetExpiringSubscriptionsRequest.ValidateAll()
+// if the designated constraints aren't met.
+type GetExpiringSubscriptionsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetExpiringSubscriptionsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetExpiringSubscriptionsRequestMultiError) AllErrors() []error { return m }
+
+// GetExpiringSubscriptionsRequestValidationError is the validation error
+// returned by GetExpiringSubscriptionsRequest.Validate if the designated
+// constraints aren't met.
+type GetExpiringSubscriptionsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetExpiringSubscriptionsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetExpiringSubscriptionsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetExpiringSubscriptionsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetExpiringSubscriptionsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetExpiringSubscriptionsRequestValidationError) ErrorName() string {
+	return "GetExpiringSubscriptionsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetExpiringSubscriptionsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetExpiringSubscriptionsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetExpiringSubscriptionsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetExpiringSubscriptionsRequestValidationError{}
+
+// Validate checks the field values on SubscriptionInfo with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *SubscriptionInfo) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SubscriptionInfo with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SubscriptionInfoMultiError, or nil if none found.
+func (m *SubscriptionInfo) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SubscriptionInfo) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Uid
+
+	// no validation rules for PlanId
+
+	// no validation rules for PlanName
+
+	// no validation rules for StartTime
+
+	// no validation rules for EndTime
+
+	// no validation rules for AutoRenew
+
+	// no validation rules for Amount
+
+	if len(errors) > 0 {
+		return SubscriptionInfoMultiError(errors)
+	}
+
+	return nil
+}
+
+// SubscriptionInfoMultiError is an error wrapping multiple validation errors
+// returned by SubscriptionInfo.ValidateAll() if the designated constraints
+// aren't met.
+type SubscriptionInfoMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SubscriptionInfoMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SubscriptionInfoMultiError) AllErrors() []error { return m }
+
+// SubscriptionInfoValidationError is the validation error returned by
+// SubscriptionInfo.Validate if the designated constraints aren't met.
+type SubscriptionInfoValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SubscriptionInfoValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SubscriptionInfoValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SubscriptionInfoValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SubscriptionInfoValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SubscriptionInfoValidationError) ErrorName() string { return "SubscriptionInfoValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SubscriptionInfoValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSubscriptionInfo.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SubscriptionInfoValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SubscriptionInfoValidationError{}
+
+// Validate checks the field values on GetExpiringSubscriptionsReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetExpiringSubscriptionsReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetExpiringSubscriptionsReply with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// GetExpiringSubscriptionsReplyMultiError, or nil if none found.
+func (m *GetExpiringSubscriptionsReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetExpiringSubscriptionsReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetSubscriptions() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetExpiringSubscriptionsReplyValidationError{
+						field:  fmt.Sprintf("Subscriptions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetExpiringSubscriptionsReplyValidationError{
+						field:  fmt.Sprintf("Subscriptions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetExpiringSubscriptionsReplyValidationError{
+					field:  fmt.Sprintf("Subscriptions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Total
+
+	// no validation rules for Page
+
+	// no validation rules for PageSize
+
+	if len(errors) > 0 {
+		return GetExpiringSubscriptionsReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetExpiringSubscriptionsReplyMultiError is an error wrapping multiple
+// validation errors returned by GetExpiringSubscriptionsReply.ValidateAll()
+// if the designated constraints aren't met.
+type GetExpiringSubscriptionsReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetExpiringSubscriptionsReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetExpiringSubscriptionsReplyMultiError) AllErrors() []error { return m }
+
+// GetExpiringSubscriptionsReplyValidationError is the validation error
+// returned by GetExpiringSubscriptionsReply.Validate if the designated
+// constraints aren't met.
+type GetExpiringSubscriptionsReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetExpiringSubscriptionsReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetExpiringSubscriptionsReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetExpiringSubscriptionsReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetExpiringSubscriptionsReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetExpiringSubscriptionsReplyValidationError) ErrorName() string {
+	return "GetExpiringSubscriptionsReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetExpiringSubscriptionsReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetExpiringSubscriptionsReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetExpiringSubscriptionsReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetExpiringSubscriptionsReplyValidationError{}
+
+// Validate checks the field values on UpdateExpiredSubscriptionsRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *UpdateExpiredSubscriptionsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateExpiredSubscriptionsRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// UpdateExpiredSubscriptionsRequestMultiError, or nil if none found.
+func (m *UpdateExpiredSubscriptionsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateExpiredSubscriptionsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return UpdateExpiredSubscriptionsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateExpiredSubscriptionsRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// UpdateExpiredSubscriptionsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateExpiredSubscriptionsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateExpiredSubscriptionsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateExpiredSubscriptionsRequestMultiError) AllErrors() []error { return m }
+
+// UpdateExpiredSubscriptionsRequestValidationError is the validation error
+// returned by UpdateExpiredSubscriptionsRequest.Validate if the designated
+// constraints aren't met.
+type UpdateExpiredSubscriptionsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateExpiredSubscriptionsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateExpiredSubscriptionsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateExpiredSubscriptionsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateExpiredSubscriptionsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateExpiredSubscriptionsRequestValidationError) ErrorName() string {
+	return "UpdateExpiredSubscriptionsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateExpiredSubscriptionsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateExpiredSubscriptionsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateExpiredSubscriptionsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateExpiredSubscriptionsRequestValidationError{}
+
+// Validate checks the field values on UpdateExpiredSubscriptionsReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateExpiredSubscriptionsReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateExpiredSubscriptionsReply with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// UpdateExpiredSubscriptionsReplyMultiError, or nil if none found.
+func (m *UpdateExpiredSubscriptionsReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateExpiredSubscriptionsReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UpdatedCount
+
+	if len(errors) > 0 {
+		return UpdateExpiredSubscriptionsReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateExpiredSubscriptionsReplyMultiError is an error wrapping multiple
+// validation errors returned by UpdateExpiredSubscriptionsReply.ValidateAll()
+// if the designated constraints aren't met.
+type UpdateExpiredSubscriptionsReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateExpiredSubscriptionsReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateExpiredSubscriptionsReplyMultiError) AllErrors() []error { return m }
+
+// UpdateExpiredSubscriptionsReplyValidationError is the validation error
+// returned by UpdateExpiredSubscriptionsReply.Validate if the designated
+// constraints aren't met.
+type UpdateExpiredSubscriptionsReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateExpiredSubscriptionsReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateExpiredSubscriptionsReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateExpiredSubscriptionsReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateExpiredSubscriptionsReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateExpiredSubscriptionsReplyValidationError) ErrorName() string {
+	return "UpdateExpiredSubscriptionsReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateExpiredSubscriptionsReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateExpiredSubscriptionsReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateExpiredSubscriptionsReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateExpiredSubscriptionsReplyValidationError{}
+
+// Validate checks the field values on ProcessAutoRenewalsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ProcessAutoRenewalsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ProcessAutoRenewalsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ProcessAutoRenewalsRequestMultiError, or nil if none found.
+func (m *ProcessAutoRenewalsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ProcessAutoRenewalsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if val := m.GetDaysBeforeExpiry(); val < 1 || val > 30 {
+		err := ProcessAutoRenewalsRequestValidationError{
+			field:  "DaysBeforeExpiry",
+			reason: "value must be inside range [1, 30]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for DryRun
+
+	if len(errors) > 0 {
+		return ProcessAutoRenewalsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ProcessAutoRenewalsRequestMultiError is an error wrapping multiple
+// validation errors returned by ProcessAutoRenewalsRequest.ValidateAll() if
+// the designated constraints aren't met.
+type ProcessAutoRenewalsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ProcessAutoRenewalsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ProcessAutoRenewalsRequestMultiError) AllErrors() []error { return m }
+
+// ProcessAutoRenewalsRequestValidationError is the validation error returned
+// by ProcessAutoRenewalsRequest.Validate if the designated constraints aren't met.
+type ProcessAutoRenewalsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ProcessAutoRenewalsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ProcessAutoRenewalsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ProcessAutoRenewalsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ProcessAutoRenewalsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ProcessAutoRenewalsRequestValidationError) ErrorName() string {
+	return "ProcessAutoRenewalsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ProcessAutoRenewalsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sProcessAutoRenewalsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ProcessAutoRenewalsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ProcessAutoRenewalsRequestValidationError{}
+
+// Validate checks the field values on AutoRenewResult with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *AutoRenewResult) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AutoRenewResult with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AutoRenewResultMultiError, or nil if none found.
+func (m *AutoRenewResult) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AutoRenewResult) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Uid
+
+	// no validation rules for PlanId
+
+	// no validation rules for Success
+
+	// no validation rules for OrderId
+
+	// no validation rules for PaymentId
+
+	// no validation rules for ErrorMessage
+
+	if len(errors) > 0 {
+		return AutoRenewResultMultiError(errors)
+	}
+
+	return nil
+}
+
+// AutoRenewResultMultiError is an error wrapping multiple validation errors
+// returned by AutoRenewResult.ValidateAll() if the designated constraints
+// aren't met.
+type AutoRenewResultMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AutoRenewResultMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AutoRenewResultMultiError) AllErrors() []error { return m }
+
+// AutoRenewResultValidationError is the validation error returned by
+// AutoRenewResult.Validate if the designated constraints aren't met.
+type AutoRenewResultValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AutoRenewResultValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AutoRenewResultValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AutoRenewResultValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AutoRenewResultValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AutoRenewResultValidationError) ErrorName() string { return "AutoRenewResultValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AutoRenewResultValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAutoRenewResult.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AutoRenewResultValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AutoRenewResultValidationError{}
+
+// Validate checks the field values on ProcessAutoRenewalsReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ProcessAutoRenewalsReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ProcessAutoRenewalsReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ProcessAutoRenewalsReplyMultiError, or nil if none found.
+func (m *ProcessAutoRenewalsReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ProcessAutoRenewalsReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TotalCount
+
+	// no validation rules for SuccessCount
+
+	// no validation rules for FailedCount
+
+	for idx, item := range m.GetResults() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ProcessAutoRenewalsReplyValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ProcessAutoRenewalsReplyValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ProcessAutoRenewalsReplyValidationError{
+					field:  fmt.Sprintf("Results[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ProcessAutoRenewalsReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ProcessAutoRenewalsReplyMultiError is an error wrapping multiple validation
+// errors returned by ProcessAutoRenewalsReply.ValidateAll() if the designated
+// constraints aren't met.
+type ProcessAutoRenewalsReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ProcessAutoRenewalsReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ProcessAutoRenewalsReplyMultiError) AllErrors() []error { return m }
+
+// ProcessAutoRenewalsReplyValidationError is the validation error returned by
+// ProcessAutoRenewalsReply.Validate if the designated constraints aren't met.
+type ProcessAutoRenewalsReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ProcessAutoRenewalsReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ProcessAutoRenewalsReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ProcessAutoRenewalsReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ProcessAutoRenewalsReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ProcessAutoRenewalsReplyValidationError) ErrorName() string {
+	return "ProcessAutoRenewalsReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ProcessAutoRenewalsReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sProcessAutoRenewalsReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ProcessAutoRenewalsReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ProcessAutoRenewalsReplyValidationError{}
+
+// Validate checks the field values on ProcessPriceChangeNoticesRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *ProcessPriceChangeNoticesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ProcessPriceChangeNoticesRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ProcessPriceChangeNoticesRequestMultiError, or nil if none found.
+func (m *ProcessPriceChangeNoticesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ProcessPriceChangeNoticesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if val := m.GetDaysBeforeRenewal(); val < 1 || val > 60 {
+		err := ProcessPriceChangeNoticesRequestValidationError{
+			field:  "DaysBeforeRenewal",
+			reason: "value must be inside range [1, 60]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for DryRun
+
+	if len(errors) > 0 {
+		return ProcessPriceChangeNoticesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ProcessPriceChangeNoticesRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// ProcessPriceChangeNoticesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ProcessPriceChangeNoticesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ProcessPriceChangeNoticesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ProcessPriceChangeNoticesRequestMultiError) AllErrors() []error { return m }
+
+// ProcessPriceChangeNoticesRequestValidationError is the validation error
+// returned by ProcessPriceChangeNoticesRequest.Validate if the designated
+// constraints aren't met.
+type ProcessPriceChangeNoticesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ProcessPriceChangeNoticesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ProcessPriceChangeNoticesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ProcessPriceChangeNoticesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ProcessPriceChangeNoticesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ProcessPriceChangeNoticesRequestValidationError) ErrorName() string {
+	return "ProcessPriceChangeNoticesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ProcessPriceChangeNoticesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sProcessPriceChangeNoticesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ProcessPriceChangeNoticesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ProcessPriceChangeNoticesRequestValidationError{}
+
+// Validate checks the field values on PriceChangeNotice with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *PriceChangeNotice) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PriceChangeNotice with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PriceChangeNoticeMultiError, or nil if none found.
+func (m *PriceChangeNotice) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PriceChangeNotice) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Uid
+
+	// no validation rules for PlanId
+
+	// no validation rules for CountryCode
+
+	// no validation rules for OldPrice
+
+	// no validation rules for OldCurrency
+
+	// no validation rules for NewPrice
+
+	// no validation rules for NewCurrency
+
+	// no validation rules for RenewAt
+
+	if len(errors) > 0 {
+		return PriceChangeNoticeMultiError(errors)
+	}
+
+	return nil
+}
+
+// PriceChangeNoticeMultiError is an error wrapping multiple validation errors
+// returned by PriceChangeNotice.ValidateAll() if the designated constraints
+// aren't met.
+type PriceChangeNoticeMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PriceChangeNoticeMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PriceChangeNoticeMultiError) AllErrors() []error { return m }
+
+// PriceChangeNoticeValidationError is the validation error returned by
+// PriceChangeNotice.Validate if the designated constraints aren't met.
+type PriceChangeNoticeValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PriceChangeNoticeValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PriceChangeNoticeValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PriceChangeNoticeValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PriceChangeNoticeValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PriceChangeNoticeValidationError) ErrorName() string {
+	return "PriceChangeNoticeValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PriceChangeNoticeValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPriceChangeNotice.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PriceChangeNoticeValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PriceChangeNoticeValidationError{}
+
+// Validate checks the field values on ProcessPriceChangeNoticesReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ProcessPriceChangeNoticesReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ProcessPriceChangeNoticesReply with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ProcessPriceChangeNoticesReplyMultiError, or nil if none found.
+func (m *ProcessPriceChangeNoticesReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ProcessPriceChangeNoticesReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TotalCount
+
+	// no validation rules for NotifiedCount
+
+	for idx, item := range m.GetNotices() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ProcessPriceChangeNoticesReplyValidationError{
+						field:  fmt.Sprintf("Notices[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ProcessPriceChangeNoticesReplyValidationError{
+						field:  fmt.Sprintf("Notices[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ProcessPriceChangeNoticesReplyValidationError{
+					field:  fmt.Sprintf("Notices[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ProcessPriceChangeNoticesReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ProcessPriceChangeNoticesReplyMultiError is an error wrapping multiple
+// validation errors returned by ProcessPriceChangeNoticesReply.ValidateAll()
+// if the designated constraints aren't met.
+type ProcessPriceChangeNoticesReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ProcessPriceChangeNoticesReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ProcessPriceChangeNoticesReplyMultiError) AllErrors() []error { return m }
+
+// ProcessPriceChangeNoticesReplyValidationError is the validation error
+// returned by ProcessPriceChangeNoticesReply.Validate if the designated
+// constraints aren't met.
+type ProcessPriceChangeNoticesReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ProcessPriceChangeNoticesReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ProcessPriceChangeNoticesReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ProcessPriceChangeNoticesReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ProcessPriceChangeNoticesReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ProcessPriceChangeNoticesReplyValidationError) ErrorName() string {
+	return "ProcessPriceChangeNoticesReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ProcessPriceChangeNoticesReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sProcessPriceChangeNoticesReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ProcessPriceChangeNoticesReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ProcessPriceChangeNoticesReplyValidationError{}
+
+// Validate checks the field values on PlanPricing with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *PlanPricing) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PlanPricing with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in PlanPricingMultiError, or
+// nil if none found.
+func (m *PlanPricing) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PlanPricing) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for PlanPricingId
+
+	// no validation rules for PlanId
+
+	// no validation rules for CountryCode
+
+	// no validation rules for Price
+
+	// no validation rules for Currency
+
+	// no validation rules for EffectiveFrom
+
+	// no validation rules for EffectiveTo
+
+	if len(errors) > 0 {
+		return PlanPricingMultiError(errors)
+	}
+
+	return nil
+}
+
+// PlanPricingMultiError is an error wrapping multiple validation errors
+// returned by PlanPricing.ValidateAll() if the designated constraints aren't met.
+type PlanPricingMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PlanPricingMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PlanPricingMultiError) AllErrors() []error { return m }
+
+// PlanPricingValidationError is the validation error returned by
+// PlanPricing.Validate if the designated constraints aren't met.
+type PlanPricingValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PlanPricingValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PlanPricingValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PlanPricingValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PlanPricingValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PlanPricingValidationError) ErrorName() string { return "PlanPricingValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PlanPricingValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPlanPricing.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PlanPricingValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PlanPricingValidationError{}
+
+// Validate checks the field values on ListPlanPricingsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListPlanPricingsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListPlanPricingsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListPlanPricingsRequestMultiError, or nil if none found.
+func (m *ListPlanPricingsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListPlanPricingsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetPlanId()) < 1 {
+		err := ListPlanPricingsRequestValidationError{
+			field:  "PlanId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListPlanPricingsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListPlanPricingsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListPlanPricingsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListPlanPricingsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListPlanPricingsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListPlanPricingsRequestMultiError) AllErrors() []error { return m }
+
+// ListPlanPricingsRequestValidationError is the validation error returned by
+// ListPlanPricingsRequest.Validate if the designated constraints aren't met.
+type ListPlanPricingsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListPlanPricingsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListPlanPricingsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListPlanPricingsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListPlanPricingsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListPlanPricingsRequestValidationError) ErrorName() string {
+	return "ListPlanPricingsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListPlanPricingsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListPlanPricingsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListPlanPricingsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListPlanPricingsRequestValidationError{}
+
+// Validate checks the field values on ListPlanPricingsReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListPlanPricingsReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListPlanPricingsReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListPlanPricingsReplyMultiError, or nil if none found.
+func (m *ListPlanPricingsReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListPlanPricingsReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetPricings() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListPlanPricingsReplyValidationError{
+						field:  fmt.Sprintf("Pricings[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListPlanPricingsReplyValidationError{
+						field:  fmt.Sprintf("Pricings[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListPlanPricingsReplyValidationError{
+					field:  fmt.Sprintf("Pricings[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListPlanPricingsReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListPlanPricingsReplyMultiError is an error wrapping multiple validation
+// errors returned by ListPlanPricingsReply.ValidateAll() if the designated
+// constraints aren't met.
+type ListPlanPricingsReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListPlanPricingsReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListPlanPricingsReplyMultiError) AllErrors() []error { return m }
+
+// ListPlanPricingsReplyValidationError is the validation error returned by
+// ListPlanPricingsReply.Validate if the designated constraints aren't met.
+type ListPlanPricingsReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListPlanPricingsReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListPlanPricingsReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListPlanPricingsReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListPlanPricingsReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListPlanPricingsReplyValidationError) ErrorName() string {
+	return "ListPlanPricingsReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListPlanPricingsReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListPlanPricingsReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListPlanPricingsReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListPlanPricingsReplyValidationError{}
+
+// Validate checks the field values on CreatePlanPricingRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreatePlanPricingRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreatePlanPricingRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreatePlanPricingRequestMultiError, or nil if none found.
+func (m *CreatePlanPricingRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreatePlanPricingRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetPlanId()) < 1 {
+		err := CreatePlanPricingRequestValidationError{
+			field:  "PlanId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetCountryCode()); l < 2 || l > 10 {
+		err := CreatePlanPricingRequestValidationError{
+			field:  "CountryCode",
+			reason: "value length must be between 2 and 10 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetPrice() <= 0 {
+		err := CreatePlanPricingRequestValidationError{
+			field:  "Price",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetCurrency()) != 3 {
+		err := CreatePlanPricingRequestValidationError{
+			field:  "Currency",
+			reason: "value length must be 3 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+
+	}
+
+	if m.GetEffectiveFrom() < 0 {
+		err := CreatePlanPricingRequestValidationError{
+			field:  "EffectiveFrom",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetEffectiveTo() < 0 {
+		err := CreatePlanPricingRequestValidationError{
+			field:  "EffectiveTo",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CreatePlanPricingRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreatePlanPricingRequestMultiError is an error wrapping multiple validation
+// errors returned by CreatePlanPricingRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreatePlanPricingRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreatePlanPricingRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreatePlanPricingRequestMultiError) AllErrors() []error { return m }
+
+// CreatePlanPricingRequestValidationError is the validation error returned by
+// CreatePlanPricingRequest.Validate if the designated constraints aren't met.
+type CreatePlanPricingRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreatePlanPricingRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreatePlanPricingRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreatePlanPricingRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreatePlanPricingRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreatePlanPricingRequestValidationError) ErrorName() string {
+	return "CreatePlanPricingRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreatePlanPricingRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreatePlanPricingRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreatePlanPricingRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreatePlanPricingRequestValidationError{}
+
+// Validate checks the field values on CreatePlanPricingReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreatePlanPricingReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreatePlanPricingReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreatePlanPricingReplyMultiError, or nil if none found.
+func (m *CreatePlanPricingReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreatePlanPricingReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetPricing()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreatePlanPricingReplyValidationError{
+					field:  "Pricing",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreatePlanPricingReplyValidationError{
+					field:  "Pricing",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPricing()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreatePlanPricingReplyValidationError{
+				field:  "Pricing",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreatePlanPricingReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreatePlanPricingReplyMultiError is an error wrapping multiple validation
+// errors returned by CreatePlanPricingReply.ValidateAll() if the designated
+// constraints aren't met.
+type CreatePlanPricingReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreatePlanPricingReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreatePlanPricingReplyMultiError) AllErrors() []error { return m }
+
+// CreatePlanPricingReplyValidationError is the validation error returned by
+// CreatePlanPricingReply.Validate if the designated constraints aren't met.
+type CreatePlanPricingReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreatePlanPricingReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreatePlanPricingReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreatePlanPricingReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreatePlanPricingReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreatePlanPricingReplyValidationError) ErrorName() string {
+	return "CreatePlanPricingReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreatePlanPricingReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreatePlanPricingReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreatePlanPricingReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreatePlanPricingReplyValidationError{}
+
+// Validate checks the field values on UpdatePlanPricingRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdatePlanPricingRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdatePlanPricingRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdatePlanPricingRequestMultiError, or nil if none found.
+func (m *UpdatePlanPricingRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdatePlanPricingRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetPlanPricingId() <= 0 {
+		err := UpdatePlanPricingRequestValidationError{
+			field:  "PlanPricingId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetPrice() <= 0 {
+		err := UpdatePlanPricingRequestValidationError{
+			field:  "Price",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetCurrency()) != 3 {
+		err := UpdatePlanPricingRequestValidationError{
+			field:  "Currency",
+			reason: "value length must be 3 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+
+	}
+
+	if len(errors) > 0 {
+		return UpdatePlanPricingRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdatePlanPricingRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdatePlanPricingRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UpdatePlanPricingRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdatePlanPricingRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdatePlanPricingRequestMultiError) AllErrors() []error { return m }
+
+// UpdatePlanPricingRequestValidationError is the validation error returned by
+// UpdatePlanPricingRequest.Validate if the designated constraints aren't met.
+type UpdatePlanPricingRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdatePlanPricingRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdatePlanPricingRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdatePlanPricingRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdatePlanPricingRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdatePlanPricingRequestValidationError) ErrorName() string {
+	return "UpdatePlanPricingRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdatePlanPricingRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdatePlanPricingRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdatePlanPricingRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdatePlanPricingRequestValidationError{}
+
+// Validate checks the field values on UpdatePlanPricingReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdatePlanPricingReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdatePlanPricingReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdatePlanPricingReplyMultiError, or nil if none found.
+func (m *UpdatePlanPricingReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdatePlanPricingReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetPricing()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdatePlanPricingReplyValidationError{
+					field:  "Pricing",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdatePlanPricingReplyValidationError{
+					field:  "Pricing",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPricing()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdatePlanPricingReplyValidationError{
+				field:  "Pricing",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdatePlanPricingReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdatePlanPricingReplyMultiError is an error wrapping multiple validation
+// errors returned by UpdatePlanPricingReply.ValidateAll() if the designated
+// constraints aren't met.
+type UpdatePlanPricingReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdatePlanPricingReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdatePlanPricingReplyMultiError) AllErrors() []error { return m }
+
+// UpdatePlanPricingReplyValidationError is the validation error returned by
+// UpdatePlanPricingReply.Validate if the designated constraints aren't met.
+type UpdatePlanPricingReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdatePlanPricingReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdatePlanPricingReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdatePlanPricingReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdatePlanPricingReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdatePlanPricingReplyValidationError) ErrorName() string {
+	return "UpdatePlanPricingReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdatePlanPricingReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdatePlanPricingReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdatePlanPricingReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdatePlanPricingReplyValidationError{}
+
+// Validate checks the field values on DeletePlanPricingRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeletePlanPricingRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeletePlanPricingRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeletePlanPricingRequestMultiError, or nil if none found.
+func (m *DeletePlanPricingRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeletePlanPricingRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetPlanPricingId() <= 0 {
+		err := DeletePlanPricingRequestValidationError{
+			field:  "PlanPricingId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DeletePlanPricingRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeletePlanPricingRequestMultiError is an error wrapping multiple validation
+// errors returned by DeletePlanPricingRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeletePlanPricingRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeletePlanPricingRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeletePlanPricingRequestMultiError) AllErrors() []error { return m }
+
+// DeletePlanPricingRequestValidationError is the validation error returned by
+// DeletePlanPricingRequest.Validate if the designated constraints aren't met.
+type DeletePlanPricingRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeletePlanPricingRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeletePlanPricingRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeletePlanPricingRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeletePlanPricingRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeletePlanPricingRequestValidationError) ErrorName() string {
+	return "DeletePlanPricingRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeletePlanPricingRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeletePlanPricingRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeletePlanPricingRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeletePlanPricingRequestValidationError{}
+
+// Validate checks the field values on DeletePlanPricingReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeletePlanPricingReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeletePlanPricingReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeletePlanPricingReplyMultiError, or nil if none found.
+func (m *DeletePlanPricingReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeletePlanPricingReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for PlanPricingId
+
+	if len(errors) > 0 {
+		return DeletePlanPricingReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeletePlanPricingReplyMultiError is an error wrapping multiple validation
+// errors returned by DeletePlanPricingReply.ValidateAll() if the designated
+// constraints aren't met.
+type DeletePlanPricingReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeletePlanPricingReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeletePlanPricingReplyMultiError) AllErrors() []error { return m }
+
+// DeletePlanPricingReplyValidationError is the validation error returned by
+// DeletePlanPricingReply.Validate if the designated constraints aren't met.
+type DeletePlanPricingReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeletePlanPricingReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeletePlanPricingReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeletePlanPricingReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeletePlanPricingReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeletePlanPricingReplyValidationError) ErrorName() string {
+	return "DeletePlanPricingReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeletePlanPricingReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeletePlanPricingReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeletePlanPricingReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeletePlanPricingReplyValidationError{}
+
+// Validate checks the field values on AppSetting with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AppSetting) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AppSetting with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AppSettingMultiError, or
+// nil if none found.
+func (m *AppSetting) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AppSetting) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for AppId
+
+	// no validation rules for DefaultFreePlanId
+
+	// no validation rules for UpdatedAt
+
+	// no validation rules for ReportingCurrency
+
+	if len(errors) > 0 {
+		return AppSettingMultiError(errors)
+	}
+
+	return nil
+}
+
+// AppSettingMultiError is an error wrapping multiple validation errors
+// returned by AppSetting.ValidateAll() if the designated constraints aren't met.
+type AppSettingMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AppSettingMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AppSettingMultiError) AllErrors() []error { return m }
+
+// AppSettingValidationError is the validation error returned by
+// AppSetting.Validate if the designated constraints aren't met.
+type AppSettingValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AppSettingValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AppSettingValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AppSettingValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AppSettingValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AppSettingValidationError) ErrorName() string { return "AppSettingValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AppSettingValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAppSetting.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AppSettingValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AppSettingValidationError{}
+
+// Validate checks the field values on GetAppSettingRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetAppSettingRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetAppSettingRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetAppSettingRequestMultiError, or nil if none found.
+func (m *GetAppSettingRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetAppSettingRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for AppId
+
+	if len(errors) > 0 {
+		return GetAppSettingRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetAppSettingRequestMultiError is an error wrapping multiple validation
+// errors returned by GetAppSettingRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetAppSettingRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetAppSettingRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetAppSettingRequestMultiError) AllErrors() []error { return m }
+
+// GetAppSettingRequestValidationError is the validation error returned by
+// GetAppSettingRequest.Validate if the designated constraints aren't met.
+type GetAppSettingRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetAppSettingRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetAppSettingRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetAppSettingRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetAppSettingRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetAppSettingRequestValidationError) ErrorName() string {
+	return "GetAppSettingRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetAppSettingRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetAppSettingRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetAppSettingRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetAppSettingRequestValidationError{}
+
+// Validate checks the field values on GetAppSettingReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetAppSettingReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetAppSettingReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetAppSettingReplyMultiError, or nil if none found.
+func (m *GetAppSettingReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetAppSettingReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetSetting()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetAppSettingReplyValidationError{
+					field:  "Setting",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetAppSettingReplyValidationError{
+					field:  "Setting",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSetting()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetAppSettingReplyValidationError{
+				field:  "Setting",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetAppSettingReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetAppSettingReplyMultiError is an error wrapping multiple validation errors
+// returned by GetAppSettingReply.ValidateAll() if the designated constraints
+// aren't met.
+type GetAppSettingReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetAppSettingReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetAppSettingReplyMultiError) AllErrors() []error { return m }
+
+// GetAppSettingReplyValidationError is the validation error returned by
+// GetAppSettingReply.Validate if the designated constraints aren't met.
+type GetAppSettingReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetAppSettingReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetAppSettingReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetAppSettingReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetAppSettingReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetAppSettingReplyValidationError) ErrorName() string {
+	return "GetAppSettingReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetAppSettingReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetAppSettingReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetAppSettingReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetAppSettingReplyValidationError{}
+
+// Validate checks the field values on UpdateAppSettingRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateAppSettingRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateAppSettingRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateAppSettingRequestMultiError, or nil if none found.
+func (m *UpdateAppSettingRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateAppSettingRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetDefaultFreePlanId()) > 50 {
+		err := UpdateAppSettingRequestValidationError{
+			field:  "DefaultFreePlanId",
+			reason: "value length must be at most 50 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetRegionDetection()) > 5 {
+		err := UpdateAppSettingRequestValidationError{
+			field:  "RegionDetection",
+			reason: "value must contain no more than 5 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	_UpdateAppSettingRequest_RegionDetection_Unique := make(map[string]struct{}, len(m.GetRegionDetection()))
+
+	for idx, item := range m.GetRegionDetection() {
+		_, _ = idx, item
+
+		if _, exists := _UpdateAppSettingRequest_RegionDetection_Unique[item]; exists {
+			err := UpdateAppSettingRequestValidationError{
+				field:  fmt.Sprintf("RegionDetection[%v]", idx),
+				reason: "repeated value must contain unique items",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+			_UpdateAppSettingRequest_RegionDetection_Unique[item] = struct{}{}
+		}
+
+		if _, ok := _UpdateAppSettingRequest_RegionDetection_InLookup[item]; !ok {
+			err := UpdateAppSettingRequestValidationError{
+				field:  fmt.Sprintf("RegionDetection[%v]", idx),
+				reason: "value must be in list [user_profile geoip passport_geoip accept_language x_language]",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if utf8.RuneCountInString(m.GetReportingCurrency()) > 3 {
+		err := UpdateAppSettingRequestValidationError{
+			field:  "ReportingCurrency",
+			reason: "value length must be at most 3 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return UpdateAppSettingRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateAppSettingRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdateAppSettingRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateAppSettingRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateAppSettingRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateAppSettingRequestMultiError) AllErrors() []error { return m }
+
+// UpdateAppSettingRequestValidationError is the validation error returned by
+// UpdateAppSettingRequest.Validate if the designated constraints aren't met.
+type UpdateAppSettingRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateAppSettingRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateAppSettingRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateAppSettingRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateAppSettingRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateAppSettingRequestValidationError) ErrorName() string {
+	return "UpdateAppSettingRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateAppSettingRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateAppSettingRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateAppSettingRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateAppSettingRequestValidationError{}
+
+var _UpdateAppSettingRequest_RegionDetection_InLookup = map[string]struct{}{
+	"user_profile":    {},
+	"geoip":           {},
+	"passport_geoip":  {},
+	"accept_language": {},
+	"x_language":      {},
+}
+
+// Validate checks the field values on UpdateAppSettingReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateAppSettingReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateAppSettingReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateAppSettingReplyMultiError, or nil if none found.
+func (m *UpdateAppSettingReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateAppSettingReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetSetting()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateAppSettingReplyValidationError{
+					field:  "Setting",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateAppSettingReplyValidationError{
+					field:  "Setting",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSetting()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateAppSettingReplyValidationError{
+				field:  "Setting",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateAppSettingReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateAppSettingReplyMultiError is an error wrapping multiple validation
+// errors returned by UpdateAppSettingReply.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateAppSettingReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateAppSettingReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateAppSettingReplyMultiError) AllErrors() []error { return m }
+
+// UpdateAppSettingReplyValidationError is the validation error returned by
+// UpdateAppSettingReply.Validate if the designated constraints aren't met.
+type UpdateAppSettingReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateAppSettingReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateAppSettingReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateAppSettingReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateAppSettingReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateAppSettingReplyValidationError) ErrorName() string {
+	return "UpdateAppSettingReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateAppSettingReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateAppSettingReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateAppSettingReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateAppSettingReplyValidationError{}
+
+// Validate checks the field values on RegionGroup with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *RegionGroup) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RegionGroup with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in RegionGroupMultiError, or
+// nil if none found.
+func (m *RegionGroup) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RegionGroup) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for GroupCode
+
+	// no validation rules for Name
+
+	// no validation rules for Description
+
+	// no validation rules for UpdatedAt
+
+	if len(errors) > 0 {
+		return RegionGroupMultiError(errors)
+	}
+
+	return nil
+}
+
+// RegionGroupMultiError is an error wrapping multiple validation errors
+// returned by RegionGroup.ValidateAll() if the designated constraints aren't met.
+type RegionGroupMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RegionGroupMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RegionGroupMultiError) AllErrors() []error { return m }
+
+// RegionGroupValidationError is the validation error returned by
+// RegionGroup.Validate if the designated constraints aren't met.
+type RegionGroupValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RegionGroupValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RegionGroupValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RegionGroupValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RegionGroupValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RegionGroupValidationError) ErrorName() string { return "RegionGroupValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RegionGroupValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRegionGroup.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RegionGroupValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RegionGroupValidationError{}
+
+// Validate checks the field values on ListRegionGroupsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListRegionGroupsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListRegionGroupsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListRegionGroupsRequestMultiError, or nil if none found.
+func (m *ListRegionGroupsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListRegionGroupsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ListRegionGroupsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListRegionGroupsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListRegionGroupsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListRegionGroupsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListRegionGroupsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListRegionGroupsRequestMultiError) AllErrors() []error { return m }
+
+// ListRegionGroupsRequestValidationError is the validation error returned by
+// ListRegionGroupsRequest.Validate if the designated constraints aren't met.
+type ListRegionGroupsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListRegionGroupsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListRegionGroupsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListRegionGroupsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListRegionGroupsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListRegionGroupsRequestValidationError) ErrorName() string {
+	return "ListRegionGroupsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListRegionGroupsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListRegionGroupsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListRegionGroupsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListRegionGroupsRequestValidationError{}
+
+// Validate checks the field values on ListRegionGroupsReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListRegionGroupsReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListRegionGroupsReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListRegionGroupsReplyMultiError, or nil if none found.
+func (m *ListRegionGroupsReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListRegionGroupsReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetGroups() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListRegionGroupsReplyValidationError{
+						field:  fmt.Sprintf("Groups[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListRegionGroupsReplyValidationError{
+						field:  fmt.Sprintf("Groups[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListRegionGroupsReplyValidationError{
+					field:  fmt.Sprintf("Groups[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListRegionGroupsReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListRegionGroupsReplyMultiError is an error wrapping multiple validation
+// errors returned by ListRegionGroupsReply.ValidateAll() if the designated
+// constraints aren't met.
+type ListRegionGroupsReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListRegionGroupsReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListRegionGroupsReplyMultiError) AllErrors() []error { return m }
+
+// ListRegionGroupsReplyValidationError is the validation error returned by
+// ListRegionGroupsReply.Validate if the designated constraints aren't met.
+type ListRegionGroupsReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListRegionGroupsReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListRegionGroupsReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListRegionGroupsReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListRegionGroupsReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListRegionGroupsReplyValidationError) ErrorName() string {
+	return "ListRegionGroupsReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListRegionGroupsReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListRegionGroupsReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListRegionGroupsReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListRegionGroupsReplyValidationError{}
+
+// Validate checks the field values on GetRegionGroupRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetRegionGroupRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetRegionGroupRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetRegionGroupRequestMultiError, or nil if none found.
+func (m *GetRegionGroupRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetRegionGroupRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetGroupCode()); l < 2 || l > 10 {
+		err := GetRegionGroupRequestValidationError{
+			field:  "GroupCode",
+			reason: "value length must be between 2 and 10 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetRegionGroupRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetRegionGroupRequestMultiError is an error wrapping multiple validation
+// errors returned by GetRegionGroupRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetRegionGroupRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetRegionGroupRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetRegionGroupRequestMultiError) AllErrors() []error { return m }
+
+// GetRegionGroupRequestValidationError is the validation error returned by
+// GetRegionGroupRequest.Validate if the designated constraints aren't met.
+type GetRegionGroupRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetRegionGroupRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetRegionGroupRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetRegionGroupRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetRegionGroupRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetRegionGroupRequestValidationError) ErrorName() string {
+	return "GetRegionGroupRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetRegionGroupRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetRegionGroupRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetRegionGroupRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetRegionGroupRequestValidationError{}
+
+// Validate checks the field values on GetRegionGroupReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetRegionGroupReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetRegionGroupReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetRegionGroupReplyMultiError, or nil if none found.
+func (m *GetRegionGroupReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetRegionGroupReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetGroup()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetRegionGroupReplyValidationError{
+					field:  "Group",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetRegionGroupReplyValidationError{
+					field:  "Group",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetGroup()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetRegionGroupReplyValidationError{
+				field:  "Group",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetRegionGroupReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetRegionGroupReplyMultiError is an error wrapping multiple validation
+// errors returned by GetRegionGroupReply.ValidateAll() if the designated
+// constraints aren't met.
+type GetRegionGroupReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetRegionGroupReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetRegionGroupReplyMultiError) AllErrors() []error { return m }
+
+// GetRegionGroupReplyValidationError is the validation error returned by
+// GetRegionGroupReply.Validate if the designated constraints aren't met.
+type GetRegionGroupReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetRegionGroupReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetRegionGroupReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetRegionGroupReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetRegionGroupReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetRegionGroupReplyValidationError) ErrorName() string {
+	return "GetRegionGroupReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetRegionGroupReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetRegionGroupReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetRegionGroupReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetRegionGroupReplyValidationError{}
+
+// Validate checks the field values on SaveRegionGroupRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SaveRegionGroupRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SaveRegionGroupRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SaveRegionGroupRequestMultiError, or nil if none found.
+func (m *SaveRegionGroupRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SaveRegionGroupRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetGroupCode()); l < 2 || l > 10 {
+		err := SaveRegionGroupRequestValidationError{
+			field:  "GroupCode",
+			reason: "value length must be between 2 and 10 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 100 {
+		err := SaveRegionGroupRequestValidationError{
+			field:  "Name",
+			reason: "value length must be between 1 and 100 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetDescription()) > 255 {
+		err := SaveRegionGroupRequestValidationError{
+			field:  "Description",
+			reason: "value length must be at most 255 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetCountries() {
+		_, _ = idx, item
+
+		if utf8.RuneCountInString(item) != 2 {
+			err := SaveRegionGroupRequestValidationError{
+				field:  fmt.Sprintf("Countries[%v]", idx),
+				reason: "value length must be 2 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return SaveRegionGroupRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SaveRegionGroupRequestMultiError is an error wrapping multiple validation
+// errors returned by SaveRegionGroupRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SaveRegionGroupRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SaveRegionGroupRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SaveRegionGroupRequestMultiError) AllErrors() []error { return m }
+
+// SaveRegionGroupRequestValidationError is the validation error returned by
+// SaveRegionGroupRequest.Validate if the designated constraints aren't met.
+type SaveRegionGroupRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SaveRegionGroupRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SaveRegionGroupRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SaveRegionGroupRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SaveRegionGroupRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SaveRegionGroupRequestValidationError) ErrorName() string {
+	return "SaveRegionGroupRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SaveRegionGroupRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSaveRegionGroupRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SaveRegionGroupRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SaveRegionGroupRequestValidationError{}
+
+// Validate checks the field values on SaveRegionGroupReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SaveRegionGroupReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SaveRegionGroupReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SaveRegionGroupReplyMultiError, or nil if none found.
+func (m *SaveRegionGroupReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SaveRegionGroupReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetGroup()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SaveRegionGroupReplyValidationError{
+					field:  "Group",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SaveRegionGroupReplyValidationError{
+					field:  "Group",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetGroup()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SaveRegionGroupReplyValidationError{
+				field:  "Group",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SaveRegionGroupReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// SaveRegionGroupReplyMultiError is an error wrapping multiple validation
+// errors returned by SaveRegionGroupReply.ValidateAll() if the designated
+// constraints aren't met.
+type SaveRegionGroupReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SaveRegionGroupReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SaveRegionGroupReplyMultiError) AllErrors() []error { return m }
+
+// SaveRegionGroupReplyValidationError is the validation error returned by
+// SaveRegionGroupReply.Validate if the designated constraints aren't met.
+type SaveRegionGroupReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SaveRegionGroupReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SaveRegionGroupReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SaveRegionGroupReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SaveRegionGroupReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SaveRegionGroupReplyValidationError) ErrorName() string {
+	return "SaveRegionGroupReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SaveRegionGroupReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSaveRegionGroupReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SaveRegionGroupReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SaveRegionGroupReplyValidationError{}
+
+// Validate checks the field values on DeleteRegionGroupRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteRegionGroupRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteRegionGroupRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteRegionGroupRequestMultiError, or nil if none found.
+func (m *DeleteRegionGroupRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteRegionGroupRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetGroupCode()); l < 2 || l > 10 {
+		err := DeleteRegionGroupRequestValidationError{
+			field:  "GroupCode",
+			reason: "value length must be between 2 and 10 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DeleteRegionGroupRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteRegionGroupRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteRegionGroupRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteRegionGroupRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteRegionGroupRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteRegionGroupRequestMultiError) AllErrors() []error { return m }
+
+// DeleteRegionGroupRequestValidationError is the validation error returned by
+// DeleteRegionGroupRequest.Validate if the designated constraints aren't met.
+type DeleteRegionGroupRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteRegionGroupRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteRegionGroupRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteRegionGroupRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteRegionGroupRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteRegionGroupRequestValidationError) ErrorName() string {
+	return "DeleteRegionGroupRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteRegionGroupRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteRegionGroupRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteRegionGroupRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteRegionGroupRequestValidationError{}
+
+// Validate checks the field values on DeleteRegionGroupReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteRegionGroupReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteRegionGroupReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteRegionGroupReplyMultiError, or nil if none found.
+func (m *DeleteRegionGroupReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteRegionGroupReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for GroupCode
+
+	if len(errors) > 0 {
+		return DeleteRegionGroupReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteRegionGroupReplyMultiError is an error wrapping multiple validation
+// errors returned by DeleteRegionGroupReply.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteRegionGroupReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteRegionGroupReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteRegionGroupReplyMultiError) AllErrors() []error { return m }
+
+// DeleteRegionGroupReplyValidationError is the validation error returned by
+// DeleteRegionGroupReply.Validate if the designated constraints aren't met.
+type DeleteRegionGroupReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteRegionGroupReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteRegionGroupReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteRegionGroupReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteRegionGroupReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteRegionGroupReplyValidationError) ErrorName() string {
+	return "DeleteRegionGroupReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteRegionGroupReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteRegionGroupReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteRegionGroupReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteRegionGroupReplyValidationError{}
+
+// Validate checks the field values on Invoice with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Invoice) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Invoice with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in InvoiceMultiError, or nil if none found.
+func (m *Invoice) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Invoice) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for InvoiceId
+
+	// no validation rules for InvoiceNo
+
+	// no validation rules for InvoiceType
+
+	// no validation rules for Uid
+
+	// no validation rules for OrderId
+
+	// no validation rules for OriginalInvoiceNo
+
+	// no validation rules for PlanId
+
+	// no validation rules for PlanName
+
+	// no validation rules for CountryCode
+
+	// no validation rules for VatId
+
+	// no validation rules for Currency
+
+	// no validation rules for Subtotal
+
+	// no validation rules for Discount
+
+	// no validation rules for ProrationCredit
+
+	// no validation rules for TaxAmount
+
+	// no validation rules for Total
+
+	// no validation rules for PeriodStart
+
+	// no validation rules for PeriodEnd
+
+	// no validation rules for IssuedAt
+
+	for idx, item := range m.GetLines() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, InvoiceValidationError{
+						field:  fmt.Sprintf("Lines[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, InvoiceValidationError{
+						field:  fmt.Sprintf("Lines[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return InvoiceValidationError{
+					field:  fmt.Sprintf("Lines[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return InvoiceMultiError(errors)
+	}
+
+	return nil
+}
+
+// InvoiceMultiError is an error wrapping multiple validation errors returned
+// by Invoice.ValidateAll() if the designated constraints aren't met.
+type InvoiceMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InvoiceMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InvoiceMultiError) AllErrors() []error { return m }
+
+// InvoiceValidationError is the validation error returned by Invoice.Validate
+// if the designated constraints aren't met.
+type InvoiceValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InvoiceValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InvoiceValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InvoiceValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InvoiceValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InvoiceValidationError) ErrorName() string { return "InvoiceValidationError" }
+
+// Error satisfies the builtin error interface
+func (e InvoiceValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInvoice.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InvoiceValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InvoiceValidationError{}
+
+// Validate checks the field values on InvoiceLine with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *InvoiceLine) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InvoiceLine with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in InvoiceLineMultiError, or
+// nil if none found.
+func (m *InvoiceLine) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InvoiceLine) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for LineType
+
+	// no validation rules for Description
+
+	// no validation rules for PeriodStart
+
+	// no validation rules for PeriodEnd
+
+	// no validation rules for TaxRate
+
+	// no validation rules for Inclusive
+
+	// no validation rules for ReverseCharge
+
+	// no validation rules for Amount
+
+	if len(errors) > 0 {
+		return InvoiceLineMultiError(errors)
+	}
+
+	return nil
+}
+
+// InvoiceLineMultiError is an error wrapping multiple validation errors
+// returned by InvoiceLine.ValidateAll() if the designated constraints aren't met.
+type InvoiceLineMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InvoiceLineMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InvoiceLineMultiError) AllErrors() []error { return m }
+
+// InvoiceLineValidationError is the validation error returned by
+// InvoiceLine.Validate if the designated constraints aren't met.
+type InvoiceLineValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InvoiceLineValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InvoiceLineValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InvoiceLineValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InvoiceLineValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InvoiceLineValidationError) ErrorName() string { return "InvoiceLineValidationError" }
+
+// Error satisfies the builtin error interface
+func (e InvoiceLineValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInvoiceLine.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InvoiceLineValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InvoiceLineValidationError{}
+
+// Validate checks the field values on GetInvoiceRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetInvoiceRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetInvoiceRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetInvoiceRequestMultiError, or nil if none found.
+func (m *GetInvoiceRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetInvoiceRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetInvoiceId() <= 0 {
+		err := GetInvoiceRequestValidationError{
+			field:  "InvoiceId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _GetInvoiceRequest_Format_InLookup[m.GetFormat()]; !ok {
+		err := GetInvoiceRequestValidationError{
+			field:  "Format",
+			reason: "value must be in list [ html pdf]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetLanguage()) > 10 {
+		err := GetInvoiceRequestValidationError{
+			field:  "Language",
+			reason: "value length must be at most 10 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetInvoiceRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetInvoiceRequestMultiError is an error wrapping multiple validation errors
+// returned by GetInvoiceRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetInvoiceRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetInvoiceRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetInvoiceRequestMultiError) AllErrors() []error { return m }
+
+// GetInvoiceRequestValidationError is the validation error returned by
+// GetInvoiceRequest.Validate if the designated constraints aren't met.
+type GetInvoiceRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetInvoiceRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetInvoiceRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetInvoiceRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetInvoiceRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetInvoiceRequestValidationError) ErrorName() string {
+	return "GetInvoiceRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetInvoiceRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetInvoiceRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetInvoiceRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetInvoiceRequestValidationError{}
+
+var _GetInvoiceRequest_Format_InLookup = map[string]struct{}{
+	"":     {},
+	"html": {},
+	"pdf":  {},
+}
+
+// Validate checks the field values on GetInvoiceReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetInvoiceReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetInvoiceReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetInvoiceReplyMultiError, or nil if none found.
+func (m *GetInvoiceReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetInvoiceReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetInvoice()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetInvoiceReplyValidationError{
+					field:  "Invoice",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetInvoiceReplyValidationError{
+					field:  "Invoice",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetInvoice()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetInvoiceReplyValidationError{
+				field:  "Invoice",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Content
+
+	// no validation rules for ContentType
+
+	// no validation rules for FileName
+
+	if len(errors) > 0 {
+		return GetInvoiceReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetInvoiceReplyMultiError is an error wrapping multiple validation errors
+// returned by GetInvoiceReply.ValidateAll() if the designated constraints
+// aren't met.
+type GetInvoiceReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetInvoiceReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetInvoiceReplyMultiError) AllErrors() []error { return m }
+
+// GetInvoiceReplyValidationError is the validation error returned by
+// GetInvoiceReply.Validate if the designated constraints aren't met.
+type GetInvoiceReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetInvoiceReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetInvoiceReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetInvoiceReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetInvoiceReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetInvoiceReplyValidationError) ErrorName() string { return "GetInvoiceReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetInvoiceReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetInvoiceReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetInvoiceReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetInvoiceReplyValidationError{}
+
+// Validate checks the field values on ListInvoicesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListInvoicesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListInvoicesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListInvoicesRequestMultiError, or nil if none found.
+func (m *ListInvoicesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListInvoicesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetUid()); l < 1 || l > 36 {
+		err := ListInvoicesRequestValidationError{
+			field:  "Uid",
+			reason: "value length must be between 1 and 36 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Page
+
+	// no validation rules for PageSize
+
+	if len(errors) > 0 {
+		return ListInvoicesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListInvoicesRequestMultiError is an error wrapping multiple validation
+// errors returned by ListInvoicesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListInvoicesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListInvoicesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListInvoicesRequestMultiError) AllErrors() []error { return m }
+
+// ListInvoicesRequestValidationError is the validation error returned by
+// ListInvoicesRequest.Validate if the designated constraints aren't met.
+type ListInvoicesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListInvoicesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListInvoicesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListInvoicesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListInvoicesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListInvoicesRequestValidationError) ErrorName() string {
+	return "ListInvoicesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListInvoicesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListInvoicesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListInvoicesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListInvoicesRequestValidationError{}
+
+// Validate checks the field values on ListInvoicesReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListInvoicesReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListInvoicesReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListInvoicesReplyMultiError, or nil if none found.
+func (m *ListInvoicesReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListInvoicesReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetItems() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListInvoicesReplyValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListInvoicesReplyValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListInvoicesReplyValidationError{
+					field:  fmt.Sprintf("Items[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Total
+
+	// no validation rules for Page
+
+	// no validation rules for PageSize
+
+	if len(errors) > 0 {
+		return ListInvoicesReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListInvoicesReplyMultiError is an error wrapping multiple validation errors
+// returned by ListInvoicesReply.ValidateAll() if the designated constraints
+// aren't met.
+type ListInvoicesReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListInvoicesReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListInvoicesReplyMultiError) AllErrors() []error { return m }
+
+// ListInvoicesReplyValidationError is the validation error returned by
+// ListInvoicesReply.Validate if the designated constraints aren't met.
+type ListInvoicesReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListInvoicesReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListInvoicesReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListInvoicesReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListInvoicesReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListInvoicesReplyValidationError) ErrorName() string {
+	return "ListInvoicesReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListInvoicesReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListInvoicesReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListInvoicesReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListInvoicesReplyValidationError{}
+
+// Validate checks the field values on TaxRule with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *TaxRule) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TaxRule with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in TaxRuleMultiError, or nil if none found.
+func (m *TaxRule) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TaxRule) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TaxRuleId
+
+	// no validation rules for CountryCode
+
+	// no validation rules for Name
+
+	// no validation rules for Rate
+
+	// no validation rules for Inclusive
+
+	// no validation rules for ReverseCharge
+
+	// no validation rules for Enabled
+
+	// no validation rules for UpdatedAt
+
+	if len(errors) > 0 {
+		return TaxRuleMultiError(errors)
+	}
+
+	return nil
+}
+
+// TaxRuleMultiError is an error wrapping multiple validation errors returned
+// by TaxRule.ValidateAll() if the designated constraints aren't met.
+type TaxRuleMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TaxRuleMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TaxRuleMultiError) AllErrors() []error { return m }
+
+// TaxRuleValidationError is the validation error returned by TaxRule.Validate
+// if the designated constraints aren't met.
+type TaxRuleValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TaxRuleValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TaxRuleValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TaxRuleValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TaxRuleValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TaxRuleValidationError) ErrorName() string { return "TaxRuleValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TaxRuleValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTaxRule.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TaxRuleValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TaxRuleValidationError{}
+
+// Validate checks the field values on ListTaxRulesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListTaxRulesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListTaxRulesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListTaxRulesRequestMultiError, or nil if none found.
+func (m *ListTaxRulesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListTaxRulesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetCountryCode()) > 2 {
+		err := ListTaxRulesRequestValidationError{
+			field:  "CountryCode",
+			reason: "value length must be at most 2 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListTaxRulesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListTaxRulesRequestMultiError is an error wrapping multiple validation
+// errors returned by ListTaxRulesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListTaxRulesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListTaxRulesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListTaxRulesRequestMultiError) AllErrors() []error { return m }
+
+// ListTaxRulesRequestValidationError is the validation error returned by
+// ListTaxRulesRequest.Validate if the designated constraints aren't met.
+type ListTaxRulesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListTaxRulesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListTaxRulesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListTaxRulesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListTaxRulesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListTaxRulesRequestValidationError) ErrorName() string {
+	return "ListTaxRulesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListTaxRulesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListTaxRulesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListTaxRulesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListTaxRulesRequestValidationError{}
+
+// Validate checks the field values on ListTaxRulesReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListTaxRulesReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListTaxRulesReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListTaxRulesReplyMultiError, or nil if none found.
+func (m *ListTaxRulesReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListTaxRulesReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetRules() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListTaxRulesReplyValidationError{
+						field:  fmt.Sprintf("Rules[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListTaxRulesReplyValidationError{
+						field:  fmt.Sprintf("Rules[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListTaxRulesReplyValidationError{
+					field:  fmt.Sprintf("Rules[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListTaxRulesReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListTaxRulesReplyMultiError is an error wrapping multiple validation errors
+// returned by ListTaxRulesReply.ValidateAll() if the designated constraints
+// aren't met.
+type ListTaxRulesReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListTaxRulesReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListTaxRulesReplyMultiError) AllErrors() []error { return m }
+
+// ListTaxRulesReplyValidationError is the validation error returned by
+// ListTaxRulesReply.Validate if the designated constraints aren't met.
+type ListTaxRulesReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListTaxRulesReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListTaxRulesReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListTaxRulesReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListTaxRulesReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListTaxRulesReplyValidationError) ErrorName() string {
+	return "ListTaxRulesReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListTaxRulesReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListTaxRulesReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListTaxRulesReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListTaxRulesReplyValidationError{}
+
+// Validate checks the field values on CreateTaxRuleRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateTaxRuleRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateTaxRuleRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateTaxRuleRequestMultiError, or nil if none found.
+func (m *CreateTaxRuleRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateTaxRuleRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetCountryCode()) != 2 {
+		err := CreateTaxRuleRequestValidationError{
+			field:  "CountryCode",
+			reason: "value length must be 2 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+
+	}
+
+	if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 50 {
+		err := CreateTaxRuleRequestValidationError{
+			field:  "Name",
+			reason: "value length must be between 1 and 50 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetRate(); val < 0 || val >= 1 {
+		err := CreateTaxRuleRequestValidationError{
+			field:  "Rate",
+			reason: "value must be inside range [0, 1)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Inclusive
+
+	// no validation rules for ReverseCharge
+
+	// no validation rules for Enabled
+
+	if len(errors) > 0 {
+		return CreateTaxRuleRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateTaxRuleRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateTaxRuleRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateTaxRuleRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateTaxRuleRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateTaxRuleRequestMultiError) AllErrors() []error { return m }
+
+// CreateTaxRuleRequestValidationError is the validation error returned by
+// CreateTaxRuleRequest.Validate if the designated constraints aren't met.
+type CreateTaxRuleRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateTaxRuleRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateTaxRuleRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateTaxRuleRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateTaxRuleRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateTaxRuleRequestValidationError) ErrorName() string {
+	return "CreateTaxRuleRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateTaxRuleRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateTaxRuleRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateTaxRuleRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateTaxRuleRequestValidationError{}
+
+// Validate checks the field values on CreateTaxRuleReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateTaxRuleReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateTaxRuleReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateTaxRuleReplyMultiError, or nil if none found.
+func (m *CreateTaxRuleReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateTaxRuleReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetRule()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateTaxRuleReplyValidationError{
+					field:  "Rule",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateTaxRuleReplyValidationError{
+					field:  "Rule",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRule()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateTaxRuleReplyValidationError{
+				field:  "Rule",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateTaxRuleReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateTaxRuleReplyMultiError is an error wrapping multiple validation errors
+// returned by CreateTaxRuleReply.ValidateAll() if the designated constraints
+// aren't met.
+type CreateTaxRuleReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateTaxRuleReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateTaxRuleReplyMultiError) AllErrors() []error { return m }
+
+// CreateTaxRuleReplyValidationError is the validation error returned by
+// CreateTaxRuleReply.Validate if the designated constraints aren't met.
+type CreateTaxRuleReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateTaxRuleReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateTaxRuleReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateTaxRuleReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateTaxRuleReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateTaxRuleReplyValidationError) ErrorName() string {
+	return "CreateTaxRuleReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateTaxRuleReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateTaxRuleReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateTaxRuleReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateTaxRuleReplyValidationError{}
+
+// Validate checks the field values on UpdateTaxRuleRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateTaxRuleRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateTaxRuleRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateTaxRuleRequestMultiError, or nil if none found.
+func (m *UpdateTaxRuleRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateTaxRuleRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetTaxRuleId() <= 0 {
+		err := UpdateTaxRuleRequestValidationError{
+			field:  "TaxRuleId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 50 {
+		err := UpdateTaxRuleRequestValidationError{
+			field:  "Name",
+			reason: "value length must be between 1 and 50 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetRate(); val < 0 || val >= 1 {
+		err := UpdateTaxRuleRequestValidationError{
+			field:  "Rate",
+			reason: "value must be inside range [0, 1)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Inclusive
+
+	// no validation rules for ReverseCharge
+
+	// no validation rules for Enabled
+
+	if len(errors) > 0 {
+		return UpdateTaxRuleRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateTaxRuleRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdateTaxRuleRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateTaxRuleRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateTaxRuleRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateTaxRuleRequestMultiError) AllErrors() []error { return m }
+
+// UpdateTaxRuleRequestValidationError is the validation error returned by
+// UpdateTaxRuleRequest.Validate if the designated constraints aren't met.
+type UpdateTaxRuleRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateTaxRuleRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateTaxRuleRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateTaxRuleRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateTaxRuleRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateTaxRuleRequestValidationError) ErrorName() string {
+	return "UpdateTaxRuleRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateTaxRuleRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateTaxRuleRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateTaxRuleRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateTaxRuleRequestValidationError{}
+
+// Validate checks the field values on UpdateTaxRuleReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateTaxRuleReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateTaxRuleReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateTaxRuleReplyMultiError, or nil if none found.
+func (m *UpdateTaxRuleReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateTaxRuleReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetRule()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateTaxRuleReplyValidationError{
+					field:  "Rule",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateTaxRuleReplyValidationError{
+					field:  "Rule",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRule()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateTaxRuleReplyValidationError{
+				field:  "Rule",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateTaxRuleReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateTaxRuleReplyMultiError is an error wrapping multiple validation errors
+// returned by UpdateTaxRuleReply.ValidateAll() if the designated constraints
+// aren't met.
+type UpdateTaxRuleReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateTaxRuleReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateTaxRuleReplyMultiError) AllErrors() []error { return m }
+
+// UpdateTaxRuleReplyValidationError is the validation error returned by
+// UpdateTaxRuleReply.Validate if the designated constraints aren't met.
+type UpdateTaxRuleReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateTaxRuleReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateTaxRuleReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateTaxRuleReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateTaxRuleReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateTaxRuleReplyValidationError) ErrorName() string {
+	return "UpdateTaxRuleReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateTaxRuleReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateTaxRuleReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateTaxRuleReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateTaxRuleReplyValidationError{}
+
+// Validate checks the field values on DeleteTaxRuleRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteTaxRuleRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteTaxRuleRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteTaxRuleRequestMultiError, or nil if none found.
+func (m *DeleteTaxRuleRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteTaxRuleRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetTaxRuleId() <= 0 {
+		err := DeleteTaxRuleRequestValidationError{
+			field:  "TaxRuleId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DeleteTaxRuleRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteTaxRuleRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteTaxRuleRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteTaxRuleRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteTaxRuleRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteTaxRuleRequestMultiError) AllErrors() []error { return m }
+
+// DeleteTaxRuleRequestValidationError is the validation error returned by
+// DeleteTaxRuleRequest.Validate if the designated constraints aren't met.
+type DeleteTaxRuleRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteTaxRuleRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteTaxRuleRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteTaxRuleRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteTaxRuleRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteTaxRuleRequestValidationError) ErrorName() string {
+	return "DeleteTaxRuleRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteTaxRuleRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteTaxRuleRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteTaxRuleRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteTaxRuleRequestValidationError{}
+
+// Validate checks the field values on DeleteTaxRuleReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteTaxRuleReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteTaxRuleReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteTaxRuleReplyMultiError, or nil if none found.
+func (m *DeleteTaxRuleReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteTaxRuleReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TaxRuleId
+
+	if len(errors) > 0 {
+		return DeleteTaxRuleReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteTaxRuleReplyMultiError is an error wrapping multiple validation errors
+// returned by DeleteTaxRuleReply.ValidateAll() if the designated constraints
+// aren't met.
+type DeleteTaxRuleReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteTaxRuleReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteTaxRuleReplyMultiError) AllErrors() []error { return m }
+
+// DeleteTaxRuleReplyValidationError is the validation error returned by
+// DeleteTaxRuleReply.Validate if the designated constraints aren't met.
+type DeleteTaxRuleReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteTaxRuleReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteTaxRuleReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteTaxRuleReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteTaxRuleReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteTaxRuleReplyValidationError) ErrorName() string {
+	return "DeleteTaxRuleReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteTaxRuleReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteTaxRuleReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteTaxRuleReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteTaxRuleReplyValidationError{}
+
+// Validate checks the field values on ExchangeRate with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ExchangeRate) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExchangeRate with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ExchangeRateMultiError, or
+// nil if none found.
+func (m *ExchangeRate) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExchangeRate) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ExchangeRateId
+
+	// no validation rules for FromCurrency
+
+	// no validation rules for ToCurrency
+
+	// no validation rules for Rate
+
+	// no validation rules for EffectiveAt
+
+	// no validation rules for Source
+
+	// no validation rules for CreatedAt
+
+	if len(errors) > 0 {
+		return ExchangeRateMultiError(errors)
+	}
+
+	return nil
+}
+
+// ExchangeRateMultiError is an error wrapping multiple validation errors
+// returned by ExchangeRate.ValidateAll() if the designated constraints aren't met.
+type ExchangeRateMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExchangeRateMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExchangeRateMultiError) AllErrors() []error { return m }
+
+// ExchangeRateValidationError is the validation error returned by
+// ExchangeRate.Validate if the designated constraints aren't met.
+type ExchangeRateValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExchangeRateValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExchangeRateValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExchangeRateValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExchangeRateValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExchangeRateValidationError) ErrorName() string { return "ExchangeRateValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ExchangeRateValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExchangeRate.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExchangeRateValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExchangeRateValidationError{}
+
+// Validate checks the field values on ListExchangeRatesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListExchangeRatesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListExchangeRatesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListExchangeRatesRequestMultiError, or nil if none found.
+func (m *ListExchangeRatesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListExchangeRatesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetFromCurrency()) > 3 {
+		err := ListExchangeRatesRequestValidationError{
+			field:  "FromCurrency",
+			reason: "value length must be at most 3 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetToCurrency()) > 3 {
+		err := ListExchangeRatesRequestValidationError{
+			field:  "ToCurrency",
+			reason: "value length must be at most 3 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Page
+
+	// no validation rules for PageSize
+
+	if len(errors) > 0 {
+		return ListExchangeRatesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListExchangeRatesRequestMultiError is an error wrapping multiple validation
+// errors returned by ListExchangeRatesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListExchangeRatesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListExchangeRatesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListExchangeRatesRequestMultiError) AllErrors() []error { return m }
+
+// ListExchangeRatesRequestValidationError is the validation error returned by
+// ListExchangeRatesRequest.Validate if the designated constraints aren't met.
+type ListExchangeRatesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListExchangeRatesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListExchangeRatesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListExchangeRatesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListExchangeRatesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListExchangeRatesRequestValidationError) ErrorName() string {
+	return "ListExchangeRatesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListExchangeRatesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListExchangeRatesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListExchangeRatesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListExchangeRatesRequestValidationError{}
+
+// Validate checks the field values on ListExchangeRatesReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListExchangeRatesReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListExchangeRatesReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListExchangeRatesReplyMultiError, or nil if none found.
+func (m *ListExchangeRatesReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListExchangeRatesReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetItems() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListExchangeRatesReplyValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListExchangeRatesReplyValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListExchangeRatesReplyValidationError{
+					field:  fmt.Sprintf("Items[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Total
+
+	// no validation rules for Page
+
+	// no validation rules for PageSize
+
+	if len(errors) > 0 {
+		return ListExchangeRatesReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListExchangeRatesReplyMultiError is an error wrapping multiple validation
+// errors returned by ListExchangeRatesReply.ValidateAll() if the designated
+// constraints aren't met.
+type ListExchangeRatesReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListExchangeRatesReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListExchangeRatesReplyMultiError) AllErrors() []error { return m }
+
+// ListExchangeRatesReplyValidationError is the validation error returned by
+// ListExchangeRatesReply.Validate if the designated constraints aren't met.
+type ListExchangeRatesReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListExchangeRatesReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListExchangeRatesReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListExchangeRatesReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListExchangeRatesReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListExchangeRatesReplyValidationError) ErrorName() string {
+	return "ListExchangeRatesReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListExchangeRatesReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListExchangeRatesReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListExchangeRatesReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListExchangeRatesReplyValidationError{}
+
+// Validate checks the field values on SaveExchangeRatesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SaveExchangeRatesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SaveExchangeRatesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SaveExchangeRatesRequestMultiError, or nil if none found.
+func (m *SaveExchangeRatesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SaveExchangeRatesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := len(m.GetRates()); l < 1 || l > 500 {
+		err := SaveExchangeRatesRequestValidationError{
+			field:  "Rates",
+			reason: "value must contain between 1 and 500 items, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetRates() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SaveExchangeRatesRequestValidationError{
+						field:  fmt.Sprintf("Rates[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SaveExchangeRatesRequestValidationError{
+						field:  fmt.Sprintf("Rates[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SaveExchangeRatesRequestValidationError{
+					field:  fmt.Sprintf("Rates[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return SaveExchangeRatesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SaveExchangeRatesRequestMultiError is an error wrapping multiple validation
+// errors returned by SaveExchangeRatesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SaveExchangeRatesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SaveExchangeRatesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SaveExchangeRatesRequestMultiError) AllErrors() []error { return m }
+
+// SaveExchangeRatesRequestValidationError is the validation error returned by
+// SaveExchangeRatesRequest.Validate if the designated constraints aren't met.
+type SaveExchangeRatesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SaveExchangeRatesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SaveExchangeRatesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SaveExchangeRatesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SaveExchangeRatesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SaveExchangeRatesRequestValidationError) ErrorName() string {
+	return "SaveExchangeRatesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SaveExchangeRatesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSaveExchangeRatesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SaveExchangeRatesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SaveExchangeRatesRequestValidationError{}
+
+// Validate checks the field values on SaveExchangeRatesReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SaveExchangeRatesReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SaveExchangeRatesReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SaveExchangeRatesReplyMultiError, or nil if none found.
+func (m *SaveExchangeRatesReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SaveExchangeRatesReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Count
+
+	if len(errors) > 0 {
+		return SaveExchangeRatesReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// SaveExchangeRatesReplyMultiError is an error wrapping multiple validation
+// errors returned by SaveExchangeRatesReply.ValidateAll() if the designated
+// constraints aren't met.
+type SaveExchangeRatesReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SaveExchangeRatesReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SaveExchangeRatesReplyMultiError) AllErrors() []error { return m }
+
+// SaveExchangeRatesReplyValidationError is the validation error returned by
+// SaveExchangeRatesReply.Validate if the designated constraints aren't met.
+type SaveExchangeRatesReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SaveExchangeRatesReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SaveExchangeRatesReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SaveExchangeRatesReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SaveExchangeRatesReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SaveExchangeRatesReplyValidationError) ErrorName() string {
+	return "SaveExchangeRatesReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SaveExchangeRatesReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSaveExchangeRatesReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SaveExchangeRatesReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SaveExchangeRatesReplyValidationError{}
+
+// Validate checks the field values on ImportExchangeRatesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ImportExchangeRatesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ImportExchangeRatesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ImportExchangeRatesRequestMultiError, or nil if none found.
+func (m *ImportExchangeRatesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ImportExchangeRatesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Content
+
+	if len(errors) > 0 {
+		return ImportExchangeRatesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ImportExchangeRatesRequestMultiError is an error wrapping multiple
+// validation errors returned by ImportExchangeRatesRequest.ValidateAll() if
+// the designated constraints aren't met.
+type ImportExchangeRatesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ImportExchangeRatesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ImportExchangeRatesRequestMultiError) AllErrors() []error { return m }
+
+// ImportExchangeRatesRequestValidationError is the validation error returned
+// by ImportExchangeRatesRequest.Validate if the designated constraints aren't met.
+type ImportExchangeRatesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ImportExchangeRatesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ImportExchangeRatesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ImportExchangeRatesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ImportExchangeRatesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ImportExchangeRatesRequestValidationError) ErrorName() string {
+	return "ImportExchangeRatesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ImportExchangeRatesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sImportExchangeRatesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ImportExchangeRatesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ImportExchangeRatesRequestValidationError{}
+
+// Validate checks the field values on ImportExchangeRatesReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ImportExchangeRatesReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ImportExchangeRatesReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ImportExchangeRatesReplyMultiError, or nil if none found.
+func (m *ImportExchangeRatesReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ImportExchangeRatesReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Count
+
+	if len(errors) > 0 {
+		return ImportExchangeRatesReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ImportExchangeRatesReplyMultiError is an error wrapping multiple validation
+// errors returned by ImportExchangeRatesReply.ValidateAll() if the designated
+// constraints aren't met.
+type ImportExchangeRatesReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ImportExchangeRatesReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ImportExchangeRatesReplyMultiError) AllErrors() []error { return m }
+
+// ImportExchangeRatesReplyValidationError is the validation error returned by
+// ImportExchangeRatesReply.Validate if the designated constraints aren't met.
+type ImportExchangeRatesReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ImportExchangeRatesReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ImportExchangeRatesReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ImportExchangeRatesReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ImportExchangeRatesReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ImportExchangeRatesReplyValidationError) ErrorName() string {
+	return "ImportExchangeRatesReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ImportExchangeRatesReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sImportExchangeRatesReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ImportExchangeRatesReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ImportExchangeRatesReplyValidationError{}
+
+// Validate checks the field values on GetRevenueReportRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetRevenueReportRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetRevenueReportRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetRevenueReportRequestMultiError, or nil if none found.
+func (m *GetRevenueReportRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetRevenueReportRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for StartTime
+
+	// no validation rules for EndTime
+
+	if len(errors) > 0 {
+		return GetRevenueReportRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetRevenueReportRequestMultiError is an error wrapping multiple validation
+// errors returned by GetRevenueReportRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetRevenueReportRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetRevenueReportRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetRevenueReportRequestMultiError) AllErrors() []error { return m }
+
+// GetRevenueReportRequestValidationError is the validation error returned by
+// GetRevenueReportRequest.Validate if the designated constraints aren't met.
+type GetRevenueReportRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetRevenueReportRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetRevenueReportRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetRevenueReportRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetRevenueReportRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetRevenueReportRequestValidationError) ErrorName() string {
+	return "GetRevenueReportRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetRevenueReportRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetRevenueReportRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetRevenueReportRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetRevenueReportRequestValidationError{}
+
+// Validate checks the field values on CurrencyRevenue with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *CurrencyRevenue) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CurrencyRevenue with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CurrencyRevenueMultiError, or nil if none found.
+func (m *CurrencyRevenue) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CurrencyRevenue) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Currency
+
+	// no validation rules for OrderCount
+
+	// no validation rules for GrossAmount
+
+	// no validation rules for RefundedAmount
+
+	// no validation rules for TaxAmount
+
+	// no validation rules for NetAmount
+
+	// no validation rules for ConvertedNet
+
+	// no validation rules for Estimated
+
+	if len(errors) > 0 {
+		return CurrencyRevenueMultiError(errors)
+	}
+
+	return nil
+}
+
+// CurrencyRevenueMultiError is an error wrapping multiple validation errors
+// returned by CurrencyRevenue.ValidateAll() if the designated constraints
+// aren't met.
+type CurrencyRevenueMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CurrencyRevenueMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CurrencyRevenueMultiError) AllErrors() []error { return m }
+
+// CurrencyRevenueValidationError is the validation error returned by
+// CurrencyRevenue.Validate if the designated constraints aren't met.
+type CurrencyRevenueValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CurrencyRevenueValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CurrencyRevenueValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CurrencyRevenueValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CurrencyRevenueValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CurrencyRevenueValidationError) ErrorName() string { return "CurrencyRevenueValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CurrencyRevenueValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCurrencyRevenue.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CurrencyRevenueValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CurrencyRevenueValidationError{}
+
+// Validate checks the field values on GetRevenueReportReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetRevenueReportReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetRevenueReportReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetRevenueReportReplyMultiError, or nil if none found.
+func (m *GetRevenueReportReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetRevenueReportReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for AppId
+
+	// no validation rules for ReportingCurrency
+
+	// no validation rules for StartTime
+
+	// no validation rules for EndTime
+
+	// no validation rules for OrderCount
+
+	// no validation rules for GrossAmount
+
+	// no validation rules for RefundedAmount
+
+	// no validation rules for TaxAmount
+
+	// no validation rules for NetAmount
+
+	for idx, item := range m.GetByCurrency() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetRevenueReportReplyValidationError{
+						field:  fmt.Sprintf("ByCurrency[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetRevenueReportReplyValidationError{
+						field:  fmt.Sprintf("ByCurrency[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetRevenueReportReplyValidationError{
+					field:  fmt.Sprintf("ByCurrency[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetRevenueReportReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetRevenueReportReplyMultiError is an error wrapping multiple validation
+// errors returned by GetRevenueReportReply.ValidateAll() if the designated
+// constraints aren't met.
+type GetRevenueReportReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetRevenueReportReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetRevenueReportReplyMultiError) AllErrors() []error { return m }
+
+// GetRevenueReportReplyValidationError is the validation error returned by
+// GetRevenueReportReply.Validate if the designated constraints aren't met.
+type GetRevenueReportReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetRevenueReportReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetRevenueReportReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetRevenueReportReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetRevenueReportReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetRevenueReportReplyValidationError) ErrorName() string {
+	return "GetRevenueReportReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetRevenueReportReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetRevenueReportReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetRevenueReportReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetRevenueReportReplyValidationError{}
+
+// Validate checks the field values on MetricSnapshot with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *MetricSnapshot) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MetricSnapshot with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in MetricSnapshotMultiError,
+// or nil if none found.
+func (m *MetricSnapshot) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MetricSnapshot) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for SnapshotDate
+
+	// no validation rules for ReportingCurrency
+
+	// no validation rules for ActiveSubscribers
+
+	// no validation rules for PayingSubscribers
+
+	// no validation rules for Mrr
+
+	// no validation rules for Arr
+
+	// no validation rules for NewSubscribers
+
+	// no validation rules for ChurnedSubscribers
+
+	// no validation rules for ReactivatedSubscribers
+
+	// no validation rules for StateUnavailable
+
+	if len(errors) > 0 {
+		return MetricSnapshotMultiError(errors)
+	}
+
+	return nil
+}
+
+// MetricSnapshotMultiError is an error wrapping multiple validation errors
+// returned by MetricSnapshot.ValidateAll() if the designated constraints
+// aren't met.
+type MetricSnapshotMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MetricSnapshotMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MetricSnapshotMultiError) AllErrors() []error { return m }
+
+// MetricSnapshotValidationError is the validation error returned by
+// MetricSnapshot.Validate if the designated constraints aren't met.
+type MetricSnapshotValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MetricSnapshotValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MetricSnapshotValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MetricSnapshotValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MetricSnapshotValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MetricSnapshotValidationError) ErrorName() string { return "MetricSnapshotValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MetricSnapshotValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMetricSnapshot.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MetricSnapshotValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MetricSnapshotValidationError{}
+
+// Validate checks the field values on GetSubscriptionMetricsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetSubscriptionMetricsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetSubscriptionMetricsRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// GetSubscriptionMetricsRequestMultiError, or nil if none found.
+func (m *GetSubscriptionMetricsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetSubscriptionMetricsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if !_GetSubscriptionMetricsRequest_StartDate_Pattern.MatchString(m.GetStartDate()) {
+		err := GetSubscriptionMetricsRequestValidationError{
+			field:  "StartDate",
+			reason: "value does not match regex pattern \"^(\\\\d{4}-\\\\d{2}-\\\\d{2})?$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_GetSubscriptionMetricsRequest_EndDate_Pattern.MatchString(m.GetEndDate()) {
+		err := GetSubscriptionMetricsRequestValidationError{
+			field:  "EndDate",
+			reason: "value does not match regex pattern \"^(\\\\d{4}-\\\\d{2}-\\\\d{2})?$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetSubscriptionMetricsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetSubscriptionMetricsRequestMultiError is an error wrapping multiple
+// validation errors returned by GetSubscriptionMetricsRequest.ValidateAll()
+// if the designated constraints aren't met.
+type GetSubscriptionMetricsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetSubscriptionMetricsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetSubscriptionMetricsRequestMultiError) AllErrors() []error { return m }
+
+// GetSubscriptionMetricsRequestValidationError is the validation error
+// returned by GetSubscriptionMetricsRequest.Validate if the designated
+// constraints aren't met.
+type GetSubscriptionMetricsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetSubscriptionMetricsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetSubscriptionMetricsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetSubscriptionMetricsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetSubscriptionMetricsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetSubscriptionMetricsRequestValidationError) ErrorName() string {
+	return "GetSubscriptionMetricsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetSubscriptionMetricsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetSubscriptionMetricsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetSubscriptionMetricsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetSubscriptionMetricsRequestValidationError{}
+
+var _GetSubscriptionMetricsRequest_StartDate_Pattern = regexp.MustCompile("^(\\d{4}-\\d{2}-\\d{2})?$")
+
+var _GetSubscriptionMetricsRequest_EndDate_Pattern = regexp.MustCompile("^(\\d{4}-\\d{2}-\\d{2})?$")
+
+// Validate checks the field values on GetSubscriptionMetricsReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetSubscriptionMetricsReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetSubscriptionMetricsReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetSubscriptionMetricsReplyMultiError, or nil if none found.
+func (m *GetSubscriptionMetricsReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetSubscriptionMetricsReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for AppId
+
+	// no validation rules for StartDate
+
+	// no validation rules for EndDate
+
+	// no validation rules for ReportingCurrency
+
+	// no validation rules for ActiveSubscribers
+
+	// no validation rules for PayingSubscribers
+
+	// no validation rules for Mrr
+
+	// no validation rules for Arr
+
+	// no validation rules for StartActiveSubscribers
+
+	// no validation rules for NewSubscribers
+
+	// no validation rules for ChurnedSubscribers
+
+	// no validation rules for ReactivatedSubscribers
+
+	// no validation rules for ChurnRate
+
+	// no validation rules for RetentionRate
+
+	for idx, item := range m.GetSnapshots() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetSubscriptionMetricsReplyValidationError{
+						field:  fmt.Sprintf("Snapshots[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetSubscriptionMetricsReplyValidationError{
+						field:  fmt.Sprintf("Snapshots[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetSubscriptionMetricsReplyValidationError{
+					field:  fmt.Sprintf("Snapshots[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for StartPayingSubscribers
+
+	if len(errors) > 0 {
+		return GetSubscriptionMetricsReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetSubscriptionMetricsReplyMultiError is an error wrapping multiple
+// validation errors returned by GetSubscriptionMetricsReply.ValidateAll() if
+// the designated constraints aren't met.
+type GetSubscriptionMetricsReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetSubscriptionMetricsReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetSubscriptionMetricsReplyMultiError) AllErrors() []error { return m }
+
+// GetSubscriptionMetricsReplyValidationError is the validation error returned
+// by GetSubscriptionMetricsReply.Validate if the designated constraints
+// aren't met.
+type GetSubscriptionMetricsReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetSubscriptionMetricsReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetSubscriptionMetricsReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetSubscriptionMetricsReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetSubscriptionMetricsReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetSubscriptionMetricsReplyValidationError) ErrorName() string {
+	return "GetSubscriptionMetricsReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetSubscriptionMetricsReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetSubscriptionMetricsReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetSubscriptionMetricsReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetSubscriptionMetricsReplyValidationError{}
+
+// Validate checks the field values on ListMetricSnapshotsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListMetricSnapshotsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListMetricSnapshotsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListMetricSnapshotsRequestMultiError, or nil if none found.
+func (m *ListMetricSnapshotsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListMetricSnapshotsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if !_ListMetricSnapshotsRequest_StartDate_Pattern.MatchString(m.GetStartDate()) {
+		err := ListMetricSnapshotsRequestValidationError{
+			field:  "StartDate",
+			reason: "value does not match regex pattern \"^(\\\\d{4}-\\\\d{2}-\\\\d{2})?$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_ListMetricSnapshotsRequest_EndDate_Pattern.MatchString(m.GetEndDate()) {
+		err := ListMetricSnapshotsRequestValidationError{
+			field:  "EndDate",
+			reason: "value does not match regex pattern \"^(\\\\d{4}-\\\\d{2}-\\\\d{2})?$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListMetricSnapshotsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListMetricSnapshotsRequestMultiError is an error wrapping multiple
+// validation errors returned by ListMetricSnapshotsRequest.ValidateAll() if
+// the designated constraints aren't met.
+type ListMetricSnapshotsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListMetricSnapshotsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListMetricSnapshotsRequestMultiError) AllErrors() []error { return m }
+
+// ListMetricSnapshotsRequestValidationError is the validation error returned
+// by ListMetricSnapshotsRequest.Validate if the designated constraints aren't met.
+type ListMetricSnapshotsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListMetricSnapshotsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListMetricSnapshotsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListMetricSnapshotsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListMetricSnapshotsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListMetricSnapshotsRequestValidationError) ErrorName() string {
+	return "ListMetricSnapshotsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListMetricSnapshotsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListMetricSnapshotsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListMetricSnapshotsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListMetricSnapshotsRequestValidationError{}
+
+var _ListMetricSnapshotsRequest_StartDate_Pattern = regexp.MustCompile("^(\\d{4}-\\d{2}-\\d{2})?$")
+
+var _ListMetricSnapshotsRequest_EndDate_Pattern = regexp.MustCompile("^(\\d{4}-\\d{2}-\\d{2})?$")
+
+// Validate checks the field values on ListMetricSnapshotsReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListMetricSnapshotsReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListMetricSnapshotsReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListMetricSnapshotsReplyMultiError, or nil if none found.
+func (m *ListMetricSnapshotsReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListMetricSnapshotsReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetSnapshots() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListMetricSnapshotsReplyValidationError{
+						field:  fmt.Sprintf("Snapshots[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListMetricSnapshotsReplyValidationError{
+						field:  fmt.Sprintf("Snapshots[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListMetricSnapshotsReplyValidationError{
+					field:  fmt.Sprintf("Snapshots[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListMetricSnapshotsReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListMetricSnapshotsReplyMultiError is an error wrapping multiple validation
+// errors returned by ListMetricSnapshotsReply.ValidateAll() if the designated
+// constraints aren't met.
+type ListMetricSnapshotsReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListMetricSnapshotsReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListMetricSnapshotsReplyMultiError) AllErrors() []error { return m }
+
+// ListMetricSnapshotsReplyValidationError is the validation error returned by
+// ListMetricSnapshotsReply.Validate if the designated constraints aren't met.
+type ListMetricSnapshotsReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListMetricSnapshotsReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListMetricSnapshotsReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListMetricSnapshotsReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListMetricSnapshotsReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListMetricSnapshotsReplyValidationError) ErrorName() string {
+	return "ListMetricSnapshotsReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListMetricSnapshotsReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListMetricSnapshotsReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListMetricSnapshotsReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListMetricSnapshotsReplyValidationError{}
+
+// Validate checks the field values on GenerateMetricSnapshotsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GenerateMetricSnapshotsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GenerateMetricSnapshotsRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// GenerateMetricSnapshotsRequestMultiError, or nil if none found.
+func (m *GenerateMetricSnapshotsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GenerateMetricSnapshotsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if !_GenerateMetricSnapshotsRequest_Date_Pattern.MatchString(m.GetDate()) {
+		err := GenerateMetricSnapshotsRequestValidationError{
+			field:  "Date",
+			reason: "value does not match regex pattern \"^(\\\\d{4}-\\\\d{2}-\\\\d{2})?$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetAppId()) > 50 {
+		err := GenerateMetricSnapshotsRequestValidationError{
+			field:  "AppId",
+			reason: "value length must be at most 50 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GenerateMetricSnapshotsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GenerateMetricSnapshotsRequestMultiError is an error wrapping multiple
+// validation errors returned by GenerateMetricSnapshotsRequest.ValidateAll()
+// if the designated constraints aren't met.
+type GenerateMetricSnapshotsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GenerateMetricSnapshotsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GenerateMetricSnapshotsRequestMultiError) AllErrors() []error { return m }
+
+// GenerateMetricSnapshotsRequestValidationError is the validation error
+// returned by GenerateMetricSnapshotsRequest.Validate if the designated
+// constraints aren't met.
+type GenerateMetricSnapshotsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GenerateMetricSnapshotsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GenerateMetricSnapshotsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GenerateMetricSnapshotsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GenerateMetricSnapshotsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GenerateMetricSnapshotsRequestValidationError) ErrorName() string {
+	return "GenerateMetricSnapshotsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GenerateMetricSnapshotsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGenerateMetricSnapshotsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GenerateMetricSnapshotsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GenerateMetricSnapshotsRequestValidationError{}
+
+var _GenerateMetricSnapshotsRequest_Date_Pattern = regexp.MustCompile("^(\\d{4}-\\d{2}-\\d{2})?$")
+
+// Validate checks the field values on GenerateMetricSnapshotsReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GenerateMetricSnapshotsReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GenerateMetricSnapshotsReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GenerateMetricSnapshotsReplyMultiError, or nil if none found.
+func (m *GenerateMetricSnapshotsReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GenerateMetricSnapshotsReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Count
+
+	if len(errors) > 0 {
+		return GenerateMetricSnapshotsReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// GenerateMetricSnapshotsReplyMultiError is an error wrapping multiple
+// validation errors returned by GenerateMetricSnapshotsReply.ValidateAll() if
+// the designated constraints aren't met.
+type GenerateMetricSnapshotsReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GenerateMetricSnapshotsReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GenerateMetricSnapshotsReplyMultiError) AllErrors() []error { return m }
+
+// GenerateMetricSnapshotsReplyValidationError is the validation error returned
+// by GenerateMetricSnapshotsReply.Validate if the designated constraints
+// aren't met.
+type GenerateMetricSnapshotsReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GenerateMetricSnapshotsReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GenerateMetricSnapshotsReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GenerateMetricSnapshotsReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GenerateMetricSnapshotsReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GenerateMetricSnapshotsReplyValidationError) ErrorName() string {
+	return "GenerateMetricSnapshotsReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GenerateMetricSnapshotsReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGenerateMetricSnapshotsReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GenerateMetricSnapshotsReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GenerateMetricSnapshotsReplyValidationError{}
+
+// Validate checks the field values on GetCohortReportRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetCohortReportRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetCohortReportRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetCohortReportRequestMultiError, or nil if none found.
+func (m *GetCohortReportRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetCohortReportRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if val := m.GetMonths(); val < 0 || val > 36 {
+		err := GetCohortReportRequestValidationError{
+			field:  "Months",
+			reason: "value must be inside range [0, 36]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _GetCohortReportRequest_Format_InLookup[m.GetFormat()]; !ok {
+		err := GetCohortReportRequestValidationError{
+			field:  "Format",
+			reason: "value must be in list [ csv]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetCohortReportRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetCohortReportRequestMultiError is an error wrapping multiple validation
+// errors returned by GetCohortReportRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetCohortReportRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetCohortReportRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetCohortReportRequestMultiError) AllErrors() []error { return m }
+
+// GetCohortReportRequestValidationError is the validation error returned by
+// GetCohortReportRequest.Validate if the designated constraints aren't met.
+type GetCohortReportRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetCohortReportRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetCohortReportRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetCohortReportRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetCohortReportRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetCohortReportRequestValidationError) ErrorName() string {
+	return "GetCohortReportRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetCohortReportRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetCohortReportRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetCohortReportRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetCohortReportRequestValidationError{}
+
+var _GetCohortReportRequest_Format_InLookup = map[string]struct{}{
+	"":    {},
+	"csv": {},
+}
+
+// Validate checks the field values on CohortRow with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *CohortRow) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CohortRow with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in CohortRowMultiError, or nil
+// if none found.
+func (m *CohortRow) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CohortRow) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for CohortMonth
+
+	// no validation rules for Size
+
+	// no validation rules for Revenue
+
+	// no validation rules for AverageRevenue
+
+	if len(errors) > 0 {
+		return CohortRowMultiError(errors)
+	}
+
+	return nil
+}
+
+// CohortRowMultiError is an error wrapping multiple validation errors returned
+// by CohortRow.ValidateAll() if the designated constraints aren't met.
+type CohortRowMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CohortRowMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CohortRowMultiError) AllErrors() []error { return m }
+
+// CohortRowValidationError is the validation error returned by
+// CohortRow.Validate if the designated constraints aren't met.
+type CohortRowValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CohortRowValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CohortRowValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CohortRowValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CohortRowValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CohortRowValidationError) ErrorName() string { return "CohortRowValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CohortRowValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCohortRow.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CohortRowValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CohortRowValidationError{}
+
+// Validate checks the field values on GetCohortReportReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetCohortReportReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetCohortReportReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetCohortReportReplyMultiError, or nil if none found.
+func (m *GetCohortReportReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetCohortReportReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for AppId
+
+	// no validation rules for ReportingCurrency
+
+	// no validation rules for StartMonth
+
+	// no validation rules for EndMonth
+
+	for idx, item := range m.GetCohorts() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetCohortReportReplyValidationError{
+						field:  fmt.Sprintf("Cohorts[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetCohortReportReplyValidationError{
+						field:  fmt.Sprintf("Cohorts[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetCohortReportReplyValidationError{
+					field:  fmt.Sprintf("Cohorts[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Content
+
+	// no validation rules for ContentType
+
+	// no validation rules for FileName
+
+	if len(errors) > 0 {
+		return GetCohortReportReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetCohortReportReplyMultiError is an error wrapping multiple validation
+// errors returned by GetCohortReportReply.ValidateAll() if the designated
+// constraints aren't met.
+type GetCohortReportReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetCohortReportReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetCohortReportReplyMultiError) AllErrors() []error { return m }
+
+// GetCohortReportReplyValidationError is the validation error returned by
+// GetCohortReportReply.Validate if the designated constraints aren't met.
+type GetCohortReportReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetCohortReportReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetCohortReportReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetCohortReportReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetCohortReportReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetCohortReportReplyValidationError) ErrorName() string {
+	return "GetCohortReportReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetCohortReportReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetCohortReportReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetCohortReportReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetCohortReportReplyValidationError{}
+
+// Validate checks the field values on GetPlanLTVReportRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetPlanLTVReportRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetPlanLTVReportRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetPlanLTVReportRequestMultiError, or nil if none found.
+func (m *GetPlanLTVReportRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetPlanLTVReportRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if val := m.GetMonths(); val < 0 || val > 36 {
+		err := GetPlanLTVReportRequestValidationError{
+			field:  "Months",
+			reason: "value must be inside range [0, 36]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _GetPlanLTVReportRequest_Format_InLookup[m.GetFormat()]; !ok {
+		err := GetPlanLTVReportRequestValidationError{
+			field:  "Format",
+			reason: "value must be in list [ csv]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetPlanLTVReportRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetPlanLTVReportRequestMultiError is an error wrapping multiple validation
+// errors returned by GetPlanLTVReportRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetPlanLTVReportRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetPlanLTVReportRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetPlanLTVReportRequestMultiError) AllErrors() []error { return m }
+
+// GetPlanLTVReportRequestValidationError is the validation error returned by
+// GetPlanLTVReportRequest.Validate if the designated constraints aren't met.
+type GetPlanLTVReportRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetPlanLTVReportRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetPlanLTVReportRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetPlanLTVReportRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetPlanLTVReportRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetPlanLTVReportRequestValidationError) ErrorName() string {
+	return "GetPlanLTVReportRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetPlanLTVReportRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetPlanLTVReportRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetPlanLTVReportRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetPlanLTVReportRequestValidationError{}
+
+var _GetPlanLTVReportRequest_Format_InLookup = map[string]struct{}{
+	"":    {},
+	"csv": {},
+}
+
+// Validate checks the field values on PlanLTV with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *PlanLTV) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PlanLTV with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in PlanLTVMultiError, or nil if none found.
+func (m *PlanLTV) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PlanLTV) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for PlanId
+
+	// no validation rules for PlanName
+
+	// no validation rules for BillingType
+
+	// no validation rules for Subscribers
+
+	// no validation rules for Orders
+
+	// no validation rules for AverageOrderRevenue
+
+	// no validation rules for RenewalRate
+
+	// no validation rules for ChurnRate
+
+	// no validation rules for ExpectedPeriods
+
+	// no validation rules for EstimatedLtv
+
+	// no validation rules for ObservedRevenuePerUser
+
+	// no validation rules for EndedPeriods
+
+	// no validation rules for LtvUnknown
+
+	if len(errors) > 0 {
+		return PlanLTVMultiError(errors)
+	}
+
+	return nil
+}
+
+// PlanLTVMultiError is an error wrapping multiple validation errors returned
+// by PlanLTV.ValidateAll() if the designated constraints aren't met.
+type PlanLTVMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PlanLTVMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PlanLTVMultiError) AllErrors() []error { return m }
+
+// PlanLTVValidationError is the validation error returned by PlanLTV.Validate
+// if the designated constraints aren't met.
+type PlanLTVValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PlanLTVValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PlanLTVValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PlanLTVValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PlanLTVValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PlanLTVValidationError) ErrorName() string { return "PlanLTVValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PlanLTVValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPlanLTV.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PlanLTVValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PlanLTVValidationError{}
+
+// Validate checks the field values on GetPlanLTVReportReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetPlanLTVReportReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetPlanLTVReportReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetPlanLTVReportReplyMultiError, or nil if none found.
+func (m *GetPlanLTVReportReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetPlanLTVReportReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for AppId
+
+	// no validation rules for ReportingCurrency
+
+	// no validation rules for StartTime
+
+	for idx, item := range m.GetPlans() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetPlanLTVReportReplyValidationError{
+						field:  fmt.Sprintf("Plans[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetPlanLTVReportReplyValidationError{
+						field:  fmt.Sprintf("Plans[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetPlanLTVReportReplyValidationError{
+					field:  fmt.Sprintf("Plans[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Content
+
+	// no validation rules for ContentType
+
+	// no validation rules for FileName
+
+	if len(errors) > 0 {
+		return GetPlanLTVReportReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetPlanLTVReportReplyMultiError is an error wrapping multiple validation
+// errors returned by GetPlanLTVReportReply.ValidateAll() if the designated
+// constraints aren't met.
+type GetPlanLTVReportReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetPlanLTVReportReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetPlanLTVReportReplyMultiError) AllErrors() []error { return m }
+
+// GetPlanLTVReportReplyValidationError is the validation error returned by
+// GetPlanLTVReportReply.Validate if the designated constraints aren't met.
+type GetPlanLTVReportReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetPlanLTVReportReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetPlanLTVReportReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetPlanLTVReportReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetPlanLTVReportReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetPlanLTVReportReplyValidationError) ErrorName() string {
+	return "GetPlanLTVReportReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetPlanLTVReportReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetPlanLTVReportReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetPlanLTVReportReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetPlanLTVReportReplyValidationError{}
+
+// Validate checks the field values on JobRun with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *JobRun) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on JobRun with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in JobRunMultiError, or nil if none found.
+func (m *JobRun) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *JobRun) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for JobRunId
+
+	// no validation rules for JobName
+
+	// no validation rules for ScheduledAt
+
+	// no validation rules for Trigger
+
+	// no validation rules for Status
+
+	// no validation rules for Host
+
+	// no validation rules for Attempts
+
+	// no validation rules for DryRun
+
+	// no validation rules for StartedAt
+
+	// no validation rules for FinishedAt
+
+	// no validation rules for TotalCount
+
+	// no validation rules for SuccessCount
+
+	// no validation rules for FailedCount
+
+	// no validation rules for Error
+
+	if len(errors) > 0 {
+		return JobRunMultiError(errors)
+	}
+
+	return nil
+}
+
+// JobRunMultiError is an error wrapping multiple validation errors returned by
+// JobRun.ValidateAll() if the designated constraints aren't met.
+type JobRunMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m JobRunMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m JobRunMultiError) AllErrors() []error { return m }
+
+// JobRunValidationError is the validation error returned by JobRun.Validate if
+// the designated constraints aren't met.
+type JobRunValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e JobRunValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e JobRunValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e JobRunValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e JobRunValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e JobRunValidationError) ErrorName() string { return "JobRunValidationError" }
+
+// Error satisfies the builtin error interface
+func (e JobRunValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sJobRun.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = JobRunValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = JobRunValidationError{}
+
+// Validate checks the field values on CronJob with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *CronJob) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CronJob with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in CronJobMultiError, or nil if none found.
+func (m *CronJob) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CronJob) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	// no validation rules for Spec
+
+	// no validation rules for TimeoutSeconds
+
+	// no validation rules for CatchUpAll
+
+	// no validation rules for Resumable
+
+	// no validation rules for Paused
+
+	// no validation rules for PauseReason
+
+	// no validation rules for PausedAt
+
+	// no validation rules for NextRunAt
+
+	if all {
+		switch v := interface{}(m.GetLastRun()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CronJobValidationError{
+					field:  "LastRun",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CronJobValidationError{
+					field:  "LastRun",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLastRun()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CronJobValidationError{
+				field:  "LastRun",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CronJobMultiError(errors)
+	}
+
+	return nil
+}
+
+// CronJobMultiError is an error wrapping multiple validation errors returned
+// by CronJob.ValidateAll() if the designated constraints aren't met.
+type CronJobMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CronJobMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CronJobMultiError) AllErrors() []error { return m }
+
+// CronJobValidationError is the validation error returned by CronJob.Validate
+// if the designated constraints aren't met.
+type CronJobValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CronJobValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CronJobValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CronJobValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CronJobValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CronJobValidationError) ErrorName() string { return "CronJobValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CronJobValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCronJob.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CronJobValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CronJobValidationError{}
+
+// Validate checks the field values on ListJobsRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListJobsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListJobsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListJobsRequestMultiError, or nil if none found.
+func (m *ListJobsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListJobsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ListJobsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListJobsRequestMultiError is an error wrapping multiple validation errors
+// returned by ListJobsRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ListJobsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListJobsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListJobsRequestMultiError) AllErrors() []error { return m }
+
+// ListJobsRequestValidationError is the validation error returned by
+// ListJobsRequest.Validate if the designated constraints aren't met.
+type ListJobsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListJobsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListJobsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListJobsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListJobsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListJobsRequestValidationError) ErrorName() string { return "ListJobsRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ListJobsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListJobsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListJobsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListJobsRequestValidationError{}
+
+// Validate checks the field values on ListJobsReply with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ListJobsReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListJobsReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ListJobsReplyMultiError, or
+// nil if none found.
+func (m *ListJobsReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListJobsReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetJobs() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListJobsReplyValidationError{
+						field:  fmt.Sprintf("Jobs[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListJobsReplyValidationError{
+						field:  fmt.Sprintf("Jobs[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListJobsReplyValidationError{
+					field:  fmt.Sprintf("Jobs[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Leader
+
+	if len(errors) > 0 {
+		return ListJobsReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListJobsReplyMultiError is an error wrapping multiple validation errors
+// returned by ListJobsReply.ValidateAll() if the designated constraints
+// aren't met.
+type ListJobsReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListJobsReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListJobsReplyMultiError) AllErrors() []error { return m }
+
+// ListJobsReplyValidationError is the validation error returned by
+// ListJobsReply.Validate if the designated constraints aren't met.
+type ListJobsReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListJobsReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListJobsReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListJobsReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListJobsReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListJobsReplyValidationError) ErrorName() string { return "ListJobsReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ListJobsReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListJobsReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListJobsReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListJobsReplyValidationError{}
+
+// Validate checks the field values on TriggerJobRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *TriggerJobRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TriggerJobRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TriggerJobRequestMultiError, or nil if none found.
+func (m *TriggerJobRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TriggerJobRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 64 {
+		err := TriggerJobRequestValidationError{
+			field:  "Name",
+			reason: "value length must be between 1 and 64 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for DryRun
+
+	if len(errors) > 0 {
+		return TriggerJobRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// TriggerJobRequestMultiError is an error wrapping multiple validation errors
+// returned by TriggerJobRequest.ValidateAll() if the designated constraints
+// aren't met.
+type TriggerJobRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TriggerJobRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TriggerJobRequestMultiError) AllErrors() []error { return m }
+
+// TriggerJobRequestValidationError is the validation error returned by
+// TriggerJobRequest.Validate if the designated constraints aren't met.
+type TriggerJobRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TriggerJobRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TriggerJobRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TriggerJobRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TriggerJobRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TriggerJobRequestValidationError) ErrorName() string {
+	return "TriggerJobRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e TriggerJobRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTriggerJobRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TriggerJobRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TriggerJobRequestValidationError{}
+
+// Validate checks the field values on TriggerJobReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *TriggerJobReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TriggerJobReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TriggerJobReplyMultiError, or nil if none found.
+func (m *TriggerJobReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TriggerJobReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetRun()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TriggerJobReplyValidationError{
+					field:  "Run",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TriggerJobReplyValidationError{
+					field:  "Run",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRun()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TriggerJobReplyValidationError{
+				field:  "Run",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return TriggerJobReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// TriggerJobReplyMultiError is an error wrapping multiple validation errors
+// returned by TriggerJobReply.ValidateAll() if the designated constraints
+// aren't met.
+type TriggerJobReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TriggerJobReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TriggerJobReplyMultiError) AllErrors() []error { return m }
+
+// TriggerJobReplyValidationError is the validation error returned by
+// TriggerJobReply.Validate if the designated constraints aren't met.
+type TriggerJobReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TriggerJobReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TriggerJobReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TriggerJobReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TriggerJobReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TriggerJobReplyValidationError) ErrorName() string { return "TriggerJobReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TriggerJobReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTriggerJobReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TriggerJobReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TriggerJobReplyValidationError{}
+
+// Validate checks the field values on PauseJobRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *PauseJobRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PauseJobRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PauseJobRequestMultiError, or nil if none found.
+func (m *PauseJobRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PauseJobRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 64 {
+		err := PauseJobRequestValidationError{
+			field:  "Name",
+			reason: "value length must be between 1 and 64 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetReason()) > 255 {
+		err := PauseJobRequestValidationError{
+			field:  "Reason",
+			reason: "value length must be at most 255 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return PauseJobRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// PauseJobRequestMultiError is an error wrapping multiple validation errors
+// returned by PauseJobRequest.ValidateAll() if the designated constraints
+// aren't met.
+type PauseJobRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PauseJobRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PauseJobRequestMultiError) AllErrors() []error { return m }
+
+// PauseJobRequestValidationError is the validation error returned by
+// PauseJobRequest.Validate if the designated constraints aren't met.
+type PauseJobRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PauseJobRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PauseJobRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PauseJobRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PauseJobRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PauseJobRequestValidationError) ErrorName() string { return "PauseJobRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PauseJobRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPauseJobRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PauseJobRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PauseJobRequestValidationError{}
+
+// Validate checks the field values on ResumeJobRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ResumeJobRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ResumeJobRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ResumeJobRequestMultiError, or nil if none found.
+func (m *ResumeJobRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ResumeJobRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 64 {
+		err := ResumeJobRequestValidationError{
+			field:  "Name",
+			reason: "value length must be between 1 and 64 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ResumeJobRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ResumeJobRequestMultiError is an error wrapping multiple validation errors
+// returned by ResumeJobRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ResumeJobRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ResumeJobRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ResumeJobRequestMultiError) AllErrors() []error { return m }
+
+// ResumeJobRequestValidationError is the validation error returned by
+// ResumeJobRequest.Validate if the designated constraints aren't met.
+type ResumeJobRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ResumeJobRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ResumeJobRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ResumeJobRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ResumeJobRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ResumeJobRequestValidationError) ErrorName() string { return "ResumeJobRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ResumeJobRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sResumeJobRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ResumeJobRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ResumeJobRequestValidationError{}
+
+// Validate checks the field values on ListJobRunsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListJobRunsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListJobRunsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListJobRunsRequestMultiError, or nil if none found.
+func (m *ListJobRunsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListJobRunsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetJobName()) > 64 {
+		err := ListJobRunsRequestValidationError{
+			field:  "JobName",
+			reason: "value length must be at most 64 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _ListJobRunsRequest_Status_InLookup[m.GetStatus()]; !ok {
+		err := ListJobRunsRequestValidationError{
+			field:  "Status",
+			reason: "value must be in list [ running success failed skipped]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetPage() < 0 {
+		err := ListJobRunsRequestValidationError{
+			field:  "Page",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetPageSize(); val < 0 || val > 100 {
+		err := ListJobRunsRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be inside range [0, 100]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListJobRunsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListJobRunsRequestMultiError is an error wrapping multiple validation errors
+// returned by ListJobRunsRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ListJobRunsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListJobRunsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListJobRunsRequestMultiError) AllErrors() []error { return m }
+
+// ListJobRunsRequestValidationError is the validation error returned by
+// ListJobRunsRequest.Validate if the designated constraints aren't met.
+type ListJobRunsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListJobRunsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListJobRunsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListJobRunsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListJobRunsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListJobRunsRequestValidationError) ErrorName() string {
+	return "ListJobRunsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListJobRunsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListJobRunsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListJobRunsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListJobRunsRequestValidationError{}
+
+var _ListJobRunsRequest_Status_InLookup = map[string]struct{}{
+	"":        {},
+	"running": {},
+	"success": {},
+	"failed":  {},
+	"skipped": {},
+}
+
+// Validate checks the field values on ListJobRunsReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListJobRunsReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListJobRunsReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListJobRunsReplyMultiError, or nil if none found.
+func (m *ListJobRunsReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListJobRunsReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetRuns() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListJobRunsReplyValidationError{
+						field:  fmt.Sprintf("Runs[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListJobRunsReplyValidationError{
+						field:  fmt.Sprintf("Runs[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListJobRunsReplyValidationError{
+					field:  fmt.Sprintf("Runs[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Total
+
+	// no validation rules for Page
+
+	// no validation rules for PageSize
+
+	if len(errors) > 0 {
+		return ListJobRunsReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListJobRunsReplyMultiError is an error wrapping multiple validation errors
+// returned by ListJobRunsReply.ValidateAll() if the designated constraints
+// aren't met.
+type ListJobRunsReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListJobRunsReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListJobRunsReplyMultiError) AllErrors() []error { return m }
+
+// ListJobRunsReplyValidationError is the validation error returned by
+// ListJobRunsReply.Validate if the designated constraints aren't met.
+type ListJobRunsReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListJobRunsReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListJobRunsReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListJobRunsReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListJobRunsReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListJobRunsReplyValidationError) ErrorName() string { return "ListJobRunsReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ListJobRunsReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListJobRunsReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListJobRunsReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListJobRunsReplyValidationError{}
+
+// Validate checks the field values on SupportSubscription with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SupportSubscription) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SupportSubscription with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SupportSubscriptionMultiError, or nil if none found.
+func (m *SupportSubscription) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SupportSubscription) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for SubscriptionId
+
+	// no validation rules for Uid
+
+	// no validation rules for PlanId
+
+	// no validation rules for AppId
+
+	// no validation rules for CountryCode
+
+	// no validation rules for Status
+
+	// no validation rules for StartTime
+
+	// no validation rules for EndTime
+
+	// no validation rules for AutoRenew
+
+	// no validation rules for OrderId
+
+	// no validation rules for Version
+
+	// no validation rules for CreatedAt
+
+	// no validation rules for UpdatedAt
+
+	// no validation rules for Source
+
+	// no validation rules for NextPlanId
+
+	if len(errors) > 0 {
+		return SupportSubscriptionMultiError(errors)
+	}
+
+	return nil
+}
+
+// SupportSubscriptionMultiError is an error wrapping multiple validation
+// errors returned by SupportSubscription.ValidateAll() if the designated
+// constraints aren't met.
+type SupportSubscriptionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SupportSubscriptionMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SupportSubscriptionMultiError) AllErrors() []error { return m }
+
+// SupportSubscriptionValidationError is the validation error returned by
+// SupportSubscription.Validate if the designated constraints aren't met.
+type SupportSubscriptionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SupportSubscriptionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SupportSubscriptionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SupportSubscriptionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SupportSubscriptionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SupportSubscriptionValidationError) ErrorName() string {
+	return "SupportSubscriptionValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SupportSubscriptionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSupportSubscription.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SupportSubscriptionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SupportSubscriptionValidationError{}
 
-// Validate checks the field values on GetSubscriptionHistoryReply with the
+// Validate checks the field values on SearchSubscriptionsRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetSubscriptionHistoryReply) Validate() error {
+func (m *SearchSubscriptionsRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetSubscriptionHistoryReply with the
+// ValidateAll checks the field values on SearchSubscriptionsRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetSubscriptionHistoryReplyMultiError, or nil if none found.
-func (m *GetSubscriptionHistoryReply) ValidateAll() error {
+// SearchSubscriptionsRequestMultiError, or nil if none found.
+func (m *SearchSubscriptionsRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetSubscriptionHistoryReply) validate(all bool) error {
+func (m *SearchSubscriptionsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetItems() {
+	if utf8.RuneCountInString(m.GetUid()) > 36 {
+		err := SearchSubscriptionsRequestValidationError{
+			field:  "Uid",
+			reason: "value length must be at most 36 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetAppId()) > 50 {
+		err := SearchSubscriptionsRequestValidationError{
+			field:  "AppId",
+			reason: "value length must be at most 50 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetPlanId()) > 50 {
+		err := SearchSubscriptionsRequestValidationError{
+			field:  "PlanId",
+			reason: "value length must be at most 50 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _SearchSubscriptionsRequest_Status_InLookup[m.GetStatus()]; !ok {
+		err := SearchSubscriptionsRequestValidationError{
+			field:  "Status",
+			reason: "value must be in list [ active expired paused cancelled]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _SearchSubscriptionsRequest_TimeField_InLookup[m.GetTimeField()]; !ok {
+		err := SearchSubscriptionsRequestValidationError{
+			field:  "TimeField",
+			reason: "value must be in list [ start_time end_time created_at updated_at]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetStartTime() < 0 {
+		err := SearchSubscriptionsRequestValidationError{
+			field:  "StartTime",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetEndTime() < 0 {
+		err := SearchSubscriptionsRequestValidationError{
+			field:  "EndTime",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetPage() < 0 {
+		err := SearchSubscriptionsRequestValidationError{
+			field:  "Page",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetPageSize(); val < 0 || val > 100 {
+		err := SearchSubscriptionsRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be inside range [0, 100]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return SearchSubscriptionsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SearchSubscriptionsRequestMultiError is an error wrapping multiple
+// validation errors returned by SearchSubscriptionsRequest.ValidateAll() if
+// the designated constraints aren't met.
+type SearchSubscriptionsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SearchSubscriptionsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SearchSubscriptionsRequestMultiError) AllErrors() []error { return m }
+
+// SearchSubscriptionsRequestValidationError is the validation error returned
+// by SearchSubscriptionsRequest.Validate if the designated constraints aren't met.
+type SearchSubscriptionsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SearchSubscriptionsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SearchSubscriptionsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SearchSubscriptionsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SearchSubscriptionsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SearchSubscriptionsRequestValidationError) ErrorName() string {
+	return "SearchSubscriptionsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SearchSubscriptionsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSearchSubscriptionsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SearchSubscriptionsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SearchSubscriptionsRequestValidationError{}
+
+var _SearchSubscriptionsRequest_Status_InLookup = map[string]struct{}{
+	"":          {},
+	"active":    {},
+	"expired":   {},
+	"paused":    {},
+	"cancelled": {},
+}
+
+var _SearchSubscriptionsRequest_TimeField_InLookup = map[string]struct{}{
+	"":           {},
+	"start_time": {},
+	"end_time":   {},
+	"created_at": {},
+	"updated_at": {},
+}
+
+// Validate checks the field values on SearchSubscriptionsReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SearchSubscriptionsReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SearchSubscriptionsReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SearchSubscriptionsReplyMultiError, or nil if none found.
+func (m *SearchSubscriptionsReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SearchSubscriptionsReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetSubscriptions() {
 		_, _ = idx, item
 
 		if all {
 			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, GetSubscriptionHistoryReplyValidationError{
-						field:  fmt.Sprintf("Items[%v]", idx),
+					errors = append(errors, SearchSubscriptionsReplyValidationError{
+						field:  fmt.Sprintf("Subscriptions[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, GetSubscriptionHistoryReplyValidationError{
-						field:  fmt.Sprintf("Items[%v]", idx),
+					errors = append(errors, SearchSubscriptionsReplyValidationError{
+						field:  fmt.Sprintf("Subscriptions[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -2387,8 +14739,8 @@ func (m *GetSubscriptionHistoryReply) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return GetSubscriptionHistoryReplyValidationError{
-					field:  fmt.Sprintf("Items[%v]", idx),
+				return SearchSubscriptionsReplyValidationError{
+					field:  fmt.Sprintf("Subscriptions[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -2404,19 +14756,19 @@ func (m *GetSubscriptionHistoryReply) validate(all bool) error {
 	// no validation rules for PageSize
 
 	if len(errors) > 0 {
-		return GetSubscriptionHistoryReplyMultiError(errors)
+		return SearchSubscriptionsReplyMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetSubscriptionHistoryReplyMultiError is an error wrapping multiple
-// validation errors returned by GetSubscriptionHistoryReply.ValidateAll() if
-// the designated constraints aren't met.
-type GetSubscriptionHistoryReplyMultiError []error
+// SearchSubscriptionsReplyMultiError is an error wrapping multiple validation
+// errors returned by SearchSubscriptionsReply.ValidateAll() if the designated
+// constraints aren't met.
+type SearchSubscriptionsReplyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetSubscriptionHistoryReplyMultiError) Error() string {
+func (m SearchSubscriptionsReplyMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2425,12 +14777,11 @@ func (m GetSubscriptionHistoryReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetSubscriptionHistoryReplyMultiError) AllErrors() []error { return m }
+func (m SearchSubscriptionsReplyMultiError) AllErrors() []error { return m }
 
-// GetSubscriptionHistoryReplyValidationError is the validation error returned
-// by GetSubscriptionHistoryReply.Validate if the designated constraints
-// aren't met.
-type GetSubscriptionHistoryReplyValidationError struct {
+// SearchSubscriptionsReplyValidationError is the validation error returned by
+// SearchSubscriptionsReply.Validate if the designated constraints aren't met.
+type SearchSubscriptionsReplyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2438,24 +14789,24 @@ type GetSubscriptionHistoryReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetSubscriptionHistoryReplyValidationError) Field() string { return e.field }
+func (e SearchSubscriptionsReplyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetSubscriptionHistoryReplyValidationError) Reason() string { return e.reason }
+func (e SearchSubscriptionsReplyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetSubscriptionHistoryReplyValidationError) Cause() error { return e.cause }
+func (e SearchSubscriptionsReplyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetSubscriptionHistoryReplyValidationError) Key() bool { return e.key }
+func (e SearchSubscriptionsReplyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetSubscriptionHistoryReplyValidationError) ErrorName() string {
-	return "GetSubscriptionHistoryReplyValidationError"
+func (e SearchSubscriptionsReplyValidationError) ErrorName() string {
+	return "SearchSubscriptionsReplyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetSubscriptionHistoryReplyValidationError) Error() string {
+func (e SearchSubscriptionsReplyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2467,14 +14818,14 @@ func (e GetSubscriptionHistoryReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetSubscriptionHistoryReply.%s: %s%s",
+		"invalid %sSearchSubscriptionsReply.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetSubscriptionHistoryReplyValidationError{}
+var _ error = SearchSubscriptionsReplyValidationError{}
 
 var _ interface {
 	Field() string
@@ -2482,57 +14833,69 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetSubscriptionHistoryReplyValidationError{}
+} = SearchSubscriptionsReplyValidationError{}
 
-// Validate checks the field values on SetAutoRenewRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *SetAutoRenewRequest) Validate() error {
+// Validate checks the field values on SupportOrder with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SupportOrder) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SetAutoRenewRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// SetAutoRenewRequestMultiError, or nil if none found.
-func (m *SetAutoRenewRequest) ValidateAll() error {
-	return m.validate(true)
-}
+// ValidateAll checks the field values on SupportOrder with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SupportOrderMultiError, or
+// nil if none found.
+func (m *SupportOrder) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SupportOrder) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for OrderId
+
+	// no validation rules for PaymentId
+
+	// no validation rules for PlanId
+
+	// no validation rules for AppId
+
+	// no validation rules for Currency
+
+	// no validation rules for Amount
+
+	// no validation rules for RefundedAmount
 
-func (m *SetAutoRenewRequest) validate(all bool) error {
-	if m == nil {
-		return nil
-	}
+	// no validation rules for PaymentStatus
 
-	var errors []error
+	// no validation rules for PeriodStart
 
-	if l := utf8.RuneCountInString(m.GetUid()); l < 1 || l > 36 {
-		err := SetAutoRenewRequestValidationError{
-			field:  "Uid",
-			reason: "value length must be between 1 and 36 runes, inclusive",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for PeriodEnd
 
-	// no validation rules for AutoRenew
+	// no validation rules for PaidAt
+
+	// no validation rules for CreatedAt
+
+	// no validation rules for Source
 
 	if len(errors) > 0 {
-		return SetAutoRenewRequestMultiError(errors)
+		return SupportOrderMultiError(errors)
 	}
 
 	return nil
 }
 
-// SetAutoRenewRequestMultiError is an error wrapping multiple validation
-// errors returned by SetAutoRenewRequest.ValidateAll() if the designated
-// constraints aren't met.
-type SetAutoRenewRequestMultiError []error
+// SupportOrderMultiError is an error wrapping multiple validation errors
+// returned by SupportOrder.ValidateAll() if the designated constraints aren't met.
+type SupportOrderMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SetAutoRenewRequestMultiError) Error() string {
+func (m SupportOrderMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2541,11 +14904,11 @@ func (m SetAutoRenewRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SetAutoRenewRequestMultiError) AllErrors() []error { return m }
+func (m SupportOrderMultiError) AllErrors() []error { return m }
 
-// SetAutoRenewRequestValidationError is the validation error returned by
-// SetAutoRenewRequest.Validate if the designated constraints aren't met.
-type SetAutoRenewRequestValidationError struct {
+// SupportOrderValidationError is the validation error returned by
+// SupportOrder.Validate if the designated constraints aren't met.
+type SupportOrderValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2553,24 +14916,22 @@ type SetAutoRenewRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e SetAutoRenewRequestValidationError) Field() string { return e.field }
+func (e SupportOrderValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SetAutoRenewRequestValidationError) Reason() string { return e.reason }
+func (e SupportOrderValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SetAutoRenewRequestValidationError) Cause() error { return e.cause }
+func (e SupportOrderValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SetAutoRenewRequestValidationError) Key() bool { return e.key }
+func (e SupportOrderValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SetAutoRenewRequestValidationError) ErrorName() string {
-	return "SetAutoRenewRequestValidationError"
-}
+func (e SupportOrderValidationError) ErrorName() string { return "SupportOrderValidationError" }
 
 // Error satisfies the builtin error interface
-func (e SetAutoRenewRequestValidationError) Error() string {
+func (e SupportOrderValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2582,14 +14943,14 @@ func (e SetAutoRenewRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSetAutoRenewRequest.%s: %s%s",
+		"invalid %sSupportOrder.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SetAutoRenewRequestValidationError{}
+var _ error = SupportOrderValidationError{}
 
 var _ interface {
 	Field() string
@@ -2597,59 +14958,66 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SetAutoRenewRequestValidationError{}
+} = SupportOrderValidationError{}
 
-// Validate checks the field values on GetExpiringSubscriptionsRequest with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetExpiringSubscriptionsRequest) Validate() error {
+// Validate checks the field values on TimelineEvent with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *TimelineEvent) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetExpiringSubscriptionsRequest with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// GetExpiringSubscriptionsRequestMultiError, or nil if none found.
-func (m *GetExpiringSubscriptionsRequest) ValidateAll() error {
+// ValidateAll checks the field values on TimelineEvent with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in TimelineEventMultiError, or
+// nil if none found.
+func (m *TimelineEvent) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetExpiringSubscriptionsRequest) validate(all bool) error {
+func (m *TimelineEvent) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if val := m.GetDaysBeforeExpiry(); val < 1 || val > 30 {
-		err := GetExpiringSubscriptionsRequestValidationError{
-			field:  "DaysBeforeExpiry",
-			reason: "value must be inside range [1, 30]",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for Time
 
-	// no validation rules for Page
+	// no validation rules for Type
 
-	// no validation rules for PageSize
+	// no validation rules for Action
+
+	// no validation rules for PlanId
+
+	// no validation rules for OrderId
+
+	// no validation rules for Status
+
+	// no validation rules for Amount
+
+	// no validation rules for Currency
+
+	// no validation rules for OperatorId
+
+	// no validation rules for Reason
+
+	// no validation rules for ActorType
 
 	if len(errors) > 0 {
-		return GetExpiringSubscriptionsRequestMultiError(errors)
+		return TimelineEventMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetExpiringSubscriptionsRequestMultiError is an error wrapping multiple
-// validation errors returned by GetExpiringSubscriptionsRequest.ValidateAll()
-// if the designated constraints aren't met.
-type GetExpiringSubscriptionsRequestMultiError []error
+// TimelineEventMultiError is an error wrapping multiple validation errors
+// returned by TimelineEvent.ValidateAll() if the designated constraints
+// aren't met.
+type TimelineEventMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetExpiringSubscriptionsRequestMultiError) Error() string {
+func (m TimelineEventMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2658,12 +15026,11 @@ func (m GetExpiringSubscriptionsRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetExpiringSubscriptionsRequestMultiError) AllErrors() []error { return m }
+func (m TimelineEventMultiError) AllErrors() []error { return m }
 
-// GetExpiringSubscriptionsRequestValidationError is the validation error
-// returned by GetExpiringSubscriptionsRequest.Validate if the designated
-// constraints aren't met.
-type GetExpiringSubscriptionsRequestValidationError struct {
+// TimelineEventValidationError is the validation error returned by
+// TimelineEvent.Validate if the designated constraints aren't met.
+type TimelineEventValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2671,24 +15038,22 @@ type GetExpiringSubscriptionsRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetExpiringSubscriptionsRequestValidationError) Field() string { return e.field }
+func (e TimelineEventValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetExpiringSubscriptionsRequestValidationError) Reason() string { return e.reason }
+func (e TimelineEventValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetExpiringSubscriptionsRequestValidationError) Cause() error { return e.cause }
+func (e TimelineEventValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetExpiringSubscriptionsRequestValidationError) Key() bool { return e.key }
+func (e TimelineEventValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetExpiringSubscriptionsRequestValidationError) ErrorName() string {
-	return "GetExpiringSubscriptionsRequestValidationError"
-}
+func (e TimelineEventValidationError) ErrorName() string { return "TimelineEventValidationError" }
 
 // Error satisfies the builtin error interface
-func (e GetExpiringSubscriptionsRequestValidationError) Error() string {
+func (e TimelineEventValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2700,14 +15065,14 @@ func (e GetExpiringSubscriptionsRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetExpiringSubscriptionsRequest.%s: %s%s",
+		"invalid %sTimelineEvent.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetExpiringSubscriptionsRequestValidationError{}
+var _ error = TimelineEventValidationError{}
 
 var _ interface {
 	Field() string
@@ -2715,58 +15080,55 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetExpiringSubscriptionsRequestValidationError{}
+} = TimelineEventValidationError{}
 
-// Validate checks the field values on SubscriptionInfo with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *SubscriptionInfo) Validate() error {
+// Validate checks the field values on GetUserTimelineRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetUserTimelineRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SubscriptionInfo with the rules
+// ValidateAll checks the field values on GetUserTimelineRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// SubscriptionInfoMultiError, or nil if none found.
-func (m *SubscriptionInfo) ValidateAll() error {
+// GetUserTimelineRequestMultiError, or nil if none found.
+func (m *GetUserTimelineRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SubscriptionInfo) validate(all bool) error {
+func (m *GetUserTimelineRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Uid
-
-	// no validation rules for PlanId
-
-	// no validation rules for PlanName
-
-	// no validation rules for StartTime
-
-	// no validation rules for EndTime
-
-	// no validation rules for AutoRenew
-
-	// no validation rules for Amount
+	if l := utf8.RuneCountInString(m.GetUid()); l < 1 || l > 36 {
+		err := GetUserTimelineRequestValidationError{
+			field:  "Uid",
+			reason: "value length must be between 1 and 36 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
 	if len(errors) > 0 {
-		return SubscriptionInfoMultiError(errors)
+		return GetUserTimelineRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// SubscriptionInfoMultiError is an error wrapping multiple validation errors
-// returned by SubscriptionInfo.ValidateAll() if the designated constraints
-// aren't met.
-type SubscriptionInfoMultiError []error
+// GetUserTimelineRequestMultiError is an error wrapping multiple validation
+// errors returned by GetUserTimelineRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetUserTimelineRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SubscriptionInfoMultiError) Error() string {
+func (m GetUserTimelineRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2775,11 +15137,11 @@ func (m SubscriptionInfoMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SubscriptionInfoMultiError) AllErrors() []error { return m }
+func (m GetUserTimelineRequestMultiError) AllErrors() []error { return m }
 
-// SubscriptionInfoValidationError is the validation error returned by
-// SubscriptionInfo.Validate if the designated constraints aren't met.
-type SubscriptionInfoValidationError struct {
+// GetUserTimelineRequestValidationError is the validation error returned by
+// GetUserTimelineRequest.Validate if the designated constraints aren't met.
+type GetUserTimelineRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2787,22 +15149,24 @@ type SubscriptionInfoValidationError struct {
 }
 
 // Field function returns field value.
-func (e SubscriptionInfoValidationError) Field() string { return e.field }
+func (e GetUserTimelineRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SubscriptionInfoValidationError) Reason() string { return e.reason }
+func (e GetUserTimelineRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SubscriptionInfoValidationError) Cause() error { return e.cause }
+func (e GetUserTimelineRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SubscriptionInfoValidationError) Key() bool { return e.key }
+func (e GetUserTimelineRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SubscriptionInfoValidationError) ErrorName() string { return "SubscriptionInfoValidationError" }
+func (e GetUserTimelineRequestValidationError) ErrorName() string {
+	return "GetUserTimelineRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e SubscriptionInfoValidationError) Error() string {
+func (e GetUserTimelineRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2814,14 +15178,14 @@ func (e SubscriptionInfoValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSubscriptionInfo.%s: %s%s",
+		"invalid %sGetUserTimelineRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SubscriptionInfoValidationError{}
+var _ error = GetUserTimelineRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -2829,47 +15193,144 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SubscriptionInfoValidationError{}
+} = GetUserTimelineRequestValidationError{}
 
-// Validate checks the field values on GetExpiringSubscriptionsReply with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on GetUserTimelineReply with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetExpiringSubscriptionsReply) Validate() error {
+func (m *GetUserTimelineReply) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetExpiringSubscriptionsReply with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// GetExpiringSubscriptionsReplyMultiError, or nil if none found.
-func (m *GetExpiringSubscriptionsReply) ValidateAll() error {
+// ValidateAll checks the field values on GetUserTimelineReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetUserTimelineReplyMultiError, or nil if none found.
+func (m *GetUserTimelineReply) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetExpiringSubscriptionsReply) validate(all bool) error {
+func (m *GetUserTimelineReply) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetSubscriptions() {
+	if all {
+		switch v := interface{}(m.GetSubscription()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetUserTimelineReplyValidationError{
+					field:  "Subscription",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetUserTimelineReplyValidationError{
+					field:  "Subscription",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSubscription()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetUserTimelineReplyValidationError{
+				field:  "Subscription",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetOrders() {
 		_, _ = idx, item
 
 		if all {
 			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, GetExpiringSubscriptionsReplyValidationError{
-						field:  fmt.Sprintf("Subscriptions[%v]", idx),
+					errors = append(errors, GetUserTimelineReplyValidationError{
+						field:  fmt.Sprintf("Orders[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetUserTimelineReplyValidationError{
+						field:  fmt.Sprintf("Orders[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetUserTimelineReplyValidationError{
+					field:  fmt.Sprintf("Orders[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetHistory() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetUserTimelineReplyValidationError{
+						field:  fmt.Sprintf("History[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetUserTimelineReplyValidationError{
+						field:  fmt.Sprintf("History[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetUserTimelineReplyValidationError{
+					field:  fmt.Sprintf("History[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetEvents() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetUserTimelineReplyValidationError{
+						field:  fmt.Sprintf("Events[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, GetExpiringSubscriptionsReplyValidationError{
-						field:  fmt.Sprintf("Subscriptions[%v]", idx),
+					errors = append(errors, GetUserTimelineReplyValidationError{
+						field:  fmt.Sprintf("Events[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -2877,8 +15338,8 @@ func (m *GetExpiringSubscriptionsReply) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return GetExpiringSubscriptionsReplyValidationError{
-					field:  fmt.Sprintf("Subscriptions[%v]", idx),
+				return GetUserTimelineReplyValidationError{
+					field:  fmt.Sprintf("Events[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -2887,26 +15348,20 @@ func (m *GetExpiringSubscriptionsReply) validate(all bool) error {
 
 	}
 
-	// no validation rules for Total
-
-	// no validation rules for Page
-
-	// no validation rules for PageSize
-
 	if len(errors) > 0 {
-		return GetExpiringSubscriptionsReplyMultiError(errors)
+		return GetUserTimelineReplyMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetExpiringSubscriptionsReplyMultiError is an error wrapping multiple
-// validation errors returned by GetExpiringSubscriptionsReply.ValidateAll()
-// if the designated constraints aren't met.
-type GetExpiringSubscriptionsReplyMultiError []error
+// GetUserTimelineReplyMultiError is an error wrapping multiple validation
+// errors returned by GetUserTimelineReply.ValidateAll() if the designated
+// constraints aren't met.
+type GetUserTimelineReplyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetExpiringSubscriptionsReplyMultiError) Error() string {
+func (m GetUserTimelineReplyMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2915,12 +15370,11 @@ func (m GetExpiringSubscriptionsReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetExpiringSubscriptionsReplyMultiError) AllErrors() []error { return m }
+func (m GetUserTimelineReplyMultiError) AllErrors() []error { return m }
 
-// GetExpiringSubscriptionsReplyValidationError is the validation error
-// returned by GetExpiringSubscriptionsReply.Validate if the designated
-// constraints aren't met.
-type GetExpiringSubscriptionsReplyValidationError struct {
+// GetUserTimelineReplyValidationError is the validation error returned by
+// GetUserTimelineReply.Validate if the designated constraints aren't met.
+type GetUserTimelineReplyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2928,24 +15382,24 @@ type GetExpiringSubscriptionsReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetExpiringSubscriptionsReplyValidationError) Field() string { return e.field }
+func (e GetUserTimelineReplyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetExpiringSubscriptionsReplyValidationError) Reason() string { return e.reason }
+func (e GetUserTimelineReplyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetExpiringSubscriptionsReplyValidationError) Cause() error { return e.cause }
+func (e GetUserTimelineReplyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetExpiringSubscriptionsReplyValidationError) Key() bool { return e.key }
+func (e GetUserTimelineReplyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetExpiringSubscriptionsReplyValidationError) ErrorName() string {
-	return "GetExpiringSubscriptionsReplyValidationError"
+func (e GetUserTimelineReplyValidationError) ErrorName() string {
+	return "GetUserTimelineReplyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetExpiringSubscriptionsReplyValidationError) Error() string {
+func (e GetUserTimelineReplyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2957,14 +15411,14 @@ func (e GetExpiringSubscriptionsReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetExpiringSubscriptionsReply.%s: %s%s",
+		"invalid %sGetUserTimelineReply.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetExpiringSubscriptionsReplyValidationError{}
+var _ error = GetUserTimelineReplyValidationError{}
 
 var _ interface {
 	Field() string
@@ -2972,46 +15426,88 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetExpiringSubscriptionsReplyValidationError{}
+} = GetUserTimelineReplyValidationError{}
 
-// Validate checks the field values on UpdateExpiredSubscriptionsRequest with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the first error encountered is returned, or nil if there are
-// no violations.
-func (m *UpdateExpiredSubscriptionsRequest) Validate() error {
+// Validate checks the field values on AdjustSubscriptionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdjustSubscriptionRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpdateExpiredSubscriptionsRequest
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the result is a list of violation errors wrapped in
-// UpdateExpiredSubscriptionsRequestMultiError, or nil if none found.
-func (m *UpdateExpiredSubscriptionsRequest) ValidateAll() error {
+// ValidateAll checks the field values on AdjustSubscriptionRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdjustSubscriptionRequestMultiError, or nil if none found.
+func (m *AdjustSubscriptionRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpdateExpiredSubscriptionsRequest) validate(all bool) error {
+func (m *AdjustSubscriptionRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	if l := utf8.RuneCountInString(m.GetUid()); l < 1 || l > 36 {
+		err := AdjustSubscriptionRequestValidationError{
+			field:  "Uid",
+			reason: "value length must be between 1 and 36 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetDays(); val < -3650 || val > 3650 {
+		err := AdjustSubscriptionRequestValidationError{
+			field:  "Days",
+			reason: "value must be inside range [-3650, 3650]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _AdjustSubscriptionRequest_Days_NotInLookup[m.GetDays()]; ok {
+		err := AdjustSubscriptionRequestValidationError{
+			field:  "Days",
+			reason: "value must not be in list [0]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetReason()); l < 1 || l > 255 {
+		err := AdjustSubscriptionRequestValidationError{
+			field:  "Reason",
+			reason: "value length must be between 1 and 255 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
 	if len(errors) > 0 {
-		return UpdateExpiredSubscriptionsRequestMultiError(errors)
+		return AdjustSubscriptionRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpdateExpiredSubscriptionsRequestMultiError is an error wrapping multiple
-// validation errors returned by
-// UpdateExpiredSubscriptionsRequest.ValidateAll() if the designated
-// constraints aren't met.
-type UpdateExpiredSubscriptionsRequestMultiError []error
+// AdjustSubscriptionRequestMultiError is an error wrapping multiple validation
+// errors returned by AdjustSubscriptionRequest.ValidateAll() if the
+// designated constraints aren't met.
+type AdjustSubscriptionRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdateExpiredSubscriptionsRequestMultiError) Error() string {
+func (m AdjustSubscriptionRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3020,12 +15516,11 @@ func (m UpdateExpiredSubscriptionsRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdateExpiredSubscriptionsRequestMultiError) AllErrors() []error { return m }
+func (m AdjustSubscriptionRequestMultiError) AllErrors() []error { return m }
 
-// UpdateExpiredSubscriptionsRequestValidationError is the validation error
-// returned by UpdateExpiredSubscriptionsRequest.Validate if the designated
-// constraints aren't met.
-type UpdateExpiredSubscriptionsRequestValidationError struct {
+// AdjustSubscriptionRequestValidationError is the validation error returned by
+// AdjustSubscriptionRequest.Validate if the designated constraints aren't met.
+type AdjustSubscriptionRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3033,24 +15528,24 @@ type UpdateExpiredSubscriptionsRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateExpiredSubscriptionsRequestValidationError) Field() string { return e.field }
+func (e AdjustSubscriptionRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateExpiredSubscriptionsRequestValidationError) Reason() string { return e.reason }
+func (e AdjustSubscriptionRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateExpiredSubscriptionsRequestValidationError) Cause() error { return e.cause }
+func (e AdjustSubscriptionRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateExpiredSubscriptionsRequestValidationError) Key() bool { return e.key }
+func (e AdjustSubscriptionRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateExpiredSubscriptionsRequestValidationError) ErrorName() string {
-	return "UpdateExpiredSubscriptionsRequestValidationError"
+func (e AdjustSubscriptionRequestValidationError) ErrorName() string {
+	return "AdjustSubscriptionRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdateExpiredSubscriptionsRequestValidationError) Error() string {
+func (e AdjustSubscriptionRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3062,14 +15557,14 @@ func (e UpdateExpiredSubscriptionsRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateExpiredSubscriptionsRequest.%s: %s%s",
+		"invalid %sAdjustSubscriptionRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateExpiredSubscriptionsRequestValidationError{}
+var _ error = AdjustSubscriptionRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -3077,46 +15572,77 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateExpiredSubscriptionsRequestValidationError{}
+} = AdjustSubscriptionRequestValidationError{}
 
-// Validate checks the field values on UpdateExpiredSubscriptionsReply with the
-// rules defined in the proto definition for this message. If any rules are
+var _AdjustSubscriptionRequest_Days_NotInLookup = map[int32]struct{}{
+	0: {},
+}
+
+// Validate checks the field values on AdjustSubscriptionReply with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UpdateExpiredSubscriptionsReply) Validate() error {
+func (m *AdjustSubscriptionReply) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpdateExpiredSubscriptionsReply with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// UpdateExpiredSubscriptionsReplyMultiError, or nil if none found.
-func (m *UpdateExpiredSubscriptionsReply) ValidateAll() error {
+// ValidateAll checks the field values on AdjustSubscriptionReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdjustSubscriptionReplyMultiError, or nil if none found.
+func (m *AdjustSubscriptionReply) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpdateExpiredSubscriptionsReply) validate(all bool) error {
+func (m *AdjustSubscriptionReply) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for UpdatedCount
+	if all {
+		switch v := interface{}(m.GetSubscription()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AdjustSubscriptionReplyValidationError{
+					field:  "Subscription",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AdjustSubscriptionReplyValidationError{
+					field:  "Subscription",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSubscription()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AdjustSubscriptionReplyValidationError{
+				field:  "Subscription",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
-		return UpdateExpiredSubscriptionsReplyMultiError(errors)
+		return AdjustSubscriptionReplyMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpdateExpiredSubscriptionsReplyMultiError is an error wrapping multiple
-// validation errors returned by UpdateExpiredSubscriptionsReply.ValidateAll()
-// if the designated constraints aren't met.
-type UpdateExpiredSubscriptionsReplyMultiError []error
+// AdjustSubscriptionReplyMultiError is an error wrapping multiple validation
+// errors returned by AdjustSubscriptionReply.ValidateAll() if the designated
+// constraints aren't met.
+type AdjustSubscriptionReplyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdateExpiredSubscriptionsReplyMultiError) Error() string {
+func (m AdjustSubscriptionReplyMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3125,12 +15651,11 @@ func (m UpdateExpiredSubscriptionsReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdateExpiredSubscriptionsReplyMultiError) AllErrors() []error { return m }
+func (m AdjustSubscriptionReplyMultiError) AllErrors() []error { return m }
 
-// UpdateExpiredSubscriptionsReplyValidationError is the validation error
-// returned by UpdateExpiredSubscriptionsReply.Validate if the designated
-// constraints aren't met.
-type UpdateExpiredSubscriptionsReplyValidationError struct {
+// AdjustSubscriptionReplyValidationError is the validation error returned by
+// AdjustSubscriptionReply.Validate if the designated constraints aren't met.
+type AdjustSubscriptionReplyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3138,24 +15663,24 @@ type UpdateExpiredSubscriptionsReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateExpiredSubscriptionsReplyValidationError) Field() string { return e.field }
+func (e AdjustSubscriptionReplyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateExpiredSubscriptionsReplyValidationError) Reason() string { return e.reason }
+func (e AdjustSubscriptionReplyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateExpiredSubscriptionsReplyValidationError) Cause() error { return e.cause }
+func (e AdjustSubscriptionReplyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateExpiredSubscriptionsReplyValidationError) Key() bool { return e.key }
+func (e AdjustSubscriptionReplyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateExpiredSubscriptionsReplyValidationError) ErrorName() string {
-	return "UpdateExpiredSubscriptionsReplyValidationError"
+func (e AdjustSubscriptionReplyValidationError) ErrorName() string {
+	return "AdjustSubscriptionReplyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdateExpiredSubscriptionsReplyValidationError) Error() string {
+func (e AdjustSubscriptionReplyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3167,14 +15692,14 @@ func (e UpdateExpiredSubscriptionsReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateExpiredSubscriptionsReply.%s: %s%s",
+		"invalid %sAdjustSubscriptionReply.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateExpiredSubscriptionsReplyValidationError{}
+var _ error = AdjustSubscriptionReplyValidationError{}
 
 var _ interface {
 	Field() string
@@ -3182,34 +15707,34 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateExpiredSubscriptionsReplyValidationError{}
+} = AdjustSubscriptionReplyValidationError{}
 
-// Validate checks the field values on ProcessAutoRenewalsRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on GrantSubscriptionRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ProcessAutoRenewalsRequest) Validate() error {
+func (m *GrantSubscriptionRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ProcessAutoRenewalsRequest with the
+// ValidateAll checks the field values on GrantSubscriptionRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ProcessAutoRenewalsRequestMultiError, or nil if none found.
-func (m *ProcessAutoRenewalsRequest) ValidateAll() error {
+// GrantSubscriptionRequestMultiError, or nil if none found.
+func (m *GrantSubscriptionRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ProcessAutoRenewalsRequest) validate(all bool) error {
+func (m *GrantSubscriptionRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if val := m.GetDaysBeforeExpiry(); val < 1 || val > 30 {
-		err := ProcessAutoRenewalsRequestValidationError{
-			field:  "DaysBeforeExpiry",
-			reason: "value must be inside range [1, 30]",
+	if l := utf8.RuneCountInString(m.GetUid()); l < 1 || l > 36 {
+		err := GrantSubscriptionRequestValidationError{
+			field:  "Uid",
+			reason: "value length must be between 1 and 36 runes, inclusive",
 		}
 		if !all {
 			return err
@@ -3217,22 +15742,66 @@ func (m *ProcessAutoRenewalsRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	// no validation rules for DryRun
+	if l := utf8.RuneCountInString(m.GetPlanId()); l < 1 || l > 50 {
+		err := GrantSubscriptionRequestValidationError{
+			field:  "PlanId",
+			reason: "value length must be between 1 and 50 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetDays(); val < 0 || val > 3650 {
+		err := GrantSubscriptionRequestValidationError{
+			field:  "Days",
+			reason: "value must be inside range [0, 3650]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetReason()); l < 1 || l > 255 {
+		err := GrantSubscriptionRequestValidationError{
+			field:  "Reason",
+			reason: "value length must be between 1 and 255 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _GrantSubscriptionRequest_Source_InLookup[m.GetSource()]; !ok {
+		err := GrantSubscriptionRequestValidationError{
+			field:  "Source",
+			reason: "value must be in list [comp manual]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for CreateOrder
 
 	if len(errors) > 0 {
-		return ProcessAutoRenewalsRequestMultiError(errors)
+		return GrantSubscriptionRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ProcessAutoRenewalsRequestMultiError is an error wrapping multiple
-// validation errors returned by ProcessAutoRenewalsRequest.ValidateAll() if
-// the designated constraints aren't met.
-type ProcessAutoRenewalsRequestMultiError []error
+// GrantSubscriptionRequestMultiError is an error wrapping multiple validation
+// errors returned by GrantSubscriptionRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GrantSubscriptionRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ProcessAutoRenewalsRequestMultiError) Error() string {
+func (m GrantSubscriptionRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3241,11 +15810,11 @@ func (m ProcessAutoRenewalsRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ProcessAutoRenewalsRequestMultiError) AllErrors() []error { return m }
+func (m GrantSubscriptionRequestMultiError) AllErrors() []error { return m }
 
-// ProcessAutoRenewalsRequestValidationError is the validation error returned
-// by ProcessAutoRenewalsRequest.Validate if the designated constraints aren't met.
-type ProcessAutoRenewalsRequestValidationError struct {
+// GrantSubscriptionRequestValidationError is the validation error returned by
+// GrantSubscriptionRequest.Validate if the designated constraints aren't met.
+type GrantSubscriptionRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3253,24 +15822,24 @@ type ProcessAutoRenewalsRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ProcessAutoRenewalsRequestValidationError) Field() string { return e.field }
+func (e GrantSubscriptionRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ProcessAutoRenewalsRequestValidationError) Reason() string { return e.reason }
+func (e GrantSubscriptionRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ProcessAutoRenewalsRequestValidationError) Cause() error { return e.cause }
+func (e GrantSubscriptionRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ProcessAutoRenewalsRequestValidationError) Key() bool { return e.key }
+func (e GrantSubscriptionRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ProcessAutoRenewalsRequestValidationError) ErrorName() string {
-	return "ProcessAutoRenewalsRequestValidationError"
+func (e GrantSubscriptionRequestValidationError) ErrorName() string {
+	return "GrantSubscriptionRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ProcessAutoRenewalsRequestValidationError) Error() string {
+func (e GrantSubscriptionRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3282,14 +15851,14 @@ func (e ProcessAutoRenewalsRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sProcessAutoRenewalsRequest.%s: %s%s",
+		"invalid %sGrantSubscriptionRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ProcessAutoRenewalsRequestValidationError{}
+var _ error = GrantSubscriptionRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -3297,56 +15866,107 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ProcessAutoRenewalsRequestValidationError{}
+} = GrantSubscriptionRequestValidationError{}
 
-// Validate checks the field values on AutoRenewResult with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *AutoRenewResult) Validate() error {
+var _GrantSubscriptionRequest_Source_InLookup = map[string]struct{}{
+	"comp":   {},
+	"manual": {},
+}
+
+// Validate checks the field values on GrantSubscriptionReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GrantSubscriptionReply) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AutoRenewResult with the rules
+// ValidateAll checks the field values on GrantSubscriptionReply with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AutoRenewResultMultiError, or nil if none found.
-func (m *AutoRenewResult) ValidateAll() error {
+// GrantSubscriptionReplyMultiError, or nil if none found.
+func (m *GrantSubscriptionReply) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AutoRenewResult) validate(all bool) error {
+func (m *GrantSubscriptionReply) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
-	var errors []error
-
-	// no validation rules for Uid
-
-	// no validation rules for PlanId
-
-	// no validation rules for Success
-
-	// no validation rules for OrderId
-
-	// no validation rules for PaymentId
-
-	// no validation rules for ErrorMessage
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetSubscription()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GrantSubscriptionReplyValidationError{
+					field:  "Subscription",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GrantSubscriptionReplyValidationError{
+					field:  "Subscription",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSubscription()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GrantSubscriptionReplyValidationError{
+				field:  "Subscription",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetOrder()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GrantSubscriptionReplyValidationError{
+					field:  "Order",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GrantSubscriptionReplyValidationError{
+					field:  "Order",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetOrder()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GrantSubscriptionReplyValidationError{
+				field:  "Order",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
-		return AutoRenewResultMultiError(errors)
+		return GrantSubscriptionReplyMultiError(errors)
 	}
 
 	return nil
 }
 
-// AutoRenewResultMultiError is an error wrapping multiple validation errors
-// returned by AutoRenewResult.ValidateAll() if the designated constraints
-// aren't met.
-type AutoRenewResultMultiError []error
+// GrantSubscriptionReplyMultiError is an error wrapping multiple validation
+// errors returned by GrantSubscriptionReply.ValidateAll() if the designated
+// constraints aren't met.
+type GrantSubscriptionReplyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AutoRenewResultMultiError) Error() string {
+func (m GrantSubscriptionReplyMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3355,11 +15975,11 @@ func (m AutoRenewResultMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AutoRenewResultMultiError) AllErrors() []error { return m }
+func (m GrantSubscriptionReplyMultiError) AllErrors() []error { return m }
 
-// AutoRenewResultValidationError is the validation error returned by
-// AutoRenewResult.Validate if the designated constraints aren't met.
-type AutoRenewResultValidationError struct {
+// GrantSubscriptionReplyValidationError is the validation error returned by
+// GrantSubscriptionReply.Validate if the designated constraints aren't met.
+type GrantSubscriptionReplyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3367,22 +15987,24 @@ type AutoRenewResultValidationError struct {
 }
 
 // Field function returns field value.
-func (e AutoRenewResultValidationError) Field() string { return e.field }
+func (e GrantSubscriptionReplyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AutoRenewResultValidationError) Reason() string { return e.reason }
+func (e GrantSubscriptionReplyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AutoRenewResultValidationError) Cause() error { return e.cause }
+func (e GrantSubscriptionReplyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AutoRenewResultValidationError) Key() bool { return e.key }
+func (e GrantSubscriptionReplyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AutoRenewResultValidationError) ErrorName() string { return "AutoRenewResultValidationError" }
+func (e GrantSubscriptionReplyValidationError) ErrorName() string {
+	return "GrantSubscriptionReplyValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e AutoRenewResultValidationError) Error() string {
+func (e GrantSubscriptionReplyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3394,14 +16016,14 @@ func (e AutoRenewResultValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAutoRenewResult.%s: %s%s",
+		"invalid %sGrantSubscriptionReply.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AutoRenewResultValidationError{}
+var _ error = GrantSubscriptionReplyValidationError{}
 
 var _ interface {
 	Field() string
@@ -3409,84 +16031,84 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AutoRenewResultValidationError{}
+} = GrantSubscriptionReplyValidationError{}
 
-// Validate checks the field values on ProcessAutoRenewalsReply with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ProcessAutoRenewalsReply) Validate() error {
+// Validate checks the field values on BulkOperation with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *BulkOperation) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ProcessAutoRenewalsReply with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// ProcessAutoRenewalsReplyMultiError, or nil if none found.
-func (m *ProcessAutoRenewalsReply) ValidateAll() error {
+// ValidateAll checks the field values on BulkOperation with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in BulkOperationMultiError, or
+// nil if none found.
+func (m *BulkOperation) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ProcessAutoRenewalsReply) validate(all bool) error {
+func (m *BulkOperation) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for Id
+
+	// no validation rules for Type
+
+	// no validation rules for AppId
+
+	// no validation rules for PlanId
+
+	// no validation rules for FilterStatus
+
+	// no validation rules for Days
+
+	// no validation rules for TargetPlanId
+
+	// no validation rules for OperatorId
+
+	// no validation rules for Reason
+
+	// no validation rules for Status
+
 	// no validation rules for TotalCount
 
+	// no validation rules for ProcessedCount
+
 	// no validation rules for SuccessCount
 
+	// no validation rules for SkippedCount
+
 	// no validation rules for FailedCount
 
-	for idx, item := range m.GetResults() {
-		_, _ = idx, item
+	// no validation rules for Error
 
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ProcessAutoRenewalsReplyValidationError{
-						field:  fmt.Sprintf("Results[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ProcessAutoRenewalsReplyValidationError{
-						field:  fmt.Sprintf("Results[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return ProcessAutoRenewalsReplyValidationError{
-					field:  fmt.Sprintf("Results[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+	// no validation rules for StartedAt
 
-	}
+	// no validation rules for FinishedAt
+
+	// no validation rules for CreatedAt
+
+	// no validation rules for UpdatedAt
 
 	if len(errors) > 0 {
-		return ProcessAutoRenewalsReplyMultiError(errors)
+		return BulkOperationMultiError(errors)
 	}
 
 	return nil
 }
 
-// ProcessAutoRenewalsReplyMultiError is an error wrapping multiple validation
-// errors returned by ProcessAutoRenewalsReply.ValidateAll() if the designated
-// constraints aren't met.
-type ProcessAutoRenewalsReplyMultiError []error
+// BulkOperationMultiError is an error wrapping multiple validation errors
+// returned by BulkOperation.ValidateAll() if the designated constraints
+// aren't met.
+type BulkOperationMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ProcessAutoRenewalsReplyMultiError) Error() string {
+func (m BulkOperationMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3495,11 +16117,11 @@ func (m ProcessAutoRenewalsReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ProcessAutoRenewalsReplyMultiError) AllErrors() []error { return m }
+func (m BulkOperationMultiError) AllErrors() []error { return m }
 
-// ProcessAutoRenewalsReplyValidationError is the validation error returned by
-// ProcessAutoRenewalsReply.Validate if the designated constraints aren't met.
-type ProcessAutoRenewalsReplyValidationError struct {
+// BulkOperationValidationError is the validation error returned by
+// BulkOperation.Validate if the designated constraints aren't met.
+type BulkOperationValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3507,24 +16129,22 @@ type ProcessAutoRenewalsReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e ProcessAutoRenewalsReplyValidationError) Field() string { return e.field }
+func (e BulkOperationValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ProcessAutoRenewalsReplyValidationError) Reason() string { return e.reason }
+func (e BulkOperationValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ProcessAutoRenewalsReplyValidationError) Cause() error { return e.cause }
+func (e BulkOperationValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ProcessAutoRenewalsReplyValidationError) Key() bool { return e.key }
+func (e BulkOperationValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ProcessAutoRenewalsReplyValidationError) ErrorName() string {
-	return "ProcessAutoRenewalsReplyValidationError"
-}
+func (e BulkOperationValidationError) ErrorName() string { return "BulkOperationValidationError" }
 
 // Error satisfies the builtin error interface
-func (e ProcessAutoRenewalsReplyValidationError) Error() string {
+func (e BulkOperationValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3536,14 +16156,14 @@ func (e ProcessAutoRenewalsReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sProcessAutoRenewalsReply.%s: %s%s",
+		"invalid %sBulkOperation.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ProcessAutoRenewalsReplyValidationError{}
+var _ error = BulkOperationValidationError{}
 
 var _ interface {
 	Field() string
@@ -3551,53 +16171,123 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ProcessAutoRenewalsReplyValidationError{}
+} = BulkOperationValidationError{}
 
-// Validate checks the field values on PlanPricing with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *PlanPricing) Validate() error {
+// Validate checks the field values on CreateBulkOperationRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateBulkOperationRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on PlanPricing with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in PlanPricingMultiError, or
-// nil if none found.
-func (m *PlanPricing) ValidateAll() error {
+// ValidateAll checks the field values on CreateBulkOperationRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateBulkOperationRequestMultiError, or nil if none found.
+func (m *CreateBulkOperationRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *PlanPricing) validate(all bool) error {
+func (m *CreateBulkOperationRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for PlanPricingId
+	if _, ok := _CreateBulkOperationRequest_Type_InLookup[m.GetType()]; !ok {
+		err := CreateBulkOperationRequestValidationError{
+			field:  "Type",
+			reason: "value must be in list [extend migrate_plan]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-	// no validation rules for PlanId
+	if l := utf8.RuneCountInString(m.GetAppId()); l < 1 || l > 50 {
+		err := CreateBulkOperationRequestValidationError{
+			field:  "AppId",
+			reason: "value length must be between 1 and 50 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-	// no validation rules for CountryCode
+	if utf8.RuneCountInString(m.GetPlanId()) > 50 {
+		err := CreateBulkOperationRequestValidationError{
+			field:  "PlanId",
+			reason: "value length must be at most 50 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-	// no validation rules for Price
+	if _, ok := _CreateBulkOperationRequest_Status_InLookup[m.GetStatus()]; !ok {
+		err := CreateBulkOperationRequestValidationError{
+			field:  "Status",
+			reason: "value must be in list [ active expired paused cancelled]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-	// no validation rules for Currency
+	if val := m.GetDays(); val < 0 || val > 3650 {
+		err := CreateBulkOperationRequestValidationError{
+			field:  "Days",
+			reason: "value must be inside range [0, 3650]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetTargetPlanId()) > 50 {
+		err := CreateBulkOperationRequestValidationError{
+			field:  "TargetPlanId",
+			reason: "value length must be at most 50 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetReason()); l < 1 || l > 255 {
+		err := CreateBulkOperationRequestValidationError{
+			field:  "Reason",
+			reason: "value length must be between 1 and 255 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for DryRun
 
 	if len(errors) > 0 {
-		return PlanPricingMultiError(errors)
+		return CreateBulkOperationRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// PlanPricingMultiError is an error wrapping multiple validation errors
-// returned by PlanPricing.ValidateAll() if the designated constraints aren't met.
-type PlanPricingMultiError []error
+// CreateBulkOperationRequestMultiError is an error wrapping multiple
+// validation errors returned by CreateBulkOperationRequest.ValidateAll() if
+// the designated constraints aren't met.
+type CreateBulkOperationRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m PlanPricingMultiError) Error() string {
+func (m CreateBulkOperationRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3606,11 +16296,11 @@ func (m PlanPricingMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m PlanPricingMultiError) AllErrors() []error { return m }
+func (m CreateBulkOperationRequestMultiError) AllErrors() []error { return m }
 
-// PlanPricingValidationError is the validation error returned by
-// PlanPricing.Validate if the designated constraints aren't met.
-type PlanPricingValidationError struct {
+// CreateBulkOperationRequestValidationError is the validation error returned
+// by CreateBulkOperationRequest.Validate if the designated constraints aren't met.
+type CreateBulkOperationRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3618,22 +16308,24 @@ type PlanPricingValidationError struct {
 }
 
 // Field function returns field value.
-func (e PlanPricingValidationError) Field() string { return e.field }
+func (e CreateBulkOperationRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e PlanPricingValidationError) Reason() string { return e.reason }
+func (e CreateBulkOperationRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e PlanPricingValidationError) Cause() error { return e.cause }
+func (e CreateBulkOperationRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e PlanPricingValidationError) Key() bool { return e.key }
+func (e CreateBulkOperationRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e PlanPricingValidationError) ErrorName() string { return "PlanPricingValidationError" }
+func (e CreateBulkOperationRequestValidationError) ErrorName() string {
+	return "CreateBulkOperationRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e PlanPricingValidationError) Error() string {
+func (e CreateBulkOperationRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3645,14 +16337,14 @@ func (e PlanPricingValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sPlanPricing.%s: %s%s",
+		"invalid %sCreateBulkOperationRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = PlanPricingValidationError{}
+var _ error = CreateBulkOperationRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -3660,55 +16352,86 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = PlanPricingValidationError{}
+} = CreateBulkOperationRequestValidationError{}
 
-// Validate checks the field values on ListPlanPricingsRequest with the rules
+var _CreateBulkOperationRequest_Type_InLookup = map[string]struct{}{
+	"extend":       {},
+	"migrate_plan": {},
+}
+
+var _CreateBulkOperationRequest_Status_InLookup = map[string]struct{}{
+	"":          {},
+	"active":    {},
+	"expired":   {},
+	"paused":    {},
+	"cancelled": {},
+}
+
+// Validate checks the field values on CreateBulkOperationReply with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListPlanPricingsRequest) Validate() error {
+func (m *CreateBulkOperationReply) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListPlanPricingsRequest with the
+// ValidateAll checks the field values on CreateBulkOperationReply with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListPlanPricingsRequestMultiError, or nil if none found.
-func (m *ListPlanPricingsRequest) ValidateAll() error {
+// CreateBulkOperationReplyMultiError, or nil if none found.
+func (m *CreateBulkOperationReply) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListPlanPricingsRequest) validate(all bool) error {
+func (m *CreateBulkOperationReply) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if utf8.RuneCountInString(m.GetPlanId()) < 1 {
-		err := ListPlanPricingsRequestValidationError{
-			field:  "PlanId",
-			reason: "value length must be at least 1 runes",
+	if all {
+		switch v := interface{}(m.GetOperation()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateBulkOperationReplyValidationError{
+					field:  "Operation",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateBulkOperationReplyValidationError{
+					field:  "Operation",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
 		}
-		if !all {
-			return err
+	} else if v, ok := interface{}(m.GetOperation()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateBulkOperationReplyValidationError{
+				field:  "Operation",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
 		}
-		errors = append(errors, err)
 	}
 
 	if len(errors) > 0 {
-		return ListPlanPricingsRequestMultiError(errors)
+		return CreateBulkOperationReplyMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListPlanPricingsRequestMultiError is an error wrapping multiple validation
-// errors returned by ListPlanPricingsRequest.ValidateAll() if the designated
+// CreateBulkOperationReplyMultiError is an error wrapping multiple validation
+// errors returned by CreateBulkOperationReply.ValidateAll() if the designated
 // constraints aren't met.
-type ListPlanPricingsRequestMultiError []error
+type CreateBulkOperationReplyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListPlanPricingsRequestMultiError) Error() string {
+func (m CreateBulkOperationReplyMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3717,11 +16440,11 @@ func (m ListPlanPricingsRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListPlanPricingsRequestMultiError) AllErrors() []error { return m }
+func (m CreateBulkOperationReplyMultiError) AllErrors() []error { return m }
 
-// ListPlanPricingsRequestValidationError is the validation error returned by
-// ListPlanPricingsRequest.Validate if the designated constraints aren't met.
-type ListPlanPricingsRequestValidationError struct {
+// CreateBulkOperationReplyValidationError is the validation error returned by
+// CreateBulkOperationReply.Validate if the designated constraints aren't met.
+type CreateBulkOperationReplyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3729,24 +16452,24 @@ type ListPlanPricingsRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListPlanPricingsRequestValidationError) Field() string { return e.field }
+func (e CreateBulkOperationReplyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListPlanPricingsRequestValidationError) Reason() string { return e.reason }
+func (e CreateBulkOperationReplyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListPlanPricingsRequestValidationError) Cause() error { return e.cause }
+func (e CreateBulkOperationReplyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListPlanPricingsRequestValidationError) Key() bool { return e.key }
+func (e CreateBulkOperationReplyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListPlanPricingsRequestValidationError) ErrorName() string {
-	return "ListPlanPricingsRequestValidationError"
+func (e CreateBulkOperationReplyValidationError) ErrorName() string {
+	return "CreateBulkOperationReplyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListPlanPricingsRequestValidationError) Error() string {
+func (e CreateBulkOperationReplyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3758,14 +16481,14 @@ func (e ListPlanPricingsRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListPlanPricingsRequest.%s: %s%s",
+		"invalid %sCreateBulkOperationReply.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListPlanPricingsRequestValidationError{}
+var _ error = CreateBulkOperationReplyValidationError{}
 
 var _ interface {
 	Field() string
@@ -3773,78 +16496,55 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListPlanPricingsRequestValidationError{}
+} = CreateBulkOperationReplyValidationError{}
 
-// Validate checks the field values on ListPlanPricingsReply with the rules
+// Validate checks the field values on GetBulkOperationRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListPlanPricingsReply) Validate() error {
+func (m *GetBulkOperationRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListPlanPricingsReply with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on GetBulkOperationRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListPlanPricingsReplyMultiError, or nil if none found.
-func (m *ListPlanPricingsReply) ValidateAll() error {
+// GetBulkOperationRequestMultiError, or nil if none found.
+func (m *GetBulkOperationRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListPlanPricingsReply) validate(all bool) error {
+func (m *GetBulkOperationRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetPricings() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ListPlanPricingsReplyValidationError{
-						field:  fmt.Sprintf("Pricings[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ListPlanPricingsReplyValidationError{
-						field:  fmt.Sprintf("Pricings[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return ListPlanPricingsReplyValidationError{
-					field:  fmt.Sprintf("Pricings[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
+	if m.GetId() <= 0 {
+		err := GetBulkOperationRequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
 		}
-
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
 	}
 
 	if len(errors) > 0 {
-		return ListPlanPricingsReplyMultiError(errors)
+		return GetBulkOperationRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListPlanPricingsReplyMultiError is an error wrapping multiple validation
-// errors returned by ListPlanPricingsReply.ValidateAll() if the designated
+// GetBulkOperationRequestMultiError is an error wrapping multiple validation
+// errors returned by GetBulkOperationRequest.ValidateAll() if the designated
 // constraints aren't met.
-type ListPlanPricingsReplyMultiError []error
+type GetBulkOperationRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListPlanPricingsReplyMultiError) Error() string {
+func (m GetBulkOperationRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3853,11 +16553,11 @@ func (m ListPlanPricingsReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListPlanPricingsReplyMultiError) AllErrors() []error { return m }
+func (m GetBulkOperationRequestMultiError) AllErrors() []error { return m }
 
-// ListPlanPricingsReplyValidationError is the validation error returned by
-// ListPlanPricingsReply.Validate if the designated constraints aren't met.
-type ListPlanPricingsReplyValidationError struct {
+// GetBulkOperationRequestValidationError is the validation error returned by
+// GetBulkOperationRequest.Validate if the designated constraints aren't met.
+type GetBulkOperationRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3865,24 +16565,24 @@ type ListPlanPricingsReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListPlanPricingsReplyValidationError) Field() string { return e.field }
+func (e GetBulkOperationRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListPlanPricingsReplyValidationError) Reason() string { return e.reason }
+func (e GetBulkOperationRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListPlanPricingsReplyValidationError) Cause() error { return e.cause }
+func (e GetBulkOperationRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListPlanPricingsReplyValidationError) Key() bool { return e.key }
+func (e GetBulkOperationRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListPlanPricingsReplyValidationError) ErrorName() string {
-	return "ListPlanPricingsReplyValidationError"
+func (e GetBulkOperationRequestValidationError) ErrorName() string {
+	return "GetBulkOperationRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListPlanPricingsReplyValidationError) Error() string {
+func (e GetBulkOperationRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3894,14 +16594,14 @@ func (e ListPlanPricingsReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListPlanPricingsReply.%s: %s%s",
+		"invalid %sGetBulkOperationRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListPlanPricingsReplyValidationError{}
+var _ error = GetBulkOperationRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -3909,90 +16609,73 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListPlanPricingsReplyValidationError{}
+} = GetBulkOperationRequestValidationError{}
 
-// Validate checks the field values on CreatePlanPricingRequest with the rules
+// Validate checks the field values on GetBulkOperationReply with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreatePlanPricingRequest) Validate() error {
+func (m *GetBulkOperationReply) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreatePlanPricingRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on GetBulkOperationReply with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CreatePlanPricingRequestMultiError, or nil if none found.
-func (m *CreatePlanPricingRequest) ValidateAll() error {
+// GetBulkOperationReplyMultiError, or nil if none found.
+func (m *GetBulkOperationReply) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreatePlanPricingRequest) validate(all bool) error {
+func (m *GetBulkOperationReply) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if utf8.RuneCountInString(m.GetPlanId()) < 1 {
-		err := CreatePlanPricingRequestValidationError{
-			field:  "PlanId",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if utf8.RuneCountInString(m.GetCountryCode()) != 2 {
-		err := CreatePlanPricingRequestValidationError{
-			field:  "CountryCode",
-			reason: "value length must be 2 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-
-	}
-
-	if m.GetPrice() <= 0 {
-		err := CreatePlanPricingRequestValidationError{
-			field:  "Price",
-			reason: "value must be greater than 0",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if utf8.RuneCountInString(m.GetCurrency()) != 3 {
-		err := CreatePlanPricingRequestValidationError{
-			field:  "Currency",
-			reason: "value length must be 3 runes",
+	if all {
+		switch v := interface{}(m.GetOperation()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetBulkOperationReplyValidationError{
+					field:  "Operation",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetBulkOperationReplyValidationError{
+					field:  "Operation",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
 		}
-		if !all {
-			return err
+	} else if v, ok := interface{}(m.GetOperation()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetBulkOperationReplyValidationError{
+				field:  "Operation",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
 		}
-		errors = append(errors, err)
-
 	}
 
 	if len(errors) > 0 {
-		return CreatePlanPricingRequestMultiError(errors)
+		return GetBulkOperationReplyMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreatePlanPricingRequestMultiError is an error wrapping multiple validation
-// errors returned by CreatePlanPricingRequest.ValidateAll() if the designated
+// GetBulkOperationReplyMultiError is an error wrapping multiple validation
+// errors returned by GetBulkOperationReply.ValidateAll() if the designated
 // constraints aren't met.
-type CreatePlanPricingRequestMultiError []error
+type GetBulkOperationReplyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreatePlanPricingRequestMultiError) Error() string {
+func (m GetBulkOperationReplyMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4001,11 +16684,11 @@ func (m CreatePlanPricingRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreatePlanPricingRequestMultiError) AllErrors() []error { return m }
+func (m GetBulkOperationReplyMultiError) AllErrors() []error { return m }
 
-// CreatePlanPricingRequestValidationError is the validation error returned by
-// CreatePlanPricingRequest.Validate if the designated constraints aren't met.
-type CreatePlanPricingRequestValidationError struct {
+// GetBulkOperationReplyValidationError is the validation error returned by
+// GetBulkOperationReply.Validate if the designated constraints aren't met.
+type GetBulkOperationReplyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4013,24 +16696,24 @@ type CreatePlanPricingRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreatePlanPricingRequestValidationError) Field() string { return e.field }
+func (e GetBulkOperationReplyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreatePlanPricingRequestValidationError) Reason() string { return e.reason }
+func (e GetBulkOperationReplyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreatePlanPricingRequestValidationError) Cause() error { return e.cause }
+func (e GetBulkOperationReplyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreatePlanPricingRequestValidationError) Key() bool { return e.key }
+func (e GetBulkOperationReplyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreatePlanPricingRequestValidationError) ErrorName() string {
-	return "CreatePlanPricingRequestValidationError"
+func (e GetBulkOperationReplyValidationError) ErrorName() string {
+	return "GetBulkOperationReplyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreatePlanPricingRequestValidationError) Error() string {
+func (e GetBulkOperationReplyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4042,14 +16725,14 @@ func (e CreatePlanPricingRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreatePlanPricingRequest.%s: %s%s",
+		"invalid %sGetBulkOperationReply.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreatePlanPricingRequestValidationError{}
+var _ error = GetBulkOperationReplyValidationError{}
 
 var _ interface {
 	Field() string
@@ -4057,73 +16740,88 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreatePlanPricingRequestValidationError{}
+} = GetBulkOperationReplyValidationError{}
 
-// Validate checks the field values on CreatePlanPricingReply with the rules
+// Validate checks the field values on ListBulkOperationsRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreatePlanPricingReply) Validate() error {
+func (m *ListBulkOperationsRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreatePlanPricingReply with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on ListBulkOperationsRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CreatePlanPricingReplyMultiError, or nil if none found.
-func (m *CreatePlanPricingReply) ValidateAll() error {
+// ListBulkOperationsRequestMultiError, or nil if none found.
+func (m *ListBulkOperationsRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreatePlanPricingReply) validate(all bool) error {
+func (m *ListBulkOperationsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetPricing()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, CreatePlanPricingReplyValidationError{
-					field:  "Pricing",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, CreatePlanPricingReplyValidationError{
-					field:  "Pricing",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
+	if utf8.RuneCountInString(m.GetAppId()) > 50 {
+		err := ListBulkOperationsRequestValidationError{
+			field:  "AppId",
+			reason: "value length must be at most 50 runes",
 		}
-	} else if v, ok := interface{}(m.GetPricing()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return CreatePlanPricingReplyValidationError{
-				field:  "Pricing",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _ListBulkOperationsRequest_Status_InLookup[m.GetStatus()]; !ok {
+		err := ListBulkOperationsRequestValidationError{
+			field:  "Status",
+			reason: "value must be in list [ pending running completed cancelled]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetPage() < 0 {
+		err := ListBulkOperationsRequestValidationError{
+			field:  "Page",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetPageSize(); val < 0 || val > 100 {
+		err := ListBulkOperationsRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be inside range [0, 100]",
+		}
+		if !all {
+			return err
 		}
+		errors = append(errors, err)
 	}
 
 	if len(errors) > 0 {
-		return CreatePlanPricingReplyMultiError(errors)
+		return ListBulkOperationsRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreatePlanPricingReplyMultiError is an error wrapping multiple validation
-// errors returned by CreatePlanPricingReply.ValidateAll() if the designated
-// constraints aren't met.
-type CreatePlanPricingReplyMultiError []error
+// ListBulkOperationsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListBulkOperationsRequest.ValidateAll() if the
+// designated constraints aren't met.
+type ListBulkOperationsRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreatePlanPricingReplyMultiError) Error() string {
+func (m ListBulkOperationsRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4132,11 +16830,11 @@ func (m CreatePlanPricingReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreatePlanPricingReplyMultiError) AllErrors() []error { return m }
+func (m ListBulkOperationsRequestMultiError) AllErrors() []error { return m }
 
-// CreatePlanPricingReplyValidationError is the validation error returned by
-// CreatePlanPricingReply.Validate if the designated constraints aren't met.
-type CreatePlanPricingReplyValidationError struct {
+// ListBulkOperationsRequestValidationError is the validation error returned by
+// ListBulkOperationsRequest.Validate if the designated constraints aren't met.
+type ListBulkOperationsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4144,24 +16842,24 @@ type CreatePlanPricingReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreatePlanPricingReplyValidationError) Field() string { return e.field }
+func (e ListBulkOperationsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreatePlanPricingReplyValidationError) Reason() string { return e.reason }
+func (e ListBulkOperationsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreatePlanPricingReplyValidationError) Cause() error { return e.cause }
+func (e ListBulkOperationsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreatePlanPricingReplyValidationError) Key() bool { return e.key }
+func (e ListBulkOperationsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreatePlanPricingReplyValidationError) ErrorName() string {
-	return "CreatePlanPricingReplyValidationError"
+func (e ListBulkOperationsRequestValidationError) ErrorName() string {
+	return "ListBulkOperationsRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreatePlanPricingReplyValidationError) Error() string {
+func (e ListBulkOperationsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4173,14 +16871,14 @@ func (e CreatePlanPricingReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreatePlanPricingReply.%s: %s%s",
+		"invalid %sListBulkOperationsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreatePlanPricingReplyValidationError{}
+var _ error = ListBulkOperationsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -4188,78 +16886,92 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreatePlanPricingReplyValidationError{}
+} = ListBulkOperationsRequestValidationError{}
 
-// Validate checks the field values on UpdatePlanPricingRequest with the rules
+var _ListBulkOperationsRequest_Status_InLookup = map[string]struct{}{
+	"":          {},
+	"pending":   {},
+	"running":   {},
+	"completed": {},
+	"cancelled": {},
+}
+
+// Validate checks the field values on ListBulkOperationsReply with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UpdatePlanPricingRequest) Validate() error {
+func (m *ListBulkOperationsReply) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpdatePlanPricingRequest with the
+// ValidateAll checks the field values on ListBulkOperationsReply with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// UpdatePlanPricingRequestMultiError, or nil if none found.
-func (m *UpdatePlanPricingRequest) ValidateAll() error {
+// ListBulkOperationsReplyMultiError, or nil if none found.
+func (m *ListBulkOperationsReply) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpdatePlanPricingRequest) validate(all bool) error {
+func (m *ListBulkOperationsReply) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if m.GetPlanPricingId() <= 0 {
-		err := UpdatePlanPricingRequestValidationError{
-			field:  "PlanPricingId",
-			reason: "value must be greater than 0",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	for idx, item := range m.GetOperations() {
+		_, _ = idx, item
 
-	if m.GetPrice() <= 0 {
-		err := UpdatePlanPricingRequestValidationError{
-			field:  "Price",
-			reason: "value must be greater than 0",
-		}
-		if !all {
-			return err
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListBulkOperationsReplyValidationError{
+						field:  fmt.Sprintf("Operations[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListBulkOperationsReplyValidationError{
+						field:  fmt.Sprintf("Operations[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListBulkOperationsReplyValidationError{
+					field:  fmt.Sprintf("Operations[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
 		}
-		errors = append(errors, err)
+
 	}
 
-	if utf8.RuneCountInString(m.GetCurrency()) != 3 {
-		err := UpdatePlanPricingRequestValidationError{
-			field:  "Currency",
-			reason: "value length must be 3 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
+	// no validation rules for Total
 
-	}
+	// no validation rules for Page
+
+	// no validation rules for PageSize
 
 	if len(errors) > 0 {
-		return UpdatePlanPricingRequestMultiError(errors)
+		return ListBulkOperationsReplyMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpdatePlanPricingRequestMultiError is an error wrapping multiple validation
-// errors returned by UpdatePlanPricingRequest.ValidateAll() if the designated
+// ListBulkOperationsReplyMultiError is an error wrapping multiple validation
+// errors returned by ListBulkOperationsReply.ValidateAll() if the designated
 // constraints aren't met.
-type UpdatePlanPricingRequestMultiError []error
+type ListBulkOperationsReplyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdatePlanPricingRequestMultiError) Error() string {
+func (m ListBulkOperationsReplyMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4268,11 +16980,11 @@ func (m UpdatePlanPricingRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdatePlanPricingRequestMultiError) AllErrors() []error { return m }
+func (m ListBulkOperationsReplyMultiError) AllErrors() []error { return m }
 
-// UpdatePlanPricingRequestValidationError is the validation error returned by
-// UpdatePlanPricingRequest.Validate if the designated constraints aren't met.
-type UpdatePlanPricingRequestValidationError struct {
+// ListBulkOperationsReplyValidationError is the validation error returned by
+// ListBulkOperationsReply.Validate if the designated constraints aren't met.
+type ListBulkOperationsReplyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4280,24 +16992,24 @@ type UpdatePlanPricingRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdatePlanPricingRequestValidationError) Field() string { return e.field }
+func (e ListBulkOperationsReplyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdatePlanPricingRequestValidationError) Reason() string { return e.reason }
+func (e ListBulkOperationsReplyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdatePlanPricingRequestValidationError) Cause() error { return e.cause }
+func (e ListBulkOperationsReplyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdatePlanPricingRequestValidationError) Key() bool { return e.key }
+func (e ListBulkOperationsReplyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdatePlanPricingRequestValidationError) ErrorName() string {
-	return "UpdatePlanPricingRequestValidationError"
+func (e ListBulkOperationsReplyValidationError) ErrorName() string {
+	return "ListBulkOperationsReplyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdatePlanPricingRequestValidationError) Error() string {
+func (e ListBulkOperationsReplyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4309,14 +17021,14 @@ func (e UpdatePlanPricingRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdatePlanPricingRequest.%s: %s%s",
+		"invalid %sListBulkOperationsReply.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdatePlanPricingRequestValidationError{}
+var _ error = ListBulkOperationsReplyValidationError{}
 
 var _ interface {
 	Field() string
@@ -4324,73 +17036,55 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdatePlanPricingRequestValidationError{}
+} = ListBulkOperationsReplyValidationError{}
 
-// Validate checks the field values on UpdatePlanPricingReply with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on CancelBulkOperationRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UpdatePlanPricingReply) Validate() error {
+func (m *CancelBulkOperationRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpdatePlanPricingReply with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on CancelBulkOperationRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// UpdatePlanPricingReplyMultiError, or nil if none found.
-func (m *UpdatePlanPricingReply) ValidateAll() error {
+// CancelBulkOperationRequestMultiError, or nil if none found.
+func (m *CancelBulkOperationRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpdatePlanPricingReply) validate(all bool) error {
+func (m *CancelBulkOperationRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetPricing()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, UpdatePlanPricingReplyValidationError{
-					field:  "Pricing",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, UpdatePlanPricingReplyValidationError{
-					field:  "Pricing",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
+	if m.GetId() <= 0 {
+		err := CancelBulkOperationRequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
 		}
-	} else if v, ok := interface{}(m.GetPricing()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return UpdatePlanPricingReplyValidationError{
-				field:  "Pricing",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
+		if !all {
+			return err
 		}
+		errors = append(errors, err)
 	}
 
 	if len(errors) > 0 {
-		return UpdatePlanPricingReplyMultiError(errors)
+		return CancelBulkOperationRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpdatePlanPricingReplyMultiError is an error wrapping multiple validation
-// errors returned by UpdatePlanPricingReply.ValidateAll() if the designated
-// constraints aren't met.
-type UpdatePlanPricingReplyMultiError []error
+// CancelBulkOperationRequestMultiError is an error wrapping multiple
+// validation errors returned by CancelBulkOperationRequest.ValidateAll() if
+// the designated constraints aren't met.
+type CancelBulkOperationRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdatePlanPricingReplyMultiError) Error() string {
+func (m CancelBulkOperationRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4399,11 +17093,11 @@ func (m UpdatePlanPricingReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdatePlanPricingReplyMultiError) AllErrors() []error { return m }
+func (m CancelBulkOperationRequestMultiError) AllErrors() []error { return m }
 
-// UpdatePlanPricingReplyValidationError is the validation error returned by
-// UpdatePlanPricingReply.Validate if the designated constraints aren't met.
-type UpdatePlanPricingReplyValidationError struct {
+// CancelBulkOperationRequestValidationError is the validation error returned
+// by CancelBulkOperationRequest.Validate if the designated constraints aren't met.
+type CancelBulkOperationRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4411,24 +17105,24 @@ type UpdatePlanPricingReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdatePlanPricingReplyValidationError) Field() string { return e.field }
+func (e CancelBulkOperationRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdatePlanPricingReplyValidationError) Reason() string { return e.reason }
+func (e CancelBulkOperationRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdatePlanPricingReplyValidationError) Cause() error { return e.cause }
+func (e CancelBulkOperationRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdatePlanPricingReplyValidationError) Key() bool { return e.key }
+func (e CancelBulkOperationRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdatePlanPricingReplyValidationError) ErrorName() string {
-	return "UpdatePlanPricingReplyValidationError"
+func (e CancelBulkOperationRequestValidationError) ErrorName() string {
+	return "CancelBulkOperationRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdatePlanPricingReplyValidationError) Error() string {
+func (e CancelBulkOperationRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4440,14 +17134,14 @@ func (e UpdatePlanPricingReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdatePlanPricingReply.%s: %s%s",
+		"invalid %sCancelBulkOperationRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdatePlanPricingReplyValidationError{}
+var _ error = CancelBulkOperationRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -4455,55 +17149,73 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdatePlanPricingReplyValidationError{}
+} = CancelBulkOperationRequestValidationError{}
 
-// Validate checks the field values on DeletePlanPricingRequest with the rules
+// Validate checks the field values on CancelBulkOperationReply with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DeletePlanPricingRequest) Validate() error {
+func (m *CancelBulkOperationReply) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeletePlanPricingRequest with the
+// ValidateAll checks the field values on CancelBulkOperationReply with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// DeletePlanPricingRequestMultiError, or nil if none found.
-func (m *DeletePlanPricingRequest) ValidateAll() error {
+// CancelBulkOperationReplyMultiError, or nil if none found.
+func (m *CancelBulkOperationReply) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeletePlanPricingRequest) validate(all bool) error {
+func (m *CancelBulkOperationReply) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if m.GetPlanPricingId() <= 0 {
-		err := DeletePlanPricingRequestValidationError{
-			field:  "PlanPricingId",
-			reason: "value must be greater than 0",
+	if all {
+		switch v := interface{}(m.GetOperation()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CancelBulkOperationReplyValidationError{
+					field:  "Operation",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CancelBulkOperationReplyValidationError{
+					field:  "Operation",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
 		}
-		if !all {
-			return err
+	} else if v, ok := interface{}(m.GetOperation()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CancelBulkOperationReplyValidationError{
+				field:  "Operation",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
 		}
-		errors = append(errors, err)
 	}
 
 	if len(errors) > 0 {
-		return DeletePlanPricingRequestMultiError(errors)
+		return CancelBulkOperationReplyMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeletePlanPricingRequestMultiError is an error wrapping multiple validation
-// errors returned by DeletePlanPricingRequest.ValidateAll() if the designated
+// CancelBulkOperationReplyMultiError is an error wrapping multiple validation
+// errors returned by CancelBulkOperationReply.ValidateAll() if the designated
 // constraints aren't met.
-type DeletePlanPricingRequestMultiError []error
+type CancelBulkOperationReplyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeletePlanPricingRequestMultiError) Error() string {
+func (m CancelBulkOperationReplyMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4512,11 +17224,11 @@ func (m DeletePlanPricingRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeletePlanPricingRequestMultiError) AllErrors() []error { return m }
+func (m CancelBulkOperationReplyMultiError) AllErrors() []error { return m }
 
-// DeletePlanPricingRequestValidationError is the validation error returned by
-// DeletePlanPricingRequest.Validate if the designated constraints aren't met.
-type DeletePlanPricingRequestValidationError struct {
+// CancelBulkOperationReplyValidationError is the validation error returned by
+// CancelBulkOperationReply.Validate if the designated constraints aren't met.
+type CancelBulkOperationReplyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4524,24 +17236,24 @@ type DeletePlanPricingRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeletePlanPricingRequestValidationError) Field() string { return e.field }
+func (e CancelBulkOperationReplyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeletePlanPricingRequestValidationError) Reason() string { return e.reason }
+func (e CancelBulkOperationReplyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeletePlanPricingRequestValidationError) Cause() error { return e.cause }
+func (e CancelBulkOperationReplyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeletePlanPricingRequestValidationError) Key() bool { return e.key }
+func (e CancelBulkOperationReplyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeletePlanPricingRequestValidationError) ErrorName() string {
-	return "DeletePlanPricingRequestValidationError"
+func (e CancelBulkOperationReplyValidationError) ErrorName() string {
+	return "CancelBulkOperationReplyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DeletePlanPricingRequestValidationError) Error() string {
+func (e CancelBulkOperationReplyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4553,14 +17265,14 @@ func (e DeletePlanPricingRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeletePlanPricingRequest.%s: %s%s",
+		"invalid %sCancelBulkOperationReply.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeletePlanPricingRequestValidationError{}
+var _ error = CancelBulkOperationReplyValidationError{}
 
 var _ interface {
 	Field() string
@@ -4568,46 +17280,81 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeletePlanPricingRequestValidationError{}
+} = CancelBulkOperationReplyValidationError{}
 
-// Validate checks the field values on DeletePlanPricingReply with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on SaveExchangeRatesRequest_Item with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DeletePlanPricingReply) Validate() error {
+func (m *SaveExchangeRatesRequest_Item) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeletePlanPricingReply with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// DeletePlanPricingReplyMultiError, or nil if none found.
-func (m *DeletePlanPricingReply) ValidateAll() error {
+// ValidateAll checks the field values on SaveExchangeRatesRequest_Item with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// SaveExchangeRatesRequest_ItemMultiError, or nil if none found.
+func (m *SaveExchangeRatesRequest_Item) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeletePlanPricingReply) validate(all bool) error {
+func (m *SaveExchangeRatesRequest_Item) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for PlanPricingId
+	if utf8.RuneCountInString(m.GetFromCurrency()) != 3 {
+		err := SaveExchangeRatesRequest_ItemValidationError{
+			field:  "FromCurrency",
+			reason: "value length must be 3 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+
+	}
+
+	if utf8.RuneCountInString(m.GetToCurrency()) != 3 {
+		err := SaveExchangeRatesRequest_ItemValidationError{
+			field:  "ToCurrency",
+			reason: "value length must be 3 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+
+	}
+
+	if m.GetRate() <= 0 {
+		err := SaveExchangeRatesRequest_ItemValidationError{
+			field:  "Rate",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for EffectiveAt
 
 	if len(errors) > 0 {
-		return DeletePlanPricingReplyMultiError(errors)
+		return SaveExchangeRatesRequest_ItemMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeletePlanPricingReplyMultiError is an error wrapping multiple validation
-// errors returned by DeletePlanPricingReply.ValidateAll() if the designated
-// constraints aren't met.
-type DeletePlanPricingReplyMultiError []error
+// SaveExchangeRatesRequest_ItemMultiError is an error wrapping multiple
+// validation errors returned by SaveExchangeRatesRequest_Item.ValidateAll()
+// if the designated constraints aren't met.
+type SaveExchangeRatesRequest_ItemMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeletePlanPricingReplyMultiError) Error() string {
+func (m SaveExchangeRatesRequest_ItemMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4616,11 +17363,12 @@ func (m DeletePlanPricingReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeletePlanPricingReplyMultiError) AllErrors() []error { return m }
+func (m SaveExchangeRatesRequest_ItemMultiError) AllErrors() []error { return m }
 
-// DeletePlanPricingReplyValidationError is the validation error returned by
-// DeletePlanPricingReply.Validate if the designated constraints aren't met.
-type DeletePlanPricingReplyValidationError struct {
+// SaveExchangeRatesRequest_ItemValidationError is the validation error
+// returned by SaveExchangeRatesRequest_Item.Validate if the designated
+// constraints aren't met.
+type SaveExchangeRatesRequest_ItemValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4628,24 +17376,24 @@ type DeletePlanPricingReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeletePlanPricingReplyValidationError) Field() string { return e.field }
+func (e SaveExchangeRatesRequest_ItemValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeletePlanPricingReplyValidationError) Reason() string { return e.reason }
+func (e SaveExchangeRatesRequest_ItemValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeletePlanPricingReplyValidationError) Cause() error { return e.cause }
+func (e SaveExchangeRatesRequest_ItemValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeletePlanPricingReplyValidationError) Key() bool { return e.key }
+func (e SaveExchangeRatesRequest_ItemValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeletePlanPricingReplyValidationError) ErrorName() string {
-	return "DeletePlanPricingReplyValidationError"
+func (e SaveExchangeRatesRequest_ItemValidationError) ErrorName() string {
+	return "SaveExchangeRatesRequest_ItemValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DeletePlanPricingReplyValidationError) Error() string {
+func (e SaveExchangeRatesRequest_ItemValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4657,14 +17405,14 @@ func (e DeletePlanPricingReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeletePlanPricingReply.%s: %s%s",
+		"invalid %sSaveExchangeRatesRequest_Item.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeletePlanPricingReplyValidationError{}
+var _ error = SaveExchangeRatesRequest_ItemValidationError{}
 
 var _ interface {
 	Field() string
@@ -4672,4 +17420,4 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeletePlanPricingReplyValidationError{}
+} = SaveExchangeRatesRequest_ItemValidationError{}
@@ -22,16 +22,21 @@ const _ = grpc.SupportPackageIsVersion9
 const (
 	Subscription_ListPlans_FullMethodName                  = "/subscription.v1.Subscription/ListPlans"
 	Subscription_GetMySubscription_FullMethodName          = "/subscription.v1.Subscription/GetMySubscription"
+	Subscription_QuoteSubscription_FullMethodName          = "/subscription.v1.Subscription/QuoteSubscription"
 	Subscription_CreateSubscriptionOrder_FullMethodName    = "/subscription.v1.Subscription/CreateSubscriptionOrder"
 	Subscription_HandlePaymentSuccess_FullMethodName       = "/subscription.v1.Subscription/HandlePaymentSuccess"
+	Subscription_HandlePaymentRefund_FullMethodName        = "/subscription.v1.Subscription/HandlePaymentRefund"
 	Subscription_CancelSubscription_FullMethodName         = "/subscription.v1.Subscription/CancelSubscription"
 	Subscription_PauseSubscription_FullMethodName          = "/subscription.v1.Subscription/PauseSubscription"
 	Subscription_ResumeSubscription_FullMethodName         = "/subscription.v1.Subscription/ResumeSubscription"
 	Subscription_GetSubscriptionHistory_FullMethodName     = "/subscription.v1.Subscription/GetSubscriptionHistory"
+	Subscription_ListAppSubscriptionHistory_FullMethodName = "/subscription.v1.Subscription/ListAppSubscriptionHistory"
+	Subscription_ExportSubscriptionHistory_FullMethodName  = "/subscription.v1.Subscription/ExportSubscriptionHistory"
 	Subscription_SetAutoRenew_FullMethodName               = "/subscription.v1.Subscription/SetAutoRenew"
 	Subscription_GetExpiringSubscriptions_FullMethodName   = "/subscription.v1.Subscription/GetExpiringSubscriptions"
 	Subscription_UpdateExpiredSubscriptions_FullMethodName = "/subscription.v1.Subscription/UpdateExpiredSubscriptions"
 	Subscription_ProcessAutoRenewals_FullMethodName        = "/subscription.v1.Subscription/ProcessAutoRenewals"
+	Subscription_ProcessPriceChangeNotices_FullMethodName  = "/subscription.v1.Subscription/ProcessPriceChangeNotices"
 	Subscription_CreatePlan_FullMethodName                 = "/subscription.v1.Subscription/CreatePlan"
 	Subscription_UpdatePlan_FullMethodName                 = "/subscription.v1.Subscription/UpdatePlan"
 	Subscription_DeletePlan_FullMethodName                 = "/subscription.v1.Subscription/DeletePlan"
@@ -39,6 +44,27 @@ const (
 	Subscription_CreatePlanPricing_FullMethodName          = "/subscription.v1.Subscription/CreatePlanPricing"
 	Subscription_UpdatePlanPricing_FullMethodName          = "/subscription.v1.Subscription/UpdatePlanPricing"
 	Subscription_DeletePlanPricing_FullMethodName          = "/subscription.v1.Subscription/DeletePlanPricing"
+	Subscription_GetAppSetting_FullMethodName              = "/subscription.v1.Subscription/GetAppSetting"
+	Subscription_UpdateAppSetting_FullMethodName           = "/subscription.v1.Subscription/UpdateAppSetting"
+	Subscription_ListRegionGroups_FullMethodName           = "/subscription.v1.Subscription/ListRegionGroups"
+	Subscription_GetRegionGroup_FullMethodName             = "/subscription.v1.Subscription/GetRegionGroup"
+	Subscription_SaveRegionGroup_FullMethodName            = "/subscription.v1.Subscription/SaveRegionGroup"
+	Subscription_DeleteRegionGroup_FullMethodName          = "/subscription.v1.Subscription/DeleteRegionGroup"
+	Subscription_GetInvoice_FullMethodName                 = "/subscription.v1.Subscription/GetInvoice"
+	Subscription_ListInvoices_FullMethodName               = "/subscription.v1.Subscription/ListInvoices"
+	Subscription_ListTaxRules_FullMethodName               = "/subscription.v1.Subscription/ListTaxRules"
+	Subscription_CreateTaxRule_FullMethodName              = "/subscription.v1.Subscription/CreateTaxRule"
+	Subscription_UpdateTaxRule_FullMethodName              = "/subscription.v1.Subscription/UpdateTaxRule"
+	Subscription_DeleteTaxRule_FullMethodName              = "/subscription.v1.Subscription/DeleteTaxRule"
+	Subscription_ListExchangeRates_FullMethodName          = "/subscription.v1.Subscription/ListExchangeRates"
+	Subscription_SaveExchangeRates_FullMethodName          = "/subscription.v1.Subscription/SaveExchangeRates"
+	Subscription_ImportExchangeRates_FullMethodName        = "/subscription.v1.Subscription/ImportExchangeRates"
+	Subscription_GetRevenueReport_FullMethodName           = "/subscription.v1.Subscription/GetRevenueReport"
+	Subscription_GetSubscriptionMetrics_FullMethodName     = "/subscription.v1.Subscription/GetSubscriptionMetrics"
+	Subscription_ListMetricSnapshots_FullMethodName        = "/subscription.v1.Subscription/ListMetricSnapshots"
+	Subscription_GenerateMetricSnapshots_FullMethodName    = "/subscription.v1.Subscription/GenerateMetricSnapshots"
+	Subscription_GetCohortReport_FullMethodName            = "/subscription.v1.Subscription/GetCohortReport"
+	Subscription_GetPlanLTVReport_FullMethodName           = "/subscription.v1.Subscription/GetPlanLTVReport"
 )
 
 // SubscriptionClient is the client API for Subscription service.
@@ -49,18 +75,26 @@ type SubscriptionClient interface {
 	ListPlans(ctx context.Context, in *ListPlansRequest, opts ...grpc.CallOption) (*ListPlansReply, error)
 	// 获取用户的订阅状态
 	GetMySubscription(ctx context.Context, in *GetMySubscriptionRequest, opts ...grpc.CallOption) (*GetMySubscriptionReply, error)
+	// 获取订阅报价（结算预览，不创建订单）
+	QuoteSubscription(ctx context.Context, in *QuoteSubscriptionRequest, opts ...grpc.CallOption) (*QuoteSubscriptionReply, error)
 	// 创建订阅订单 (调用 Payment Service)
 	CreateSubscriptionOrder(ctx context.Context, in *CreateSubscriptionOrderRequest, opts ...grpc.CallOption) (*CreateSubscriptionOrderReply, error)
 	// 支付回调处理 (通常由 Payment Service 或 MQ 调用)
 	HandlePaymentSuccess(ctx context.Context, in *HandlePaymentSuccessRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// 退款回调处理 (全额退款时结束订阅，并回落到应用的默认免费套餐)
+	HandlePaymentRefund(ctx context.Context, in *HandlePaymentRefundRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// 取消订阅
 	CancelSubscription(ctx context.Context, in *CancelSubscriptionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// 暂停订阅
 	PauseSubscription(ctx context.Context, in *PauseSubscriptionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// 恢复订阅
 	ResumeSubscription(ctx context.Context, in *ResumeSubscriptionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
-	// 获取订阅历史记录
+	// 获取订阅历史记录（支持按操作类型、套餐、应用和时间范围筛选，按页码或游标分页）
 	GetSubscriptionHistory(ctx context.Context, in *GetSubscriptionHistoryRequest, opts ...grpc.CallOption) (*GetSubscriptionHistoryReply, error)
+	// 获取当前应用所有用户的订阅历史记录（游标分页）
+	ListAppSubscriptionHistory(ctx context.Context, in *ListAppSubscriptionHistoryRequest, opts ...grpc.CallOption) (*ListAppSubscriptionHistoryReply, error)
+	// 流式导出当前应用的订阅历史记录（CSV 或 NDJSON；HTTP 接口以分块传输返回文件）
+	ExportSubscriptionHistory(ctx context.Context, in *ExportSubscriptionHistoryRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportSubscriptionHistoryChunk], error)
 	// 设置自动续费
 	SetAutoRenew(ctx context.Context, in *SetAutoRenewRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// 获取即将过期的订阅（用于定时任务）
@@ -69,6 +103,8 @@ type SubscriptionClient interface {
 	UpdateExpiredSubscriptions(ctx context.Context, in *UpdateExpiredSubscriptionsRequest, opts ...grpc.CallOption) (*UpdateExpiredSubscriptionsReply, error)
 	// 处理自动续费（用于定时任务）
 	ProcessAutoRenewals(ctx context.Context, in *ProcessAutoRenewalsRequest, opts ...grpc.CallOption) (*ProcessAutoRenewalsReply, error)
+	// 发送续费调价通知 (系统内部调用)
+	ProcessPriceChangeNotices(ctx context.Context, in *ProcessPriceChangeNoticesRequest, opts ...grpc.CallOption) (*ProcessPriceChangeNoticesReply, error)
 	// 创建订阅套餐
 	CreatePlan(ctx context.Context, in *CreatePlanRequest, opts ...grpc.CallOption) (*CreatePlanReply, error)
 	// 更新订阅套餐
@@ -83,6 +119,48 @@ type SubscriptionClient interface {
 	UpdatePlanPricing(ctx context.Context, in *UpdatePlanPricingRequest, opts ...grpc.CallOption) (*UpdatePlanPricingReply, error)
 	// 删除区域定价
 	DeletePlanPricing(ctx context.Context, in *DeletePlanPricingRequest, opts ...grpc.CallOption) (*DeletePlanPricingReply, error)
+	// 获取应用订阅配置
+	GetAppSetting(ctx context.Context, in *GetAppSettingRequest, opts ...grpc.CallOption) (*GetAppSettingReply, error)
+	// 更新应用订阅配置（如默认免费套餐）
+	UpdateAppSetting(ctx context.Context, in *UpdateAppSettingRequest, opts ...grpc.CallOption) (*UpdateAppSettingReply, error)
+	// 获取地区组列表
+	ListRegionGroups(ctx context.Context, in *ListRegionGroupsRequest, opts ...grpc.CallOption) (*ListRegionGroupsReply, error)
+	// 获取地区组
+	GetRegionGroup(ctx context.Context, in *GetRegionGroupRequest, opts ...grpc.CallOption) (*GetRegionGroupReply, error)
+	// 创建或更新地区组（整体替换成员国家）
+	SaveRegionGroup(ctx context.Context, in *SaveRegionGroupRequest, opts ...grpc.CallOption) (*SaveRegionGroupReply, error)
+	// 删除地区组
+	DeleteRegionGroup(ctx context.Context, in *DeleteRegionGroupRequest, opts ...grpc.CallOption) (*DeleteRegionGroupReply, error)
+	// 获取发票或红字发票（format 为 html 或 pdf 时同时返回渲染后的内容）
+	GetInvoice(ctx context.Context, in *GetInvoiceRequest, opts ...grpc.CallOption) (*GetInvoiceReply, error)
+	// 获取用户的发票列表（包含红字发票）
+	ListInvoices(ctx context.Context, in *ListInvoicesRequest, opts ...grpc.CallOption) (*ListInvoicesReply, error)
+	// 获取税务规则列表
+	ListTaxRules(ctx context.Context, in *ListTaxRulesRequest, opts ...grpc.CallOption) (*ListTaxRulesReply, error)
+	// 创建税务规则
+	CreateTaxRule(ctx context.Context, in *CreateTaxRuleRequest, opts ...grpc.CallOption) (*CreateTaxRuleReply, error)
+	// 更新税务规则（只影响之后的报价和订单）
+	UpdateTaxRule(ctx context.Context, in *UpdateTaxRuleRequest, opts ...grpc.CallOption) (*UpdateTaxRuleReply, error)
+	// 删除税务规则
+	DeleteTaxRule(ctx context.Context, in *DeleteTaxRuleRequest, opts ...grpc.CallOption) (*DeleteTaxRuleReply, error)
+	// 获取汇率列表（按生效时间倒序）
+	ListExchangeRates(ctx context.Context, in *ListExchangeRatesRequest, opts ...grpc.CallOption) (*ListExchangeRatesReply, error)
+	// 保存汇率（币种对和生效时间相同时覆盖）
+	SaveExchangeRates(ctx context.Context, in *SaveExchangeRatesRequest, opts ...grpc.CallOption) (*SaveExchangeRatesReply, error)
+	// 导入汇率（CSV 内容为空时从配置的本地汇率文件导入）
+	ImportExchangeRates(ctx context.Context, in *ImportExchangeRatesRequest, opts ...grpc.CallOption) (*ImportExchangeRatesReply, error)
+	// 获取当前应用的收入报表（按应用报表币种折算）
+	GetRevenueReport(ctx context.Context, in *GetRevenueReportRequest, opts ...grpc.CallOption) (*GetRevenueReportReply, error)
+	// 获取当前应用的订阅指标汇总（活跃订阅数、MRR/ARR、新增/流失/回流、流失率和留存率）
+	GetSubscriptionMetrics(ctx context.Context, in *GetSubscriptionMetricsRequest, opts ...grpc.CallOption) (*GetSubscriptionMetricsReply, error)
+	// 获取当前应用的订阅指标日快照
+	ListMetricSnapshots(ctx context.Context, in *ListMetricSnapshotsRequest, opts ...grpc.CallOption) (*ListMetricSnapshotsReply, error)
+	// 生成订阅指标日快照（Cron 每天自动生成前一天的快照，可用于补生成）
+	GenerateMetricSnapshots(ctx context.Context, in *GenerateMetricSnapshotsRequest, opts ...grpc.CallOption) (*GenerateMetricSnapshotsReply, error)
+	// 获取当前应用的月度同期群留存报表（format 为 csv 时同时返回 CSV 内容）
+	GetCohortReport(ctx context.Context, in *GetCohortReportRequest, opts ...grpc.CallOption) (*GetCohortReportReply, error)
+	// 获取当前应用各套餐的 LTV 估算（format 为 csv 时同时返回 CSV 内容）
+	GetPlanLTVReport(ctx context.Context, in *GetPlanLTVReportRequest, opts ...grpc.CallOption) (*GetPlanLTVReportReply, error)
 }
 
 type subscriptionClient struct {
@@ -113,6 +191,16 @@ func (c *subscriptionClient) GetMySubscription(ctx context.Context, in *GetMySub
 	return out, nil
 }
 
+func (c *subscriptionClient) QuoteSubscription(ctx context.Context, in *QuoteSubscriptionRequest, opts ...grpc.CallOption) (*QuoteSubscriptionReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QuoteSubscriptionReply)
+	err := c.cc.Invoke(ctx, Subscription_QuoteSubscription_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *subscriptionClient) CreateSubscriptionOrder(ctx context.Context, in *CreateSubscriptionOrderRequest, opts ...grpc.CallOption) (*CreateSubscriptionOrderReply, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CreateSubscriptionOrderReply)
@@ -133,6 +221,16 @@ func (c *subscriptionClient) HandlePaymentSuccess(ctx context.Context, in *Handl
 	return out, nil
 }
 
+func (c *subscriptionClient) HandlePaymentRefund(ctx context.Context, in *HandlePaymentRefundRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Subscription_HandlePaymentRefund_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *subscriptionClient) CancelSubscription(ctx context.Context, in *CancelSubscriptionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(emptypb.Empty)
@@ -173,6 +271,35 @@ func (c *subscriptionClient) GetSubscriptionHistory(ctx context.Context, in *Get
 	return out, nil
 }
 
+func (c *subscriptionClient) ListAppSubscriptionHistory(ctx context.Context, in *ListAppSubscriptionHistoryRequest, opts ...grpc.CallOption) (*ListAppSubscriptionHistoryReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAppSubscriptionHistoryReply)
+	err := c.cc.Invoke(ctx, Subscription_ListAppSubscriptionHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *subscriptionClient) ExportSubscriptionHistory(ctx context.Context, in *ExportSubscriptionHistoryRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportSubscriptionHistoryChunk], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Subscription_ServiceDesc.Streams[0], Subscription_ExportSubscriptionHistory_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ExportSubscriptionHistoryRequest, ExportSubscriptionHistoryChunk]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Subscription_ExportSubscriptionHistoryClient = grpc.ServerStreamingClient[ExportSubscriptionHistoryChunk]
+
 func (c *subscriptionClient) SetAutoRenew(ctx context.Context, in *SetAutoRenewRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(emptypb.Empty)
@@ -213,6 +340,16 @@ func (c *subscriptionClient) ProcessAutoRenewals(ctx context.Context, in *Proces
 	return out, nil
 }
 
+func (c *subscriptionClient) ProcessPriceChangeNotices(ctx context.Context, in *ProcessPriceChangeNoticesRequest, opts ...grpc.CallOption) (*ProcessPriceChangeNoticesReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProcessPriceChangeNoticesReply)
+	err := c.cc.Invoke(ctx, Subscription_ProcessPriceChangeNotices_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *subscriptionClient) CreatePlan(ctx context.Context, in *CreatePlanRequest, opts ...grpc.CallOption) (*CreatePlanReply, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CreatePlanReply)
@@ -283,6 +420,216 @@ func (c *subscriptionClient) DeletePlanPricing(ctx context.Context, in *DeletePl
 	return out, nil
 }
 
+func (c *subscriptionClient) GetAppSetting(ctx context.Context, in *GetAppSettingRequest, opts ...grpc.CallOption) (*GetAppSettingReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAppSettingReply)
+	err := c.cc.Invoke(ctx, Subscription_GetAppSetting_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *subscriptionClient) UpdateAppSetting(ctx context.Context, in *UpdateAppSettingRequest, opts ...grpc.CallOption) (*UpdateAppSettingReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateAppSettingReply)
+	err := c.cc.Invoke(ctx, Subscription_UpdateAppSetting_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *subscriptionClient) ListRegionGroups(ctx context.Context, in *ListRegionGroupsRequest, opts ...grpc.CallOption) (*ListRegionGroupsReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListRegionGroupsReply)
+	err := c.cc.Invoke(ctx, Subscription_ListRegionGroups_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *subscriptionClient) GetRegionGroup(ctx context.Context, in *GetRegionGroupRequest, opts ...grpc.CallOption) (*GetRegionGroupReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetRegionGroupReply)
+	err := c.cc.Invoke(ctx, Subscription_GetRegionGroup_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *subscriptionClient) SaveRegionGroup(ctx context.Context, in *SaveRegionGroupRequest, opts ...grpc.CallOption) (*SaveRegionGroupReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SaveRegionGroupReply)
+	err := c.cc.Invoke(ctx, Subscription_SaveRegionGroup_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *subscriptionClient) DeleteRegionGroup(ctx context.Context, in *DeleteRegionGroupRequest, opts ...grpc.CallOption) (*DeleteRegionGroupReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteRegionGroupReply)
+	err := c.cc.Invoke(ctx, Subscription_DeleteRegionGroup_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *subscriptionClient) GetInvoice(ctx context.Context, in *GetInvoiceRequest, opts ...grpc.CallOption) (*GetInvoiceReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetInvoiceReply)
+	err := c.cc.Invoke(ctx, Subscription_GetInvoice_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *subscriptionClient) ListInvoices(ctx context.Context, in *ListInvoicesRequest, opts ...grpc.CallOption) (*ListInvoicesReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListInvoicesReply)
+	err := c.cc.Invoke(ctx, Subscription_ListInvoices_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *subscriptionClient) ListTaxRules(ctx context.Context, in *ListTaxRulesRequest, opts ...grpc.CallOption) (*ListTaxRulesReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTaxRulesReply)
+	err := c.cc.Invoke(ctx, Subscription_ListTaxRules_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *subscriptionClient) CreateTaxRule(ctx context.Context, in *CreateTaxRuleRequest, opts ...grpc.CallOption) (*CreateTaxRuleReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateTaxRuleReply)
+	err := c.cc.Invoke(ctx, Subscription_CreateTaxRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *subscriptionClient) UpdateTaxRule(ctx context.Context, in *UpdateTaxRuleRequest, opts ...grpc.CallOption) (*UpdateTaxRuleReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateTaxRuleReply)
+	err := c.cc.Invoke(ctx, Subscription_UpdateTaxRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *subscriptionClient) DeleteTaxRule(ctx context.Context, in *DeleteTaxRuleRequest, opts ...grpc.CallOption) (*DeleteTaxRuleReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteTaxRuleReply)
+	err := c.cc.Invoke(ctx, Subscription_DeleteTaxRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *subscriptionClient) ListExchangeRates(ctx context.Context, in *ListExchangeRatesRequest, opts ...grpc.CallOption) (*ListExchangeRatesReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListExchangeRatesReply)
+	err := c.cc.Invoke(ctx, Subscription_ListExchangeRates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *subscriptionClient) SaveExchangeRates(ctx context.Context, in *SaveExchangeRatesRequest, opts ...grpc.CallOption) (*SaveExchangeRatesReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SaveExchangeRatesReply)
+	err := c.cc.Invoke(ctx, Subscription_SaveExchangeRates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *subscriptionClient) ImportExchangeRates(ctx context.Context, in *ImportExchangeRatesRequest, opts ...grpc.CallOption) (*ImportExchangeRatesReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImportExchangeRatesReply)
+	err := c.cc.Invoke(ctx, Subscription_ImportExchangeRates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *subscriptionClient) GetRevenueReport(ctx context.Context, in *GetRevenueReportRequest, opts ...grpc.CallOption) (*GetRevenueReportReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetRevenueReportReply)
+	err := c.cc.Invoke(ctx, Subscription_GetRevenueReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *subscriptionClient) GetSubscriptionMetrics(ctx context.Context, in *GetSubscriptionMetricsRequest, opts ...grpc.CallOption) (*GetSubscriptionMetricsReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSubscriptionMetricsReply)
+	err := c.cc.Invoke(ctx, Subscription_GetSubscriptionMetrics_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *subscriptionClient) ListMetricSnapshots(ctx context.Context, in *ListMetricSnapshotsRequest, opts ...grpc.CallOption) (*ListMetricSnapshotsReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMetricSnapshotsReply)
+	err := c.cc.Invoke(ctx, Subscription_ListMetricSnapshots_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *subscriptionClient) GenerateMetricSnapshots(ctx context.Context, in *GenerateMetricSnapshotsRequest, opts ...grpc.CallOption) (*GenerateMetricSnapshotsReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GenerateMetricSnapshotsReply)
+	err := c.cc.Invoke(ctx, Subscription_GenerateMetricSnapshots_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *subscriptionClient) GetCohortReport(ctx context.Context, in *GetCohortReportRequest, opts ...grpc.CallOption) (*GetCohortReportReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCohortReportReply)
+	err := c.cc.Invoke(ctx, Subscription_GetCohortReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *subscriptionClient) GetPlanLTVReport(ctx context.Context, in *GetPlanLTVReportRequest, opts ...grpc.CallOption) (*GetPlanLTVReportReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPlanLTVReportReply)
+	err := c.cc.Invoke(ctx, Subscription_GetPlanLTVReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // SubscriptionServer is the server API for Subscription service.
 // All implementations must embed UnimplementedSubscriptionServer
 // for forward compatibility.
@@ -291,18 +638,26 @@ type SubscriptionServer interface {
 	ListPlans(context.Context, *ListPlansRequest) (*ListPlansReply, error)
 	// 获取用户的订阅状态
 	GetMySubscription(context.Context, *GetMySubscriptionRequest) (*GetMySubscriptionReply, error)
+	// 获取订阅报价（结算预览，不创建订单）
+	QuoteSubscription(context.Context, *QuoteSubscriptionRequest) (*QuoteSubscriptionReply, error)
 	// 创建订阅订单 (调用 Payment Service)
 	CreateSubscriptionOrder(context.Context, *CreateSubscriptionOrderRequest) (*CreateSubscriptionOrderReply, error)
 	// 支付回调处理 (通常由 Payment Service 或 MQ 调用)
 	HandlePaymentSuccess(context.Context, *HandlePaymentSuccessRequest) (*emptypb.Empty, error)
+	// 退款回调处理 (全额退款时结束订阅，并回落到应用的默认免费套餐)
+	HandlePaymentRefund(context.Context, *HandlePaymentRefundRequest) (*emptypb.Empty, error)
 	// 取消订阅
 	CancelSubscription(context.Context, *CancelSubscriptionRequest) (*emptypb.Empty, error)
 	// 暂停订阅
 	PauseSubscription(context.Context, *PauseSubscriptionRequest) (*emptypb.Empty, error)
 	// 恢复订阅
 	ResumeSubscription(context.Context, *ResumeSubscriptionRequest) (*emptypb.Empty, error)
-	// 获取订阅历史记录
+	// 获取订阅历史记录（支持按操作类型、套餐、应用和时间范围筛选，按页码或游标分页）
 	GetSubscriptionHistory(context.Context, *GetSubscriptionHistoryRequest) (*GetSubscriptionHistoryReply, error)
+	// 获取当前应用所有用户的订阅历史记录（游标分页）
+	ListAppSubscriptionHistory(context.Context, *ListAppSubscriptionHistoryRequest) (*ListAppSubscriptionHistoryReply, error)
+	// 流式导出当前应用的订阅历史记录（CSV 或 NDJSON；HTTP 接口以分块传输返回文件）
+	ExportSubscriptionHistory(*ExportSubscriptionHistoryRequest, grpc.ServerStreamingServer[ExportSubscriptionHistoryChunk]) error
 	// 设置自动续费
 	SetAutoRenew(context.Context, *SetAutoRenewRequest) (*emptypb.Empty, error)
 	// 获取即将过期的订阅（用于定时任务）
@@ -311,6 +666,8 @@ type SubscriptionServer interface {
 	UpdateExpiredSubscriptions(context.Context, *UpdateExpiredSubscriptionsRequest) (*UpdateExpiredSubscriptionsReply, error)
 	// 处理自动续费（用于定时任务）
 	ProcessAutoRenewals(context.Context, *ProcessAutoRenewalsRequest) (*ProcessAutoRenewalsReply, error)
+	// 发送续费调价通知 (系统内部调用)
+	ProcessPriceChangeNotices(context.Context, *ProcessPriceChangeNoticesRequest) (*ProcessPriceChangeNoticesReply, error)
 	// 创建订阅套餐
 	CreatePlan(context.Context, *CreatePlanRequest) (*CreatePlanReply, error)
 	// 更新订阅套餐
@@ -325,6 +682,48 @@ type SubscriptionServer interface {
 	UpdatePlanPricing(context.Context, *UpdatePlanPricingRequest) (*UpdatePlanPricingReply, error)
 	// 删除区域定价
 	DeletePlanPricing(context.Context, *DeletePlanPricingRequest) (*DeletePlanPricingReply, error)
+	// 获取应用订阅配置
+	GetAppSetting(context.Context, *GetAppSettingRequest) (*GetAppSettingReply, error)
+	// 更新应用订阅配置（如默认免费套餐）
+	UpdateAppSetting(context.Context, *UpdateAppSettingRequest) (*UpdateAppSettingReply, error)
+	// 获取地区组列表
+	ListRegionGroups(context.Context, *ListRegionGroupsRequest) (*ListRegionGroupsReply, error)
+	// 获取地区组
+	GetRegionGroup(context.Context, *GetRegionGroupRequest) (*GetRegionGroupReply, error)
+	// 创建或更新地区组（整体替换成员国家）
+	SaveRegionGroup(context.Context, *SaveRegionGroupRequest) (*SaveRegionGroupReply, error)
+	// 删除地区组
+	DeleteRegionGroup(context.Context, *DeleteRegionGroupRequest) (*DeleteRegionGroupReply, error)
+	// 获取发票或红字发票（format 为 html 或 pdf 时同时返回渲染后的内容）
+	GetInvoice(context.Context, *GetInvoiceRequest) (*GetInvoiceReply, error)
+	// 获取用户的发票列表（包含红字发票）
+	ListInvoices(context.Context, *ListInvoicesRequest) (*ListInvoicesReply, error)
+	// 获取税务规则列表
+	ListTaxRules(context.Context, *ListTaxRulesRequest) (*ListTaxRulesReply, error)
+	// 创建税务规则
+	CreateTaxRule(context.Context, *CreateTaxRuleRequest) (*CreateTaxRuleReply, error)
+	// 更新税务规则（只影响之后的报价和订单）
+	UpdateTaxRule(context.Context, *UpdateTaxRuleRequest) (*UpdateTaxRuleReply, error)
+	// 删除税务规则
+	DeleteTaxRule(context.Context, *DeleteTaxRuleRequest) (*DeleteTaxRuleReply, error)
+	// 获取汇率列表（按生效时间倒序）
+	ListExchangeRates(context.Context, *ListExchangeRatesRequest) (*ListExchangeRatesReply, error)
+	// 保存汇率（币种对和生效时间相同时覆盖）
+	SaveExchangeRates(context.Context, *SaveExchangeRatesRequest) (*SaveExchangeRatesReply, error)
+	// 导入汇率（CSV 内容为空时从配置的本地汇率文件导入）
+	ImportExchangeRates(context.Context, *ImportExchangeRatesRequest) (*ImportExchangeRatesReply, error)
+	// 获取当前应用的收入报表（按应用报表币种折算）
+	GetRevenueReport(context.Context, *GetRevenueReportRequest) (*GetRevenueReportReply, error)
+	// 获取当前应用的订阅指标汇总（活跃订阅数、MRR/ARR、新增/流失/回流、流失率和留存率）
+	GetSubscriptionMetrics(context.Context, *GetSubscriptionMetricsRequest) (*GetSubscriptionMetricsReply, error)
+	// 获取当前应用的订阅指标日快照
+	ListMetricSnapshots(context.Context, *ListMetricSnapshotsRequest) (*ListMetricSnapshotsReply, error)
+	// 生成订阅指标日快照（Cron 每天自动生成前一天的快照，可用于补生成）
+	GenerateMetricSnapshots(context.Context, *GenerateMetricSnapshotsRequest) (*GenerateMetricSnapshotsReply, error)
+	// 获取当前应用的月度同期群留存报表（format 为 csv 时同时返回 CSV 内容）
+	GetCohortReport(context.Context, *GetCohortReportRequest) (*GetCohortReportReply, error)
+	// 获取当前应用各套餐的 LTV 估算（format 为 csv 时同时返回 CSV 内容）
+	GetPlanLTVReport(context.Context, *GetPlanLTVReportRequest) (*GetPlanLTVReportReply, error)
 	mustEmbedUnimplementedSubscriptionServer()
 }
 
@@ -341,12 +740,18 @@ func (UnimplementedSubscriptionServer) ListPlans(context.Context, *ListPlansRequ
 func (UnimplementedSubscriptionServer) GetMySubscription(context.Context, *GetMySubscriptionRequest) (*GetMySubscriptionReply, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetMySubscription not implemented")
 }
+func (UnimplementedSubscriptionServer) QuoteSubscription(context.Context, *QuoteSubscriptionRequest) (*QuoteSubscriptionReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method QuoteSubscription not implemented")
+}
 func (UnimplementedSubscriptionServer) CreateSubscriptionOrder(context.Context, *CreateSubscriptionOrderRequest) (*CreateSubscriptionOrderReply, error) {
 	return nil, status.Error(codes.Unimplemented, "method CreateSubscriptionOrder not implemented")
 }
 func (UnimplementedSubscriptionServer) HandlePaymentSuccess(context.Context, *HandlePaymentSuccessRequest) (*emptypb.Empty, error) {
 	return nil, status.Error(codes.Unimplemented, "method HandlePaymentSuccess not implemented")
 }
+func (UnimplementedSubscriptionServer) HandlePaymentRefund(context.Context, *HandlePaymentRefundRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method HandlePaymentRefund not implemented")
+}
 func (UnimplementedSubscriptionServer) CancelSubscription(context.Context, *CancelSubscriptionRequest) (*emptypb.Empty, error) {
 	return nil, status.Error(codes.Unimplemented, "method CancelSubscription not implemented")
 }
@@ -359,6 +764,12 @@ func (UnimplementedSubscriptionServer) ResumeSubscription(context.Context, *Resu
 func (UnimplementedSubscriptionServer) GetSubscriptionHistory(context.Context, *GetSubscriptionHistoryRequest) (*GetSubscriptionHistoryReply, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetSubscriptionHistory not implemented")
 }
+func (UnimplementedSubscriptionServer) ListAppSubscriptionHistory(context.Context, *ListAppSubscriptionHistoryRequest) (*ListAppSubscriptionHistoryReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListAppSubscriptionHistory not implemented")
+}
+func (UnimplementedSubscriptionServer) ExportSubscriptionHistory(*ExportSubscriptionHistoryRequest, grpc.ServerStreamingServer[ExportSubscriptionHistoryChunk]) error {
+	return status.Error(codes.Unimplemented, "method ExportSubscriptionHistory not implemented")
+}
 func (UnimplementedSubscriptionServer) SetAutoRenew(context.Context, *SetAutoRenewRequest) (*emptypb.Empty, error) {
 	return nil, status.Error(codes.Unimplemented, "method SetAutoRenew not implemented")
 }
@@ -371,6 +782,9 @@ func (UnimplementedSubscriptionServer) UpdateExpiredSubscriptions(context.Contex
 func (UnimplementedSubscriptionServer) ProcessAutoRenewals(context.Context, *ProcessAutoRenewalsRequest) (*ProcessAutoRenewalsReply, error) {
 	return nil, status.Error(codes.Unimplemented, "method ProcessAutoRenewals not implemented")
 }
+func (UnimplementedSubscriptionServer) ProcessPriceChangeNotices(context.Context, *ProcessPriceChangeNoticesRequest) (*ProcessPriceChangeNoticesReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method ProcessPriceChangeNotices not implemented")
+}
 func (UnimplementedSubscriptionServer) CreatePlan(context.Context, *CreatePlanRequest) (*CreatePlanReply, error) {
 	return nil, status.Error(codes.Unimplemented, "method CreatePlan not implemented")
 }
@@ -392,6 +806,69 @@ func (UnimplementedSubscriptionServer) UpdatePlanPricing(context.Context, *Updat
 func (UnimplementedSubscriptionServer) DeletePlanPricing(context.Context, *DeletePlanPricingRequest) (*DeletePlanPricingReply, error) {
 	return nil, status.Error(codes.Unimplemented, "method DeletePlanPricing not implemented")
 }
+func (UnimplementedSubscriptionServer) GetAppSetting(context.Context, *GetAppSettingRequest) (*GetAppSettingReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetAppSetting not implemented")
+}
+func (UnimplementedSubscriptionServer) UpdateAppSetting(context.Context, *UpdateAppSettingRequest) (*UpdateAppSettingReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateAppSetting not implemented")
+}
+func (UnimplementedSubscriptionServer) ListRegionGroups(context.Context, *ListRegionGroupsRequest) (*ListRegionGroupsReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListRegionGroups not implemented")
+}
+func (UnimplementedSubscriptionServer) GetRegionGroup(context.Context, *GetRegionGroupRequest) (*GetRegionGroupReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetRegionGroup not implemented")
+}
+func (UnimplementedSubscriptionServer) SaveRegionGroup(context.Context, *SaveRegionGroupRequest) (*SaveRegionGroupReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method SaveRegionGroup not implemented")
+}
+func (UnimplementedSubscriptionServer) DeleteRegionGroup(context.Context, *DeleteRegionGroupRequest) (*DeleteRegionGroupReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteRegionGroup not implemented")
+}
+func (UnimplementedSubscriptionServer) GetInvoice(context.Context, *GetInvoiceRequest) (*GetInvoiceReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetInvoice not implemented")
+}
+func (UnimplementedSubscriptionServer) ListInvoices(context.Context, *ListInvoicesRequest) (*ListInvoicesReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListInvoices not implemented")
+}
+func (UnimplementedSubscriptionServer) ListTaxRules(context.Context, *ListTaxRulesRequest) (*ListTaxRulesReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListTaxRules not implemented")
+}
+func (UnimplementedSubscriptionServer) CreateTaxRule(context.Context, *CreateTaxRuleRequest) (*CreateTaxRuleReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateTaxRule not implemented")
+}
+func (UnimplementedSubscriptionServer) UpdateTaxRule(context.Context, *UpdateTaxRuleRequest) (*UpdateTaxRuleReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateTaxRule not implemented")
+}
+func (UnimplementedSubscriptionServer) DeleteTaxRule(context.Context, *DeleteTaxRuleRequest) (*DeleteTaxRuleReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteTaxRule not implemented")
+}
+func (UnimplementedSubscriptionServer) ListExchangeRates(context.Context, *ListExchangeRatesRequest) (*ListExchangeRatesReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListExchangeRates not implemented")
+}
+func (UnimplementedSubscriptionServer) SaveExchangeRates(context.Context, *SaveExchangeRatesRequest) (*SaveExchangeRatesReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method SaveExchangeRates not implemented")
+}
+func (UnimplementedSubscriptionServer) ImportExchangeRates(context.Context, *ImportExchangeRatesRequest) (*ImportExchangeRatesReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method ImportExchangeRates not implemented")
+}
+func (UnimplementedSubscriptionServer) GetRevenueReport(context.Context, *GetRevenueReportRequest) (*GetRevenueReportReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetRevenueReport not implemented")
+}
+func (UnimplementedSubscriptionServer) GetSubscriptionMetrics(context.Context, *GetSubscriptionMetricsRequest) (*GetSubscriptionMetricsReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetSubscriptionMetrics not implemented")
+}
+func (UnimplementedSubscriptionServer) ListMetricSnapshots(context.Context, *ListMetricSnapshotsRequest) (*ListMetricSnapshotsReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListMetricSnapshots not implemented")
+}
+func (UnimplementedSubscriptionServer) GenerateMetricSnapshots(context.Context, *GenerateMetricSnapshotsRequest) (*GenerateMetricSnapshotsReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method GenerateMetricSnapshots not implemented")
+}
+func (UnimplementedSubscriptionServer) GetCohortReport(context.Context, *GetCohortReportRequest) (*GetCohortReportReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetCohortReport not implemented")
+}
+func (UnimplementedSubscriptionServer) GetPlanLTVReport(context.Context, *GetPlanLTVReportRequest) (*GetPlanLTVReportReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetPlanLTVReport not implemented")
+}
 func (UnimplementedSubscriptionServer) mustEmbedUnimplementedSubscriptionServer() {}
 func (UnimplementedSubscriptionServer) testEmbeddedByValue()                      {}
 
@@ -449,6 +926,24 @@ func _Subscription_GetMySubscription_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Subscription_QuoteSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuoteSubscriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubscriptionServer).QuoteSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Subscription_QuoteSubscription_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubscriptionServer).QuoteSubscription(ctx, req.(*QuoteSubscriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Subscription_CreateSubscriptionOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateSubscriptionOrderRequest)
 	if err := dec(in); err != nil {
@@ -485,6 +980,24 @@ func _Subscription_HandlePaymentSuccess_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Subscription_HandlePaymentRefund_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HandlePaymentRefundRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubscriptionServer).HandlePaymentRefund(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Subscription_HandlePaymentRefund_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubscriptionServer).HandlePaymentRefund(ctx, req.(*HandlePaymentRefundRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Subscription_CancelSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CancelSubscriptionRequest)
 	if err := dec(in); err != nil {
@@ -557,6 +1070,35 @@ func _Subscription_GetSubscriptionHistory_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Subscription_ListAppSubscriptionHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAppSubscriptionHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubscriptionServer).ListAppSubscriptionHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Subscription_ListAppSubscriptionHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubscriptionServer).ListAppSubscriptionHistory(ctx, req.(*ListAppSubscriptionHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Subscription_ExportSubscriptionHistory_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportSubscriptionHistoryRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SubscriptionServer).ExportSubscriptionHistory(m, &grpc.GenericServerStream[ExportSubscriptionHistoryRequest, ExportSubscriptionHistoryChunk]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Subscription_ExportSubscriptionHistoryServer = grpc.ServerStreamingServer[ExportSubscriptionHistoryChunk]
+
 func _Subscription_SetAutoRenew_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SetAutoRenewRequest)
 	if err := dec(in); err != nil {
@@ -629,6 +1171,24 @@ func _Subscription_ProcessAutoRenewals_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Subscription_ProcessPriceChangeNotices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProcessPriceChangeNoticesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubscriptionServer).ProcessPriceChangeNotices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Subscription_ProcessPriceChangeNotices_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubscriptionServer).ProcessPriceChangeNotices(ctx, req.(*ProcessPriceChangeNoticesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Subscription_CreatePlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreatePlanRequest)
 	if err := dec(in); err != nil {
@@ -755,88 +1315,1228 @@ func _Subscription_DeletePlanPricing_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
-// Subscription_ServiceDesc is the grpc.ServiceDesc for Subscription service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var Subscription_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "subscription.v1.Subscription",
-	HandlerType: (*SubscriptionServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "ListPlans",
-			Handler:    _Subscription_ListPlans_Handler,
-		},
-		{
-			MethodName: "GetMySubscription",
-			Handler:    _Subscription_GetMySubscription_Handler,
-		},
-		{
-			MethodName: "CreateSubscriptionOrder",
-			Handler:    _Subscription_CreateSubscriptionOrder_Handler,
-		},
-		{
-			MethodName: "HandlePaymentSuccess",
-			Handler:    _Subscription_HandlePaymentSuccess_Handler,
-		},
-		{
-			MethodName: "CancelSubscription",
-			Handler:    _Subscription_CancelSubscription_Handler,
-		},
-		{
-			MethodName: "PauseSubscription",
-			Handler:    _Subscription_PauseSubscription_Handler,
-		},
-		{
-			MethodName: "ResumeSubscription",
-			Handler:    _Subscription_ResumeSubscription_Handler,
-		},
-		{
-			MethodName: "GetSubscriptionHistory",
-			Handler:    _Subscription_GetSubscriptionHistory_Handler,
-		},
-		{
-			MethodName: "SetAutoRenew",
-			Handler:    _Subscription_SetAutoRenew_Handler,
-		},
-		{
-			MethodName: "GetExpiringSubscriptions",
-			Handler:    _Subscription_GetExpiringSubscriptions_Handler,
-		},
-		{
-			MethodName: "UpdateExpiredSubscriptions",
-			Handler:    _Subscription_UpdateExpiredSubscriptions_Handler,
-		},
-		{
-			MethodName: "ProcessAutoRenewals",
-			Handler:    _Subscription_ProcessAutoRenewals_Handler,
-		},
-		{
-			MethodName: "CreatePlan",
-			Handler:    _Subscription_CreatePlan_Handler,
-		},
-		{
-			MethodName: "UpdatePlan",
-			Handler:    _Subscription_UpdatePlan_Handler,
-		},
-		{
-			MethodName: "DeletePlan",
-			Handler:    _Subscription_DeletePlan_Handler,
-		},
-		{
-			MethodName: "ListPlanPricings",
-			Handler:    _Subscription_ListPlanPricings_Handler,
-		},
-		{
-			MethodName: "CreatePlanPricing",
-			Handler:    _Subscription_CreatePlanPricing_Handler,
-		},
-		{
-			MethodName: "UpdatePlanPricing",
-			Handler:    _Subscription_UpdatePlanPricing_Handler,
-		},
-		{
-			MethodName: "DeletePlanPricing",
-			Handler:    _Subscription_DeletePlanPricing_Handler,
+func _Subscription_GetAppSetting_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAppSettingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubscriptionServer).GetAppSetting(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Subscription_GetAppSetting_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubscriptionServer).GetAppSetting(ctx, req.(*GetAppSettingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Subscription_UpdateAppSetting_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateAppSettingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubscriptionServer).UpdateAppSetting(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Subscription_UpdateAppSetting_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubscriptionServer).UpdateAppSetting(ctx, req.(*UpdateAppSettingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Subscription_ListRegionGroups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRegionGroupsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubscriptionServer).ListRegionGroups(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Subscription_ListRegionGroups_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubscriptionServer).ListRegionGroups(ctx, req.(*ListRegionGroupsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Subscription_GetRegionGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRegionGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubscriptionServer).GetRegionGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Subscription_GetRegionGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubscriptionServer).GetRegionGroup(ctx, req.(*GetRegionGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Subscription_SaveRegionGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SaveRegionGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubscriptionServer).SaveRegionGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Subscription_SaveRegionGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubscriptionServer).SaveRegionGroup(ctx, req.(*SaveRegionGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Subscription_DeleteRegionGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRegionGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubscriptionServer).DeleteRegionGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Subscription_DeleteRegionGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubscriptionServer).DeleteRegionGroup(ctx, req.(*DeleteRegionGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Subscription_GetInvoice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetInvoiceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubscriptionServer).GetInvoice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Subscription_GetInvoice_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubscriptionServer).GetInvoice(ctx, req.(*GetInvoiceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Subscription_ListInvoices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListInvoicesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubscriptionServer).ListInvoices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Subscription_ListInvoices_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubscriptionServer).ListInvoices(ctx, req.(*ListInvoicesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Subscription_ListTaxRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTaxRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubscriptionServer).ListTaxRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Subscription_ListTaxRules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubscriptionServer).ListTaxRules(ctx, req.(*ListTaxRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Subscription_CreateTaxRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTaxRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubscriptionServer).CreateTaxRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Subscription_CreateTaxRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubscriptionServer).CreateTaxRule(ctx, req.(*CreateTaxRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Subscription_UpdateTaxRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTaxRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubscriptionServer).UpdateTaxRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Subscription_UpdateTaxRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubscriptionServer).UpdateTaxRule(ctx, req.(*UpdateTaxRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Subscription_DeleteTaxRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTaxRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubscriptionServer).DeleteTaxRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Subscription_DeleteTaxRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubscriptionServer).DeleteTaxRule(ctx, req.(*DeleteTaxRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Subscription_ListExchangeRates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListExchangeRatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubscriptionServer).ListExchangeRates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Subscription_ListExchangeRates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubscriptionServer).ListExchangeRates(ctx, req.(*ListExchangeRatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Subscription_SaveExchangeRates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SaveExchangeRatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubscriptionServer).SaveExchangeRates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Subscription_SaveExchangeRates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubscriptionServer).SaveExchangeRates(ctx, req.(*SaveExchangeRatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Subscription_ImportExchangeRates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportExchangeRatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubscriptionServer).ImportExchangeRates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Subscription_ImportExchangeRates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubscriptionServer).ImportExchangeRates(ctx, req.(*ImportExchangeRatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Subscription_GetRevenueReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRevenueReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubscriptionServer).GetRevenueReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Subscription_GetRevenueReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubscriptionServer).GetRevenueReport(ctx, req.(*GetRevenueReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Subscription_GetSubscriptionMetrics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSubscriptionMetricsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubscriptionServer).GetSubscriptionMetrics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Subscription_GetSubscriptionMetrics_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubscriptionServer).GetSubscriptionMetrics(ctx, req.(*GetSubscriptionMetricsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Subscription_ListMetricSnapshots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMetricSnapshotsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubscriptionServer).ListMetricSnapshots(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Subscription_ListMetricSnapshots_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubscriptionServer).ListMetricSnapshots(ctx, req.(*ListMetricSnapshotsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Subscription_GenerateMetricSnapshots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenerateMetricSnapshotsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubscriptionServer).GenerateMetricSnapshots(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Subscription_GenerateMetricSnapshots_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubscriptionServer).GenerateMetricSnapshots(ctx, req.(*GenerateMetricSnapshotsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Subscription_GetCohortReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCohortReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubscriptionServer).GetCohortReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Subscription_GetCohortReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubscriptionServer).GetCohortReport(ctx, req.(*GetCohortReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Subscription_GetPlanLTVReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPlanLTVReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubscriptionServer).GetPlanLTVReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Subscription_GetPlanLTVReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubscriptionServer).GetPlanLTVReport(ctx, req.(*GetPlanLTVReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Subscription_ServiceDesc is the grpc.ServiceDesc for Subscription service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Subscription_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "subscription.v1.Subscription",
+	HandlerType: (*SubscriptionServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListPlans",
+			Handler:    _Subscription_ListPlans_Handler,
+		},
+		{
+			MethodName: "GetMySubscription",
+			Handler:    _Subscription_GetMySubscription_Handler,
+		},
+		{
+			MethodName: "QuoteSubscription",
+			Handler:    _Subscription_QuoteSubscription_Handler,
+		},
+		{
+			MethodName: "CreateSubscriptionOrder",
+			Handler:    _Subscription_CreateSubscriptionOrder_Handler,
+		},
+		{
+			MethodName: "HandlePaymentSuccess",
+			Handler:    _Subscription_HandlePaymentSuccess_Handler,
+		},
+		{
+			MethodName: "HandlePaymentRefund",
+			Handler:    _Subscription_HandlePaymentRefund_Handler,
+		},
+		{
+			MethodName: "CancelSubscription",
+			Handler:    _Subscription_CancelSubscription_Handler,
+		},
+		{
+			MethodName: "PauseSubscription",
+			Handler:    _Subscription_PauseSubscription_Handler,
+		},
+		{
+			MethodName: "ResumeSubscription",
+			Handler:    _Subscription_ResumeSubscription_Handler,
+		},
+		{
+			MethodName: "GetSubscriptionHistory",
+			Handler:    _Subscription_GetSubscriptionHistory_Handler,
+		},
+		{
+			MethodName: "ListAppSubscriptionHistory",
+			Handler:    _Subscription_ListAppSubscriptionHistory_Handler,
+		},
+		{
+			MethodName: "SetAutoRenew",
+			Handler:    _Subscription_SetAutoRenew_Handler,
+		},
+		{
+			MethodName: "GetExpiringSubscriptions",
+			Handler:    _Subscription_GetExpiringSubscriptions_Handler,
+		},
+		{
+			MethodName: "UpdateExpiredSubscriptions",
+			Handler:    _Subscription_UpdateExpiredSubscriptions_Handler,
+		},
+		{
+			MethodName: "ProcessAutoRenewals",
+			Handler:    _Subscription_ProcessAutoRenewals_Handler,
+		},
+		{
+			MethodName: "ProcessPriceChangeNotices",
+			Handler:    _Subscription_ProcessPriceChangeNotices_Handler,
+		},
+		{
+			MethodName: "CreatePlan",
+			Handler:    _Subscription_CreatePlan_Handler,
+		},
+		{
+			MethodName: "UpdatePlan",
+			Handler:    _Subscription_UpdatePlan_Handler,
+		},
+		{
+			MethodName: "DeletePlan",
+			Handler:    _Subscription_DeletePlan_Handler,
+		},
+		{
+			MethodName: "ListPlanPricings",
+			Handler:    _Subscription_ListPlanPricings_Handler,
+		},
+		{
+			MethodName: "CreatePlanPricing",
+			Handler:    _Subscription_CreatePlanPricing_Handler,
+		},
+		{
+			MethodName: "UpdatePlanPricing",
+			Handler:    _Subscription_UpdatePlanPricing_Handler,
+		},
+		{
+			MethodName: "DeletePlanPricing",
+			Handler:    _Subscription_DeletePlanPricing_Handler,
+		},
+		{
+			MethodName: "GetAppSetting",
+			Handler:    _Subscription_GetAppSetting_Handler,
+		},
+		{
+			MethodName: "UpdateAppSetting",
+			Handler:    _Subscription_UpdateAppSetting_Handler,
+		},
+		{
+			MethodName: "ListRegionGroups",
+			Handler:    _Subscription_ListRegionGroups_Handler,
+		},
+		{
+			MethodName: "GetRegionGroup",
+			Handler:    _Subscription_GetRegionGroup_Handler,
+		},
+		{
+			MethodName: "SaveRegionGroup",
+			Handler:    _Subscription_SaveRegionGroup_Handler,
+		},
+		{
+			MethodName: "DeleteRegionGroup",
+			Handler:    _Subscription_DeleteRegionGroup_Handler,
+		},
+		{
+			MethodName: "GetInvoice",
+			Handler:    _Subscription_GetInvoice_Handler,
+		},
+		{
+			MethodName: "ListInvoices",
+			Handler:    _Subscription_ListInvoices_Handler,
+		},
+		{
+			MethodName: "ListTaxRules",
+			Handler:    _Subscription_ListTaxRules_Handler,
+		},
+		{
+			MethodName: "CreateTaxRule",
+			Handler:    _Subscription_CreateTaxRule_Handler,
+		},
+		{
+			MethodName: "UpdateTaxRule",
+			Handler:    _Subscription_UpdateTaxRule_Handler,
+		},
+		{
+			MethodName: "DeleteTaxRule",
+			Handler:    _Subscription_DeleteTaxRule_Handler,
+		},
+		{
+			MethodName: "ListExchangeRates",
+			Handler:    _Subscription_ListExchangeRates_Handler,
+		},
+		{
+			MethodName: "SaveExchangeRates",
+			Handler:    _Subscription_SaveExchangeRates_Handler,
+		},
+		{
+			MethodName: "ImportExchangeRates",
+			Handler:    _Subscription_ImportExchangeRates_Handler,
+		},
+		{
+			MethodName: "GetRevenueReport",
+			Handler:    _Subscription_GetRevenueReport_Handler,
+		},
+		{
+			MethodName: "GetSubscriptionMetrics",
+			Handler:    _Subscription_GetSubscriptionMetrics_Handler,
+		},
+		{
+			MethodName: "ListMetricSnapshots",
+			Handler:    _Subscription_ListMetricSnapshots_Handler,
+		},
+		{
+			MethodName: "GenerateMetricSnapshots",
+			Handler:    _Subscription_GenerateMetricSnapshots_Handler,
+		},
+		{
+			MethodName: "GetCohortReport",
+			Handler:    _Subscription_GetCohortReport_Handler,
+		},
+		{
+			MethodName: "GetPlanLTVReport",
+			Handler:    _Subscription_GetPlanLTVReport_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ExportSubscriptionHistory",
+			Handler:       _Subscription_ExportSubscriptionHistory_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "subscription.proto",
+}
+
+const (
+	SubscriptionAdmin_ListJobs_FullMethodName    = "/subscription.v1.SubscriptionAdmin/ListJobs"
+	SubscriptionAdmin_TriggerJob_FullMethodName  = "/subscription.v1.SubscriptionAdmin/TriggerJob"
+	SubscriptionAdmin_PauseJob_FullMethodName    = "/subscription.v1.SubscriptionAdmin/PauseJob"
+	SubscriptionAdmin_ResumeJob_FullMethodName   = "/subscription.v1.SubscriptionAdmin/ResumeJob"
+	SubscriptionAdmin_ListJobRuns_FullMethodName = "/subscription.v1.SubscriptionAdmin/ListJobRuns"
+)
+
+// SubscriptionAdminClient is the client API for SubscriptionAdmin service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// 定时任务管理服务（由 Cron 服务提供，使用独立的管理端口）
+type SubscriptionAdminClient interface {
+	// 获取已注册的定时任务及其计划、暂停状态、下次执行时间和最近一次执行
+	ListJobs(ctx context.Context, in *ListJobsRequest, opts ...grpc.CallOption) (*ListJobsReply, error)
+	// 立即执行定时任务（后台执行，返回执行记录）；dryRun 为 true 时只统计将要处理的数据，不修改数据
+	TriggerJob(ctx context.Context, in *TriggerJobRequest, opts ...grpc.CallOption) (*TriggerJobReply, error)
+	// 暂停定时任务的计划执行（手动触发不受影响）
+	PauseJob(ctx context.Context, in *PauseJobRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// 恢复定时任务的计划执行
+	ResumeJob(ctx context.Context, in *ResumeJobRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// 分页获取定时任务执行记录（按开始时间倒序）
+	ListJobRuns(ctx context.Context, in *ListJobRunsRequest, opts ...grpc.CallOption) (*ListJobRunsReply, error)
+}
+
+type subscriptionAdminClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSubscriptionAdminClient(cc grpc.ClientConnInterface) SubscriptionAdminClient {
+	return &subscriptionAdminClient{cc}
+}
+
+func (c *subscriptionAdminClient) ListJobs(ctx context.Context, in *ListJobsRequest, opts ...grpc.CallOption) (*ListJobsReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListJobsReply)
+	err := c.cc.Invoke(ctx, SubscriptionAdmin_ListJobs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *subscriptionAdminClient) TriggerJob(ctx context.Context, in *TriggerJobRequest, opts ...grpc.CallOption) (*TriggerJobReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TriggerJobReply)
+	err := c.cc.Invoke(ctx, SubscriptionAdmin_TriggerJob_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *subscriptionAdminClient) PauseJob(ctx context.Context, in *PauseJobRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, SubscriptionAdmin_PauseJob_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *subscriptionAdminClient) ResumeJob(ctx context.Context, in *ResumeJobRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, SubscriptionAdmin_ResumeJob_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *subscriptionAdminClient) ListJobRuns(ctx context.Context, in *ListJobRunsRequest, opts ...grpc.CallOption) (*ListJobRunsReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListJobRunsReply)
+	err := c.cc.Invoke(ctx, SubscriptionAdmin_ListJobRuns_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SubscriptionAdminServer is the server API for SubscriptionAdmin service.
+// All implementations must embed UnimplementedSubscriptionAdminServer
+// for forward compatibility.
+//
+// 定时任务管理服务（由 Cron 服务提供，使用独立的管理端口）
+type SubscriptionAdminServer interface {
+	// 获取已注册的定时任务及其计划、暂停状态、下次执行时间和最近一次执行
+	ListJobs(context.Context, *ListJobsRequest) (*ListJobsReply, error)
+	// 立即执行定时任务（后台执行，返回执行记录）；dryRun 为 true 时只统计将要处理的数据，不修改数据
+	TriggerJob(context.Context, *TriggerJobRequest) (*TriggerJobReply, error)
+	// 暂停定时任务的计划执行（手动触发不受影响）
+	PauseJob(context.Context, *PauseJobRequest) (*emptypb.Empty, error)
+	// 恢复定时任务的计划执行
+	ResumeJob(context.Context, *ResumeJobRequest) (*emptypb.Empty, error)
+	// 分页获取定时任务执行记录（按开始时间倒序）
+	ListJobRuns(context.Context, *ListJobRunsRequest) (*ListJobRunsReply, error)
+	mustEmbedUnimplementedSubscriptionAdminServer()
+}
+
+// UnimplementedSubscriptionAdminServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedSubscriptionAdminServer struct{}
+
+func (UnimplementedSubscriptionAdminServer) ListJobs(context.Context, *ListJobsRequest) (*ListJobsReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListJobs not implemented")
+}
+func (UnimplementedSubscriptionAdminServer) TriggerJob(context.Context, *TriggerJobRequest) (*TriggerJobReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method TriggerJob not implemented")
+}
+func (UnimplementedSubscriptionAdminServer) PauseJob(context.Context, *PauseJobRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method PauseJob not implemented")
+}
+func (UnimplementedSubscriptionAdminServer) ResumeJob(context.Context, *ResumeJobRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method ResumeJob not implemented")
+}
+func (UnimplementedSubscriptionAdminServer) ListJobRuns(context.Context, *ListJobRunsRequest) (*ListJobRunsReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListJobRuns not implemented")
+}
+func (UnimplementedSubscriptionAdminServer) mustEmbedUnimplementedSubscriptionAdminServer() {}
+func (UnimplementedSubscriptionAdminServer) testEmbeddedByValue()                           {}
+
+// UnsafeSubscriptionAdminServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SubscriptionAdminServer will
+// result in compilation errors.
+type UnsafeSubscriptionAdminServer interface {
+	mustEmbedUnimplementedSubscriptionAdminServer()
+}
+
+func RegisterSubscriptionAdminServer(s grpc.ServiceRegistrar, srv SubscriptionAdminServer) {
+	// If the following call panics, it indicates UnimplementedSubscriptionAdminServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&SubscriptionAdmin_ServiceDesc, srv)
+}
+
+func _SubscriptionAdmin_ListJobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListJobsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubscriptionAdminServer).ListJobs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SubscriptionAdmin_ListJobs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubscriptionAdminServer).ListJobs(ctx, req.(*ListJobsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SubscriptionAdmin_TriggerJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TriggerJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubscriptionAdminServer).TriggerJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SubscriptionAdmin_TriggerJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubscriptionAdminServer).TriggerJob(ctx, req.(*TriggerJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SubscriptionAdmin_PauseJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PauseJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubscriptionAdminServer).PauseJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SubscriptionAdmin_PauseJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubscriptionAdminServer).PauseJob(ctx, req.(*PauseJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SubscriptionAdmin_ResumeJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResumeJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubscriptionAdminServer).ResumeJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SubscriptionAdmin_ResumeJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubscriptionAdminServer).ResumeJob(ctx, req.(*ResumeJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SubscriptionAdmin_ListJobRuns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListJobRunsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubscriptionAdminServer).ListJobRuns(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SubscriptionAdmin_ListJobRuns_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubscriptionAdminServer).ListJobRuns(ctx, req.(*ListJobRunsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// SubscriptionAdmin_ServiceDesc is the grpc.ServiceDesc for SubscriptionAdmin service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var SubscriptionAdmin_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "subscription.v1.SubscriptionAdmin",
+	HandlerType: (*SubscriptionAdminServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListJobs",
+			Handler:    _SubscriptionAdmin_ListJobs_Handler,
+		},
+		{
+			MethodName: "TriggerJob",
+			Handler:    _SubscriptionAdmin_TriggerJob_Handler,
+		},
+		{
+			MethodName: "PauseJob",
+			Handler:    _SubscriptionAdmin_PauseJob_Handler,
+		},
+		{
+			MethodName: "ResumeJob",
+			Handler:    _SubscriptionAdmin_ResumeJob_Handler,
+		},
+		{
+			MethodName: "ListJobRuns",
+			Handler:    _SubscriptionAdmin_ListJobRuns_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "subscription.proto",
+}
+
+const (
+	SubscriptionSupport_SearchSubscriptions_FullMethodName = "/subscription.v1.SubscriptionSupport/SearchSubscriptions"
+	SubscriptionSupport_GetUserTimeline_FullMethodName     = "/subscription.v1.SubscriptionSupport/GetUserTimeline"
+	SubscriptionSupport_AdjustSubscription_FullMethodName  = "/subscription.v1.SubscriptionSupport/AdjustSubscription"
+	SubscriptionSupport_GrantSubscription_FullMethodName   = "/subscription.v1.SubscriptionSupport/GrantSubscription"
+	SubscriptionSupport_CreateBulkOperation_FullMethodName = "/subscription.v1.SubscriptionSupport/CreateBulkOperation"
+	SubscriptionSupport_GetBulkOperation_FullMethodName    = "/subscription.v1.SubscriptionSupport/GetBulkOperation"
+	SubscriptionSupport_ListBulkOperations_FullMethodName  = "/subscription.v1.SubscriptionSupport/ListBulkOperations"
+	SubscriptionSupport_CancelBulkOperation_FullMethodName = "/subscription.v1.SubscriptionSupport/CancelBulkOperation"
+)
+
+// SubscriptionSupportClient is the client API for SubscriptionSupport service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// 客服支持服务（仅管理员可用，修改操作记录操作人和原因）
+type SubscriptionSupportClient interface {
+	// 按用户、应用、套餐、状态和时间范围查询订阅
+	SearchSubscriptions(ctx context.Context, in *SearchSubscriptionsRequest, opts ...grpc.CallOption) (*SearchSubscriptionsReply, error)
+	// 查看用户的当前订阅、订单（含支付和退款信息）、订阅历史和合并后的时间线
+	GetUserTimeline(ctx context.Context, in *GetUserTimelineRequest, opts ...grpc.CallOption) (*GetUserTimelineReply, error)
+	// 延长（days > 0）或缩短（days < 0）用户订阅
+	AdjustSubscription(ctx context.Context, in *AdjustSubscriptionRequest, opts ...grpc.CallOption) (*AdjustSubscriptionReply, error)
+	// 不经过支付开通或延长用户订阅（赠送或手动开通），可选创建零金额记账订单；不计入收入
+	GrantSubscription(ctx context.Context, in *GrantSubscriptionRequest, opts ...grpc.CallOption) (*GrantSubscriptionReply, error)
+	// 创建批量操作（按应用、套餐、状态筛选订阅，批量延长或在下次续费时迁移套餐）；dryRun 只返回匹配的订阅数
+	CreateBulkOperation(ctx context.Context, in *CreateBulkOperationRequest, opts ...grpc.CallOption) (*CreateBulkOperationReply, error)
+	// 查询批量操作的执行进度
+	GetBulkOperation(ctx context.Context, in *GetBulkOperationRequest, opts ...grpc.CallOption) (*GetBulkOperationReply, error)
+	// 分页查询批量操作
+	ListBulkOperations(ctx context.Context, in *ListBulkOperationsRequest, opts ...grpc.CallOption) (*ListBulkOperationsReply, error)
+	// 取消未结束的批量操作（已处理的用户不回滚）
+	CancelBulkOperation(ctx context.Context, in *CancelBulkOperationRequest, opts ...grpc.CallOption) (*CancelBulkOperationReply, error)
+}
+
+type subscriptionSupportClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSubscriptionSupportClient(cc grpc.ClientConnInterface) SubscriptionSupportClient {
+	return &subscriptionSupportClient{cc}
+}
+
+func (c *subscriptionSupportClient) SearchSubscriptions(ctx context.Context, in *SearchSubscriptionsRequest, opts ...grpc.CallOption) (*SearchSubscriptionsReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchSubscriptionsReply)
+	err := c.cc.Invoke(ctx, SubscriptionSupport_SearchSubscriptions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *subscriptionSupportClient) GetUserTimeline(ctx context.Context, in *GetUserTimelineRequest, opts ...grpc.CallOption) (*GetUserTimelineReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetUserTimelineReply)
+	err := c.cc.Invoke(ctx, SubscriptionSupport_GetUserTimeline_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *subscriptionSupportClient) AdjustSubscription(ctx context.Context, in *AdjustSubscriptionRequest, opts ...grpc.CallOption) (*AdjustSubscriptionReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AdjustSubscriptionReply)
+	err := c.cc.Invoke(ctx, SubscriptionSupport_AdjustSubscription_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *subscriptionSupportClient) GrantSubscription(ctx context.Context, in *GrantSubscriptionRequest, opts ...grpc.CallOption) (*GrantSubscriptionReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GrantSubscriptionReply)
+	err := c.cc.Invoke(ctx, SubscriptionSupport_GrantSubscription_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *subscriptionSupportClient) CreateBulkOperation(ctx context.Context, in *CreateBulkOperationRequest, opts ...grpc.CallOption) (*CreateBulkOperationReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateBulkOperationReply)
+	err := c.cc.Invoke(ctx, SubscriptionSupport_CreateBulkOperation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *subscriptionSupportClient) GetBulkOperation(ctx context.Context, in *GetBulkOperationRequest, opts ...grpc.CallOption) (*GetBulkOperationReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetBulkOperationReply)
+	err := c.cc.Invoke(ctx, SubscriptionSupport_GetBulkOperation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *subscriptionSupportClient) ListBulkOperations(ctx context.Context, in *ListBulkOperationsRequest, opts ...grpc.CallOption) (*ListBulkOperationsReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListBulkOperationsReply)
+	err := c.cc.Invoke(ctx, SubscriptionSupport_ListBulkOperations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *subscriptionSupportClient) CancelBulkOperation(ctx context.Context, in *CancelBulkOperationRequest, opts ...grpc.CallOption) (*CancelBulkOperationReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CancelBulkOperationReply)
+	err := c.cc.Invoke(ctx, SubscriptionSupport_CancelBulkOperation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SubscriptionSupportServer is the server API for SubscriptionSupport service.
+// All implementations must embed UnimplementedSubscriptionSupportServer
+// for forward compatibility.
+//
+// 客服支持服务（仅管理员可用，修改操作记录操作人和原因）
+type SubscriptionSupportServer interface {
+	// 按用户、应用、套餐、状态和时间范围查询订阅
+	SearchSubscriptions(context.Context, *SearchSubscriptionsRequest) (*SearchSubscriptionsReply, error)
+	// 查看用户的当前订阅、订单（含支付和退款信息）、订阅历史和合并后的时间线
+	GetUserTimeline(context.Context, *GetUserTimelineRequest) (*GetUserTimelineReply, error)
+	// 延长（days > 0）或缩短（days < 0）用户订阅
+	AdjustSubscription(context.Context, *AdjustSubscriptionRequest) (*AdjustSubscriptionReply, error)
+	// 不经过支付开通或延长用户订阅（赠送或手动开通），可选创建零金额记账订单；不计入收入
+	GrantSubscription(context.Context, *GrantSubscriptionRequest) (*GrantSubscriptionReply, error)
+	// 创建批量操作（按应用、套餐、状态筛选订阅，批量延长或在下次续费时迁移套餐）；dryRun 只返回匹配的订阅数
+	CreateBulkOperation(context.Context, *CreateBulkOperationRequest) (*CreateBulkOperationReply, error)
+	// 查询批量操作的执行进度
+	GetBulkOperation(context.Context, *GetBulkOperationRequest) (*GetBulkOperationReply, error)
+	// 分页查询批量操作
+	ListBulkOperations(context.Context, *ListBulkOperationsRequest) (*ListBulkOperationsReply, error)
+	// 取消未结束的批量操作（已处理的用户不回滚）
+	CancelBulkOperation(context.Context, *CancelBulkOperationRequest) (*CancelBulkOperationReply, error)
+	mustEmbedUnimplementedSubscriptionSupportServer()
+}
+
+// UnimplementedSubscriptionSupportServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedSubscriptionSupportServer struct{}
+
+func (UnimplementedSubscriptionSupportServer) SearchSubscriptions(context.Context, *SearchSubscriptionsRequest) (*SearchSubscriptionsReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method SearchSubscriptions not implemented")
+}
+func (UnimplementedSubscriptionSupportServer) GetUserTimeline(context.Context, *GetUserTimelineRequest) (*GetUserTimelineReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetUserTimeline not implemented")
+}
+func (UnimplementedSubscriptionSupportServer) AdjustSubscription(context.Context, *AdjustSubscriptionRequest) (*AdjustSubscriptionReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method AdjustSubscription not implemented")
+}
+func (UnimplementedSubscriptionSupportServer) GrantSubscription(context.Context, *GrantSubscriptionRequest) (*GrantSubscriptionReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method GrantSubscription not implemented")
+}
+func (UnimplementedSubscriptionSupportServer) CreateBulkOperation(context.Context, *CreateBulkOperationRequest) (*CreateBulkOperationReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateBulkOperation not implemented")
+}
+func (UnimplementedSubscriptionSupportServer) GetBulkOperation(context.Context, *GetBulkOperationRequest) (*GetBulkOperationReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetBulkOperation not implemented")
+}
+func (UnimplementedSubscriptionSupportServer) ListBulkOperations(context.Context, *ListBulkOperationsRequest) (*ListBulkOperationsReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListBulkOperations not implemented")
+}
+func (UnimplementedSubscriptionSupportServer) CancelBulkOperation(context.Context, *CancelBulkOperationRequest) (*CancelBulkOperationReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method CancelBulkOperation not implemented")
+}
+func (UnimplementedSubscriptionSupportServer) mustEmbedUnimplementedSubscriptionSupportServer() {}
+func (UnimplementedSubscriptionSupportServer) testEmbeddedByValue()                             {}
+
+// UnsafeSubscriptionSupportServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SubscriptionSupportServer will
+// result in compilation errors.
+type UnsafeSubscriptionSupportServer interface {
+	mustEmbedUnimplementedSubscriptionSupportServer()
+}
+
+func RegisterSubscriptionSupportServer(s grpc.ServiceRegistrar, srv SubscriptionSupportServer) {
+	// If the following call panics, it indicates UnimplementedSubscriptionSupportServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&SubscriptionSupport_ServiceDesc, srv)
+}
+
+func _SubscriptionSupport_SearchSubscriptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchSubscriptionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubscriptionSupportServer).SearchSubscriptions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SubscriptionSupport_SearchSubscriptions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubscriptionSupportServer).SearchSubscriptions(ctx, req.(*SearchSubscriptionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SubscriptionSupport_GetUserTimeline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserTimelineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubscriptionSupportServer).GetUserTimeline(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SubscriptionSupport_GetUserTimeline_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubscriptionSupportServer).GetUserTimeline(ctx, req.(*GetUserTimelineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SubscriptionSupport_AdjustSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdjustSubscriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubscriptionSupportServer).AdjustSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SubscriptionSupport_AdjustSubscription_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubscriptionSupportServer).AdjustSubscription(ctx, req.(*AdjustSubscriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SubscriptionSupport_GrantSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GrantSubscriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubscriptionSupportServer).GrantSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SubscriptionSupport_GrantSubscription_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubscriptionSupportServer).GrantSubscription(ctx, req.(*GrantSubscriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SubscriptionSupport_CreateBulkOperation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateBulkOperationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubscriptionSupportServer).CreateBulkOperation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SubscriptionSupport_CreateBulkOperation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubscriptionSupportServer).CreateBulkOperation(ctx, req.(*CreateBulkOperationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SubscriptionSupport_GetBulkOperation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBulkOperationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubscriptionSupportServer).GetBulkOperation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SubscriptionSupport_GetBulkOperation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubscriptionSupportServer).GetBulkOperation(ctx, req.(*GetBulkOperationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SubscriptionSupport_ListBulkOperations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBulkOperationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubscriptionSupportServer).ListBulkOperations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SubscriptionSupport_ListBulkOperations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubscriptionSupportServer).ListBulkOperations(ctx, req.(*ListBulkOperationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SubscriptionSupport_CancelBulkOperation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelBulkOperationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubscriptionSupportServer).CancelBulkOperation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SubscriptionSupport_CancelBulkOperation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubscriptionSupportServer).CancelBulkOperation(ctx, req.(*CancelBulkOperationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// SubscriptionSupport_ServiceDesc is the grpc.ServiceDesc for SubscriptionSupport service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var SubscriptionSupport_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "subscription.v1.SubscriptionSupport",
+	HandlerType: (*SubscriptionSupportServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SearchSubscriptions",
+			Handler:    _SubscriptionSupport_SearchSubscriptions_Handler,
+		},
+		{
+			MethodName: "GetUserTimeline",
+			Handler:    _SubscriptionSupport_GetUserTimeline_Handler,
+		},
+		{
+			MethodName: "AdjustSubscription",
+			Handler:    _SubscriptionSupport_AdjustSubscription_Handler,
+		},
+		{
+			MethodName: "GrantSubscription",
+			Handler:    _SubscriptionSupport_GrantSubscription_Handler,
+		},
+		{
+			MethodName: "CreateBulkOperation",
+			Handler:    _SubscriptionSupport_CreateBulkOperation_Handler,
+		},
+		{
+			MethodName: "GetBulkOperation",
+			Handler:    _SubscriptionSupport_GetBulkOperation_Handler,
+		},
+		{
+			MethodName: "ListBulkOperations",
+			Handler:    _SubscriptionSupport_ListBulkOperations_Handler,
+		},
+		{
+			MethodName: "CancelBulkOperation",
+			Handler:    _SubscriptionSupport_CancelBulkOperation_Handler,
 		},
 	},
 	Streams:  []grpc.StreamDesc{},
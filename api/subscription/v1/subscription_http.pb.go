@@ -24,21 +24,46 @@ const OperationSubscriptionCancelSubscription = "/subscription.v1.Subscription/C
 const OperationSubscriptionCreatePlan = "/subscription.v1.Subscription/CreatePlan"
 const OperationSubscriptionCreatePlanPricing = "/subscription.v1.Subscription/CreatePlanPricing"
 const OperationSubscriptionCreateSubscriptionOrder = "/subscription.v1.Subscription/CreateSubscriptionOrder"
+const OperationSubscriptionCreateTaxRule = "/subscription.v1.Subscription/CreateTaxRule"
 const OperationSubscriptionDeletePlan = "/subscription.v1.Subscription/DeletePlan"
 const OperationSubscriptionDeletePlanPricing = "/subscription.v1.Subscription/DeletePlanPricing"
+const OperationSubscriptionDeleteRegionGroup = "/subscription.v1.Subscription/DeleteRegionGroup"
+const OperationSubscriptionDeleteTaxRule = "/subscription.v1.Subscription/DeleteTaxRule"
+const OperationSubscriptionGenerateMetricSnapshots = "/subscription.v1.Subscription/GenerateMetricSnapshots"
+const OperationSubscriptionGetAppSetting = "/subscription.v1.Subscription/GetAppSetting"
+const OperationSubscriptionGetCohortReport = "/subscription.v1.Subscription/GetCohortReport"
 const OperationSubscriptionGetExpiringSubscriptions = "/subscription.v1.Subscription/GetExpiringSubscriptions"
+const OperationSubscriptionGetInvoice = "/subscription.v1.Subscription/GetInvoice"
 const OperationSubscriptionGetMySubscription = "/subscription.v1.Subscription/GetMySubscription"
+const OperationSubscriptionGetPlanLTVReport = "/subscription.v1.Subscription/GetPlanLTVReport"
+const OperationSubscriptionGetRegionGroup = "/subscription.v1.Subscription/GetRegionGroup"
+const OperationSubscriptionGetRevenueReport = "/subscription.v1.Subscription/GetRevenueReport"
 const OperationSubscriptionGetSubscriptionHistory = "/subscription.v1.Subscription/GetSubscriptionHistory"
+const OperationSubscriptionGetSubscriptionMetrics = "/subscription.v1.Subscription/GetSubscriptionMetrics"
+const OperationSubscriptionHandlePaymentRefund = "/subscription.v1.Subscription/HandlePaymentRefund"
 const OperationSubscriptionHandlePaymentSuccess = "/subscription.v1.Subscription/HandlePaymentSuccess"
+const OperationSubscriptionImportExchangeRates = "/subscription.v1.Subscription/ImportExchangeRates"
+const OperationSubscriptionListAppSubscriptionHistory = "/subscription.v1.Subscription/ListAppSubscriptionHistory"
+const OperationSubscriptionListExchangeRates = "/subscription.v1.Subscription/ListExchangeRates"
+const OperationSubscriptionListInvoices = "/subscription.v1.Subscription/ListInvoices"
+const OperationSubscriptionListMetricSnapshots = "/subscription.v1.Subscription/ListMetricSnapshots"
 const OperationSubscriptionListPlanPricings = "/subscription.v1.Subscription/ListPlanPricings"
 const OperationSubscriptionListPlans = "/subscription.v1.Subscription/ListPlans"
+const OperationSubscriptionListRegionGroups = "/subscription.v1.Subscription/ListRegionGroups"
+const OperationSubscriptionListTaxRules = "/subscription.v1.Subscription/ListTaxRules"
 const OperationSubscriptionPauseSubscription = "/subscription.v1.Subscription/PauseSubscription"
 const OperationSubscriptionProcessAutoRenewals = "/subscription.v1.Subscription/ProcessAutoRenewals"
+const OperationSubscriptionProcessPriceChangeNotices = "/subscription.v1.Subscription/ProcessPriceChangeNotices"
+const OperationSubscriptionQuoteSubscription = "/subscription.v1.Subscription/QuoteSubscription"
 const OperationSubscriptionResumeSubscription = "/subscription.v1.Subscription/ResumeSubscription"
+const OperationSubscriptionSaveExchangeRates = "/subscription.v1.Subscription/SaveExchangeRates"
+const OperationSubscriptionSaveRegionGroup = "/subscription.v1.Subscription/SaveRegionGroup"
 const OperationSubscriptionSetAutoRenew = "/subscription.v1.Subscription/SetAutoRenew"
+const OperationSubscriptionUpdateAppSetting = "/subscription.v1.Subscription/UpdateAppSetting"
 const OperationSubscriptionUpdateExpiredSubscriptions = "/subscription.v1.Subscription/UpdateExpiredSubscriptions"
 const OperationSubscriptionUpdatePlan = "/subscription.v1.Subscription/UpdatePlan"
 const OperationSubscriptionUpdatePlanPricing = "/subscription.v1.Subscription/UpdatePlanPricing"
+const OperationSubscriptionUpdateTaxRule = "/subscription.v1.Subscription/UpdateTaxRule"
 
 type SubscriptionHTTPServer interface {
 	// CancelSubscription 取消订阅
@@ -49,52 +74,106 @@ type SubscriptionHTTPServer interface {
 	CreatePlanPricing(context.Context, *CreatePlanPricingRequest) (*CreatePlanPricingReply, error)
 	// CreateSubscriptionOrder 创建订阅订单 (调用 Payment Service)
 	CreateSubscriptionOrder(context.Context, *CreateSubscriptionOrderRequest) (*CreateSubscriptionOrderReply, error)
+	// CreateTaxRule 创建税务规则
+	CreateTaxRule(context.Context, *CreateTaxRuleRequest) (*CreateTaxRuleReply, error)
 	// DeletePlan 删除订阅套餐
 	DeletePlan(context.Context, *DeletePlanRequest) (*DeletePlanReply, error)
 	// DeletePlanPricing 删除区域定价
 	DeletePlanPricing(context.Context, *DeletePlanPricingRequest) (*DeletePlanPricingReply, error)
+	// DeleteRegionGroup 删除地区组
+	DeleteRegionGroup(context.Context, *DeleteRegionGroupRequest) (*DeleteRegionGroupReply, error)
+	// DeleteTaxRule 删除税务规则
+	DeleteTaxRule(context.Context, *DeleteTaxRuleRequest) (*DeleteTaxRuleReply, error)
+	// GenerateMetricSnapshots 生成订阅指标日快照（Cron 每天自动生成前一天的快照，可用于补生成）
+	GenerateMetricSnapshots(context.Context, *GenerateMetricSnapshotsRequest) (*GenerateMetricSnapshotsReply, error)
+	// GetAppSetting 获取应用订阅配置
+	GetAppSetting(context.Context, *GetAppSettingRequest) (*GetAppSettingReply, error)
+	// GetCohortReport 获取当前应用的月度同期群留存报表（format 为 csv 时同时返回 CSV 内容）
+	GetCohortReport(context.Context, *GetCohortReportRequest) (*GetCohortReportReply, error)
 	// GetExpiringSubscriptions 获取即将过期的订阅（用于定时任务）
 	GetExpiringSubscriptions(context.Context, *GetExpiringSubscriptionsRequest) (*GetExpiringSubscriptionsReply, error)
+	// GetInvoice 获取发票或红字发票（format 为 html 或 pdf 时同时返回渲染后的内容）
+	GetInvoice(context.Context, *GetInvoiceRequest) (*GetInvoiceReply, error)
 	// GetMySubscription 获取用户的订阅状态
 	GetMySubscription(context.Context, *GetMySubscriptionRequest) (*GetMySubscriptionReply, error)
-	// GetSubscriptionHistory 获取订阅历史记录
+	// GetPlanLTVReport 获取当前应用各套餐的 LTV 估算（format 为 csv 时同时返回 CSV 内容）
+	GetPlanLTVReport(context.Context, *GetPlanLTVReportRequest) (*GetPlanLTVReportReply, error)
+	// GetRegionGroup 获取地区组
+	GetRegionGroup(context.Context, *GetRegionGroupRequest) (*GetRegionGroupReply, error)
+	// GetRevenueReport 获取当前应用的收入报表（按应用报表币种折算）
+	GetRevenueReport(context.Context, *GetRevenueReportRequest) (*GetRevenueReportReply, error)
+	// GetSubscriptionHistory 获取订阅历史记录（支持按操作类型、套餐、应用和时间范围筛选，按页码或游标分页）
 	GetSubscriptionHistory(context.Context, *GetSubscriptionHistoryRequest) (*GetSubscriptionHistoryReply, error)
+	// GetSubscriptionMetrics 获取当前应用的订阅指标汇总（活跃订阅数、MRR/ARR、新增/流失/回流、流失率和留存率）
+	GetSubscriptionMetrics(context.Context, *GetSubscriptionMetricsRequest) (*GetSubscriptionMetricsReply, error)
+	// HandlePaymentRefund 退款回调处理 (全额退款时结束订阅，并回落到应用的默认免费套餐)
+	HandlePaymentRefund(context.Context, *HandlePaymentRefundRequest) (*emptypb.Empty, error)
 	// HandlePaymentSuccess 支付回调处理 (通常由 Payment Service 或 MQ 调用)
 	HandlePaymentSuccess(context.Context, *HandlePaymentSuccessRequest) (*emptypb.Empty, error)
+	// ImportExchangeRates 导入汇率（CSV 内容为空时从配置的本地汇率文件导入）
+	ImportExchangeRates(context.Context, *ImportExchangeRatesRequest) (*ImportExchangeRatesReply, error)
+	// ListAppSubscriptionHistory 获取当前应用所有用户的订阅历史记录（游标分页）
+	ListAppSubscriptionHistory(context.Context, *ListAppSubscriptionHistoryRequest) (*ListAppSubscriptionHistoryReply, error)
+	// ListExchangeRates 获取汇率列表（按生效时间倒序）
+	ListExchangeRates(context.Context, *ListExchangeRatesRequest) (*ListExchangeRatesReply, error)
+	// ListInvoices 获取用户的发票列表（包含红字发票）
+	ListInvoices(context.Context, *ListInvoicesRequest) (*ListInvoicesReply, error)
+	// ListMetricSnapshots 获取当前应用的订阅指标日快照
+	ListMetricSnapshots(context.Context, *ListMetricSnapshotsRequest) (*ListMetricSnapshotsReply, error)
 	// ListPlanPricings 获取套餐的区域定价列表
 	ListPlanPricings(context.Context, *ListPlanPricingsRequest) (*ListPlanPricingsReply, error)
 	// ListPlans 获取所有订阅套餐
 	ListPlans(context.Context, *ListPlansRequest) (*ListPlansReply, error)
+	// ListRegionGroups 获取地区组列表
+	ListRegionGroups(context.Context, *ListRegionGroupsRequest) (*ListRegionGroupsReply, error)
+	// ListTaxRules 获取税务规则列表
+	ListTaxRules(context.Context, *ListTaxRulesRequest) (*ListTaxRulesReply, error)
 	// PauseSubscription 暂停订阅
 	PauseSubscription(context.Context, *PauseSubscriptionRequest) (*emptypb.Empty, error)
 	// ProcessAutoRenewals 处理自动续费（用于定时任务）
 	ProcessAutoRenewals(context.Context, *ProcessAutoRenewalsRequest) (*ProcessAutoRenewalsReply, error)
+	// ProcessPriceChangeNotices 发送续费调价通知 (系统内部调用)
+	ProcessPriceChangeNotices(context.Context, *ProcessPriceChangeNoticesRequest) (*ProcessPriceChangeNoticesReply, error)
+	// QuoteSubscription 获取订阅报价（结算预览，不创建订单）
+	QuoteSubscription(context.Context, *QuoteSubscriptionRequest) (*QuoteSubscriptionReply, error)
 	// ResumeSubscription 恢复订阅
 	ResumeSubscription(context.Context, *ResumeSubscriptionRequest) (*emptypb.Empty, error)
+	// SaveExchangeRates 保存汇率（币种对和生效时间相同时覆盖）
+	SaveExchangeRates(context.Context, *SaveExchangeRatesRequest) (*SaveExchangeRatesReply, error)
+	// SaveRegionGroup 创建或更新地区组（整体替换成员国家）
+	SaveRegionGroup(context.Context, *SaveRegionGroupRequest) (*SaveRegionGroupReply, error)
 	// SetAutoRenew 设置自动续费
 	SetAutoRenew(context.Context, *SetAutoRenewRequest) (*emptypb.Empty, error)
+	// UpdateAppSetting 更新应用订阅配置（如默认免费套餐）
+	UpdateAppSetting(context.Context, *UpdateAppSettingRequest) (*UpdateAppSettingReply, error)
 	// UpdateExpiredSubscriptions 批量更新过期订阅状态（用于定时任务）
 	UpdateExpiredSubscriptions(context.Context, *UpdateExpiredSubscriptionsRequest) (*UpdateExpiredSubscriptionsReply, error)
 	// UpdatePlan 更新订阅套餐
 	UpdatePlan(context.Context, *UpdatePlanRequest) (*UpdatePlanReply, error)
 	// UpdatePlanPricing 更新区域定价
 	UpdatePlanPricing(context.Context, *UpdatePlanPricingRequest) (*UpdatePlanPricingReply, error)
+	// UpdateTaxRule 更新税务规则（只影响之后的报价和订单）
+	UpdateTaxRule(context.Context, *UpdateTaxRuleRequest) (*UpdateTaxRuleReply, error)
 }
 
 func RegisterSubscriptionHTTPServer(s *http.Server, srv SubscriptionHTTPServer) {
 	r := s.Route("/")
 	r.GET("/v1/subscription/plans", _Subscription_ListPlans0_HTTP_Handler(srv))
 	r.GET("/v1/subscription/my/{uid}", _Subscription_GetMySubscription0_HTTP_Handler(srv))
+	r.POST("/v1/subscription/quote", _Subscription_QuoteSubscription0_HTTP_Handler(srv))
 	r.POST("/v1/subscription/order", _Subscription_CreateSubscriptionOrder0_HTTP_Handler(srv))
 	r.POST("/v1/subscription/payment/success", _Subscription_HandlePaymentSuccess0_HTTP_Handler(srv))
+	r.POST("/v1/subscription/payment/refund", _Subscription_HandlePaymentRefund0_HTTP_Handler(srv))
 	r.POST("/v1/subscription/cancel", _Subscription_CancelSubscription0_HTTP_Handler(srv))
 	r.POST("/v1/subscription/pause", _Subscription_PauseSubscription0_HTTP_Handler(srv))
 	r.POST("/v1/subscription/resume", _Subscription_ResumeSubscription0_HTTP_Handler(srv))
 	r.GET("/v1/subscription/history/{uid}", _Subscription_GetSubscriptionHistory0_HTTP_Handler(srv))
+	r.GET("/v1/subscription/app-history", _Subscription_ListAppSubscriptionHistory0_HTTP_Handler(srv))
 	r.POST("/v1/subscription/auto-renew", _Subscription_SetAutoRenew0_HTTP_Handler(srv))
 	r.GET("/v1/subscription/expiring", _Subscription_GetExpiringSubscriptions0_HTTP_Handler(srv))
 	r.POST("/v1/subscription/expired/update", _Subscription_UpdateExpiredSubscriptions0_HTTP_Handler(srv))
 	r.POST("/v1/subscription/auto-renew/process", _Subscription_ProcessAutoRenewals0_HTTP_Handler(srv))
+	r.POST("/v1/subscription/pricings/notices/process", _Subscription_ProcessPriceChangeNotices0_HTTP_Handler(srv))
 	r.POST("/v1/subscription/plans", _Subscription_CreatePlan0_HTTP_Handler(srv))
 	r.PUT("/v1/subscription/plans/{planId}", _Subscription_UpdatePlan0_HTTP_Handler(srv))
 	r.DELETE("/v1/subscription/plans/{planId}", _Subscription_DeletePlan0_HTTP_Handler(srv))
@@ -102,6 +181,27 @@ func RegisterSubscriptionHTTPServer(s *http.Server, srv SubscriptionHTTPServer)
 	r.POST("/v1/subscription/plans/{planId}/pricings", _Subscription_CreatePlanPricing0_HTTP_Handler(srv))
 	r.PUT("/v1/subscription/pricings/{planPricingId}", _Subscription_UpdatePlanPricing0_HTTP_Handler(srv))
 	r.DELETE("/v1/subscription/pricings/{planPricingId}", _Subscription_DeletePlanPricing0_HTTP_Handler(srv))
+	r.GET("/v1/subscription/app-setting", _Subscription_GetAppSetting0_HTTP_Handler(srv))
+	r.PUT("/v1/subscription/app-setting", _Subscription_UpdateAppSetting0_HTTP_Handler(srv))
+	r.GET("/v1/subscription/region-groups", _Subscription_ListRegionGroups0_HTTP_Handler(srv))
+	r.GET("/v1/subscription/region-groups/{groupCode}", _Subscription_GetRegionGroup0_HTTP_Handler(srv))
+	r.PUT("/v1/subscription/region-groups/{groupCode}", _Subscription_SaveRegionGroup0_HTTP_Handler(srv))
+	r.DELETE("/v1/subscription/region-groups/{groupCode}", _Subscription_DeleteRegionGroup0_HTTP_Handler(srv))
+	r.GET("/v1/subscription/invoices/{invoiceId}", _Subscription_GetInvoice0_HTTP_Handler(srv))
+	r.GET("/v1/subscription/invoices", _Subscription_ListInvoices0_HTTP_Handler(srv))
+	r.GET("/v1/subscription/tax-rules", _Subscription_ListTaxRules0_HTTP_Handler(srv))
+	r.POST("/v1/subscription/tax-rules", _Subscription_CreateTaxRule0_HTTP_Handler(srv))
+	r.PUT("/v1/subscription/tax-rules/{taxRuleId}", _Subscription_UpdateTaxRule0_HTTP_Handler(srv))
+	r.DELETE("/v1/subscription/tax-rules/{taxRuleId}", _Subscription_DeleteTaxRule0_HTTP_Handler(srv))
+	r.GET("/v1/subscription/exchange-rates", _Subscription_ListExchangeRates0_HTTP_Handler(srv))
+	r.POST("/v1/subscription/exchange-rates", _Subscription_SaveExchangeRates0_HTTP_Handler(srv))
+	r.POST("/v1/subscription/exchange-rates/import", _Subscription_ImportExchangeRates0_HTTP_Handler(srv))
+	r.GET("/v1/subscription/reports/revenue", _Subscription_GetRevenueReport0_HTTP_Handler(srv))
+	r.GET("/v1/subscription/metrics", _Subscription_GetSubscriptionMetrics0_HTTP_Handler(srv))
+	r.GET("/v1/subscription/metrics/snapshots", _Subscription_ListMetricSnapshots0_HTTP_Handler(srv))
+	r.POST("/v1/subscription/metrics/snapshots", _Subscription_GenerateMetricSnapshots0_HTTP_Handler(srv))
+	r.GET("/v1/subscription/reports/cohorts", _Subscription_GetCohortReport0_HTTP_Handler(srv))
+	r.GET("/v1/subscription/reports/ltv", _Subscription_GetPlanLTVReport0_HTTP_Handler(srv))
 }
 
 func _Subscription_ListPlans0_HTTP_Handler(srv SubscriptionHTTPServer) func(ctx http.Context) error {
@@ -145,6 +245,28 @@ func _Subscription_GetMySubscription0_HTTP_Handler(srv SubscriptionHTTPServer) f
 	}
 }
 
+func _Subscription_QuoteSubscription0_HTTP_Handler(srv SubscriptionHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in QuoteSubscriptionRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationSubscriptionQuoteSubscription)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.QuoteSubscription(ctx, req.(*QuoteSubscriptionRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*QuoteSubscriptionReply)
+		return ctx.Result(200, reply)
+	}
+}
+
 func _Subscription_CreateSubscriptionOrder0_HTTP_Handler(srv SubscriptionHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in CreateSubscriptionOrderRequest
@@ -189,6 +311,28 @@ func _Subscription_HandlePaymentSuccess0_HTTP_Handler(srv SubscriptionHTTPServer
 	}
 }
 
+func _Subscription_HandlePaymentRefund0_HTTP_Handler(srv SubscriptionHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in HandlePaymentRefundRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationSubscriptionHandlePaymentRefund)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.HandlePaymentRefund(ctx, req.(*HandlePaymentRefundRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*emptypb.Empty)
+		return ctx.Result(200, reply)
+	}
+}
+
 func _Subscription_CancelSubscription0_HTTP_Handler(srv SubscriptionHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in CancelSubscriptionRequest
@@ -277,6 +421,25 @@ func _Subscription_GetSubscriptionHistory0_HTTP_Handler(srv SubscriptionHTTPServ
 	}
 }
 
+func _Subscription_ListAppSubscriptionHistory0_HTTP_Handler(srv SubscriptionHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListAppSubscriptionHistoryRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationSubscriptionListAppSubscriptionHistory)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListAppSubscriptionHistory(ctx, req.(*ListAppSubscriptionHistoryRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListAppSubscriptionHistoryReply)
+		return ctx.Result(200, reply)
+	}
+}
+
 func _Subscription_SetAutoRenew0_HTTP_Handler(srv SubscriptionHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in SetAutoRenewRequest
@@ -362,6 +525,28 @@ func _Subscription_ProcessAutoRenewals0_HTTP_Handler(srv SubscriptionHTTPServer)
 	}
 }
 
+func _Subscription_ProcessPriceChangeNotices0_HTTP_Handler(srv SubscriptionHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ProcessPriceChangeNoticesRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationSubscriptionProcessPriceChangeNotices)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ProcessPriceChangeNotices(ctx, req.(*ProcessPriceChangeNoticesRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ProcessPriceChangeNoticesReply)
+		return ctx.Result(200, reply)
+	}
+}
+
 func _Subscription_CreatePlan0_HTTP_Handler(srv SubscriptionHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in CreatePlanRequest
@@ -525,145 +710,1327 @@ func _Subscription_DeletePlanPricing0_HTTP_Handler(srv SubscriptionHTTPServer) f
 	}
 }
 
-type SubscriptionHTTPClient interface {
-	// CancelSubscription 取消订阅
-	CancelSubscription(ctx context.Context, req *CancelSubscriptionRequest, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
-	// CreatePlan 创建订阅套餐
-	CreatePlan(ctx context.Context, req *CreatePlanRequest, opts ...http.CallOption) (rsp *CreatePlanReply, err error)
-	// CreatePlanPricing 创建区域定价
-	CreatePlanPricing(ctx context.Context, req *CreatePlanPricingRequest, opts ...http.CallOption) (rsp *CreatePlanPricingReply, err error)
-	// CreateSubscriptionOrder 创建订阅订单 (调用 Payment Service)
-	CreateSubscriptionOrder(ctx context.Context, req *CreateSubscriptionOrderRequest, opts ...http.CallOption) (rsp *CreateSubscriptionOrderReply, err error)
-	// DeletePlan 删除订阅套餐
-	DeletePlan(ctx context.Context, req *DeletePlanRequest, opts ...http.CallOption) (rsp *DeletePlanReply, err error)
-	// DeletePlanPricing 删除区域定价
-	DeletePlanPricing(ctx context.Context, req *DeletePlanPricingRequest, opts ...http.CallOption) (rsp *DeletePlanPricingReply, err error)
-	// GetExpiringSubscriptions 获取即将过期的订阅（用于定时任务）
-	GetExpiringSubscriptions(ctx context.Context, req *GetExpiringSubscriptionsRequest, opts ...http.CallOption) (rsp *GetExpiringSubscriptionsReply, err error)
-	// GetMySubscription 获取用户的订阅状态
-	GetMySubscription(ctx context.Context, req *GetMySubscriptionRequest, opts ...http.CallOption) (rsp *GetMySubscriptionReply, err error)
-	// GetSubscriptionHistory 获取订阅历史记录
-	GetSubscriptionHistory(ctx context.Context, req *GetSubscriptionHistoryRequest, opts ...http.CallOption) (rsp *GetSubscriptionHistoryReply, err error)
-	// HandlePaymentSuccess 支付回调处理 (通常由 Payment Service 或 MQ 调用)
-	HandlePaymentSuccess(ctx context.Context, req *HandlePaymentSuccessRequest, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
-	// ListPlanPricings 获取套餐的区域定价列表
-	ListPlanPricings(ctx context.Context, req *ListPlanPricingsRequest, opts ...http.CallOption) (rsp *ListPlanPricingsReply, err error)
-	// ListPlans 获取所有订阅套餐
-	ListPlans(ctx context.Context, req *ListPlansRequest, opts ...http.CallOption) (rsp *ListPlansReply, err error)
-	// PauseSubscription 暂停订阅
-	PauseSubscription(ctx context.Context, req *PauseSubscriptionRequest, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
-	// ProcessAutoRenewals 处理自动续费（用于定时任务）
-	ProcessAutoRenewals(ctx context.Context, req *ProcessAutoRenewalsRequest, opts ...http.CallOption) (rsp *ProcessAutoRenewalsReply, err error)
-	// ResumeSubscription 恢复订阅
-	ResumeSubscription(ctx context.Context, req *ResumeSubscriptionRequest, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
-	// SetAutoRenew 设置自动续费
-	SetAutoRenew(ctx context.Context, req *SetAutoRenewRequest, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
-	// UpdateExpiredSubscriptions 批量更新过期订阅状态（用于定时任务）
-	UpdateExpiredSubscriptions(ctx context.Context, req *UpdateExpiredSubscriptionsRequest, opts ...http.CallOption) (rsp *UpdateExpiredSubscriptionsReply, err error)
-	// UpdatePlan 更新订阅套餐
-	UpdatePlan(ctx context.Context, req *UpdatePlanRequest, opts ...http.CallOption) (rsp *UpdatePlanReply, err error)
-	// UpdatePlanPricing 更新区域定价
-	UpdatePlanPricing(ctx context.Context, req *UpdatePlanPricingRequest, opts ...http.CallOption) (rsp *UpdatePlanPricingReply, err error)
+func _Subscription_GetAppSetting0_HTTP_Handler(srv SubscriptionHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetAppSettingRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationSubscriptionGetAppSetting)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetAppSetting(ctx, req.(*GetAppSettingRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetAppSettingReply)
+		return ctx.Result(200, reply)
+	}
 }
 
-type SubscriptionHTTPClientImpl struct {
-	cc *http.Client
+func _Subscription_UpdateAppSetting0_HTTP_Handler(srv SubscriptionHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UpdateAppSettingRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationSubscriptionUpdateAppSetting)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UpdateAppSetting(ctx, req.(*UpdateAppSettingRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*UpdateAppSettingReply)
+		return ctx.Result(200, reply)
+	}
 }
 
-func NewSubscriptionHTTPClient(client *http.Client) SubscriptionHTTPClient {
-	return &SubscriptionHTTPClientImpl{client}
+func _Subscription_ListRegionGroups0_HTTP_Handler(srv SubscriptionHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListRegionGroupsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationSubscriptionListRegionGroups)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListRegionGroups(ctx, req.(*ListRegionGroupsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListRegionGroupsReply)
+		return ctx.Result(200, reply)
+	}
 }
 
-// CancelSubscription 取消订阅
-func (c *SubscriptionHTTPClientImpl) CancelSubscription(ctx context.Context, in *CancelSubscriptionRequest, opts ...http.CallOption) (*emptypb.Empty, error) {
-	var out emptypb.Empty
-	pattern := "/v1/subscription/cancel"
-	path := binding.EncodeURL(pattern, in, false)
-	opts = append(opts, http.Operation(OperationSubscriptionCancelSubscription))
-	opts = append(opts, http.PathTemplate(pattern))
-	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
-	if err != nil {
-		return nil, err
+func _Subscription_GetRegionGroup0_HTTP_Handler(srv SubscriptionHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetRegionGroupRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationSubscriptionGetRegionGroup)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetRegionGroup(ctx, req.(*GetRegionGroupRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetRegionGroupReply)
+		return ctx.Result(200, reply)
 	}
-	return &out, nil
 }
 
-// CreatePlan 创建订阅套餐
-func (c *SubscriptionHTTPClientImpl) CreatePlan(ctx context.Context, in *CreatePlanRequest, opts ...http.CallOption) (*CreatePlanReply, error) {
-	var out CreatePlanReply
-	pattern := "/v1/subscription/plans"
-	path := binding.EncodeURL(pattern, in, false)
-	opts = append(opts, http.Operation(OperationSubscriptionCreatePlan))
-	opts = append(opts, http.PathTemplate(pattern))
-	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
-	if err != nil {
-		return nil, err
+func _Subscription_SaveRegionGroup0_HTTP_Handler(srv SubscriptionHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in SaveRegionGroupRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationSubscriptionSaveRegionGroup)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.SaveRegionGroup(ctx, req.(*SaveRegionGroupRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*SaveRegionGroupReply)
+		return ctx.Result(200, reply)
 	}
-	return &out, nil
 }
 
-// CreatePlanPricing 创建区域定价
-func (c *SubscriptionHTTPClientImpl) CreatePlanPricing(ctx context.Context, in *CreatePlanPricingRequest, opts ...http.CallOption) (*CreatePlanPricingReply, error) {
-	var out CreatePlanPricingReply
-	pattern := "/v1/subscription/plans/{planId}/pricings"
-	path := binding.EncodeURL(pattern, in, false)
-	opts = append(opts, http.Operation(OperationSubscriptionCreatePlanPricing))
-	opts = append(opts, http.PathTemplate(pattern))
-	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
-	if err != nil {
-		return nil, err
+func _Subscription_DeleteRegionGroup0_HTTP_Handler(srv SubscriptionHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in DeleteRegionGroupRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationSubscriptionDeleteRegionGroup)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.DeleteRegionGroup(ctx, req.(*DeleteRegionGroupRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*DeleteRegionGroupReply)
+		return ctx.Result(200, reply)
 	}
-	return &out, nil
 }
 
-// CreateSubscriptionOrder 创建订阅订单 (调用 Payment Service)
-func (c *SubscriptionHTTPClientImpl) CreateSubscriptionOrder(ctx context.Context, in *CreateSubscriptionOrderRequest, opts ...http.CallOption) (*CreateSubscriptionOrderReply, error) {
-	var out CreateSubscriptionOrderReply
-	pattern := "/v1/subscription/order"
-	path := binding.EncodeURL(pattern, in, false)
-	opts = append(opts, http.Operation(OperationSubscriptionCreateSubscriptionOrder))
-	opts = append(opts, http.PathTemplate(pattern))
-	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
-	if err != nil {
-		return nil, err
+func _Subscription_GetInvoice0_HTTP_Handler(srv SubscriptionHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetInvoiceRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationSubscriptionGetInvoice)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetInvoice(ctx, req.(*GetInvoiceRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetInvoiceReply)
+		return ctx.Result(200, reply)
 	}
-	return &out, nil
 }
 
-// DeletePlan 删除订阅套餐
-func (c *SubscriptionHTTPClientImpl) DeletePlan(ctx context.Context, in *DeletePlanRequest, opts ...http.CallOption) (*DeletePlanReply, error) {
-	var out DeletePlanReply
-	pattern := "/v1/subscription/plans/{planId}"
-	path := binding.EncodeURL(pattern, in, true)
-	opts = append(opts, http.Operation(OperationSubscriptionDeletePlan))
+func _Subscription_ListInvoices0_HTTP_Handler(srv SubscriptionHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListInvoicesRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationSubscriptionListInvoices)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListInvoices(ctx, req.(*ListInvoicesRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListInvoicesReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Subscription_ListTaxRules0_HTTP_Handler(srv SubscriptionHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListTaxRulesRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationSubscriptionListTaxRules)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListTaxRules(ctx, req.(*ListTaxRulesRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListTaxRulesReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Subscription_CreateTaxRule0_HTTP_Handler(srv SubscriptionHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CreateTaxRuleRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationSubscriptionCreateTaxRule)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CreateTaxRule(ctx, req.(*CreateTaxRuleRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*CreateTaxRuleReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Subscription_UpdateTaxRule0_HTTP_Handler(srv SubscriptionHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UpdateTaxRuleRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationSubscriptionUpdateTaxRule)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UpdateTaxRule(ctx, req.(*UpdateTaxRuleRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*UpdateTaxRuleReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Subscription_DeleteTaxRule0_HTTP_Handler(srv SubscriptionHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in DeleteTaxRuleRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationSubscriptionDeleteTaxRule)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.DeleteTaxRule(ctx, req.(*DeleteTaxRuleRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*DeleteTaxRuleReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Subscription_ListExchangeRates0_HTTP_Handler(srv SubscriptionHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListExchangeRatesRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationSubscriptionListExchangeRates)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListExchangeRates(ctx, req.(*ListExchangeRatesRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListExchangeRatesReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Subscription_SaveExchangeRates0_HTTP_Handler(srv SubscriptionHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in SaveExchangeRatesRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationSubscriptionSaveExchangeRates)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.SaveExchangeRates(ctx, req.(*SaveExchangeRatesRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*SaveExchangeRatesReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Subscription_ImportExchangeRates0_HTTP_Handler(srv SubscriptionHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ImportExchangeRatesRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationSubscriptionImportExchangeRates)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ImportExchangeRates(ctx, req.(*ImportExchangeRatesRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ImportExchangeRatesReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Subscription_GetRevenueReport0_HTTP_Handler(srv SubscriptionHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetRevenueReportRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationSubscriptionGetRevenueReport)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetRevenueReport(ctx, req.(*GetRevenueReportRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetRevenueReportReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Subscription_GetSubscriptionMetrics0_HTTP_Handler(srv SubscriptionHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetSubscriptionMetricsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationSubscriptionGetSubscriptionMetrics)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetSubscriptionMetrics(ctx, req.(*GetSubscriptionMetricsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetSubscriptionMetricsReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Subscription_ListMetricSnapshots0_HTTP_Handler(srv SubscriptionHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListMetricSnapshotsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationSubscriptionListMetricSnapshots)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListMetricSnapshots(ctx, req.(*ListMetricSnapshotsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListMetricSnapshotsReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Subscription_GenerateMetricSnapshots0_HTTP_Handler(srv SubscriptionHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GenerateMetricSnapshotsRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationSubscriptionGenerateMetricSnapshots)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GenerateMetricSnapshots(ctx, req.(*GenerateMetricSnapshotsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GenerateMetricSnapshotsReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Subscription_GetCohortReport0_HTTP_Handler(srv SubscriptionHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetCohortReportRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationSubscriptionGetCohortReport)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetCohortReport(ctx, req.(*GetCohortReportRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetCohortReportReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Subscription_GetPlanLTVReport0_HTTP_Handler(srv SubscriptionHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetPlanLTVReportRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationSubscriptionGetPlanLTVReport)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetPlanLTVReport(ctx, req.(*GetPlanLTVReportRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetPlanLTVReportReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+type SubscriptionHTTPClient interface {
+	// CancelSubscription 取消订阅
+	CancelSubscription(ctx context.Context, req *CancelSubscriptionRequest, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
+	// CreatePlan 创建订阅套餐
+	CreatePlan(ctx context.Context, req *CreatePlanRequest, opts ...http.CallOption) (rsp *CreatePlanReply, err error)
+	// CreatePlanPricing 创建区域定价
+	CreatePlanPricing(ctx context.Context, req *CreatePlanPricingRequest, opts ...http.CallOption) (rsp *CreatePlanPricingReply, err error)
+	// CreateSubscriptionOrder 创建订阅订单 (调用 Payment Service)
+	CreateSubscriptionOrder(ctx context.Context, req *CreateSubscriptionOrderRequest, opts ...http.CallOption) (rsp *CreateSubscriptionOrderReply, err error)
+	// CreateTaxRule 创建税务规则
+	CreateTaxRule(ctx context.Context, req *CreateTaxRuleRequest, opts ...http.CallOption) (rsp *CreateTaxRuleReply, err error)
+	// DeletePlan 删除订阅套餐
+	DeletePlan(ctx context.Context, req *DeletePlanRequest, opts ...http.CallOption) (rsp *DeletePlanReply, err error)
+	// DeletePlanPricing 删除区域定价
+	DeletePlanPricing(ctx context.Context, req *DeletePlanPricingRequest, opts ...http.CallOption) (rsp *DeletePlanPricingReply, err error)
+	// DeleteRegionGroup 删除地区组
+	DeleteRegionGroup(ctx context.Context, req *DeleteRegionGroupRequest, opts ...http.CallOption) (rsp *DeleteRegionGroupReply, err error)
+	// DeleteTaxRule 删除税务规则
+	DeleteTaxRule(ctx context.Context, req *DeleteTaxRuleRequest, opts ...http.CallOption) (rsp *DeleteTaxRuleReply, err error)
+	// GenerateMetricSnapshots 生成订阅指标日快照（Cron 每天自动生成前一天的快照，可用于补生成）
+	GenerateMetricSnapshots(ctx context.Context, req *GenerateMetricSnapshotsRequest, opts ...http.CallOption) (rsp *GenerateMetricSnapshotsReply, err error)
+	// GetAppSetting 获取应用订阅配置
+	GetAppSetting(ctx context.Context, req *GetAppSettingRequest, opts ...http.CallOption) (rsp *GetAppSettingReply, err error)
+	// GetCohortReport 获取当前应用的月度同期群留存报表（format 为 csv 时同时返回 CSV 内容）
+	GetCohortReport(ctx context.Context, req *GetCohortReportRequest, opts ...http.CallOption) (rsp *GetCohortReportReply, err error)
+	// GetExpiringSubscriptions 获取即将过期的订阅（用于定时任务）
+	GetExpiringSubscriptions(ctx context.Context, req *GetExpiringSubscriptionsRequest, opts ...http.CallOption) (rsp *GetExpiringSubscriptionsReply, err error)
+	// GetInvoice 获取发票或红字发票（format 为 html 或 pdf 时同时返回渲染后的内容）
+	GetInvoice(ctx context.Context, req *GetInvoiceRequest, opts ...http.CallOption) (rsp *GetInvoiceReply, err error)
+	// GetMySubscription 获取用户的订阅状态
+	GetMySubscription(ctx context.Context, req *GetMySubscriptionRequest, opts ...http.CallOption) (rsp *GetMySubscriptionReply, err error)
+	// GetPlanLTVReport 获取当前应用各套餐的 LTV 估算（format 为 csv 时同时返回 CSV 内容）
+	GetPlanLTVReport(ctx context.Context, req *GetPlanLTVReportRequest, opts ...http.CallOption) (rsp *GetPlanLTVReportReply, err error)
+	// GetRegionGroup 获取地区组
+	GetRegionGroup(ctx context.Context, req *GetRegionGroupRequest, opts ...http.CallOption) (rsp *GetRegionGroupReply, err error)
+	// GetRevenueReport 获取当前应用的收入报表（按应用报表币种折算）
+	GetRevenueReport(ctx context.Context, req *GetRevenueReportRequest, opts ...http.CallOption) (rsp *GetRevenueReportReply, err error)
+	// GetSubscriptionHistory 获取订阅历史记录（支持按操作类型、套餐、应用和时间范围筛选，按页码或游标分页）
+	GetSubscriptionHistory(ctx context.Context, req *GetSubscriptionHistoryRequest, opts ...http.CallOption) (rsp *GetSubscriptionHistoryReply, err error)
+	// GetSubscriptionMetrics 获取当前应用的订阅指标汇总（活跃订阅数、MRR/ARR、新增/流失/回流、流失率和留存率）
+	GetSubscriptionMetrics(ctx context.Context, req *GetSubscriptionMetricsRequest, opts ...http.CallOption) (rsp *GetSubscriptionMetricsReply, err error)
+	// HandlePaymentRefund 退款回调处理 (全额退款时结束订阅，并回落到应用的默认免费套餐)
+	HandlePaymentRefund(ctx context.Context, req *HandlePaymentRefundRequest, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
+	// HandlePaymentSuccess 支付回调处理 (通常由 Payment Service 或 MQ 调用)
+	HandlePaymentSuccess(ctx context.Context, req *HandlePaymentSuccessRequest, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
+	// ImportExchangeRates 导入汇率（CSV 内容为空时从配置的本地汇率文件导入）
+	ImportExchangeRates(ctx context.Context, req *ImportExchangeRatesRequest, opts ...http.CallOption) (rsp *ImportExchangeRatesReply, err error)
+	// ListAppSubscriptionHistory 获取当前应用所有用户的订阅历史记录（游标分页）
+	ListAppSubscriptionHistory(ctx context.Context, req *ListAppSubscriptionHistoryRequest, opts ...http.CallOption) (rsp *ListAppSubscriptionHistoryReply, err error)
+	// ListExchangeRates 获取汇率列表（按生效时间倒序）
+	ListExchangeRates(ctx context.Context, req *ListExchangeRatesRequest, opts ...http.CallOption) (rsp *ListExchangeRatesReply, err error)
+	// ListInvoices 获取用户的发票列表（包含红字发票）
+	ListInvoices(ctx context.Context, req *ListInvoicesRequest, opts ...http.CallOption) (rsp *ListInvoicesReply, err error)
+	// ListMetricSnapshots 获取当前应用的订阅指标日快照
+	ListMetricSnapshots(ctx context.Context, req *ListMetricSnapshotsRequest, opts ...http.CallOption) (rsp *ListMetricSnapshotsReply, err error)
+	// ListPlanPricings 获取套餐的区域定价列表
+	ListPlanPricings(ctx context.Context, req *ListPlanPricingsRequest, opts ...http.CallOption) (rsp *ListPlanPricingsReply, err error)
+	// ListPlans 获取所有订阅套餐
+	ListPlans(ctx context.Context, req *ListPlansRequest, opts ...http.CallOption) (rsp *ListPlansReply, err error)
+	// ListRegionGroups 获取地区组列表
+	ListRegionGroups(ctx context.Context, req *ListRegionGroupsRequest, opts ...http.CallOption) (rsp *ListRegionGroupsReply, err error)
+	// ListTaxRules 获取税务规则列表
+	ListTaxRules(ctx context.Context, req *ListTaxRulesRequest, opts ...http.CallOption) (rsp *ListTaxRulesReply, err error)
+	// PauseSubscription 暂停订阅
+	PauseSubscription(ctx context.Context, req *PauseSubscriptionRequest, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
+	// ProcessAutoRenewals 处理自动续费（用于定时任务）
+	ProcessAutoRenewals(ctx context.Context, req *ProcessAutoRenewalsRequest, opts ...http.CallOption) (rsp *ProcessAutoRenewalsReply, err error)
+	// ProcessPriceChangeNotices 发送续费调价通知 (系统内部调用)
+	ProcessPriceChangeNotices(ctx context.Context, req *ProcessPriceChangeNoticesRequest, opts ...http.CallOption) (rsp *ProcessPriceChangeNoticesReply, err error)
+	// QuoteSubscription 获取订阅报价（结算预览，不创建订单）
+	QuoteSubscription(ctx context.Context, req *QuoteSubscriptionRequest, opts ...http.CallOption) (rsp *QuoteSubscriptionReply, err error)
+	// ResumeSubscription 恢复订阅
+	ResumeSubscription(ctx context.Context, req *ResumeSubscriptionRequest, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
+	// SaveExchangeRates 保存汇率（币种对和生效时间相同时覆盖）
+	SaveExchangeRates(ctx context.Context, req *SaveExchangeRatesRequest, opts ...http.CallOption) (rsp *SaveExchangeRatesReply, err error)
+	// SaveRegionGroup 创建或更新地区组（整体替换成员国家）
+	SaveRegionGroup(ctx context.Context, req *SaveRegionGroupRequest, opts ...http.CallOption) (rsp *SaveRegionGroupReply, err error)
+	// SetAutoRenew 设置自动续费
+	SetAutoRenew(ctx context.Context, req *SetAutoRenewRequest, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
+	// UpdateAppSetting 更新应用订阅配置（如默认免费套餐）
+	UpdateAppSetting(ctx context.Context, req *UpdateAppSettingRequest, opts ...http.CallOption) (rsp *UpdateAppSettingReply, err error)
+	// UpdateExpiredSubscriptions 批量更新过期订阅状态（用于定时任务）
+	UpdateExpiredSubscriptions(ctx context.Context, req *UpdateExpiredSubscriptionsRequest, opts ...http.CallOption) (rsp *UpdateExpiredSubscriptionsReply, err error)
+	// UpdatePlan 更新订阅套餐
+	UpdatePlan(ctx context.Context, req *UpdatePlanRequest, opts ...http.CallOption) (rsp *UpdatePlanReply, err error)
+	// UpdatePlanPricing 更新区域定价
+	UpdatePlanPricing(ctx context.Context, req *UpdatePlanPricingRequest, opts ...http.CallOption) (rsp *UpdatePlanPricingReply, err error)
+	// UpdateTaxRule 更新税务规则（只影响之后的报价和订单）
+	UpdateTaxRule(ctx context.Context, req *UpdateTaxRuleRequest, opts ...http.CallOption) (rsp *UpdateTaxRuleReply, err error)
+}
+
+type SubscriptionHTTPClientImpl struct {
+	cc *http.Client
+}
+
+func NewSubscriptionHTTPClient(client *http.Client) SubscriptionHTTPClient {
+	return &SubscriptionHTTPClientImpl{client}
+}
+
+// CancelSubscription 取消订阅
+func (c *SubscriptionHTTPClientImpl) CancelSubscription(ctx context.Context, in *CancelSubscriptionRequest, opts ...http.CallOption) (*emptypb.Empty, error) {
+	var out emptypb.Empty
+	pattern := "/v1/subscription/cancel"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationSubscriptionCancelSubscription))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CreatePlan 创建订阅套餐
+func (c *SubscriptionHTTPClientImpl) CreatePlan(ctx context.Context, in *CreatePlanRequest, opts ...http.CallOption) (*CreatePlanReply, error) {
+	var out CreatePlanReply
+	pattern := "/v1/subscription/plans"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationSubscriptionCreatePlan))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CreatePlanPricing 创建区域定价
+func (c *SubscriptionHTTPClientImpl) CreatePlanPricing(ctx context.Context, in *CreatePlanPricingRequest, opts ...http.CallOption) (*CreatePlanPricingReply, error) {
+	var out CreatePlanPricingReply
+	pattern := "/v1/subscription/plans/{planId}/pricings"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationSubscriptionCreatePlanPricing))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CreateSubscriptionOrder 创建订阅订单 (调用 Payment Service)
+func (c *SubscriptionHTTPClientImpl) CreateSubscriptionOrder(ctx context.Context, in *CreateSubscriptionOrderRequest, opts ...http.CallOption) (*CreateSubscriptionOrderReply, error) {
+	var out CreateSubscriptionOrderReply
+	pattern := "/v1/subscription/order"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationSubscriptionCreateSubscriptionOrder))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CreateTaxRule 创建税务规则
+func (c *SubscriptionHTTPClientImpl) CreateTaxRule(ctx context.Context, in *CreateTaxRuleRequest, opts ...http.CallOption) (*CreateTaxRuleReply, error) {
+	var out CreateTaxRuleReply
+	pattern := "/v1/subscription/tax-rules"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationSubscriptionCreateTaxRule))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// DeletePlan 删除订阅套餐
+func (c *SubscriptionHTTPClientImpl) DeletePlan(ctx context.Context, in *DeletePlanRequest, opts ...http.CallOption) (*DeletePlanReply, error) {
+	var out DeletePlanReply
+	pattern := "/v1/subscription/plans/{planId}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationSubscriptionDeletePlan))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// DeletePlanPricing 删除区域定价
+func (c *SubscriptionHTTPClientImpl) DeletePlanPricing(ctx context.Context, in *DeletePlanPricingRequest, opts ...http.CallOption) (*DeletePlanPricingReply, error) {
+	var out DeletePlanPricingReply
+	pattern := "/v1/subscription/pricings/{planPricingId}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationSubscriptionDeletePlanPricing))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// DeleteRegionGroup 删除地区组
+func (c *SubscriptionHTTPClientImpl) DeleteRegionGroup(ctx context.Context, in *DeleteRegionGroupRequest, opts ...http.CallOption) (*DeleteRegionGroupReply, error) {
+	var out DeleteRegionGroupReply
+	pattern := "/v1/subscription/region-groups/{groupCode}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationSubscriptionDeleteRegionGroup))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// DeleteTaxRule 删除税务规则
+func (c *SubscriptionHTTPClientImpl) DeleteTaxRule(ctx context.Context, in *DeleteTaxRuleRequest, opts ...http.CallOption) (*DeleteTaxRuleReply, error) {
+	var out DeleteTaxRuleReply
+	pattern := "/v1/subscription/tax-rules/{taxRuleId}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationSubscriptionDeleteTaxRule))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GenerateMetricSnapshots 生成订阅指标日快照（Cron 每天自动生成前一天的快照，可用于补生成）
+func (c *SubscriptionHTTPClientImpl) GenerateMetricSnapshots(ctx context.Context, in *GenerateMetricSnapshotsRequest, opts ...http.CallOption) (*GenerateMetricSnapshotsReply, error) {
+	var out GenerateMetricSnapshotsReply
+	pattern := "/v1/subscription/metrics/snapshots"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationSubscriptionGenerateMetricSnapshots))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetAppSetting 获取应用订阅配置
+func (c *SubscriptionHTTPClientImpl) GetAppSetting(ctx context.Context, in *GetAppSettingRequest, opts ...http.CallOption) (*GetAppSettingReply, error) {
+	var out GetAppSettingReply
+	pattern := "/v1/subscription/app-setting"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationSubscriptionGetAppSetting))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetCohortReport 获取当前应用的月度同期群留存报表（format 为 csv 时同时返回 CSV 内容）
+func (c *SubscriptionHTTPClientImpl) GetCohortReport(ctx context.Context, in *GetCohortReportRequest, opts ...http.CallOption) (*GetCohortReportReply, error) {
+	var out GetCohortReportReply
+	pattern := "/v1/subscription/reports/cohorts"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationSubscriptionGetCohortReport))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetExpiringSubscriptions 获取即将过期的订阅（用于定时任务）
+func (c *SubscriptionHTTPClientImpl) GetExpiringSubscriptions(ctx context.Context, in *GetExpiringSubscriptionsRequest, opts ...http.CallOption) (*GetExpiringSubscriptionsReply, error) {
+	var out GetExpiringSubscriptionsReply
+	pattern := "/v1/subscription/expiring"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationSubscriptionGetExpiringSubscriptions))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetInvoice 获取发票或红字发票（format 为 html 或 pdf 时同时返回渲染后的内容）
+func (c *SubscriptionHTTPClientImpl) GetInvoice(ctx context.Context, in *GetInvoiceRequest, opts ...http.CallOption) (*GetInvoiceReply, error) {
+	var out GetInvoiceReply
+	pattern := "/v1/subscription/invoices/{invoiceId}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationSubscriptionGetInvoice))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetMySubscription 获取用户的订阅状态
+func (c *SubscriptionHTTPClientImpl) GetMySubscription(ctx context.Context, in *GetMySubscriptionRequest, opts ...http.CallOption) (*GetMySubscriptionReply, error) {
+	var out GetMySubscriptionReply
+	pattern := "/v1/subscription/my/{uid}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationSubscriptionGetMySubscription))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetPlanLTVReport 获取当前应用各套餐的 LTV 估算（format 为 csv 时同时返回 CSV 内容）
+func (c *SubscriptionHTTPClientImpl) GetPlanLTVReport(ctx context.Context, in *GetPlanLTVReportRequest, opts ...http.CallOption) (*GetPlanLTVReportReply, error) {
+	var out GetPlanLTVReportReply
+	pattern := "/v1/subscription/reports/ltv"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationSubscriptionGetPlanLTVReport))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetRegionGroup 获取地区组
+func (c *SubscriptionHTTPClientImpl) GetRegionGroup(ctx context.Context, in *GetRegionGroupRequest, opts ...http.CallOption) (*GetRegionGroupReply, error) {
+	var out GetRegionGroupReply
+	pattern := "/v1/subscription/region-groups/{groupCode}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationSubscriptionGetRegionGroup))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetRevenueReport 获取当前应用的收入报表（按应用报表币种折算）
+func (c *SubscriptionHTTPClientImpl) GetRevenueReport(ctx context.Context, in *GetRevenueReportRequest, opts ...http.CallOption) (*GetRevenueReportReply, error) {
+	var out GetRevenueReportReply
+	pattern := "/v1/subscription/reports/revenue"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationSubscriptionGetRevenueReport))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetSubscriptionHistory 获取订阅历史记录（支持按操作类型、套餐、应用和时间范围筛选，按页码或游标分页）
+func (c *SubscriptionHTTPClientImpl) GetSubscriptionHistory(ctx context.Context, in *GetSubscriptionHistoryRequest, opts ...http.CallOption) (*GetSubscriptionHistoryReply, error) {
+	var out GetSubscriptionHistoryReply
+	pattern := "/v1/subscription/history/{uid}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationSubscriptionGetSubscriptionHistory))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetSubscriptionMetrics 获取当前应用的订阅指标汇总（活跃订阅数、MRR/ARR、新增/流失/回流、流失率和留存率）
+func (c *SubscriptionHTTPClientImpl) GetSubscriptionMetrics(ctx context.Context, in *GetSubscriptionMetricsRequest, opts ...http.CallOption) (*GetSubscriptionMetricsReply, error) {
+	var out GetSubscriptionMetricsReply
+	pattern := "/v1/subscription/metrics"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationSubscriptionGetSubscriptionMetrics))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// HandlePaymentRefund 退款回调处理 (全额退款时结束订阅，并回落到应用的默认免费套餐)
+func (c *SubscriptionHTTPClientImpl) HandlePaymentRefund(ctx context.Context, in *HandlePaymentRefundRequest, opts ...http.CallOption) (*emptypb.Empty, error) {
+	var out emptypb.Empty
+	pattern := "/v1/subscription/payment/refund"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationSubscriptionHandlePaymentRefund))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// HandlePaymentSuccess 支付回调处理 (通常由 Payment Service 或 MQ 调用)
+func (c *SubscriptionHTTPClientImpl) HandlePaymentSuccess(ctx context.Context, in *HandlePaymentSuccessRequest, opts ...http.CallOption) (*emptypb.Empty, error) {
+	var out emptypb.Empty
+	pattern := "/v1/subscription/payment/success"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationSubscriptionHandlePaymentSuccess))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ImportExchangeRates 导入汇率（CSV 内容为空时从配置的本地汇率文件导入）
+func (c *SubscriptionHTTPClientImpl) ImportExchangeRates(ctx context.Context, in *ImportExchangeRatesRequest, opts ...http.CallOption) (*ImportExchangeRatesReply, error) {
+	var out ImportExchangeRatesReply
+	pattern := "/v1/subscription/exchange-rates/import"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationSubscriptionImportExchangeRates))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListAppSubscriptionHistory 获取当前应用所有用户的订阅历史记录（游标分页）
+func (c *SubscriptionHTTPClientImpl) ListAppSubscriptionHistory(ctx context.Context, in *ListAppSubscriptionHistoryRequest, opts ...http.CallOption) (*ListAppSubscriptionHistoryReply, error) {
+	var out ListAppSubscriptionHistoryReply
+	pattern := "/v1/subscription/app-history"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationSubscriptionListAppSubscriptionHistory))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListExchangeRates 获取汇率列表（按生效时间倒序）
+func (c *SubscriptionHTTPClientImpl) ListExchangeRates(ctx context.Context, in *ListExchangeRatesRequest, opts ...http.CallOption) (*ListExchangeRatesReply, error) {
+	var out ListExchangeRatesReply
+	pattern := "/v1/subscription/exchange-rates"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationSubscriptionListExchangeRates))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListInvoices 获取用户的发票列表（包含红字发票）
+func (c *SubscriptionHTTPClientImpl) ListInvoices(ctx context.Context, in *ListInvoicesRequest, opts ...http.CallOption) (*ListInvoicesReply, error) {
+	var out ListInvoicesReply
+	pattern := "/v1/subscription/invoices"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationSubscriptionListInvoices))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListMetricSnapshots 获取当前应用的订阅指标日快照
+func (c *SubscriptionHTTPClientImpl) ListMetricSnapshots(ctx context.Context, in *ListMetricSnapshotsRequest, opts ...http.CallOption) (*ListMetricSnapshotsReply, error) {
+	var out ListMetricSnapshotsReply
+	pattern := "/v1/subscription/metrics/snapshots"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationSubscriptionListMetricSnapshots))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListPlanPricings 获取套餐的区域定价列表
+func (c *SubscriptionHTTPClientImpl) ListPlanPricings(ctx context.Context, in *ListPlanPricingsRequest, opts ...http.CallOption) (*ListPlanPricingsReply, error) {
+	var out ListPlanPricingsReply
+	pattern := "/v1/subscription/plans/{planId}/pricings"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationSubscriptionListPlanPricings))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListPlans 获取所有订阅套餐
+func (c *SubscriptionHTTPClientImpl) ListPlans(ctx context.Context, in *ListPlansRequest, opts ...http.CallOption) (*ListPlansReply, error) {
+	var out ListPlansReply
+	pattern := "/v1/subscription/plans"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationSubscriptionListPlans))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListRegionGroups 获取地区组列表
+func (c *SubscriptionHTTPClientImpl) ListRegionGroups(ctx context.Context, in *ListRegionGroupsRequest, opts ...http.CallOption) (*ListRegionGroupsReply, error) {
+	var out ListRegionGroupsReply
+	pattern := "/v1/subscription/region-groups"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationSubscriptionListRegionGroups))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListTaxRules 获取税务规则列表
+func (c *SubscriptionHTTPClientImpl) ListTaxRules(ctx context.Context, in *ListTaxRulesRequest, opts ...http.CallOption) (*ListTaxRulesReply, error) {
+	var out ListTaxRulesReply
+	pattern := "/v1/subscription/tax-rules"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationSubscriptionListTaxRules))
 	opts = append(opts, http.PathTemplate(pattern))
-	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// PauseSubscription 暂停订阅
+func (c *SubscriptionHTTPClientImpl) PauseSubscription(ctx context.Context, in *PauseSubscriptionRequest, opts ...http.CallOption) (*emptypb.Empty, error) {
+	var out emptypb.Empty
+	pattern := "/v1/subscription/pause"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationSubscriptionPauseSubscription))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ProcessAutoRenewals 处理自动续费（用于定时任务）
+func (c *SubscriptionHTTPClientImpl) ProcessAutoRenewals(ctx context.Context, in *ProcessAutoRenewalsRequest, opts ...http.CallOption) (*ProcessAutoRenewalsReply, error) {
+	var out ProcessAutoRenewalsReply
+	pattern := "/v1/subscription/auto-renew/process"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationSubscriptionProcessAutoRenewals))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ProcessPriceChangeNotices 发送续费调价通知 (系统内部调用)
+func (c *SubscriptionHTTPClientImpl) ProcessPriceChangeNotices(ctx context.Context, in *ProcessPriceChangeNoticesRequest, opts ...http.CallOption) (*ProcessPriceChangeNoticesReply, error) {
+	var out ProcessPriceChangeNoticesReply
+	pattern := "/v1/subscription/pricings/notices/process"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationSubscriptionProcessPriceChangeNotices))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// QuoteSubscription 获取订阅报价（结算预览，不创建订单）
+func (c *SubscriptionHTTPClientImpl) QuoteSubscription(ctx context.Context, in *QuoteSubscriptionRequest, opts ...http.CallOption) (*QuoteSubscriptionReply, error) {
+	var out QuoteSubscriptionReply
+	pattern := "/v1/subscription/quote"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationSubscriptionQuoteSubscription))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ResumeSubscription 恢复订阅
+func (c *SubscriptionHTTPClientImpl) ResumeSubscription(ctx context.Context, in *ResumeSubscriptionRequest, opts ...http.CallOption) (*emptypb.Empty, error) {
+	var out emptypb.Empty
+	pattern := "/v1/subscription/resume"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationSubscriptionResumeSubscription))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// SaveExchangeRates 保存汇率（币种对和生效时间相同时覆盖）
+func (c *SubscriptionHTTPClientImpl) SaveExchangeRates(ctx context.Context, in *SaveExchangeRatesRequest, opts ...http.CallOption) (*SaveExchangeRatesReply, error) {
+	var out SaveExchangeRatesReply
+	pattern := "/v1/subscription/exchange-rates"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationSubscriptionSaveExchangeRates))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// SaveRegionGroup 创建或更新地区组（整体替换成员国家）
+func (c *SubscriptionHTTPClientImpl) SaveRegionGroup(ctx context.Context, in *SaveRegionGroupRequest, opts ...http.CallOption) (*SaveRegionGroupReply, error) {
+	var out SaveRegionGroupReply
+	pattern := "/v1/subscription/region-groups/{groupCode}"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationSubscriptionSaveRegionGroup))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "PUT", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// SetAutoRenew 设置自动续费
+func (c *SubscriptionHTTPClientImpl) SetAutoRenew(ctx context.Context, in *SetAutoRenewRequest, opts ...http.CallOption) (*emptypb.Empty, error) {
+	var out emptypb.Empty
+	pattern := "/v1/subscription/auto-renew"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationSubscriptionSetAutoRenew))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UpdateAppSetting 更新应用订阅配置（如默认免费套餐）
+func (c *SubscriptionHTTPClientImpl) UpdateAppSetting(ctx context.Context, in *UpdateAppSettingRequest, opts ...http.CallOption) (*UpdateAppSettingReply, error) {
+	var out UpdateAppSettingReply
+	pattern := "/v1/subscription/app-setting"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationSubscriptionUpdateAppSetting))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "PUT", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UpdateExpiredSubscriptions 批量更新过期订阅状态（用于定时任务）
+func (c *SubscriptionHTTPClientImpl) UpdateExpiredSubscriptions(ctx context.Context, in *UpdateExpiredSubscriptionsRequest, opts ...http.CallOption) (*UpdateExpiredSubscriptionsReply, error) {
+	var out UpdateExpiredSubscriptionsReply
+	pattern := "/v1/subscription/expired/update"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationSubscriptionUpdateExpiredSubscriptions))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UpdatePlan 更新订阅套餐
+func (c *SubscriptionHTTPClientImpl) UpdatePlan(ctx context.Context, in *UpdatePlanRequest, opts ...http.CallOption) (*UpdatePlanReply, error) {
+	var out UpdatePlanReply
+	pattern := "/v1/subscription/plans/{planId}"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationSubscriptionUpdatePlan))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "PUT", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UpdatePlanPricing 更新区域定价
+func (c *SubscriptionHTTPClientImpl) UpdatePlanPricing(ctx context.Context, in *UpdatePlanPricingRequest, opts ...http.CallOption) (*UpdatePlanPricingReply, error) {
+	var out UpdatePlanPricingReply
+	pattern := "/v1/subscription/pricings/{planPricingId}"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationSubscriptionUpdatePlanPricing))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "PUT", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UpdateTaxRule 更新税务规则（只影响之后的报价和订单）
+func (c *SubscriptionHTTPClientImpl) UpdateTaxRule(ctx context.Context, in *UpdateTaxRuleRequest, opts ...http.CallOption) (*UpdateTaxRuleReply, error) {
+	var out UpdateTaxRuleReply
+	pattern := "/v1/subscription/tax-rules/{taxRuleId}"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationSubscriptionUpdateTaxRule))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "PUT", path, in, &out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &out, nil
 }
 
-// DeletePlanPricing 删除区域定价
-func (c *SubscriptionHTTPClientImpl) DeletePlanPricing(ctx context.Context, in *DeletePlanPricingRequest, opts ...http.CallOption) (*DeletePlanPricingReply, error) {
-	var out DeletePlanPricingReply
-	pattern := "/v1/subscription/pricings/{planPricingId}"
-	path := binding.EncodeURL(pattern, in, true)
-	opts = append(opts, http.Operation(OperationSubscriptionDeletePlanPricing))
-	opts = append(opts, http.PathTemplate(pattern))
-	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return &out, nil
+const OperationSubscriptionAdminListJobRuns = "/subscription.v1.SubscriptionAdmin/ListJobRuns"
+const OperationSubscriptionAdminListJobs = "/subscription.v1.SubscriptionAdmin/ListJobs"
+const OperationSubscriptionAdminPauseJob = "/subscription.v1.SubscriptionAdmin/PauseJob"
+const OperationSubscriptionAdminResumeJob = "/subscription.v1.SubscriptionAdmin/ResumeJob"
+const OperationSubscriptionAdminTriggerJob = "/subscription.v1.SubscriptionAdmin/TriggerJob"
+
+type SubscriptionAdminHTTPServer interface {
+	// ListJobRuns 分页获取定时任务执行记录（按开始时间倒序）
+	ListJobRuns(context.Context, *ListJobRunsRequest) (*ListJobRunsReply, error)
+	// ListJobs 获取已注册的定时任务及其计划、暂停状态、下次执行时间和最近一次执行
+	ListJobs(context.Context, *ListJobsRequest) (*ListJobsReply, error)
+	// PauseJob 暂停定时任务的计划执行（手动触发不受影响）
+	PauseJob(context.Context, *PauseJobRequest) (*emptypb.Empty, error)
+	// ResumeJob 恢复定时任务的计划执行
+	ResumeJob(context.Context, *ResumeJobRequest) (*emptypb.Empty, error)
+	// TriggerJob 立即执行定时任务（后台执行，返回执行记录）；dryRun 为 true 时只统计将要处理的数据，不修改数据
+	TriggerJob(context.Context, *TriggerJobRequest) (*TriggerJobReply, error)
+}
+
+func RegisterSubscriptionAdminHTTPServer(s *http.Server, srv SubscriptionAdminHTTPServer) {
+	r := s.Route("/")
+	r.GET("/v1/admin/cron/jobs", _SubscriptionAdmin_ListJobs0_HTTP_Handler(srv))
+	r.POST("/v1/admin/cron/jobs/{name}/trigger", _SubscriptionAdmin_TriggerJob0_HTTP_Handler(srv))
+	r.POST("/v1/admin/cron/jobs/{name}/pause", _SubscriptionAdmin_PauseJob0_HTTP_Handler(srv))
+	r.POST("/v1/admin/cron/jobs/{name}/resume", _SubscriptionAdmin_ResumeJob0_HTTP_Handler(srv))
+	r.GET("/v1/admin/cron/runs", _SubscriptionAdmin_ListJobRuns0_HTTP_Handler(srv))
+}
+
+func _SubscriptionAdmin_ListJobs0_HTTP_Handler(srv SubscriptionAdminHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListJobsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationSubscriptionAdminListJobs)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListJobs(ctx, req.(*ListJobsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListJobsReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _SubscriptionAdmin_TriggerJob0_HTTP_Handler(srv SubscriptionAdminHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in TriggerJobRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationSubscriptionAdminTriggerJob)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.TriggerJob(ctx, req.(*TriggerJobRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*TriggerJobReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _SubscriptionAdmin_PauseJob0_HTTP_Handler(srv SubscriptionAdminHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in PauseJobRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationSubscriptionAdminPauseJob)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.PauseJob(ctx, req.(*PauseJobRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*emptypb.Empty)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _SubscriptionAdmin_ResumeJob0_HTTP_Handler(srv SubscriptionAdminHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ResumeJobRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationSubscriptionAdminResumeJob)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ResumeJob(ctx, req.(*ResumeJobRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*emptypb.Empty)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _SubscriptionAdmin_ListJobRuns0_HTTP_Handler(srv SubscriptionAdminHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListJobRunsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationSubscriptionAdminListJobRuns)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListJobRuns(ctx, req.(*ListJobRunsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListJobRunsReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+type SubscriptionAdminHTTPClient interface {
+	// ListJobRuns 分页获取定时任务执行记录（按开始时间倒序）
+	ListJobRuns(ctx context.Context, req *ListJobRunsRequest, opts ...http.CallOption) (rsp *ListJobRunsReply, err error)
+	// ListJobs 获取已注册的定时任务及其计划、暂停状态、下次执行时间和最近一次执行
+	ListJobs(ctx context.Context, req *ListJobsRequest, opts ...http.CallOption) (rsp *ListJobsReply, err error)
+	// PauseJob 暂停定时任务的计划执行（手动触发不受影响）
+	PauseJob(ctx context.Context, req *PauseJobRequest, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
+	// ResumeJob 恢复定时任务的计划执行
+	ResumeJob(ctx context.Context, req *ResumeJobRequest, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
+	// TriggerJob 立即执行定时任务（后台执行，返回执行记录）；dryRun 为 true 时只统计将要处理的数据，不修改数据
+	TriggerJob(ctx context.Context, req *TriggerJobRequest, opts ...http.CallOption) (rsp *TriggerJobReply, err error)
+}
+
+type SubscriptionAdminHTTPClientImpl struct {
+	cc *http.Client
+}
+
+func NewSubscriptionAdminHTTPClient(client *http.Client) SubscriptionAdminHTTPClient {
+	return &SubscriptionAdminHTTPClientImpl{client}
 }
 
-// GetExpiringSubscriptions 获取即将过期的订阅（用于定时任务）
-func (c *SubscriptionHTTPClientImpl) GetExpiringSubscriptions(ctx context.Context, in *GetExpiringSubscriptionsRequest, opts ...http.CallOption) (*GetExpiringSubscriptionsReply, error) {
-	var out GetExpiringSubscriptionsReply
-	pattern := "/v1/subscription/expiring"
+// ListJobRuns 分页获取定时任务执行记录（按开始时间倒序）
+func (c *SubscriptionAdminHTTPClientImpl) ListJobRuns(ctx context.Context, in *ListJobRunsRequest, opts ...http.CallOption) (*ListJobRunsReply, error) {
+	var out ListJobRunsReply
+	pattern := "/v1/admin/cron/runs"
 	path := binding.EncodeURL(pattern, in, true)
-	opts = append(opts, http.Operation(OperationSubscriptionGetExpiringSubscriptions))
+	opts = append(opts, http.Operation(OperationSubscriptionAdminListJobRuns))
 	opts = append(opts, http.PathTemplate(pattern))
 	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
 	if err != nil {
@@ -672,12 +2039,12 @@ func (c *SubscriptionHTTPClientImpl) GetExpiringSubscriptions(ctx context.Contex
 	return &out, nil
 }
 
-// GetMySubscription 获取用户的订阅状态
-func (c *SubscriptionHTTPClientImpl) GetMySubscription(ctx context.Context, in *GetMySubscriptionRequest, opts ...http.CallOption) (*GetMySubscriptionReply, error) {
-	var out GetMySubscriptionReply
-	pattern := "/v1/subscription/my/{uid}"
+// ListJobs 获取已注册的定时任务及其计划、暂停状态、下次执行时间和最近一次执行
+func (c *SubscriptionAdminHTTPClientImpl) ListJobs(ctx context.Context, in *ListJobsRequest, opts ...http.CallOption) (*ListJobsReply, error) {
+	var out ListJobsReply
+	pattern := "/v1/admin/cron/jobs"
 	path := binding.EncodeURL(pattern, in, true)
-	opts = append(opts, http.Operation(OperationSubscriptionGetMySubscription))
+	opts = append(opts, http.Operation(OperationSubscriptionAdminListJobs))
 	opts = append(opts, http.PathTemplate(pattern))
 	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
 	if err != nil {
@@ -686,26 +2053,26 @@ func (c *SubscriptionHTTPClientImpl) GetMySubscription(ctx context.Context, in *
 	return &out, nil
 }
 
-// GetSubscriptionHistory 获取订阅历史记录
-func (c *SubscriptionHTTPClientImpl) GetSubscriptionHistory(ctx context.Context, in *GetSubscriptionHistoryRequest, opts ...http.CallOption) (*GetSubscriptionHistoryReply, error) {
-	var out GetSubscriptionHistoryReply
-	pattern := "/v1/subscription/history/{uid}"
-	path := binding.EncodeURL(pattern, in, true)
-	opts = append(opts, http.Operation(OperationSubscriptionGetSubscriptionHistory))
+// PauseJob 暂停定时任务的计划执行（手动触发不受影响）
+func (c *SubscriptionAdminHTTPClientImpl) PauseJob(ctx context.Context, in *PauseJobRequest, opts ...http.CallOption) (*emptypb.Empty, error) {
+	var out emptypb.Empty
+	pattern := "/v1/admin/cron/jobs/{name}/pause"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationSubscriptionAdminPauseJob))
 	opts = append(opts, http.PathTemplate(pattern))
-	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &out, nil
 }
 
-// HandlePaymentSuccess 支付回调处理 (通常由 Payment Service 或 MQ 调用)
-func (c *SubscriptionHTTPClientImpl) HandlePaymentSuccess(ctx context.Context, in *HandlePaymentSuccessRequest, opts ...http.CallOption) (*emptypb.Empty, error) {
+// ResumeJob 恢复定时任务的计划执行
+func (c *SubscriptionAdminHTTPClientImpl) ResumeJob(ctx context.Context, in *ResumeJobRequest, opts ...http.CallOption) (*emptypb.Empty, error) {
 	var out emptypb.Empty
-	pattern := "/v1/subscription/payment/success"
+	pattern := "/v1/admin/cron/jobs/{name}/resume"
 	path := binding.EncodeURL(pattern, in, false)
-	opts = append(opts, http.Operation(OperationSubscriptionHandlePaymentSuccess))
+	opts = append(opts, http.Operation(OperationSubscriptionAdminResumeJob))
 	opts = append(opts, http.PathTemplate(pattern))
 	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
 	if err != nil {
@@ -714,40 +2081,286 @@ func (c *SubscriptionHTTPClientImpl) HandlePaymentSuccess(ctx context.Context, i
 	return &out, nil
 }
 
-// ListPlanPricings 获取套餐的区域定价列表
-func (c *SubscriptionHTTPClientImpl) ListPlanPricings(ctx context.Context, in *ListPlanPricingsRequest, opts ...http.CallOption) (*ListPlanPricingsReply, error) {
-	var out ListPlanPricingsReply
-	pattern := "/v1/subscription/plans/{planId}/pricings"
-	path := binding.EncodeURL(pattern, in, true)
-	opts = append(opts, http.Operation(OperationSubscriptionListPlanPricings))
+// TriggerJob 立即执行定时任务（后台执行，返回执行记录）；dryRun 为 true 时只统计将要处理的数据，不修改数据
+func (c *SubscriptionAdminHTTPClientImpl) TriggerJob(ctx context.Context, in *TriggerJobRequest, opts ...http.CallOption) (*TriggerJobReply, error) {
+	var out TriggerJobReply
+	pattern := "/v1/admin/cron/jobs/{name}/trigger"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationSubscriptionAdminTriggerJob))
 	opts = append(opts, http.PathTemplate(pattern))
-	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &out, nil
 }
 
-// ListPlans 获取所有订阅套餐
-func (c *SubscriptionHTTPClientImpl) ListPlans(ctx context.Context, in *ListPlansRequest, opts ...http.CallOption) (*ListPlansReply, error) {
-	var out ListPlansReply
-	pattern := "/v1/subscription/plans"
-	path := binding.EncodeURL(pattern, in, true)
-	opts = append(opts, http.Operation(OperationSubscriptionListPlans))
+const OperationSubscriptionSupportAdjustSubscription = "/subscription.v1.SubscriptionSupport/AdjustSubscription"
+const OperationSubscriptionSupportCancelBulkOperation = "/subscription.v1.SubscriptionSupport/CancelBulkOperation"
+const OperationSubscriptionSupportCreateBulkOperation = "/subscription.v1.SubscriptionSupport/CreateBulkOperation"
+const OperationSubscriptionSupportGetBulkOperation = "/subscription.v1.SubscriptionSupport/GetBulkOperation"
+const OperationSubscriptionSupportGetUserTimeline = "/subscription.v1.SubscriptionSupport/GetUserTimeline"
+const OperationSubscriptionSupportGrantSubscription = "/subscription.v1.SubscriptionSupport/GrantSubscription"
+const OperationSubscriptionSupportListBulkOperations = "/subscription.v1.SubscriptionSupport/ListBulkOperations"
+const OperationSubscriptionSupportSearchSubscriptions = "/subscription.v1.SubscriptionSupport/SearchSubscriptions"
+
+type SubscriptionSupportHTTPServer interface {
+	// AdjustSubscription 延长（days > 0）或缩短（days < 0）用户订阅
+	AdjustSubscription(context.Context, *AdjustSubscriptionRequest) (*AdjustSubscriptionReply, error)
+	// CancelBulkOperation 取消未结束的批量操作（已处理的用户不回滚）
+	CancelBulkOperation(context.Context, *CancelBulkOperationRequest) (*CancelBulkOperationReply, error)
+	// CreateBulkOperation 创建批量操作（按应用、套餐、状态筛选订阅，批量延长或在下次续费时迁移套餐）；dryRun 只返回匹配的订阅数
+	CreateBulkOperation(context.Context, *CreateBulkOperationRequest) (*CreateBulkOperationReply, error)
+	// GetBulkOperation 查询批量操作的执行进度
+	GetBulkOperation(context.Context, *GetBulkOperationRequest) (*GetBulkOperationReply, error)
+	// GetUserTimeline 查看用户的当前订阅、订单（含支付和退款信息）、订阅历史和合并后的时间线
+	GetUserTimeline(context.Context, *GetUserTimelineRequest) (*GetUserTimelineReply, error)
+	// GrantSubscription 不经过支付开通或延长用户订阅（赠送或手动开通），可选创建零金额记账订单；不计入收入
+	GrantSubscription(context.Context, *GrantSubscriptionRequest) (*GrantSubscriptionReply, error)
+	// ListBulkOperations 分页查询批量操作
+	ListBulkOperations(context.Context, *ListBulkOperationsRequest) (*ListBulkOperationsReply, error)
+	// SearchSubscriptions 按用户、应用、套餐、状态和时间范围查询订阅
+	SearchSubscriptions(context.Context, *SearchSubscriptionsRequest) (*SearchSubscriptionsReply, error)
+}
+
+func RegisterSubscriptionSupportHTTPServer(s *http.Server, srv SubscriptionSupportHTTPServer) {
+	r := s.Route("/")
+	r.GET("/v1/admin/support/subscriptions", _SubscriptionSupport_SearchSubscriptions0_HTTP_Handler(srv))
+	r.GET("/v1/admin/support/users/{uid}/timeline", _SubscriptionSupport_GetUserTimeline0_HTTP_Handler(srv))
+	r.POST("/v1/admin/support/users/{uid}/adjust", _SubscriptionSupport_AdjustSubscription0_HTTP_Handler(srv))
+	r.POST("/v1/admin/support/users/{uid}/grant", _SubscriptionSupport_GrantSubscription0_HTTP_Handler(srv))
+	r.POST("/v1/admin/support/bulk-operations", _SubscriptionSupport_CreateBulkOperation0_HTTP_Handler(srv))
+	r.GET("/v1/admin/support/bulk-operations/{id}", _SubscriptionSupport_GetBulkOperation0_HTTP_Handler(srv))
+	r.GET("/v1/admin/support/bulk-operations", _SubscriptionSupport_ListBulkOperations0_HTTP_Handler(srv))
+	r.POST("/v1/admin/support/bulk-operations/{id}/cancel", _SubscriptionSupport_CancelBulkOperation0_HTTP_Handler(srv))
+}
+
+func _SubscriptionSupport_SearchSubscriptions0_HTTP_Handler(srv SubscriptionSupportHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in SearchSubscriptionsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationSubscriptionSupportSearchSubscriptions)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.SearchSubscriptions(ctx, req.(*SearchSubscriptionsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*SearchSubscriptionsReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _SubscriptionSupport_GetUserTimeline0_HTTP_Handler(srv SubscriptionSupportHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetUserTimelineRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationSubscriptionSupportGetUserTimeline)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetUserTimeline(ctx, req.(*GetUserTimelineRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetUserTimelineReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _SubscriptionSupport_AdjustSubscription0_HTTP_Handler(srv SubscriptionSupportHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdjustSubscriptionRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationSubscriptionSupportAdjustSubscription)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdjustSubscription(ctx, req.(*AdjustSubscriptionRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdjustSubscriptionReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _SubscriptionSupport_GrantSubscription0_HTTP_Handler(srv SubscriptionSupportHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GrantSubscriptionRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationSubscriptionSupportGrantSubscription)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GrantSubscription(ctx, req.(*GrantSubscriptionRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GrantSubscriptionReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _SubscriptionSupport_CreateBulkOperation0_HTTP_Handler(srv SubscriptionSupportHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CreateBulkOperationRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationSubscriptionSupportCreateBulkOperation)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CreateBulkOperation(ctx, req.(*CreateBulkOperationRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*CreateBulkOperationReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _SubscriptionSupport_GetBulkOperation0_HTTP_Handler(srv SubscriptionSupportHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetBulkOperationRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationSubscriptionSupportGetBulkOperation)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetBulkOperation(ctx, req.(*GetBulkOperationRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetBulkOperationReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _SubscriptionSupport_ListBulkOperations0_HTTP_Handler(srv SubscriptionSupportHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListBulkOperationsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationSubscriptionSupportListBulkOperations)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListBulkOperations(ctx, req.(*ListBulkOperationsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListBulkOperationsReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _SubscriptionSupport_CancelBulkOperation0_HTTP_Handler(srv SubscriptionSupportHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CancelBulkOperationRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationSubscriptionSupportCancelBulkOperation)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CancelBulkOperation(ctx, req.(*CancelBulkOperationRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*CancelBulkOperationReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+type SubscriptionSupportHTTPClient interface {
+	// AdjustSubscription 延长（days > 0）或缩短（days < 0）用户订阅
+	AdjustSubscription(ctx context.Context, req *AdjustSubscriptionRequest, opts ...http.CallOption) (rsp *AdjustSubscriptionReply, err error)
+	// CancelBulkOperation 取消未结束的批量操作（已处理的用户不回滚）
+	CancelBulkOperation(ctx context.Context, req *CancelBulkOperationRequest, opts ...http.CallOption) (rsp *CancelBulkOperationReply, err error)
+	// CreateBulkOperation 创建批量操作（按应用、套餐、状态筛选订阅，批量延长或在下次续费时迁移套餐）；dryRun 只返回匹配的订阅数
+	CreateBulkOperation(ctx context.Context, req *CreateBulkOperationRequest, opts ...http.CallOption) (rsp *CreateBulkOperationReply, err error)
+	// GetBulkOperation 查询批量操作的执行进度
+	GetBulkOperation(ctx context.Context, req *GetBulkOperationRequest, opts ...http.CallOption) (rsp *GetBulkOperationReply, err error)
+	// GetUserTimeline 查看用户的当前订阅、订单（含支付和退款信息）、订阅历史和合并后的时间线
+	GetUserTimeline(ctx context.Context, req *GetUserTimelineRequest, opts ...http.CallOption) (rsp *GetUserTimelineReply, err error)
+	// GrantSubscription 不经过支付开通或延长用户订阅（赠送或手动开通），可选创建零金额记账订单；不计入收入
+	GrantSubscription(ctx context.Context, req *GrantSubscriptionRequest, opts ...http.CallOption) (rsp *GrantSubscriptionReply, err error)
+	// ListBulkOperations 分页查询批量操作
+	ListBulkOperations(ctx context.Context, req *ListBulkOperationsRequest, opts ...http.CallOption) (rsp *ListBulkOperationsReply, err error)
+	// SearchSubscriptions 按用户、应用、套餐、状态和时间范围查询订阅
+	SearchSubscriptions(ctx context.Context, req *SearchSubscriptionsRequest, opts ...http.CallOption) (rsp *SearchSubscriptionsReply, err error)
+}
+
+type SubscriptionSupportHTTPClientImpl struct {
+	cc *http.Client
+}
+
+func NewSubscriptionSupportHTTPClient(client *http.Client) SubscriptionSupportHTTPClient {
+	return &SubscriptionSupportHTTPClientImpl{client}
+}
+
+// AdjustSubscription 延长（days > 0）或缩短（days < 0）用户订阅
+func (c *SubscriptionSupportHTTPClientImpl) AdjustSubscription(ctx context.Context, in *AdjustSubscriptionRequest, opts ...http.CallOption) (*AdjustSubscriptionReply, error) {
+	var out AdjustSubscriptionReply
+	pattern := "/v1/admin/support/users/{uid}/adjust"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationSubscriptionSupportAdjustSubscription))
 	opts = append(opts, http.PathTemplate(pattern))
-	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &out, nil
 }
 
-// PauseSubscription 暂停订阅
-func (c *SubscriptionHTTPClientImpl) PauseSubscription(ctx context.Context, in *PauseSubscriptionRequest, opts ...http.CallOption) (*emptypb.Empty, error) {
-	var out emptypb.Empty
-	pattern := "/v1/subscription/pause"
+// CancelBulkOperation 取消未结束的批量操作（已处理的用户不回滚）
+func (c *SubscriptionSupportHTTPClientImpl) CancelBulkOperation(ctx context.Context, in *CancelBulkOperationRequest, opts ...http.CallOption) (*CancelBulkOperationReply, error) {
+	var out CancelBulkOperationReply
+	pattern := "/v1/admin/support/bulk-operations/{id}/cancel"
 	path := binding.EncodeURL(pattern, in, false)
-	opts = append(opts, http.Operation(OperationSubscriptionPauseSubscription))
+	opts = append(opts, http.Operation(OperationSubscriptionSupportCancelBulkOperation))
 	opts = append(opts, http.PathTemplate(pattern))
 	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
 	if err != nil {
@@ -756,12 +2369,12 @@ func (c *SubscriptionHTTPClientImpl) PauseSubscription(ctx context.Context, in *
 	return &out, nil
 }
 
-// ProcessAutoRenewals 处理自动续费（用于定时任务）
-func (c *SubscriptionHTTPClientImpl) ProcessAutoRenewals(ctx context.Context, in *ProcessAutoRenewalsRequest, opts ...http.CallOption) (*ProcessAutoRenewalsReply, error) {
-	var out ProcessAutoRenewalsReply
-	pattern := "/v1/subscription/auto-renew/process"
+// CreateBulkOperation 创建批量操作（按应用、套餐、状态筛选订阅，批量延长或在下次续费时迁移套餐）；dryRun 只返回匹配的订阅数
+func (c *SubscriptionSupportHTTPClientImpl) CreateBulkOperation(ctx context.Context, in *CreateBulkOperationRequest, opts ...http.CallOption) (*CreateBulkOperationReply, error) {
+	var out CreateBulkOperationReply
+	pattern := "/v1/admin/support/bulk-operations"
 	path := binding.EncodeURL(pattern, in, false)
-	opts = append(opts, http.Operation(OperationSubscriptionProcessAutoRenewals))
+	opts = append(opts, http.Operation(OperationSubscriptionSupportCreateBulkOperation))
 	opts = append(opts, http.PathTemplate(pattern))
 	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
 	if err != nil {
@@ -770,40 +2383,40 @@ func (c *SubscriptionHTTPClientImpl) ProcessAutoRenewals(ctx context.Context, in
 	return &out, nil
 }
 
-// ResumeSubscription 恢复订阅
-func (c *SubscriptionHTTPClientImpl) ResumeSubscription(ctx context.Context, in *ResumeSubscriptionRequest, opts ...http.CallOption) (*emptypb.Empty, error) {
-	var out emptypb.Empty
-	pattern := "/v1/subscription/resume"
-	path := binding.EncodeURL(pattern, in, false)
-	opts = append(opts, http.Operation(OperationSubscriptionResumeSubscription))
+// GetBulkOperation 查询批量操作的执行进度
+func (c *SubscriptionSupportHTTPClientImpl) GetBulkOperation(ctx context.Context, in *GetBulkOperationRequest, opts ...http.CallOption) (*GetBulkOperationReply, error) {
+	var out GetBulkOperationReply
+	pattern := "/v1/admin/support/bulk-operations/{id}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationSubscriptionSupportGetBulkOperation))
 	opts = append(opts, http.PathTemplate(pattern))
-	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &out, nil
 }
 
-// SetAutoRenew 设置自动续费
-func (c *SubscriptionHTTPClientImpl) SetAutoRenew(ctx context.Context, in *SetAutoRenewRequest, opts ...http.CallOption) (*emptypb.Empty, error) {
-	var out emptypb.Empty
-	pattern := "/v1/subscription/auto-renew"
-	path := binding.EncodeURL(pattern, in, false)
-	opts = append(opts, http.Operation(OperationSubscriptionSetAutoRenew))
+// GetUserTimeline 查看用户的当前订阅、订单（含支付和退款信息）、订阅历史和合并后的时间线
+func (c *SubscriptionSupportHTTPClientImpl) GetUserTimeline(ctx context.Context, in *GetUserTimelineRequest, opts ...http.CallOption) (*GetUserTimelineReply, error) {
+	var out GetUserTimelineReply
+	pattern := "/v1/admin/support/users/{uid}/timeline"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationSubscriptionSupportGetUserTimeline))
 	opts = append(opts, http.PathTemplate(pattern))
-	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &out, nil
 }
 
-// UpdateExpiredSubscriptions 批量更新过期订阅状态（用于定时任务）
-func (c *SubscriptionHTTPClientImpl) UpdateExpiredSubscriptions(ctx context.Context, in *UpdateExpiredSubscriptionsRequest, opts ...http.CallOption) (*UpdateExpiredSubscriptionsReply, error) {
-	var out UpdateExpiredSubscriptionsReply
-	pattern := "/v1/subscription/expired/update"
+// GrantSubscription 不经过支付开通或延长用户订阅（赠送或手动开通），可选创建零金额记账订单；不计入收入
+func (c *SubscriptionSupportHTTPClientImpl) GrantSubscription(ctx context.Context, in *GrantSubscriptionRequest, opts ...http.CallOption) (*GrantSubscriptionReply, error) {
+	var out GrantSubscriptionReply
+	pattern := "/v1/admin/support/users/{uid}/grant"
 	path := binding.EncodeURL(pattern, in, false)
-	opts = append(opts, http.Operation(OperationSubscriptionUpdateExpiredSubscriptions))
+	opts = append(opts, http.Operation(OperationSubscriptionSupportGrantSubscription))
 	opts = append(opts, http.PathTemplate(pattern))
 	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
 	if err != nil {
@@ -812,28 +2425,28 @@ func (c *SubscriptionHTTPClientImpl) UpdateExpiredSubscriptions(ctx context.Cont
 	return &out, nil
 }
 
-// UpdatePlan 更新订阅套餐
-func (c *SubscriptionHTTPClientImpl) UpdatePlan(ctx context.Context, in *UpdatePlanRequest, opts ...http.CallOption) (*UpdatePlanReply, error) {
-	var out UpdatePlanReply
-	pattern := "/v1/subscription/plans/{planId}"
-	path := binding.EncodeURL(pattern, in, false)
-	opts = append(opts, http.Operation(OperationSubscriptionUpdatePlan))
+// ListBulkOperations 分页查询批量操作
+func (c *SubscriptionSupportHTTPClientImpl) ListBulkOperations(ctx context.Context, in *ListBulkOperationsRequest, opts ...http.CallOption) (*ListBulkOperationsReply, error) {
+	var out ListBulkOperationsReply
+	pattern := "/v1/admin/support/bulk-operations"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationSubscriptionSupportListBulkOperations))
 	opts = append(opts, http.PathTemplate(pattern))
-	err := c.cc.Invoke(ctx, "PUT", path, in, &out, opts...)
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &out, nil
 }
 
-// UpdatePlanPricing 更新区域定价
-func (c *SubscriptionHTTPClientImpl) UpdatePlanPricing(ctx context.Context, in *UpdatePlanPricingRequest, opts ...http.CallOption) (*UpdatePlanPricingReply, error) {
-	var out UpdatePlanPricingReply
-	pattern := "/v1/subscription/pricings/{planPricingId}"
-	path := binding.EncodeURL(pattern, in, false)
-	opts = append(opts, http.Operation(OperationSubscriptionUpdatePlanPricing))
+// SearchSubscriptions 按用户、应用、套餐、状态和时间范围查询订阅
+func (c *SubscriptionSupportHTTPClientImpl) SearchSubscriptions(ctx context.Context, in *SearchSubscriptionsRequest, opts ...http.CallOption) (*SearchSubscriptionsReply, error) {
+	var out SearchSubscriptionsReply
+	pattern := "/v1/admin/support/subscriptions"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationSubscriptionSupportSearchSubscriptions))
 	opts = append(opts, http.PathTemplate(pattern))
-	err := c.cc.Invoke(ctx, "PUT", path, in, &out, opts...)
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
 	if err != nil {
 		return nil, err
 	}
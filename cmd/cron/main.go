@@ -4,16 +4,21 @@ import (
 	"context"
 	"flag"
 	"log"
+	"net/http"
 	"os"
 	"os/signal"
 	"syscall"
 	"time"
 
+	"xinyuan_tech/subscription-service/internal/biz"
 	"xinyuan_tech/subscription-service/internal/conf"
+	"xinyuan_tech/subscription-service/internal/constants"
+	"xinyuan_tech/subscription-service/internal/metrics"
+	"xinyuan_tech/subscription-service/internal/scheduler"
+	"xinyuan_tech/subscription-service/internal/telemetry"
 
 	"github.com/go-kratos/kratos/v2/config"
 	"github.com/go-kratos/kratos/v2/config/file"
-	"github.com/robfig/cron/v3"
 	_ "go.uber.org/automaxprocs"
 )
 
@@ -45,6 +50,13 @@ func main() {
 		panic(err)
 	}
 
+	// 初始化链路追踪（OpenTelemetry）
+	shutdownTracer, err := telemetry.InitTracer(bc.GetTrace(), "subscription-cron", "")
+	if err != nil {
+		panic(err)
+	}
+	defer shutdownTracer()
+
 	// 初始化应用
 	app, cleanup, err := wireApp(&bc)
 	if err != nil {
@@ -55,9 +67,16 @@ func main() {
 	// 从配置中获取参数
 	autoRenewDaysBefore := int(3)
 	expiryCheckDays := int(7)
-	cronExpiryCheck := "0 0 2 * * *"      // 默认: 每天凌晨 2 点
-	cronRenewalReminder := "0 0 10 * * *" // 默认: 每天上午 10 点
-	cronAutoRenewal := "0 0 3 * * *"      // 默认: 每天凌晨 3 点
+	priceChangeNoticeDays := int(7)
+	cronExpiryCheck := "0 0 2 * * *"        // 默认: 每天凌晨 2 点
+	cronRenewalReminder := "0 0 10 * * *"   // 默认: 每天上午 10 点
+	cronAutoRenewal := "0 0 3 * * *"        // 默认: 每天凌晨 3 点
+	cronPriceChangeNotice := "0 0 11 * * *" // 默认: 每天上午 11 点
+	cronExchangeRateImport := "0 0 1 * * *" // 默认: 每天凌晨 1 点
+	cronMetricSnapshot := "0 30 0 * * *"    // 默认: 每天凌晨 0 点 30 分
+	cronBulkOperation := "0 * * * * *"      // 默认: 每分钟
+	metricsAddr := "0.0.0.0:8112"           // 默认: Prometheus 指标监听地址
+	adminHTTPAddr := constants.DefaultCronAdminHTTPAddr
 
 	// 读取订阅业务配置
 	if bc.GetSubscription() != nil {
@@ -68,6 +87,9 @@ func main() {
 		if subConf.GetExpiryCheckDays() > 0 {
 			expiryCheckDays = int(subConf.GetExpiryCheckDays())
 		}
+		if subConf.GetPriceChangeNoticeDays() > 0 {
+			priceChangeNoticeDays = int(subConf.GetPriceChangeNoticeDays())
+		}
 	}
 
 	// 读取 Cron 调度配置
@@ -82,83 +104,215 @@ func main() {
 		if cronConf.GetAutoRenewal() != "" {
 			cronAutoRenewal = cronConf.GetAutoRenewal()
 		}
+		if cronConf.GetPriceChangeNotice() != "" {
+			cronPriceChangeNotice = cronConf.GetPriceChangeNotice()
+		}
+		if cronConf.GetExchangeRateImport() != "" {
+			cronExchangeRateImport = cronConf.GetExchangeRateImport()
+		}
+		if cronConf.GetMetricSnapshot() != "" {
+			cronMetricSnapshot = cronConf.GetMetricSnapshot()
+		}
+		if cronConf.GetBulkOperation() != "" {
+			cronBulkOperation = cronConf.GetBulkOperation()
+		}
+		if cronConf.GetMetricsAddr() != "" {
+			metricsAddr = cronConf.GetMetricsAddr()
+		}
+		if cronConf.GetAdmin().GetHttp().GetAddr() != "" {
+			adminHTTPAddr = cronConf.GetAdmin().GetHttp().GetAddr()
+		}
 	}
 
-	// 创建定时任务调度器（支持秒级调度）
-	cronScheduler := cron.New(cron.WithSeconds())
+	// 定时任务调度器：多副本部署时只有主节点执行任务，执行记录保存在 job_run，成为主节点时补跑错过的计划
+	cronScheduler := app.scheduler
+	register := func(job *scheduler.Job) {
+		if err := cronScheduler.Register(job); err != nil {
+			log.Printf("Failed to add %s job: %v", job.Name, err)
+		}
+	}
 
 	// 1. 订阅过期检查
-	_, err = cronScheduler.AddFunc(cronExpiryCheck, func() {
-		log.Println("[CRON] Starting subscription expiration check...")
-		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
-		defer cancel()
-
-		count, uids, err := app.subscriptionUsecase.UpdateExpiredSubscriptions(ctx)
-		if err != nil {
-			log.Printf("[CRON] Error updating expired subscriptions: %v", err)
-		} else {
+	register(&scheduler.Job{
+		Name:    constants.CronJobExpiryCheck,
+		Spec:    cronExpiryCheck,
+		Timeout: 5 * time.Minute,
+		Run: func(ctx context.Context, _ time.Time, dryRun bool) (*biz.JobResult, error) {
+			count, uids, err := app.subscriptionUsecase.UpdateExpiredSubscriptions(ctx, dryRun)
+			if err != nil {
+				log.Printf("[CRON] Error updating expired subscriptions: %v", err)
+				return nil, err
+			}
+			if dryRun {
+				log.Printf("[CRON] [DRY RUN] Would update %d expired subscriptions: %v", count, uids)
+				return &biz.JobResult{TotalCount: count}, nil
+			}
 			log.Printf("[CRON] Updated %d expired subscriptions: %v", count, uids)
-			log.Println("[CRON] Finished subscription expiration check")
-		}
+			return &biz.JobResult{TotalCount: count, SuccessCount: count}, nil
+		},
 	})
-	if err != nil {
-		log.Printf("Failed to add expiration check job: %v", err)
-	}
 
 	// 2. 续费提醒
-	_, err = cronScheduler.AddFunc(cronRenewalReminder, func() {
-		log.Println("[CRON] Starting renewal reminder check...")
-		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
-		defer cancel()
-
-		subscriptions, total, err := app.subscriptionUsecase.GetExpiringSubscriptions(ctx, expiryCheckDays, 1, 100)
-		if err != nil {
-			log.Printf("[CRON] Error getting expiring subscriptions: %v", err)
-			return
-		}
+	register(&scheduler.Job{
+		Name:    constants.CronJobRenewalReminder,
+		Spec:    cronRenewalReminder,
+		Timeout: 5 * time.Minute,
+		Run: func(ctx context.Context, _ time.Time, dryRun bool) (*biz.JobResult, error) {
+			subscriptions, total, err := app.subscriptionUsecase.GetExpiringSubscriptions(ctx, expiryCheckDays, 1, 100)
+			if err != nil {
+				log.Printf("[CRON] Error getting expiring subscriptions: %v", err)
+				return nil, err
+			}
 
-		log.Printf("[CRON] Found %d subscriptions expiring within %d days", total, expiryCheckDays)
-		for _, sub := range subscriptions {
-			// TODO: 发送续费提醒通知
-			log.Printf("[CRON] Reminder: User %d subscription (plan: %s) expires at %s",
-				sub.UID, sub.PlanID, sub.EndTime.Format("2006-01-02 15:04:05"))
-		}
-		log.Println("[CRON] Finished renewal reminder check")
+			log.Printf("[CRON] Found %d subscriptions expiring within %d days", total, expiryCheckDays)
+			if dryRun {
+				return &biz.JobResult{TotalCount: total}, nil
+			}
+			for _, sub := range subscriptions {
+				// TODO: 发送续费提醒通知
+				log.Printf("[CRON] Reminder: User %s subscription (plan: %s) expires at %s",
+					sub.UID, sub.PlanID, sub.EndTime.Format("2006-01-02 15:04:05"))
+			}
+			return &biz.JobResult{TotalCount: total, SuccessCount: len(subscriptions)}, nil
+		},
 	})
-	if err != nil {
-		log.Printf("Failed to add renewal reminder job: %v", err)
-	}
 
 	// 3. 自动续费处理
-	_, err = cronScheduler.AddFunc(cronAutoRenewal, func() {
-		log.Println("[CRON] Starting auto-renewal process...")
-		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Minute)
-		defer cancel()
-
-		totalCount, successCount, failedCount, results, err := app.subscriptionUsecase.ProcessAutoRenewals(ctx, autoRenewDaysBefore, false)
-		if err != nil {
-			log.Printf("[CRON] Error processing auto-renewals: %v", err)
-		} else {
-			log.Printf("[CRON] Auto-renewal completed: total=%d, success=%d, failed=%d",
-				totalCount, successCount, failedCount)
+	register(&scheduler.Job{
+		Name:      constants.CronJobAutoRenewal,
+		Spec:      cronAutoRenewal,
+		Timeout:   10 * time.Minute,
+		Resumable: true,
+		Run: func(ctx context.Context, scheduledAt time.Time, dryRun bool) (*biz.JobResult, error) {
+			// 分批处理并保存进度，超时或中途退出后重试时从进度处继续（测试运行不保存进度）
+			var checkpoint biz.Checkpoint
+			if !dryRun {
+				checkpoint = app.jobRunUsecase.Checkpoint(constants.CronJobAutoRenewal, scheduledAt)
+			}
+			totalCount, successCount, failedCount, results, err := app.subscriptionUsecase.ProcessAutoRenewalsWithCheckpoint(ctx, autoRenewDaysBefore, dryRun, checkpoint)
 
 			// 记录详细结果
 			for _, result := range results {
 				if result.Success {
-					log.Printf("[CRON] Auto-renewal success: user=%d, plan=%s, order=%s",
+					log.Printf("[CRON] Auto-renewal success: user=%s, plan=%s, order=%s",
 						result.UID, result.PlanID, result.OrderID)
 				} else {
-					log.Printf("[CRON] Auto-renewal failed: user=%d, plan=%s, error=%s",
+					log.Printf("[CRON] Auto-renewal failed: user=%s, plan=%s, error=%s",
 						result.UID, result.PlanID, result.ErrorMessage)
 				}
 			}
-		}
-		log.Println("[CRON] Finished auto-renewal process")
+			jobResult := &biz.JobResult{TotalCount: totalCount, SuccessCount: successCount, FailedCount: failedCount}
+			if err != nil {
+				log.Printf("[CRON] Error processing auto-renewals: %v", err)
+				return jobResult, err
+			}
+			log.Printf("[CRON] Auto-renewal completed: total=%d, success=%d, failed=%d",
+				totalCount, successCount, failedCount)
+			return jobResult, nil
+		},
 	})
-	if err != nil {
-		log.Printf("Failed to add auto-renewal job: %v", err)
+
+	// 4. 调价通知
+	register(&scheduler.Job{
+		Name:    constants.CronJobPriceChangeNotice,
+		Spec:    cronPriceChangeNotice,
+		Timeout: 10 * time.Minute,
+		Run: func(ctx context.Context, _ time.Time, dryRun bool) (*biz.JobResult, error) {
+			totalCount, notifiedCount, _, err := app.subscriptionUsecase.ProcessPriceChangeNotices(ctx, priceChangeNoticeDays, dryRun)
+			if err != nil {
+				log.Printf("[CRON] Error processing price change notices: %v", err)
+				return nil, err
+			}
+			log.Printf("[CRON] Price change notice completed: total=%d, notified=%d", totalCount, notifiedCount)
+			return &biz.JobResult{TotalCount: totalCount, SuccessCount: notifiedCount}, nil
+		},
+	})
+
+	// 5. 汇率文件导入（未配置汇率文件时不启用）
+	exchangeRateFile := bc.GetExchangeRate().GetFilePath()
+	if exchangeRateFile != "" {
+		register(&scheduler.Job{
+			Name:    constants.CronJobExchangeRateImport,
+			Spec:    cronExchangeRateImport,
+			Timeout: 5 * time.Minute,
+			Run: func(ctx context.Context, _ time.Time, dryRun bool) (*biz.JobResult, error) {
+				count, err := app.subscriptionUsecase.ImportExchangeRates(ctx, "", dryRun)
+				if err != nil {
+					log.Printf("[CRON] Error importing exchange rates from %s: %v", exchangeRateFile, err)
+					return nil, err
+				}
+				if dryRun {
+					log.Printf("[CRON] [DRY RUN] Would import %d exchange rates from %s", count, exchangeRateFile)
+					return &biz.JobResult{TotalCount: count}, nil
+				}
+				log.Printf("[CRON] Imported %d exchange rates from %s", count, exchangeRateFile)
+				return &biz.JobResult{TotalCount: count, SuccessCount: count}, nil
+			},
+		})
 	}
 
+	// 6. 订阅指标快照（生成计划时间前一天的快照，停机期间错过的每一天都会补跑）
+	register(&scheduler.Job{
+		Name:       constants.CronJobMetricSnapshot,
+		Spec:       cronMetricSnapshot,
+		Timeout:    10 * time.Minute,
+		CatchUpAll: true,
+		Run: func(ctx context.Context, scheduledAt time.Time, dryRun bool) (*biz.JobResult, error) {
+			date := scheduledAt.UTC().AddDate(0, 0, -1)
+			count, err := app.subscriptionUsecase.GenerateMetricSnapshots(ctx, date, "", dryRun)
+			if err != nil {
+				log.Printf("[CRON] Error generating metric snapshots: %v", err)
+				return nil, err
+			}
+			if dryRun {
+				log.Printf("[CRON] [DRY RUN] Calculated %d metric snapshots on %s", count, date.Format("2006-01-02"))
+				return &biz.JobResult{TotalCount: count}, nil
+			}
+			log.Printf("[CRON] Generated %d metric snapshots on %s", count, date.Format("2006-01-02"))
+			return &biz.JobResult{TotalCount: count, SuccessCount: count}, nil
+		},
+	})
+
+	// 7. 批量操作（客服创建的批量延长、迁移套餐操作分批执行，进度保存在操作记录上，超时后下次从进度处继续）
+	register(&scheduler.Job{
+		Name:    constants.CronJobBulkOperation,
+		Spec:    cronBulkOperation,
+		Timeout: constants.DefaultBulkOperationTimeout,
+		Run: func(ctx context.Context, scheduledAt time.Time, dryRun bool) (*biz.JobResult, error) {
+			result, err := app.subscriptionUsecase.ProcessBulkOperations(ctx, dryRun)
+			if err != nil {
+				log.Printf("[CRON] Error processing bulk operations: %v", err)
+				return nil, err
+			}
+			if result.TotalCount > 0 {
+				log.Printf("[CRON] Bulk operations: total=%d, finished=%d, failed=%d", result.TotalCount, result.SuccessCount, result.FailedCount)
+			}
+			return result, nil
+		},
+	})
+
+	// 启动 Prometheus 指标端点
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", metrics.Handler())
+	metricsServer := &http.Server{Addr: metricsAddr, Handler: mux}
+	go func() {
+		if err := metricsServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			log.Printf("Metrics server error: %v", err)
+		}
+	}()
+
+	// 启动定时任务管理接口（任意实例均可处理，立即执行的任务在处理请求的实例上运行）
+	go func() {
+		if err := app.adminHTTPServer.Start(context.Background()); err != nil && err != http.ErrServerClosed {
+			log.Printf("Admin HTTP server error: %v", err)
+		}
+	}()
+	go func() {
+		if err := app.adminGRPCServer.Start(context.Background()); err != nil {
+			log.Printf("Admin gRPC server error: %v", err)
+		}
+	}()
+
 	// 启动定时任务
 	cronScheduler.Start()
 	log.Println("========================================")
@@ -167,6 +321,15 @@ func main() {
 	log.Printf("  - Expiration check:  %s", cronExpiryCheck)
 	log.Printf("  - Renewal reminder:  %s", cronRenewalReminder)
 	log.Printf("  - Auto-renewal:      %s", cronAutoRenewal)
+	log.Printf("  - Price change:      %s", cronPriceChangeNotice)
+	if exchangeRateFile != "" {
+		log.Printf("  - Exchange rate:     %s", cronExchangeRateImport)
+	}
+	log.Printf("  - Metric snapshot:   %s", cronMetricSnapshot)
+	log.Printf("  - Bulk operation:    %s", cronBulkOperation)
+	log.Printf("Metrics endpoint: http://%s/metrics", metricsAddr)
+	log.Printf("Admin endpoint: http://%s/v1/admin/cron/jobs", adminHTTPAddr)
+	log.Println("Jobs only run on the leader instance (Redis lock), runs are recorded in job_run")
 	log.Println("========================================")
 
 	// 优雅退出
@@ -176,7 +339,17 @@ func main() {
 
 	log.Println("Shutting down gracefully...")
 
-	// 停止定时任务
+	// 停止管理接口，不再接受新的手动触发
+	adminCtx, adminCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer adminCancel()
+	if err := app.adminHTTPServer.Stop(adminCtx); err != nil {
+		log.Printf("Failed to stop admin HTTP server: %v", err)
+	}
+	if err := app.adminGRPCServer.Stop(adminCtx); err != nil {
+		log.Printf("Failed to stop admin gRPC server: %v", err)
+	}
+
+	// 停止定时任务（等待手动触发的任务结束）
 	ctx := cronScheduler.Stop()
 	select {
 	case <-ctx.Done():
@@ -184,4 +357,11 @@ func main() {
 	case <-time.After(5 * time.Second):
 		log.Println("Cron jobs forced to stop after timeout")
 	}
+
+	// 停止指标端点
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := metricsServer.Shutdown(shutdownCtx); err != nil {
+		log.Printf("Failed to shutdown metrics server: %v", err)
+	}
 }
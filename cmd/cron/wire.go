@@ -8,14 +8,23 @@ import (
 	"xinyuan_tech/subscription-service/internal/biz"
 	"xinyuan_tech/subscription-service/internal/conf"
 	"xinyuan_tech/subscription-service/internal/data"
+	"xinyuan_tech/subscription-service/internal/scheduler"
+	"xinyuan_tech/subscription-service/internal/server"
+	"xinyuan_tech/subscription-service/internal/service"
 
 	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/transport/grpc"
+	"github.com/go-kratos/kratos/v2/transport/http"
 	"github.com/google/wire"
 )
 
 // CronApp Cron 应用结构
 type CronApp struct {
 	subscriptionUsecase *biz.SubscriptionUsecase
+	jobRunUsecase       *biz.JobRunUsecase
+	scheduler           *scheduler.Scheduler
+	adminHTTPServer     *http.Server
+	adminGRPCServer     *grpc.Server
 }
 
 // wireApp 初始化应用
@@ -24,13 +33,21 @@ func wireApp(*conf.Bootstrap) (*CronApp, func(), error) {
 		// Logger
 		wire.FieldsOf(new(*conf.Bootstrap), "Log"),
 		newLogger,
-		
+
 		// Data 层
 		data.ProviderSet,
-		
+
 		// Biz 层
 		biz.ProviderSet,
-		
+
+		// 定时任务调度器
+		scheduler.NewScheduler,
+
+		// 定时任务管理接口
+		service.NewCronAdminService,
+		server.NewAdminHTTPServer,
+		server.NewAdminGRPCServer,
+
 		// App 结构
 		wire.Struct(new(CronApp), "*"),
 	))
@@ -44,4 +61,3 @@ func newLogger(c *conf.Log) log.Logger {
 		"service.name", "subscription-cron",
 	)
 }
-
@@ -8,10 +8,15 @@ package main
 
 import (
 	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/transport/grpc"
+	"github.com/go-kratos/kratos/v2/transport/http"
 	"os"
 	"xinyuan_tech/subscription-service/internal/biz"
 	"xinyuan_tech/subscription-service/internal/conf"
 	"xinyuan_tech/subscription-service/internal/data"
+	"xinyuan_tech/subscription-service/internal/scheduler"
+	"xinyuan_tech/subscription-service/internal/server"
+	"xinyuan_tech/subscription-service/internal/service"
 )
 
 import (
@@ -34,6 +39,15 @@ func wireApp(bootstrap *conf.Bootstrap) (*CronApp, func(), error) {
 	userSubscriptionRepo := data.NewUserSubscriptionRepo(dataData, logger)
 	subscriptionOrderRepo := data.NewSubscriptionOrderRepo(dataData, logger)
 	subscriptionHistoryRepo := data.NewSubscriptionHistoryRepo(dataData, logger)
+	appSettingRepo := data.NewAppSettingRepo(dataData, logger)
+	regionGroupRepo := data.NewRegionGroupRepo(dataData, logger)
+	taxRuleRepo := data.NewTaxRuleRepo(dataData, logger)
+	invoiceRepo := data.NewInvoiceRepo(dataData, logger)
+	exchangeRateRepo := data.NewExchangeRateRepo(dataData, logger)
+	metricRepo := data.NewMetricRepo(dataData, logger)
+	priceChangeNoticeRepo := data.NewPriceChangeNoticeRepo(dataData, logger)
+	bulkOperationRepo := data.NewBulkOperationRepo(dataData, logger)
+	notifier := data.NewNotifier(dataData, logger)
 	paymentClient, err := data.NewPaymentClient(bootstrap)
 	if err != nil {
 		cleanup()
@@ -44,13 +58,29 @@ func wireApp(bootstrap *conf.Bootstrap) (*CronApp, func(), error) {
 		cleanup()
 		return nil, nil, err
 	}
-	regionDetectionService := biz.NewRegionDetectionService(passportClient, logger)
+	geoIPResolver, cleanup2, err := data.NewGeoIPResolver(bootstrap, logger)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	regionDetectionService := biz.NewRegionDetectionService(passportClient, geoIPResolver, appSettingRepo, bootstrap, logger)
 	redsync := data.NewRedsync(client)
-	subscriptionUsecase := biz.NewSubscriptionUsecase(planRepo, userSubscriptionRepo, subscriptionOrderRepo, subscriptionHistoryRepo, paymentClient, regionDetectionService, dataData, redsync, bootstrap, logger)
+	subscriptionUsecase := biz.NewSubscriptionUsecase(planRepo, userSubscriptionRepo, subscriptionOrderRepo, subscriptionHistoryRepo, appSettingRepo, regionGroupRepo, taxRuleRepo, invoiceRepo, exchangeRateRepo, metricRepo, priceChangeNoticeRepo, bulkOperationRepo, notifier, paymentClient, regionDetectionService, dataData, redsync, bootstrap, logger)
+	jobRunRepo := data.NewJobRunRepo(dataData, logger)
+	jobRunUsecase := biz.NewJobRunUsecase(jobRunRepo, logger)
+	schedulerScheduler := scheduler.NewScheduler(bootstrap, jobRunUsecase, redsync, logger)
+	cronAdminService := service.NewCronAdminService(schedulerScheduler, jobRunUsecase)
+	httpServer := server.NewAdminHTTPServer(bootstrap, cronAdminService, logger)
+	grpcServer := server.NewAdminGRPCServer(bootstrap, cronAdminService, logger)
 	cronApp := &CronApp{
 		subscriptionUsecase: subscriptionUsecase,
+		jobRunUsecase:       jobRunUsecase,
+		scheduler:           schedulerScheduler,
+		adminHTTPServer:     httpServer,
+		adminGRPCServer:     grpcServer,
 	}
 	return cronApp, func() {
+		cleanup2()
 		cleanup()
 	}, nil
 }
@@ -60,6 +90,10 @@ func wireApp(bootstrap *conf.Bootstrap) (*CronApp, func(), error) {
 // CronApp Cron 应用结构
 type CronApp struct {
 	subscriptionUsecase *biz.SubscriptionUsecase
+	jobRunUsecase       *biz.JobRunUsecase
+	scheduler           *scheduler.Scheduler
+	adminHTTPServer     *http.Server
+	adminGRPCServer     *grpc.Server
 }
 
 // newLogger 创建 logger
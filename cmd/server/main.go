@@ -6,6 +6,7 @@ import (
 	"os"
 
 	"xinyuan_tech/subscription-service/internal/conf"
+	"xinyuan_tech/subscription-service/internal/telemetry"
 
 	"github.com/gaoyong06/go-pkg/errors"
 	"github.com/gaoyong06/go-pkg/logger"
@@ -14,6 +15,7 @@ import (
 	"github.com/go-kratos/kratos/v2/config"
 	"github.com/go-kratos/kratos/v2/config/file"
 	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/middleware/tracing"
 	"github.com/go-kratos/kratos/v2/transport/grpc"
 	"github.com/go-kratos/kratos/v2/transport/http"
 	_ "go.uber.org/automaxprocs"
@@ -120,8 +122,17 @@ func main() {
 		"service.id", id,
 		"service.name", Name,
 		"service.version", Version,
+		"trace.id", tracing.TraceID(),
+		"span.id", tracing.SpanID(),
 	)
 
+	// 初始化链路追踪（OpenTelemetry）
+	shutdownTracer, err := telemetry.InitTracer(bc.GetTrace(), Name, Version)
+	if err != nil {
+		panic(err)
+	}
+	defer shutdownTracer()
+
 	// 初始化全局错误管理器
 	// 假设 i18n 配置文件在 configs/i18n 目录下
 	errors.InitGlobalErrorManager("configs/i18n", func(ctx context.Context) string {
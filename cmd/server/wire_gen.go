@@ -12,6 +12,7 @@ import (
 	"xinyuan_tech/subscription-service/internal/biz"
 	"xinyuan_tech/subscription-service/internal/conf"
 	"xinyuan_tech/subscription-service/internal/data"
+	"xinyuan_tech/subscription-service/internal/invoice"
 	"xinyuan_tech/subscription-service/internal/server"
 	"xinyuan_tech/subscription-service/internal/service"
 )
@@ -34,6 +35,15 @@ func wireApp(bootstrap *conf.Bootstrap, logger log.Logger) (*kratos.App, func(),
 	userSubscriptionRepo := data.NewUserSubscriptionRepo(dataData, logger)
 	subscriptionOrderRepo := data.NewSubscriptionOrderRepo(dataData, logger)
 	subscriptionHistoryRepo := data.NewSubscriptionHistoryRepo(dataData, logger)
+	appSettingRepo := data.NewAppSettingRepo(dataData, logger)
+	regionGroupRepo := data.NewRegionGroupRepo(dataData, logger)
+	taxRuleRepo := data.NewTaxRuleRepo(dataData, logger)
+	invoiceRepo := data.NewInvoiceRepo(dataData, logger)
+	exchangeRateRepo := data.NewExchangeRateRepo(dataData, logger)
+	metricRepo := data.NewMetricRepo(dataData, logger)
+	priceChangeNoticeRepo := data.NewPriceChangeNoticeRepo(dataData, logger)
+	bulkOperationRepo := data.NewBulkOperationRepo(dataData, logger)
+	notifier := data.NewNotifier(dataData, logger)
 	paymentClient, err := data.NewPaymentClient(bootstrap)
 	if err != nil {
 		cleanup()
@@ -44,14 +54,25 @@ func wireApp(bootstrap *conf.Bootstrap, logger log.Logger) (*kratos.App, func(),
 		cleanup()
 		return nil, nil, err
 	}
-	regionDetectionService := biz.NewRegionDetectionService(passportClient, logger)
+	geoIPResolver, cleanup2, err := data.NewGeoIPResolver(bootstrap, logger)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	regionDetectionService := biz.NewRegionDetectionService(passportClient, geoIPResolver, appSettingRepo, bootstrap, logger)
 	redsync := data.NewRedsync(client)
-	subscriptionUsecase := biz.NewSubscriptionUsecase(planRepo, userSubscriptionRepo, subscriptionOrderRepo, subscriptionHistoryRepo, paymentClient, regionDetectionService, dataData, redsync, bootstrap, logger)
-	subscriptionService := service.NewSubscriptionService(subscriptionUsecase)
-	grpcServer := server.NewGRPCServer(bootstrap, subscriptionService, logger)
-	httpServer := server.NewHTTPServer(bootstrap, subscriptionService, logger)
+	subscriptionUsecase := biz.NewSubscriptionUsecase(planRepo, userSubscriptionRepo, subscriptionOrderRepo, subscriptionHistoryRepo, appSettingRepo, regionGroupRepo, taxRuleRepo, invoiceRepo, exchangeRateRepo, metricRepo, priceChangeNoticeRepo, bulkOperationRepo, notifier, paymentClient, regionDetectionService, dataData, redsync, bootstrap, logger)
+	renderer := invoice.NewRenderer(bootstrap)
+	subscriptionService := service.NewSubscriptionService(subscriptionUsecase, renderer)
+	supportService := service.NewSupportService(subscriptionUsecase)
+	healthChecker := data.NewHealthChecker(dataData, paymentClient, passportClient)
+	healthUsecase := biz.NewHealthUsecase(healthChecker, logger)
+	healthService := service.NewHealthService(healthUsecase)
+	grpcServer := server.NewGRPCServer(bootstrap, subscriptionService, supportService, healthService, logger)
+	httpServer := server.NewHTTPServer(bootstrap, subscriptionService, supportService, healthService, logger)
 	app := newApp(logger, grpcServer, httpServer)
 	return app, func() {
+		cleanup2()
 		cleanup()
 	}, nil
 }
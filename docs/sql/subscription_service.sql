@@ -6,7 +6,10 @@ CREATE TABLE `plan` (
   `description` varchar(255) DEFAULT '' COMMENT '描述',
   `price` decimal(10,2) NOT NULL COMMENT '默认价格（用于兜底，如果plan_pricing表中没有对应地域的价格）',
   `currency` varchar(10) NOT NULL DEFAULT 'USD' COMMENT '默认币种（用于兜底）',
-  `duration_days` int NOT NULL COMMENT '持续天数',
+  `duration_days` int NOT NULL COMMENT '持续天数（interval_count 为 0 时按天数计费，否则为按周期折算的名义天数）',
+  `interval_unit` enum('day', 'week', 'month', 'year') NOT NULL DEFAULT 'day' COMMENT '计费周期单位: day-天, week-周, month-月(按日历月，月末截断), year-年(按日历年)',
+  `interval_count` int NOT NULL DEFAULT 0 COMMENT '计费周期数量（如 3 month 表示季付，0 表示按 duration_days 计费）',
+  `billing_type` enum('recurring', 'lifetime') NOT NULL DEFAULT 'recurring' COMMENT '计费类型: recurring-周期订阅, lifetime-终身(一次性买断，订阅永不过期)',
   `type` varchar(20) NOT NULL COMMENT '类型',
   `created_at` datetime DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
   `updated_at` datetime DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
@@ -21,13 +24,15 @@ CREATE TABLE `plan_pricing` (
   `plan_pricing_id` bigint unsigned NOT NULL AUTO_INCREMENT COMMENT '主键',
   `plan_id` varchar(50) NOT NULL COMMENT '套餐ID（关联plan表）',
   `app_id` varchar(50) NOT NULL DEFAULT '' COMMENT '应用ID（冗余字段，通过plan_id关联，便于按app查询）',
-  `country_code` varchar(10) NOT NULL COMMENT '国家代码（ISO 3166-1 alpha-2，如CN, US, DE等）',
+  `country_code` varchar(10) NOT NULL COMMENT '国家代码（ISO 3166-1 alpha-2，如CN, US, DE等）或地区组代码（如EU, LATAM）',
   `price` decimal(10,2) NOT NULL COMMENT '价格',
   `currency` varchar(10) NOT NULL COMMENT '币种',
+  `effective_from` datetime NOT NULL DEFAULT '1970-01-01 00:00:00' COMMENT '生效开始时间（包含，1970-01-01 表示不限；唯一索引中 NULL 互不相等，不使用 NULL）',
+  `effective_to` datetime DEFAULT NULL COMMENT '生效结束时间（不包含，NULL 表示不限）',
   `created_at` datetime DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
   `updated_at` datetime DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
   PRIMARY KEY (`plan_pricing_id`),
-  UNIQUE KEY `uk_plan_country` (`plan_id`, `country_code`),
+  UNIQUE KEY `uk_plan_country_from` (`plan_id`, `country_code`, `effective_from`),
   KEY `idx_plan_id` (`plan_id`),
   KEY `idx_app_id` (`app_id`),
   KEY `idx_app_plan_country` (`app_id`, `plan_id`, `country_code`),
@@ -39,11 +44,16 @@ CREATE TABLE `user_subscription` (
   `uid` bigint unsigned NOT NULL COMMENT '用户ID',
   `plan_id` varchar(50) NOT NULL COMMENT '当前套餐ID',
   `app_id` varchar(50) NOT NULL DEFAULT '' COMMENT '应用ID（冗余字段，通过plan_id关联，便于按app统计和查询）',
+  `country_code` varchar(10) NOT NULL DEFAULT '' COMMENT '定价地区（最近一次购买时的国家代码，自动续费按该地区定价）',
   `start_time` datetime NOT NULL COMMENT '开始时间',
-  `end_time` datetime NOT NULL COMMENT '结束时间',
+  `end_time` datetime DEFAULT NULL COMMENT '结束时间（终身订阅为 NULL，永不过期）',
+  `billing_anchor` datetime DEFAULT NULL COMMENT '计费锚点（按锚点日期推算月/年周期的结束时间，月末自动截断）',
   `status` enum('active', 'expired', 'paused', 'cancelled') NOT NULL DEFAULT 'active' COMMENT '订阅状态: active-活跃(订阅有效中), expired-过期(订阅已过期), paused-暂停(用户主动暂停), cancelled-已取消(用户主动取消)',
   `order_id` varchar(64) NOT NULL DEFAULT '' COMMENT '订单ID（关联subscription_order表）',
   `is_auto_renew` tinyint(1) NOT NULL DEFAULT 0 COMMENT '是否自动续费',
+  `source` enum('purchase', 'comp', 'manual') NOT NULL DEFAULT 'purchase' COMMENT '当前周期的来源: purchase-购买, comp-赠送, manual-手动开通（赠送和手动开通不计入 MRR）',
+  `next_plan_id` varchar(50) NOT NULL DEFAULT '' COMMENT '下次续费时切换到的套餐（批量迁移套餐时设置，续费成功后清空）',
+  `version` int NOT NULL DEFAULT 0 COMMENT '版本号（每次更新递增，用于条件更新）',
   `created_at` datetime DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
   `updated_at` datetime DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
   PRIMARY KEY (`subscription_id`),
@@ -51,7 +61,8 @@ CREATE TABLE `user_subscription` (
   KEY `idx_app_id` (`app_id`),
   KEY `idx_app_uid` (`app_id`, `uid`),
   KEY `idx_end_time` (`end_time`),
-  KEY `idx_order_id` (`order_id`)
+  KEY `idx_order_id` (`order_id`),
+  KEY `idx_app_plan` (`app_id`, `plan_id`)
 ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='用户订阅表';
 
 CREATE TABLE `subscription_order` (
@@ -60,13 +71,29 @@ CREATE TABLE `subscription_order` (
   `uid` bigint unsigned NOT NULL COMMENT '用户ID',
   `plan_id` varchar(50) NOT NULL COMMENT '套餐ID',
   `app_id` varchar(50) DEFAULT '' COMMENT '应用ID',
-  `amount` decimal(10,2) NOT NULL COMMENT '金额',
-  `payment_status` enum('pending', 'success', 'failed', 'closed', 'refunded', 'partially_refunded') NOT NULL DEFAULT 'pending' COMMENT '支付状态(与payment-service保持一致): pending-待支付(订单已创建，等待支付), success-支付成功, failed-支付失败, closed-订单关闭, refunded-已全额退款, partially_refunded-部分退款',
+  `country_code` varchar(10) NOT NULL DEFAULT '' COMMENT '定价地区（下单时使用的国家代码）',
+  `currency` varchar(10) NOT NULL DEFAULT '' COMMENT '币种',
+  `subtotal` decimal(10,2) NOT NULL DEFAULT 0 COMMENT '套餐价格',
+  `discount_amount` decimal(10,2) NOT NULL DEFAULT 0 COMMENT '优惠金额',
+  `proration_credit` decimal(10,2) NOT NULL DEFAULT 0 COMMENT '切换套餐时当前周期未使用部分的抵扣金额',
+  `credit_order_id` varchar(64) NOT NULL DEFAULT '' COMMENT '被抵扣未使用部分的订单（支付时已不是用户订阅的当前生效订单则不开通，标记为需要退款）',
+  `vat_id` varchar(20) NOT NULL DEFAULT '' COMMENT '买方 VAT ID（B2B 反向征税）',
+  `tax_amount` decimal(10,2) NOT NULL DEFAULT 0 COMMENT '税额',
+  `amount` decimal(10,2) NOT NULL COMMENT '实付金额（套餐价格 - 优惠 - 抵扣 + 不含税规则加收的税额）',
+  `refunded_amount` decimal(10,2) NOT NULL DEFAULT 0 COMMENT '累计退款金额',
+  `paid_at` datetime DEFAULT NULL COMMENT '支付成功时间',
+  `reporting_currency` varchar(10) NOT NULL DEFAULT '' COMMENT '支付时应用的报表币种',
+  `exchange_rate` decimal(18,8) NOT NULL DEFAULT 0 COMMENT '支付时订单币种到报表币种的汇率（0 表示支付时没有可用汇率）',
+  `period_start` datetime DEFAULT NULL COMMENT '本订单购买的订阅周期开始时间（支付成功后记录）',
+  `period_end` datetime DEFAULT NULL COMMENT '本订单购买的订阅周期结束时间（终身套餐为 NULL）',
+  `payment_status` enum('pending', 'success', 'failed', 'closed', 'refunded', 'partially_refunded', 'refund_required') NOT NULL DEFAULT 'pending' COMMENT '支付状态(与payment-service保持一致): pending-待支付(订单已创建，等待支付), success-支付成功, failed-支付失败, closed-订单关闭, refunded-已全额退款, partially_refunded-部分退款, refund_required-已支付但未开通订阅需要退款',
+  `source` enum('purchase', 'comp', 'manual') NOT NULL DEFAULT 'purchase' COMMENT '订单来源: purchase-购买, comp-赠送, manual-手动开通（赠送和手动开通为零金额记账订单，不计入收入）',
   `created_at` datetime DEFAULT CURRENT_TIMESTAMP,
   PRIMARY KEY (`order_id`),
   KEY `idx_uid` (`uid`),
   KEY `idx_app_id` (`app_id`),
-  KEY `idx_payment_id` (`payment_id`)
+  KEY `idx_payment_id` (`payment_id`),
+  KEY `idx_app_paid_at` (`app_id`, `paid_at`)
 ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='订阅订单表';
 
 -- 订阅历史记录表
@@ -77,21 +104,256 @@ CREATE TABLE `subscription_history` (
   `plan_name` varchar(100) NOT NULL COMMENT '套餐名称',
   `app_id` varchar(50) NOT NULL DEFAULT '' COMMENT '应用ID（冗余字段，通过plan_id关联，便于按app统计和查询）',
   `start_time` datetime NOT NULL COMMENT '开始时间',
-  `end_time` datetime NOT NULL COMMENT '结束时间',
+  `end_time` datetime DEFAULT NULL COMMENT '结束时间（终身订阅为 NULL）',
   `status` varchar(20) NOT NULL COMMENT '状态',
-  `action` enum('created', 'renewed', 'upgraded', 'paused', 'resumed', 'cancelled', 'expired', 'enabled_auto_renew', 'disabled_auto_renew') NOT NULL COMMENT '操作类型: created-创建, renewed-续费, upgraded-升级, paused-暂停, resumed-恢复, cancelled-取消, expired-过期, enabled_auto_renew-启用自动续费, disabled_auto_renew-禁用自动续费',
+  `action` enum('created', 'renewed', 'upgraded', 'paused', 'resumed', 'cancelled', 'expired', 'enabled_auto_renew', 'disabled_auto_renew', 'downgraded_to_free', 'extended', 'shortened', 'granted', 'plan_change_scheduled') NOT NULL COMMENT '操作类型: created-创建, renewed-续费, upgraded-升级, paused-暂停, resumed-恢复, cancelled-取消, expired-过期, enabled_auto_renew-启用自动续费, disabled_auto_renew-禁用自动续费, downgraded_to_free-回落到默认免费套餐, extended-客服延长, shortened-客服缩短, granted-客服赠送, plan_change_scheduled-批量迁移套餐（下次续费时切换到 changes 中 next_plan_id 的套餐）',
+  `actor_type` varchar(20) NOT NULL DEFAULT '' COMMENT '操作人类型: user-用户, admin-管理员, cron-定时任务, payment-支付回调, system-系统',
+  `operator_id` varchar(64) NOT NULL DEFAULT '' COMMENT '操作人ID（用户或管理员的用户ID、定时任务名、支付ID）',
+  `reason` varchar(255) NOT NULL DEFAULT '' COMMENT '操作原因（用户取消、暂停时填写的原因，客服操作时必填）',
+  `request_id` varchar(64) NOT NULL DEFAULT '' COMMENT '请求ID（同一请求或同一次定时任务执行的修改相同）',
+  `changes` text COMMENT '字段变更（JSON 数组: [{"field","before","after"}]）',
   `created_at` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
   PRIMARY KEY (`subscription_history_id`),
   KEY `idx_uid` (`uid`),
   KEY `idx_app_id` (`app_id`),
   KEY `idx_app_uid` (`app_id`, `uid`),
+  KEY `idx_request_id` (`request_id`),
   KEY `idx_created_at` (`created_at`)
 ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='订阅历史记录表';
 
+-- 应用订阅配置表
+CREATE TABLE `app_setting` (
+  `app_id` varchar(50) NOT NULL COMMENT '应用ID',
+  `default_free_plan_id` varchar(50) NOT NULL DEFAULT '' COMMENT '默认免费套餐ID（订阅过期、取消或退款后自动回落到该套餐，为空表示不回落）',
+  `region_detection` varchar(255) NOT NULL DEFAULT '' COMMENT '地区推断顺序（逗号分隔，如 geoip,accept_language，为空表示使用全局配置）',
+  `reporting_currency` varchar(10) NOT NULL DEFAULT '' COMMENT '报表币种（收入报表按该币种折算，为空表示使用全局配置）',
+  `created_at` datetime DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+  `updated_at` datetime DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
+  PRIMARY KEY (`app_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='应用订阅配置表';
+
+-- 地区组表（如 EU、LATAM、SEA，组内国家共用区域定价）
+CREATE TABLE `region_group` (
+  `group_code` varchar(10) NOT NULL COMMENT '地区组代码（2-10位大写字母）',
+  `name` varchar(100) NOT NULL COMMENT '地区组名称',
+  `description` varchar(255) DEFAULT '' COMMENT '描述',
+  `created_at` datetime DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+  `updated_at` datetime DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
+  PRIMARY KEY (`group_code`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='地区组表';
+
+-- 地区组成员国家表（每个国家只能属于一个地区组）
+CREATE TABLE `region_group_country` (
+  `region_group_country_id` bigint unsigned NOT NULL AUTO_INCREMENT COMMENT '主键',
+  `group_code` varchar(10) NOT NULL COMMENT '地区组代码（关联region_group表）',
+  `country_code` varchar(2) NOT NULL COMMENT '国家代码（ISO 3166-1 alpha-2）',
+  PRIMARY KEY (`region_group_country_id`),
+  UNIQUE KEY `uk_country_code` (`country_code`),
+  KEY `idx_group_code` (`group_code`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='地区组成员国家表';
+
+-- 续费调价通知记录表（同一用户、套餐、续费时间只通知一次）
+CREATE TABLE `price_change_notice` (
+  `price_change_notice_id` bigint unsigned NOT NULL AUTO_INCREMENT COMMENT '主键',
+  `uid` varchar(36) NOT NULL COMMENT '用户ID',
+  `app_id` varchar(50) NOT NULL DEFAULT '' COMMENT '应用ID',
+  `plan_id` varchar(50) NOT NULL COMMENT '套餐ID',
+  `country_code` varchar(10) NOT NULL DEFAULT '' COMMENT '定价地区',
+  `old_price` decimal(10,2) NOT NULL COMMENT '当前价格',
+  `old_currency` varchar(10) NOT NULL COMMENT '当前币种',
+  `new_price` decimal(10,2) NOT NULL COMMENT '续费时的新价格',
+  `new_currency` varchar(10) NOT NULL COMMENT '续费时的新币种',
+  `renew_at` datetime NOT NULL COMMENT '按新价格续费的时间（当前订阅结束时间）',
+  `created_at` datetime DEFAULT CURRENT_TIMESTAMP COMMENT '通知时间',
+  PRIMARY KEY (`price_change_notice_id`),
+  UNIQUE KEY `uk_uid_plan_renew` (`uid`, `plan_id`, `renew_at`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='续费调价通知记录表';
+
+-- 国家税务规则表（同一国家可以有多个税种，如加拿大 GST + PST）
+CREATE TABLE `tax_rule` (
+  `tax_rule_id` bigint unsigned NOT NULL AUTO_INCREMENT COMMENT '主键',
+  `country_code` varchar(2) NOT NULL COMMENT '国家代码（ISO 3166-1 alpha-2）',
+  `name` varchar(50) NOT NULL COMMENT '税种名称，如 VAT、GST',
+  `rate` decimal(6,4) NOT NULL COMMENT '税率（0.2000 表示 20%）',
+  `inclusive` tinyint(1) NOT NULL DEFAULT 0 COMMENT '定价是否含税: 1-从价格中拆出税额, 0-在价格之上加收',
+  `reverse_charge` tinyint(1) NOT NULL DEFAULT 0 COMMENT '是否支持 B2B 反向征税（买方提供 VAT ID 时不收税）',
+  `enabled` tinyint(1) NOT NULL DEFAULT 1 COMMENT '是否启用',
+  `created_at` datetime DEFAULT CURRENT_TIMESTAMP,
+  `updated_at` datetime DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+  PRIMARY KEY (`tax_rule_id`),
+  UNIQUE KEY `uk_country_name` (`country_code`, `name`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='国家税务规则表';
+
+-- 订单税费明细表（创建订单时按当时的税务规则生成，之后不随规则变化）
+CREATE TABLE `subscription_order_tax_line` (
+  `order_tax_line_id` bigint unsigned NOT NULL AUTO_INCREMENT COMMENT '主键',
+  `order_id` varchar(64) NOT NULL COMMENT '订单号（关联subscription_order表）',
+  `name` varchar(50) NOT NULL COMMENT '税种名称',
+  `country_code` varchar(2) NOT NULL COMMENT '国家代码',
+  `rate` decimal(6,4) NOT NULL COMMENT '税率',
+  `inclusive` tinyint(1) NOT NULL DEFAULT 0 COMMENT '是否含税定价',
+  `reverse_charge` tinyint(1) NOT NULL DEFAULT 0 COMMENT '是否反向征税（税额为 0）',
+  `taxable_amount` decimal(10,2) NOT NULL COMMENT '计税金额（不含税）',
+  `amount` decimal(10,2) NOT NULL COMMENT '税额',
+  `created_at` datetime DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (`order_tax_line_id`),
+  KEY `idx_order_id` (`order_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='订单税费明细表';
+
+-- 发票表（支付成功后开具发票，退款时开具红字发票；开具后不可修改）
+CREATE TABLE `invoice` (
+  `invoice_id` bigint unsigned NOT NULL AUTO_INCREMENT COMMENT '主键',
+  `invoice_no` varchar(32) NOT NULL COMMENT '发票编号（应用内按类型连续递增，如 INV-000001、CN-000001）',
+  `invoice_type` enum('invoice', 'credit_note') NOT NULL COMMENT '类型: invoice-发票, credit_note-红字发票',
+  `app_id` varchar(50) NOT NULL COMMENT '应用ID',
+  `uid` varchar(36) NOT NULL COMMENT '用户ID',
+  `order_id` varchar(64) NOT NULL COMMENT '订单号（关联subscription_order表）',
+  `original_invoice_no` varchar(32) NOT NULL DEFAULT '' COMMENT '红字发票冲减的原发票编号',
+  `plan_id` varchar(50) NOT NULL COMMENT '套餐ID',
+  `plan_name` varchar(100) NOT NULL DEFAULT '' COMMENT '套餐名称（开票时）',
+  `country_code` varchar(10) NOT NULL DEFAULT '' COMMENT '定价地区',
+  `vat_id` varchar(20) NOT NULL DEFAULT '' COMMENT '买方 VAT ID',
+  `currency` varchar(10) NOT NULL COMMENT '币种',
+  `subtotal` decimal(10,2) NOT NULL COMMENT '套餐价格（红字发票为冲减的不含税金额）',
+  `discount` decimal(10,2) NOT NULL DEFAULT 0 COMMENT '优惠金额',
+  `proration_credit` decimal(10,2) NOT NULL DEFAULT 0 COMMENT '切换套餐抵扣金额',
+  `tax_amount` decimal(10,2) NOT NULL DEFAULT 0 COMMENT '税额',
+  `total` decimal(10,2) NOT NULL COMMENT '发票金额（红字发票为退款金额）',
+  `period_start` datetime DEFAULT NULL COMMENT '服务周期开始时间',
+  `period_end` datetime DEFAULT NULL COMMENT '服务周期结束时间（终身套餐为 NULL）',
+  `issued_at` datetime NOT NULL COMMENT '开票时间',
+  PRIMARY KEY (`invoice_id`),
+  UNIQUE KEY `uk_app_invoice_no` (`app_id`, `invoice_no`),
+  KEY `idx_app_uid` (`app_id`, `uid`),
+  KEY `idx_order_id` (`order_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='发票表';
+
+-- 发票明细表
+CREATE TABLE `invoice_line` (
+  `invoice_line_id` bigint unsigned NOT NULL AUTO_INCREMENT COMMENT '主键',
+  `invoice_id` bigint unsigned NOT NULL COMMENT '发票ID（关联invoice表）',
+  `line_type` varchar(20) NOT NULL COMMENT '明细类型: plan-套餐, discount-优惠, proration-切换套餐抵扣, tax-税费, refund-退款',
+  `description` varchar(255) NOT NULL DEFAULT '' COMMENT '套餐名称或税种名称',
+  `period_start` datetime DEFAULT NULL COMMENT '服务周期开始时间',
+  `period_end` datetime DEFAULT NULL COMMENT '服务周期结束时间',
+  `tax_rate` decimal(6,4) NOT NULL DEFAULT 0 COMMENT '税率（税费明细）',
+  `inclusive` tinyint(1) NOT NULL DEFAULT 0 COMMENT '是否含税定价（税费明细）',
+  `reverse_charge` tinyint(1) NOT NULL DEFAULT 0 COMMENT '是否反向征税（税费明细）',
+  `amount` decimal(10,2) NOT NULL COMMENT '金额（优惠和抵扣为负数）',
+  PRIMARY KEY (`invoice_line_id`),
+  KEY `idx_invoice_id` (`invoice_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='发票明细表';
+
+-- 发票编号序列表（每个应用、每种发票类型一个序列，开票时加行锁分配编号）
+CREATE TABLE `invoice_sequence` (
+  `app_id` varchar(50) NOT NULL COMMENT '应用ID',
+  `invoice_type` varchar(20) NOT NULL COMMENT '发票类型',
+  `next_value` bigint unsigned NOT NULL COMMENT '下一个编号',
+  PRIMARY KEY (`app_id`, `invoice_type`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='发票编号序列表';
+
+-- 汇率表（1 from_currency = rate to_currency，从生效时间起生效直到同一币种对的下一条汇率）
+CREATE TABLE `exchange_rate` (
+  `exchange_rate_id` bigint unsigned NOT NULL AUTO_INCREMENT COMMENT '汇率ID',
+  `from_currency` varchar(10) NOT NULL COMMENT '源币种',
+  `to_currency` varchar(10) NOT NULL COMMENT '目标币种',
+  `rate` decimal(18,8) NOT NULL COMMENT '汇率',
+  `effective_at` datetime NOT NULL COMMENT '生效时间',
+  `source` varchar(20) NOT NULL DEFAULT '' COMMENT '来源: admin-管理接口, file-本地汇率文件',
+  `created_at` datetime DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+  PRIMARY KEY (`exchange_rate_id`),
+  UNIQUE KEY `uk_pair_effective` (`from_currency`, `to_currency`, `effective_at`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='汇率表';
+
+-- 订阅指标日快照表（Cron 每天凌晨生成前一天的快照，MRR/ARR 按应用报表币种折算）
+CREATE TABLE `subscription_metric_snapshot` (
+  `metric_snapshot_id` bigint unsigned NOT NULL AUTO_INCREMENT COMMENT '快照ID',
+  `app_id` varchar(50) NOT NULL COMMENT '应用ID',
+  `snapshot_date` date NOT NULL COMMENT '快照日期（UTC）',
+  `reporting_currency` varchar(10) NOT NULL DEFAULT '' COMMENT 'MRR/ARR 的币种',
+  `active_subscribers` int NOT NULL DEFAULT 0 COMMENT '活跃订阅数（包含免费套餐和终身套餐，不包含暂停中的订阅）',
+  `paying_subscribers` int NOT NULL DEFAULT 0 COMMENT '付费周期订阅数（计入 MRR）',
+  `mrr` decimal(14,2) NOT NULL DEFAULT 0 COMMENT '月度经常性收入（不含税）',
+  `arr` decimal(14,2) NOT NULL DEFAULT 0 COMMENT '年度经常性收入',
+  `new_subscribers` int NOT NULL DEFAULT 0 COMMENT '当天首次订阅的用户数',
+  `churned_subscribers` int NOT NULL DEFAULT 0 COMMENT '当天过期或取消的用户数',
+  `reactivated_subscribers` int NOT NULL DEFAULT 0 COMMENT '当天过期或取消后重新付费的用户数',
+  `state_unavailable` tinyint(1) NOT NULL DEFAULT 0 COMMENT '活跃订阅数和 MRR 是否不可用（补生成的更早日期无法还原当天的订阅状态）',
+  `created_at` datetime DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+  `updated_at` datetime DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
+  PRIMARY KEY (`metric_snapshot_id`),
+  UNIQUE KEY `uk_app_date` (`app_id`, `snapshot_date`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='订阅指标日快照表';
+
+-- 定时任务执行记录表
+CREATE TABLE `job_run` (
+  `job_run_id` bigint unsigned NOT NULL AUTO_INCREMENT COMMENT '执行记录ID',
+  `job_name` varchar(64) NOT NULL COMMENT '任务名称',
+  `scheduled_at` datetime NOT NULL COMMENT '计划执行时间（UTC，同一任务同一计划时间只执行一次）',
+  `trigger_type` varchar(20) NOT NULL DEFAULT '' COMMENT '触发方式: schedule-定时, catch_up-停机后补跑, retry-失败后重试, manual-手动触发',
+  `status` varchar(20) NOT NULL DEFAULT '' COMMENT '状态: running, success, failed, skipped-任务已暂停',
+  `host` varchar(128) NOT NULL DEFAULT '' COMMENT '执行实例主机名',
+  `attempts` int NOT NULL DEFAULT 1 COMMENT '执行次数（失败后重试同一计划时递增）',
+  `dry_run` tinyint(1) NOT NULL DEFAULT 0 COMMENT '是否测试运行（只统计不修改数据）',
+  `started_at` datetime NOT NULL COMMENT '开始时间',
+  `finished_at` datetime DEFAULT NULL COMMENT '结束时间',
+  `total_count` int NOT NULL DEFAULT 0 COMMENT '处理总数',
+  `success_count` int NOT NULL DEFAULT 0 COMMENT '成功数',
+  `failed_count` int NOT NULL DEFAULT 0 COMMENT '失败数',
+  `error` text COMMENT '错误信息',
+  `checkpoint` text COMMENT '任务进度（JSON），重试时从进度处继续',
+  `created_at` datetime DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+  `updated_at` datetime DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
+  PRIMARY KEY (`job_run_id`),
+  UNIQUE KEY `uk_job_scheduled` (`job_name`, `scheduled_at`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='定时任务执行记录表';
+
+-- 定时任务设置表（暂停/恢复计划执行，所有 Cron 实例共享）
+CREATE TABLE `job_setting` (
+  `job_name` varchar(64) NOT NULL COMMENT '任务名称',
+  `paused` tinyint(1) NOT NULL DEFAULT 0 COMMENT '是否暂停计划执行（手动触发不受影响）',
+  `pause_reason` varchar(255) NOT NULL DEFAULT '' COMMENT '暂停原因',
+  `paused_at` datetime DEFAULT NULL COMMENT '暂停时间',
+  `created_at` datetime DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+  `updated_at` datetime DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
+  PRIMARY KEY (`job_name`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='定时任务设置表';
+
+-- 批量操作表（按筛选条件批量延长订阅或迁移套餐，由定时任务分批执行）
+CREATE TABLE `bulk_operation` (
+  `bulk_operation_id` bigint unsigned NOT NULL AUTO_INCREMENT COMMENT '批量操作ID',
+  `type` varchar(20) NOT NULL COMMENT '操作类型: extend-延长订阅, migrate_plan-下次续费时迁移到目标套餐',
+  `app_id` varchar(50) NOT NULL COMMENT '筛选条件：应用ID',
+  `plan_id` varchar(50) NOT NULL DEFAULT '' COMMENT '筛选条件：套餐ID（迁移套餐时为原套餐）',
+  `filter_status` varchar(20) NOT NULL DEFAULT '' COMMENT '筛选条件：订阅状态',
+  `days` int NOT NULL DEFAULT 0 COMMENT '延长天数（extend）',
+  `target_plan_id` varchar(50) NOT NULL DEFAULT '' COMMENT '目标套餐ID（migrate_plan）',
+  `operator_id` varchar(36) NOT NULL DEFAULT '' COMMENT '操作人（管理员的用户ID）',
+  `reason` varchar(255) NOT NULL DEFAULT '' COMMENT '操作原因（记录到每个用户的订阅历史）',
+  `status` varchar(20) NOT NULL DEFAULT 'pending' COMMENT '状态: pending-等待执行, running-执行中, completed-已完成, cancelled-已取消',
+  `max_subscription_id` bigint unsigned NOT NULL DEFAULT 0 COMMENT '创建时匹配的最大订阅ID（之后新建的订阅不处理）',
+  `cursor_subscription_id` bigint unsigned NOT NULL DEFAULT 0 COMMENT '进度：已处理的最后一个订阅ID',
+  `total_count` int NOT NULL DEFAULT 0 COMMENT '创建时匹配的订阅数',
+  `processed_count` int NOT NULL DEFAULT 0 COMMENT '已处理数',
+  `success_count` int NOT NULL DEFAULT 0 COMMENT '成功数',
+  `skipped_count` int NOT NULL DEFAULT 0 COMMENT '跳过数（处理时已不满足筛选条件）',
+  `failed_count` int NOT NULL DEFAULT 0 COMMENT '失败数',
+  `error` varchar(1024) NOT NULL DEFAULT '' COMMENT '最近一次失败的错误信息',
+  `started_at` datetime DEFAULT NULL COMMENT '开始执行时间',
+  `finished_at` datetime DEFAULT NULL COMMENT '结束时间（完成或取消）',
+  `created_at` datetime DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+  `updated_at` datetime DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
+  PRIMARY KEY (`bulk_operation_id`),
+  KEY `idx_status` (`status`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='批量操作表';
+
 -- 初始化数据示例（需要根据实际app_id和uid填写）
--- INSERT INTO `plan` (`plan_id`, `app_id`, `uid`, `name`, `description`, `price`, `currency`, `duration_days`, `type`) VALUES
--- ('plan_monthly', 'app_id_here', 'uid_here', 'Pro Monthly', 'Pro features for 1 month', 9.99, 'USD', 30, 'pro'),
--- ('plan_yearly', 'app_id_here', 'uid_here', 'Pro Yearly', 'Pro features for 1 year', 99.99, 'USD', 365, 'pro');
+-- INSERT INTO `plan` (`plan_id`, `app_id`, `uid`, `name`, `description`, `price`, `currency`, `duration_days`, `interval_unit`, `interval_count`, `type`) VALUES
+-- ('plan_monthly', 'app_id_here', 'uid_here', 'Pro Monthly', 'Pro features for 1 month', 9.99, 'USD', 30, 'month', 1, 'pro'),
+-- ('plan_quarterly', 'app_id_here', 'uid_here', 'Pro Quarterly', 'Pro features for 3 months', 25.99, 'USD', 90, 'month', 3, 'pro'),
+-- ('plan_yearly', 'app_id_here', 'uid_here', 'Pro Yearly', 'Pro features for 1 year', 99.99, 'USD', 365, 'year', 1, 'pro');
+-- INSERT INTO `plan` (`plan_id`, `app_id`, `uid`, `name`, `description`, `price`, `currency`, `duration_days`, `billing_type`, `type`) VALUES
+-- ('plan_lifetime', 'app_id_here', 'uid_here', 'Pro Lifetime', 'Pro features forever', 299.99, 'USD', 0, 'lifetime', 'pro');
 
 -- 区域定价示例（基于巨无霸指数PPP的定价策略）
 -- 假设 plan_id='plan_monthly', app_id='default_app', uid='default_uid'
@@ -105,3 +367,26 @@ CREATE TABLE `subscription_history` (
 -- ('plan_monthly', 'TW', 59.9, 'USD'),   -- 台湾
 -- ('plan_monthly', 'BR', 46, 'USD'),     -- 巴西（新兴市场）
 -- ('plan_monthly', 'IN', 32, 'USD');     -- 印度（发展中市场）
+
+-- 地区组示例：欧盟国家没有单独定价时使用 EU 定价
+-- INSERT INTO `region_group` (`group_code`, `name`) VALUES ('EU', 'European Union'), ('LATAM', 'Latin America'), ('SEA', 'Southeast Asia');
+-- INSERT INTO `region_group_country` (`group_code`, `country_code`) VALUES
+-- ('EU', 'AT'), ('EU', 'BE'), ('EU', 'ES'), ('EU', 'IT'), ('EU', 'NL'), ('EU', 'PL'),
+-- ('LATAM', 'AR'), ('LATAM', 'CL'), ('LATAM', 'CO'), ('LATAM', 'MX'),
+-- ('SEA', 'ID'), ('SEA', 'MY'), ('SEA', 'PH'), ('SEA', 'TH'), ('SEA', 'VN');
+-- INSERT INTO `plan_pricing` (`plan_id`, `country_code`, `price`, `currency`) VALUES
+-- ('plan_monthly', 'EU', 8.99, 'EUR'),
+-- ('plan_monthly', 'LATAM', 4.99, 'USD'),
+-- ('plan_monthly', 'SEA', 3.99, 'USD');
+
+-- 税务规则示例：欧洲增值税含税定价并支持反向征税，加拿大 GST 不含税在价格之上加收
+-- INSERT INTO `tax_rule` (`country_code`, `name`, `rate`, `inclusive`, `reverse_charge`) VALUES
+-- ('DE', 'VAT', 0.19, 1, 1),
+-- ('GB', 'VAT', 0.20, 1, 1),
+-- ('AU', 'GST', 0.10, 1, 0),
+-- ('CA', 'GST', 0.05, 0, 0);
+
+-- 汇率示例：CNY 订单按 USD 报表币种折算（没有直接汇率时也会使用反向汇率或经由 USD 的交叉汇率）
+-- INSERT INTO `exchange_rate` (`from_currency`, `to_currency`, `rate`, `effective_at`, `source`) VALUES
+-- ('CNY', 'USD', 0.1389, '2026-01-01 00:00:00', 'admin'),
+-- ('EUR', 'USD', 1.0850, '2026-01-01 00:00:00', 'admin');
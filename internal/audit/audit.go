@@ -0,0 +1,55 @@
+package audit
+
+import (
+	"context"
+
+	"xinyuan_tech/subscription-service/internal/auth"
+	"xinyuan_tech/subscription-service/internal/constants"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+type actorKey struct{}
+
+type requestIDKey struct{}
+
+// actor 操作人
+type actor struct {
+	actorType string
+	actorID   string
+}
+
+// WithActor 将操作人设置到context（定时任务、支付回调、批量操作等没有登录用户或需要覆盖登录用户时使用）
+func WithActor(ctx context.Context, actorType, actorID string) context.Context {
+	return context.WithValue(ctx, actorKey{}, actor{actorType: actorType, actorID: actorID})
+}
+
+// ActorFromContext 获取操作人类型和ID：优先使用 WithActor 设置的操作人，其次为当前登录的用户或管理员，都没有时为 system
+func ActorFromContext(ctx context.Context) (string, string) {
+	if a, ok := ctx.Value(actorKey{}).(actor); ok {
+		return a.actorType, a.actorID
+	}
+	if uid, ok := auth.GetUIDFromContext(ctx); ok && uid != "" {
+		if auth.IsAdmin(ctx) {
+			return constants.ActorTypeAdmin, uid
+		}
+		return constants.ActorTypeUser, uid
+	}
+	return constants.ActorTypeSystem, ""
+}
+
+// WithRequestID 将请求ID设置到context
+func WithRequestID(ctx context.Context, requestID string) context.Context {
+	return context.WithValue(ctx, requestIDKey{}, requestID)
+}
+
+// RequestIDFromContext 获取请求ID，没有设置时使用链路追踪的 trace ID，都没有时返回空字符串
+func RequestIDFromContext(ctx context.Context) string {
+	if id, ok := ctx.Value(requestIDKey{}).(string); ok && id != "" {
+		return id
+	}
+	if sc := trace.SpanContextFromContext(ctx); sc.HasTraceID() {
+		return sc.TraceID().String()
+	}
+	return ""
+}
@@ -0,0 +1,40 @@
+package audit
+
+import (
+	"context"
+
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/transport"
+	"github.com/google/uuid"
+)
+
+const (
+	// HeaderRequestID 请求ID请求头（由 API Gateway 或调用方设置，未设置时生成）
+	HeaderRequestID = "X-Request-ID"
+	// maxRequestIDLen 请求ID最大长度（与 subscription_history.request_id 列长度一致）
+	maxRequestIDLen = 64
+)
+
+// Middleware 从请求头（HTTP Header 或 gRPC Metadata）中提取请求ID设置到context，并写入响应头
+// 请求头未设置时使用链路追踪的 trace ID，未启用链路追踪时生成新的请求ID
+func Middleware() middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			if tr, ok := transport.FromServerContext(ctx); ok {
+				requestID := tr.RequestHeader().Get(HeaderRequestID)
+				if requestID == "" {
+					requestID = RequestIDFromContext(ctx)
+				}
+				if requestID == "" {
+					requestID = uuid.NewString()
+				}
+				if len(requestID) > maxRequestIDLen {
+					requestID = requestID[:maxRequestIDLen]
+				}
+				tr.ReplyHeader().Set(HeaderRequestID, requestID)
+				ctx = WithRequestID(ctx, requestID)
+			}
+			return handler(ctx, req)
+		}
+	}
+}
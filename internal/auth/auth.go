@@ -24,6 +24,12 @@ const (
 	RoleAdmin Role = "admin"
 )
 
+// SetUserContext 将当前用户ID和角色设置到context
+func SetUserContext(ctx context.Context, uid string, role Role) context.Context {
+	ctx = context.WithValue(ctx, UserIDKey, uid)
+	return context.WithValue(ctx, UserRoleKey, role)
+}
+
 // GetUIDFromContext 从context中获取用户ID（字符串 UUID）
 func GetUIDFromContext(ctx context.Context) (string, bool) {
 	uid, ok := ctx.Value(UserIDKey).(string)
@@ -61,3 +67,15 @@ func CheckOwnership(ctx context.Context, resourceUID string) error {
 
 	return nil
 }
+
+// RequireAdmin 检查当前用户是否为管理员，返回管理员的用户ID（作为操作人记录）
+func RequireAdmin(ctx context.Context) (string, error) {
+	currentUID, ok := GetUIDFromContext(ctx)
+	if !ok || currentUID == "" {
+		return "", errors.Unauthorized("UNAUTHORIZED", "authentication required")
+	}
+	if !IsAdmin(ctx) {
+		return "", errors.Forbidden("FORBIDDEN", "permission denied: admin role required")
+	}
+	return currentUID, nil
+}
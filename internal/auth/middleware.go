@@ -0,0 +1,34 @@
+package auth
+
+import (
+	"context"
+
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/transport"
+)
+
+const (
+	// HeaderUserID 当前用户ID请求头（由 API Gateway 鉴权后设置）
+	HeaderUserID = "X-User-ID"
+	// HeaderUserRole 当前用户角色请求头（由 API Gateway 鉴权后设置，未设置时为普通用户）
+	HeaderUserRole = "X-User-Role"
+)
+
+// Middleware 从请求头（HTTP Header 或 gRPC Metadata）中提取当前用户ID和角色设置到context
+// 请求头由 API Gateway 鉴权后设置，网关需要清除客户端传入的同名请求头
+func Middleware() middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			if tr, ok := transport.FromServerContext(ctx); ok {
+				if uid := tr.RequestHeader().Get(HeaderUserID); uid != "" {
+					role := Role(tr.RequestHeader().Get(HeaderUserRole))
+					if role != RoleAdmin {
+						role = RoleUser
+					}
+					ctx = SetUserContext(ctx, uid, role)
+				}
+			}
+			return handler(ctx, req)
+		}
+	}
+}
@@ -0,0 +1,139 @@
+package biz
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"xinyuan_tech/subscription-service/internal/constants"
+	"xinyuan_tech/subscription-service/internal/errors"
+
+	pkgErrors "github.com/gaoyong06/go-pkg/errors"
+)
+
+// AppSetting 应用级订阅配置
+type AppSetting struct {
+	AppID             string
+	DefaultFreePlanID string   // 默认免费套餐ID（订阅过期、取消、退款后自动切换到该套餐，为空表示不回落）
+	RegionDetection   []string // 地区推断顺序（为空表示使用全局配置）
+	ReportingCurrency string   // 报表币种（收入报表按该币种折算，为空表示使用全局配置）
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
+}
+
+// AppSettingRepo 应用配置仓库接口
+type AppSettingRepo interface {
+	// GetAppSetting 获取应用配置，未配置时返回 nil
+	GetAppSetting(ctx context.Context, appID string) (*AppSetting, error)
+	SaveAppSetting(ctx context.Context, setting *AppSetting) error
+}
+
+// GetAppSetting 获取应用配置（未配置时返回空配置）
+func (uc *SubscriptionUsecase) GetAppSetting(ctx context.Context, appID string) (*AppSetting, error) {
+	setting, err := uc.appSettingRepo.GetAppSetting(ctx, appID)
+	if err != nil {
+		return nil, err
+	}
+	if setting == nil {
+		setting = &AppSetting{AppID: appID}
+	}
+	return setting, nil
+}
+
+// UpdateAppSetting 更新应用配置
+// 默认免费套餐必须属于该应用、价格为 0 且不是终身套餐
+func (uc *SubscriptionUsecase) UpdateAppSetting(ctx context.Context, setting *AppSetting) error {
+	uc.log.Infof("UpdateAppSetting: appID=%s, defaultFreePlanID=%s, regionDetection=%v, reportingCurrency=%s", setting.AppID, setting.DefaultFreePlanID, setting.RegionDetection, setting.ReportingCurrency)
+
+	for _, source := range setting.RegionDetection {
+		if !isValidRegionSource(source) {
+			return pkgErrors.NewBizErrorWithLang(ctx, errors.ErrCodeRegionDetectionInvalid)
+		}
+	}
+
+	setting.ReportingCurrency = strings.ToUpper(setting.ReportingCurrency)
+	if setting.ReportingCurrency != "" && !isCurrencyCode(setting.ReportingCurrency) {
+		return pkgErrors.NewBizErrorWithLang(ctx, errors.ErrCodeCurrencyInvalid)
+	}
+
+	if setting.DefaultFreePlanID != "" {
+		plan, err := uc.planRepo.GetPlan(ctx, setting.DefaultFreePlanID)
+		if err != nil || plan == nil {
+			return pkgErrors.NewBizErrorWithLang(ctx, errors.ErrCodePlanNotFound)
+		}
+		if plan.AppID != setting.AppID || plan.Price != 0 || plan.IsLifetime() {
+			uc.log.Errorf("Plan %s cannot be used as default free plan of app %s", plan.PlanID, setting.AppID)
+			return pkgErrors.NewBizErrorWithLang(ctx, errors.ErrCodeFreePlanInvalid)
+		}
+	}
+
+	existing, err := uc.appSettingRepo.GetAppSetting(ctx, setting.AppID)
+	if err != nil {
+		return err
+	}
+	now := time.Now().UTC()
+	setting.CreatedAt = now
+	if existing != nil {
+		setting.CreatedAt = existing.CreatedAt
+	}
+	setting.UpdatedAt = now
+	return uc.appSettingRepo.SaveAppSetting(ctx, setting)
+}
+
+// getDefaultFreePlan 获取应用的默认免费套餐，未配置时返回 nil
+func (uc *SubscriptionUsecase) getDefaultFreePlan(ctx context.Context, appID string) (*Plan, error) {
+	if appID == "" {
+		return nil, nil
+	}
+	setting, err := uc.appSettingRepo.GetAppSetting(ctx, appID)
+	if err != nil {
+		return nil, err
+	}
+	if setting == nil || setting.DefaultFreePlanID == "" {
+		return nil, nil
+	}
+	plan, err := uc.planRepo.GetPlan(ctx, setting.DefaultFreePlanID)
+	if err != nil {
+		// 免费套餐已被删除时不回落，不影响主流程
+		uc.log.Warnf("Default free plan %s of app %s not available: %v", setting.DefaultFreePlanID, appID, err)
+		return nil, nil
+	}
+	return plan, nil
+}
+
+// downgradeToFree 将订阅切换到应用的默认免费套餐，并记录 downgraded_to_free 历史
+// 免费套餐订阅没有结束时间，也不会自动续费；应用未配置免费套餐或已在免费套餐上时返回 false
+func (uc *SubscriptionUsecase) downgradeToFree(ctx context.Context, sub *UserSubscription, now time.Time) (bool, error) {
+	freePlan, err := uc.getDefaultFreePlan(ctx, sub.AppID)
+	if err != nil {
+		return false, err
+	}
+	if freePlan == nil || sub.PlanID == freePlan.PlanID {
+		return false, nil
+	}
+
+	fromPlanID := sub.PlanID
+	before := snapshot(sub)
+	sub.PlanID = freePlan.PlanID
+	sub.Status = constants.StatusActive
+	sub.StartTime = now
+	sub.EndTime = time.Time{}
+	sub.BillingAnchor = time.Time{}
+	sub.IsAutoRenew = false
+	sub.OrderID = ""
+	sub.Source = constants.SourcePurchase
+	sub.NextPlanID = ""
+	sub.UpdatedAt = now
+
+	if err := uc.subRepo.SaveSubscription(ctx, sub); err != nil {
+		uc.log.Errorf("Failed to downgrade subscription to free plan for user %s: %v", sub.UID, err)
+		return false, err
+	}
+
+	if err := uc.recordHistory(ctx, constants.ActionDowngradedToFree, before, sub, "", now); err != nil {
+		return false, err
+	}
+
+	uc.log.Infof("Subscription of user %s downgraded from plan %s to free plan %s", sub.UID, fromPlanID, freePlan.PlanID)
+	return true, nil
+}
@@ -0,0 +1,112 @@
+package biz
+
+import (
+	"time"
+
+	"xinyuan_tech/subscription-service/internal/constants"
+)
+
+// BillingInterval 计费周期（单位 + 数量，如 1 month、3 month、1 year）
+type BillingInterval struct {
+	Unit  string // day, week, month, year
+	Count int
+}
+
+// BillingInterval 获取套餐的计费周期
+// 未配置 interval_count 的旧套餐按 duration_days 天计算
+func (p *Plan) BillingInterval() BillingInterval {
+	if p.IntervalCount <= 0 || p.IntervalUnit == "" {
+		return BillingInterval{Unit: constants.IntervalDay, Count: p.DurationDays}
+	}
+	return BillingInterval{Unit: p.IntervalUnit, Count: p.IntervalCount}
+}
+
+// IsValid 计费周期是否有效
+func (b BillingInterval) IsValid() bool {
+	if b.Count <= 0 {
+		return false
+	}
+	switch b.Unit {
+	case constants.IntervalDay, constants.IntervalWeek, constants.IntervalMonth, constants.IntervalYear:
+		return true
+	}
+	return false
+}
+
+// NominalDays 计费周期的名义天数（仅用于兼容 duration_days 字段和展示，不参与周期计算）
+func (b BillingInterval) NominalDays() int {
+	switch b.Unit {
+	case constants.IntervalWeek:
+		return 7 * b.Count
+	case constants.IntervalMonth:
+		return 30 * b.Count
+	case constants.IntervalYear:
+		return 365 * b.Count
+	default:
+		return b.Count
+	}
+}
+
+// Months 计费周期折算的月数（用于 MRR 归一化，按天和按周的周期以每月 365/12 天折算）
+func (b BillingInterval) Months() float64 {
+	switch b.Unit {
+	case constants.IntervalWeek:
+		return float64(7*b.Count) * 12 / 365
+	case constants.IntervalMonth:
+		return float64(b.Count)
+	case constants.IntervalYear:
+		return float64(12 * b.Count)
+	default:
+		return float64(b.Count) * 12 / 365
+	}
+}
+
+// PeriodEnd 计算从计费锚点 anchor 开始第 n 个计费周期的结束时间
+// 月/年周期按日历计算，始终以锚点的日期为准做月末截断，
+// 例如锚点为 1月31日 的月付订阅：2月28日(闰年29日) -> 3月31日 -> 4月30日
+func (b BillingInterval) PeriodEnd(anchor time.Time, n int) time.Time {
+	switch b.Unit {
+	case constants.IntervalWeek:
+		return anchor.AddDate(0, 0, 7*b.Count*n)
+	case constants.IntervalMonth:
+		return addMonthsClamped(anchor, b.Count*n)
+	case constants.IntervalYear:
+		return addMonthsClamped(anchor, 12*b.Count*n)
+	default:
+		return anchor.AddDate(0, 0, b.Count*n)
+	}
+}
+
+// NextPeriodEnd 计算 current 之后的下一个周期结束时间（用于续费）
+// 以计费锚点逐周期推算，避免在截断后的日期上继续累加导致日期漂移
+func (b BillingInterval) NextPeriodEnd(anchor, current time.Time) time.Time {
+	if !b.IsValid() {
+		return current
+	}
+	if anchor.IsZero() || anchor.After(current) {
+		return b.PeriodEnd(current, 1)
+	}
+	n := 1
+	for !b.PeriodEnd(anchor, n).After(current) {
+		n++
+	}
+	return b.PeriodEnd(anchor, n)
+}
+
+// addMonthsClamped 在 t 上增加 months 个日历月，目标月份天数不足时截断到月末
+// 与 time.AddDate 不同，1月31日 + 1个月 = 2月28日，而不是 3月3日
+func addMonthsClamped(t time.Time, months int) time.Time {
+	year, month, day := t.Date()
+	hour, min, sec := t.Clock()
+
+	target := time.Date(year, month+time.Month(months), 1, hour, min, sec, t.Nanosecond(), t.Location())
+	if last := daysInMonth(target.Year(), target.Month(), t.Location()); day > last {
+		day = last
+	}
+	return time.Date(target.Year(), target.Month(), day, hour, min, sec, t.Nanosecond(), t.Location())
+}
+
+// daysInMonth 获取指定月份的天数
+func daysInMonth(year int, month time.Month, loc *time.Location) int {
+	return time.Date(year, month+1, 0, 0, 0, 0, 0, loc).Day()
+}
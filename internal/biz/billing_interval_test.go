@@ -0,0 +1,91 @@
+package biz
+
+import (
+	"testing"
+	"time"
+
+	"xinyuan_tech/subscription-service/internal/constants"
+)
+
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 8, 30, 0, 0, time.UTC)
+}
+
+func TestAddMonthsClamped(t *testing.T) {
+	tests := []struct {
+		name   string
+		t      time.Time
+		months int
+		want   time.Time
+	}{
+		{"普通日期", date(2026, 3, 15), 1, date(2026, 4, 15)},
+		{"1月31日到平年2月", date(2026, 1, 31), 1, date(2026, 2, 28)},
+		{"1月31日到闰年2月", date(2028, 1, 31), 1, date(2028, 2, 29)},
+		{"3月31日到4月", date(2026, 3, 31), 1, date(2026, 4, 30)},
+		{"跨年", date(2026, 11, 30), 3, date(2027, 2, 28)},
+		{"闰日加一年", date(2028, 2, 29), 12, date(2029, 2, 28)},
+		{"闰日加四年", date(2028, 2, 29), 48, date(2032, 2, 29)},
+		{"减少月份", date(2026, 3, 31), -1, date(2026, 2, 28)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := addMonthsClamped(tt.t, tt.months); !got.Equal(tt.want) {
+				t.Errorf("addMonthsClamped(%v, %d) = %v, want %v", tt.t, tt.months, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBillingIntervalPeriodEnd(t *testing.T) {
+	monthly := BillingInterval{Unit: constants.IntervalMonth, Count: 1}
+	tests := []struct {
+		name     string
+		interval BillingInterval
+		anchor   time.Time
+		n        int
+		want     time.Time
+	}{
+		{"按天", BillingInterval{Unit: constants.IntervalDay, Count: 30}, date(2026, 1, 31), 1, date(2026, 3, 2)},
+		{"按周", BillingInterval{Unit: constants.IntervalWeek, Count: 2}, date(2026, 1, 31), 2, date(2026, 2, 28)},
+		{"月付第1期截断到2月末", monthly, date(2026, 1, 31), 1, date(2026, 2, 28)},
+		{"月付第2期回到31日", monthly, date(2026, 1, 31), 2, date(2026, 3, 31)},
+		{"月付第3期截断到4月末", monthly, date(2026, 1, 31), 3, date(2026, 4, 30)},
+		{"季付", BillingInterval{Unit: constants.IntervalMonth, Count: 3}, date(2026, 11, 30), 1, date(2027, 2, 28)},
+		{"年付闰日", BillingInterval{Unit: constants.IntervalYear, Count: 1}, date(2028, 2, 29), 1, date(2029, 2, 28)},
+		{"年付闰日第4期", BillingInterval{Unit: constants.IntervalYear, Count: 1}, date(2028, 2, 29), 4, date(2032, 2, 29)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.interval.PeriodEnd(tt.anchor, tt.n); !got.Equal(tt.want) {
+				t.Errorf("PeriodEnd(%v, %d) = %v, want %v", tt.anchor, tt.n, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBillingIntervalNextPeriodEnd(t *testing.T) {
+	monthly := BillingInterval{Unit: constants.IntervalMonth, Count: 1}
+	tests := []struct {
+		name     string
+		interval BillingInterval
+		anchor   time.Time
+		current  time.Time
+		want     time.Time
+	}{
+		{"截断后按锚点回到31日", monthly, date(2026, 1, 31), date(2026, 2, 28), date(2026, 3, 31)},
+		{"闰年截断后回到31日", monthly, date(2028, 1, 31), date(2028, 2, 29), date(2028, 3, 31)},
+		{"再次截断到4月末", monthly, date(2026, 1, 31), date(2026, 3, 31), date(2026, 4, 30)},
+		{"4月末之后回到31日", monthly, date(2026, 1, 31), date(2026, 4, 30), date(2026, 5, 31)},
+		{"年付闰日锚点回到闰日", BillingInterval{Unit: constants.IntervalYear, Count: 1}, date(2028, 2, 29), date(2031, 2, 28), date(2032, 2, 29)},
+		{"没有锚点按当前结束时间推算", monthly, time.Time{}, date(2026, 2, 28), date(2026, 3, 28)},
+		{"锚点晚于当前结束时间", monthly, date(2026, 5, 31), date(2026, 2, 28), date(2026, 3, 28)},
+		{"无效周期保持不变", BillingInterval{Unit: constants.IntervalMonth}, date(2026, 1, 31), date(2026, 2, 28), date(2026, 2, 28)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.interval.NextPeriodEnd(tt.anchor, tt.current); !got.Equal(tt.want) {
+				t.Errorf("NextPeriodEnd(%v, %v) = %v, want %v", tt.anchor, tt.current, got, tt.want)
+			}
+		})
+	}
+}
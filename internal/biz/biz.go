@@ -10,6 +10,8 @@ import (
 var ProviderSet = wire.NewSet(
 	NewSubscriptionUsecase,
 	NewRegionDetectionService,
+	NewHealthUsecase,
+	NewJobRunUsecase,
 )
 
 // Transaction 事务接口
@@ -0,0 +1,396 @@
+package biz
+
+import (
+	"context"
+	stderrors "errors"
+	"fmt"
+	"time"
+
+	"xinyuan_tech/subscription-service/internal/audit"
+	"xinyuan_tech/subscription-service/internal/constants"
+	"xinyuan_tech/subscription-service/internal/errors"
+
+	pkgErrors "github.com/gaoyong06/go-pkg/errors"
+)
+
+// BulkOperation 批量操作：按筛选条件批量延长订阅或在下次续费时迁移套餐，由定时任务分批执行
+type BulkOperation struct {
+	BulkOperationID      uint64
+	Type                 string // extend, migrate_plan
+	AppID                string // 筛选条件：应用（必填）
+	PlanID               string // 筛选条件：套餐（迁移套餐时为原套餐，必填）
+	FilterStatus         string // 筛选条件：订阅状态，默认 active
+	Days                 int    // 延长天数（extend）
+	TargetPlanID         string // 目标套餐（migrate_plan）
+	OperatorID           string
+	Reason               string
+	Status               string // pending, running, completed, cancelled
+	MaxSubscriptionID    uint64 // 创建时匹配的最大订阅ID，之后新建的订阅不处理
+	CursorSubscriptionID uint64 // 进度：已处理的最后一个订阅ID
+	TotalCount           int    // 创建时匹配的订阅数
+	ProcessedCount       int
+	SuccessCount         int
+	SkippedCount         int // 处理时已不满足筛选条件（如已续费到其他套餐、已过期）
+	FailedCount          int
+	Error                string // 最近一次失败的错误信息
+	StartedAt            time.Time
+	FinishedAt           time.Time
+	CreatedAt            time.Time
+	UpdatedAt            time.Time
+}
+
+// filter 批量操作的订阅筛选条件
+func (op *BulkOperation) filter() *SubscriptionFilter {
+	return &SubscriptionFilter{
+		AppID:             op.AppID,
+		PlanID:            op.PlanID,
+		Status:            op.FilterStatus,
+		MaxSubscriptionID: op.MaxSubscriptionID,
+	}
+}
+
+// matches 订阅当前是否仍满足筛选条件（查询后可能已被修改）
+func (op *BulkOperation) matches(sub *UserSubscription) bool {
+	return sub.AppID == op.AppID &&
+		(op.PlanID == "" || sub.PlanID == op.PlanID) &&
+		sub.Status == op.FilterStatus &&
+		!sub.IsLifetime()
+}
+
+// SubscriptionFilter 批量操作的订阅筛选条件
+// 只匹配有结束时间的订阅，终身订阅和免费套餐不参与批量操作
+type SubscriptionFilter struct {
+	AppID             string
+	PlanID            string // 为空时不过滤
+	Status            string
+	MaxSubscriptionID uint64 // 大于 0 时只匹配不超过该ID的订阅
+}
+
+// BulkOperationRepo 批量操作仓库接口
+type BulkOperationRepo interface {
+	CreateBulkOperation(ctx context.Context, op *BulkOperation) error
+	// GetBulkOperation 获取批量操作，不存在时返回 nil
+	GetBulkOperation(ctx context.Context, id uint64) (*BulkOperation, error)
+	// ListBulkOperations 分页查询批量操作，appID、status 为空时不过滤，按创建时间倒序
+	ListBulkOperations(ctx context.Context, appID, status string, page, pageSize int) ([]*BulkOperation, int, error)
+	// ListUnfinishedBulkOperations 获取等待执行和执行中的批量操作，按创建顺序
+	ListUnfinishedBulkOperations(ctx context.Context) ([]*BulkOperation, error)
+	// UpdateBulkOperation 保存执行进度和状态（只更新未结束的操作），操作已被取消时返回 false
+	UpdateBulkOperation(ctx context.Context, op *BulkOperation) (bool, error)
+	// CancelBulkOperation 取消未结束的批量操作，操作已结束时返回 false
+	CancelBulkOperation(ctx context.Context, id uint64, now time.Time) (bool, error)
+}
+
+// errBulkOperationCancelled 处理过程中批量操作已被取消（回滚当前用户的修改）
+var errBulkOperationCancelled = stderrors.New("bulk operation was cancelled")
+
+// CreateBulkOperation 校验并创建批量操作，由定时任务在后台分批执行
+// dryRun 为 true 时只返回匹配的订阅数（TotalCount），不创建操作
+func (uc *SubscriptionUsecase) CreateBulkOperation(ctx context.Context, op *BulkOperation, dryRun bool) (*BulkOperation, error) {
+	uc.log.Infof("CreateBulkOperation: type=%s, appID=%s, planID=%s, status=%s, days=%d, targetPlanID=%s, operator=%s, dryRun=%v",
+		op.Type, op.AppID, op.PlanID, op.FilterStatus, op.Days, op.TargetPlanID, op.OperatorID, dryRun)
+
+	if err := uc.validateBulkOperation(ctx, op); err != nil {
+		return nil, err
+	}
+
+	total, maxID, err := uc.subRepo.CountSubscriptions(ctx, op.filter())
+	if err != nil {
+		uc.log.Errorf("Failed to count subscriptions for bulk operation: %v", err)
+		return nil, err
+	}
+	op.TotalCount = total
+	op.MaxSubscriptionID = maxID
+	if dryRun {
+		uc.log.Infof("[DRY RUN] Bulk operation %s would process %d subscriptions of app %s", op.Type, total, op.AppID)
+		return op, nil
+	}
+
+	now := time.Now().UTC()
+	op.Status = constants.BulkOperationStatusPending
+	op.CreatedAt = now
+	op.UpdatedAt = now
+	if total == 0 {
+		// 没有匹配的订阅，直接完成
+		op.Status = constants.BulkOperationStatusCompleted
+		op.FinishedAt = now
+	}
+	if err := uc.bulkOpRepo.CreateBulkOperation(ctx, op); err != nil {
+		uc.log.Errorf("Failed to create bulk operation: %v", err)
+		return nil, err
+	}
+	uc.log.Infof("Bulk operation %d created by %s, %d subscriptions matched", op.BulkOperationID, op.OperatorID, total)
+	return op, nil
+}
+
+// validateBulkOperation 校验批量操作类型、筛选条件和参数
+func (uc *SubscriptionUsecase) validateBulkOperation(ctx context.Context, op *BulkOperation) error {
+	invalid := pkgErrors.NewBizErrorWithLang(ctx, errors.ErrCodeBulkOperationInvalid)
+	if op.AppID == "" {
+		return invalid
+	}
+	switch op.FilterStatus {
+	case "":
+		op.FilterStatus = constants.StatusActive
+	case constants.StatusActive, constants.StatusExpired, constants.StatusPaused, constants.StatusCancelled:
+	default:
+		return invalid
+	}
+
+	if op.PlanID != "" {
+		plan, err := uc.planRepo.GetPlan(ctx, op.PlanID)
+		if err != nil || plan == nil {
+			return pkgErrors.NewBizErrorWithLang(ctx, errors.ErrCodePlanNotFound)
+		}
+		if plan.AppID != "" && plan.AppID != op.AppID {
+			return invalid
+		}
+	}
+
+	switch op.Type {
+	case constants.BulkOperationExtend:
+		if op.Days < 1 || op.Days > constants.MaxSupportAdjustDays || op.TargetPlanID != "" {
+			return invalid
+		}
+	case constants.BulkOperationMigratePlan:
+		if op.PlanID == "" || op.TargetPlanID == "" || op.TargetPlanID == op.PlanID || op.Days != 0 {
+			return invalid
+		}
+		target, err := uc.planRepo.GetPlan(ctx, op.TargetPlanID)
+		if err != nil || target == nil {
+			return pkgErrors.NewBizErrorWithLang(ctx, errors.ErrCodePlanNotFound)
+		}
+		// 迁移到的套餐需要同一应用的周期套餐（续费时按新套餐下单）
+		if (target.AppID != "" && target.AppID != op.AppID) || target.IsLifetime() {
+			return invalid
+		}
+		if !target.BillingInterval().IsValid() {
+			return pkgErrors.NewBizErrorWithLang(ctx, errors.ErrCodePlanIntervalInvalid)
+		}
+	default:
+		return invalid
+	}
+	return nil
+}
+
+// GetBulkOperation 查询批量操作的执行进度
+func (uc *SubscriptionUsecase) GetBulkOperation(ctx context.Context, id uint64) (*BulkOperation, error) {
+	op, err := uc.bulkOpRepo.GetBulkOperation(ctx, id)
+	if err != nil {
+		uc.log.Errorf("Failed to get bulk operation %d: %v", id, err)
+		return nil, err
+	}
+	if op == nil {
+		return nil, pkgErrors.NewBizErrorWithLang(ctx, errors.ErrCodeBulkOperationNotFound)
+	}
+	return op, nil
+}
+
+// ListBulkOperations 分页查询批量操作
+func (uc *SubscriptionUsecase) ListBulkOperations(ctx context.Context, appID, status string, page, pageSize int) ([]*BulkOperation, int, error) {
+	ops, total, err := uc.bulkOpRepo.ListBulkOperations(ctx, appID, status, page, pageSize)
+	if err != nil {
+		uc.log.Errorf("Failed to list bulk operations: %v", err)
+		return nil, 0, err
+	}
+	return ops, total, nil
+}
+
+// CancelBulkOperation 取消未结束的批量操作，已处理的用户不回滚
+func (uc *SubscriptionUsecase) CancelBulkOperation(ctx context.Context, id uint64, operatorID string) (*BulkOperation, error) {
+	uc.log.Infof("CancelBulkOperation: id=%d, operator=%s", id, operatorID)
+
+	if _, err := uc.GetBulkOperation(ctx, id); err != nil {
+		return nil, err
+	}
+	ok, err := uc.bulkOpRepo.CancelBulkOperation(ctx, id, time.Now().UTC())
+	if err != nil {
+		uc.log.Errorf("Failed to cancel bulk operation %d: %v", id, err)
+		return nil, err
+	}
+	if !ok {
+		return nil, pkgErrors.NewBizErrorWithLang(ctx, errors.ErrCodeBulkOperationFinished)
+	}
+	return uc.GetBulkOperation(ctx, id)
+}
+
+// ProcessBulkOperations 按创建顺序执行等待中和执行中的批量操作，dryRun 为 true 时只统计不执行
+// 每个订阅的修改和操作进度在同一事务中保存；任务超时后未处理完的操作在下次执行时从进度处继续
+func (uc *SubscriptionUsecase) ProcessBulkOperations(ctx context.Context, dryRun bool) (*JobResult, error) {
+	ops, err := uc.bulkOpRepo.ListUnfinishedBulkOperations(ctx)
+	if err != nil {
+		uc.log.Errorf("Failed to list unfinished bulk operations: %v", err)
+		return nil, err
+	}
+	result := &JobResult{TotalCount: len(ops)}
+	if dryRun {
+		for _, op := range ops {
+			uc.log.Infof("[DRY RUN] Would process bulk operation %d (%s): %d/%d processed", op.BulkOperationID, op.Type, op.ProcessedCount, op.TotalCount)
+		}
+		return result, nil
+	}
+
+	for _, op := range ops {
+		if ctx.Err() != nil {
+			break
+		}
+		finished, err := uc.runBulkOperation(ctx, op)
+		if err != nil {
+			uc.log.Errorf("Failed to process bulk operation %d: %v", op.BulkOperationID, err)
+			result.FailedCount++
+			continue
+		}
+		if finished {
+			result.SuccessCount++
+		}
+	}
+	uc.log.Infof("Bulk operations processed: total=%d, finished=%d, failed=%d", result.TotalCount, result.SuccessCount, result.FailedCount)
+	return result, nil
+}
+
+// runBulkOperation 从进度处分批执行批量操作，全部处理完返回 true；任务超时或操作已被取消时返回 false
+func (uc *SubscriptionUsecase) runBulkOperation(ctx context.Context, op *BulkOperation) (bool, error) {
+	// 订阅历史记录创建批量操作的管理员，同一批量操作的修改使用相同的请求ID
+	ctx = audit.WithActor(ctx, constants.ActorTypeAdmin, op.OperatorID)
+	ctx = audit.WithRequestID(ctx, fmt.Sprintf("bulk-operation-%d", op.BulkOperationID))
+	if op.Status == constants.BulkOperationStatusPending {
+		now := time.Now().UTC()
+		op.Status = constants.BulkOperationStatusRunning
+		op.StartedAt = now
+		op.UpdatedAt = now
+		ok, err := uc.bulkOpRepo.UpdateBulkOperation(ctx, op)
+		if err != nil || !ok {
+			return false, err
+		}
+	}
+
+	filter := op.filter()
+	for {
+		subs, err := uc.subRepo.ListSubscriptionsByFilter(ctx, filter, op.CursorSubscriptionID, constants.BulkOperationBatchSize)
+		if err != nil {
+			return false, err
+		}
+		for _, sub := range subs {
+			if ctx.Err() != nil {
+				// 任务超时，未处理的订阅下次继续
+				return false, nil
+			}
+			err := uc.processBulkSubscription(ctx, op, sub)
+			if stderrors.Is(err, errBulkOperationCancelled) {
+				uc.log.Infof("Bulk operation %d was cancelled", op.BulkOperationID)
+				return false, nil
+			}
+			if err != nil {
+				if ctx.Err() != nil {
+					return false, nil
+				}
+				if ok, err := uc.recordBulkFailure(ctx, op, sub, err); err != nil || !ok {
+					return false, err
+				}
+			}
+		}
+		if len(subs) < constants.BulkOperationBatchSize {
+			break
+		}
+	}
+
+	now := time.Now().UTC()
+	op.Status = constants.BulkOperationStatusCompleted
+	op.FinishedAt = now
+	op.UpdatedAt = now
+	ok, err := uc.bulkOpRepo.UpdateBulkOperation(ctx, op)
+	if err != nil || !ok {
+		return false, err
+	}
+	uc.log.Infof("Bulk operation %d completed: processed=%d, success=%d, skipped=%d, failed=%d",
+		op.BulkOperationID, op.ProcessedCount, op.SuccessCount, op.SkippedCount, op.FailedCount)
+	return true, nil
+}
+
+// processBulkSubscription 持有用户锁处理批量操作中的一个订阅
+// 订阅的修改、订阅历史和操作进度在同一事务中保存，中途退出后继续执行时不会重复处理同一用户
+func (uc *SubscriptionUsecase) processBulkSubscription(ctx context.Context, op *BulkOperation, candidate *UserSubscription) error {
+	var progress BulkOperation
+	err := uc.mutateSubscription(ctx, candidate.UID, func(ctx context.Context) error {
+		progress = *op
+		now := time.Now().UTC()
+		progress.CursorSubscriptionID = candidate.SubscriptionID
+		progress.ProcessedCount++
+		progress.UpdatedAt = now
+
+		// 查询后订阅可能已被修改，持有锁后重新检查筛选条件
+		sub, err := uc.subRepo.GetSubscription(ctx, candidate.UID)
+		if err != nil {
+			return err
+		}
+		applied := false
+		if sub != nil && sub.SubscriptionID == candidate.SubscriptionID && op.matches(sub) {
+			if applied, err = uc.applyBulkOperation(ctx, op, sub, now); err != nil {
+				return err
+			}
+		}
+		if applied {
+			progress.SuccessCount++
+		} else {
+			progress.SkippedCount++
+		}
+
+		ok, err := uc.bulkOpRepo.UpdateBulkOperation(ctx, &progress)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return errBulkOperationCancelled
+		}
+		return nil
+	})
+	if err == nil {
+		*op = progress
+	}
+	return err
+}
+
+// applyBulkOperation 对满足筛选条件的订阅执行批量操作并记录订阅历史，无需修改时返回 false
+func (uc *SubscriptionUsecase) applyBulkOperation(ctx context.Context, op *BulkOperation, sub *UserSubscription, now time.Time) (bool, error) {
+	before := snapshot(sub)
+	switch op.Type {
+	case constants.BulkOperationExtend:
+		sub.shiftEndTime(op.Days)
+		if sub.Status == constants.StatusExpired && sub.EndTime.After(now) {
+			sub.Status = constants.StatusActive
+		}
+		sub.UpdatedAt = now
+		if err := uc.subRepo.SaveSubscription(ctx, sub); err != nil {
+			return false, err
+		}
+		return true, uc.recordHistory(ctx, constants.ActionExtended, before, sub, op.Reason, now)
+
+	case constants.BulkOperationMigratePlan:
+		if sub.NextPlanID == op.TargetPlanID {
+			return false, nil
+		}
+		sub.NextPlanID = op.TargetPlanID
+		sub.UpdatedAt = now
+		if err := uc.subRepo.SaveSubscription(ctx, sub); err != nil {
+			return false, err
+		}
+		// 下次续费时切换到的套餐记录在历史的 next_plan_id 变更中
+		return true, uc.recordHistory(ctx, constants.ActionPlanChangeScheduled, before, sub, op.Reason, now)
+	}
+	return false, nil
+}
+
+// recordBulkFailure 记录处理失败的订阅并跳过，操作已被取消时返回 false
+func (uc *SubscriptionUsecase) recordBulkFailure(ctx context.Context, op *BulkOperation, sub *UserSubscription, cause error) (bool, error) {
+	uc.log.Warnf("Bulk operation %d failed for user %s: %v", op.BulkOperationID, sub.UID, cause)
+	op.CursorSubscriptionID = sub.SubscriptionID
+	op.ProcessedCount++
+	op.FailedCount++
+	msg := []rune(fmt.Sprintf("%s: %v", sub.UID, cause))
+	if len(msg) > constants.BulkOperationErrorMaxLen {
+		msg = msg[:constants.BulkOperationErrorMaxLen]
+	}
+	op.Error = string(msg)
+	op.UpdatedAt = time.Now().UTC()
+	return uc.bulkOpRepo.UpdateBulkOperation(ctx, op)
+}
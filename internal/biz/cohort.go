@@ -0,0 +1,280 @@
+package biz
+
+import (
+	"context"
+	"math"
+	"sort"
+	"time"
+
+	"xinyuan_tech/subscription-service/internal/constants"
+	"xinyuan_tech/subscription-service/internal/errors"
+
+	pkgErrors "github.com/gaoyong06/go-pkg/errors"
+	"github.com/gaoyong06/go-pkg/middleware/app_id"
+)
+
+// CohortMember 同期群用户（按首次订阅时间归入月度同期群）
+type CohortMember struct {
+	UID               string
+	FirstSubscribedAt time.Time
+}
+
+// CohortRow 月度同期群
+type CohortRow struct {
+	CohortMonth    time.Time // 同期群月份（UTC 月初）
+	Size           int       // 同期群用户数
+	ActiveCounts   []int     // 第 N 个月仍有付费订阅周期的用户数（下标为月份偏移，0 表示首次订阅当月）
+	Retention      []float64 // 第 N 个月的留存率（百分比）
+	Revenue        float64   // 同期群累计净收入（报表币种）
+	AverageRevenue float64   // 人均累计净收入
+}
+
+// CohortReport 同期群留存报表
+type CohortReport struct {
+	AppID             string
+	ReportingCurrency string
+	StartMonth        time.Time
+	EndMonth          time.Time
+	Cohorts           []*CohortRow
+	Unconverted       []string // 没有可用汇率、未计入收入的币种
+}
+
+// PlanLTV 套餐 LTV 估算
+type PlanLTV struct {
+	PlanID                 string
+	PlanName               string
+	BillingType            string
+	Subscribers            int     // 购买过该套餐的用户数
+	Orders                 int     // 已支付订单数
+	AverageOrderRevenue    float64 // 平均每单净收入（报表币种）
+	EndedPeriods           int     // 已结束的订阅周期数（续费率的样本数）
+	RenewalRate            float64 // 已结束周期中续费的比例
+	ChurnRate              float64 // 每个计费周期的流失率 = 1 - 续费率
+	LTVUnknown             bool    // 已结束周期不足 constants.MinLTVEndedPeriods 个或没有观察到流失，无法估算 LTV（预期订阅周期数和 LTV 为 0）
+	ExpectedPeriods        float64 // 预期订阅周期数 = 1 / 流失率（上限为 constants.MaxLTVMonths 个月）
+	EstimatedLTV           float64 // 估算 LTV = 平均每单净收入 * 预期订阅周期数（终身套餐为平均每单净收入）
+	ObservedRevenuePerUser float64 // 统计期内人均净收入
+}
+
+// LTVReport 套餐 LTV 报表
+type LTVReport struct {
+	AppID             string
+	ReportingCurrency string
+	StartTime         time.Time
+	Plans             []*PlanLTV
+	Unconverted       []string
+}
+
+// GetCohortReport 获取当前应用最近 months 个月的月度同期群留存报表
+// 同期群按用户首次订阅（订阅历史 created）的月份划分；用户在某月有已支付订单的订阅周期覆盖即视为当月留存，
+// 全额退款的订单不计入留存；收入为同期群用户所有订单的净收入（实收 - 退款 - 税额）
+func (uc *SubscriptionUsecase) GetCohortReport(ctx context.Context, months int) (*CohortReport, error) {
+	if months <= 0 || months > constants.MaxReportMonths {
+		return nil, pkgErrors.NewBizErrorWithLang(ctx, errors.ErrCodeReportRangeInvalid)
+	}
+	appID := app_id.GetAppIDFromContext(ctx)
+	now := time.Now().UTC()
+	endMonth := truncateMonth(now)
+	startMonth := endMonth.AddDate(0, 1-months, 0)
+
+	members, err := uc.metricRepo.ListCohortMembers(ctx, appID, startMonth, endMonth.AddDate(0, 1, 0))
+	if err != nil {
+		uc.log.Errorf("Failed to list cohort members of app %s: %v", appID, err)
+		return nil, err
+	}
+	orders, err := uc.orderRepo.ListPaidOrders(ctx, appID, startMonth)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &CohortReport{
+		AppID:             appID,
+		ReportingCurrency: uc.reportingCurrency(ctx, appID),
+		StartMonth:        startMonth,
+		EndMonth:          endMonth,
+	}
+	converter := uc.newRevenueConverter(report.ReportingCurrency, now)
+
+	cohortOf := make(map[string]time.Time, len(members))
+	cohorts := make(map[time.Time]*CohortRow)
+	for _, m := range members {
+		month := truncateMonth(m.FirstSubscribedAt)
+		cohortOf[m.UID] = month
+		row := cohorts[month]
+		if row == nil {
+			span := monthsBetween(month, endMonth) + 1
+			row = &CohortRow{CohortMonth: month, ActiveCounts: make([]int, span), Retention: make([]float64, span)}
+			cohorts[month] = row
+			report.Cohorts = append(report.Cohorts, row)
+		}
+		row.Size++
+	}
+
+	// 每个用户在各月份偏移是否有付费订阅周期
+	active := make(map[string]map[int]bool)
+	for _, o := range orders {
+		month, ok := cohortOf[o.UID]
+		if !ok {
+			continue
+		}
+		row := cohorts[month]
+		revenue, converted, err := converter.netRevenue(ctx, o)
+		if err != nil {
+			return nil, err
+		}
+		if converted {
+			row.Revenue += revenue
+		}
+		if o.PaymentStatus == constants.PaymentStatusRefunded || o.PeriodStart.IsZero() {
+			continue
+		}
+		if active[o.UID] == nil {
+			active[o.UID] = make(map[int]bool)
+		}
+		first := monthsBetween(month, truncateMonth(o.PeriodStart))
+		last := len(row.ActiveCounts) - 1
+		if !o.PeriodEnd.IsZero() {
+			// 周期结束时间为开区间，恰好在月初结束的周期不计入当月
+			last = min(last, monthsBetween(month, truncateMonth(o.PeriodEnd.Add(-time.Second))))
+		}
+		for n := max(first, 0); n <= last; n++ {
+			active[o.UID][n] = true
+		}
+	}
+	for uid, offsets := range active {
+		row := cohorts[cohortOf[uid]]
+		for n := range offsets {
+			row.ActiveCounts[n]++
+		}
+	}
+
+	for _, row := range report.Cohorts {
+		for n, count := range row.ActiveCounts {
+			row.Retention[n] = roundAmount(float64(count) * 100 / float64(row.Size))
+		}
+		row.Revenue = roundAmount(row.Revenue)
+		row.AverageRevenue = roundAmount(row.Revenue / float64(row.Size))
+	}
+	sort.Slice(report.Cohorts, func(i, j int) bool { return report.Cohorts[i].CohortMonth.Before(report.Cohorts[j].CohortMonth) })
+	report.Unconverted = converter.unconvertedCurrencies()
+	return report, nil
+}
+
+// GetPlanLTVReport 按最近 months 个月支付的订单估算当前应用各套餐的 LTV
+// 续费率按已结束的订阅周期统计：同一用户之后还有已支付订单的周期视为续费；全额退款的订单不参与续费率统计
+// 已结束周期太少或没有观察到流失时样本不足以估算订阅时长，LTV 标记为未知，不按最长订阅时长估算
+func (uc *SubscriptionUsecase) GetPlanLTVReport(ctx context.Context, months int) (*LTVReport, error) {
+	if months <= 0 || months > constants.MaxReportMonths {
+		return nil, pkgErrors.NewBizErrorWithLang(ctx, errors.ErrCodeReportRangeInvalid)
+	}
+	appID := app_id.GetAppIDFromContext(ctx)
+	now := time.Now().UTC()
+	startTime := truncateMonth(now).AddDate(0, 1-months, 0)
+
+	orders, err := uc.orderRepo.ListPaidOrders(ctx, appID, startTime)
+	if err != nil {
+		return nil, err
+	}
+	report := &LTVReport{
+		AppID:             appID,
+		ReportingCurrency: uc.reportingCurrency(ctx, appID),
+		StartTime:         startTime,
+	}
+	converter := uc.newRevenueConverter(report.ReportingCurrency, now)
+
+	type planStats struct {
+		ltv      *PlanLTV
+		users    map[string]bool
+		revenue  float64
+		ended    int
+		renewed  int
+		interval BillingInterval
+	}
+	stats := make(map[string]*planStats)
+	byUser := make(map[string][]*SubscriptionOrder)
+	for _, o := range orders {
+		s := stats[o.PlanID]
+		if s == nil {
+			s = &planStats{ltv: &PlanLTV{PlanID: o.PlanID}, users: make(map[string]bool)}
+			if plan, err := uc.planRepo.GetPlan(ctx, o.PlanID); err == nil && plan != nil {
+				s.ltv.PlanName = plan.Name
+				s.ltv.BillingType = plan.BillingType
+				s.interval = plan.BillingInterval()
+			}
+			stats[o.PlanID] = s
+			report.Plans = append(report.Plans, s.ltv)
+		}
+		// 续费率与币种无关，无法换算的订单也参与续费统计，否则其前一个周期会被误判为流失
+		if o.PaymentStatus != constants.PaymentStatusRefunded {
+			byUser[o.UID] = append(byUser[o.UID], o)
+		}
+		revenue, converted, err := converter.netRevenue(ctx, o)
+		if err != nil {
+			return nil, err
+		}
+		if !converted {
+			continue
+		}
+		s.revenue += revenue
+		s.users[o.UID] = true
+		s.ltv.Orders++
+	}
+
+	// 已结束的订阅周期是否续费（订单已按支付时间升序）：下一笔订单为同一套餐才算续费，换到其他套餐计为原套餐流失
+	for _, userOrders := range byUser {
+		for i, o := range userOrders {
+			if o.PeriodEnd.IsZero() || o.PeriodEnd.After(now) {
+				continue
+			}
+			s := stats[o.PlanID]
+			s.ended++
+			if i < len(userOrders)-1 && userOrders[i+1].PlanID == o.PlanID {
+				s.renewed++
+			}
+		}
+	}
+
+	for _, s := range stats {
+		ltv := s.ltv
+		ltv.Subscribers = len(s.users)
+		if ltv.Orders == 0 {
+			continue
+		}
+		ltv.AverageOrderRevenue = roundAmount(s.revenue / float64(ltv.Orders))
+		ltv.ObservedRevenuePerUser = roundAmount(s.revenue / float64(ltv.Subscribers))
+		if ltv.BillingType == constants.PlanBillingLifetime {
+			ltv.ExpectedPeriods = 1
+			ltv.EstimatedLTV = ltv.AverageOrderRevenue
+			continue
+		}
+		ltv.EndedPeriods = s.ended
+		if s.ended > 0 {
+			ltv.RenewalRate = roundRate(float64(s.renewed) / float64(s.ended))
+			ltv.ChurnRate = roundRate(1 - float64(s.renewed)/float64(s.ended))
+		}
+		if s.ended < constants.MinLTVEndedPeriods || ltv.ChurnRate <= 0 {
+			ltv.LTVUnknown = true
+			continue
+		}
+		maxPeriods := float64(constants.MaxLTVMonths)
+		if s.interval.IsValid() {
+			maxPeriods = float64(constants.MaxLTVMonths) / s.interval.Months()
+		}
+		ltv.ExpectedPeriods = roundAmount(math.Min(1/ltv.ChurnRate, maxPeriods))
+		ltv.EstimatedLTV = roundAmount(ltv.AverageOrderRevenue * ltv.ExpectedPeriods)
+	}
+	sort.Slice(report.Plans, func(i, j int) bool { return report.Plans[i].PlanID < report.Plans[j].PlanID })
+	report.Unconverted = converter.unconvertedCurrencies()
+	return report, nil
+}
+
+// truncateMonth 截断为 UTC 月初
+func truncateMonth(t time.Time) time.Time {
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+}
+
+// monthsBetween from 到 to 相差的月数（均为月初）
+func monthsBetween(from, to time.Time) int {
+	return (to.Year()-from.Year())*12 + int(to.Month()) - int(from.Month())
+}
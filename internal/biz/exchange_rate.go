@@ -0,0 +1,242 @@
+package biz
+
+import (
+	"context"
+	"encoding/csv"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"xinyuan_tech/subscription-service/internal/constants"
+	"xinyuan_tech/subscription-service/internal/errors"
+
+	pkgErrors "github.com/gaoyong06/go-pkg/errors"
+)
+
+// ExchangeRate 汇率（1 FromCurrency = Rate ToCurrency），从生效时间起生效直到同一币种对的下一条汇率
+type ExchangeRate struct {
+	ExchangeRateID uint64
+	FromCurrency   string
+	ToCurrency     string
+	Rate           float64
+	EffectiveAt    time.Time
+	Source         string // admin, file
+	CreatedAt      time.Time
+}
+
+// ExchangeRateRepo 汇率仓库接口
+type ExchangeRateRepo interface {
+	// SaveExchangeRates 批量保存汇率（同一币种对、同一生效时间已存在时覆盖汇率）
+	SaveExchangeRates(ctx context.Context, rates []*ExchangeRate) error
+	// GetEffectiveRate 获取指定时间生效的汇率（生效时间不晚于 at 的最新一条），不存在时返回 nil
+	GetEffectiveRate(ctx context.Context, from, to string, at time.Time) (*ExchangeRate, error)
+	// ListExchangeRates 分页获取汇率，from、to 为空时不过滤，按生效时间倒序
+	ListExchangeRates(ctx context.Context, from, to string, page, pageSize int) ([]*ExchangeRate, int, error)
+}
+
+// SaveExchangeRates 保存汇率（管理接口）
+func (uc *SubscriptionUsecase) SaveExchangeRates(ctx context.Context, rates []*ExchangeRate) error {
+	if err := normalizeExchangeRates(ctx, rates); err != nil {
+		return err
+	}
+	if len(rates) == 0 {
+		return nil
+	}
+	if err := uc.exchangeRateRepo.SaveExchangeRates(ctx, rates); err != nil {
+		uc.log.Errorf("Failed to save exchange rates: %v", err)
+		return err
+	}
+	uc.log.Infof("Saved %d exchange rates", len(rates))
+	return nil
+}
+
+// normalizeExchangeRates 校验汇率并补全币种大小写、生效时间和来源
+func normalizeExchangeRates(ctx context.Context, rates []*ExchangeRate) error {
+	now := time.Now().UTC()
+	for _, r := range rates {
+		r.FromCurrency = strings.ToUpper(r.FromCurrency)
+		r.ToCurrency = strings.ToUpper(r.ToCurrency)
+		if !isCurrencyCode(r.FromCurrency) || !isCurrencyCode(r.ToCurrency) || r.FromCurrency == r.ToCurrency || r.Rate <= 0 {
+			return pkgErrors.NewBizErrorWithLang(ctx, errors.ErrCodeExchangeRateInvalid)
+		}
+		if r.EffectiveAt.IsZero() {
+			r.EffectiveAt = now
+		}
+		if r.Source == "" {
+			r.Source = constants.ExchangeRateSourceAdmin
+		}
+		r.CreatedAt = now
+	}
+	return nil
+}
+
+// ListExchangeRates 分页获取汇率
+func (uc *SubscriptionUsecase) ListExchangeRates(ctx context.Context, from, to string, page, pageSize int) ([]*ExchangeRate, int, error) {
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 || pageSize > 100 {
+		pageSize = 10
+	}
+	return uc.exchangeRateRepo.ListExchangeRates(ctx, strings.ToUpper(from), strings.ToUpper(to), page, pageSize)
+}
+
+// ImportExchangeRates 从 CSV 导入汇率，content 为空时读取配置的本地汇率文件
+// CSV 每行格式：from_currency,to_currency,rate,effective_at（RFC3339 或 2006-01-02，为空表示当前时间）
+// 以 # 开头的行和表头行会被忽略；同一币种对、同一生效时间重复导入时覆盖
+// dryRun 为 true 时只解析和校验，不保存
+func (uc *SubscriptionUsecase) ImportExchangeRates(ctx context.Context, content string, dryRun bool) (int, error) {
+	source := constants.ExchangeRateSourceAdmin
+	if content == "" {
+		path := uc.exchangeRateFilePath()
+		if path == "" {
+			uc.log.Warnf("Exchange rate file is not configured")
+			return 0, pkgErrors.NewBizErrorWithLang(ctx, errors.ErrCodeExchangeRateFileInvalid)
+		}
+		data, err := os.ReadFile(path)
+		if err != nil {
+			uc.log.Errorf("Failed to read exchange rate file %s: %v", path, err)
+			return 0, pkgErrors.NewBizErrorWithLang(ctx, errors.ErrCodeExchangeRateFileInvalid)
+		}
+		content = string(data)
+		source = constants.ExchangeRateSourceFile
+	}
+
+	rates, err := parseExchangeRates(content, source)
+	if err != nil {
+		uc.log.Errorf("Failed to parse exchange rates: %v", err)
+		return 0, pkgErrors.NewBizErrorWithLang(ctx, errors.ErrCodeExchangeRateFileInvalid)
+	}
+	if dryRun {
+		if err := normalizeExchangeRates(ctx, rates); err != nil {
+			return 0, err
+		}
+		uc.log.Infof("[DRY RUN] Would import %d exchange rates", len(rates))
+		return len(rates), nil
+	}
+	if err := uc.SaveExchangeRates(ctx, rates); err != nil {
+		return 0, err
+	}
+	return len(rates), nil
+}
+
+// parseExchangeRates 解析 CSV 格式的汇率
+func parseExchangeRates(content, source string) ([]*ExchangeRate, error) {
+	reader := csv.NewReader(strings.NewReader(content))
+	reader.Comment = '#'
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	rates := make([]*ExchangeRate, 0)
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if len(record) < 3 || strings.EqualFold(record[0], "from_currency") {
+			continue
+		}
+		rate, err := strconv.ParseFloat(strings.TrimSpace(record[2]), 64)
+		if err != nil {
+			return nil, err
+		}
+		r := &ExchangeRate{
+			FromCurrency: strings.TrimSpace(record[0]),
+			ToCurrency:   strings.TrimSpace(record[1]),
+			Rate:         rate,
+			Source:       source,
+		}
+		if len(record) > 3 && strings.TrimSpace(record[3]) != "" {
+			if r.EffectiveAt, err = parseRateTime(strings.TrimSpace(record[3])); err != nil {
+				return nil, err
+			}
+		}
+		rates = append(rates, r)
+	}
+	return rates, nil
+}
+
+func parseRateTime(s string) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, s); err == nil {
+		return t.UTC(), nil
+	}
+	return time.Parse(constants.DateLayout, s)
+}
+
+// convertRate 获取 at 时刻 from 到 to 的汇率，依次尝试直接汇率、反向汇率和经由中间币种的交叉汇率
+// 没有可用汇率时返回 0
+func (uc *SubscriptionUsecase) convertRate(ctx context.Context, from, to string, at time.Time) (float64, error) {
+	if from == to {
+		return 1, nil
+	}
+	rate, err := uc.pairRate(ctx, from, to, at)
+	if err != nil || rate > 0 {
+		return rate, err
+	}
+	pivot := constants.ExchangeRatePivotCurrency
+	if from == pivot || to == pivot {
+		return 0, nil
+	}
+	fromPivot, err := uc.pairRate(ctx, from, pivot, at)
+	if err != nil || fromPivot == 0 {
+		return 0, err
+	}
+	pivotTo, err := uc.pairRate(ctx, pivot, to, at)
+	if err != nil || pivotTo == 0 {
+		return 0, err
+	}
+	return fromPivot * pivotTo, nil
+}
+
+// pairRate 获取币种对的直接汇率或反向汇率，没有时返回 0
+func (uc *SubscriptionUsecase) pairRate(ctx context.Context, from, to string, at time.Time) (float64, error) {
+	direct, err := uc.exchangeRateRepo.GetEffectiveRate(ctx, from, to, at)
+	if err != nil {
+		return 0, err
+	}
+	if direct != nil {
+		return direct.Rate, nil
+	}
+	inverse, err := uc.exchangeRateRepo.GetEffectiveRate(ctx, to, from, at)
+	if err != nil {
+		return 0, err
+	}
+	if inverse != nil && inverse.Rate > 0 {
+		return 1 / inverse.Rate, nil
+	}
+	return 0, nil
+}
+
+// reportingCurrency 获取应用的报表币种：应用配置 -> 全局配置 -> 默认 USD
+func (uc *SubscriptionUsecase) reportingCurrency(ctx context.Context, appID string) string {
+	if appID != "" {
+		setting, err := uc.appSettingRepo.GetAppSetting(ctx, appID)
+		if err != nil {
+			uc.log.Warnf("Failed to get app setting of %s: %v", appID, err)
+		} else if setting != nil && setting.ReportingCurrency != "" {
+			return setting.ReportingCurrency
+		}
+	}
+	if uc.config != nil && uc.config.GetExchangeRate() != nil && uc.config.GetExchangeRate().GetDefaultReportingCurrency() != "" {
+		return strings.ToUpper(uc.config.GetExchangeRate().GetDefaultReportingCurrency())
+	}
+	return constants.DefaultReportingCurrency
+}
+
+// exchangeRateFilePath 本地汇率文件路径
+func (uc *SubscriptionUsecase) exchangeRateFilePath() string {
+	if uc.config != nil && uc.config.GetExchangeRate() != nil {
+		return uc.config.GetExchangeRate().GetFilePath()
+	}
+	return ""
+}
+
+// isCurrencyCode 是否为 ISO 4217 币种代码格式（3 位大写字母）
+func isCurrencyCode(s string) bool {
+	return len(s) == 3 && isUpperLetters(s)
+}
@@ -0,0 +1,54 @@
+package biz
+
+import (
+	"context"
+	"time"
+
+	"xinyuan_tech/subscription-service/internal/constants"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// DependencyHealth 依赖的健康状态
+type DependencyHealth struct {
+	Name     string
+	Status   string // UP, DOWN, DISABLED
+	Required bool   // 是否影响就绪状态（可选依赖不可用时服务降级运行）
+	Latency  time.Duration
+	Error    string
+}
+
+// HealthChecker 依赖健康检查接口（MySQL、Redis 以及下游 gRPC 服务）
+type HealthChecker interface {
+	// CheckDependencies 检查所有依赖，返回每个依赖的状态
+	CheckDependencies(ctx context.Context) []*DependencyHealth
+}
+
+// HealthUsecase 健康检查用例
+type HealthUsecase struct {
+	checker HealthChecker
+	log     *log.Helper
+}
+
+// NewHealthUsecase 创建健康检查用例
+func NewHealthUsecase(checker HealthChecker, logger log.Logger) *HealthUsecase {
+	return &HealthUsecase{checker: checker, log: log.NewHelper(logger)}
+}
+
+// Readiness 就绪检查：所有必需依赖可用时就绪
+func (uc *HealthUsecase) Readiness(ctx context.Context) (bool, []*DependencyHealth) {
+	ctx, cancel := context.WithTimeout(ctx, constants.HealthCheckTimeout)
+	defer cancel()
+
+	ready := true
+	deps := uc.checker.CheckDependencies(ctx)
+	for _, dep := range deps {
+		if dep.Status == constants.HealthStatusDown {
+			uc.log.Warnf("Dependency %s is down: %s", dep.Name, dep.Error)
+			if dep.Required {
+				ready = false
+			}
+		}
+	}
+	return ready, deps
+}
@@ -0,0 +1,269 @@
+package biz
+
+import (
+	"context"
+	"time"
+
+	"xinyuan_tech/subscription-service/internal/constants"
+	"xinyuan_tech/subscription-service/internal/errors"
+
+	pkgErrors "github.com/gaoyong06/go-pkg/errors"
+	"github.com/gaoyong06/go-pkg/middleware/app_id"
+)
+
+// Invoice 发票（支付成功后开具）或红字发票（退款时开具）
+// 发票一经开具不可修改，退款通过红字发票冲减
+type Invoice struct {
+	InvoiceID         uint64
+	InvoiceNo         string // 应用内按类型连续递增的编号，如 INV-000001、CN-000001
+	InvoiceType       string // invoice, credit_note
+	AppID             string
+	UID               string
+	OrderID           string
+	OriginalInvoiceNo string // 红字发票冲减的原发票编号
+	PlanID            string
+	PlanName          string
+	CountryCode       string
+	VatID             string
+	Currency          string
+	Subtotal          float64 // 套餐价格（红字发票为冲减的不含税金额）
+	Discount          float64
+	ProrationCredit   float64
+	TaxAmount         float64
+	Total             float64 // 发票金额（红字发票为退款金额）
+	PeriodStart       time.Time
+	PeriodEnd         time.Time // 终身套餐为零值
+	Lines             []*InvoiceLine
+	IssuedAt          time.Time
+}
+
+// InvoiceLine 发票明细行
+type InvoiceLine struct {
+	LineType      string // plan, discount, proration, tax, refund
+	Description   string // 套餐名称或税种名称（渲染时按语言加上明细类型标签）
+	PeriodStart   time.Time
+	PeriodEnd     time.Time
+	TaxRate       float64 // 税费明细的税率
+	Inclusive     bool    // 税费明细是否含税定价
+	ReverseCharge bool    // 税费明细是否反向征税
+	Amount        float64 // 金额（优惠和抵扣为负数）
+}
+
+// InvoiceRepo 发票仓库接口
+type InvoiceRepo interface {
+	// CreateInvoice 分配应用内连续编号并保存发票及明细
+	CreateInvoice(ctx context.Context, invoice *Invoice) error
+	// GetInvoice 获取发票（包含明细），不存在时返回 nil
+	GetInvoice(ctx context.Context, invoiceID uint64) (*Invoice, error)
+	// ListInvoicesByOrder 获取订单的发票和红字发票（包含明细），按开具时间排序
+	ListInvoicesByOrder(ctx context.Context, orderID string) ([]*Invoice, error)
+	// ListInvoices 分页获取用户的发票（不包含明细），appID 为空时不按应用过滤
+	ListInvoices(ctx context.Context, appID, uid string, page, pageSize int) ([]*Invoice, int, error)
+}
+
+// GetInvoice 获取发票
+func (uc *SubscriptionUsecase) GetInvoice(ctx context.Context, invoiceID uint64) (*Invoice, error) {
+	invoice, err := uc.invoiceRepo.GetInvoice(ctx, invoiceID)
+	if err != nil {
+		uc.log.Errorf("Failed to get invoice %d: %v", invoiceID, err)
+		return nil, err
+	}
+	if invoice == nil {
+		return nil, pkgErrors.NewBizErrorWithLang(ctx, errors.ErrCodeInvoiceNotFound)
+	}
+	return invoice, nil
+}
+
+// ListInvoices 分页获取用户在当前应用的发票和红字发票
+func (uc *SubscriptionUsecase) ListInvoices(ctx context.Context, uid string, page, pageSize int) ([]*Invoice, int, error) {
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 || pageSize > 100 {
+		pageSize = 10
+	}
+	return uc.invoiceRepo.ListInvoices(ctx, app_id.GetAppIDFromContext(ctx), uid, page, pageSize)
+}
+
+// ensureInvoice 为已支付订单开具发票（已开具时跳过）
+// 支付成功回调重试时同样会调用，保证开票失败后可以补开
+func (uc *SubscriptionUsecase) ensureInvoice(ctx context.Context, order *SubscriptionOrder) error {
+	invoices, err := uc.invoiceRepo.ListInvoicesByOrder(ctx, order.OrderID)
+	if err != nil {
+		uc.log.Errorf("Failed to get invoices of order %s: %v", order.OrderID, err)
+		return err
+	}
+	if findInvoice(invoices) != nil {
+		return nil
+	}
+
+	plan, err := uc.planRepo.GetPlan(ctx, order.PlanID)
+	if err != nil {
+		uc.log.Errorf("Failed to get plan %s for invoice: %v", order.PlanID, err)
+		return pkgErrors.NewBizErrorWithLang(ctx, errors.ErrCodePlanNotFound)
+	}
+	taxLines, err := uc.orderRepo.GetOrderTaxLines(ctx, order.OrderID)
+	if err != nil {
+		return err
+	}
+
+	invoice := &Invoice{
+		InvoiceType:     constants.InvoiceTypeInvoice,
+		AppID:           order.AppID,
+		UID:             order.UID,
+		OrderID:         order.OrderID,
+		PlanID:          order.PlanID,
+		PlanName:        plan.Name,
+		CountryCode:     order.CountryCode,
+		VatID:           order.VatID,
+		Currency:        order.Currency,
+		Subtotal:        order.Subtotal,
+		Discount:        order.DiscountAmount,
+		ProrationCredit: order.ProrationCredit,
+		TaxAmount:       order.TaxAmount,
+		Total:           order.Amount,
+		PeriodStart:     order.PeriodStart,
+		PeriodEnd:       order.PeriodEnd,
+		IssuedAt:        time.Now().UTC(),
+	}
+	invoice.Lines = append(invoice.Lines, &InvoiceLine{
+		LineType:    constants.InvoiceLineTypePlan,
+		Description: plan.Name,
+		PeriodStart: order.PeriodStart,
+		PeriodEnd:   order.PeriodEnd,
+		Amount:      order.Subtotal,
+	})
+	if order.DiscountAmount > 0 {
+		invoice.Lines = append(invoice.Lines, &InvoiceLine{
+			LineType:    constants.InvoiceLineTypeDiscount,
+			Description: plan.Name,
+			Amount:      -order.DiscountAmount,
+		})
+	}
+	if order.ProrationCredit > 0 {
+		invoice.Lines = append(invoice.Lines, &InvoiceLine{
+			LineType:    constants.InvoiceLineTypeProration,
+			Description: plan.Name,
+			Amount:      -order.ProrationCredit,
+		})
+	}
+	invoice.Lines = append(invoice.Lines, taxInvoiceLines(taxLines, 1)...)
+
+	if err := uc.invoiceRepo.CreateInvoice(ctx, invoice); err != nil {
+		uc.log.Errorf("Failed to issue invoice for order %s: %v", order.OrderID, err)
+		return err
+	}
+	uc.log.Infof("Issued invoice %s for order %s", invoice.InvoiceNo, order.OrderID)
+	return nil
+}
+
+// issueCreditNote 退款时开具红字发票冲减原发票
+// 全额退款冲减原发票剩余金额，部分退款按退款金额冲减（不超过剩余金额），税额按退款比例冲减
+// 订单没有开具过发票（如开票功能上线前的订单）时不开具
+func (uc *SubscriptionUsecase) issueCreditNote(ctx context.Context, order *SubscriptionOrder, amount float64, fullRefund bool) error {
+	invoices, err := uc.invoiceRepo.ListInvoicesByOrder(ctx, order.OrderID)
+	if err != nil {
+		uc.log.Errorf("Failed to get invoices of order %s: %v", order.OrderID, err)
+		return err
+	}
+	original := findInvoice(invoices)
+	if original == nil || original.Total <= 0 {
+		uc.log.Infof("Order %s has no invoice to credit, skip credit note", order.OrderID)
+		return nil
+	}
+
+	remaining := original.Total
+	for _, inv := range invoices {
+		if inv.InvoiceType == constants.InvoiceTypeCreditNote {
+			remaining -= inv.Total
+		}
+	}
+	remaining = roundAmount(remaining)
+	credit := roundAmount(amount)
+	if fullRefund || credit > remaining {
+		credit = remaining
+	}
+	if credit <= 0 {
+		return nil
+	}
+
+	// 税费明细按退款比例冲减
+	ratio := credit / original.Total
+	taxLines := make([]*TaxLine, 0)
+	for _, l := range original.Lines {
+		if l.LineType == constants.InvoiceLineTypeTax {
+			taxLines = append(taxLines, &TaxLine{
+				Name:          l.Description,
+				Rate:          l.TaxRate,
+				Inclusive:     l.Inclusive,
+				ReverseCharge: l.ReverseCharge,
+				Amount:        l.Amount,
+			})
+		}
+	}
+	lines := taxInvoiceLines(taxLines, ratio)
+	tax := 0.0
+	for _, l := range lines {
+		tax += l.Amount
+	}
+	tax = roundAmount(tax)
+
+	note := &Invoice{
+		InvoiceType:       constants.InvoiceTypeCreditNote,
+		AppID:             original.AppID,
+		UID:               original.UID,
+		OrderID:           original.OrderID,
+		OriginalInvoiceNo: original.InvoiceNo,
+		PlanID:            original.PlanID,
+		PlanName:          original.PlanName,
+		CountryCode:       original.CountryCode,
+		VatID:             original.VatID,
+		Currency:          original.Currency,
+		Subtotal:          roundAmount(credit - tax),
+		TaxAmount:         tax,
+		Total:             credit,
+		PeriodStart:       original.PeriodStart,
+		PeriodEnd:         original.PeriodEnd,
+		IssuedAt:          time.Now().UTC(),
+	}
+	note.Lines = append([]*InvoiceLine{{
+		LineType:    constants.InvoiceLineTypeRefund,
+		Description: original.PlanName,
+		PeriodStart: original.PeriodStart,
+		PeriodEnd:   original.PeriodEnd,
+		Amount:      note.Subtotal,
+	}}, lines...)
+
+	if err := uc.invoiceRepo.CreateInvoice(ctx, note); err != nil {
+		uc.log.Errorf("Failed to issue credit note for order %s: %v", order.OrderID, err)
+		return err
+	}
+	uc.log.Infof("Issued credit note %s for invoice %s, amount=%.2f %s", note.InvoiceNo, original.InvoiceNo, credit, note.Currency)
+	return nil
+}
+
+// taxInvoiceLines 将税费明细转换为发票明细，税额按 ratio 折算
+func taxInvoiceLines(taxLines []*TaxLine, ratio float64) []*InvoiceLine {
+	lines := make([]*InvoiceLine, len(taxLines))
+	for i, t := range taxLines {
+		lines[i] = &InvoiceLine{
+			LineType:      constants.InvoiceLineTypeTax,
+			Description:   t.Name,
+			TaxRate:       t.Rate,
+			Inclusive:     t.Inclusive,
+			ReverseCharge: t.ReverseCharge,
+			Amount:        roundAmount(t.Amount * ratio),
+		}
+	}
+	return lines
+}
+
+// findInvoice 从订单的发票列表中找到原发票
+func findInvoice(invoices []*Invoice) *Invoice {
+	for _, inv := range invoices {
+		if inv.InvoiceType == constants.InvoiceTypeInvoice {
+			return inv
+		}
+	}
+	return nil
+}
@@ -0,0 +1,280 @@
+package biz
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"time"
+
+	"xinyuan_tech/subscription-service/internal/constants"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// JobRun 定时任务执行记录（同一任务同一计划时间只执行一次）
+type JobRun struct {
+	JobRunID     uint64
+	JobName      string
+	ScheduledAt  time.Time // 计划执行时间（补跑时为错过的计划时间）
+	Trigger      string    // schedule, catch_up, retry, manual
+	Status       string    // running, success, failed, skipped
+	Host         string    // 执行实例
+	Attempts     int       // 执行次数（失败后重试同一计划时递增）
+	DryRun       bool      // 测试运行（只统计不修改数据）
+	StartedAt    time.Time
+	FinishedAt   time.Time
+	TotalCount   int
+	SuccessCount int
+	FailedCount  int
+	Error        string
+}
+
+// JobSetting 定时任务设置（暂停后主节点跳过该任务的计划执行，手动触发不受影响）
+type JobSetting struct {
+	JobName     string
+	Paused      bool
+	PauseReason string
+	PausedAt    time.Time
+	UpdatedAt   time.Time
+}
+
+// JobResult 定时任务处理结果（记录到执行记录）
+type JobResult struct {
+	TotalCount   int
+	SuccessCount int
+	FailedCount  int
+}
+
+// JobRunRepo 定时任务执行记录仓库接口
+type JobRunRepo interface {
+	// CreateJobRun 创建执行记录，同一任务同一计划时间已有记录时返回 false
+	CreateJobRun(ctx context.Context, run *JobRun) (bool, error)
+	// RetryJobRun 将失败的执行记录重新标记为执行中并递增执行次数（只更新执行次数与 run.Attempts 一致的失败记录），已被其他实例重试时返回 false
+	RetryJobRun(ctx context.Context, run *JobRun) (bool, error)
+	// UpdateJobRun 更新执行结果（只更新同一次执行，已被重试的旧执行不会覆盖结果）
+	UpdateJobRun(ctx context.Context, run *JobRun) error
+	// GetLastJobRun 获取任务计划时间最近的执行记录（不含手动触发），不存在时返回 nil
+	GetLastJobRun(ctx context.Context, jobName string) (*JobRun, error)
+	// ListJobRuns 分页获取执行记录，jobName、status 为空时不过滤，按开始时间倒序
+	ListJobRuns(ctx context.Context, jobName, status string, page, pageSize int) ([]*JobRun, int, error)
+	// FailStaleJobRuns 将开始时间早于 startedBefore 仍在执行中的记录标记为失败（实例中途退出），返回更新条数
+	FailStaleJobRuns(ctx context.Context, jobName string, startedBefore time.Time, reason string) (int64, error)
+	// GetJobCheckpoint 获取执行记录上保存的任务进度，不存在时返回空字符串
+	GetJobCheckpoint(ctx context.Context, jobName string, scheduledAt time.Time) (string, error)
+	// SaveJobCheckpoint 保存任务进度到执行记录
+	SaveJobCheckpoint(ctx context.Context, jobName string, scheduledAt time.Time, checkpoint string) error
+	// GetJobSetting 获取任务设置，不存在时返回 nil
+	GetJobSetting(ctx context.Context, jobName string) (*JobSetting, error)
+	// SaveJobSetting 保存任务设置
+	SaveJobSetting(ctx context.Context, setting *JobSetting) error
+}
+
+// Checkpoint 定时任务进度（保存在本次计划的执行记录上），任务超时或实例中途退出后重试同一计划时从进度处继续
+type Checkpoint interface {
+	// Load 读取进度到 v，没有保存过进度时返回 false
+	Load(ctx context.Context, v interface{}) (bool, error)
+	// Save 保存进度
+	Save(ctx context.Context, v interface{}) error
+}
+
+// JobRunUsecase 定时任务执行记录用例
+type JobRunUsecase struct {
+	repo JobRunRepo
+	host string
+	log  *log.Helper
+}
+
+// NewJobRunUsecase 创建定时任务执行记录用例
+func NewJobRunUsecase(repo JobRunRepo, logger log.Logger) *JobRunUsecase {
+	host, _ := os.Hostname()
+	return &JobRunUsecase{repo: repo, host: host, log: log.NewHelper(logger)}
+}
+
+// RunJob 执行定时任务并记录执行记录
+// 同一任务同一计划时间已有执行记录（其他实例已执行或正在执行）时不执行，返回 false
+func (uc *JobRunUsecase) RunJob(ctx context.Context, jobName, trigger string, scheduledAt time.Time, fn func(ctx context.Context) (*JobResult, error)) (bool, error) {
+	run, err := uc.StartJob(ctx, jobName, trigger, scheduledAt, false)
+	if err != nil || run == nil {
+		return false, err
+	}
+	return true, uc.FinishJob(ctx, run, fn)
+}
+
+// StartJob 创建执行中的执行记录，同一任务同一计划时间已有执行记录时返回 nil
+func (uc *JobRunUsecase) StartJob(ctx context.Context, jobName, trigger string, scheduledAt time.Time, dryRun bool) (*JobRun, error) {
+	run := &JobRun{
+		JobName:     jobName,
+		ScheduledAt: scheduledAt.UTC().Truncate(time.Second),
+		Trigger:     trigger,
+		Status:      constants.JobRunStatusRunning,
+		Host:        uc.host,
+		StartedAt:   time.Now().UTC(),
+		Attempts:    1,
+		DryRun:      dryRun,
+	}
+	created, err := uc.repo.CreateJobRun(ctx, run)
+	if err != nil {
+		return nil, err
+	}
+	if !created {
+		uc.log.Infof("Job %s scheduled at %s already ran, skip", jobName, run.ScheduledAt.Format(time.RFC3339))
+		return nil, nil
+	}
+	return run, nil
+}
+
+// SkipJob 记录跳过的计划（任务已暂停），之后不会补跑该计划
+func (uc *JobRunUsecase) SkipJob(ctx context.Context, jobName, trigger string, scheduledAt time.Time, reason string) error {
+	now := time.Now().UTC()
+	run := &JobRun{
+		JobName:     jobName,
+		ScheduledAt: scheduledAt.UTC().Truncate(time.Second),
+		Trigger:     trigger,
+		Status:      constants.JobRunStatusSkipped,
+		Host:        uc.host,
+		StartedAt:   now,
+		FinishedAt:  now,
+		Attempts:    1,
+		Error:       reason,
+	}
+	_, err := uc.repo.CreateJobRun(ctx, run)
+	return err
+}
+
+// RetryJob 重试失败的执行（同一计划），任务可以通过 Checkpoint 从上次保存的进度继续
+// 已被其他实例重试时不执行，返回 false
+func (uc *JobRunUsecase) RetryJob(ctx context.Context, last *JobRun, fn func(ctx context.Context) (*JobResult, error)) (bool, error) {
+	run := &JobRun{
+		JobRunID:    last.JobRunID,
+		JobName:     last.JobName,
+		ScheduledAt: last.ScheduledAt,
+		Trigger:     constants.JobTriggerRetry,
+		Status:      constants.JobRunStatusRunning,
+		Host:        uc.host,
+		StartedAt:   time.Now().UTC(),
+		Attempts:    last.Attempts,
+	}
+	retried, err := uc.repo.RetryJobRun(ctx, run)
+	if err != nil {
+		return false, err
+	}
+	if !retried {
+		uc.log.Infof("Job %s scheduled at %s is already retried, skip", run.JobName, run.ScheduledAt.Format(time.RFC3339))
+		return false, nil
+	}
+	return true, uc.FinishJob(ctx, run, fn)
+}
+
+// FinishJob 执行任务并保存执行结果
+func (uc *JobRunUsecase) FinishJob(ctx context.Context, run *JobRun, fn func(ctx context.Context) (*JobResult, error)) error {
+	result, runErr := fn(ctx)
+	run.FinishedAt = time.Now().UTC()
+	run.Status = constants.JobRunStatusSuccess
+	if result != nil {
+		run.TotalCount = result.TotalCount
+		run.SuccessCount = result.SuccessCount
+		run.FailedCount = result.FailedCount
+	}
+	if runErr != nil {
+		run.Status = constants.JobRunStatusFailed
+		run.Error = runErr.Error()
+	}
+	// 任务可能因超时结束，使用新的 Context 保存执行结果
+	saveCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), 10*time.Second)
+	defer cancel()
+	if err := uc.repo.UpdateJobRun(saveCtx, run); err != nil {
+		uc.log.Errorf("Failed to save result of job %s run %d: %v", run.JobName, run.JobRunID, err)
+	}
+	return runErr
+}
+
+// GetLastJobRun 获取任务最近一次计划执行记录（不含手动触发），不存在时返回 nil
+func (uc *JobRunUsecase) GetLastJobRun(ctx context.Context, jobName string) (*JobRun, error) {
+	return uc.repo.GetLastJobRun(ctx, jobName)
+}
+
+// ListJobRuns 分页获取执行记录
+func (uc *JobRunUsecase) ListJobRuns(ctx context.Context, jobName, status string, page, pageSize int) ([]*JobRun, int, error) {
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 || pageSize > constants.MaxPageSize {
+		pageSize = constants.DefaultPageSize
+	}
+	return uc.repo.ListJobRuns(ctx, jobName, status, page, pageSize)
+}
+
+// GetJobSetting 获取任务设置，没有设置过时返回未暂停的默认设置
+func (uc *JobRunUsecase) GetJobSetting(ctx context.Context, jobName string) (*JobSetting, error) {
+	setting, err := uc.repo.GetJobSetting(ctx, jobName)
+	if err != nil {
+		return nil, err
+	}
+	if setting == nil {
+		setting = &JobSetting{JobName: jobName}
+	}
+	return setting, nil
+}
+
+// PauseJob 暂停任务的计划执行
+func (uc *JobRunUsecase) PauseJob(ctx context.Context, jobName, reason string) error {
+	now := time.Now().UTC()
+	if err := uc.repo.SaveJobSetting(ctx, &JobSetting{JobName: jobName, Paused: true, PauseReason: reason, PausedAt: now, UpdatedAt: now}); err != nil {
+		return err
+	}
+	uc.log.Infof("Paused job %s: %s", jobName, reason)
+	return nil
+}
+
+// ResumeJob 恢复任务的计划执行（暂停期间跳过的计划不会补跑）
+func (uc *JobRunUsecase) ResumeJob(ctx context.Context, jobName string) error {
+	if err := uc.repo.SaveJobSetting(ctx, &JobSetting{JobName: jobName, UpdatedAt: time.Now().UTC()}); err != nil {
+		return err
+	}
+	uc.log.Infof("Resumed job %s", jobName)
+	return nil
+}
+
+// FailStaleJobRuns 将超过 timeout（加宽限时间）仍在执行中的记录标记为失败（执行实例中途退出，未能记录结果）
+func (uc *JobRunUsecase) FailStaleJobRuns(ctx context.Context, jobName string, timeout time.Duration) error {
+	startedBefore := time.Now().UTC().Add(-timeout - constants.CronJobStaleGrace)
+	count, err := uc.repo.FailStaleJobRuns(ctx, jobName, startedBefore, "interrupted: run did not finish within timeout")
+	if err != nil {
+		return err
+	}
+	if count > 0 {
+		uc.log.Warnf("Marked %d interrupted runs of job %s as failed", count, jobName)
+	}
+	return nil
+}
+
+// Checkpoint 获取任务某次计划的进度存储
+func (uc *JobRunUsecase) Checkpoint(jobName string, scheduledAt time.Time) Checkpoint {
+	return &jobCheckpoint{repo: uc.repo, jobName: jobName, scheduledAt: scheduledAt.UTC().Truncate(time.Second)}
+}
+
+// jobCheckpoint 保存在执行记录上的任务进度（JSON）
+type jobCheckpoint struct {
+	repo        JobRunRepo
+	jobName     string
+	scheduledAt time.Time
+}
+
+func (c *jobCheckpoint) Load(ctx context.Context, v interface{}) (bool, error) {
+	data, err := c.repo.GetJobCheckpoint(ctx, c.jobName, c.scheduledAt)
+	if err != nil || data == "" {
+		return false, err
+	}
+	if err := json.Unmarshal([]byte(data), v); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (c *jobCheckpoint) Save(ctx context.Context, v interface{}) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	return c.repo.SaveJobCheckpoint(ctx, c.jobName, c.scheduledAt, string(data))
+}
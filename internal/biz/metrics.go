@@ -0,0 +1,308 @@
+package biz
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"xinyuan_tech/subscription-service/internal/constants"
+	"xinyuan_tech/subscription-service/internal/errors"
+
+	pkgErrors "github.com/gaoyong06/go-pkg/errors"
+	"github.com/gaoyong06/go-pkg/middleware/app_id"
+)
+
+// MetricSnapshot 应用订阅指标日快照（snapshot_date 为 UTC 日期）
+// 活跃订阅数和 MRR 为生成快照时的订阅状态（每天凌晨生成前一天的快照），新增、流失和回流数按当天的订阅历史统计
+type MetricSnapshot struct {
+	AppID                  string
+	SnapshotDate           time.Time
+	ReportingCurrency      string
+	ActiveSubscribers      int     // 活跃订阅数（包含免费套餐和终身套餐，不包含暂停中的订阅）
+	PayingSubscribers      int     // 付费周期订阅数（计入 MRR 的订阅）
+	MRR                    float64 // 月度经常性收入（报表币种，不含税）
+	ARR                    float64 // 年度经常性收入 = MRR * 12
+	NewSubscribers         int     // 当天首次订阅的用户数
+	ChurnedSubscribers     int     // 当天过期或取消的用户数
+	ReactivatedSubscribers int     // 当天过期或取消后重新付费的用户数
+	StateUnavailable       bool    // 活跃订阅数、付费订阅数和 MRR/ARR 不可用（补生成更早日期时无法还原当天的订阅状态）
+	CreatedAt              time.Time
+	UpdatedAt              time.Time
+}
+
+// ActiveSubscriptionRevenue 活跃订阅及其周期收入（用于计算 MRR）
+type ActiveSubscriptionRevenue struct {
+	UID           string
+	PlanID        string
+	BillingType   string
+	IntervalUnit  string
+	IntervalCount int
+	DurationDays  int
+	Currency      string
+	Amount        float64 // 一个计费周期的不含税金额：当前生效订单的实付金额加回切换套餐抵扣、扣除税额；没有订单时为套餐默认价格
+	Source        string  // 当前周期的来源，赠送和手动开通的订阅不计入 MRR
+}
+
+// SubscriptionEventCounts 时间范围内的订阅变化用户数
+type SubscriptionEventCounts struct {
+	New         int
+	Churned     int
+	Reactivated int
+}
+
+// MetricRepo 订阅指标仓库接口
+type MetricRepo interface {
+	// ListMetricAppIDs 获取有订阅记录的应用
+	ListMetricAppIDs(ctx context.Context) ([]string, error)
+	// ListActiveSubscriptionRevenue 获取应用在 at 时刻活跃（未暂停、未过期）的订阅及其周期收入
+	ListActiveSubscriptionRevenue(ctx context.Context, appID string, at time.Time) ([]*ActiveSubscriptionRevenue, error)
+	// CountSubscriptionEvents 按订阅历史统计应用在 [from, to) 内的新增、流失和回流用户数
+	CountSubscriptionEvents(ctx context.Context, appID string, from, to time.Time) (*SubscriptionEventCounts, error)
+	// GetMetricSnapshot 获取应用某天的快照，不存在时返回 nil
+	GetMetricSnapshot(ctx context.Context, appID string, date time.Time) (*MetricSnapshot, error)
+	// SaveMetricSnapshot 保存快照（同一应用同一天已存在时覆盖）
+	SaveMetricSnapshot(ctx context.Context, snapshot *MetricSnapshot) error
+	// ListMetricSnapshots 获取应用在 [from, to] 日期内的快照，按日期升序
+	ListMetricSnapshots(ctx context.Context, appID string, from, to time.Time) ([]*MetricSnapshot, error)
+	// ListCohortMembers 获取在 [from, to) 内首次订阅应用的用户
+	ListCohortMembers(ctx context.Context, appID string, from, to time.Time) ([]*CohortMember, error)
+}
+
+// SubscriptionMetrics 时间范围内的订阅指标汇总
+type SubscriptionMetrics struct {
+	AppID                  string
+	StartDate              time.Time
+	EndDate                time.Time
+	ReportingCurrency      string
+	ActiveSubscribers      int // 结束日期的活跃订阅数
+	PayingSubscribers      int
+	MRR                    float64 // 结束日期的 MRR
+	ARR                    float64
+	StartActiveSubscribers int // 开始日期前一天的活跃订阅数
+	StartPayingSubscribers int // 开始日期前一天的付费订阅数（流失率和留存率的分母）
+	NewSubscribers         int
+	ChurnedSubscribers     int
+	ReactivatedSubscribers int
+	// 流失率和留存率按付费订阅计算：付费订阅过期后回落到免费套餐仍计入活跃订阅数，按活跃订阅数计算会低估流失
+	ChurnRate     float64 // 流失率 = 流失用户数 / 期初付费订阅数
+	RetentionRate float64 // 留存率 = (期末付费订阅数 - 新增 - 回流) / 期初付费订阅数
+	Snapshots     []*MetricSnapshot
+}
+
+// GenerateMetricSnapshots 生成 date 当天的指标快照，appID 为空时生成所有应用的快照
+// 新增、流失和回流数按订阅历史重新统计；活跃订阅数和 MRR 取当前订阅状态，
+// 补生成更早日期时保留已有快照的活跃订阅数和 MRR，没有快照时标记为不可用；dryRun 为 true 时只计算不保存
+func (uc *SubscriptionUsecase) GenerateMetricSnapshots(ctx context.Context, date time.Time, appID string, dryRun bool) (int, error) {
+	day := truncateDay(date)
+	now := time.Now().UTC()
+	if day.After(now) {
+		return 0, pkgErrors.NewBizErrorWithLang(ctx, errors.ErrCodeReportRangeInvalid)
+	}
+
+	appIDs := []string{appID}
+	if appID == "" {
+		var err error
+		if appIDs, err = uc.metricRepo.ListMetricAppIDs(ctx); err != nil {
+			uc.log.Errorf("Failed to list apps for metric snapshots: %v", err)
+			return 0, err
+		}
+	}
+
+	count := 0
+	for _, id := range appIDs {
+		if err := uc.generateMetricSnapshot(ctx, id, day, now, dryRun); err != nil {
+			uc.log.Errorf("Failed to generate metric snapshot of app %s on %s: %v", id, day.Format(constants.DateLayout), err)
+			continue
+		}
+		count++
+	}
+	uc.log.Infof("Generated %d/%d metric snapshots on %s", count, len(appIDs), day.Format(constants.DateLayout))
+	return count, nil
+}
+
+// generateMetricSnapshot 生成单个应用某天的指标快照
+func (uc *SubscriptionUsecase) generateMetricSnapshot(ctx context.Context, appID string, day, now time.Time, dryRun bool) error {
+	events, err := uc.metricRepo.CountSubscriptionEvents(ctx, appID, day, day.AddDate(0, 0, 1))
+	if err != nil {
+		return err
+	}
+	snapshot := &MetricSnapshot{
+		AppID:                  appID,
+		SnapshotDate:           day,
+		NewSubscribers:         events.New,
+		ChurnedSubscribers:     events.Churned,
+		ReactivatedSubscribers: events.Reactivated,
+		CreatedAt:              now,
+		UpdatedAt:              now,
+	}
+
+	existing, err := uc.metricRepo.GetMetricSnapshot(ctx, appID, day)
+	if err != nil {
+		return err
+	}
+	if existing != nil {
+		snapshot.CreatedAt = existing.CreatedAt
+	}
+	// 活跃订阅数和 MRR 只能取当前状态，补生成更早日期时保留已有的值，没有已有快照时标记为不可用（不使用当前状态代替）
+	switch {
+	case !day.Before(truncateDay(now).AddDate(0, 0, -1)):
+		if err := uc.measureRecurringRevenue(ctx, snapshot, now); err != nil {
+			return err
+		}
+	case existing != nil:
+		snapshot.ReportingCurrency = existing.ReportingCurrency
+		snapshot.ActiveSubscribers = existing.ActiveSubscribers
+		snapshot.PayingSubscribers = existing.PayingSubscribers
+		snapshot.MRR = existing.MRR
+		snapshot.ARR = existing.ARR
+		snapshot.StateUnavailable = existing.StateUnavailable
+	default:
+		snapshot.ReportingCurrency = uc.reportingCurrency(ctx, appID)
+		snapshot.StateUnavailable = true
+	}
+	if dryRun {
+		uc.log.Infof("[DRY RUN] Metric snapshot of app %s on %s: active=%d, mrr=%.2f %s, stateUnavailable=%v, new=%d, churned=%d, reactivated=%d",
+			appID, day.Format(constants.DateLayout), snapshot.ActiveSubscribers, snapshot.MRR, snapshot.ReportingCurrency, snapshot.StateUnavailable,
+			snapshot.NewSubscribers, snapshot.ChurnedSubscribers, snapshot.ReactivatedSubscribers)
+		return nil
+	}
+	return uc.metricRepo.SaveMetricSnapshot(ctx, snapshot)
+}
+
+// measureRecurringRevenue 按当前订阅状态计算活跃订阅数和 MRR
+// 周期订阅的周期收入按计费周期折算为月收入（赠送和手动开通的订阅计入活跃订阅数，不计入付费订阅数和 MRR），并按当前汇率折算为应用报表币种；没有可用汇率的订阅不计入 MRR
+func (uc *SubscriptionUsecase) measureRecurringRevenue(ctx context.Context, snapshot *MetricSnapshot, now time.Time) error {
+	subs, err := uc.metricRepo.ListActiveSubscriptionRevenue(ctx, snapshot.AppID, now)
+	if err != nil {
+		return err
+	}
+	snapshot.ReportingCurrency = uc.reportingCurrency(ctx, snapshot.AppID)
+	snapshot.ActiveSubscribers = len(subs)
+
+	rates := make(map[string]float64)
+	mrr := 0.0
+	for _, s := range subs {
+		if s.BillingType == constants.PlanBillingLifetime || s.Amount <= 0 || s.Source != constants.SourcePurchase {
+			continue
+		}
+		plan := &Plan{IntervalUnit: s.IntervalUnit, IntervalCount: s.IntervalCount, DurationDays: s.DurationDays}
+		interval := plan.BillingInterval()
+		if !interval.IsValid() {
+			continue
+		}
+		rate, ok := rates[s.Currency]
+		if !ok {
+			if rate, err = uc.convertRate(ctx, s.Currency, snapshot.ReportingCurrency, now); err != nil {
+				return err
+			}
+			rates[s.Currency] = rate
+			if rate == 0 {
+				uc.log.Warnf("No exchange rate %s->%s, subscriptions in %s are excluded from MRR of app %s", s.Currency, snapshot.ReportingCurrency, s.Currency, snapshot.AppID)
+			}
+		}
+		if rate == 0 {
+			continue
+		}
+		snapshot.PayingSubscribers++
+		mrr += s.Amount * rate / interval.Months()
+	}
+	snapshot.MRR = roundAmount(mrr)
+	snapshot.ARR = roundAmount(mrr * 12)
+	return nil
+}
+
+// ListMetricSnapshots 获取当前应用在 [startDate, endDate] 内的指标快照
+func (uc *SubscriptionUsecase) ListMetricSnapshots(ctx context.Context, startDate, endDate time.Time) ([]*MetricSnapshot, error) {
+	startDate, endDate = truncateDay(startDate), truncateDay(endDate)
+	if err := checkMetricRange(ctx, startDate, endDate); err != nil {
+		return nil, err
+	}
+	return uc.metricRepo.ListMetricSnapshots(ctx, app_id.GetAppIDFromContext(ctx), startDate, endDate)
+}
+
+// GetSubscriptionMetrics 汇总当前应用在 [startDate, endDate] 内的订阅指标
+// 期末活跃订阅数和 MRR 取范围内最后一个可用的快照；期初活跃订阅数取开始日期前一天的快照，没有时取范围内第一个可用的快照
+// 活跃订阅数不可用的快照（补生成的更早日期）只统计新增、流失和回流数
+func (uc *SubscriptionUsecase) GetSubscriptionMetrics(ctx context.Context, startDate, endDate time.Time) (*SubscriptionMetrics, error) {
+	startDate, endDate = truncateDay(startDate), truncateDay(endDate)
+	if err := checkMetricRange(ctx, startDate, endDate); err != nil {
+		return nil, err
+	}
+	appID := app_id.GetAppIDFromContext(ctx)
+	snapshots, err := uc.metricRepo.ListMetricSnapshots(ctx, appID, startDate, endDate)
+	if err != nil {
+		uc.log.Errorf("Failed to list metric snapshots of app %s: %v", appID, err)
+		return nil, err
+	}
+
+	metrics := &SubscriptionMetrics{
+		AppID:             appID,
+		StartDate:         startDate,
+		EndDate:           endDate,
+		ReportingCurrency: uc.reportingCurrency(ctx, appID),
+		Snapshots:         snapshots,
+	}
+	if len(snapshots) == 0 {
+		return metrics, nil
+	}
+	for _, s := range snapshots {
+		metrics.NewSubscribers += s.NewSubscribers
+		metrics.ChurnedSubscribers += s.ChurnedSubscribers
+		metrics.ReactivatedSubscribers += s.ReactivatedSubscribers
+	}
+	var first, last *MetricSnapshot
+	for _, s := range snapshots {
+		if s.StateUnavailable {
+			continue
+		}
+		if first == nil {
+			first = s
+		}
+		last = s
+	}
+	if last == nil {
+		return metrics, nil
+	}
+	metrics.ReportingCurrency = last.ReportingCurrency
+	metrics.ActiveSubscribers = last.ActiveSubscribers
+	metrics.PayingSubscribers = last.PayingSubscribers
+	metrics.MRR = last.MRR
+	metrics.ARR = last.ARR
+
+	previous, err := uc.metricRepo.GetMetricSnapshot(ctx, appID, startDate.AddDate(0, 0, -1))
+	if err != nil {
+		return nil, err
+	}
+	metrics.StartActiveSubscribers = first.ActiveSubscribers
+	metrics.StartPayingSubscribers = first.PayingSubscribers
+	if previous != nil && !previous.StateUnavailable {
+		metrics.StartActiveSubscribers = previous.ActiveSubscribers
+		metrics.StartPayingSubscribers = previous.PayingSubscribers
+	}
+	if metrics.StartPayingSubscribers > 0 {
+		start := float64(metrics.StartPayingSubscribers)
+		metrics.ChurnRate = roundRate(float64(metrics.ChurnedSubscribers) / start)
+		retained := float64(metrics.PayingSubscribers - metrics.NewSubscribers - metrics.ReactivatedSubscribers)
+		metrics.RetentionRate = roundRate(math.Min(math.Max(retained/start, 0), 1))
+	}
+	return metrics, nil
+}
+
+// checkMetricRange 校验指标查询的日期范围
+func checkMetricRange(ctx context.Context, startDate, endDate time.Time) error {
+	if endDate.Before(startDate) || endDate.Sub(startDate) > constants.MaxMetricRangeDays*24*time.Hour {
+		return pkgErrors.NewBizErrorWithLang(ctx, errors.ErrCodeReportRangeInvalid)
+	}
+	return nil
+}
+
+// truncateDay 截断为 UTC 日期
+func truncateDay(t time.Time) time.Time {
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// roundRate 比率保留 4 位小数
+func roundRate(rate float64) float64 {
+	return math.Round(rate*10000) / 10000
+}
@@ -0,0 +1,21 @@
+package biz
+
+import (
+	"context"
+	"time"
+)
+
+// Notification 订阅通知消息
+type Notification struct {
+	Type      string // 通知类型，如 price_change
+	AppID     string
+	UID       string // 用户ID（字符串 UUID）
+	Payload   map[string]interface{}
+	CreatedAt time.Time
+}
+
+// Notifier 通知发送接口（防腐层）
+// 只负责投递通知事件，实际的邮件/推送由 Notification Service 完成
+type Notifier interface {
+	Notify(ctx context.Context, n *Notification) error
+}
@@ -1,18 +1,35 @@
 package biz
 
-import "context"
+import (
+	"context"
+	"strings"
+	"time"
+
+	"xinyuan_tech/subscription-service/internal/constants"
+	"xinyuan_tech/subscription-service/internal/errors"
+
+	pkgErrors "github.com/gaoyong06/go-pkg/errors"
+)
 
 // Plan 订阅套餐
 type Plan struct {
-	PlanID       string
-	AppID        string // 应用ID（关联api-key-service的app表）
-	UID          string // 开发者ID（用户ID，关联api-key-service的app.uid）
-	Name         string
-	Description  string
-	Price        float64 // 默认价格（用于兜底，如果plan_pricing表中没有对应地域的价格）
-	Currency     string  // 默认币种（用于兜底）
-	DurationDays int
-	Type         string
+	PlanID        string
+	AppID         string // 应用ID（关联api-key-service的app表）
+	UID           string // 开发者ID（用户ID，关联api-key-service的app.uid）
+	Name          string
+	Description   string
+	Price         float64 // 默认价格（用于兜底，如果plan_pricing表中没有对应地域的价格）
+	Currency      string  // 默认币种（用于兜底）
+	DurationDays  int     // 持续天数（旧字段，interval_count 为 0 时按天数计费）
+	IntervalUnit  string  // 计费周期单位: day, week, month, year
+	IntervalCount int     // 计费周期数量（如 3 month 表示季付）
+	BillingType   string  // 计费类型: recurring-周期订阅, lifetime-终身（一次性买断）
+	Type          string
+}
+
+// IsLifetime 是否为终身套餐（购买后订阅永不过期）
+func (p *Plan) IsLifetime() bool {
+	return p.BillingType == constants.PlanBillingLifetime
 }
 
 // PlanPricing 套餐区域定价（所有价格都在数据库中配置）
@@ -23,6 +40,16 @@ type PlanPricing struct {
 	CountryCode   string // ISO 3166-1 alpha-2 国家代码（如CN, US, DE等）
 	Price         float64
 	Currency      string
+	EffectiveFrom time.Time // 生效开始时间（零值表示不限，包含）
+	EffectiveTo   time.Time // 生效结束时间（零值表示不限，不包含）
+}
+
+// IsEffectiveAt 定价在指定时间是否生效
+func (p *PlanPricing) IsEffectiveAt(t time.Time) bool {
+	if !p.EffectiveFrom.IsZero() && t.Before(p.EffectiveFrom) {
+		return false
+	}
+	return p.EffectiveTo.IsZero() || t.Before(p.EffectiveTo)
 }
 
 // PlanRepo 套餐仓库接口
@@ -32,7 +59,8 @@ type PlanRepo interface {
 	CreatePlan(ctx context.Context, plan *Plan) error
 	UpdatePlan(ctx context.Context, plan *Plan) error
 	DeletePlan(ctx context.Context, id string) error
-	GetPlanPricing(ctx context.Context, planID, countryCode string) (*PlanPricing, error)
+	// GetPlanPricing 获取指定时间生效的区域定价（多条生效时取 effective_from 最晚的一条）
+	GetPlanPricing(ctx context.Context, planID, countryCode string, at time.Time) (*PlanPricing, error)
 	ListPlanPricings(ctx context.Context, planID string) ([]*PlanPricing, error)
 	GetPlanPricingByID(ctx context.Context, planPricingID uint64) (*PlanPricing, error)
 	CreatePlanPricing(ctx context.Context, pricing *PlanPricing) error
@@ -47,14 +75,85 @@ func (uc *SubscriptionUsecase) ListPlans(ctx context.Context, appID string) ([]*
 
 // CreatePlan 创建套餐
 func (uc *SubscriptionUsecase) CreatePlan(ctx context.Context, plan *Plan) error {
+	if err := uc.normalizePlanInterval(ctx, plan); err != nil {
+		return err
+	}
 	return uc.planRepo.CreatePlan(ctx, plan)
 }
 
 // UpdatePlan 更新套餐
 func (uc *SubscriptionUsecase) UpdatePlan(ctx context.Context, plan *Plan) error {
+	existing, err := uc.planRepo.GetPlan(ctx, plan.PlanID)
+	if err != nil {
+		return err
+	}
+	if err := uc.mergePlanInterval(ctx, plan, existing); err != nil {
+		return err
+	}
 	return uc.planRepo.UpdatePlan(ctx, plan)
 }
 
+// mergePlanInterval 将请求中的计费周期与原套餐合并后校验，合并后的字段整体写入（包括零值）
+//   - 未修改计费周期：保持原值
+//   - 只修改 billing_type：沿用原来的计费周期（改为终身套餐时清空）
+//   - 只修改 interval_count：沿用原来的 interval_unit
+func (uc *SubscriptionUsecase) mergePlanInterval(ctx context.Context, plan, existing *Plan) error {
+	if plan.BillingType == "" && plan.IntervalUnit == "" && plan.IntervalCount <= 0 && plan.DurationDays <= 0 {
+		plan.BillingType = existing.BillingType
+		plan.IntervalUnit = existing.IntervalUnit
+		plan.IntervalCount = existing.IntervalCount
+		plan.DurationDays = existing.DurationDays
+		return nil
+	}
+	if plan.BillingType == "" {
+		plan.BillingType = existing.BillingType
+	}
+	if plan.IntervalUnit == "" {
+		switch {
+		case plan.IntervalCount > 0:
+			plan.IntervalUnit = existing.IntervalUnit
+		case plan.DurationDays <= 0:
+			plan.IntervalUnit = existing.IntervalUnit
+			plan.IntervalCount = existing.IntervalCount
+			plan.DurationDays = existing.DurationDays
+		}
+	}
+	return uc.normalizePlanInterval(ctx, plan)
+}
+
+// normalizePlanInterval 校验并规范化套餐的计费周期
+// 只传 duration_days 时按天计费；传了 interval_unit 时 interval_count 默认为 1，
+// 并同步 duration_days 为名义天数，兼容仍读取 duration_days 的调用方。
+// 终身套餐没有计费周期，相关字段清零
+func (uc *SubscriptionUsecase) normalizePlanInterval(ctx context.Context, plan *Plan) error {
+	if plan.IsLifetime() {
+		plan.IntervalUnit = ""
+		plan.IntervalCount = 0
+		plan.DurationDays = 0
+		return nil
+	}
+	if plan.BillingType == "" {
+		plan.BillingType = constants.PlanBillingRecurring
+	}
+
+	if plan.IntervalUnit == "" {
+		plan.IntervalUnit = constants.IntervalDay
+		if plan.IntervalCount <= 0 {
+			plan.IntervalCount = plan.DurationDays
+		}
+	} else if plan.IntervalCount <= 0 {
+		plan.IntervalCount = 1
+	}
+
+	interval := BillingInterval{Unit: plan.IntervalUnit, Count: plan.IntervalCount}
+	if !interval.IsValid() {
+		uc.log.Errorf("Invalid plan interval: unit=%s, count=%d, durationDays=%d", plan.IntervalUnit, plan.IntervalCount, plan.DurationDays)
+		return pkgErrors.NewBizErrorWithLang(ctx, errors.ErrCodePlanIntervalInvalid)
+	}
+	plan.DurationDays = interval.NominalDays()
+	return nil
+}
+
 // DeletePlan 删除套餐
 func (uc *SubscriptionUsecase) DeletePlan(ctx context.Context, id string) error {
 	return uc.planRepo.DeletePlan(ctx, id)
@@ -65,10 +164,18 @@ func (uc *SubscriptionUsecase) GetPlan(ctx context.Context, planID string) (*Pla
 	return uc.planRepo.GetPlan(ctx, planID)
 }
 
-// GetPlanPricing 获取套餐区域定价（根据国家代码）
-// 如果找不到对应国家代码的价格，返回plan表中的默认价格
-func (uc *SubscriptionUsecase) GetPlanPricing(ctx context.Context, planID, countryCode string) (*PlanPricing, error) {
-	pricing, err := uc.planRepo.GetPlanPricing(ctx, planID, countryCode)
+// GetPlanPricing 获取套餐在 at 时刻生效的区域定价（根据国家代码）
+// 解析顺序：国家代码 -> 国家所属地区组 -> plan表中的默认价格
+func (uc *SubscriptionUsecase) GetPlanPricing(ctx context.Context, planID, countryCode string, at time.Time) (*PlanPricing, error) {
+	pricing, err := uc.planRepo.GetPlanPricing(ctx, planID, countryCode, at)
+	if (err != nil || pricing == nil) && isCountryCode(countryCode) {
+		groupCode, gErr := uc.regionGroupRepo.GetGroupCodeByCountry(ctx, countryCode)
+		if gErr != nil {
+			uc.log.Warnf("Failed to get region group of country %s: %v", countryCode, gErr)
+		} else if groupCode != "" {
+			pricing, err = uc.planRepo.GetPlanPricing(ctx, planID, groupCode, at)
+		}
+	}
 	if err != nil || pricing == nil {
 		// 如果没有找到区域定价，返回默认价格
 		plan, err := uc.planRepo.GetPlan(ctx, planID)
@@ -97,11 +204,20 @@ func (uc *SubscriptionUsecase) GetPlanPricingByID(ctx context.Context, planPrici
 }
 
 // CreatePlanPricing 创建区域定价
+// 设置 effective_from 可以预先配置未来的调价，到期后自动按新价格报价和续费
+// country_code 可以是国家代码，也可以是已配置的地区组代码（组内国家共用该定价）
 func (uc *SubscriptionUsecase) CreatePlanPricing(ctx context.Context, pricing *PlanPricing) error {
+	pricing.CountryCode = strings.ToUpper(pricing.CountryCode)
+	if err := uc.validatePricingRegion(ctx, pricing.CountryCode); err != nil {
+		return err
+	}
+	if !pricing.EffectiveFrom.IsZero() && !pricing.EffectiveTo.IsZero() && !pricing.EffectiveTo.After(pricing.EffectiveFrom) {
+		return pkgErrors.NewBizErrorWithLang(ctx, errors.ErrCodePricingWindowInvalid)
+	}
 	return uc.planRepo.CreatePlanPricing(ctx, pricing)
 }
 
-// UpdatePlanPricing 更新区域定价
+// UpdatePlanPricing 更新区域定价（立即生效，计划调价请创建带 effective_from 的新定价）
 func (uc *SubscriptionUsecase) UpdatePlanPricing(ctx context.Context, planPricingID uint64, price float64, currency string) error {
 	return uc.planRepo.UpdatePlanPricing(ctx, planPricingID, price, currency)
 }
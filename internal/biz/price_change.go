@@ -0,0 +1,134 @@
+package biz
+
+import (
+	"context"
+	"time"
+
+	"xinyuan_tech/subscription-service/internal/constants"
+)
+
+// PriceChangeNotice 续费调价通知记录（用于保证同一次续费只通知一次）
+type PriceChangeNotice struct {
+	ID          uint64
+	UID         string // 用户ID（字符串 UUID）
+	AppID       string
+	PlanID      string
+	CountryCode string
+	OldPrice    float64
+	OldCurrency string
+	NewPrice    float64
+	NewCurrency string
+	RenewAt     time.Time // 续费的订阅周期结束时间（即当前订阅的结束时间，用于去重）
+	CreatedAt   time.Time
+}
+
+// PriceChangeNoticeRepo 调价通知记录仓库接口
+type PriceChangeNoticeRepo interface {
+	// ExistsNotice 同一用户、套餐、续费时间是否已经通知过
+	ExistsNotice(ctx context.Context, uid, planID string, renewAt time.Time) (bool, error)
+	CreateNotice(ctx context.Context, notice *PriceChangeNotice) error
+}
+
+// ProcessPriceChangeNotices 通知即将按新价格续费的自动续费用户
+// 对 daysBeforeRenewal 天内到期的自动续费订阅，比较当前生效价格与续费扣款时间（见 renewalChargeTime）生效的价格，
+// 价格或币种变化时发送通知；每个订阅的每次续费只通知一次
+// 已安排迁移套餐的订阅按新套餐的续费价格比较
+func (uc *SubscriptionUsecase) ProcessPriceChangeNotices(ctx context.Context, daysBeforeRenewal int, dryRun bool) (int, int, []*PriceChangeNotice, error) {
+	uc.log.Infof("Starting price change notice process (daysBeforeRenewal=%d, dryRun=%v)", daysBeforeRenewal, dryRun)
+
+	// 参数验证
+	if daysBeforeRenewal < 1 || daysBeforeRenewal > constants.MaxPriceChangeNoticeDays {
+		daysBeforeRenewal = constants.DefaultPriceChangeNoticeDays
+	}
+
+	subscriptions, err := uc.subRepo.GetAutoRenewSubscriptions(ctx, daysBeforeRenewal)
+	if err != nil {
+		uc.log.Errorf("Failed to get auto-renew subscriptions: %v", err)
+		return 0, 0, nil, err
+	}
+
+	now := time.Now().UTC()
+	notices := make([]*PriceChangeNotice, 0)
+	notifiedCount := 0
+
+	for _, sub := range subscriptions {
+		region := sub.CountryCode
+		if region == "" {
+			region = "default"
+		}
+
+		renewalPlanID := sub.RenewalPlanID()
+		current, err := uc.GetPlanPricing(ctx, sub.PlanID, region, now)
+		if err != nil {
+			uc.log.Warnf("Failed to get current pricing for user %s, plan %s: %v", sub.UID, sub.PlanID, err)
+			continue
+		}
+		chargeAt := uc.renewalChargeTime(sub)
+		next, err := uc.GetPlanPricing(ctx, renewalPlanID, region, chargeAt)
+		if err != nil {
+			uc.log.Warnf("Failed to get renewal pricing for user %s, plan %s: %v", sub.UID, renewalPlanID, err)
+			continue
+		}
+		if current.Price == next.Price && current.Currency == next.Currency {
+			continue
+		}
+
+		exists, err := uc.priceNoticeRepo.ExistsNotice(ctx, sub.UID, renewalPlanID, sub.EndTime)
+		if err != nil {
+			uc.log.Errorf("Failed to check price change notice for user %s: %v", sub.UID, err)
+			continue
+		}
+		if exists {
+			continue
+		}
+
+		notice := &PriceChangeNotice{
+			UID:         sub.UID,
+			AppID:       sub.AppID,
+			PlanID:      renewalPlanID,
+			CountryCode: region,
+			OldPrice:    current.Price,
+			OldCurrency: current.Currency,
+			NewPrice:    next.Price,
+			NewCurrency: next.Currency,
+			RenewAt:     sub.EndTime,
+			CreatedAt:   now,
+		}
+		notices = append(notices, notice)
+
+		if dryRun {
+			uc.log.Infof("[DRY RUN] Would notify user %s of price change on plan %s: %.2f %s -> %.2f %s",
+				sub.UID, renewalPlanID, current.Price, current.Currency, next.Price, next.Currency)
+			continue
+		}
+
+		// 先投递通知再记录：记录失败时下次任务会重复通知，但不会漏发
+		if err := uc.notifier.Notify(ctx, &Notification{
+			Type:  constants.NotificationTypePriceChange,
+			AppID: sub.AppID,
+			UID:   sub.UID,
+			Payload: map[string]interface{}{
+				"plan_id":      renewalPlanID,
+				"from_plan_id": sub.PlanID,
+				"country_code": region,
+				"old_price":    current.Price,
+				"old_currency": current.Currency,
+				"new_price":    next.Price,
+				"new_currency": next.Currency,
+				"renew_at":     sub.EndTime.Unix(),
+				"charge_at":    chargeAt.Unix(),
+			},
+			CreatedAt: now,
+		}); err != nil {
+			uc.log.Errorf("Failed to send price change notice to user %s: %v", sub.UID, err)
+			continue
+		}
+		if err := uc.priceNoticeRepo.CreateNotice(ctx, notice); err != nil {
+			uc.log.Errorf("Failed to record price change notice for user %s: %v", sub.UID, err)
+		}
+		notifiedCount++
+	}
+
+	uc.log.Infof("Price change notice process completed: total=%d, notified=%d", len(subscriptions), notifiedCount)
+	return len(subscriptions), notifiedCount, notices, nil
+}
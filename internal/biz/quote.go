@@ -0,0 +1,414 @@
+package biz
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"math"
+	"strings"
+	"time"
+
+	"xinyuan_tech/subscription-service/internal/constants"
+	"xinyuan_tech/subscription-service/internal/errors"
+
+	pkgErrors "github.com/gaoyong06/go-pkg/errors"
+	"github.com/gaoyong06/go-pkg/middleware/app_id"
+)
+
+// Quote 订阅报价（结算预览）
+// 实付金额 = 套餐价格 - 优惠 - 切换套餐抵扣 + 不含税规则加收的税额；下单时携带报价 token 即按报价金额扣款
+type Quote struct {
+	UID             string
+	AppID           string
+	PlanID          string
+	Region          string // 定价地区（国家代码、地区组代码或 default）
+	RegionSource    string // 地区来源：request, user_profile, geoip, passport_geoip, accept_language, x_language, default
+	PricingRegion   string // 实际命中的定价（国家或地区组代码，default 表示套餐默认价格）
+	Currency        string
+	Subtotal        float64    // 套餐价格
+	Discount        float64    // 优惠金额（营销服务暂未接入，当前为 0）
+	ProrationCredit float64    // 切换套餐时当前周期未使用部分的抵扣金额
+	CreditOrderID   string     // 被抵扣未使用部分的订单（用户订阅的当前生效订单）
+	VatID           string     // 买方 VAT ID（B2B 反向征税）
+	TaxLines        []*TaxLine // 税费明细
+	Tax             float64    // 税额
+	Total           float64    // 实付金额
+	PeriodStart     time.Time
+	PeriodEnd       time.Time // 终身套餐为零值
+	ExpiresAt       time.Time
+	Token           string // 签名后的报价 token（未配置签名密钥时为空）
+	// 报价时用户订阅的状态（签入 token，下单时订阅已变化则需要重新报价）
+	SubscriptionOrderID string
+	SubscriptionVersion int
+	SubscriptionEndTime time.Time
+}
+
+// quoteClaims 报价 token 中签名的内容
+type quoteClaims struct {
+	UID             string     `json:"uid"`
+	AppID           string     `json:"app_id"`
+	PlanID          string     `json:"plan_id"`
+	Region          string     `json:"region"`
+	RegionSource    string     `json:"region_source"`
+	PricingRegion   string     `json:"pricing_region"`
+	Currency        string     `json:"currency"`
+	Subtotal        float64    `json:"subtotal"`
+	Discount        float64    `json:"discount"`
+	ProrationCredit float64    `json:"proration_credit"`
+	VatID           string     `json:"vat_id,omitempty"`
+	TaxLines        []*TaxLine `json:"tax_lines,omitempty"`
+	Tax             float64    `json:"tax"`
+	Total           float64    `json:"total"`
+	ExpiresAt       int64      `json:"exp"`
+	SubOrderID      string     `json:"sub_order_id,omitempty"`
+	SubVersion      int        `json:"sub_version,omitempty"`
+	SubEndTime      int64      `json:"sub_end_time,omitempty"`
+}
+
+// QuoteSubscription 获取订阅报价
+// region 为空时自动推断；vatID 为买方 VAT ID（可选，用于 B2B 反向征税）
+// 返回的报价 token 在有效期内传给 CreateSubscriptionOrder 即按报价金额下单
+func (uc *SubscriptionUsecase) QuoteSubscription(ctx context.Context, uid, planID, region, vatID, clientIP, acceptLanguage, xLanguage string) (*Quote, error) {
+	uc.log.Infof("QuoteSubscription: uid=%s, planID=%s, region=%s", uid, planID, region)
+
+	vatID, err := normalizeVatID(ctx, vatID)
+	if err != nil {
+		return nil, err
+	}
+	plan, current, err := uc.checkPurchasable(ctx, uid, planID)
+	if err != nil {
+		return nil, err
+	}
+
+	region, source := uc.resolveRegion(ctx, uid, region, clientIP, acceptLanguage, xLanguage)
+	quote, err := uc.buildQuote(ctx, uid, plan, current, region, source, vatID, time.Now().UTC())
+	if err != nil {
+		return nil, err
+	}
+	if quote.Token, err = uc.signQuote(quote); err != nil {
+		uc.log.Errorf("Failed to sign quote: %v", err)
+		return nil, err
+	}
+	return quote, nil
+}
+
+// resolveRegion 确定定价地区及其来源
+// 请求中指定了 region 时直接使用（国家代码或已配置的地区组），否则按推断顺序推断
+func (uc *SubscriptionUsecase) resolveRegion(ctx context.Context, uid, region, clientIP, acceptLanguage, xLanguage string) (string, string) {
+	if region != "" {
+		region = uc.normalizeRegion(ctx, region)
+		if region == "default" {
+			return region, constants.RegionSourceDefault
+		}
+		return region, constants.RegionSourceRequest
+	}
+	if uc.regionDetectionSvc == nil {
+		// 如果没有配置地区推断服务，使用默认值
+		uc.log.Infof("Region detection service not configured, using default region")
+		return "default", constants.RegionSourceDefault
+	}
+	detected, source, err := uc.regionDetectionSvc.DetectRegion(ctx, uid, clientIP, acceptLanguage, xLanguage)
+	if err != nil {
+		uc.log.Warnf("Failed to detect region, using default: %v", err)
+		return "default", constants.RegionSourceDefault
+	}
+	uc.log.Infof("Auto-detected region: %s (source: %s)", detected, source)
+	return detected, source
+}
+
+// checkPurchasable 校验用户是否可以购买该套餐，返回套餐和用户当前订阅
+func (uc *SubscriptionUsecase) checkPurchasable(ctx context.Context, uid, planID string) (*Plan, *UserSubscription, error) {
+	// 获取 app_id（优先从 Context，由中间件从 Header 提取）
+	appID := contextAppID(ctx)
+	if appID == "" {
+		uc.log.Errorf("app_id is required, please provide X-App-Id header")
+		return nil, nil, pkgErrors.NewBizErrorWithLang(ctx, pkgErrors.ErrCodeInvalidArgument)
+	}
+
+	// 获取套餐信息（用于获取名称等信息，并验证 app_id 是否匹配）
+	plan, err := uc.planRepo.GetPlan(ctx, planID)
+	if err != nil || plan == nil {
+		uc.log.Errorf("Failed to get plan %s: %v", planID, err)
+		return nil, nil, pkgErrors.NewBizErrorWithLang(ctx, errors.ErrCodePlanNotFound)
+	}
+
+	// 验证 app_id 是否与 plan 的 app_id 匹配（数据一致性校验）
+	if plan.AppID != "" && plan.AppID != appID {
+		uc.log.Errorf("app_id mismatch: plan %s belongs to app %s, but request app_id is %s", planID, plan.AppID, appID)
+		return nil, nil, pkgErrors.NewBizErrorWithLang(ctx, pkgErrors.ErrCodeInvalidArgument)
+	}
+
+	// 终身订阅用户不能再购买周期套餐（避免降级），也无需重复购买同一终身套餐
+	current, err := uc.subRepo.GetSubscription(ctx, uid)
+	if err != nil {
+		uc.log.Errorf("Failed to get current subscription: %v", err)
+		return nil, nil, err
+	}
+	if uc.holdsLifetimePlan(ctx, current) {
+		if !plan.IsLifetime() || current.PlanID == plan.PlanID {
+			uc.log.Warnf("User %s already has lifetime subscription %s, reject purchase of plan %s", uid, current.PlanID, planID)
+			return nil, nil, pkgErrors.NewBizErrorWithLang(ctx, errors.ErrCodeLifetimeSubscription)
+		}
+	}
+	return plan, current, nil
+}
+
+// buildQuote 计算报价
+// 税费按定价地区的国家税务规则计算，地区组或 default 定价不计税
+func (uc *SubscriptionUsecase) buildQuote(ctx context.Context, uid string, plan *Plan, current *UserSubscription, region, source, vatID string, now time.Time) (*Quote, error) {
+	// 获取套餐区域定价：国家 -> 地区组 -> 套餐默认价格，按报价时刻（自动续费为续费扣款时间）解析生效的定价
+	pricing, err := uc.GetPlanPricing(ctx, plan.PlanID, region, contextPricingTime(ctx, now))
+	if err != nil || pricing == nil {
+		uc.log.Errorf("Failed to get plan pricing for %s in %s: %v", plan.PlanID, region, err)
+		return nil, pkgErrors.NewBizErrorWithLang(ctx, errors.ErrCodePlanNotFound)
+	}
+	pricingRegion := pricing.CountryCode
+	if pricing.PlanPricingID == 0 {
+		pricingRegion = "default"
+	}
+
+	quote := &Quote{
+		UID:           uid,
+		AppID:         contextAppID(ctx),
+		PlanID:        plan.PlanID,
+		Region:        region,
+		RegionSource:  source,
+		PricingRegion: pricingRegion,
+		Currency:      pricing.Currency,
+		Subtotal:      pricing.Price,
+		VatID:         vatID,
+		ExpiresAt:     now.Add(uc.quoteTTL()),
+	}
+	if current != nil {
+		quote.SubscriptionOrderID = current.OrderID
+		quote.SubscriptionVersion = current.Version
+		quote.SubscriptionEndTime = current.EndTime
+	}
+
+	if err := uc.applyProrationCredit(ctx, quote, current, plan, now); err != nil {
+		return nil, err
+	}
+	quote.PeriodStart, quote.PeriodEnd, _ = purchasePeriod(current, plan, quote.ProrationCredit > 0, now)
+
+	uc.log.Infof("Quote for user %s, plan %s: region=%s(%s), pricing=%s, subtotal=%.2f, credit=%.2f, tax=%.2f, total=%.2f %s",
+		uid, plan.PlanID, region, source, pricingRegion, quote.Subtotal, quote.ProrationCredit, quote.Tax, quote.Total, quote.Currency)
+	return quote, nil
+}
+
+// applyProrationCredit 计算切换套餐抵扣以及税费和实付金额
+// 抵扣不超过应付金额，超出部分不退还
+func (uc *SubscriptionUsecase) applyProrationCredit(ctx context.Context, quote *Quote, current *UserSubscription, plan *Plan, now time.Time) error {
+	quote.ProrationCredit = uc.prorationCredit(ctx, current, plan, quote.Currency, now)
+	if payable := roundAmount(quote.Subtotal - quote.Discount); quote.ProrationCredit > payable {
+		quote.ProrationCredit = payable
+	}
+	quote.CreditOrderID = ""
+	if quote.ProrationCredit > 0 {
+		quote.CreditOrderID = current.OrderID
+	}
+	var err error
+	quote.TaxLines, quote.Tax, quote.Total, err = uc.calculateTax(ctx, quote.Region, quote.VatID, roundAmount(quote.Subtotal-quote.Discount-quote.ProrationCredit))
+	return err
+}
+
+// prorationCredit 计算切换套餐时当前周期未使用部分的抵扣金额
+// 仅当用户有生效中的付费周期订阅、切换到其他套餐且币种相同时抵扣，按剩余时间比例折算当前订单实付金额（不含税）
+// 续费到已安排迁移的套餐时不抵扣，新套餐从当前周期结束时开始
+func (uc *SubscriptionUsecase) prorationCredit(ctx context.Context, sub *UserSubscription, plan *Plan, currency string, now time.Time) float64 {
+	if sub == nil || sub.PlanID == plan.PlanID || sub.NextPlanID == plan.PlanID || sub.Status != constants.StatusActive || sub.IsLifetime() || !sub.EndTime.After(now) || sub.OrderID == "" {
+		return 0
+	}
+	order, err := uc.orderRepo.GetOrder(ctx, sub.OrderID)
+	if err != nil || order == nil {
+		return 0
+	}
+	// 历史订单没有记录购买周期，或当前订单不是覆盖订阅末尾的订单时不抵扣
+	if order.PaymentStatus != constants.PaymentStatusSuccess || order.PeriodStart.IsZero() || !order.PeriodEnd.Equal(sub.EndTime) || order.Currency != currency {
+		return 0
+	}
+	paid := order.Amount - order.TaxAmount
+	period := order.PeriodEnd.Sub(order.PeriodStart)
+	if paid <= 0 || period <= 0 {
+		return 0
+	}
+	start := order.PeriodStart
+	if now.After(start) {
+		start = now
+	}
+	return roundAmount(paid * order.PeriodEnd.Sub(start).Seconds() / period.Seconds())
+}
+
+// signQuote 签发报价 token
+func (uc *SubscriptionUsecase) signQuote(q *Quote) (string, error) {
+	secret := uc.quoteSecret()
+	if secret == "" {
+		return "", nil
+	}
+	payload, err := json.Marshal(&quoteClaims{
+		UID:             q.UID,
+		AppID:           q.AppID,
+		PlanID:          q.PlanID,
+		Region:          q.Region,
+		RegionSource:    q.RegionSource,
+		PricingRegion:   q.PricingRegion,
+		Currency:        q.Currency,
+		Subtotal:        q.Subtotal,
+		Discount:        q.Discount,
+		ProrationCredit: q.ProrationCredit,
+		VatID:           q.VatID,
+		TaxLines:        q.TaxLines,
+		Tax:             q.Tax,
+		Total:           q.Total,
+		ExpiresAt:       q.ExpiresAt.Unix(),
+		SubOrderID:      q.SubscriptionOrderID,
+		SubVersion:      q.SubscriptionVersion,
+		SubEndTime:      unixOrZero(q.SubscriptionEndTime),
+	})
+	if err != nil {
+		return "", err
+	}
+	encoded := base64.RawURLEncoding.EncodeToString(payload)
+	return encoded + "." + signQuotePayload(secret, encoded), nil
+}
+
+// verifyQuote 校验报价 token（签名、有效期、用户、应用、套餐和 VAT ID），返回报价内容
+// 报价后用户订阅已变化（如支付了其他订单、续费或调整）时报价失效，避免同一抵扣被多个订单使用
+func (uc *SubscriptionUsecase) verifyQuote(ctx context.Context, token, uid, planID, vatID string, current *UserSubscription) (*Quote, error) {
+	secret := uc.quoteSecret()
+	parts := strings.Split(token, ".")
+	if secret == "" || len(parts) != 2 || !hmac.Equal([]byte(parts[1]), []byte(signQuotePayload(secret, parts[0]))) {
+		uc.log.Warnf("Invalid quote token for user %s", uid)
+		return nil, pkgErrors.NewBizErrorWithLang(ctx, errors.ErrCodeQuoteInvalid)
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(parts[0])
+	if err != nil {
+		return nil, pkgErrors.NewBizErrorWithLang(ctx, errors.ErrCodeQuoteInvalid)
+	}
+	var claims quoteClaims
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return nil, pkgErrors.NewBizErrorWithLang(ctx, errors.ErrCodeQuoteInvalid)
+	}
+	if claims.UID != uid || claims.PlanID != planID || claims.VatID != vatID || claims.AppID != contextAppID(ctx) {
+		uc.log.Warnf("Quote token does not match request: uid=%s, planID=%s", uid, planID)
+		return nil, pkgErrors.NewBizErrorWithLang(ctx, errors.ErrCodeQuoteInvalid)
+	}
+	expiresAt := time.Unix(claims.ExpiresAt, 0).UTC()
+	if time.Now().UTC().After(expiresAt) {
+		return nil, pkgErrors.NewBizErrorWithLang(ctx, errors.ErrCodeQuoteExpired)
+	}
+	var subOrderID string
+	var subVersion int
+	var subEndTime int64
+	if current != nil {
+		subOrderID, subVersion, subEndTime = current.OrderID, current.Version, unixOrZero(current.EndTime)
+	}
+	if claims.SubOrderID != subOrderID || claims.SubVersion != subVersion || claims.SubEndTime != subEndTime {
+		uc.log.Warnf("Subscription of user %s changed after quote, quote expired", uid)
+		return nil, pkgErrors.NewBizErrorWithLang(ctx, errors.ErrCodeQuoteExpired)
+	}
+	quote := &Quote{
+		UID:                 claims.UID,
+		AppID:               claims.AppID,
+		PlanID:              claims.PlanID,
+		Region:              claims.Region,
+		RegionSource:        claims.RegionSource,
+		PricingRegion:       claims.PricingRegion,
+		Currency:            claims.Currency,
+		Subtotal:            claims.Subtotal,
+		Discount:            claims.Discount,
+		ProrationCredit:     claims.ProrationCredit,
+		VatID:               claims.VatID,
+		TaxLines:            claims.TaxLines,
+		Tax:                 claims.Tax,
+		Total:               claims.Total,
+		ExpiresAt:           expiresAt,
+		Token:               token,
+		SubscriptionOrderID: claims.SubOrderID,
+		SubscriptionVersion: claims.SubVersion,
+		SubscriptionEndTime: timeFromUnix(claims.SubEndTime),
+	}
+	// 抵扣的是报价时绑定的当前生效订单
+	if quote.ProrationCredit > 0 {
+		quote.CreditOrderID = claims.SubOrderID
+	}
+	return quote, nil
+}
+
+// quoteSecret 报价 token 签名密钥
+func (uc *SubscriptionUsecase) quoteSecret() string {
+	if uc.config != nil && uc.config.GetSubscription() != nil {
+		return uc.config.GetSubscription().GetQuoteSecret()
+	}
+	return ""
+}
+
+// quoteTTL 报价有效期
+func (uc *SubscriptionUsecase) quoteTTL() time.Duration {
+	if uc.config != nil && uc.config.GetSubscription() != nil && uc.config.GetSubscription().GetQuoteTtl() != nil {
+		if ttl := uc.config.GetSubscription().GetQuoteTtl().AsDuration(); ttl > 0 {
+			return ttl
+		}
+	}
+	return constants.DefaultQuoteTTL
+}
+
+// appIDContextKey 后台任务代为指定的应用ID（定时任务没有请求头）
+type appIDContextKey struct{}
+
+// pricingTimeContextKey 后台任务代为指定的定价时间
+type pricingTimeContextKey struct{}
+
+// withPricingTime 指定解析定价的时间（自动续费按续费扣款时间定价，与调价通知一致，不受任务实际执行时间影响）
+func withPricingTime(ctx context.Context, t time.Time) context.Context {
+	return context.WithValue(ctx, pricingTimeContextKey{}, t)
+}
+
+// contextPricingTime 获取解析定价的时间：后台任务指定的时间优先，否则为报价时刻
+func contextPricingTime(ctx context.Context, now time.Time) time.Time {
+	if t, ok := ctx.Value(pricingTimeContextKey{}).(time.Time); ok && !t.IsZero() {
+		return t
+	}
+	return now
+}
+
+// withAppID 为后台任务指定应用ID（如自动续费按订阅所属应用下单）
+func withAppID(ctx context.Context, appID string) context.Context {
+	return context.WithValue(ctx, appIDContextKey{}, appID)
+}
+
+// contextAppID 获取下单的应用ID：后台任务指定的应用ID优先，否则从 Context 获取（由中间件从 Header 提取）
+func contextAppID(ctx context.Context) string {
+	if appID, ok := ctx.Value(appIDContextKey{}).(string); ok && appID != "" {
+		return appID
+	}
+	return app_id.GetAppIDFromContext(ctx)
+}
+
+func signQuotePayload(secret, payload string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(payload))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// roundAmount 金额保留两位小数
+func roundAmount(amount float64) float64 {
+	return math.Round(amount*100) / 100
+}
+
+// unixOrZero 转换为 Unix 时间戳，零值时间返回 0
+func unixOrZero(t time.Time) int64 {
+	if t.IsZero() {
+		return 0
+	}
+	return t.Unix()
+}
+
+// timeFromUnix 转换 Unix 时间戳，0 返回零值时间
+func timeFromUnix(ts int64) time.Time {
+	if ts == 0 {
+		return time.Time{}
+	}
+	return time.Unix(ts, 0).UTC()
+}
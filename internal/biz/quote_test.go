@@ -0,0 +1,95 @@
+package biz
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"xinyuan_tech/subscription-service/internal/constants"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// fakeOrderRepo 按订单ID返回订单
+type fakeOrderRepo struct {
+	SubscriptionOrderRepo
+	orders map[string]*SubscriptionOrder
+}
+
+func (r *fakeOrderRepo) GetOrder(_ context.Context, orderID string) (*SubscriptionOrder, error) {
+	return r.orders[orderID], nil
+}
+
+func TestProrationCredit(t *testing.T) {
+	periodStart := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	periodEnd := periodStart.AddDate(0, 0, 30)
+	// 当前订单实付 36（含税 6），不含税部分每天 1
+	paidOrder := func(modify func(o *SubscriptionOrder)) *SubscriptionOrder {
+		o := &SubscriptionOrder{
+			OrderID:       "order-1",
+			PlanID:        "basic",
+			Currency:      "USD",
+			Amount:        36,
+			TaxAmount:     6,
+			PaymentStatus: constants.PaymentStatusSuccess,
+			PeriodStart:   periodStart,
+			PeriodEnd:     periodEnd,
+		}
+		if modify != nil {
+			modify(o)
+		}
+		return o
+	}
+	activeSub := func(modify func(s *UserSubscription)) *UserSubscription {
+		s := &UserSubscription{
+			UID:       "user-1",
+			PlanID:    "basic",
+			StartTime: periodStart,
+			EndTime:   periodEnd,
+			Status:    constants.StatusActive,
+			OrderID:   "order-1",
+		}
+		if modify != nil {
+			modify(s)
+		}
+		return s
+	}
+	pro := &Plan{PlanID: "pro"}
+	midPeriod := periodStart.AddDate(0, 0, 15)
+
+	tests := []struct {
+		name     string
+		sub      *UserSubscription
+		order    *SubscriptionOrder
+		plan     *Plan
+		currency string
+		now      time.Time
+		want     float64
+	}{
+		{"按剩余时间比例折算不含税金额", activeSub(nil), paidOrder(nil), pro, "USD", midPeriod, 15},
+		{"周期开始前按整个周期折算", activeSub(nil), paidOrder(nil), pro, "USD", periodStart.Add(-time.Hour), 30},
+		{"剩余不足一天", activeSub(nil), paidOrder(nil), pro, "USD", periodEnd.Add(-12 * time.Hour), 0.5},
+		{"没有订阅", nil, paidOrder(nil), pro, "USD", midPeriod, 0},
+		{"续费同一套餐", activeSub(nil), paidOrder(nil), &Plan{PlanID: "basic"}, "USD", midPeriod, 0},
+		{"续费到已安排迁移的套餐", activeSub(func(s *UserSubscription) { s.NextPlanID = "pro" }), paidOrder(nil), pro, "USD", midPeriod, 0},
+		{"订阅已暂停", activeSub(func(s *UserSubscription) { s.Status = constants.StatusPaused }), paidOrder(nil), pro, "USD", midPeriod, 0},
+		{"订阅已过期", activeSub(nil), paidOrder(nil), pro, "USD", periodEnd.Add(time.Hour), 0},
+		{"终身订阅", activeSub(func(s *UserSubscription) { s.EndTime = time.Time{} }), paidOrder(nil), pro, "USD", midPeriod, 0},
+		{"币种不同", activeSub(nil), paidOrder(nil), pro, "EUR", midPeriod, 0},
+		{"订单已退款", activeSub(nil), paidOrder(func(o *SubscriptionOrder) { o.PaymentStatus = constants.PaymentStatusRefunded }), pro, "USD", midPeriod, 0},
+		{"历史订单没有购买周期", activeSub(nil), paidOrder(func(o *SubscriptionOrder) { o.PeriodStart = time.Time{} }), pro, "USD", midPeriod, 0},
+		{"订单不覆盖订阅末尾", activeSub(func(s *UserSubscription) { s.EndTime = periodEnd.AddDate(0, 0, 7) }), paidOrder(nil), pro, "USD", midPeriod, 0},
+		{"零金额订单", activeSub(nil), paidOrder(func(o *SubscriptionOrder) { o.Amount, o.TaxAmount = 0, 0 }), pro, "USD", midPeriod, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc := &SubscriptionUsecase{
+				orderRepo: &fakeOrderRepo{orders: map[string]*SubscriptionOrder{tt.order.OrderID: tt.order}},
+				log:       log.NewHelper(log.DefaultLogger),
+			}
+			if got := uc.prorationCredit(context.Background(), tt.sub, tt.plan, tt.currency, tt.now); got != tt.want {
+				t.Errorf("prorationCredit() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -4,6 +4,10 @@ import (
 	"context"
 	"strings"
 
+	"xinyuan_tech/subscription-service/internal/conf"
+	"xinyuan_tech/subscription-service/internal/constants"
+
+	"github.com/gaoyong06/go-pkg/middleware/app_id"
 	"github.com/gaoyong06/go-pkg/utils"
 	"github.com/go-kratos/kratos/v2/log"
 )
@@ -12,78 +16,129 @@ import (
 // 根据多维度信息推断用户所在国家代码（ISO 3166-1 alpha-2）
 type RegionDetectionService interface {
 	// DetectRegion 推断用户所在地区
-	// 优先级：
-	// 1. 用户注册信息（从 passport-service 查询，如果用户已注册）
-	// 2. GeoIP 数据库（根据 IP 地址，如果配置了 GeoIP）
-	// 3. HTTP 头 Accept-Language（从语言推断）
-	// 4. HTTP 头 X-Language（从语言推断）
-	// 5. 默认值 "default"
-	DetectRegion(ctx context.Context, uid string, clientIP, acceptLanguage, xLanguage string) (string, error)
+	// 按应用配置的推断顺序依次尝试（未配置时使用全局配置，默认顺序）：
+	// 1. user_profile: 用户注册信息（从 passport-service 查询，如果用户已注册）
+	// 2. geoip: 本地离线 GeoIP 数据库（根据 IP 地址）
+	// 3. passport_geoip: passport-service 的 GeoIP 接口（根据 IP 地址）
+	// 4. accept_language: HTTP 头 Accept-Language（从语言推断）
+	// 5. x_language: HTTP 头 X-Language（从语言推断）
+	// 都无法推断时返回 "default"，source 为生效的推断来源（无法推断时为 "default"）
+	DetectRegion(ctx context.Context, uid string, clientIP, acceptLanguage, xLanguage string) (region, source string, err error)
+}
+
+// GeoIPResolver 离线 GeoIP 解析接口
+type GeoIPResolver interface {
+	// LookupCountry 根据 IP 地址查询国家代码（ISO 3166-1 alpha-2），未启用或查不到时返回空字符串
+	LookupCountry(ctx context.Context, ip string) (string, error)
 }
 
 // regionDetectionService 地区推断服务实现
 type regionDetectionService struct {
 	log            *log.Helper
 	passportClient PassportClient // passport-service 客户端，用于查询用户信息和 GeoIP
+	geoIP          GeoIPResolver  // 本地离线 GeoIP 解析
+	appSettingRepo AppSettingRepo // 应用配置（按应用定制推断顺序）
+	defaultChain   []string       // 全局默认推断顺序
 }
 
 // NewRegionDetectionService 创建地区推断服务
-func NewRegionDetectionService(passportClient PassportClient, logger log.Logger) RegionDetectionService {
+func NewRegionDetectionService(passportClient PassportClient, geoIP GeoIPResolver, appSettingRepo AppSettingRepo, config *conf.Bootstrap, logger log.Logger) RegionDetectionService {
+	chain := constants.DefaultRegionDetection
+	if config != nil && config.GetSubscription() != nil && len(config.GetSubscription().GetRegionDetection()) > 0 {
+		chain = config.GetSubscription().GetRegionDetection()
+	}
 	return &regionDetectionService{
 		log:            log.NewHelper(logger),
 		passportClient: passportClient,
+		geoIP:          geoIP,
+		appSettingRepo: appSettingRepo,
+		defaultChain:   chain,
 	}
 }
 
 // DetectRegion 推断用户所在地区
-func (s *regionDetectionService) DetectRegion(ctx context.Context, uid string, clientIP, acceptLanguage, xLanguage string) (string, error) {
-	// 优先级 1: 从 passport-service 查询用户注册信息中的国家代码（如果用户已注册）
-	if uid != "" && s.passportClient != nil {
-		countryCode, err := s.passportClient.GetUserCountryCode(ctx, uid)
-		if err == nil && countryCode != "" {
-			s.log.WithContext(ctx).Infof("Detected region from user profile: %s (uid: %s)", countryCode, uid)
-			return countryCode, nil
+func (s *regionDetectionService) DetectRegion(ctx context.Context, uid string, clientIP, acceptLanguage, xLanguage string) (string, string, error) {
+	for _, source := range s.detectionChain(ctx) {
+		countryCode := s.detectBy(ctx, source, uid, clientIP, acceptLanguage, xLanguage)
+		if countryCode != "" {
+			s.log.WithContext(ctx).Infof("Detected region from %s: %s (uid: %s, IP: %s)", source, countryCode, uid, clientIP)
+			return strings.ToUpper(countryCode), source, nil
+		}
+	}
+
+	s.log.WithContext(ctx).Infof("Using default region: default")
+	return "default", constants.RegionSourceDefault, nil
+}
+
+// detectionChain 获取当前应用的地区推断顺序
+func (s *regionDetectionService) detectionChain(ctx context.Context) []string {
+	appID := app_id.GetAppIDFromContext(ctx)
+	if appID == "" || s.appSettingRepo == nil {
+		return s.defaultChain
+	}
+	setting, err := s.appSettingRepo.GetAppSetting(ctx, appID)
+	if err != nil {
+		s.log.WithContext(ctx).Warnf("Failed to get app setting for %s, using default region detection: %v", appID, err)
+		return s.defaultChain
+	}
+	if setting == nil || len(setting.RegionDetection) == 0 {
+		return s.defaultChain
+	}
+	return setting.RegionDetection
+}
+
+// detectBy 使用指定来源推断国家代码，无法推断时返回空字符串
+func (s *regionDetectionService) detectBy(ctx context.Context, source, uid, clientIP, acceptLanguage, xLanguage string) string {
+	switch source {
+	case constants.RegionSourceUserProfile:
+		if uid == "" || s.passportClient == nil {
+			return ""
 		}
+		countryCode, err := s.passportClient.GetUserCountryCode(ctx, uid)
 		if err != nil {
 			s.log.WithContext(ctx).Warnf("Failed to get user country code from passport service: %v", err)
+			return ""
 		}
-	}
-
-	// 优先级 2: 从 GeoIP 数据库查询（根据 IP 地址）
-	// 如果 passport-service 提供了 GeoIP API，则调用该 API
-	if clientIP != "" && s.passportClient != nil && utils.IsValidPublicIP(clientIP) {
-		countryCode, err := s.passportClient.GetCountryCodeByIP(ctx, clientIP)
-		if err == nil && countryCode != "" {
-			s.log.WithContext(ctx).Infof("Detected region from GeoIP: %s (IP: %s)", countryCode, clientIP)
-			return countryCode, nil
+		return countryCode
+	case constants.RegionSourceGeoIP:
+		if clientIP == "" || s.geoIP == nil || !utils.IsValidPublicIP(clientIP) {
+			return ""
 		}
+		countryCode, err := s.geoIP.LookupCountry(ctx, clientIP)
 		if err != nil {
-			s.log.WithContext(ctx).Warnf("Failed to get country code from IP: %v", err)
+			s.log.WithContext(ctx).Warnf("Failed to lookup country from GeoIP database: %v", err)
+			return ""
+		}
+		return countryCode
+	case constants.RegionSourcePassportGeoIP:
+		if clientIP == "" || s.passportClient == nil || !utils.IsValidPublicIP(clientIP) {
+			return ""
 		}
 		// 如果返回空字符串，可能是 API 未实现，继续使用其他方式推断
-	}
-
-	// 优先级 3: 从 Accept-Language 头推断
-	if acceptLanguage != "" {
-		countryCode := s.extractCountryFromLanguage(acceptLanguage)
-		if countryCode != "" {
-			s.log.WithContext(ctx).Infof("Detected region from Accept-Language: %s", countryCode)
-			return countryCode, nil
+		countryCode, err := s.passportClient.GetCountryCodeByIP(ctx, clientIP)
+		if err != nil {
+			s.log.WithContext(ctx).Warnf("Failed to get country code from IP: %v", err)
+			return ""
 		}
+		return countryCode
+	case constants.RegionSourceAcceptLanguage:
+		return s.extractCountryFromLanguage(acceptLanguage)
+	case constants.RegionSourceXLanguage:
+		return s.extractCountryFromLanguage(xLanguage)
+	default:
+		s.log.WithContext(ctx).Warnf("Unknown region detection source: %s", source)
+		return ""
 	}
+}
 
-	// 优先级 4: 从 X-Language 头推断
-	if xLanguage != "" {
-		countryCode := s.extractCountryFromLanguage(xLanguage)
-		if countryCode != "" {
-			s.log.WithContext(ctx).Infof("Detected region from X-Language: %s", countryCode)
-			return countryCode, nil
+// isValidRegionSource 是否为支持的地区推断来源
+func isValidRegionSource(source string) bool {
+	for _, s := range constants.DefaultRegionDetection {
+		if s == source {
+			return true
 		}
 	}
-
-	// 优先级 5: 默认值
-	s.log.WithContext(ctx).Infof("Using default region: default")
-	return "default", nil
+	return false
 }
 
 // extractCountryFromLanguage 从语言字符串中提取国家代码
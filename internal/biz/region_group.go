@@ -0,0 +1,165 @@
+package biz
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"xinyuan_tech/subscription-service/internal/errors"
+
+	pkgErrors "github.com/gaoyong06/go-pkg/errors"
+)
+
+// RegionGroup 地区组（如 EU、LATAM、SEA），组内国家共用一套区域定价
+type RegionGroup struct {
+	GroupCode   string // 地区组代码（2-10 位大写字母，如 EU、LATAM）
+	Name        string
+	Description string
+	Countries   []string // 成员国家代码（ISO 3166-1 alpha-2），每个国家只能属于一个地区组
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// RegionGroupRepo 地区组仓库接口
+type RegionGroupRepo interface {
+	ListRegionGroups(ctx context.Context) ([]*RegionGroup, error)
+	// GetRegionGroup 获取地区组，不存在时返回 nil
+	GetRegionGroup(ctx context.Context, groupCode string) (*RegionGroup, error)
+	// GetGroupCodeByCountry 获取国家所属的地区组代码，不属于任何地区组时返回空字符串
+	GetGroupCodeByCountry(ctx context.Context, countryCode string) (string, error)
+	// GetGroupCodesByCountries 批量获取国家所属的地区组代码（国家代码 -> 地区组代码）
+	GetGroupCodesByCountries(ctx context.Context, countryCodes []string) (map[string]string, error)
+	// SaveRegionGroup 保存地区组（不存在则创建），并整体替换成员国家
+	SaveRegionGroup(ctx context.Context, group *RegionGroup) error
+	DeleteRegionGroup(ctx context.Context, groupCode string) error
+}
+
+// ListRegionGroups 获取所有地区组
+func (uc *SubscriptionUsecase) ListRegionGroups(ctx context.Context) ([]*RegionGroup, error) {
+	return uc.regionGroupRepo.ListRegionGroups(ctx)
+}
+
+// GetRegionGroup 获取地区组
+func (uc *SubscriptionUsecase) GetRegionGroup(ctx context.Context, groupCode string) (*RegionGroup, error) {
+	group, err := uc.regionGroupRepo.GetRegionGroup(ctx, strings.ToUpper(groupCode))
+	if err != nil {
+		return nil, err
+	}
+	if group == nil {
+		return nil, pkgErrors.NewBizErrorWithLang(ctx, errors.ErrCodeRegionGroupNotFound)
+	}
+	return group, nil
+}
+
+// SaveRegionGroup 创建或更新地区组
+// 地区组代码不能与成员国家代码相同，每个国家只能属于一个地区组
+func (uc *SubscriptionUsecase) SaveRegionGroup(ctx context.Context, group *RegionGroup) error {
+	uc.log.Infof("SaveRegionGroup: groupCode=%s, countries=%v", group.GroupCode, group.Countries)
+
+	group.GroupCode = strings.ToUpper(strings.TrimSpace(group.GroupCode))
+	if !isRegionGroupCode(group.GroupCode) {
+		return pkgErrors.NewBizErrorWithLang(ctx, errors.ErrCodeRegionCodeInvalid)
+	}
+
+	seen := make(map[string]bool, len(group.Countries))
+	countries := make([]string, 0, len(group.Countries))
+	for _, c := range group.Countries {
+		c = strings.ToUpper(strings.TrimSpace(c))
+		if !isCountryCode(c) || c == group.GroupCode {
+			return pkgErrors.NewBizErrorWithLang(ctx, errors.ErrCodeRegionCodeInvalid)
+		}
+		if !seen[c] {
+			seen[c] = true
+			countries = append(countries, c)
+		}
+	}
+	group.Countries = countries
+
+	owners, err := uc.regionGroupRepo.GetGroupCodesByCountries(ctx, countries)
+	if err != nil {
+		return err
+	}
+	for country, owner := range owners {
+		if owner != group.GroupCode {
+			uc.log.Warnf("Country %s already belongs to region group %s", country, owner)
+			return pkgErrors.NewBizErrorWithLang(ctx, errors.ErrCodeRegionCountryConflict)
+		}
+	}
+
+	existing, err := uc.regionGroupRepo.GetRegionGroup(ctx, group.GroupCode)
+	if err != nil {
+		return err
+	}
+	now := time.Now().UTC()
+	group.CreatedAt = now
+	if existing != nil {
+		group.CreatedAt = existing.CreatedAt
+	}
+	group.UpdatedAt = now
+	return uc.regionGroupRepo.SaveRegionGroup(ctx, group)
+}
+
+// DeleteRegionGroup 删除地区组（该组的区域定价不再被成员国家命中）
+func (uc *SubscriptionUsecase) DeleteRegionGroup(ctx context.Context, groupCode string) error {
+	groupCode = strings.ToUpper(groupCode)
+	group, err := uc.regionGroupRepo.GetRegionGroup(ctx, groupCode)
+	if err != nil {
+		return err
+	}
+	if group == nil {
+		return pkgErrors.NewBizErrorWithLang(ctx, errors.ErrCodeRegionGroupNotFound)
+	}
+	return uc.regionGroupRepo.DeleteRegionGroup(ctx, groupCode)
+}
+
+// normalizeRegion 规范化下单时指定的地区代码
+// 国家代码（ISO 3166-1 alpha-2）和已配置的地区组代码直接使用，其他值回落到 default
+func (uc *SubscriptionUsecase) normalizeRegion(ctx context.Context, region string) string {
+	region = strings.ToUpper(strings.TrimSpace(region))
+	if region == "" || region == "DEFAULT" {
+		return "default"
+	}
+	if isCountryCode(region) {
+		return region
+	}
+	group, err := uc.regionGroupRepo.GetRegionGroup(ctx, region)
+	if err != nil || group == nil {
+		uc.log.Warnf("Unsupported region: %s, using default", region)
+		return "default"
+	}
+	return region
+}
+
+// validatePricingRegion 校验区域定价的地区代码：国家代码或已配置的地区组代码
+func (uc *SubscriptionUsecase) validatePricingRegion(ctx context.Context, code string) error {
+	if isCountryCode(code) {
+		return nil
+	}
+	group, err := uc.regionGroupRepo.GetRegionGroup(ctx, code)
+	if err != nil {
+		return err
+	}
+	if group == nil {
+		return pkgErrors.NewBizErrorWithLang(ctx, errors.ErrCodeRegionCodeInvalid)
+	}
+	return nil
+}
+
+// isCountryCode 是否为 ISO 3166-1 alpha-2 格式的国家代码（两位大写字母）
+func isCountryCode(code string) bool {
+	return len(code) == 2 && isUpperLetters(code)
+}
+
+// isRegionGroupCode 是否为合法的地区组代码（2-10 位大写字母）
+func isRegionGroupCode(code string) bool {
+	return len(code) >= 2 && len(code) <= 10 && isUpperLetters(code)
+}
+
+func isUpperLetters(s string) bool {
+	for _, r := range s {
+		if r < 'A' || r > 'Z' {
+			return false
+		}
+	}
+	return true
+}
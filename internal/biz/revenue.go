@@ -0,0 +1,209 @@
+package biz
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"xinyuan_tech/subscription-service/internal/errors"
+
+	pkgErrors "github.com/gaoyong06/go-pkg/errors"
+	"github.com/gaoyong06/go-pkg/middleware/app_id"
+)
+
+// RevenueSummary 按订单币种和支付时报表币种汇总的订单收入（由订单仓库统计）
+type RevenueSummary struct {
+	Currency          string
+	ReportingCurrency string // 支付时记录的报表币种（支付时没有可用汇率的订单为空）
+	OrderCount        int
+	Amount            float64 // 实收金额（含税）
+	RefundedAmount    float64 // 退款金额
+	TaxAmount         float64 // 税额（已扣除退款冲减部分）
+	// 按支付时汇率折算为 ReportingCurrency 的金额（ReportingCurrency 为空时为 0）
+	ConvertedAmount   float64
+	ConvertedRefunded float64
+	ConvertedTax      float64
+}
+
+// CurrencyRevenue 收入报表中单个币种的收入
+type CurrencyRevenue struct {
+	Currency       string
+	OrderCount     int
+	GrossAmount    float64 // 订单币种金额
+	RefundedAmount float64
+	TaxAmount      float64
+	NetAmount      float64
+	ConvertedNet   float64 // 折算为报表币种的净收入
+	Estimated      bool    // 是否有订单按报表截止时间的汇率估算（支付时没有记录汇率或报表币种已变更）
+}
+
+// RevenueReport 收入报表（金额均已折算为报表币种，净收入 = 实收 - 退款 - 税额）
+type RevenueReport struct {
+	AppID             string
+	ReportingCurrency string
+	StartTime         time.Time
+	EndTime           time.Time
+	OrderCount        int
+	GrossAmount       float64
+	RefundedAmount    float64
+	TaxAmount         float64
+	NetAmount         float64
+	ByCurrency        []*CurrencyRevenue
+	Unconverted       []string // 没有可用汇率、未计入合计的币种
+}
+
+// recordPaymentRate 支付成功时记录支付时间以及订单币种到应用报表币种的汇率
+// 没有可用汇率时汇率记为 0，报表按报表截止时间的汇率估算，不影响支付流程
+func (uc *SubscriptionUsecase) recordPaymentRate(ctx context.Context, order *SubscriptionOrder, now time.Time) {
+	order.PaidAt = now
+	order.ReportingCurrency = uc.reportingCurrency(ctx, order.AppID)
+	if order.Currency == "" {
+		return
+	}
+	rate, err := uc.convertRate(ctx, order.Currency, order.ReportingCurrency, now)
+	if err != nil {
+		uc.log.Warnf("Failed to get exchange rate %s->%s for order %s: %v", order.Currency, order.ReportingCurrency, order.OrderID, err)
+		return
+	}
+	if rate == 0 {
+		uc.log.Warnf("No exchange rate %s->%s for order %s", order.Currency, order.ReportingCurrency, order.OrderID)
+	}
+	order.ExchangeRate = rate
+}
+
+// GetRevenueReport 获取当前应用在 [startTime, endTime) 内支付订单的收入报表，按应用报表币种折算
+// 订单按支付时记录的汇率折算；支付时没有汇率或报表币种已变更的订单按截止时间的汇率估算
+func (uc *SubscriptionUsecase) GetRevenueReport(ctx context.Context, startTime, endTime time.Time) (*RevenueReport, error) {
+	if !startTime.Before(endTime) {
+		return nil, pkgErrors.NewBizErrorWithLang(ctx, errors.ErrCodeReportRangeInvalid)
+	}
+	appID := app_id.GetAppIDFromContext(ctx)
+	summaries, err := uc.orderRepo.SumRevenue(ctx, appID, startTime, endTime)
+	if err != nil {
+		uc.log.Errorf("Failed to sum revenue of app %s: %v", appID, err)
+		return nil, err
+	}
+
+	report := &RevenueReport{
+		AppID:             appID,
+		ReportingCurrency: uc.reportingCurrency(ctx, appID),
+		StartTime:         startTime,
+		EndTime:           endTime,
+	}
+	rateAt := endTime
+	if now := time.Now().UTC(); now.Before(rateAt) {
+		rateAt = now
+	}
+
+	byCurrency := make(map[string]*CurrencyRevenue)
+	unconverted := make(map[string]bool)
+	for _, s := range summaries {
+		cr := byCurrency[s.Currency]
+		if cr == nil {
+			cr = &CurrencyRevenue{Currency: s.Currency}
+			byCurrency[s.Currency] = cr
+			report.ByCurrency = append(report.ByCurrency, cr)
+		}
+		cr.OrderCount += s.OrderCount
+		cr.GrossAmount += s.Amount
+		cr.RefundedAmount += s.RefundedAmount
+		cr.TaxAmount += s.TaxAmount
+
+		gross, refunded, tax := s.ConvertedAmount, s.ConvertedRefunded, s.ConvertedTax
+		if s.ReportingCurrency != report.ReportingCurrency {
+			rate, err := uc.convertRate(ctx, s.Currency, report.ReportingCurrency, rateAt)
+			if err != nil {
+				return nil, err
+			}
+			if rate == 0 {
+				unconverted[s.Currency] = true
+				continue
+			}
+			gross, refunded, tax = s.Amount*rate, s.RefundedAmount*rate, s.TaxAmount*rate
+			cr.Estimated = cr.Estimated || s.Currency != report.ReportingCurrency
+		}
+		report.OrderCount += s.OrderCount
+		report.GrossAmount += gross
+		report.RefundedAmount += refunded
+		report.TaxAmount += tax
+		cr.ConvertedNet += gross - refunded - tax
+	}
+
+	for _, cr := range report.ByCurrency {
+		cr.GrossAmount = roundAmount(cr.GrossAmount)
+		cr.RefundedAmount = roundAmount(cr.RefundedAmount)
+		cr.TaxAmount = roundAmount(cr.TaxAmount)
+		cr.NetAmount = roundAmount(cr.GrossAmount - cr.RefundedAmount - cr.TaxAmount)
+		cr.ConvertedNet = roundAmount(cr.ConvertedNet)
+	}
+	sort.Slice(report.ByCurrency, func(i, j int) bool { return report.ByCurrency[i].Currency < report.ByCurrency[j].Currency })
+	for currency := range unconverted {
+		report.Unconverted = append(report.Unconverted, currency)
+	}
+	sort.Strings(report.Unconverted)
+
+	report.GrossAmount = roundAmount(report.GrossAmount)
+	report.RefundedAmount = roundAmount(report.RefundedAmount)
+	report.TaxAmount = roundAmount(report.TaxAmount)
+	report.NetAmount = roundAmount(report.GrossAmount - report.RefundedAmount - report.TaxAmount)
+	return report, nil
+}
+
+// orderNetRevenue 订单净收入（订单币种）= 实收 - 退款 - 税额（税额按退款比例扣除冲减部分）
+func orderNetRevenue(o *SubscriptionOrder) float64 {
+	if o.Amount <= 0 {
+		return 0
+	}
+	remaining := o.Amount - o.RefundedAmount
+	return remaining - o.TaxAmount*remaining/o.Amount
+}
+
+// revenueConverter 将订单收入折算为报表币种：优先使用支付时记录的汇率，否则按 at 时刻的汇率估算
+type revenueConverter struct {
+	uc          *SubscriptionUsecase
+	currency    string
+	at          time.Time
+	rates       map[string]float64
+	unconverted map[string]bool
+}
+
+func (uc *SubscriptionUsecase) newRevenueConverter(currency string, at time.Time) *revenueConverter {
+	return &revenueConverter{
+		uc:          uc,
+		currency:    currency,
+		at:          at,
+		rates:       make(map[string]float64),
+		unconverted: make(map[string]bool),
+	}
+}
+
+// netRevenue 订单净收入折算为报表币种，没有可用汇率时返回 false
+func (c *revenueConverter) netRevenue(ctx context.Context, o *SubscriptionOrder) (float64, bool, error) {
+	net := orderNetRevenue(o)
+	if o.ReportingCurrency == c.currency && o.ExchangeRate > 0 {
+		return net * o.ExchangeRate, true, nil
+	}
+	rate, ok := c.rates[o.Currency]
+	if !ok {
+		var err error
+		if rate, err = c.uc.convertRate(ctx, o.Currency, c.currency, c.at); err != nil {
+			return 0, false, err
+		}
+		c.rates[o.Currency] = rate
+	}
+	if rate == 0 {
+		c.unconverted[o.Currency] = true
+		return 0, false, nil
+	}
+	return net * rate, true, nil
+}
+
+// unconvertedCurrencies 没有可用汇率的币种（排序后）
+func (c *revenueConverter) unconvertedCurrencies() []string {
+	result := make([]string, 0, len(c.unconverted))
+	for currency := range c.unconverted {
+		result = append(result, currency)
+	}
+	sort.Strings(result)
+	return result
+}
@@ -0,0 +1,74 @@
+package biz
+
+import (
+	"context"
+	stderrors "errors"
+	"time"
+
+	"xinyuan_tech/subscription-service/internal/constants"
+	"xinyuan_tech/subscription-service/internal/errors"
+	"xinyuan_tech/subscription-service/internal/metrics"
+
+	pkgErrors "github.com/gaoyong06/go-pkg/errors"
+	"github.com/go-redsync/redsync/v4"
+)
+
+// ErrSubscriptionConflict 订阅已被其他操作修改（保存时版本号不一致，或同一用户的订阅已被创建），重新读取后重试
+var ErrSubscriptionConflict = stderrors.New("subscription was modified concurrently")
+
+type userLockKey struct{ uid string }
+
+// mutateSubscription 修改用户订阅：持有用户锁，在事务中执行 fn，保存时版本冲突则重新执行（fn 需要在事务中重新读取订阅）
+func (uc *SubscriptionUsecase) mutateSubscription(ctx context.Context, uid string, fn func(ctx context.Context) error) error {
+	return uc.withUserLock(ctx, uid, constants.SubscriptionLockExpiration, constants.SubscriptionLockTries, func(ctx context.Context) error {
+		return uc.retryOnConflict(ctx, uid, func() error {
+			return uc.withTransaction(ctx, fn)
+		})
+	})
+}
+
+// withUserLock 持有用户订阅锁执行 fn（同一用户的生命周期操作串行执行，包括自动续费）
+// 锁可重入：fn 中再次获取同一用户的锁时直接执行；获取失败时返回订阅正在处理错误
+func (uc *SubscriptionUsecase) withUserLock(ctx context.Context, uid string, expiry time.Duration, tries int, fn func(ctx context.Context) error) error {
+	if held, _ := ctx.Value(userLockKey{uid}).(bool); held {
+		return fn(ctx)
+	}
+	mutex := uc.rs.NewMutex(
+		constants.SubscriptionLockKeyPrefix+uid,
+		redsync.WithExpiry(expiry),
+		redsync.WithTries(tries),
+		redsync.WithRetryDelay(constants.SubscriptionLockRetryDelay),
+	)
+	if err := mutex.LockContext(ctx); err != nil {
+		uc.log.Infof("Subscription lock of user %s is busy: %v", uid, err)
+		metrics.LockContention.WithLabelValues(constants.MetricLockSubscription).Inc()
+		return pkgErrors.NewBizErrorWithLang(ctx, errors.ErrCodeSubscriptionBusy)
+	}
+	defer func() {
+		// 请求超时或取消后仍需释放锁，避免锁一直持有到过期
+		if _, err := mutex.UnlockContext(context.WithoutCancel(ctx)); err != nil {
+			uc.log.Warnf("Failed to unlock subscription of user %s: %v", uid, err)
+		}
+	}()
+	return fn(context.WithValue(ctx, userLockKey{uid}, true))
+}
+
+// retryOnConflict 版本冲突时重新执行 fn，超过重试次数后返回订阅冲突错误
+func (uc *SubscriptionUsecase) retryOnConflict(ctx context.Context, uid string, fn func() error) error {
+	for attempt := 1; ; attempt++ {
+		err := fn()
+		if !stderrors.Is(err, ErrSubscriptionConflict) {
+			return err
+		}
+		if attempt >= constants.SubscriptionConflictMaxRetries {
+			uc.log.Warnf("Subscription of user %s still conflicts after %d attempts", uid, attempt)
+			return pkgErrors.NewBizErrorWithLang(ctx, errors.ErrCodeSubscriptionConflict)
+		}
+		uc.log.Infof("Subscription of user %s was modified concurrently, retry (attempt %d)", uid, attempt+1)
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(time.Duration(attempt) * constants.SubscriptionConflictRetryDelay):
+		}
+	}
+}
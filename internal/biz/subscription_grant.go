@@ -0,0 +1,151 @@
+package biz
+
+import (
+	"context"
+	"time"
+
+	"xinyuan_tech/subscription-service/internal/audit"
+	"xinyuan_tech/subscription-service/internal/constants"
+	"xinyuan_tech/subscription-service/internal/errors"
+
+	pkgErrors "github.com/gaoyong06/go-pkg/errors"
+)
+
+// GrantRequest 赠送或手动开通订阅请求（不经过支付）
+type GrantRequest struct {
+	UID         string
+	PlanID      string
+	Source      string // comp-赠送（如市场活动），manual-手动开通（如企业客户线下对公付款）
+	Days        int    // 开通天数，0 表示按套餐的一个计费周期（终身套餐只能为 0）
+	CreateOrder bool   // 是否创建零金额订单用于记账
+	OperatorID  string
+	Reason      string
+}
+
+// GrantSubscription 不经过支付开通或延长用户订阅，记录 granted 历史（包含操作人和原因）
+//   - 用户持有同一套餐的未过期订阅：在原结束时间上延长，订阅来源和当前生效订单不变
+//   - 没有订阅、订阅已过期/已取消或为免费套餐：从当前时间开始新周期，订阅来源为 req.Source（不开启自动续费）
+//   - 开通终身套餐：与购买终身套餐相同，订阅永不过期
+//   - 用户持有终身套餐或其他套餐的未过期订阅：不开通，返回错误（应延长现有订阅）
+//
+// 赠送和手动开通的订单金额为 0、来源不是 purchase，不计入收入报表、MRR、同期群和 LTV，也不开具发票
+func (uc *SubscriptionUsecase) GrantSubscription(ctx context.Context, req *GrantRequest) (*UserSubscription, *SubscriptionOrder, error) {
+	uc.log.Infof("GrantSubscription: uid=%s, planID=%s, source=%s, days=%d, createOrder=%v, operator=%s, reason=%s",
+		req.UID, req.PlanID, req.Source, req.Days, req.CreateOrder, req.OperatorID, req.Reason)
+	ctx = audit.WithActor(ctx, constants.ActorTypeAdmin, req.OperatorID)
+
+	if req.Source != constants.SourceComp && req.Source != constants.SourceManual {
+		return nil, nil, pkgErrors.NewBizErrorWithLang(ctx, errors.ErrCodeGrantSourceInvalid)
+	}
+	plan, err := uc.planRepo.GetPlan(ctx, req.PlanID)
+	if err != nil || plan == nil {
+		uc.log.Errorf("Failed to get plan %s: %v", req.PlanID, err)
+		return nil, nil, pkgErrors.NewBizErrorWithLang(ctx, errors.ErrCodePlanNotFound)
+	}
+	// 终身套餐没有结束时间，不能按天开通
+	if req.Days < 0 || (plan.IsLifetime() && req.Days > 0) {
+		return nil, nil, pkgErrors.NewBizErrorWithLang(ctx, errors.ErrCodeAdjustmentInvalid)
+	}
+	interval := plan.BillingInterval()
+	if !plan.IsLifetime() && req.Days == 0 && !interval.IsValid() {
+		return nil, nil, pkgErrors.NewBizErrorWithLang(ctx, errors.ErrCodePlanIntervalInvalid)
+	}
+
+	var granted *UserSubscription
+	var grantOrder *SubscriptionOrder
+	err = uc.mutateSubscription(ctx, req.UID, func(ctx context.Context) error {
+		grantOrder = nil
+		sub, err := uc.subRepo.GetSubscription(ctx, req.UID)
+		if err != nil {
+			uc.log.Errorf("Failed to get subscription: %v", err)
+			return err
+		}
+		if uc.holdsLifetimePlan(ctx, sub) && !plan.IsLifetime() {
+			return pkgErrors.NewBizErrorWithLang(ctx, errors.ErrCodeLifetimeSubscription)
+		}
+
+		now := time.Now().UTC()
+		before := snapshot(sub)
+		if sub == nil {
+			sub = &UserSubscription{UID: req.UID, CreatedAt: now}
+		}
+		// 本次开通的周期（用于记账订单）
+		periodStart := now
+		newPeriod := true
+		switch {
+		case plan.IsLifetime():
+			sub.StartTime, sub.EndTime, sub.BillingAnchor = purchasePeriod(sub, plan, false, now)
+			periodStart = sub.StartTime
+		case sub.IsLifetime() || sub.IsExpiredAt(now) || sub.Status == constants.StatusExpired || sub.Status == constants.StatusCancelled:
+			// 新订阅、免费套餐或已失效的订阅：从当前时间开始新周期
+			sub.StartTime, sub.BillingAnchor = now, now
+			sub.EndTime = grantPeriodEnd(interval, now, req.Days)
+		case sub.PlanID == plan.PlanID:
+			// 同一套餐未过期（含暂停）：在原结束时间上延长
+			periodStart = sub.EndTime
+			newPeriod = false
+			if req.Days > 0 {
+				sub.shiftEndTime(req.Days)
+			} else {
+				sub.StartTime, sub.EndTime, sub.BillingAnchor = nextBillingPeriod(sub, plan.PlanID, interval, now)
+			}
+		default:
+			return pkgErrors.NewBizErrorWithLang(ctx, errors.ErrCodeGrantPlanConflict)
+		}
+
+		if req.CreateOrder {
+			grantOrder = &SubscriptionOrder{
+				OrderID:       newOrderID(req.UID),
+				UID:           req.UID,
+				PlanID:        plan.PlanID,
+				AppID:         plan.AppID,
+				CountryCode:   sub.CountryCode,
+				Currency:      plan.Currency,
+				PaidAt:        now,
+				PeriodStart:   periodStart,
+				PeriodEnd:     sub.EndTime,
+				PaymentStatus: constants.PaymentStatusSuccess,
+				Source:        req.Source,
+				CreatedAt:     now,
+			}
+			if err := uc.orderRepo.CreateOrder(ctx, grantOrder); err != nil {
+				uc.log.Errorf("Failed to create grant order: %v", err)
+				return err
+			}
+		}
+		if newPeriod {
+			sub.PlanID = plan.PlanID
+			sub.AppID = plan.AppID
+			sub.Status = constants.StatusActive
+			sub.IsAutoRenew = false
+			sub.Source = req.Source
+			sub.OrderID = ""
+			if grantOrder != nil {
+				sub.OrderID = grantOrder.OrderID
+			}
+		}
+		sub.UpdatedAt = now
+		if err := uc.subRepo.SaveSubscription(ctx, sub); err != nil {
+			uc.log.Errorf("Failed to save subscription: %v", err)
+			return err
+		}
+		if err := uc.recordHistory(ctx, constants.ActionGranted, before, sub, req.Reason, now); err != nil {
+			return err
+		}
+		granted = sub
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	uc.log.Infof("Granted plan %s (%s) to user %s by %s, new end time: %v", req.PlanID, req.Source, req.UID, req.OperatorID, granted.EndTime)
+	return granted, grantOrder, nil
+}
+
+// grantPeriodEnd 计算从 start 开始的开通周期结束时间：days 为 0 时按套餐的一个计费周期
+func grantPeriodEnd(interval BillingInterval, start time.Time, days int) time.Time {
+	if days > 0 {
+		return start.AddDate(0, 0, days)
+	}
+	return interval.PeriodEnd(start, 1)
+}
@@ -2,10 +2,18 @@ package biz
 
 import (
 	"context"
+	"strconv"
 	"time"
+
+	"xinyuan_tech/subscription-service/internal/audit"
+	"xinyuan_tech/subscription-service/internal/constants"
+	"xinyuan_tech/subscription-service/internal/errors"
+
+	pkgErrors "github.com/gaoyong06/go-pkg/errors"
+	"github.com/gaoyong06/go-pkg/middleware/app_id"
 )
 
-// SubscriptionHistory 订阅历史记录
+// SubscriptionHistory 订阅历史记录（审计日志：每次修改订阅时记录操作人、原因、请求ID和变更前后的字段）
 type SubscriptionHistory struct {
 	SubscriptionHistoryID uint64
 	UID                   string // 用户ID（字符串 UUID）
@@ -15,34 +23,244 @@ type SubscriptionHistory struct {
 	StartTime             time.Time
 	EndTime               time.Time
 	Status                string
-	Action                string // created, renewed, upgraded, paused, resumed, cancelled
+	Action                string // created, renewed, upgraded, paused, resumed, cancelled, extended, shortened, granted
+	ActorType             string // 操作人类型：user, admin, cron, payment, system
+	OperatorID            string // 操作人ID：用户或管理员的用户ID、定时任务名、支付ID
+	Reason                string // 操作原因（用户取消、暂停时填写的原因，客服操作时必填）
+	RequestID             string // 请求ID（同一请求或同一次定时任务执行的修改相同）
+	Changes               []*HistoryChange
 	CreatedAt             time.Time
 }
 
+// HistoryChange 订阅字段变更（值为字符串：时间为 RFC3339，零值时间和新订阅的变更前值为空字符串）
+type HistoryChange struct {
+	Field  string `json:"field"`
+	Before string `json:"before"`
+	After  string `json:"after"`
+}
+
+// HistoryFilter 订阅历史筛选条件（字段为空表示不过滤）
+type HistoryFilter struct {
+	UID     string
+	AppID   string
+	PlanID  string
+	Actions []string  // 操作类型，多个时匹配任一
+	From    time.Time // 创建时间范围 [From, To)，零值表示不限
+	To      time.Time
+}
+
+// HistoryQuery 订阅历史分页查询：Cursor 大于 0 时读取该记录之后的一页（忽略 Page），否则按页码读取
+type HistoryQuery struct {
+	HistoryFilter
+	Cursor   uint64 // 上一页返回的 nextCursor
+	Page     int
+	PageSize int
+}
+
 // SubscriptionHistoryRepo 订阅历史记录仓库接口
 type SubscriptionHistoryRepo interface {
 	AddSubscriptionHistory(ctx context.Context, history *SubscriptionHistory) error
-	GetSubscriptionHistory(ctx context.Context, uid string, page, pageSize int) ([]*SubscriptionHistory, int, error)
+	// ListSubscriptionHistory 按记录ID倒序（即创建时间倒序）查询，beforeID 大于 0 时只返回ID小于 beforeID 的记录
+	ListSubscriptionHistory(ctx context.Context, filter *HistoryFilter, beforeID uint64, offset, limit int) ([]*SubscriptionHistory, error)
+	CountSubscriptionHistory(ctx context.Context, filter *HistoryFilter) (int, error)
+}
+
+// historyActions 订阅历史的操作类型
+var historyActions = map[string]bool{
+	constants.ActionCreated:             true,
+	constants.ActionRenewed:             true,
+	constants.ActionUpgraded:            true,
+	constants.ActionPaused:              true,
+	constants.ActionResumed:             true,
+	constants.ActionCancelled:           true,
+	constants.ActionExpired:             true,
+	constants.ActionEnabledAutoRenew:    true,
+	constants.ActionDisabledAutoRenew:   true,
+	constants.ActionDowngradedToFree:    true,
+	constants.ActionExtended:            true,
+	constants.ActionShortened:           true,
+	constants.ActionGranted:             true,
+	constants.ActionPlanChangeScheduled: true,
+}
+
+// GetSubscriptionHistory 获取用户的订阅历史记录，返回当前页、总数和下一页游标（没有下一页时为 0）
+func (uc *SubscriptionUsecase) GetSubscriptionHistory(ctx context.Context, query *HistoryQuery) ([]*SubscriptionHistory, int, uint64, error) {
+	uc.log.Infof("GetSubscriptionHistory: uid=%s, page=%d, pageSize=%d, cursor=%d", query.UID, query.Page, query.PageSize, query.Cursor)
+
+	items, next, err := uc.listHistory(ctx, query)
+	if err != nil {
+		return nil, 0, 0, err
+	}
+	total, err := uc.historyRepo.CountSubscriptionHistory(ctx, &query.HistoryFilter)
+	if err != nil {
+		uc.log.Errorf("Failed to count subscription history: %v", err)
+		return nil, 0, 0, err
+	}
+
+	uc.log.Infof("Retrieved %d history items for user %s", len(items), query.UID)
+	return items, total, next, nil
 }
 
-// GetSubscriptionHistory 获取订阅历史记录
-func (uc *SubscriptionUsecase) GetSubscriptionHistory(ctx context.Context, uid string, page, pageSize int) ([]*SubscriptionHistory, int, error) {
-	uc.log.Infof("GetSubscriptionHistory: uid=%s, page=%d, pageSize=%d", uid, page, pageSize)
+// ListAppSubscriptionHistory 获取当前应用所有用户的订阅历史记录（开发者查询），返回当前页和下一页游标
+// 应用的历史记录较多，不统计总数
+func (uc *SubscriptionUsecase) ListAppSubscriptionHistory(ctx context.Context, query *HistoryQuery) ([]*SubscriptionHistory, uint64, error) {
+	query.AppID = app_id.GetAppIDFromContext(ctx)
+	uc.log.Infof("ListAppSubscriptionHistory: appID=%s, uid=%s, pageSize=%d, cursor=%d", query.AppID, query.UID, query.PageSize, query.Cursor)
+	return uc.listHistory(ctx, query)
+}
+
+// ExportSubscriptionHistory 按记录ID倒序分批读取当前应用满足条件的订阅历史，每批调用一次 fn，fn 返回错误时停止导出
+func (uc *SubscriptionUsecase) ExportSubscriptionHistory(ctx context.Context, filter *HistoryFilter, fn func([]*SubscriptionHistory) error) error {
+	filter.AppID = app_id.GetAppIDFromContext(ctx)
+	uc.log.Infof("ExportSubscriptionHistory: appID=%s, uid=%s, actions=%v, from=%v, to=%v", filter.AppID, filter.UID, filter.Actions, filter.From, filter.To)
+	if err := validateHistoryFilter(ctx, filter); err != nil {
+		return err
+	}
+
+	var beforeID uint64
+	exported := 0
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		items, err := uc.historyRepo.ListSubscriptionHistory(ctx, filter, beforeID, 0, constants.HistoryExportBatchSize)
+		if err != nil {
+			uc.log.Errorf("Failed to list subscription history for export: %v", err)
+			return err
+		}
+		if len(items) > 0 {
+			if err := fn(items); err != nil {
+				return err
+			}
+			exported += len(items)
+		}
+		if len(items) < constants.HistoryExportBatchSize {
+			break
+		}
+		beforeID = items[len(items)-1].SubscriptionHistoryID
+	}
+	uc.log.Infof("Exported %d history items of app %s", exported, filter.AppID)
+	return nil
+}
 
-	// 参数验证
-	if page < 1 {
-		page = 1
+// listHistory 按游标或页码查询一页订阅历史，返回下一页游标
+func (uc *SubscriptionUsecase) listHistory(ctx context.Context, query *HistoryQuery) ([]*SubscriptionHistory, uint64, error) {
+	if err := validateHistoryFilter(ctx, &query.HistoryFilter); err != nil {
+		return nil, 0, err
+	}
+	if query.Page < 1 {
+		query.Page = 1
+	}
+	if query.PageSize < 1 {
+		query.PageSize = constants.DefaultPageSize
 	}
-	if pageSize < 1 || pageSize > 100 {
-		pageSize = 10
+	if query.PageSize > constants.MaxPageSize {
+		query.PageSize = constants.MaxPageSize
+	}
+	offset := 0
+	if query.Cursor == 0 {
+		offset = (query.Page - 1) * query.PageSize
 	}
 
-	items, total, err := uc.historyRepo.GetSubscriptionHistory(ctx, uid, page, pageSize)
+	items, err := uc.historyRepo.ListSubscriptionHistory(ctx, &query.HistoryFilter, query.Cursor, offset, query.PageSize)
 	if err != nil {
-		uc.log.Errorf("Failed to get subscription history: %v", err)
+		uc.log.Errorf("Failed to list subscription history: %v", err)
 		return nil, 0, err
 	}
+	var next uint64
+	if len(items) == query.PageSize {
+		next = items[len(items)-1].SubscriptionHistoryID
+	}
+	return items, next, nil
+}
+
+// validateHistoryFilter 检查操作类型和时间范围
+func validateHistoryFilter(ctx context.Context, filter *HistoryFilter) error {
+	for _, action := range filter.Actions {
+		if !historyActions[action] {
+			return pkgErrors.NewBizErrorWithLang(ctx, errors.ErrCodeHistoryQueryInvalid)
+		}
+	}
+	if !filter.From.IsZero() && !filter.To.IsZero() && !filter.From.Before(filter.To) {
+		return pkgErrors.NewBizErrorWithLang(ctx, errors.ErrCodeHistoryQueryInvalid)
+	}
+	return nil
+}
+
+// recordHistory 记录订阅历史：操作人和请求ID从 Context 获取，before 为修改前的订阅快照（新订阅为 nil），after 为修改后的订阅
+// 在修改订阅的事务中调用，记录失败时事务回滚
+func (uc *SubscriptionUsecase) recordHistory(ctx context.Context, action string, before, after *UserSubscription, reason string, now time.Time) error {
+	planName := after.PlanID
+	if plan, _ := uc.planRepo.GetPlan(ctx, after.PlanID); plan != nil {
+		planName = plan.Name
+	}
+	actorType, actorID := audit.ActorFromContext(ctx)
+	history := &SubscriptionHistory{
+		UID:        after.UID,
+		PlanID:     after.PlanID,
+		PlanName:   planName,
+		AppID:      after.AppID,
+		StartTime:  after.StartTime,
+		EndTime:    after.EndTime,
+		Status:     after.Status,
+		Action:     action,
+		ActorType:  actorType,
+		OperatorID: actorID,
+		Reason:     reason,
+		RequestID:  audit.RequestIDFromContext(ctx),
+		Changes:    diffSubscription(before, after),
+		CreatedAt:  now,
+	}
+	if err := uc.historyRepo.AddSubscriptionHistory(ctx, history); err != nil {
+		uc.log.Errorf("Failed to add subscription history for user %s: %v", after.UID, err)
+		return err // 事务会回滚
+	}
+	return nil
+}
+
+// snapshot 复制订阅用于记录修改前的字段，sub 为 nil 时返回 nil
+func snapshot(sub *UserSubscription) *UserSubscription {
+	if sub == nil {
+		return nil
+	}
+	copied := *sub
+	return &copied
+}
 
-	uc.log.Infof("Retrieved %d history items for user %s", len(items), uid)
-	return items, total, nil
+// diffSubscription 比较修改前后的订阅字段，返回有变化的字段（版本号和更新时间不记录）
+func diffSubscription(before, after *UserSubscription) []*HistoryChange {
+	if before == nil {
+		before = &UserSubscription{}
+	}
+	fields := []struct {
+		name          string
+		before, after string
+	}{
+		{"plan_id", before.PlanID, after.PlanID},
+		{"app_id", before.AppID, after.AppID},
+		{"country_code", before.CountryCode, after.CountryCode},
+		{"status", before.Status, after.Status},
+		{"start_time", formatHistoryTime(before.StartTime), formatHistoryTime(after.StartTime)},
+		{"end_time", formatHistoryTime(before.EndTime), formatHistoryTime(after.EndTime)},
+		{"billing_anchor", formatHistoryTime(before.BillingAnchor), formatHistoryTime(after.BillingAnchor)},
+		{"order_id", before.OrderID, after.OrderID},
+		{"is_auto_renew", strconv.FormatBool(before.IsAutoRenew), strconv.FormatBool(after.IsAutoRenew)},
+		{"source", before.Source, after.Source},
+		{"next_plan_id", before.NextPlanID, after.NextPlanID},
+	}
+	changes := make([]*HistoryChange, 0, len(fields))
+	for _, f := range fields {
+		if f.before != f.after {
+			changes = append(changes, &HistoryChange{Field: f.name, Before: f.before, After: f.after})
+		}
+	}
+	return changes
+}
+
+// formatHistoryTime 历史记录中的时间格式（UTC RFC3339），零值为空字符串
+func formatHistoryTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
 }
@@ -2,11 +2,10 @@ package biz
 
 import (
 	"context"
-	"fmt"
+	"sync"
 	"time"
 	"xinyuan_tech/subscription-service/internal/constants"
-
-	"github.com/go-redsync/redsync/v4"
+	"xinyuan_tech/subscription-service/internal/metrics"
 )
 
 // AutoRenewResult 自动续费结果
@@ -17,6 +16,32 @@ type AutoRenewResult struct {
 	OrderID      string
 	PaymentID    string
 	ErrorMessage string
+
+	outcome string // 处理结果（success, failed, skipped），测试模式为空
+}
+
+// AutoRenewQuery 自动续费订阅分页查询条件
+type AutoRenewQuery struct {
+	ExpireBefore  time.Time        // 到期时间截止（含）
+	UpdatedBefore time.Time        // 只读取该时间之前更新过的订阅，零值不过滤
+	After         *AutoRenewCursor // 游标，nil 表示从头读取
+	Limit         int
+}
+
+// AutoRenewCursor 自动续费分页游标（上一批最后一个订阅）
+type AutoRenewCursor struct {
+	EndTime        time.Time `json:"end_time"`
+	SubscriptionID uint64    `json:"subscription_id"`
+}
+
+// autoRenewProgress 自动续费进度（保存到 Checkpoint）
+type autoRenewProgress struct {
+	StartedAt    time.Time        `json:"started_at"`    // 首次执行的开始时间
+	ExpireBefore time.Time        `json:"expire_before"` // 首次执行时确定的到期时间截止，重试时不变
+	Cursor       *AutoRenewCursor `json:"cursor,omitempty"`
+	TotalCount   int              `json:"total_count"`
+	SuccessCount int              `json:"success_count"`
+	FailedCount  int              `json:"failed_count"`
 }
 
 // GetExpiringSubscriptions 获取即将过期的订阅
@@ -45,60 +70,93 @@ func (uc *SubscriptionUsecase) GetExpiringSubscriptions(ctx context.Context, day
 	return subscriptions, total, nil
 }
 
-// UpdateExpiredSubscriptions 批量更新过期订阅状态
-func (uc *SubscriptionUsecase) UpdateExpiredSubscriptions(ctx context.Context) (int, []string, error) {
-	uc.log.Infof("Starting to update expired subscriptions")
+// UpdateExpiredSubscriptions 批量更新过期订阅状态，dryRun 为 true 时只返回已过期的订阅，不更新
+func (uc *SubscriptionUsecase) UpdateExpiredSubscriptions(ctx context.Context, dryRun bool) (int, []string, error) {
+	uc.log.Infof("Starting to update expired subscriptions (dryRun=%v)", dryRun)
 
-	// 调用 repo 批量更新
-	count, uids, err := uc.subRepo.UpdateExpiredSubscriptions(ctx)
-	if err != nil {
-		uc.log.Errorf("Failed to update expired subscriptions: %v", err)
-		return 0, nil, err
+	if dryRun {
+		uids, err := uc.subRepo.GetExpiredSubscriptionUIDs(ctx)
+		if err != nil {
+			uc.log.Errorf("Failed to get expired subscriptions: %v", err)
+			return 0, nil, err
+		}
+		uc.log.Infof("[DRY RUN] Would update %d expired subscriptions", len(uids))
+		return len(uids), uids, nil
 	}
 
-	// 为每个过期的订阅添加历史记录
+	// 按订阅 ID 游标逐批读取，逐条在事务中按版本号条件更新：读取后被续费或修改的订阅不会被标记为过期
 	now := time.Now().UTC()
-	for _, uid := range uids {
-		// 获取订阅信息
-		sub, err := uc.subRepo.GetSubscription(ctx, uid)
+	uids := make([]string, 0)
+	var afterID uint64
+	for {
+		subs, err := uc.subRepo.ListExpiredSubscriptions(ctx, now, afterID, constants.ExpiryBatchSize)
 		if err != nil {
-			uc.log.Errorf("Failed to get subscription for user %s: %v", uid, err)
-			continue
-		}
-		if sub == nil {
-			continue
+			uc.log.Errorf("Failed to list expired subscriptions: %v", err)
+			return len(uids), uids, err
 		}
-
-		// 获取套餐名称
-		plan, _ := uc.planRepo.GetPlan(ctx, sub.PlanID)
-		planName := sub.PlanID
-		if plan != nil {
-			planName = plan.Name
-		}
-
-		// 添加历史记录
-		history := &SubscriptionHistory{
-			UID:       uid,
-			PlanID:    sub.PlanID,
-			PlanName:  planName,
-			AppID:     sub.AppID,
-			StartTime: sub.StartTime,
-			EndTime:   sub.EndTime,
-			Status:    "expired",
-			Action:    "expired",
-			CreatedAt: now,
+		for _, sub := range subs {
+			if err := ctx.Err(); err != nil {
+				return len(uids), uids, err
+			}
+			expired, err := uc.expireSubscription(ctx, sub, now)
+			if err != nil {
+				uc.log.Errorf("Failed to expire subscription of user %s: %v", sub.UID, err)
+				continue
+			}
+			if expired {
+				uids = append(uids, sub.UID)
+			}
 		}
-		if err := uc.historyRepo.AddSubscriptionHistory(ctx, history); err != nil {
-			uc.log.Errorf("Failed to add history for user %s: %v", uid, err)
+		if len(subs) < constants.ExpiryBatchSize {
+			break
 		}
+		afterID = subs[len(subs)-1].SubscriptionID
 	}
 
-	uc.log.Infof("Updated %d expired subscriptions", count)
-	return count, uids, nil
+	uc.log.Infof("Updated %d expired subscriptions", len(uids))
+	return len(uids), uids, nil
+}
+
+// expireSubscription 持有用户订阅锁，在同一事务中将订阅标记为过期、记录过期历史并回落到应用的默认免费套餐
+// 订阅在读取后已被续费或修改时不更新，返回 false；任一步失败时整体回滚，下次检查时重试
+func (uc *SubscriptionUsecase) expireSubscription(ctx context.Context, sub *UserSubscription, now time.Time) (bool, error) {
+	expiredPlanID := sub.PlanID
+
+	var expired bool
+	err := uc.withUserLock(ctx, sub.UID, constants.SubscriptionLockExpiration, constants.SubscriptionLockTries, func(ctx context.Context) error {
+		return uc.withTransaction(ctx, func(ctx context.Context) error {
+			before := snapshot(sub)
+			ok, err := uc.subRepo.ExpireSubscription(ctx, sub, now)
+			if err != nil || !ok {
+				return err
+			}
+			if err := uc.recordHistory(ctx, constants.ActionExpired, before, sub, "", now); err != nil {
+				return err
+			}
+			if _, err := uc.downgradeToFree(ctx, sub, now); err != nil {
+				return err
+			}
+			expired = true
+			return nil
+		})
+	})
+	if err != nil || !expired {
+		return false, err
+	}
+	metrics.Expirations.WithLabelValues(sub.AppID, expiredPlanID).Inc()
+	return true, nil
 }
 
 // ProcessAutoRenewals 处理自动续费
 func (uc *SubscriptionUsecase) ProcessAutoRenewals(ctx context.Context, daysBeforeExpiry int, dryRun bool) (int, int, int, []*AutoRenewResult, error) {
+	return uc.ProcessAutoRenewalsWithCheckpoint(ctx, daysBeforeExpiry, dryRun, nil)
+}
+
+// ProcessAutoRenewalsWithCheckpoint 处理自动续费（支持保存进度）
+// 按 (到期时间, 订阅ID) 游标分批读取订阅，每批由有限数量的 worker 并发处理，每个用户处理完立即释放锁
+// cp 不为 nil 时每批处理完保存进度（游标和累计结果），超时或实例中途退出后重试同一计划时从进度处继续；
+// Context 结束后不再开始处理新的订阅，返回 Context 的错误
+func (uc *SubscriptionUsecase) ProcessAutoRenewalsWithCheckpoint(ctx context.Context, daysBeforeExpiry int, dryRun bool, cp Checkpoint) (int, int, int, []*AutoRenewResult, error) {
 	uc.log.Infof("Starting auto-renewal process (daysBeforeExpiry=%d, dryRun=%v)", daysBeforeExpiry, dryRun)
 
 	// 参数验证
@@ -106,113 +164,244 @@ func (uc *SubscriptionUsecase) ProcessAutoRenewals(ctx context.Context, daysBefo
 		daysBeforeExpiry = 3
 	}
 
-	// 获取需要自动续费的订阅
-	subscriptions, err := uc.subRepo.GetAutoRenewSubscriptions(ctx, daysBeforeExpiry)
-	if err != nil {
-		uc.log.Errorf("Failed to get auto-renew subscriptions: %v", err)
-		return 0, 0, 0, nil, err
+	now := time.Now().UTC()
+	progress := &autoRenewProgress{StartedAt: now, ExpireBefore: now.AddDate(0, 0, daysBeforeExpiry)}
+	if cp != nil {
+		resumed, err := cp.Load(ctx, progress)
+		if err != nil {
+			uc.log.Errorf("Failed to load auto-renewal checkpoint: %v", err)
+			return 0, 0, 0, nil, err
+		}
+		if resumed {
+			uc.log.Infof("Resuming auto-renewal from checkpoint: total=%d, success=%d, failed=%d",
+				progress.TotalCount, progress.SuccessCount, progress.FailedCount)
+		}
 	}
 
-	totalCount := len(subscriptions)
-	successCount := 0
-	failedCount := 0
-	results := make([]*AutoRenewResult, 0, totalCount)
-
-	for _, sub := range subscriptions {
-		result := &AutoRenewResult{
-			UID:    sub.UID,
-			PlanID: sub.PlanID,
+	batchSize, workers := uc.autoRenewBatchSize(), uc.autoRenewWorkers()
+	results := make([]*AutoRenewResult, 0)
+	for ctx.Err() == nil {
+		// 本次开始后更新过的订阅（已续费、到期时间后移）不再重复读取
+		subscriptions, err := uc.subRepo.ListAutoRenewSubscriptions(ctx, &AutoRenewQuery{
+			ExpireBefore:  progress.ExpireBefore,
+			UpdatedBefore: progress.StartedAt,
+			After:         progress.Cursor,
+			Limit:         batchSize,
+		})
+		if err != nil {
+			uc.log.Errorf("Failed to get auto-renew subscriptions: %v", err)
+			return progress.TotalCount, progress.SuccessCount, progress.FailedCount, results, err
+		}
+		if len(subscriptions) == 0 {
+			break
 		}
 
-		// 使用分布式锁防止重复续费
-		lockKey := fmt.Sprintf("auto_renew_lock:user:%s", sub.UID)
-		mutex := uc.rs.NewMutex(
-			lockKey,
-			redsync.WithExpiry(constants.AutoRenewLockExpiration),
-			redsync.WithTries(constants.AutoRenewLockRetries), // 只尝试一次,如果失败说明正在处理
-		)
-
-		// 尝试获取锁
-		if err := mutex.LockContext(ctx); err != nil {
-			result.Success = false
-			result.ErrorMessage = "failed to acquire lock or already processing"
-			uc.log.Infof("Skipping auto-renew for user %s: lock busy or already processing", sub.UID)
+		for _, result := range uc.renewSubscriptions(ctx, subscriptions, dryRun, workers) {
+			if result == nil {
+				continue
+			}
+			progress.TotalCount++
+			switch result.outcome {
+			case constants.MetricResultSuccess:
+				progress.SuccessCount++
+			case constants.MetricResultFailed:
+				progress.FailedCount++
+			}
 			results = append(results, result)
-			continue
+		}
+		if ctx.Err() != nil {
+			// 本批没有全部处理完，不推进游标，重试时重新读取本批中尚未续费的订阅
+			break
 		}
 
-		// 确保释放锁
-		defer func(m *redsync.Mutex) {
-			if _, err := m.UnlockContext(ctx); err != nil {
-				uc.log.Warnf("Failed to unlock for user %s: %v", sub.UID, err)
+		last := subscriptions[len(subscriptions)-1]
+		progress.Cursor = &AutoRenewCursor{EndTime: last.EndTime, SubscriptionID: last.SubscriptionID}
+		if cp != nil {
+			if err := cp.Save(ctx, progress); err != nil {
+				uc.log.Warnf("Failed to save auto-renewal checkpoint: %v", err)
 			}
-		}(mutex)
-
-		// 再次检查订阅状态,防止重复处理
-		currentSub, err := uc.subRepo.GetSubscription(ctx, sub.UID)
-		if err != nil {
-			result.Success = false
-			result.ErrorMessage = "failed to get current subscription: " + err.Error()
-			failedCount++
-			results = append(results, result)
-			continue
 		}
-		if currentSub != nil && currentSub.EndTime.After(sub.EndTime) {
-			// 已经被续费过了
-			result.Success = true
-			result.ErrorMessage = "already renewed"
-			uc.log.Infof("Subscription for user %s already renewed", sub.UID)
-			results = append(results, result)
-			continue
+		if len(subscriptions) < batchSize {
+			break
 		}
+	}
 
-		if dryRun {
-			// 测试模式，只记录不执行
-			result.Success = true
-			result.ErrorMessage = "dry run - not executed"
-			uc.log.Infof("[DRY RUN] Would renew subscription for user %s, plan %s", sub.UID, sub.PlanID)
-		} else {
-			// 实际执行续费（使用默认区域定价）
-			order, paymentID, _, _, _, err := uc.CreateSubscriptionOrder(ctx, sub.UID, sub.PlanID, "auto", "default")
-			if err != nil {
-				result.Success = false
-				result.ErrorMessage = err.Error()
-				failedCount++
-				uc.log.Errorf("Failed to create renewal order for user %s: %v", sub.UID, err)
-			} else {
-				result.Success = true
-				result.OrderID = order.OrderID
-				result.PaymentID = paymentID
-				successCount++
-				uc.log.Infof("Successfully created renewal order for user %s: %s", sub.UID, order.OrderID)
-
-				// 自动续费处理逻辑：
-				// 1. 创建订单后，订单状态为 pending
-				// 2. 当前实现：直接调用 HandlePaymentSuccess 处理支付成功
-				//    注意：这假设支付服务已经完成了自动扣款（通过其他机制，如定时任务、支付回调等）
-				// 3. 未来优化：如果支付服务提供自动扣款接口，可以在这里调用
-				//    例如：paymentClient.AutoCharge(ctx, orderID, uid, amount, currency)
-				//    然后根据扣款结果决定是否调用 HandlePaymentSuccess
-				//
-				// 当前实现说明：
-				// - 自动续费的支付处理可能由支付服务的定时任务或回调机制完成
-				// - 这里直接处理支付成功，表示假设扣款已经完成
-				// - 如果实际业务中需要先确认扣款，应该先调用支付服务的查询接口确认支付状态
-				if err := uc.HandlePaymentSuccess(ctx, order.OrderID, order.Amount); err != nil {
-					uc.log.Errorf("Failed to handle payment success for order %s: %v", order.OrderID, err)
-					result.ErrorMessage = "order created but payment processing failed: " + err.Error()
-					result.Success = false
-					failedCount++
-					successCount--
-				} else {
-					uc.log.Infof("Successfully processed auto-renewal payment for user %s, order %s", sub.UID, order.OrderID)
+	if err := ctx.Err(); err != nil {
+		uc.log.Warnf("Auto-renewal process interrupted: total=%d, success=%d, failed=%d: %v",
+			progress.TotalCount, progress.SuccessCount, progress.FailedCount, err)
+		return progress.TotalCount, progress.SuccessCount, progress.FailedCount, results, err
+	}
+	uc.log.Infof("Auto-renewal process completed: total=%d, success=%d, failed=%d",
+		progress.TotalCount, progress.SuccessCount, progress.FailedCount)
+	return progress.TotalCount, progress.SuccessCount, progress.FailedCount, results, nil
+}
+
+// renewSubscriptions 由 workers 个 goroutine 并发处理一批订阅，结果与订阅一一对应（Context 结束后未开始处理的订阅结果为 nil）
+func (uc *SubscriptionUsecase) renewSubscriptions(ctx context.Context, subscriptions []*UserSubscription, dryRun bool, workers int) []*AutoRenewResult {
+	results := make([]*AutoRenewResult, len(subscriptions))
+	indexes := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < min(workers, len(subscriptions)); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				if ctx.Err() != nil {
+					continue
 				}
+				results[i] = uc.renewSubscription(ctx, subscriptions[i], dryRun)
 			}
+		}()
+	}
+	for i := range subscriptions {
+		indexes <- i
+	}
+	close(indexes)
+	wg.Wait()
+	return results
+}
+
+// renewSubscription 续费单个订阅：持有用户订阅锁（与用户的其他生命周期操作互斥）期间再次检查订阅状态，
+// 创建续费订单并处理支付，处理完立即释放锁；锁被占用时跳过
+func (uc *SubscriptionUsecase) renewSubscription(ctx context.Context, sub *UserSubscription, dryRun bool) *AutoRenewResult {
+	result := &AutoRenewResult{
+		UID:    sub.UID,
+		PlanID: sub.PlanID,
+	}
+	defer func() {
+		if result.outcome != "" {
+			metrics.AutoRenewals.WithLabelValues(sub.AppID, sub.PlanID, result.outcome).Inc()
 		}
+	}()
 
-		results = append(results, result)
+	// 只尝试一次，获取失败说明该用户正在处理
+	err := uc.withUserLock(ctx, sub.UID, constants.AutoRenewLockExpiration, constants.AutoRenewLockRetries, func(ctx context.Context) error {
+		uc.renewLocked(ctx, sub, dryRun, result)
+		return nil
+	})
+	if err != nil {
+		result.Success = false
+		result.ErrorMessage = "failed to acquire lock or already processing"
+		result.outcome = constants.MetricResultSkipped
+		uc.log.Infof("Skipping auto-renew for user %s: lock busy or already processing", sub.UID)
 	}
+	return result
+}
 
-	uc.log.Infof("Auto-renewal process completed: total=%d, success=%d, failed=%d", totalCount, successCount, failedCount)
-	return totalCount, successCount, failedCount, results, nil
+// renewLocked 持有用户订阅锁续费订阅，结果写入 result
+func (uc *SubscriptionUsecase) renewLocked(ctx context.Context, sub *UserSubscription, dryRun bool, result *AutoRenewResult) {
+	// 再次检查订阅状态,防止重复处理
+	currentSub, err := uc.subRepo.GetSubscription(ctx, sub.UID)
+	if err != nil {
+		result.Success = false
+		result.ErrorMessage = "failed to get current subscription: " + err.Error()
+		result.outcome = constants.MetricResultFailed
+		return
+	}
+	if currentSub == nil || !currentSub.IsAutoRenew || currentSub.Status != constants.StatusActive {
+		// 期间已关闭自动续费、取消、暂停或过期，不再续费
+		result.Success = true
+		result.ErrorMessage = "auto-renew disabled or subscription not active"
+		result.outcome = constants.MetricResultSkipped
+		uc.log.Infof("Subscription for user %s is no longer active with auto-renew, skip auto-renew", sub.UID)
+		return
+	}
+	if currentSub.IsLifetime() {
+		// 期间已升级为终身订阅，无需续费
+		result.Success = true
+		result.ErrorMessage = "lifetime subscription"
+		result.outcome = constants.MetricResultSkipped
+		uc.log.Infof("Subscription for user %s is lifetime, skip auto-renew", sub.UID)
+		return
+	}
+	if currentSub.EndTime.After(sub.EndTime) {
+		// 已经被续费过了
+		result.Success = true
+		result.ErrorMessage = "already renewed"
+		result.outcome = constants.MetricResultSkipped
+		uc.log.Infof("Subscription for user %s already renewed", sub.UID)
+		return
+	}
+
+	// 已安排迁移套餐时按新套餐续费
+	planID := currentSub.RenewalPlanID()
+	result.PlanID = planID
+
+	if dryRun {
+		// 测试模式，只记录不执行
+		result.Success = true
+		result.ErrorMessage = "dry run - not executed"
+		uc.log.Infof("[DRY RUN] Would renew subscription for user %s, plan %s", sub.UID, planID)
+		return
+	}
+
+	// 实际执行续费（按订阅的定价地区报价，旧订阅没有记录地区时使用默认定价）
+	// 定时任务没有请求头，按订阅所属应用下单
+	ctx = withAppID(ctx, sub.AppID)
+	ctx = withPricingTime(ctx, uc.renewalChargeTime(currentSub))
+	region := sub.CountryCode
+	if region == "" {
+		region = "default"
+	}
+	order, paymentID, _, _, _, err := uc.CreateSubscriptionOrder(ctx, sub.UID, planID, "auto", region)
+	if err != nil {
+		result.Success = false
+		result.ErrorMessage = err.Error()
+		result.outcome = constants.MetricResultFailed
+		uc.log.Errorf("Failed to create renewal order for user %s: %v", sub.UID, err)
+		return
+	}
+	result.OrderID = order.OrderID
+	result.PaymentID = paymentID
+	uc.log.Infof("Successfully created renewal order for user %s: %s", sub.UID, order.OrderID)
+
+	// 自动续费处理逻辑：
+	// 1. 创建订单后，订单状态为 pending
+	// 2. 当前实现：直接调用 HandlePaymentSuccess 处理支付成功
+	//    注意：这假设支付服务已经完成了自动扣款（通过其他机制，如定时任务、支付回调等）
+	// 3. 未来优化：如果支付服务提供自动扣款接口，可以在这里调用
+	//    例如：paymentClient.AutoCharge(ctx, orderID, uid, amount, currency)
+	//    然后根据扣款结果决定是否调用 HandlePaymentSuccess
+	//
+	// 当前实现说明：
+	// - 自动续费的支付处理可能由支付服务的定时任务或回调机制完成
+	// - 这里直接处理支付成功，表示假设扣款已经完成
+	// - 如果实际业务中需要先确认扣款，应该先调用支付服务的查询接口确认支付状态
+	if err := uc.HandlePaymentSuccess(ctx, order.OrderID, order.Amount); err != nil {
+		uc.log.Errorf("Failed to handle payment success for order %s: %v", order.OrderID, err)
+		result.ErrorMessage = "order created but payment processing failed: " + err.Error()
+		result.Success = false
+		result.outcome = constants.MetricResultFailed
+		metrics.Payments.WithLabelValues(order.AppID, order.PlanID, constants.MetricResultFailed).Inc()
+		return
+	}
+	result.Success = true
+	result.outcome = constants.MetricResultSuccess
+	uc.log.Infof("Successfully processed auto-renewal payment for user %s, order %s", sub.UID, order.OrderID)
+}
+
+// renewalChargeTime 订阅的续费扣款时间：到期时间减去配置的自动续费提前天数
+// 自动续费和调价通知都按该时间解析续费价格，保证通知的价格就是实际扣款的价格
+func (uc *SubscriptionUsecase) renewalChargeTime(sub *UserSubscription) time.Time {
+	days := constants.DefaultAutoRenewDays
+	if uc.config != nil && uc.config.GetSubscription().GetAutoRenewDaysBefore() > 0 {
+		days = int(uc.config.GetSubscription().GetAutoRenewDaysBefore())
+	}
+	return sub.EndTime.AddDate(0, 0, -days)
+}
+
+// autoRenewBatchSize 自动续费每批处理的订阅数
+func (uc *SubscriptionUsecase) autoRenewBatchSize() int {
+	if uc.config != nil && uc.config.GetSubscription().GetAutoRenewBatchSize() > 0 {
+		return int(uc.config.GetSubscription().GetAutoRenewBatchSize())
+	}
+	return constants.DefaultAutoRenewBatchSize
+}
+
+// autoRenewWorkers 自动续费并发处理的订阅数
+func (uc *SubscriptionUsecase) autoRenewWorkers() int {
+	if uc.config != nil && uc.config.GetSubscription().GetAutoRenewWorkers() > 0 {
+		return min(int(uc.config.GetSubscription().GetAutoRenewWorkers()), constants.MaxAutoRenewWorkers)
+	}
+	return constants.DefaultAutoRenewWorkers
 }
@@ -3,25 +3,42 @@ package biz
 import (
 	"context"
 	"fmt"
+	"math"
 	"time"
 
 	"xinyuan_tech/subscription-service/internal/constants"
 	"xinyuan_tech/subscription-service/internal/errors"
+	"xinyuan_tech/subscription-service/internal/metrics"
 
 	pkgErrors "github.com/gaoyong06/go-pkg/errors"
-	"github.com/gaoyong06/go-pkg/middleware/app_id"
 )
 
 // SubscriptionOrder 简易订单记录 (用于记录订阅购买请求)
 type SubscriptionOrder struct {
-	OrderID       string
-	PaymentID     string // 支付流水号(payment-service返回的payment_id，用于追溯支付记录)
-	UID           string // 用户ID（字符串 UUID）
-	PlanID        string
-	AppID         string // 应用ID
-	Amount        float64
-	PaymentStatus string // pending, success, failed, closed, refunded, partially_refunded (与payment-service保持一致)
-	CreatedAt     time.Time
+	OrderID           string
+	PaymentID         string // 支付流水号(payment-service返回的payment_id，用于追溯支付记录)
+	UID               string // 用户ID（字符串 UUID）
+	PlanID            string
+	AppID             string     // 应用ID
+	CountryCode       string     // 定价地区（国家代码，未识别时为 default）
+	Currency          string     // 币种
+	Subtotal          float64    // 套餐价格
+	DiscountAmount    float64    // 优惠金额
+	ProrationCredit   float64    // 切换套餐时当前周期未使用部分的抵扣金额
+	CreditOrderID     string     // 被抵扣未使用部分的订单（下单时用户订阅的当前生效订单）
+	VatID             string     // 买方 VAT ID（B2B 反向征税）
+	TaxLines          []*TaxLine // 税费明细（创建订单时一并保存）
+	TaxAmount         float64    // 税额
+	Amount            float64    // 实付金额 = 套餐价格 - 优惠 - 抵扣 + 不含税规则加收的税额
+	RefundedAmount    float64    // 累计退款金额
+	PaidAt            time.Time  // 支付成功时间
+	ReportingCurrency string     // 支付时应用的报表币种
+	ExchangeRate      float64    // 支付时订单币种到报表币种的汇率（0 表示支付时没有可用汇率）
+	PeriodStart       time.Time  // 本订单购买的订阅周期（支付成功后记录，终身套餐 PeriodEnd 为零值）
+	PeriodEnd         time.Time
+	PaymentStatus     string // pending, success, failed, closed, refunded, partially_refunded (与payment-service保持一致)
+	Source            string // 订单来源：purchase-购买，comp/manual-赠送或手动开通的零金额记账订单
+	CreatedAt         time.Time
 }
 
 // SubscriptionOrderRepo 订阅订单仓库接口
@@ -29,100 +46,97 @@ type SubscriptionOrderRepo interface {
 	CreateOrder(ctx context.Context, order *SubscriptionOrder) error
 	GetOrder(ctx context.Context, orderID string) (*SubscriptionOrder, error)
 	UpdateOrder(ctx context.Context, order *SubscriptionOrder) error
+	// GetOrderTaxLines 获取订单税费明细
+	GetOrderTaxLines(ctx context.Context, orderID string) ([]*TaxLine, error)
+	// SumRevenue 按订单币种和支付时报表币种汇总应用在 [from, to) 内支付的订单收入（不含零金额订单和赠送、手动开通的订单），appID 为空时统计所有应用
+	SumRevenue(ctx context.Context, appID string, from, to time.Time) ([]*RevenueSummary, error)
+	// ListPaidOrders 获取应用在 from 之后支付的订单（包含已退款订单，不含零金额订单和赠送、手动开通的订单），按支付时间升序
+	ListPaidOrders(ctx context.Context, appID string, from time.Time) ([]*SubscriptionOrder, error)
+	// ListUserOrders 获取用户最近创建的 limit 个订单，按创建时间倒序
+	ListUserOrders(ctx context.Context, uid string, limit int) ([]*SubscriptionOrder, error)
 }
 
 // CreateSubscriptionOrder 创建订阅订单（保持向后兼容）
 // region 参数为可选，如果为空则使用默认值
 func (uc *SubscriptionUsecase) CreateSubscriptionOrder(ctx context.Context, uid string, planID, method, region string) (*SubscriptionOrder, string, string, string, string, error) {
-	return uc.CreateSubscriptionOrderWithContext(ctx, uid, planID, method, region, "", "", "")
+	return uc.CreateSubscriptionOrderWithContext(ctx, uid, planID, method, region, "", "", "", "", "")
 }
 
 // CreateSubscriptionOrderWithContext 创建订阅订单（支持自动地区推断）
+// quoteToken 为 QuoteSubscription 返回的报价 token，传入时按报价金额下单，否则实时报价
 // region 参数为可选，如果为空则自动推断
+// vatID 为买方 VAT ID（可选，用于 B2B 反向征税；携带报价 token 时必须与报价一致）
 // clientIP, acceptLanguage, xLanguage 用于地区推断
-func (uc *SubscriptionUsecase) CreateSubscriptionOrderWithContext(ctx context.Context, uid string, planID, method, region, clientIP, acceptLanguage, xLanguage string) (*SubscriptionOrder, string, string, string, string, error) {
-	uc.log.Infof("CreateSubscriptionOrder: uid=%s, planID=%s, method=%s, region=%s", uid, planID, method, region)
-
-	// 如果 region 为空，自动推断
-	if region == "" {
-		if uc.regionDetectionSvc != nil {
-			detectedRegion, err := uc.regionDetectionSvc.DetectRegion(ctx, uid, clientIP, acceptLanguage, xLanguage)
-			if err != nil {
-				uc.log.Warnf("Failed to detect region, using default: %v", err)
-				region = "default"
-			} else {
-				region = detectedRegion
-				uc.log.Infof("Auto-detected region: %s", region)
-			}
-		} else {
-			// 如果没有配置地区推断服务，使用默认值
-			region = "default"
-			uc.log.Infof("Region detection service not configured, using default region")
-		}
-	} else {
-		// 如果提供了 region，验证是否支持
-		if !constants.SupportedRegions[region] {
-			uc.log.Warnf("Unsupported region: %s, using default", region)
-			region = "default"
-		}
-	}
+func (uc *SubscriptionUsecase) CreateSubscriptionOrderWithContext(ctx context.Context, uid string, planID, method, region, vatID, quoteToken, clientIP, acceptLanguage, xLanguage string) (*SubscriptionOrder, string, string, string, string, error) {
+	uc.log.Infof("CreateSubscriptionOrder: uid=%s, planID=%s, method=%s, region=%s, withQuote=%v", uid, planID, method, region, quoteToken != "")
 
-	// 1. 获取套餐区域定价（从数据库查询，所有价格都在数据库中配置）
-	// region 是国家代码（ISO 3166-1 alpha-2），如 CN, US, DE 等
-	pricing, err := uc.GetPlanPricing(ctx, planID, region)
+	vatID, err := normalizeVatID(ctx, vatID)
 	if err != nil {
-		uc.log.Errorf("Failed to get plan pricing: %v", err)
-		return nil, "", "", "", "", pkgErrors.NewBizErrorWithLang(ctx, errors.ErrCodePlanNotFound)
-	}
-	if pricing == nil {
-		uc.log.Errorf("Plan pricing not found: %s", planID)
-		return nil, "", "", "", "", pkgErrors.NewBizErrorWithLang(ctx, errors.ErrCodePlanNotFound)
+		return nil, "", "", "", "", err
 	}
-	uc.log.Infof("Found plan pricing: countryCode=%s, price=%.2f %s", pricing.CountryCode, pricing.Price, pricing.Currency)
 
-	// 2. 获取 app_id（优先从 Context，由中间件从 Header 提取）
-	appID := app_id.GetAppIDFromContext(ctx)
-	if appID == "" {
-		uc.log.Errorf("app_id is required, please provide X-App-Id header")
-		return nil, "", "", "", "", pkgErrors.NewBizErrorWithLang(ctx, pkgErrors.ErrCodeInvalidArgument)
-	}
-
-	// 3. 获取套餐信息（用于获取名称等信息，并验证 app_id 是否匹配）
-	plan, err := uc.planRepo.GetPlan(ctx, planID)
+	// 1. 校验套餐、应用以及用户当前订阅是否允许购买
+	plan, current, err := uc.checkPurchasable(ctx, uid, planID)
 	if err != nil {
-		uc.log.Errorf("Failed to get plan: %v", err)
-		return nil, "", "", "", "", pkgErrors.NewBizErrorWithLang(ctx, errors.ErrCodePlanNotFound)
-	}
-	if plan == nil {
-		uc.log.Errorf("Plan not found: %s", planID)
-		return nil, "", "", "", "", pkgErrors.NewBizErrorWithLang(ctx, errors.ErrCodePlanNotFound)
+		return nil, "", "", "", "", err
 	}
 
-	// 4. 验证 app_id 是否与 plan 的 app_id 匹配（数据一致性校验）
-	if plan.AppID != "" && plan.AppID != appID {
-		uc.log.Errorf("app_id mismatch: plan %s belongs to app %s, but request app_id is %s", planID, plan.AppID, appID)
-		return nil, "", "", "", "", pkgErrors.NewBizErrorWithLang(ctx, pkgErrors.ErrCodeInvalidArgument)
+	// 2. 确定扣款金额：携带报价 token 时按用户看到的报价扣款，否则实时报价
+	// 定价地区是国家代码（ISO 3166-1 alpha-2，如 CN, US, DE）或地区组代码（如 EU）
+	// 国家没有单独定价时使用所属地区组的定价，都没有时使用套餐默认价格
+	var quote *Quote
+	if quoteToken != "" {
+		// 报价已绑定当前订阅状态，有效期内按签名的抵扣、税费和实付金额扣款，与用户确认的金额一致
+		quote, err = uc.verifyQuote(ctx, quoteToken, uid, planID, vatID, current)
+	} else {
+		region, source := uc.resolveRegion(ctx, uid, region, clientIP, acceptLanguage, xLanguage)
+		quote, err = uc.buildQuote(ctx, uid, plan, current, region, source, vatID, time.Now().UTC())
 	}
+	if err != nil {
+		return nil, "", "", "", "", err
+	}
+	uc.log.Infof("Order pricing: region=%s, pricing=%s, total=%.2f %s", quote.Region, quote.PricingRegion, quote.Total, quote.Currency)
 
-	// 5. 创建本地订单
-	orderID := fmt.Sprintf("SUB%d%s", time.Now().UnixNano(), uid[:8]) // 使用 uid 的前8位
+	// 3. 创建本地订单（税费明细随订单一起保存）
+	orderID := newOrderID(uid)
 	order := &SubscriptionOrder{
-		OrderID:       orderID,
-		PaymentID:     "", // 初始为空，调用支付服务后更新
-		UID:           uid,
-		PlanID:        planID,
-		AppID:         appID, // 使用从 Context 获取的 app_id
-		Amount:        pricing.Price,
-		PaymentStatus: constants.PaymentStatusPending,
-		CreatedAt:     time.Now().UTC(),
+		OrderID:         orderID,
+		PaymentID:       "", // 初始为空，调用支付服务后更新
+		UID:             uid,
+		PlanID:          planID,
+		AppID:           quote.AppID, // 使用从 Context 获取的 app_id
+		CountryCode:     quote.Region,
+		Currency:        quote.Currency,
+		Subtotal:        quote.Subtotal,
+		DiscountAmount:  quote.Discount,
+		ProrationCredit: quote.ProrationCredit,
+		CreditOrderID:   quote.CreditOrderID,
+		VatID:           quote.VatID,
+		TaxLines:        quote.TaxLines,
+		TaxAmount:       quote.Tax,
+		Amount:          quote.Total,
+		PaymentStatus:   constants.PaymentStatusPending,
+		Source:          constants.SourcePurchase,
+		CreatedAt:       time.Now().UTC(),
 	}
 	if err := uc.orderRepo.CreateOrder(ctx, order); err != nil {
 		uc.log.Errorf("Failed to create order: %v", err)
 		return nil, "", "", "", "", pkgErrors.NewBizErrorWithLang(ctx, errors.ErrCodeOrderCreateFailed)
 	}
 	uc.log.Infof("Created order: %s", orderID)
+	metrics.OrdersCreated.WithLabelValues(order.AppID, order.PlanID).Inc()
 
-	// 6. 调用支付服务
+	// 4. 抵扣后无需支付的订单直接完成
+	if order.Amount <= 0 {
+		if err := uc.HandlePaymentSuccess(ctx, orderID, 0); err != nil {
+			uc.log.Errorf("Failed to complete zero-amount order %s: %v", orderID, err)
+			return nil, "", "", "", "", err
+		}
+		order.PaymentStatus = constants.PaymentStatusSuccess
+		return order, "", "", "", "", nil
+	}
+
+	// 5. 调用支付服务
 	// 从配置中获取 ReturnURL
 	returnURL := ""
 	if uc.config != nil && uc.config.GetSubscription() != nil {
@@ -138,16 +152,17 @@ func (uc *SubscriptionUsecase) CreateSubscriptionOrderWithContext(ctx context.Co
 		subject = "Subscription: " + plan.Name
 	}
 
-	uc.log.Infof("Calling payment service: orderID=%s, appID=%s, amount=%.2f %s, method=%s", orderID, appID, pricing.Price, pricing.Currency, method)
+	uc.log.Infof("Calling payment service: orderID=%s, appID=%s, amount=%.2f %s, method=%s", orderID, order.AppID, order.Amount, order.Currency, method)
 	// 注意：appId 现在只从 Context 获取（由中间件从 Header/metadata 提取），不再作为参数传递
-	paymentID, payUrl, payCode, payParams, err := uc.paymentClient.CreatePayment(ctx, orderID, uid, pricing.Price, pricing.Currency, method, subject, returnURL)
+	paymentID, payUrl, payCode, payParams, err := uc.paymentClient.CreatePayment(ctx, orderID, uid, order.Amount, order.Currency, method, subject, returnURL)
 	if err != nil {
 		uc.log.Errorf("Failed to create payment: %v", err)
+		metrics.Payments.WithLabelValues(order.AppID, order.PlanID, constants.MetricResultFailed).Inc()
 		return nil, "", "", "", "", pkgErrors.NewBizErrorWithLang(ctx, errors.ErrCodePaymentFailed)
 	}
 	uc.log.Infof("Payment created: paymentID=%s", paymentID)
 
-	// 7. 更新订单，保存 payment_id
+	// 6. 更新订单，保存 payment_id
 	order.PaymentID = paymentID
 	if err := uc.orderRepo.UpdateOrder(ctx, order); err != nil {
 		uc.log.Errorf("Failed to update order with payment_id: %v", err)
@@ -161,8 +176,20 @@ func (uc *SubscriptionUsecase) CreateSubscriptionOrderWithContext(ctx context.Co
 func (uc *SubscriptionUsecase) HandlePaymentSuccess(ctx context.Context, orderID string, amount float64) error {
 	uc.log.Infof("HandlePaymentSuccess: orderID=%s, amount=%.2f", orderID, amount)
 
-	// 使用事务确保数据一致性
-	return uc.withTransaction(ctx, func(ctx context.Context) error {
+	// 先获取订单所属用户，持有该用户的锁处理
+	uid, err := uc.orderUID(ctx, orderID)
+	if err != nil {
+		return err
+	}
+
+	// 本次回调完成支付的订单和订阅操作（事务提交后记录监控指标，幂等回调不重复记录）
+	var paidOrder *SubscriptionOrder
+	var paidAction string
+	var refundOrder *SubscriptionOrder
+
+	// 持有用户锁在事务中执行，版本冲突时重新读取订单和订阅后重试
+	err = uc.mutateSubscription(ctx, uid, func(ctx context.Context) error {
+		paidOrder, paidAction, refundOrder = nil, "", nil
 		// 1. 获取订单
 		order, err := uc.orderRepo.GetOrder(ctx, orderID)
 		if err != nil {
@@ -171,43 +198,71 @@ func (uc *SubscriptionUsecase) HandlePaymentSuccess(ctx context.Context, orderID
 		}
 		if order.PaymentStatus == constants.PaymentStatusSuccess {
 			uc.log.Infof("Order already paid, skipping (idempotent)")
-			return nil // 幂等
+			// 幂等；上次回调开票失败时补开发票
+			return uc.ensureInvoice(ctx, order)
 		}
-
-		// 2. 更新订单状态
-		order.PaymentStatus = constants.PaymentStatusSuccess
-		if err := uc.orderRepo.UpdateOrder(ctx, order); err != nil {
-			uc.log.Errorf("Failed to update order: %v", err)
-			return err
+		if order.PaymentStatus == constants.PaymentStatusRefundRequired {
+			uc.log.Infof("Order already marked as refund required, skipping (idempotent)")
+			return nil
 		}
-		uc.log.Infof("Order updated to paid status")
 
-		// 3. 获取套餐时长
+		// 2. 获取套餐计费周期
 		plan, err := uc.planRepo.GetPlan(ctx, order.PlanID)
 		if err != nil {
 			uc.log.Errorf("Failed to get plan: %v", err)
 			return pkgErrors.NewBizErrorWithLang(ctx, errors.ErrCodePlanNotFound)
 		}
-		uc.log.Infof("Found plan: %s, duration: %d days", plan.Name, plan.DurationDays)
+		interval := plan.BillingInterval()
+		uc.log.Infof("Found plan: %s, billing type: %s, interval: %d %s", plan.Name, plan.BillingType, interval.Count, interval.Unit)
 
-		// 4. 更新或创建用户订阅
+		// 3. 更新或创建用户订阅
 		sub, err := uc.subRepo.GetSubscription(ctx, order.UID)
+		if err != nil {
+			uc.log.Errorf("Failed to get subscription: %v", err)
+			return err
+		}
+		before := snapshot(sub)
 		now := time.Now().UTC()
+		// 有切换套餐抵扣的订单从当前时间开始新周期（未使用部分已折算抵扣）
+		prorated := order.ProrationCredit > 0
 
+		order.PaymentStatus = constants.PaymentStatusSuccess
+		uc.recordPaymentRate(ctx, order, now)
+		if prorated && order.CreditOrderID != "" && (sub == nil || sub.OrderID != order.CreditOrderID) {
+			// 被抵扣的订单已不是当前生效订单（如抵扣已被同时下单的另一个订单使用），不开通，订单标记为需要退款
+			uc.log.Errorf("Credited order %s of order %s is no longer current for user %s, order requires refund", order.CreditOrderID, order.OrderID, order.UID)
+			order.PaymentStatus = constants.PaymentStatusRefundRequired
+			if err := uc.orderRepo.UpdateOrder(ctx, order); err != nil {
+				return err
+			}
+			refundOrder = order
+			return nil
+		}
 		if sub == nil {
 			// 新订阅
 			uc.log.Infof("Creating new subscription for user %s", order.UID)
 			sub = &UserSubscription{
-				UID:       order.UID,
-				PlanID:    order.PlanID,
-				AppID:     order.AppID, // 从订单中获取 app_id
-				StartTime: now,
-				EndTime:   now.AddDate(0, 0, plan.DurationDays),
-				Status:    constants.StatusActive,
-				OrderID:   order.OrderID,
-				CreatedAt: now,
-				UpdatedAt: now,
+				UID:         order.UID,
+				PlanID:      order.PlanID,
+				AppID:       order.AppID, // 从订单中获取 app_id
+				CountryCode: order.CountryCode,
+				Status:      constants.StatusActive,
+				OrderID:     order.OrderID,
+				Source:      constants.SourcePurchase,
+				CreatedAt:   now,
+				UpdatedAt:   now,
 			}
+			sub.StartTime, sub.EndTime, sub.BillingAnchor = purchasePeriod(nil, plan, prorated, now)
+			order.PeriodStart, order.PeriodEnd = sub.StartTime, sub.EndTime
+		} else if uc.holdsLifetimePlan(ctx, sub) && !plan.IsLifetime() {
+			// 终身订阅用户支付了周期套餐（如下单前已开通终身），保持终身订阅不降级，订单标记为需要退款（不开具发票、不计入收入）
+			uc.log.Errorf("User %s already has lifetime subscription %s, plan %s not granted, order %s requires refund", order.UID, sub.PlanID, order.PlanID, order.OrderID)
+			order.PaymentStatus = constants.PaymentStatusRefundRequired
+			if err := uc.orderRepo.UpdateOrder(ctx, order); err != nil {
+				return err
+			}
+			refundOrder = order
+			return nil
 		} else {
 			// 续费
 			uc.log.Infof("Renewing subscription for user %s, current end time: %v", order.UID, sub.EndTime)
@@ -215,50 +270,210 @@ func (uc *SubscriptionUsecase) HandlePaymentSuccess(ctx context.Context, orderID
 			if sub.AppID == "" || sub.AppID != order.AppID {
 				sub.AppID = order.AppID
 			}
-			if sub.EndTime.Before(now) {
-				sub.StartTime = now
-				sub.EndTime = now.AddDate(0, 0, plan.DurationDays)
-			} else {
-				sub.EndTime = sub.EndTime.AddDate(0, 0, plan.DurationDays)
+			// 本订单购买的周期：在未过期的订阅上续期时从原结束时间开始，否则从当前时间开始
+			order.PeriodStart = now
+			if !plan.IsLifetime() && !prorated && !sub.IsLifetime() && !sub.EndTime.Before(now) {
+				order.PeriodStart = sub.EndTime
+			}
+			if plan.IsLifetime() {
+				// 升级为终身订阅：永不过期，关闭自动续费
+				sub.IsAutoRenew = false
 			}
+			sub.StartTime, sub.EndTime, sub.BillingAnchor = purchasePeriod(sub, plan, prorated, now)
+			order.PeriodEnd = sub.EndTime
 			sub.PlanID = order.PlanID // 更新为最新购买的套餐
+			sub.CountryCode = order.CountryCode
 			sub.Status = constants.StatusActive
 			sub.OrderID = order.OrderID // 更新为最新订单ID
+			sub.Source = constants.SourcePurchase
+			sub.NextPlanID = "" // 已按购买的套餐续费，清除安排的套餐迁移
 			sub.UpdatedAt = now
 		}
 
+		// 4. 更新订单状态，记录本订单购买的订阅周期
+		if err := uc.orderRepo.UpdateOrder(ctx, order); err != nil {
+			uc.log.Errorf("Failed to update order: %v", err)
+			return err
+		}
+		uc.log.Infof("Order updated to paid status")
+
 		if err := uc.subRepo.SaveSubscription(ctx, sub); err != nil {
 			uc.log.Errorf("Failed to save subscription: %v", err)
 			return err
 		}
 		uc.log.Infof("Subscription saved successfully, new end time: %v", sub.EndTime)
 
-		// 记录历史
+		// 记录历史（保存后新订阅也有订阅ID，按修改前是否有订阅区分创建和续费）
 		action := constants.ActionCreated
-		if sub.SubscriptionID > 0 {
+		if before != nil {
 			action = constants.ActionRenewed
 		}
-		history := &SubscriptionHistory{
-			UID:       order.UID,
-			PlanID:    plan.PlanID,
-			PlanName:  plan.Name,
-			AppID:     plan.AppID,
-			StartTime: sub.StartTime,
-			EndTime:   sub.EndTime,
-			Status:    sub.Status,
-			Action:    action,
-			CreatedAt: now,
+		if err := uc.recordHistory(ctx, action, before, sub, "", now); err != nil {
+			return err
+		}
+		paidOrder, paidAction = order, action
+
+		// 5. 开具发票（记录本订单购买的周期、优惠、抵扣和税费明细）
+		return uc.ensureInvoice(ctx, order)
+	})
+	if err == nil && refundOrder != nil {
+		metrics.Payments.WithLabelValues(refundOrder.AppID, refundOrder.PlanID, constants.MetricResultRefundRequired).Inc()
+	}
+	if err == nil && paidOrder != nil {
+		metrics.Payments.WithLabelValues(paidOrder.AppID, paidOrder.PlanID, constants.MetricResultSuccess).Inc()
+		if paidAction == constants.ActionRenewed {
+			metrics.Renewals.WithLabelValues(paidOrder.AppID, paidOrder.PlanID).Inc()
+		}
+	}
+	return err
+}
+
+// HandlePaymentRefund 处理退款回调
+// 全额退款且退款订单为当前生效订单时，立即结束订阅并回落到应用的默认免费套餐；部分退款只更新订单状态
+func (uc *SubscriptionUsecase) HandlePaymentRefund(ctx context.Context, orderID string, amount float64) error {
+	uc.log.Infof("HandlePaymentRefund: orderID=%s, amount=%.2f", orderID, amount)
+
+	uid, err := uc.orderUID(ctx, orderID)
+	if err != nil {
+		return err
+	}
+	// 持有用户锁在事务中执行，版本冲突时重新读取订单和订阅后重试
+	return uc.mutateSubscription(ctx, uid, func(ctx context.Context) error {
+		order, err := uc.orderRepo.GetOrder(ctx, orderID)
+		if err != nil {
+			uc.log.Errorf("Failed to get order: %v", err)
+			return pkgErrors.NewBizErrorWithLang(ctx, errors.ErrCodeOrderNotFound)
+		}
+		if order.PaymentStatus == constants.PaymentStatusRefunded {
+			uc.log.Infof("Order already refunded, skipping (idempotent)")
+			return nil // 幂等
+		}
+		if order.PaymentStatus != constants.PaymentStatusSuccess && order.PaymentStatus != constants.PaymentStatusPartiallyRefunded &&
+			order.PaymentStatus != constants.PaymentStatusRefundRequired {
+			return pkgErrors.NewBizErrorWithLang(ctx, errors.ErrCodeOrderNotPaid)
+		}
+
+		// 多次部分退款累计达到订单金额时按全额退款处理
+		fullRefund := amount <= 0 || roundAmount(order.RefundedAmount+amount) >= order.Amount
+		// 需要退款的订单部分退款后仍保持需要退款状态，直到全额退款
+		if order.PaymentStatus != constants.PaymentStatusRefundRequired {
+			order.PaymentStatus = constants.PaymentStatusPartiallyRefunded
+		}
+		order.RefundedAmount = roundAmount(math.Min(order.RefundedAmount+amount, order.Amount))
+		if fullRefund {
+			order.PaymentStatus = constants.PaymentStatusRefunded
+			order.RefundedAmount = order.Amount
+		}
+		if err := uc.orderRepo.UpdateOrder(ctx, order); err != nil {
+			uc.log.Errorf("Failed to update order: %v", err)
+			return err
+		}
+		// 开具红字发票冲减原发票
+		if err := uc.issueCreditNote(ctx, order, amount, fullRefund); err != nil {
+			return err
+		}
+		if !fullRefund {
+			uc.log.Infof("Order %s partially refunded, subscription unchanged", orderID)
+			return nil
+		}
+
+		sub, err := uc.subRepo.GetSubscription(ctx, order.UID)
+		if err != nil {
+			uc.log.Errorf("Failed to get subscription: %v", err)
+			return err
+		}
+		if sub == nil || sub.OrderID != order.OrderID {
+			// 退款的不是当前生效的订单（如历史续费订单），不影响当前订阅
+			uc.log.Infof("Refunded order %s is not the current order of user %s, subscription unchanged", orderID, order.UID)
+			return nil
+		}
+
+		now := time.Now().UTC()
+		before := snapshot(sub)
+		sub.Status = constants.StatusCancelled
+		sub.EndTime = now
+		sub.IsAutoRenew = false
+		sub.UpdatedAt = now
+		if err := uc.subRepo.SaveSubscription(ctx, sub); err != nil {
+			uc.log.Errorf("Failed to save subscription: %v", err)
+			return err
 		}
-		if err := uc.historyRepo.AddSubscriptionHistory(ctx, history); err != nil {
-			uc.log.Errorf("Failed to add subscription history: %v", err)
-			// 不影响主流程，只记录日志
+
+		if err := uc.recordHistory(ctx, constants.ActionCancelled, before, sub, "refund", now); err != nil {
+			return err
 		}
 
+		if _, err := uc.downgradeToFree(ctx, sub, now); err != nil {
+			return err
+		}
+		uc.log.Infof("Subscription of user %s ended by refund of order %s", sub.UID, orderID)
 		return nil
 	})
 }
 
-// withTransaction 执行事务
+// newOrderID 生成订单号（SUB + 纳秒时间戳 + uid 的前8位）
+func newOrderID(uid string) string {
+	if len(uid) > 8 {
+		uid = uid[:8]
+	}
+	return fmt.Sprintf("SUB%d%s", time.Now().UnixNano(), uid)
+}
+
+// orderUID 获取订单所属用户（用于获取用户订阅锁）
+func (uc *SubscriptionUsecase) orderUID(ctx context.Context, orderID string) (string, error) {
+	order, err := uc.orderRepo.GetOrder(ctx, orderID)
+	if err != nil || order == nil {
+		uc.log.Errorf("Failed to get order %s: %v", orderID, err)
+		return "", pkgErrors.NewBizErrorWithLang(ctx, errors.ErrCodeOrderNotFound)
+	}
+	return order.UID, nil
+}
+
+// holdsLifetimePlan 用户是否持有终身套餐（暂停不影响持有，已取消的不再算持有）
+// 免费套餐订阅同样没有结束时间，需要通过套餐的计费类型区分
+func (uc *SubscriptionUsecase) holdsLifetimePlan(ctx context.Context, sub *UserSubscription) bool {
+	if sub == nil || !sub.IsLifetime() || sub.Status == constants.StatusCancelled {
+		return false
+	}
+	plan, err := uc.planRepo.GetPlan(ctx, sub.PlanID)
+	if err != nil {
+		return false
+	}
+	return plan.IsLifetime()
+}
+
+// nextBillingPeriod 计算续费后的订阅周期（开始时间、结束时间、计费锚点）
+//   - 已过期：从当前时间重新开始，锚点重置为当前时间
+//   - 未过期且续费同一套餐：按原锚点推算下一个周期结束时间
+//   - 未过期且切换套餐：从当前周期结束时开始新套餐的周期，锚点重置为该时间
+func nextBillingPeriod(sub *UserSubscription, planID string, interval BillingInterval, now time.Time) (start, end, anchor time.Time) {
+	if sub.IsLifetime() || sub.EndTime.Before(now) {
+		// 已过期，或终身订阅已取消后重新购买周期套餐
+		return now, interval.PeriodEnd(now, 1), now
+	}
+	if sub.PlanID != planID || sub.BillingAnchor.IsZero() {
+		// 切换套餐，或历史订阅没有记录锚点
+		return sub.StartTime, interval.PeriodEnd(sub.EndTime, 1), sub.EndTime
+	}
+	return sub.StartTime, interval.NextPeriodEnd(sub.BillingAnchor, sub.EndTime), sub.BillingAnchor
+}
+
+// purchasePeriod 计算购买套餐后的订阅周期（开始时间、结束时间、计费锚点）
+// 终身套餐没有结束时间和计费锚点；新订阅或有切换套餐抵扣时从当前时间开始新周期，否则在当前订阅上续期
+func purchasePeriod(sub *UserSubscription, plan *Plan, prorated bool, now time.Time) (start, end, anchor time.Time) {
+	if plan.IsLifetime() {
+		if sub != nil && sub.Status == constants.StatusActive && !sub.IsExpiredAt(now) {
+			return sub.StartTime, time.Time{}, time.Time{}
+		}
+		return now, time.Time{}, time.Time{}
+	}
+	interval := plan.BillingInterval()
+	if sub == nil || prorated {
+		return now, interval.PeriodEnd(now, 1), now
+	}
+	return nextBillingPeriod(sub, plan.PlanID, interval, now)
+}
+
 // withTransaction 执行事务
 func (uc *SubscriptionUsecase) withTransaction(ctx context.Context, fn func(context.Context) error) error {
 	return uc.tm.Exec(ctx, fn)
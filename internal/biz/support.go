@@ -0,0 +1,190 @@
+package biz
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"xinyuan_tech/subscription-service/internal/audit"
+	"xinyuan_tech/subscription-service/internal/constants"
+	"xinyuan_tech/subscription-service/internal/errors"
+
+	pkgErrors "github.com/gaoyong06/go-pkg/errors"
+)
+
+// SubscriptionSearchQuery 客服订阅查询条件（字段为空表示不过滤）
+type SubscriptionSearchQuery struct {
+	UID       string
+	AppID     string
+	PlanID    string
+	Status    string
+	TimeField string    // 时间范围作用的字段：start_time, end_time, created_at, updated_at，默认 created_at
+	From      time.Time // 时间范围 [From, To)，零值表示不限
+	To        time.Time
+	Page      int
+	PageSize  int
+}
+
+// UserTimeline 用户订阅时间线（客服查看）
+type UserTimeline struct {
+	Subscription *UserSubscription      // 当前订阅，没有订阅时为 nil
+	Orders       []*SubscriptionOrder   // 最近的订单（含支付和退款信息），按创建时间倒序
+	History      []*SubscriptionHistory // 最近的订阅历史，按时间倒序
+	Events       []*TimelineEvent       // 订单和历史合并后的事件，按时间倒序
+}
+
+// TimelineEvent 时间线事件
+type TimelineEvent struct {
+	Time       time.Time
+	Type       string // order_created, order_paid, history
+	Action     string // 订阅历史的操作类型
+	PlanID     string
+	OrderID    string
+	Status     string // 订单支付状态或订阅状态
+	Amount     float64
+	Currency   string
+	ActorType  string // 订阅历史的操作人类型
+	OperatorID string
+	Reason     string
+}
+
+// SearchSubscriptions 客服按用户、应用、套餐、状态和时间范围查询订阅
+func (uc *SubscriptionUsecase) SearchSubscriptions(ctx context.Context, query *SubscriptionSearchQuery) ([]*UserSubscription, int, error) {
+	uc.log.Infof("SearchSubscriptions: uid=%s, appID=%s, planID=%s, status=%s, timeField=%s, from=%v, to=%v",
+		query.UID, query.AppID, query.PlanID, query.Status, query.TimeField, query.From, query.To)
+
+	switch query.TimeField {
+	case "":
+		query.TimeField = constants.SupportTimeFieldCreated
+	case constants.SupportTimeFieldStart, constants.SupportTimeFieldEnd, constants.SupportTimeFieldCreated, constants.SupportTimeFieldUpdated:
+	default:
+		return nil, 0, pkgErrors.NewBizErrorWithLang(ctx, errors.ErrCodeSearchRangeInvalid)
+	}
+	if !query.From.IsZero() && !query.To.IsZero() && !query.From.Before(query.To) {
+		return nil, 0, pkgErrors.NewBizErrorWithLang(ctx, errors.ErrCodeSearchRangeInvalid)
+	}
+
+	subscriptions, total, err := uc.subRepo.SearchSubscriptions(ctx, query)
+	if err != nil {
+		uc.log.Errorf("Failed to search subscriptions: %v", err)
+		return nil, 0, err
+	}
+	return subscriptions, total, nil
+}
+
+// GetUserTimeline 获取用户的当前订阅、最近订单和订阅历史，并按时间合并为事件
+func (uc *SubscriptionUsecase) GetUserTimeline(ctx context.Context, uid string) (*UserTimeline, error) {
+	uc.log.Infof("GetUserTimeline: uid=%s", uid)
+
+	sub, err := uc.subRepo.GetSubscription(ctx, uid)
+	if err != nil {
+		uc.log.Errorf("Failed to get subscription: %v", err)
+		return nil, err
+	}
+	orders, err := uc.orderRepo.ListUserOrders(ctx, uid, constants.SupportTimelineLimit)
+	if err != nil {
+		return nil, err
+	}
+	history, err := uc.historyRepo.ListSubscriptionHistory(ctx, &HistoryFilter{UID: uid}, 0, 0, constants.SupportTimelineLimit)
+	if err != nil {
+		uc.log.Errorf("Failed to get subscription history: %v", err)
+		return nil, err
+	}
+
+	events := make([]*TimelineEvent, 0, len(orders)*2+len(history))
+	for _, order := range orders {
+		events = append(events, &TimelineEvent{
+			Time:     order.CreatedAt,
+			Type:     constants.TimelineEventOrderCreated,
+			PlanID:   order.PlanID,
+			OrderID:  order.OrderID,
+			Status:   order.PaymentStatus,
+			Amount:   order.Amount,
+			Currency: order.Currency,
+		})
+		if !order.PaidAt.IsZero() {
+			events = append(events, &TimelineEvent{
+				Time:     order.PaidAt,
+				Type:     constants.TimelineEventOrderPaid,
+				PlanID:   order.PlanID,
+				OrderID:  order.OrderID,
+				Status:   order.PaymentStatus,
+				Amount:   order.Amount,
+				Currency: order.Currency,
+			})
+		}
+	}
+	for _, h := range history {
+		events = append(events, &TimelineEvent{
+			Time:       h.CreatedAt,
+			Type:       constants.TimelineEventHistory,
+			Action:     h.Action,
+			PlanID:     h.PlanID,
+			Status:     h.Status,
+			ActorType:  h.ActorType,
+			OperatorID: h.OperatorID,
+			Reason:     h.Reason,
+		})
+	}
+	sort.SliceStable(events, func(i, j int) bool { return events[i].Time.After(events[j].Time) })
+
+	return &UserTimeline{Subscription: sub, Orders: orders, History: history, Events: events}, nil
+}
+
+// AdjustSubscription 客服延长（days > 0）或缩短（days < 0）用户订阅的结束时间，记录操作人和原因
+// 延长已过期（未回落到免费套餐）的订阅且新的结束时间晚于当前时间时重新激活；缩短到当前时间之前的订阅由过期检查任务处理
+func (uc *SubscriptionUsecase) AdjustSubscription(ctx context.Context, uid string, days int, operatorID, reason string) (*UserSubscription, error) {
+	uc.log.Infof("AdjustSubscription: uid=%s, days=%d, operator=%s, reason=%s", uid, days, operatorID, reason)
+	// 调整天数不能为 0，单次延长或缩短不超过 MaxSupportAdjustDays 天
+	if days == 0 || days > constants.MaxSupportAdjustDays || days < -constants.MaxSupportAdjustDays {
+		return nil, pkgErrors.NewBizErrorWithLang(ctx, errors.ErrCodeAdjustmentInvalid)
+	}
+	ctx = audit.WithActor(ctx, constants.ActorTypeAdmin, operatorID)
+
+	var adjusted *UserSubscription
+	err := uc.mutateSubscription(ctx, uid, func(ctx context.Context) error {
+		sub, err := uc.subRepo.GetSubscription(ctx, uid)
+		if err != nil {
+			uc.log.Errorf("Failed to get subscription: %v", err)
+			return err
+		}
+		if sub == nil {
+			return pkgErrors.NewBizErrorWithLang(ctx, errors.ErrCodeSubscriptionNotFound)
+		}
+		// 终身订阅和免费套餐没有结束时间，不能调整
+		if sub.IsLifetime() {
+			return pkgErrors.NewBizErrorWithLang(ctx, errors.ErrCodeAdjustmentInvalid)
+		}
+		endTime := sub.EndTime.AddDate(0, 0, days)
+		if !endTime.After(sub.StartTime) {
+			return pkgErrors.NewBizErrorWithLang(ctx, errors.ErrCodeAdjustmentInvalid)
+		}
+
+		now := time.Now().UTC()
+		before := snapshot(sub)
+		sub.shiftEndTime(days)
+		if sub.Status == constants.StatusExpired && endTime.After(now) {
+			sub.Status = constants.StatusActive
+		}
+		sub.UpdatedAt = now
+		if err := uc.subRepo.SaveSubscription(ctx, sub); err != nil {
+			uc.log.Errorf("Failed to save subscription: %v", err)
+			return err
+		}
+
+		action := constants.ActionExtended
+		if days < 0 {
+			action = constants.ActionShortened
+		}
+		if err := uc.recordHistory(ctx, action, before, sub, reason, now); err != nil {
+			return err
+		}
+		adjusted = sub
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	uc.log.Infof("Subscription of user %s adjusted by %d days to %v by %s", uid, days, adjusted.EndTime, operatorID)
+	return adjusted, nil
+}
@@ -0,0 +1,168 @@
+package biz
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"xinyuan_tech/subscription-service/internal/errors"
+
+	pkgErrors "github.com/gaoyong06/go-pkg/errors"
+)
+
+// TaxRule 国家税务规则（如增值税 VAT、消费税 GST）
+type TaxRule struct {
+	TaxRuleID     uint64
+	CountryCode   string  // ISO 3166-1 alpha-2 国家代码
+	Name          string  // 税种名称，如 VAT、GST
+	Rate          float64 // 税率（0.2 表示 20%）
+	Inclusive     bool    // 定价是否含税：含税时从价格中拆出税额，不含税时在价格之上加收
+	ReverseCharge bool    // 是否支持 B2B 反向征税（买方提供 VAT ID 时不收税，由买方自行申报）
+	Enabled       bool
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+// TaxLine 订单税费明细
+type TaxLine struct {
+	Name          string
+	CountryCode   string
+	Rate          float64
+	Inclusive     bool
+	ReverseCharge bool    // 是否反向征税（税额为 0）
+	TaxableAmount float64 // 计税金额（不含税）
+	Amount        float64 // 税额
+}
+
+// TaxRuleRepo 税务规则仓库接口
+type TaxRuleRepo interface {
+	// ListTaxRules 获取税务规则，countryCode 为空时返回所有国家
+	ListTaxRules(ctx context.Context, countryCode string) ([]*TaxRule, error)
+	// ListEnabledTaxRules 获取国家启用中的税务规则
+	ListEnabledTaxRules(ctx context.Context, countryCode string) ([]*TaxRule, error)
+	GetTaxRule(ctx context.Context, taxRuleID uint64) (*TaxRule, error)
+	CreateTaxRule(ctx context.Context, rule *TaxRule) error
+	UpdateTaxRule(ctx context.Context, rule *TaxRule) error
+	DeleteTaxRule(ctx context.Context, taxRuleID uint64) error
+}
+
+// ListTaxRules 获取税务规则
+func (uc *SubscriptionUsecase) ListTaxRules(ctx context.Context, countryCode string) ([]*TaxRule, error) {
+	return uc.taxRuleRepo.ListTaxRules(ctx, strings.ToUpper(countryCode))
+}
+
+// CreateTaxRule 创建税务规则
+func (uc *SubscriptionUsecase) CreateTaxRule(ctx context.Context, rule *TaxRule) error {
+	rule.CountryCode = strings.ToUpper(rule.CountryCode)
+	if !isCountryCode(rule.CountryCode) || rule.Name == "" || rule.Rate < 0 || rule.Rate >= 1 {
+		return pkgErrors.NewBizErrorWithLang(ctx, errors.ErrCodeTaxRuleInvalid)
+	}
+	return uc.taxRuleRepo.CreateTaxRule(ctx, rule)
+}
+
+// UpdateTaxRule 更新税务规则（只影响之后的报价和订单，已创建订单的税费明细不变）
+func (uc *SubscriptionUsecase) UpdateTaxRule(ctx context.Context, rule *TaxRule) error {
+	existing, err := uc.taxRuleRepo.GetTaxRule(ctx, rule.TaxRuleID)
+	if err != nil || existing == nil {
+		return pkgErrors.NewBizErrorWithLang(ctx, errors.ErrCodeTaxRuleNotFound)
+	}
+	if rule.Name == "" || rule.Rate < 0 || rule.Rate >= 1 {
+		return pkgErrors.NewBizErrorWithLang(ctx, errors.ErrCodeTaxRuleInvalid)
+	}
+	rule.CountryCode = existing.CountryCode
+	rule.CreatedAt = existing.CreatedAt
+	return uc.taxRuleRepo.UpdateTaxRule(ctx, rule)
+}
+
+// DeleteTaxRule 删除税务规则
+func (uc *SubscriptionUsecase) DeleteTaxRule(ctx context.Context, taxRuleID uint64) error {
+	existing, err := uc.taxRuleRepo.GetTaxRule(ctx, taxRuleID)
+	if err != nil || existing == nil {
+		return pkgErrors.NewBizErrorWithLang(ctx, errors.ErrCodeTaxRuleNotFound)
+	}
+	return uc.taxRuleRepo.DeleteTaxRule(ctx, taxRuleID)
+}
+
+// calculateTax 按国家税务规则计算税费
+// amount 为扣除优惠和抵扣后的应付金额：含税规则从中拆出税额，不含税规则在计税金额之上加收；
+// 提供 VAT ID 且规则支持反向征税时不收税（含税定价只收取不含税部分）
+// 返回税费明细、税额合计和实付金额；非国家代码（地区组或 default）不计税
+func (uc *SubscriptionUsecase) calculateTax(ctx context.Context, countryCode, vatID string, amount float64) ([]*TaxLine, float64, float64, error) {
+	if !isCountryCode(countryCode) || amount <= 0 {
+		return nil, 0, amount, nil
+	}
+	rules, err := uc.taxRuleRepo.ListEnabledTaxRules(ctx, countryCode)
+	if err != nil {
+		uc.log.Errorf("Failed to get tax rules of %s: %v", countryCode, err)
+		return nil, 0, 0, err
+	}
+	if len(rules) == 0 {
+		return nil, 0, amount, nil
+	}
+
+	inclusiveRate := 0.0
+	for _, r := range rules {
+		if r.Inclusive {
+			inclusiveRate += r.Rate
+		}
+	}
+	taxable := roundAmount(amount / (1 + inclusiveRate))
+
+	lines := make([]*TaxLine, 0, len(rules))
+	tax := 0.0
+	reverseCharged := false
+	for _, r := range rules {
+		line := &TaxLine{
+			Name:          r.Name,
+			CountryCode:   r.CountryCode,
+			Rate:          r.Rate,
+			Inclusive:     r.Inclusive,
+			ReverseCharge: r.ReverseCharge && vatID != "",
+			TaxableAmount: taxable,
+		}
+		if line.ReverseCharge {
+			reverseCharged = true
+		} else {
+			line.Amount = roundAmount(taxable * r.Rate)
+		}
+		tax += line.Amount
+		lines = append(lines, line)
+	}
+	tax = roundAmount(tax)
+
+	// 全部为含税规则且没有反向征税时，实付金额保持为定价金额，舍入误差计入最后一条税费明细
+	if !reverseCharged && inclusiveRate == sumRates(rules) {
+		if diff := roundAmount(amount - taxable - tax); diff != 0 {
+			lines[len(lines)-1].Amount = roundAmount(lines[len(lines)-1].Amount + diff)
+			tax = roundAmount(tax + diff)
+		}
+		return lines, tax, amount, nil
+	}
+	return lines, tax, roundAmount(taxable + tax), nil
+}
+
+// normalizeVatID 规范化 VAT ID（去除空格并转为大写），格式无效时返回错误
+// 格式：两位国家前缀 + 2-13 位字母数字，如 DE123456789；不校验 VAT ID 是否真实有效
+func normalizeVatID(ctx context.Context, vatID string) (string, error) {
+	vatID = strings.ToUpper(strings.ReplaceAll(strings.TrimSpace(vatID), " ", ""))
+	if vatID == "" {
+		return "", nil
+	}
+	if len(vatID) < 4 || len(vatID) > 15 || !isUpperLetters(vatID[:2]) {
+		return "", pkgErrors.NewBizErrorWithLang(ctx, errors.ErrCodeVatIDInvalid)
+	}
+	for _, r := range vatID[2:] {
+		if !(r >= 'A' && r <= 'Z') && !(r >= '0' && r <= '9') {
+			return "", pkgErrors.NewBizErrorWithLang(ctx, errors.ErrCodeVatIDInvalid)
+		}
+	}
+	return vatID, nil
+}
+
+func sumRates(rules []*TaxRule) float64 {
+	total := 0.0
+	for _, r := range rules {
+		total += r.Rate
+	}
+	return total
+}
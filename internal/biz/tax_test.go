@@ -0,0 +1,72 @@
+package biz
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// fakeTaxRuleRepo 按国家返回启用中的税务规则
+type fakeTaxRuleRepo struct {
+	TaxRuleRepo
+	rules map[string][]*TaxRule
+}
+
+func (r *fakeTaxRuleRepo) ListEnabledTaxRules(_ context.Context, countryCode string) ([]*TaxRule, error) {
+	return r.rules[countryCode], nil
+}
+
+func TestCalculateTax(t *testing.T) {
+	uc := &SubscriptionUsecase{
+		taxRuleRepo: &fakeTaxRuleRepo{rules: map[string][]*TaxRule{
+			"DE": {{CountryCode: "DE", Name: "VAT", Rate: 0.19, Inclusive: true, ReverseCharge: true}},
+			"US": {{CountryCode: "US", Name: "Sales Tax", Rate: 0.1}},
+			"CA": {
+				{CountryCode: "CA", Name: "GST", Rate: 0.05},
+				{CountryCode: "CA", Name: "PST", Rate: 0.07},
+			},
+			"AU": {{CountryCode: "AU", Name: "GST", Rate: 0.1, Inclusive: true}},
+		}},
+		log: log.NewHelper(log.DefaultLogger),
+	}
+
+	tests := []struct {
+		name        string
+		countryCode string
+		vatID       string
+		amount      float64
+		wantLines   []float64 // 每条税费明细的税额
+		wantTax     float64
+		wantTotal   float64
+	}{
+		{"默认定价不计税", "default", "", 10, nil, 0, 10},
+		{"没有税务规则", "JP", "", 10, nil, 0, 10},
+		{"应付金额为 0", "US", "", 0, nil, 0, 0},
+		{"不含税加收", "US", "", 10, []float64{1}, 1, 11},
+		{"多条不含税规则", "CA", "", 100, []float64{5, 7}, 12, 112},
+		{"含税拆出税额", "AU", "", 11, []float64{1}, 1, 11},
+		{"含税舍入误差计入最后一条", "DE", "", 9.99, []float64{1.6}, 1.6, 9.99},
+		{"含税定价反向征税只收不含税部分", "DE", "DE123456789", 11.9, []float64{0}, 0, 10},
+		{"不支持反向征税的规则照常收税", "US", "DE123456789", 10, []float64{1}, 1, 11},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lines, tax, total, err := uc.calculateTax(context.Background(), tt.countryCode, tt.vatID, tt.amount)
+			if err != nil {
+				t.Fatalf("calculateTax() error = %v", err)
+			}
+			if tax != tt.wantTax || total != tt.wantTotal {
+				t.Errorf("calculateTax() tax = %v, total = %v, want tax = %v, total = %v", tax, total, tt.wantTax, tt.wantTotal)
+			}
+			if len(lines) != len(tt.wantLines) {
+				t.Fatalf("calculateTax() returned %d tax lines, want %d", len(lines), len(tt.wantLines))
+			}
+			for i, l := range lines {
+				if l.Amount != tt.wantLines[i] {
+					t.Errorf("tax line %d amount = %v, want %v", i, l.Amount, tt.wantLines[i])
+				}
+			}
+		})
+	}
+}
@@ -7,8 +7,10 @@ import (
 	"xinyuan_tech/subscription-service/internal/conf"
 	"xinyuan_tech/subscription-service/internal/constants"
 	"xinyuan_tech/subscription-service/internal/errors"
+	"xinyuan_tech/subscription-service/internal/metrics"
 
 	pkgErrors "github.com/gaoyong06/go-pkg/errors"
+	"github.com/gaoyong06/go-pkg/middleware/app_id"
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/go-redsync/redsync/v4"
 )
@@ -19,23 +21,66 @@ type UserSubscription struct {
 	UID            string // 用户ID（字符串 UUID）
 	PlanID         string
 	AppID          string // 应用ID（冗余字段，便于按app统计和查询）
+	CountryCode    string // 定价地区（最近一次购买时的国家代码，自动续费按该地区定价）
 	StartTime      time.Time
-	EndTime        time.Time
-	Status         string // active, expired, paused, cancelled
+	EndTime        time.Time // 结束时间（终身订阅为零值，表示永不过期）
+	BillingAnchor  time.Time // 计费锚点（月/年周期按锚点日期推算周期结束时间）
+	Status         string    // active, expired, paused, cancelled
 	OrderID        string
 	IsAutoRenew    bool
+	Source         string // 当前周期的来源：purchase, comp, manual
+	NextPlanID     string // 下次续费时切换到的套餐（批量迁移套餐时设置，续费成功后清空）
+	Version        int    // 版本号（每次更新递增，用于条件更新）
 	CreatedAt      time.Time
 	UpdatedAt      time.Time
 }
 
+// IsLifetime 是否为终身订阅（没有结束时间）
+func (s *UserSubscription) IsLifetime() bool {
+	return s.EndTime.IsZero()
+}
+
+// RenewalPlanID 下次续费的套餐：已安排迁移时为新套餐，否则为当前套餐
+func (s *UserSubscription) RenewalPlanID() string {
+	if s.NextPlanID != "" {
+		return s.NextPlanID
+	}
+	return s.PlanID
+}
+
+// IsExpiredAt 订阅在指定时间是否已过期（终身订阅永不过期）
+func (s *UserSubscription) IsExpiredAt(t time.Time) bool {
+	return !s.IsLifetime() && s.EndTime.Before(t)
+}
+
+// shiftEndTime 结束时间调整 days 天（负数为缩短），计费锚点重置为新的结束时间，之后的周期按调整后的日期推算
+func (s *UserSubscription) shiftEndTime(days int) {
+	s.EndTime = s.EndTime.AddDate(0, 0, days)
+	s.BillingAnchor = s.EndTime
+}
+
 // UserSubscriptionRepo 用户订阅仓库接口
 type UserSubscriptionRepo interface {
 	GetSubscription(ctx context.Context, uid string) (*UserSubscription, error)
+	// SaveSubscription 保存订阅：新订阅插入，已有订阅按版本号条件更新；同一用户已有订阅或读取后已被修改时返回 ErrSubscriptionConflict
 	SaveSubscription(ctx context.Context, sub *UserSubscription) error
 	// 批量操作（用于定时任务）
 	GetExpiringSubscriptions(ctx context.Context, daysBeforeExpiry, page, pageSize int) ([]*UserSubscription, int, error)
-	UpdateExpiredSubscriptions(ctx context.Context) (int, []string, error)
+	// ListExpiredSubscriptions 按 subscription_id 游标获取在 now 之前已过期但状态仍为 active 的订阅
+	ListExpiredSubscriptions(ctx context.Context, now time.Time, afterID uint64, limit int) ([]*UserSubscription, error)
+	// ExpireSubscription 按版本号条件将订阅标记为过期，订阅已被修改或不再过期时返回 false；成功后更新 sub 的状态和版本号并删除缓存
+	ExpireSubscription(ctx context.Context, sub *UserSubscription, now time.Time) (bool, error)
+	// GetExpiredSubscriptionUIDs 获取已过期但状态仍为 active 的订阅用户
+	GetExpiredSubscriptionUIDs(ctx context.Context) ([]string, error)
 	GetAutoRenewSubscriptions(ctx context.Context, daysBeforeExpiry int) ([]*UserSubscription, error)
+	// ListAutoRenewSubscriptions 按 (end_time, subscription_id) 游标分页获取需要自动续费的订阅
+	ListAutoRenewSubscriptions(ctx context.Context, query *AutoRenewQuery) ([]*UserSubscription, error)
+	// SearchSubscriptions 按条件分页查询订阅（客服查询），按订阅ID倒序
+	SearchSubscriptions(ctx context.Context, query *SubscriptionSearchQuery) ([]*UserSubscription, int, error)
+	// CountSubscriptions 统计满足批量操作筛选条件的订阅数和最大订阅ID
+	CountSubscriptions(ctx context.Context, filter *SubscriptionFilter) (int, uint64, error)
+	// ListSubscriptionsByFilter 按 subscription_id 游标获取满足批量操作筛选条件的订阅
+	ListSubscriptionsByFilter(ctx context.Context, filter *SubscriptionFilter, afterID uint64, limit int) ([]*UserSubscription, error)
 }
 
 // PaymentClient 支付服务客户端接口 (防腐层)
@@ -49,6 +94,15 @@ type SubscriptionUsecase struct {
 	subRepo            UserSubscriptionRepo
 	orderRepo          SubscriptionOrderRepo
 	historyRepo        SubscriptionHistoryRepo
+	appSettingRepo     AppSettingRepo
+	regionGroupRepo    RegionGroupRepo
+	taxRuleRepo        TaxRuleRepo
+	invoiceRepo        InvoiceRepo
+	exchangeRateRepo   ExchangeRateRepo
+	metricRepo         MetricRepo
+	priceNoticeRepo    PriceChangeNoticeRepo
+	bulkOpRepo         BulkOperationRepo
+	notifier           Notifier
 	paymentClient      PaymentClient
 	regionDetectionSvc RegionDetectionService // 地区推断服务
 	tm                 Transaction            // 事务管理器
@@ -63,6 +117,15 @@ func NewSubscriptionUsecase(
 	subRepo UserSubscriptionRepo,
 	orderRepo SubscriptionOrderRepo,
 	historyRepo SubscriptionHistoryRepo,
+	appSettingRepo AppSettingRepo,
+	regionGroupRepo RegionGroupRepo,
+	taxRuleRepo TaxRuleRepo,
+	invoiceRepo InvoiceRepo,
+	exchangeRateRepo ExchangeRateRepo,
+	metricRepo MetricRepo,
+	priceNoticeRepo PriceChangeNoticeRepo,
+	bulkOpRepo BulkOperationRepo,
+	notifier Notifier,
 	paymentClient PaymentClient,
 	regionDetectionSvc RegionDetectionService,
 	tm Transaction,
@@ -75,6 +138,15 @@ func NewSubscriptionUsecase(
 		subRepo:            subRepo,
 		orderRepo:          orderRepo,
 		historyRepo:        historyRepo,
+		appSettingRepo:     appSettingRepo,
+		regionGroupRepo:    regionGroupRepo,
+		taxRuleRepo:        taxRuleRepo,
+		invoiceRepo:        invoiceRepo,
+		exchangeRateRepo:   exchangeRateRepo,
+		metricRepo:         metricRepo,
+		priceNoticeRepo:    priceNoticeRepo,
+		bulkOpRepo:         bulkOpRepo,
+		notifier:           notifier,
 		paymentClient:      paymentClient,
 		regionDetectionSvc: regionDetectionSvc,
 		tm:                 tm,
@@ -84,28 +156,61 @@ func NewSubscriptionUsecase(
 	}
 }
 
+// Entitlement 用户当前生效的订阅权益
+type Entitlement struct {
+	Subscription *UserSubscription // 用户订阅记录（从未订阅时为 nil）
+	Plan         *Plan             // 当前生效的套餐（未订阅且应用未配置免费套餐时为 nil）
+	IsFree       bool              // 是否为应用的默认免费套餐
+}
+
 // GetMySubscription 获取用户当前订阅信息
-func (uc *SubscriptionUsecase) GetMySubscription(ctx context.Context, uid string) (*UserSubscription, error) {
+// 订阅不存在、已过期或已取消时，如果应用配置了默认免费套餐，则权益解析为免费套餐
+func (uc *SubscriptionUsecase) GetMySubscription(ctx context.Context, uid string) (*Entitlement, error) {
 	sub, err := uc.subRepo.GetSubscription(ctx, uid)
 	if err != nil {
 		return nil, err
 	}
 
-	// 检查是否过期
-	if sub != nil && sub.EndTime.Before(time.Now().UTC()) {
+	// 检查是否过期（终身订阅没有结束时间，不会过期）
+	if sub != nil && sub.IsExpiredAt(time.Now().UTC()) {
 		sub.Status = "expired"
 		// 可以在这里异步更新数据库状态
 	}
 
-	return sub, nil
+	appID := app_id.GetAppIDFromContext(ctx)
+	if sub != nil && sub.AppID != "" {
+		appID = sub.AppID
+	}
+	freePlan, err := uc.getDefaultFreePlan(ctx, appID)
+	if err != nil {
+		uc.log.Warnf("Failed to get default free plan of app %s: %v", appID, err)
+	}
+
+	entitlement := &Entitlement{Subscription: sub}
+	switch {
+	case sub != nil && (sub.Status == constants.StatusActive || sub.Status == constants.StatusPaused):
+		if freePlan != nil && sub.PlanID == freePlan.PlanID {
+			entitlement.Plan = freePlan
+			entitlement.IsFree = true
+		} else if plan, err := uc.planRepo.GetPlan(ctx, sub.PlanID); err == nil {
+			entitlement.Plan = plan
+		}
+	case freePlan != nil:
+		// 未订阅或订阅已失效（过期检查任务尚未回落时）按免费套餐返回权益
+		entitlement.Plan = freePlan
+		entitlement.IsFree = true
+	}
+	return entitlement, nil
 }
 
 // CancelSubscription 取消订阅
 func (uc *SubscriptionUsecase) CancelSubscription(ctx context.Context, uid string, reason string) error {
 	uc.log.Infof("CancelSubscription: uid=%s, reason=%s", uid, reason)
 
-	// 使用事务确保数据一致性
-	return uc.withTransaction(ctx, func(ctx context.Context) error {
+	// 取消的订阅所属应用和套餐（事务提交后记录监控指标）
+	var appID, planID string
+	// 持有用户锁在事务中执行，版本冲突时重新读取后重试
+	err := uc.mutateSubscription(ctx, uid, func(ctx context.Context) error {
 		// 获取当前订阅
 		sub, err := uc.subRepo.GetSubscription(ctx, uid)
 		if err != nil {
@@ -122,6 +227,7 @@ func (uc *SubscriptionUsecase) CancelSubscription(ctx context.Context, uid strin
 		}
 
 		now := time.Now().UTC()
+		before := snapshot(sub)
 		sub.Status = constants.StatusCancelled
 		sub.IsAutoRenew = false // 取消时关闭自动续费
 		sub.UpdatedAt = now
@@ -132,32 +238,31 @@ func (uc *SubscriptionUsecase) CancelSubscription(ctx context.Context, uid strin
 		}
 
 		// 记录历史
-		history := &SubscriptionHistory{
-			UID:       uid,
-			PlanID:    sub.PlanID,
-			AppID:     sub.AppID,
-			StartTime: sub.StartTime,
-			EndTime:   sub.EndTime,
-			Status:    sub.Status,
-			Action:    constants.ActionCancelled,
-			CreatedAt: now,
+		if err := uc.recordHistory(ctx, constants.ActionCancelled, before, sub, reason, now); err != nil {
+			return err
 		}
-		if err := uc.historyRepo.AddSubscriptionHistory(ctx, history); err != nil {
-			uc.log.Errorf("Failed to add subscription history: %v", err)
-			return err // 事务会回滚
+
+		// 回落到应用的默认免费套餐
+		appID, planID = sub.AppID, sub.PlanID
+		if _, err := uc.downgradeToFree(ctx, sub, now); err != nil {
+			return err
 		}
 
 		uc.log.Infof("Subscription cancelled successfully for user %s", uid)
 		return nil
 	})
+	if err == nil {
+		metrics.Cancellations.WithLabelValues(appID, planID).Inc()
+	}
+	return err
 }
 
 // PauseSubscription 暂停订阅
 func (uc *SubscriptionUsecase) PauseSubscription(ctx context.Context, uid string, reason string) error {
 	uc.log.Infof("PauseSubscription: uid=%s, reason=%s", uid, reason)
 
-	// 使用事务确保数据一致性
-	return uc.withTransaction(ctx, func(ctx context.Context) error {
+	// 持有用户锁在事务中执行，版本冲突时重新读取后重试
+	return uc.mutateSubscription(ctx, uid, func(ctx context.Context) error {
 		// 获取当前订阅
 		sub, err := uc.subRepo.GetSubscription(ctx, uid)
 		if err != nil {
@@ -174,6 +279,7 @@ func (uc *SubscriptionUsecase) PauseSubscription(ctx context.Context, uid string
 		}
 
 		now := time.Now().UTC()
+		before := snapshot(sub)
 		sub.Status = constants.StatusPaused
 		sub.UpdatedAt = now
 
@@ -183,19 +289,8 @@ func (uc *SubscriptionUsecase) PauseSubscription(ctx context.Context, uid string
 		}
 
 		// 记录历史
-		history := &SubscriptionHistory{
-			UID:       uid,
-			PlanID:    sub.PlanID,
-			AppID:     sub.AppID,
-			StartTime: sub.StartTime,
-			EndTime:   sub.EndTime,
-			Status:    sub.Status,
-			Action:    constants.ActionPaused,
-			CreatedAt: now,
-		}
-		if err := uc.historyRepo.AddSubscriptionHistory(ctx, history); err != nil {
-			uc.log.Errorf("Failed to add subscription history: %v", err)
-			return err // 事务会回滚
+		if err := uc.recordHistory(ctx, constants.ActionPaused, before, sub, reason, now); err != nil {
+			return err
 		}
 
 		uc.log.Infof("Subscription paused successfully for user %s", uid)
@@ -207,8 +302,8 @@ func (uc *SubscriptionUsecase) PauseSubscription(ctx context.Context, uid string
 func (uc *SubscriptionUsecase) ResumeSubscription(ctx context.Context, uid string) error {
 	uc.log.Infof("ResumeSubscription: uid=%s", uid)
 
-	// 使用事务确保数据一致性
-	return uc.withTransaction(ctx, func(ctx context.Context) error {
+	// 持有用户锁在事务中执行，版本冲突时重新读取后重试
+	return uc.mutateSubscription(ctx, uid, func(ctx context.Context) error {
 		// 获取当前订阅
 		sub, err := uc.subRepo.GetSubscription(ctx, uid)
 		if err != nil {
@@ -225,6 +320,7 @@ func (uc *SubscriptionUsecase) ResumeSubscription(ctx context.Context, uid strin
 		}
 
 		now := time.Now().UTC()
+		before := snapshot(sub)
 		sub.Status = constants.StatusActive
 		sub.UpdatedAt = now
 
@@ -234,19 +330,8 @@ func (uc *SubscriptionUsecase) ResumeSubscription(ctx context.Context, uid strin
 		}
 
 		// 记录历史
-		history := &SubscriptionHistory{
-			UID:       uid,
-			PlanID:    sub.PlanID,
-			AppID:     sub.AppID,
-			StartTime: sub.StartTime,
-			EndTime:   sub.EndTime,
-			Status:    sub.Status,
-			Action:    constants.ActionResumed,
-			CreatedAt: now,
-		}
-		if err := uc.historyRepo.AddSubscriptionHistory(ctx, history); err != nil {
-			uc.log.Errorf("Failed to add subscription history: %v", err)
-			return err // 事务会回滚
+		if err := uc.recordHistory(ctx, constants.ActionResumed, before, sub, "", now); err != nil {
+			return err
 		}
 
 		uc.log.Infof("Subscription resumed successfully for user %s", uid)
@@ -258,34 +343,56 @@ func (uc *SubscriptionUsecase) ResumeSubscription(ctx context.Context, uid strin
 func (uc *SubscriptionUsecase) SetAutoRenew(ctx context.Context, uid string, autoRenew bool) error {
 	uc.log.Infof("SetAutoRenew: uid=%s, autoRenew=%v", uid, autoRenew)
 
-	// 获取当前订阅
-	sub, err := uc.subRepo.GetSubscription(ctx, uid)
-	if err != nil {
-		uc.log.Errorf("Failed to get subscription: %v", err)
-		return err
-	}
-	if sub == nil {
-		return pkgErrors.NewBizErrorWithLang(ctx, errors.ErrCodeSubscriptionNotFound)
-	}
+	err := uc.mutateSubscription(ctx, uid, func(ctx context.Context) error {
+		// 获取当前订阅
+		sub, err := uc.subRepo.GetSubscription(ctx, uid)
+		if err != nil {
+			uc.log.Errorf("Failed to get subscription: %v", err)
+			return err
+		}
+		if sub == nil {
+			return pkgErrors.NewBizErrorWithLang(ctx, errors.ErrCodeSubscriptionNotFound)
+		}
 
-	// 只有 active 状态的订阅才能设置自动续费
-	if sub.Status != "active" {
-		return pkgErrors.NewBizErrorWithLang(ctx, errors.ErrCodeCannotSetAutoRenew)
-	}
+		// 只有 active 状态的订阅才能设置自动续费
+		if sub.Status != "active" {
+			return pkgErrors.NewBizErrorWithLang(ctx, errors.ErrCodeCannotSetAutoRenew)
+		}
+		// 终身订阅无需续费
+		if sub.IsLifetime() && autoRenew {
+			return pkgErrors.NewBizErrorWithLang(ctx, errors.ErrCodeLifetimeSubscription)
+		}
 
-	now := time.Now().UTC()
-	sub.IsAutoRenew = autoRenew
-	sub.UpdatedAt = now
+		// 未变化时不保存也不记录历史
+		if sub.IsAutoRenew == autoRenew {
+			return nil
+		}
 
-	if err := uc.subRepo.SaveSubscription(ctx, sub); err != nil {
-		uc.log.Errorf("Failed to save subscription: %v", err)
+		now := time.Now().UTC()
+		before := snapshot(sub)
+		sub.IsAutoRenew = autoRenew
+		sub.UpdatedAt = now
+
+		if err := uc.subRepo.SaveSubscription(ctx, sub); err != nil {
+			uc.log.Errorf("Failed to save subscription: %v", err)
+			return err
+		}
+
+		// 记录历史
+		return uc.recordHistory(ctx, autoRenewAction(autoRenew), before, sub, "", now)
+	})
+	if err != nil {
 		return err
 	}
 
-	action := "disabled_auto_renew"
+	uc.log.Infof("Auto-renew %s successfully for user %s", autoRenewAction(autoRenew), uid)
+	return nil
+}
+
+// autoRenewAction 设置自动续费的历史操作类型
+func autoRenewAction(autoRenew bool) string {
 	if autoRenew {
-		action = "enabled_auto_renew"
+		return constants.ActionEnabledAutoRenew
 	}
-	uc.log.Infof("Auto-renew %s successfully for user %s", action, uid)
-	return nil
+	return constants.ActionDisabledAutoRenew
 }
@@ -31,6 +31,10 @@ type Bootstrap struct {
 	Subscription  *Subscription          `protobuf:"bytes,4,opt,name=subscription,proto3" json:"subscription,omitempty"` // 订阅业务配置
 	Cron          *Cron                  `protobuf:"bytes,5,opt,name=cron,proto3" json:"cron,omitempty"`                 // 定时任务配置
 	Log           *Log                   `protobuf:"bytes,6,opt,name=log,proto3" json:"log,omitempty"`
+	Geoip         *GeoIP                 `protobuf:"bytes,7,opt,name=geoip,proto3" json:"geoip,omitempty"`                                   // 离线 GeoIP 配置
+	Invoice       *Invoice               `protobuf:"bytes,8,opt,name=invoice,proto3" json:"invoice,omitempty"`                               // 发票渲染配置
+	ExchangeRate  *ExchangeRate          `protobuf:"bytes,9,opt,name=exchange_rate,json=exchangeRate,proto3" json:"exchange_rate,omitempty"` // 汇率与收入报表配置
+	Trace         *Trace                 `protobuf:"bytes,10,opt,name=trace,proto3" json:"trace,omitempty"`                                  // 链路追踪配置
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -107,6 +111,34 @@ func (x *Bootstrap) GetLog() *Log {
 	return nil
 }
 
+func (x *Bootstrap) GetGeoip() *GeoIP {
+	if x != nil {
+		return x.Geoip
+	}
+	return nil
+}
+
+func (x *Bootstrap) GetInvoice() *Invoice {
+	if x != nil {
+		return x.Invoice
+	}
+	return nil
+}
+
+func (x *Bootstrap) GetExchangeRate() *ExchangeRate {
+	if x != nil {
+		return x.ExchangeRate
+	}
+	return nil
+}
+
+func (x *Bootstrap) GetTrace() *Trace {
+	if x != nil {
+		return x.Trace
+	}
+	return nil
+}
+
 // 服务配置
 type Server struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -358,12 +390,18 @@ func (x *PassportService) GetAddr() string {
 
 // 订阅业务配置
 type Subscription struct {
-	state               protoimpl.MessageState `protogen:"open.v1"`
-	ReturnUrl           string                 `protobuf:"bytes,1,opt,name=return_url,json=returnUrl,proto3" json:"return_url,omitempty"`                                    // 支付成功返回 URL
-	AutoRenewDaysBefore int32                  `protobuf:"varint,2,opt,name=auto_renew_days_before,json=autoRenewDaysBefore,proto3" json:"auto_renew_days_before,omitempty"` // 自动续费提前天数
-	ExpiryCheckDays     int32                  `protobuf:"varint,3,opt,name=expiry_check_days,json=expiryCheckDays,proto3" json:"expiry_check_days,omitempty"`               // 过期检查天数
-	unknownFields       protoimpl.UnknownFields
-	sizeCache           protoimpl.SizeCache
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	ReturnUrl             string                 `protobuf:"bytes,1,opt,name=return_url,json=returnUrl,proto3" json:"return_url,omitempty"`                                          // 支付成功返回 URL
+	AutoRenewDaysBefore   int32                  `protobuf:"varint,2,opt,name=auto_renew_days_before,json=autoRenewDaysBefore,proto3" json:"auto_renew_days_before,omitempty"`       // 自动续费提前天数
+	ExpiryCheckDays       int32                  `protobuf:"varint,3,opt,name=expiry_check_days,json=expiryCheckDays,proto3" json:"expiry_check_days,omitempty"`                     // 过期检查天数
+	PriceChangeNoticeDays int32                  `protobuf:"varint,4,opt,name=price_change_notice_days,json=priceChangeNoticeDays,proto3" json:"price_change_notice_days,omitempty"` // 调价通知提前天数（续费价格变化前多少天通知用户）
+	RegionDetection       []string               `protobuf:"bytes,5,rep,name=region_detection,json=regionDetection,proto3" json:"region_detection,omitempty"`                        // 默认地区推断顺序（应用未单独配置时使用），可选: user_profile, geoip, passport_geoip, accept_language, x_language
+	QuoteSecret           string                 `protobuf:"bytes,6,opt,name=quote_secret,json=quoteSecret,proto3" json:"quote_secret,omitempty"`                                    // 报价 token 签名密钥（HMAC-SHA256），为空时不签发报价 token
+	QuoteTtl              *durationpb.Duration   `protobuf:"bytes,7,opt,name=quote_ttl,json=quoteTtl,proto3" json:"quote_ttl,omitempty"`                                             // 报价有效期，默认 15 分钟
+	AutoRenewBatchSize    int32                  `protobuf:"varint,8,opt,name=auto_renew_batch_size,json=autoRenewBatchSize,proto3" json:"auto_renew_batch_size,omitempty"`          // 自动续费每批处理的订阅数，默认 100
+	AutoRenewWorkers      int32                  `protobuf:"varint,9,opt,name=auto_renew_workers,json=autoRenewWorkers,proto3" json:"auto_renew_workers,omitempty"`                  // 自动续费并发处理的订阅数，默认 8
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
 }
 
 func (x *Subscription) Reset() {
@@ -417,19 +455,328 @@ func (x *Subscription) GetExpiryCheckDays() int32 {
 	return 0
 }
 
-// 定时任务配置
-type Cron struct {
+func (x *Subscription) GetPriceChangeNoticeDays() int32 {
+	if x != nil {
+		return x.PriceChangeNoticeDays
+	}
+	return 0
+}
+
+func (x *Subscription) GetRegionDetection() []string {
+	if x != nil {
+		return x.RegionDetection
+	}
+	return nil
+}
+
+func (x *Subscription) GetQuoteSecret() string {
+	if x != nil {
+		return x.QuoteSecret
+	}
+	return ""
+}
+
+func (x *Subscription) GetQuoteTtl() *durationpb.Duration {
+	if x != nil {
+		return x.QuoteTtl
+	}
+	return nil
+}
+
+func (x *Subscription) GetAutoRenewBatchSize() int32 {
+	if x != nil {
+		return x.AutoRenewBatchSize
+	}
+	return 0
+}
+
+func (x *Subscription) GetAutoRenewWorkers() int32 {
+	if x != nil {
+		return x.AutoRenewWorkers
+	}
+	return 0
+}
+
+// 发票渲染配置
+type Invoice struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
-	ExpiryCheck     string                 `protobuf:"bytes,1,opt,name=expiry_check,json=expiryCheck,proto3" json:"expiry_check,omitempty"`             // 过期检查 cron 表达式，默认: "0 0 2 * * *" (每天凌晨2点)
-	RenewalReminder string                 `protobuf:"bytes,2,opt,name=renewal_reminder,json=renewalReminder,proto3" json:"renewal_reminder,omitempty"` // 续费提醒 cron 表达式，默认: "0 0 10 * * *" (每天上午10点)
-	AutoRenewal     string                 `protobuf:"bytes,3,opt,name=auto_renewal,json=autoRenewal,proto3" json:"auto_renewal,omitempty"`             // 自动续费 cron 表达式，默认: "0 0 3 * * *" (每天凌晨3点)
+	SellerName      string                 `protobuf:"bytes,1,opt,name=seller_name,json=sellerName,proto3" json:"seller_name,omitempty"`                // 开票方名称
+	SellerAddress   string                 `protobuf:"bytes,2,opt,name=seller_address,json=sellerAddress,proto3" json:"seller_address,omitempty"`       // 开票方地址
+	SellerTaxId     string                 `protobuf:"bytes,3,opt,name=seller_tax_id,json=sellerTaxId,proto3" json:"seller_tax_id,omitempty"`           // 开票方税号（VAT ID）
+	PdfFontPath     string                 `protobuf:"bytes,4,opt,name=pdf_font_path,json=pdfFontPath,proto3" json:"pdf_font_path,omitempty"`           // PDF 使用的 UTF-8 TrueType 字体文件路径（渲染中文需要 CJK 字体），为空时 PDF 只能使用英文
+	DefaultLanguage string                 `protobuf:"bytes,5,opt,name=default_language,json=defaultLanguage,proto3" json:"default_language,omitempty"` // 默认语言: zh-CN, en-US，默认 en-US
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
 
+func (x *Invoice) Reset() {
+	*x = Invoice{}
+	mi := &file_conf_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Invoice) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Invoice) ProtoMessage() {}
+
+func (x *Invoice) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Invoice.ProtoReflect.Descriptor instead.
+func (*Invoice) Descriptor() ([]byte, []int) {
+	return file_conf_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *Invoice) GetSellerName() string {
+	if x != nil {
+		return x.SellerName
+	}
+	return ""
+}
+
+func (x *Invoice) GetSellerAddress() string {
+	if x != nil {
+		return x.SellerAddress
+	}
+	return ""
+}
+
+func (x *Invoice) GetSellerTaxId() string {
+	if x != nil {
+		return x.SellerTaxId
+	}
+	return ""
+}
+
+func (x *Invoice) GetPdfFontPath() string {
+	if x != nil {
+		return x.PdfFontPath
+	}
+	return ""
+}
+
+func (x *Invoice) GetDefaultLanguage() string {
+	if x != nil {
+		return x.DefaultLanguage
+	}
+	return ""
+}
+
+// 汇率与收入报表配置
+type ExchangeRate struct {
+	state                    protoimpl.MessageState `protogen:"open.v1"`
+	FilePath                 string                 `protobuf:"bytes,1,opt,name=file_path,json=filePath,proto3" json:"file_path,omitempty"`                                                   // 本地汇率文件路径（CSV: from_currency,to_currency,rate,effective_at），为空表示不定时导入
+	DefaultReportingCurrency string                 `protobuf:"bytes,2,opt,name=default_reporting_currency,json=defaultReportingCurrency,proto3" json:"default_reporting_currency,omitempty"` // 应用未设置报表币种时使用的报表币种，默认 USD
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *ExchangeRate) Reset() {
+	*x = ExchangeRate{}
+	mi := &file_conf_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExchangeRate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExchangeRate) ProtoMessage() {}
+
+func (x *ExchangeRate) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExchangeRate.ProtoReflect.Descriptor instead.
+func (*ExchangeRate) Descriptor() ([]byte, []int) {
+	return file_conf_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ExchangeRate) GetFilePath() string {
+	if x != nil {
+		return x.FilePath
+	}
+	return ""
+}
+
+func (x *ExchangeRate) GetDefaultReportingCurrency() string {
+	if x != nil {
+		return x.DefaultReportingCurrency
+	}
+	return ""
+}
+
+// 链路追踪配置（OpenTelemetry）
+type Trace struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Exporter      string                 `protobuf:"bytes,1,opt,name=exporter,proto3" json:"exporter,omitempty"`                            // 导出方式：otlp, stdout, file，为空表示不启用
+	Endpoint      string                 `protobuf:"bytes,2,opt,name=endpoint,proto3" json:"endpoint,omitempty"`                            // OTLP gRPC 地址，如 localhost:4317
+	Insecure      bool                   `protobuf:"varint,3,opt,name=insecure,proto3" json:"insecure,omitempty"`                           // OTLP 连接不使用 TLS
+	FilePath      string                 `protobuf:"bytes,4,opt,name=file_path,json=filePath,proto3" json:"file_path,omitempty"`            // exporter 为 file 时的输出文件路径
+	SampleRatio   float64                `protobuf:"fixed64,5,opt,name=sample_ratio,json=sampleRatio,proto3" json:"sample_ratio,omitempty"` // 采样比例 (0, 1]，默认 1（全部采样）；上游已采样的请求始终采样
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Trace) Reset() {
+	*x = Trace{}
+	mi := &file_conf_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Trace) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Trace) ProtoMessage() {}
+
+func (x *Trace) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Trace.ProtoReflect.Descriptor instead.
+func (*Trace) Descriptor() ([]byte, []int) {
+	return file_conf_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *Trace) GetExporter() string {
+	if x != nil {
+		return x.Exporter
+	}
+	return ""
+}
+
+func (x *Trace) GetEndpoint() string {
+	if x != nil {
+		return x.Endpoint
+	}
+	return ""
+}
+
+func (x *Trace) GetInsecure() bool {
+	if x != nil {
+		return x.Insecure
+	}
+	return false
+}
+
+func (x *Trace) GetFilePath() string {
+	if x != nil {
+		return x.FilePath
+	}
+	return ""
+}
+
+func (x *Trace) GetSampleRatio() float64 {
+	if x != nil {
+		return x.SampleRatio
+	}
+	return 0
+}
+
+// 离线 GeoIP 配置（MaxMind mmdb 格式）
+type GeoIP struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	DatabasePath   string                 `protobuf:"bytes,1,opt,name=database_path,json=databasePath,proto3" json:"database_path,omitempty"`       // 数据库文件路径，如 GeoLite2-Country.mmdb，为空表示不启用
+	ReloadInterval *durationpb.Duration   `protobuf:"bytes,2,opt,name=reload_interval,json=reloadInterval,proto3" json:"reload_interval,omitempty"` // 检查数据库文件更新的间隔，默认 1 分钟，文件变化后自动重新加载
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GeoIP) Reset() {
+	*x = GeoIP{}
+	mi := &file_conf_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GeoIP) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GeoIP) ProtoMessage() {}
+
+func (x *GeoIP) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GeoIP.ProtoReflect.Descriptor instead.
+func (*GeoIP) Descriptor() ([]byte, []int) {
+	return file_conf_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GeoIP) GetDatabasePath() string {
+	if x != nil {
+		return x.DatabasePath
+	}
+	return ""
+}
+
+func (x *GeoIP) GetReloadInterval() *durationpb.Duration {
+	if x != nil {
+		return x.ReloadInterval
+	}
+	return nil
+}
+
+// 定时任务配置
+type Cron struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	ExpiryCheck        string                 `protobuf:"bytes,1,opt,name=expiry_check,json=expiryCheck,proto3" json:"expiry_check,omitempty"`                        // 过期检查 cron 表达式，默认: "0 0 2 * * *" (每天凌晨2点)
+	RenewalReminder    string                 `protobuf:"bytes,2,opt,name=renewal_reminder,json=renewalReminder,proto3" json:"renewal_reminder,omitempty"`            // 续费提醒 cron 表达式，默认: "0 0 10 * * *" (每天上午10点)
+	AutoRenewal        string                 `protobuf:"bytes,3,opt,name=auto_renewal,json=autoRenewal,proto3" json:"auto_renewal,omitempty"`                        // 自动续费 cron 表达式，默认: "0 0 3 * * *" (每天凌晨3点)
+	PriceChangeNotice  string                 `protobuf:"bytes,4,opt,name=price_change_notice,json=priceChangeNotice,proto3" json:"price_change_notice,omitempty"`    // 调价通知 cron 表达式，默认: "0 0 11 * * *" (每天上午11点)
+	ExchangeRateImport string                 `protobuf:"bytes,5,opt,name=exchange_rate_import,json=exchangeRateImport,proto3" json:"exchange_rate_import,omitempty"` // 汇率文件导入 cron 表达式，默认: "0 0 1 * * *" (每天凌晨1点)
+	MetricSnapshot     string                 `protobuf:"bytes,6,opt,name=metric_snapshot,json=metricSnapshot,proto3" json:"metric_snapshot,omitempty"`               // 订阅指标快照 cron 表达式，默认: "0 30 0 * * *" (每天凌晨0点30分，生成前一天的快照)
+	MetricsAddr        string                 `protobuf:"bytes,7,opt,name=metrics_addr,json=metricsAddr,proto3" json:"metrics_addr,omitempty"`                        // Cron 服务 Prometheus 指标监听地址，默认: "0.0.0.0:8112"
+	CatchUpWindow      *durationpb.Duration   `protobuf:"bytes,8,opt,name=catch_up_window,json=catchUpWindow,proto3" json:"catch_up_window,omitempty"`                // 补跑窗口：启动或成为主节点时补跑该时间范围内错过的计划，默认 168h，设为负数不补跑
+	Admin              *Server                `protobuf:"bytes,9,opt,name=admin,proto3" json:"admin,omitempty"`                                                       // 定时任务管理接口（HTTP/gRPC）监听地址，默认 HTTP "127.0.0.1:8113"、gRPC "127.0.0.1:9113"（仅管理员可用）
+	BulkOperation      string                 `protobuf:"bytes,10,opt,name=bulk_operation,json=bulkOperation,proto3" json:"bulk_operation,omitempty"`                 // 批量操作执行 cron 表达式，默认: "0 * * * * *" (每分钟)
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
 func (x *Cron) Reset() {
 	*x = Cron{}
-	mi := &file_conf_proto_msgTypes[7]
+	mi := &file_conf_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -441,7 +788,7 @@ func (x *Cron) String() string {
 func (*Cron) ProtoMessage() {}
 
 func (x *Cron) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_proto_msgTypes[7]
+	mi := &file_conf_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -454,7 +801,7 @@ func (x *Cron) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Cron.ProtoReflect.Descriptor instead.
 func (*Cron) Descriptor() ([]byte, []int) {
-	return file_conf_proto_rawDescGZIP(), []int{7}
+	return file_conf_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *Cron) GetExpiryCheck() string {
@@ -478,6 +825,55 @@ func (x *Cron) GetAutoRenewal() string {
 	return ""
 }
 
+func (x *Cron) GetPriceChangeNotice() string {
+	if x != nil {
+		return x.PriceChangeNotice
+	}
+	return ""
+}
+
+func (x *Cron) GetExchangeRateImport() string {
+	if x != nil {
+		return x.ExchangeRateImport
+	}
+	return ""
+}
+
+func (x *Cron) GetMetricSnapshot() string {
+	if x != nil {
+		return x.MetricSnapshot
+	}
+	return ""
+}
+
+func (x *Cron) GetMetricsAddr() string {
+	if x != nil {
+		return x.MetricsAddr
+	}
+	return ""
+}
+
+func (x *Cron) GetCatchUpWindow() *durationpb.Duration {
+	if x != nil {
+		return x.CatchUpWindow
+	}
+	return nil
+}
+
+func (x *Cron) GetAdmin() *Server {
+	if x != nil {
+		return x.Admin
+	}
+	return nil
+}
+
+func (x *Cron) GetBulkOperation() string {
+	if x != nil {
+		return x.BulkOperation
+	}
+	return ""
+}
+
 // 日志配置
 type Log struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -495,7 +891,7 @@ type Log struct {
 
 func (x *Log) Reset() {
 	*x = Log{}
-	mi := &file_conf_proto_msgTypes[8]
+	mi := &file_conf_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -507,7 +903,7 @@ func (x *Log) String() string {
 func (*Log) ProtoMessage() {}
 
 func (x *Log) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_proto_msgTypes[8]
+	mi := &file_conf_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -520,7 +916,7 @@ func (x *Log) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Log.ProtoReflect.Descriptor instead.
 func (*Log) Descriptor() ([]byte, []int) {
-	return file_conf_proto_rawDescGZIP(), []int{8}
+	return file_conf_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *Log) GetLevel() string {
@@ -590,7 +986,7 @@ type Server_HTTP struct {
 
 func (x *Server_HTTP) Reset() {
 	*x = Server_HTTP{}
-	mi := &file_conf_proto_msgTypes[9]
+	mi := &file_conf_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -602,7 +998,7 @@ func (x *Server_HTTP) String() string {
 func (*Server_HTTP) ProtoMessage() {}
 
 func (x *Server_HTTP) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_proto_msgTypes[9]
+	mi := &file_conf_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -650,7 +1046,7 @@ type Server_GRPC struct {
 
 func (x *Server_GRPC) Reset() {
 	*x = Server_GRPC{}
-	mi := &file_conf_proto_msgTypes[10]
+	mi := &file_conf_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -662,7 +1058,7 @@ func (x *Server_GRPC) String() string {
 func (*Server_GRPC) ProtoMessage() {}
 
 func (x *Server_GRPC) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_proto_msgTypes[10]
+	mi := &file_conf_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -712,7 +1108,7 @@ type Data_Database struct {
 
 func (x *Data_Database) Reset() {
 	*x = Data_Database{}
-	mi := &file_conf_proto_msgTypes[11]
+	mi := &file_conf_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -724,7 +1120,7 @@ func (x *Data_Database) String() string {
 func (*Data_Database) ProtoMessage() {}
 
 func (x *Data_Database) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_proto_msgTypes[11]
+	mi := &file_conf_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -792,7 +1188,7 @@ type Data_Redis struct {
 
 func (x *Data_Redis) Reset() {
 	*x = Data_Redis{}
-	mi := &file_conf_proto_msgTypes[12]
+	mi := &file_conf_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -804,7 +1200,7 @@ func (x *Data_Redis) String() string {
 func (*Data_Redis) ProtoMessage() {}
 
 func (x *Data_Redis) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_proto_msgTypes[12]
+	mi := &file_conf_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -888,14 +1284,19 @@ var File_conf_proto protoreflect.FileDescriptor
 const file_conf_proto_rawDesc = "" +
 	"\n" +
 	"\n" +
-	"conf.proto\x12\x11subscription.conf\x1a\x1egoogle/protobuf/duration.proto\"\xba\x02\n" +
+	"conf.proto\x12\x11subscription.conf\x1a\x1egoogle/protobuf/duration.proto\"\x96\x04\n" +
 	"\tBootstrap\x121\n" +
 	"\x06server\x18\x01 \x01(\v2\x19.subscription.conf.ServerR\x06server\x12+\n" +
 	"\x04data\x18\x02 \x01(\v2\x17.subscription.conf.DataR\x04data\x121\n" +
 	"\x06client\x18\x03 \x01(\v2\x19.subscription.conf.ClientR\x06client\x12C\n" +
 	"\fsubscription\x18\x04 \x01(\v2\x1f.subscription.conf.SubscriptionR\fsubscription\x12+\n" +
 	"\x04cron\x18\x05 \x01(\v2\x17.subscription.conf.CronR\x04cron\x12(\n" +
-	"\x03log\x18\x06 \x01(\v2\x16.subscription.conf.LogR\x03log\"\xc6\x02\n" +
+	"\x03log\x18\x06 \x01(\v2\x16.subscription.conf.LogR\x03log\x12.\n" +
+	"\x05geoip\x18\a \x01(\v2\x18.subscription.conf.GeoIPR\x05geoip\x124\n" +
+	"\ainvoice\x18\b \x01(\v2\x1a.subscription.conf.InvoiceR\ainvoice\x12D\n" +
+	"\rexchange_rate\x18\t \x01(\v2\x1f.subscription.conf.ExchangeRateR\fexchangeRate\x12.\n" +
+	"\x05trace\x18\n" +
+	" \x01(\v2\x18.subscription.conf.TraceR\x05trace\"\xc6\x02\n" +
 	"\x06Server\x122\n" +
 	"\x04http\x18\x01 \x01(\v2\x1e.subscription.conf.Server.HTTPR\x04http\x122\n" +
 	"\x04grpc\x18\x02 \x01(\v2\x1e.subscription.conf.Server.GRPCR\x04grpc\x1ai\n" +
@@ -932,16 +1333,49 @@ const file_conf_proto_rawDesc = "" +
 	"\x0ePaymentService\x12\x12\n" +
 	"\x04addr\x18\x01 \x01(\tR\x04addr\"%\n" +
 	"\x0fPassportService\x12\x12\n" +
-	"\x04addr\x18\x01 \x01(\tR\x04addr\"\x8e\x01\n" +
+	"\x04addr\x18\x01 \x01(\tR\x04addr\"\xae\x03\n" +
 	"\fSubscription\x12\x1d\n" +
 	"\n" +
 	"return_url\x18\x01 \x01(\tR\treturnUrl\x123\n" +
 	"\x16auto_renew_days_before\x18\x02 \x01(\x05R\x13autoRenewDaysBefore\x12*\n" +
-	"\x11expiry_check_days\x18\x03 \x01(\x05R\x0fexpiryCheckDays\"w\n" +
+	"\x11expiry_check_days\x18\x03 \x01(\x05R\x0fexpiryCheckDays\x127\n" +
+	"\x18price_change_notice_days\x18\x04 \x01(\x05R\x15priceChangeNoticeDays\x12)\n" +
+	"\x10region_detection\x18\x05 \x03(\tR\x0fregionDetection\x12!\n" +
+	"\fquote_secret\x18\x06 \x01(\tR\vquoteSecret\x126\n" +
+	"\tquote_ttl\x18\a \x01(\v2\x19.google.protobuf.DurationR\bquoteTtl\x121\n" +
+	"\x15auto_renew_batch_size\x18\b \x01(\x05R\x12autoRenewBatchSize\x12,\n" +
+	"\x12auto_renew_workers\x18\t \x01(\x05R\x10autoRenewWorkers\"\xc4\x01\n" +
+	"\aInvoice\x12\x1f\n" +
+	"\vseller_name\x18\x01 \x01(\tR\n" +
+	"sellerName\x12%\n" +
+	"\x0eseller_address\x18\x02 \x01(\tR\rsellerAddress\x12\"\n" +
+	"\rseller_tax_id\x18\x03 \x01(\tR\vsellerTaxId\x12\"\n" +
+	"\rpdf_font_path\x18\x04 \x01(\tR\vpdfFontPath\x12)\n" +
+	"\x10default_language\x18\x05 \x01(\tR\x0fdefaultLanguage\"i\n" +
+	"\fExchangeRate\x12\x1b\n" +
+	"\tfile_path\x18\x01 \x01(\tR\bfilePath\x12<\n" +
+	"\x1adefault_reporting_currency\x18\x02 \x01(\tR\x18defaultReportingCurrency\"\x9b\x01\n" +
+	"\x05Trace\x12\x1a\n" +
+	"\bexporter\x18\x01 \x01(\tR\bexporter\x12\x1a\n" +
+	"\bendpoint\x18\x02 \x01(\tR\bendpoint\x12\x1a\n" +
+	"\binsecure\x18\x03 \x01(\bR\binsecure\x12\x1b\n" +
+	"\tfile_path\x18\x04 \x01(\tR\bfilePath\x12!\n" +
+	"\fsample_ratio\x18\x05 \x01(\x01R\vsampleRatio\"p\n" +
+	"\x05GeoIP\x12#\n" +
+	"\rdatabase_path\x18\x01 \x01(\tR\fdatabasePath\x12B\n" +
+	"\x0freload_interval\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\x0ereloadInterval\"\xc0\x03\n" +
 	"\x04Cron\x12!\n" +
 	"\fexpiry_check\x18\x01 \x01(\tR\vexpiryCheck\x12)\n" +
 	"\x10renewal_reminder\x18\x02 \x01(\tR\x0frenewalReminder\x12!\n" +
-	"\fauto_renewal\x18\x03 \x01(\tR\vautoRenewal\"\xd9\x01\n" +
+	"\fauto_renewal\x18\x03 \x01(\tR\vautoRenewal\x12.\n" +
+	"\x13price_change_notice\x18\x04 \x01(\tR\x11priceChangeNotice\x120\n" +
+	"\x14exchange_rate_import\x18\x05 \x01(\tR\x12exchangeRateImport\x12'\n" +
+	"\x0fmetric_snapshot\x18\x06 \x01(\tR\x0emetricSnapshot\x12!\n" +
+	"\fmetrics_addr\x18\a \x01(\tR\vmetricsAddr\x12A\n" +
+	"\x0fcatch_up_window\x18\b \x01(\v2\x19.google.protobuf.DurationR\rcatchUpWindow\x12/\n" +
+	"\x05admin\x18\t \x01(\v2\x19.subscription.conf.ServerR\x05admin\x12%\n" +
+	"\x0ebulk_operation\x18\n" +
+	" \x01(\tR\rbulkOperation\"\xd9\x01\n" +
 	"\x03Log\x12\x14\n" +
 	"\x05level\x18\x01 \x01(\tR\x05level\x12\x16\n" +
 	"\x06format\x18\x02 \x01(\tR\x06format\x12\x16\n" +
@@ -965,7 +1399,7 @@ func file_conf_proto_rawDescGZIP() []byte {
 	return file_conf_proto_rawDescData
 }
 
-var file_conf_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_conf_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
 var file_conf_proto_goTypes = []any{
 	(*Bootstrap)(nil),           // 0: subscription.conf.Bootstrap
 	(*Server)(nil),              // 1: subscription.conf.Server
@@ -974,38 +1408,50 @@ var file_conf_proto_goTypes = []any{
 	(*PaymentService)(nil),      // 4: subscription.conf.PaymentService
 	(*PassportService)(nil),     // 5: subscription.conf.PassportService
 	(*Subscription)(nil),        // 6: subscription.conf.Subscription
-	(*Cron)(nil),                // 7: subscription.conf.Cron
-	(*Log)(nil),                 // 8: subscription.conf.Log
-	(*Server_HTTP)(nil),         // 9: subscription.conf.Server.HTTP
-	(*Server_GRPC)(nil),         // 10: subscription.conf.Server.GRPC
-	(*Data_Database)(nil),       // 11: subscription.conf.Data.Database
-	(*Data_Redis)(nil),          // 12: subscription.conf.Data.Redis
-	(*durationpb.Duration)(nil), // 13: google.protobuf.Duration
+	(*Invoice)(nil),             // 7: subscription.conf.Invoice
+	(*ExchangeRate)(nil),        // 8: subscription.conf.ExchangeRate
+	(*Trace)(nil),               // 9: subscription.conf.Trace
+	(*GeoIP)(nil),               // 10: subscription.conf.GeoIP
+	(*Cron)(nil),                // 11: subscription.conf.Cron
+	(*Log)(nil),                 // 12: subscription.conf.Log
+	(*Server_HTTP)(nil),         // 13: subscription.conf.Server.HTTP
+	(*Server_GRPC)(nil),         // 14: subscription.conf.Server.GRPC
+	(*Data_Database)(nil),       // 15: subscription.conf.Data.Database
+	(*Data_Redis)(nil),          // 16: subscription.conf.Data.Redis
+	(*durationpb.Duration)(nil), // 17: google.protobuf.Duration
 }
 var file_conf_proto_depIdxs = []int32{
 	1,  // 0: subscription.conf.Bootstrap.server:type_name -> subscription.conf.Server
 	2,  // 1: subscription.conf.Bootstrap.data:type_name -> subscription.conf.Data
 	3,  // 2: subscription.conf.Bootstrap.client:type_name -> subscription.conf.Client
 	6,  // 3: subscription.conf.Bootstrap.subscription:type_name -> subscription.conf.Subscription
-	7,  // 4: subscription.conf.Bootstrap.cron:type_name -> subscription.conf.Cron
-	8,  // 5: subscription.conf.Bootstrap.log:type_name -> subscription.conf.Log
-	9,  // 6: subscription.conf.Server.http:type_name -> subscription.conf.Server.HTTP
-	10, // 7: subscription.conf.Server.grpc:type_name -> subscription.conf.Server.GRPC
-	11, // 8: subscription.conf.Data.database:type_name -> subscription.conf.Data.Database
-	12, // 9: subscription.conf.Data.redis:type_name -> subscription.conf.Data.Redis
-	4,  // 10: subscription.conf.Client.payment_service:type_name -> subscription.conf.PaymentService
-	5,  // 11: subscription.conf.Client.passport_service:type_name -> subscription.conf.PassportService
-	13, // 12: subscription.conf.Server.HTTP.timeout:type_name -> google.protobuf.Duration
-	13, // 13: subscription.conf.Server.GRPC.timeout:type_name -> google.protobuf.Duration
-	13, // 14: subscription.conf.Data.Database.conn_max_lifetime:type_name -> google.protobuf.Duration
-	13, // 15: subscription.conf.Data.Redis.dial_timeout:type_name -> google.protobuf.Duration
-	13, // 16: subscription.conf.Data.Redis.read_timeout:type_name -> google.protobuf.Duration
-	13, // 17: subscription.conf.Data.Redis.write_timeout:type_name -> google.protobuf.Duration
-	18, // [18:18] is the sub-list for method output_type
-	18, // [18:18] is the sub-list for method input_type
-	18, // [18:18] is the sub-list for extension type_name
-	18, // [18:18] is the sub-list for extension extendee
-	0,  // [0:18] is the sub-list for field type_name
+	11, // 4: subscription.conf.Bootstrap.cron:type_name -> subscription.conf.Cron
+	12, // 5: subscription.conf.Bootstrap.log:type_name -> subscription.conf.Log
+	10, // 6: subscription.conf.Bootstrap.geoip:type_name -> subscription.conf.GeoIP
+	7,  // 7: subscription.conf.Bootstrap.invoice:type_name -> subscription.conf.Invoice
+	8,  // 8: subscription.conf.Bootstrap.exchange_rate:type_name -> subscription.conf.ExchangeRate
+	9,  // 9: subscription.conf.Bootstrap.trace:type_name -> subscription.conf.Trace
+	13, // 10: subscription.conf.Server.http:type_name -> subscription.conf.Server.HTTP
+	14, // 11: subscription.conf.Server.grpc:type_name -> subscription.conf.Server.GRPC
+	15, // 12: subscription.conf.Data.database:type_name -> subscription.conf.Data.Database
+	16, // 13: subscription.conf.Data.redis:type_name -> subscription.conf.Data.Redis
+	4,  // 14: subscription.conf.Client.payment_service:type_name -> subscription.conf.PaymentService
+	5,  // 15: subscription.conf.Client.passport_service:type_name -> subscription.conf.PassportService
+	17, // 16: subscription.conf.Subscription.quote_ttl:type_name -> google.protobuf.Duration
+	17, // 17: subscription.conf.GeoIP.reload_interval:type_name -> google.protobuf.Duration
+	17, // 18: subscription.conf.Cron.catch_up_window:type_name -> google.protobuf.Duration
+	1,  // 19: subscription.conf.Cron.admin:type_name -> subscription.conf.Server
+	17, // 20: subscription.conf.Server.HTTP.timeout:type_name -> google.protobuf.Duration
+	17, // 21: subscription.conf.Server.GRPC.timeout:type_name -> google.protobuf.Duration
+	17, // 22: subscription.conf.Data.Database.conn_max_lifetime:type_name -> google.protobuf.Duration
+	17, // 23: subscription.conf.Data.Redis.dial_timeout:type_name -> google.protobuf.Duration
+	17, // 24: subscription.conf.Data.Redis.read_timeout:type_name -> google.protobuf.Duration
+	17, // 25: subscription.conf.Data.Redis.write_timeout:type_name -> google.protobuf.Duration
+	26, // [26:26] is the sub-list for method output_type
+	26, // [26:26] is the sub-list for method input_type
+	26, // [26:26] is the sub-list for extension type_name
+	26, // [26:26] is the sub-list for extension extendee
+	0,  // [0:26] is the sub-list for field type_name
 }
 
 func init() { file_conf_proto_init() }
@@ -1019,7 +1465,7 @@ func file_conf_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_conf_proto_rawDesc), len(file_conf_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   13,
+			NumMessages:   17,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -28,6 +28,43 @@ const (
 	MaxExpiryDays = 30
 	// DefaultAutoRenewDays 默认自动续费提前天数
 	DefaultAutoRenewDays = 3
+	// DefaultQuoteTTL 报价有效期（报价 token 过期后需要重新报价）
+	DefaultQuoteTTL = 15 * time.Minute
+	// DefaultPriceChangeNoticeDays 默认调价通知提前天数
+	DefaultPriceChangeNoticeDays = 7
+	// MaxPriceChangeNoticeDays 最大调价通知提前天数
+	MaxPriceChangeNoticeDays = 60
+)
+
+// 通知相关常量
+const (
+	// NotificationStreamKey 订阅通知 Redis Stream（由 Notification Service 消费并发送）
+	NotificationStreamKey = "subscription:notifications"
+	// NotificationStreamMaxLen Stream 最大保留条数（近似裁剪）
+	NotificationStreamMaxLen = 100000
+	// NotificationTypePriceChange 续费价格变更通知
+	NotificationTypePriceChange = "price_change"
+)
+
+// 套餐计费类型
+const (
+	PlanBillingRecurring = "recurring" // 周期订阅，按计费周期到期/续费
+	PlanBillingLifetime  = "lifetime"  // 终身（一次性买断），订阅永不过期
+)
+
+// 订阅来源（订阅当前周期和订单的来源）
+const (
+	SourcePurchase = "purchase" // 用户购买（包括自动续费和免费套餐），计入收入
+	SourceComp     = "comp"     // 赠送（如市场活动），不计入收入
+	SourceManual   = "manual"   // 手动开通（如企业客户线下对公付款），不计入收入
+)
+
+// 计费周期单位
+const (
+	IntervalDay   = "day"
+	IntervalWeek  = "week"
+	IntervalMonth = "month" // 按日历月计算，月末自动截断
+	IntervalYear  = "year"  // 按日历年计算，2月29日在平年截断为2月28日
 )
 
 // 分布式锁相关常量
@@ -38,12 +75,52 @@ const (
 	AutoRenewLockRetries = 1
 )
 
-// 支持的区域列表
-var SupportedRegions = map[string]bool{
-	"default": true,
-	"CN":      true,
-	"US":      true,
-	"EU":      true,
+// 订阅并发控制
+const (
+	// SubscriptionLockKeyPrefix 用户订阅锁前缀（同一用户的生命周期操作和自动续费串行执行）
+	SubscriptionLockKeyPrefix = "subscription:lock:user:"
+	// SubscriptionLockExpiration 用户订阅锁过期时间（自动续费使用 AutoRenewLockExpiration）
+	SubscriptionLockExpiration = 30 * time.Second
+	// SubscriptionLockTries 获取用户订阅锁的尝试次数
+	SubscriptionLockTries = 20
+	// SubscriptionLockRetryDelay 获取用户订阅锁的重试间隔
+	SubscriptionLockRetryDelay = 100 * time.Millisecond
+	// SubscriptionConflictMaxRetries 保存订阅版本冲突时的最多执行次数（含首次执行）
+	SubscriptionConflictMaxRetries = 3
+	// SubscriptionConflictRetryDelay 版本冲突后重试的基础间隔（按执行次数递增）
+	SubscriptionConflictRetryDelay = 20 * time.Millisecond
+)
+
+// 自动续费批处理
+const (
+	// DefaultAutoRenewBatchSize 默认每批处理的订阅数（按到期时间游标分页）
+	DefaultAutoRenewBatchSize = 100
+	// DefaultAutoRenewWorkers 默认并发处理的订阅数
+	DefaultAutoRenewWorkers = 8
+	// MaxAutoRenewWorkers 最大并发处理的订阅数
+	MaxAutoRenewWorkers = 64
+	// ExpiryBatchSize 过期检查每批读取的订阅数（按订阅 ID 游标分页，逐条按版本号条件更新）
+	ExpiryBatchSize = 200
+)
+
+// 地区推断来源
+const (
+	RegionSourceUserProfile    = "user_profile"    // 用户注册信息（passport-service）
+	RegionSourceGeoIP          = "geoip"           // 本地离线 GeoIP 数据库
+	RegionSourcePassportGeoIP  = "passport_geoip"  // passport-service 的 GeoIP 接口
+	RegionSourceAcceptLanguage = "accept_language" // HTTP 头 Accept-Language
+	RegionSourceXLanguage      = "x_language"      // HTTP 头 X-Language
+	RegionSourceRequest        = "request"         // 请求中显式指定（不参与推断顺序配置）
+	RegionSourceDefault        = "default"         // 无法推断，使用默认定价（不参与推断顺序配置）
+)
+
+// DefaultRegionDetection 默认地区推断顺序
+var DefaultRegionDetection = []string{
+	RegionSourceUserProfile,
+	RegionSourceGeoIP,
+	RegionSourcePassportGeoIP,
+	RegionSourceAcceptLanguage,
+	RegionSourceXLanguage,
 }
 
 // 订阅状态
@@ -56,15 +133,29 @@ const (
 
 // 订阅操作
 const (
-	ActionCreated           = "created"
-	ActionRenewed           = "renewed"
-	ActionUpgraded          = "upgraded"
-	ActionPaused            = "paused"
-	ActionResumed           = "resumed"
-	ActionCancelled         = "cancelled"
-	ActionExpired           = "expired"
-	ActionEnabledAutoRenew  = "enabled_auto_renew"
-	ActionDisabledAutoRenew = "disabled_auto_renew"
+	ActionCreated             = "created"
+	ActionRenewed             = "renewed"
+	ActionUpgraded            = "upgraded"
+	ActionPaused              = "paused"
+	ActionResumed             = "resumed"
+	ActionCancelled           = "cancelled"
+	ActionExpired             = "expired"
+	ActionEnabledAutoRenew    = "enabled_auto_renew"
+	ActionDisabledAutoRenew   = "disabled_auto_renew"
+	ActionDowngradedToFree    = "downgraded_to_free"    // 过期、取消或退款后回落到应用的默认免费套餐
+	ActionExtended            = "extended"              // 客服延长订阅
+	ActionShortened           = "shortened"             // 客服缩短订阅
+	ActionGranted             = "granted"               // 客服赠送订阅时长
+	ActionPlanChangeScheduled = "plan_change_scheduled" // 批量迁移套餐：下次续费时切换到新套餐
+)
+
+// 订阅历史操作人类型
+const (
+	ActorTypeUser    = "user"    // 用户本人（操作人ID为用户ID）
+	ActorTypeAdmin   = "admin"   // 管理员（客服操作和批量操作，操作人ID为管理员的用户ID）
+	ActorTypeCron    = "cron"    // 定时任务（操作人ID为任务名）
+	ActorTypePayment = "payment" // 支付回调（操作人ID为支付ID）
+	ActorTypeSystem  = "system"  // 其他内部调用（没有登录用户）
 )
 
 // 支付状态(与payment-service保持一致)
@@ -75,6 +166,7 @@ const (
 	PaymentStatusClosed            = "closed"             // 订单关闭
 	PaymentStatusRefunded          = "refunded"           // 已全额退款
 	PaymentStatusPartiallyRefunded = "partially_refunded" // 部分退款
+	PaymentStatusRefundRequired    = "refund_required"    // 已支付但未开通订阅，需要退款（如终身订阅用户支付了周期套餐订单）
 )
 
 // 支付来源常量（用于 payment-service）
@@ -84,3 +176,215 @@ const (
 	// PaymentSourceSubscription 订阅来源
 	PaymentSourceSubscription = "subscription"
 )
+
+// 发票类型
+const (
+	InvoiceTypeInvoice    = "invoice"     // 发票（支付成功后开具）
+	InvoiceTypeCreditNote = "credit_note" // 红字发票（退款时开具，冲减原发票）
+)
+
+// 发票编号前缀（编号在应用内按类型连续递增，如 INV-000001、CN-000001）
+const (
+	InvoiceNoPrefix    = "INV"
+	CreditNoteNoPrefix = "CN"
+)
+
+// 发票明细类型
+const (
+	InvoiceLineTypePlan      = "plan"      // 套餐
+	InvoiceLineTypeDiscount  = "discount"  // 优惠
+	InvoiceLineTypeProration = "proration" // 切换套餐抵扣
+	InvoiceLineTypeTax       = "tax"       // 税费
+	InvoiceLineTypeRefund    = "refund"    // 退款（红字发票）
+)
+
+// 发票渲染格式
+const (
+	InvoiceFormatHTML = "html"
+	InvoiceFormatPDF  = "pdf"
+)
+
+// 汇率来源
+const (
+	ExchangeRateSourceAdmin = "admin" // 管理接口录入或导入
+	ExchangeRateSourceFile  = "file"  // 本地汇率文件定时导入
+)
+
+const (
+	// DefaultReportingCurrency 默认报表币种（应用和全局配置均未设置时使用）
+	DefaultReportingCurrency = "USD"
+	// ExchangeRatePivotCurrency 交叉汇率的中间币种（没有直接汇率时经由该币种折算）
+	ExchangeRatePivotCurrency = "USD"
+	// DefaultRevenueReportDays 收入报表未指定开始时间时统计的天数
+	DefaultRevenueReportDays = 30
+)
+
+const (
+	// DateLayout 日期格式（指标快照日期，UTC）
+	DateLayout = "2006-01-02"
+	// MonthLayout 月份格式（同期群月份，UTC）
+	MonthLayout = "2006-01"
+	// ReportFormatCSV 报表导出格式
+	ReportFormatCSV = "csv"
+	// ReportFormatNDJSON 订阅历史导出格式（每行一个 JSON 对象）
+	ReportFormatNDJSON = "ndjson"
+	// HistoryExportBatchSize 导出订阅历史时每批读取和发送的记录数
+	HistoryExportBatchSize = 500
+	// HistoryExportTimeout HTTP 导出订阅历史的超时时间（不受 HTTP 服务超时限制）
+	HistoryExportTimeout = 10 * time.Minute
+	// MaxMetricRangeDays 订阅指标查询的最大日期范围（天）
+	MaxMetricRangeDays = 366
+	// DefaultMetricRangeDays 订阅指标查询未指定开始日期时的天数（包含结束日期）
+	DefaultMetricRangeDays = 30
+	// MaxReportMonths 同期群和 LTV 报表的最大统计月数
+	MaxReportMonths = 36
+	// DefaultReportMonths 同期群和 LTV 报表默认统计月数
+	DefaultReportMonths = 12
+	// MaxLTVMonths LTV 估算的最长订阅时长（月），流失率很低时预期订阅时长不超过该值
+	MaxLTVMonths = 60
+	// MinLTVEndedPeriods 估算周期套餐 LTV 至少需要的已结束订阅周期数，不足时 LTV 未知
+	MinLTVEndedPeriods = 10
+)
+
+// 监控指标标签值
+const (
+	MetricResultSuccess = "success"
+	MetricResultFailed  = "failed"
+	MetricResultSkipped = "skipped"
+	// MetricResultRefundRequired 已支付但未开通订阅，需要退款
+	MetricResultRefundRequired = "refund_required"
+
+	MetricCacheHit  = "hit"
+	MetricCacheMiss = "miss"
+
+	// MetricCacheSubscription 用户订阅缓存
+	MetricCacheSubscription = "subscription"
+	// MetricLockSubscription 用户订阅锁（生命周期操作和自动续费）
+	MetricLockSubscription = "subscription"
+	// MetricLockCronJob 定时任务执行锁
+	MetricLockCronJob = "cron_job"
+)
+
+// 定时任务名称（用于监控指标和日志）
+const (
+	CronJobExpiryCheck        = "expiry_check"
+	CronJobRenewalReminder    = "renewal_reminder"
+	CronJobAutoRenewal        = "auto_renewal"
+	CronJobPriceChangeNotice  = "price_change_notice"
+	CronJobExchangeRateImport = "exchange_rate_import"
+	CronJobMetricSnapshot     = "metric_snapshot"
+	CronJobBulkOperation      = "bulk_operation"
+)
+
+// 链路追踪导出方式
+const (
+	TraceExporterOTLP   = "otlp"   // OTLP gRPC（如 OpenTelemetry Collector、Jaeger、Tempo）
+	TraceExporterStdout = "stdout" // 输出到标准输出（本地调试）
+	TraceExporterFile   = "file"   // 输出到本地文件（本地调试）
+)
+
+// 健康检查状态
+const (
+	HealthStatusUp       = "UP"
+	HealthStatusDown     = "DOWN"
+	HealthStatusDisabled = "DISABLED" // 未配置的可选依赖
+)
+
+const (
+	// HealthCheckTimeout 就绪检查的超时时间（所有依赖并发检查）
+	HealthCheckTimeout = 2 * time.Second
+	// HealthServiceReadiness gRPC 健康检查中表示就绪状态的服务名（空服务名表示存活状态）
+	HealthServiceReadiness = "readiness"
+)
+
+// 健康检查依赖名称
+const (
+	DependencyMySQL           = "mysql"
+	DependencyRedis           = "redis"
+	DependencyPaymentService  = "payment-service"
+	DependencyPassportService = "passport-service"
+)
+
+// 定时任务执行状态
+const (
+	JobRunStatusRunning = "running"
+	JobRunStatusSuccess = "success"
+	JobRunStatusFailed  = "failed"
+	JobRunStatusSkipped = "skipped" // 任务已暂停，跳过本次计划
+)
+
+// 定时任务触发方式
+const (
+	JobTriggerSchedule = "schedule" // 按计划执行
+	JobTriggerCatchUp  = "catch_up" // 停机期间错过的计划补跑
+	JobTriggerRetry    = "retry"    // 失败或中途退出后重试同一计划（从保存的进度继续）
+	JobTriggerManual   = "manual"   // 管理接口手动触发（不影响补跑和重试）
+)
+
+const (
+	// CronLeaderLockKey Cron 服务主节点锁（多副本部署时只有主节点执行定时任务）
+	CronLeaderLockKey = "subscription:cron:leader"
+	// CronLeaderLockExpiration 主节点锁过期时间，主节点每 1/3 过期时间续期一次
+	CronLeaderLockExpiration = 30 * time.Second
+	// DefaultCronCatchUpWindow 默认补跑窗口：只补跑该时间范围内错过的计划
+	DefaultCronCatchUpWindow = 7 * 24 * time.Hour
+	// CronCatchUpMaxRuns 每个任务单次最多补跑的次数（逐次补跑的任务，如指标快照）
+	CronCatchUpMaxRuns = 31
+	// CronCatchUpInterval 主节点检查错过的计划和需要重试的执行的间隔
+	CronCatchUpInterval = time.Minute
+	// CronJobStaleGrace 执行超过任务超时时间加该宽限时间仍为 running 时，视为实例中途退出
+	CronJobStaleGrace = time.Minute
+	// CronJobMaxAttempts 可续跑任务同一计划的最多执行次数（含首次执行）
+	CronJobMaxAttempts = 3
+	// CronJobLockKeyPrefix 定时任务执行锁前缀（同一任务在所有实例中同时只有一个执行）
+	CronJobLockKeyPrefix = "subscription:cron:job:"
+	// DefaultCronAdminHTTPAddr 定时任务管理接口默认 HTTP 监听地址（默认只监听本机）
+	DefaultCronAdminHTTPAddr = "127.0.0.1:8113"
+	// DefaultCronAdminGRPCAddr 定时任务管理接口默认 gRPC 监听地址（默认只监听本机）
+	DefaultCronAdminGRPCAddr = "127.0.0.1:9113"
+)
+
+// 客服查询订阅的时间字段
+const (
+	SupportTimeFieldStart   = "start_time"
+	SupportTimeFieldEnd     = "end_time"
+	SupportTimeFieldCreated = "created_at"
+	SupportTimeFieldUpdated = "updated_at"
+)
+
+// 客服时间线事件类型
+const (
+	TimelineEventOrderCreated = "order_created" // 创建订单
+	TimelineEventOrderPaid    = "order_paid"    // 订单支付成功（退款信息见订单的退款金额和支付状态）
+	TimelineEventHistory      = "history"       // 订阅变更记录
+)
+
+const (
+	// SupportTimelineLimit 客服时间线中返回的最近订单数和最近订阅历史数
+	SupportTimelineLimit = 100
+	// MaxSupportAdjustDays 客服单次延长、缩短或赠送的最大天数
+	MaxSupportAdjustDays = 3650
+)
+
+// 批量操作类型
+const (
+	BulkOperationExtend      = "extend"       // 延长订阅结束时间
+	BulkOperationMigratePlan = "migrate_plan" // 下次续费时迁移到新套餐
+)
+
+// 批量操作状态
+const (
+	BulkOperationStatusPending   = "pending"   // 等待后台任务执行
+	BulkOperationStatusRunning   = "running"   // 执行中
+	BulkOperationStatusCompleted = "completed" // 已完成
+	BulkOperationStatusCancelled = "cancelled" // 已取消（已处理的用户不回滚）
+)
+
+const (
+	// BulkOperationBatchSize 批量操作每批处理的订阅数（每批处理后保存进度）
+	BulkOperationBatchSize = 200
+	// BulkOperationErrorMaxLen 批量操作记录的错误信息最大长度（字符数）
+	BulkOperationErrorMaxLen = 1024
+	// DefaultBulkOperationTimeout 批量操作任务单次执行的超时时间，未处理完的操作下次执行时从进度处继续
+	DefaultBulkOperationTimeout = 10 * time.Minute
+)
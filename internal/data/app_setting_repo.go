@@ -0,0 +1,72 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"xinyuan_tech/subscription-service/internal/biz"
+	"xinyuan_tech/subscription-service/internal/data/model"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+)
+
+// appSettingRepo 应用配置仓库实现
+type appSettingRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewAppSettingRepo 创建应用配置仓库
+func NewAppSettingRepo(data *Data, logger log.Logger) biz.AppSettingRepo {
+	return &appSettingRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// GetAppSetting 获取应用配置
+func (r *appSettingRepo) GetAppSetting(ctx context.Context, appID string) (*biz.AppSetting, error) {
+	var m model.AppSetting
+	err := r.data.DB(ctx).Where("app_id = ?", appID).First(&m).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		r.log.Errorf("Failed to get app setting for %s: %v", appID, err)
+		return nil, err
+	}
+	return &biz.AppSetting{
+		AppID:             m.AppID,
+		DefaultFreePlanID: m.DefaultFreePlanID,
+		RegionDetection:   splitList(m.RegionDetection),
+		ReportingCurrency: m.ReportingCurrency,
+		CreatedAt:         m.CreatedAt,
+		UpdatedAt:         m.UpdatedAt,
+	}, nil
+}
+
+// SaveAppSetting 保存应用配置（不存在则创建）
+func (r *appSettingRepo) SaveAppSetting(ctx context.Context, setting *biz.AppSetting) error {
+	m := &model.AppSetting{
+		AppID:             setting.AppID,
+		DefaultFreePlanID: setting.DefaultFreePlanID,
+		RegionDetection:   strings.Join(setting.RegionDetection, ","),
+		ReportingCurrency: setting.ReportingCurrency,
+		CreatedAt:         setting.CreatedAt,
+		UpdatedAt:         setting.UpdatedAt,
+	}
+	if err := r.data.DB(ctx).Save(m).Error; err != nil {
+		r.log.Errorf("Failed to save app setting for %s: %v", setting.AppID, err)
+		return err
+	}
+	return nil
+}
+
+// splitList 拆分逗号分隔的字符串，空字符串返回 nil
+func splitList(s string) []string {
+	if s == "" {
+		return nil
+	}
+	return strings.Split(s, ",")
+}
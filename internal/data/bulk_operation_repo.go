@@ -0,0 +1,181 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"time"
+	"xinyuan_tech/subscription-service/internal/biz"
+	"xinyuan_tech/subscription-service/internal/constants"
+	"xinyuan_tech/subscription-service/internal/data/model"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+)
+
+// unfinishedBulkOperationStatuses 未结束的批量操作状态
+var unfinishedBulkOperationStatuses = []string{constants.BulkOperationStatusPending, constants.BulkOperationStatusRunning}
+
+// bulkOperationRepo 批量操作仓库实现
+type bulkOperationRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewBulkOperationRepo 创建批量操作仓库
+func NewBulkOperationRepo(data *Data, logger log.Logger) biz.BulkOperationRepo {
+	return &bulkOperationRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// CreateBulkOperation 创建批量操作
+func (r *bulkOperationRepo) CreateBulkOperation(ctx context.Context, op *biz.BulkOperation) error {
+	m := &model.BulkOperation{
+		Type:              op.Type,
+		AppID:             op.AppID,
+		PlanID:            op.PlanID,
+		FilterStatus:      op.FilterStatus,
+		Days:              op.Days,
+		TargetPlanID:      op.TargetPlanID,
+		OperatorID:        op.OperatorID,
+		Reason:            op.Reason,
+		Status:            op.Status,
+		MaxSubscriptionID: op.MaxSubscriptionID,
+		TotalCount:        op.TotalCount,
+		FinishedAt:        timePtr(op.FinishedAt),
+		CreatedAt:         op.CreatedAt,
+		UpdatedAt:         op.UpdatedAt,
+	}
+	if err := r.data.DB(ctx).Create(m).Error; err != nil {
+		r.log.Errorf("Failed to create bulk operation: %v", err)
+		return err
+	}
+	op.BulkOperationID = m.BulkOperationID
+	return nil
+}
+
+// GetBulkOperation 获取批量操作，不存在时返回 nil
+func (r *bulkOperationRepo) GetBulkOperation(ctx context.Context, id uint64) (*biz.BulkOperation, error) {
+	var m model.BulkOperation
+	err := r.data.DB(ctx).Where("bulk_operation_id = ?", id).First(&m).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		r.log.Errorf("Failed to get bulk operation %d: %v", id, err)
+		return nil, err
+	}
+	return toBizBulkOperation(&m), nil
+}
+
+// ListBulkOperations 分页查询批量操作，按创建时间倒序
+func (r *bulkOperationRepo) ListBulkOperations(ctx context.Context, appID, status string, page, pageSize int) ([]*biz.BulkOperation, int, error) {
+	query := r.data.DB(ctx).Model(&model.BulkOperation{})
+	if appID != "" {
+		query = query.Where("app_id = ?", appID)
+	}
+	if status != "" {
+		query = query.Where("status = ?", status)
+	}
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		r.log.Errorf("Failed to count bulk operations: %v", err)
+		return nil, 0, err
+	}
+	var models []model.BulkOperation
+	if err := query.Order("bulk_operation_id DESC").
+		Offset((page - 1) * pageSize).Limit(pageSize).
+		Find(&models).Error; err != nil {
+		r.log.Errorf("Failed to list bulk operations: %v", err)
+		return nil, 0, err
+	}
+	ops := make([]*biz.BulkOperation, len(models))
+	for i := range models {
+		ops[i] = toBizBulkOperation(&models[i])
+	}
+	return ops, int(total), nil
+}
+
+// ListUnfinishedBulkOperations 获取等待执行和执行中的批量操作，按创建顺序
+func (r *bulkOperationRepo) ListUnfinishedBulkOperations(ctx context.Context) ([]*biz.BulkOperation, error) {
+	var models []model.BulkOperation
+	if err := r.data.DB(ctx).Where("status IN ?", unfinishedBulkOperationStatuses).
+		Order("bulk_operation_id ASC").
+		Find(&models).Error; err != nil {
+		r.log.Errorf("Failed to list unfinished bulk operations: %v", err)
+		return nil, err
+	}
+	ops := make([]*biz.BulkOperation, len(models))
+	for i := range models {
+		ops[i] = toBizBulkOperation(&models[i])
+	}
+	return ops, nil
+}
+
+// UpdateBulkOperation 保存执行进度和状态，只更新未结束的操作，操作已被取消时返回 false
+func (r *bulkOperationRepo) UpdateBulkOperation(ctx context.Context, op *biz.BulkOperation) (bool, error) {
+	result := r.data.DB(ctx).Model(&model.BulkOperation{}).
+		Where("bulk_operation_id = ? AND status IN ?", op.BulkOperationID, unfinishedBulkOperationStatuses).
+		Updates(map[string]interface{}{
+			"status":                 op.Status,
+			"cursor_subscription_id": op.CursorSubscriptionID,
+			"processed_count":        op.ProcessedCount,
+			"success_count":          op.SuccessCount,
+			"skipped_count":          op.SkippedCount,
+			"failed_count":           op.FailedCount,
+			"error":                  op.Error,
+			"started_at":             timePtr(op.StartedAt),
+			"finished_at":            timePtr(op.FinishedAt),
+			"updated_at":             op.UpdatedAt,
+		})
+	if result.Error != nil {
+		r.log.Errorf("Failed to update bulk operation %d: %v", op.BulkOperationID, result.Error)
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
+
+// CancelBulkOperation 取消未结束的批量操作，操作已结束时返回 false
+func (r *bulkOperationRepo) CancelBulkOperation(ctx context.Context, id uint64, now time.Time) (bool, error) {
+	result := r.data.DB(ctx).Model(&model.BulkOperation{}).
+		Where("bulk_operation_id = ? AND status IN ?", id, unfinishedBulkOperationStatuses).
+		Updates(map[string]interface{}{
+			"status":      constants.BulkOperationStatusCancelled,
+			"finished_at": now,
+			"updated_at":  now,
+		})
+	if result.Error != nil {
+		r.log.Errorf("Failed to cancel bulk operation %d: %v", id, result.Error)
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
+
+// toBizBulkOperation 转换为业务对象
+func toBizBulkOperation(m *model.BulkOperation) *biz.BulkOperation {
+	return &biz.BulkOperation{
+		BulkOperationID:      m.BulkOperationID,
+		Type:                 m.Type,
+		AppID:                m.AppID,
+		PlanID:               m.PlanID,
+		FilterStatus:         m.FilterStatus,
+		Days:                 m.Days,
+		TargetPlanID:         m.TargetPlanID,
+		OperatorID:           m.OperatorID,
+		Reason:               m.Reason,
+		Status:               m.Status,
+		MaxSubscriptionID:    m.MaxSubscriptionID,
+		CursorSubscriptionID: m.CursorSubscriptionID,
+		TotalCount:           m.TotalCount,
+		ProcessedCount:       m.ProcessedCount,
+		SuccessCount:         m.SuccessCount,
+		SkippedCount:         m.SkippedCount,
+		FailedCount:          m.FailedCount,
+		Error:                m.Error,
+		StartedAt:            timeValue(m.StartedAt),
+		FinishedAt:           timeValue(m.FinishedAt),
+		CreatedAt:            m.CreatedAt,
+		UpdatedAt:            m.UpdatedAt,
+	}
+}
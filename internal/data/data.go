@@ -10,9 +10,11 @@ import (
 	"github.com/go-redsync/redsync/v4"
 	"github.com/go-redsync/redsync/v4/redis/goredis/v9"
 	"github.com/google/wire"
+	"github.com/redis/go-redis/extra/redisotel/v9"
 	"github.com/redis/go-redis/v9" // Updated Redis import
 	"gorm.io/driver/mysql"
 	"gorm.io/gorm"
+	"gorm.io/plugin/opentelemetry/tracing"
 )
 
 // ProviderSet is data providers.
@@ -25,8 +27,20 @@ var ProviderSet = wire.NewSet(
 	NewUserSubscriptionRepo,
 	NewSubscriptionOrderRepo,
 	NewSubscriptionHistoryRepo,
+	NewAppSettingRepo,
+	NewRegionGroupRepo,
+	NewTaxRuleRepo,
+	NewInvoiceRepo,
+	NewExchangeRateRepo,
+	NewMetricRepo,
+	NewJobRunRepo,
+	NewPriceChangeNoticeRepo,
+	NewBulkOperationRepo,
+	NewNotifier,
 	NewPaymentClient,
 	NewPassportClient,
+	NewGeoIPResolver,
+	NewHealthChecker,
 	wire.Bind(new(biz.Transaction), new(*Data)),
 )
 
@@ -38,12 +52,52 @@ type Data struct {
 
 type contextTxKey struct{}
 
-// Exec 执行事务
+// txState 事务连接以及提交后执行的回调
+type txState struct {
+	db          *gorm.DB
+	afterCommit []func()
+}
+
+// Exec 执行事务，已在事务中时加入当前事务；事务提交后执行 AfterCommit 注册的回调
 func (d *Data) Exec(ctx context.Context, fn func(ctx context.Context) error) error {
-	return d.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
-		ctx = context.WithValue(ctx, contextTxKey{}, tx)
+	if _, ok := ctx.Value(contextTxKey{}).(*txState); ok {
 		return fn(ctx)
+	}
+	state := &txState{}
+	err := d.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		state.db = tx
+		return fn(context.WithValue(ctx, contextTxKey{}, state))
 	})
+	if err != nil {
+		return err
+	}
+	for _, f := range state.afterCommit {
+		f()
+	}
+	return nil
+}
+
+// DB 获取当前 Context 的数据库连接：在 Exec 事务中时返回事务连接，否则返回普通连接
+func (d *Data) DB(ctx context.Context) *gorm.DB {
+	if state, ok := ctx.Value(contextTxKey{}).(*txState); ok {
+		return state.db
+	}
+	return d.db.WithContext(ctx)
+}
+
+// InTx 当前 Context 是否在 Exec 事务中
+func (d *Data) InTx(ctx context.Context) bool {
+	_, ok := ctx.Value(contextTxKey{}).(*txState)
+	return ok
+}
+
+// AfterCommit 在事务提交后执行 fn（如删除缓存，避免事务提交前其他请求读到旧数据并重新写入缓存），不在事务中时立即执行；事务回滚时不执行
+func (d *Data) AfterCommit(ctx context.Context, fn func()) {
+	if state, ok := ctx.Value(contextTxKey{}).(*txState); ok {
+		state.afterCommit = append(state.afterCommit, fn)
+		return
+	}
+	fn()
 }
 
 // NewData .
@@ -68,6 +122,10 @@ func NewDB(c *conf.Bootstrap) *gorm.DB {
 	if err != nil {
 		panic(err)
 	}
+	// 链路追踪：每条 SQL 记录一个 span（不记录查询参数，避免泄露用户数据）
+	if err := db.Use(tracing.NewPlugin(tracing.WithoutMetrics(), tracing.WithoutQueryVariables())); err != nil {
+		panic(err)
+	}
 
 	// Configure connection pool
 	sqlDB, err := db.DB()
@@ -128,6 +186,10 @@ func NewRedis(c *conf.Bootstrap) *redis.Client {
 		PoolSize:     int(poolSize),
 		MinIdleConns: int(minIdleConns),
 	})
+	// 链路追踪：每条 Redis 命令记录一个 span
+	if err := redisotel.InstrumentTracing(rdb); err != nil {
+		panic(err)
+	}
 	return rdb
 }
 
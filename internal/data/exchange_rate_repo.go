@@ -0,0 +1,108 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"time"
+	"xinyuan_tech/subscription-service/internal/biz"
+	"xinyuan_tech/subscription-service/internal/data/model"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// exchangeRateRepo 汇率仓库实现
+type exchangeRateRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewExchangeRateRepo 创建汇率仓库
+func NewExchangeRateRepo(data *Data, logger log.Logger) biz.ExchangeRateRepo {
+	return &exchangeRateRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// SaveExchangeRates 批量保存汇率（币种对和生效时间相同的汇率覆盖）
+func (r *exchangeRateRepo) SaveExchangeRates(ctx context.Context, rates []*biz.ExchangeRate) error {
+	models := make([]*model.ExchangeRate, len(rates))
+	for i, rate := range rates {
+		models[i] = &model.ExchangeRate{
+			FromCurrency: rate.FromCurrency,
+			ToCurrency:   rate.ToCurrency,
+			Rate:         rate.Rate,
+			EffectiveAt:  rate.EffectiveAt,
+			Source:       rate.Source,
+			CreatedAt:    rate.CreatedAt,
+		}
+	}
+	err := r.data.DB(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "from_currency"}, {Name: "to_currency"}, {Name: "effective_at"}},
+		DoUpdates: clause.AssignmentColumns([]string{"rate", "source"}),
+	}).CreateInBatches(models, 100).Error
+	if err != nil {
+		r.log.Errorf("Failed to save exchange rates: %v", err)
+		return err
+	}
+	return nil
+}
+
+// GetEffectiveRate 获取指定时间生效的汇率，不存在时返回 nil
+func (r *exchangeRateRepo) GetEffectiveRate(ctx context.Context, from, to string, at time.Time) (*biz.ExchangeRate, error) {
+	var m model.ExchangeRate
+	err := r.data.DB(ctx).
+		Where("from_currency = ? AND to_currency = ? AND effective_at <= ?", from, to, at).
+		Order("effective_at DESC").
+		First(&m).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		r.log.Errorf("Failed to get exchange rate %s->%s at %v: %v", from, to, at, err)
+		return nil, err
+	}
+	return toBizExchangeRate(&m), nil
+}
+
+// ListExchangeRates 分页获取汇率
+func (r *exchangeRateRepo) ListExchangeRates(ctx context.Context, from, to string, page, pageSize int) ([]*biz.ExchangeRate, int, error) {
+	query := r.data.DB(ctx).Model(&model.ExchangeRate{})
+	if from != "" {
+		query = query.Where("from_currency = ?", from)
+	}
+	if to != "" {
+		query = query.Where("to_currency = ?", to)
+	}
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		r.log.Errorf("Failed to count exchange rates: %v", err)
+		return nil, 0, err
+	}
+	var models []model.ExchangeRate
+	if err := query.Order("effective_at DESC, exchange_rate_id DESC").
+		Offset((page - 1) * pageSize).Limit(pageSize).
+		Find(&models).Error; err != nil {
+		r.log.Errorf("Failed to list exchange rates: %v", err)
+		return nil, 0, err
+	}
+	rates := make([]*biz.ExchangeRate, len(models))
+	for i := range models {
+		rates[i] = toBizExchangeRate(&models[i])
+	}
+	return rates, int(total), nil
+}
+
+func toBizExchangeRate(m *model.ExchangeRate) *biz.ExchangeRate {
+	return &biz.ExchangeRate{
+		ExchangeRateID: m.ExchangeRateID,
+		FromCurrency:   m.FromCurrency,
+		ToCurrency:     m.ToCurrency,
+		Rate:           m.Rate,
+		EffectiveAt:    m.EffectiveAt,
+		Source:         m.Source,
+		CreatedAt:      m.CreatedAt,
+	}
+}
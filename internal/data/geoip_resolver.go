@@ -0,0 +1,135 @@
+package data
+
+import (
+	"context"
+	"net"
+	"os"
+	"sync"
+	"time"
+	"xinyuan_tech/subscription-service/internal/biz"
+	"xinyuan_tech/subscription-service/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/oschwald/geoip2-golang"
+)
+
+// defaultGeoIPReloadInterval 默认检查 GeoIP 数据库文件更新的间隔
+const defaultGeoIPReloadInterval = time.Minute
+
+// mmdbGeoIPResolver 基于本地 MaxMind mmdb 数据库的 GeoIP 解析
+// 定期检查数据库文件的修改时间，变化后自动重新加载，无需重启服务
+type mmdbGeoIPResolver struct {
+	path    string
+	mu      sync.RWMutex
+	reader  *geoip2.Reader
+	modTime time.Time
+	stop    chan struct{}
+	log     *log.Helper
+}
+
+// NewGeoIPResolver 创建离线 GeoIP 解析器
+// 未配置数据库路径时返回空实现；数据库暂时无法打开时不阻止启动，等待文件就绪后自动加载
+func NewGeoIPResolver(c *conf.Bootstrap, logger log.Logger) (biz.GeoIPResolver, func(), error) {
+	path := ""
+	interval := defaultGeoIPReloadInterval
+	if c != nil && c.GetGeoip() != nil {
+		path = c.GetGeoip().GetDatabasePath()
+		if c.GetGeoip().GetReloadInterval() != nil && c.GetGeoip().GetReloadInterval().AsDuration() > 0 {
+			interval = c.GetGeoip().GetReloadInterval().AsDuration()
+		}
+	}
+	if path == "" {
+		return &emptyGeoIPResolver{}, func() {}, nil
+	}
+
+	r := &mmdbGeoIPResolver{
+		path: path,
+		stop: make(chan struct{}),
+		log:  log.NewHelper(logger),
+	}
+	r.reload()
+	go r.watch(interval)
+
+	cleanup := func() {
+		close(r.stop)
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		if r.reader != nil {
+			r.reader.Close()
+			r.reader = nil
+		}
+	}
+	return r, cleanup, nil
+}
+
+// LookupCountry 根据 IP 查询国家代码（ISO 3166-1 alpha-2）
+func (r *mmdbGeoIPResolver) LookupCountry(ctx context.Context, ip string) (string, error) {
+	parsed := net.ParseIP(ip)
+	if parsed == nil {
+		return "", nil
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	if r.reader == nil {
+		return "", nil
+	}
+	record, err := r.reader.Country(parsed)
+	if err != nil {
+		return "", err
+	}
+	return record.Country.IsoCode, nil
+}
+
+// watch 定期检查数据库文件是否更新
+func (r *mmdbGeoIPResolver) watch(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-r.stop:
+			return
+		case <-ticker.C:
+			r.reload()
+		}
+	}
+}
+
+// reload 数据库文件修改时间变化时重新加载，加载失败时继续使用旧数据库
+func (r *mmdbGeoIPResolver) reload() {
+	info, err := os.Stat(r.path)
+	if err != nil {
+		r.log.Warnf("GeoIP database %s not available: %v", r.path, err)
+		return
+	}
+	r.mu.RLock()
+	unchanged := r.reader != nil && info.ModTime().Equal(r.modTime)
+	r.mu.RUnlock()
+	if unchanged {
+		return
+	}
+
+	reader, err := geoip2.Open(r.path)
+	if err != nil {
+		r.log.Errorf("Failed to open GeoIP database %s: %v", r.path, err)
+		return
+	}
+
+	r.mu.Lock()
+	old := r.reader
+	r.reader = reader
+	r.modTime = info.ModTime()
+	r.mu.Unlock()
+
+	if old != nil {
+		old.Close()
+	}
+	r.log.Infof("Loaded GeoIP database %s (type=%s, build=%d)", r.path, reader.Metadata().DatabaseType, reader.Metadata().BuildEpoch)
+}
+
+// emptyGeoIPResolver 未配置 GeoIP 数据库时的空实现
+type emptyGeoIPResolver struct{}
+
+func (e *emptyGeoIPResolver) LookupCountry(ctx context.Context, ip string) (string, error) {
+	return "", nil
+}
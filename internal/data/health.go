@@ -0,0 +1,105 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+	"xinyuan_tech/subscription-service/internal/biz"
+	"xinyuan_tech/subscription-service/internal/constants"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/connectivity"
+)
+
+// grpcConnHolder 持有 gRPC 连接的下游服务客户端
+type grpcConnHolder interface {
+	clientConn() *grpc.ClientConn
+}
+
+// healthChecker 依赖健康检查实现
+type healthChecker struct {
+	data     *Data
+	payment  biz.PaymentClient
+	passport biz.PassportClient
+}
+
+// NewHealthChecker 创建依赖健康检查
+func NewHealthChecker(data *Data, payment biz.PaymentClient, passport biz.PassportClient) biz.HealthChecker {
+	return &healthChecker{data: data, payment: payment, passport: passport}
+}
+
+// CheckDependencies 并发检查 MySQL、Redis、payment-service 和 passport-service
+// passport-service 未配置或不可用时地区推断会跳过该来源，因此不影响就绪状态
+func (h *healthChecker) CheckDependencies(ctx context.Context) []*biz.DependencyHealth {
+	checks := []struct {
+		name     string
+		required bool
+		check    func(context.Context) error
+	}{
+		{constants.DependencyMySQL, true, h.pingDB},
+		{constants.DependencyRedis, true, func(ctx context.Context) error { return h.data.rdb.Ping(ctx).Err() }},
+		{constants.DependencyPaymentService, true, grpcCheck(h.payment)},
+		{constants.DependencyPassportService, false, grpcCheck(h.passport)},
+	}
+
+	result := make([]*biz.DependencyHealth, len(checks))
+	var wg sync.WaitGroup
+	for i, c := range checks {
+		result[i] = &biz.DependencyHealth{Name: c.name, Required: c.required, Status: constants.HealthStatusDisabled}
+		if c.check == nil {
+			continue
+		}
+		wg.Add(1)
+		go func(dep *biz.DependencyHealth, check func(context.Context) error) {
+			defer wg.Done()
+			start := time.Now()
+			err := check(ctx)
+			dep.Latency = time.Since(start)
+			dep.Status = constants.HealthStatusUp
+			if err != nil {
+				dep.Status = constants.HealthStatusDown
+				dep.Error = err.Error()
+			}
+		}(result[i], c.check)
+	}
+	wg.Wait()
+	return result
+}
+
+func (h *healthChecker) pingDB(ctx context.Context) error {
+	sqlDB, err := h.data.db.DB()
+	if err != nil {
+		return err
+	}
+	return sqlDB.PingContext(ctx)
+}
+
+// grpcCheck 返回下游 gRPC 连接的检查函数，客户端没有连接（未配置）时返回 nil
+func grpcCheck(client interface{}) func(context.Context) error {
+	holder, ok := client.(grpcConnHolder)
+	if !ok || holder.clientConn() == nil {
+		return nil
+	}
+	conn := holder.clientConn()
+	return func(ctx context.Context) error {
+		return waitForReady(ctx, conn)
+	}
+}
+
+// waitForReady 触发连接并等待连接就绪，连接失败或超时返回错误
+func waitForReady(ctx context.Context, conn *grpc.ClientConn) error {
+	conn.Connect()
+	for {
+		state := conn.GetState()
+		switch state {
+		case connectivity.Ready:
+			return nil
+		case connectivity.TransientFailure, connectivity.Shutdown:
+			return fmt.Errorf("connection %s", state)
+		}
+		if !conn.WaitForStateChange(ctx, state) {
+			return fmt.Errorf("connection %s: %w", state, ctx.Err())
+		}
+	}
+}
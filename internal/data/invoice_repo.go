@@ -0,0 +1,236 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"xinyuan_tech/subscription-service/internal/biz"
+	"xinyuan_tech/subscription-service/internal/constants"
+	"xinyuan_tech/subscription-service/internal/data/model"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// invoiceRepo 发票仓库实现
+type invoiceRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewInvoiceRepo 创建发票仓库
+func NewInvoiceRepo(data *Data, logger log.Logger) biz.InvoiceRepo {
+	return &invoiceRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// CreateInvoice 分配应用内连续编号并保存发票及明细
+// 编号序列行使用 SELECT ... FOR UPDATE 加锁，与发票在同一事务中提交，保证编号连续且不重复
+func (r *invoiceRepo) CreateInvoice(ctx context.Context, invoice *biz.Invoice) error {
+	return r.data.DB(ctx).Transaction(func(tx *gorm.DB) error {
+		seq, err := r.nextInvoiceSeq(tx, invoice.AppID, invoice.InvoiceType)
+		if err != nil {
+			r.log.Errorf("Failed to allocate invoice number for app %s: %v", invoice.AppID, err)
+			return err
+		}
+		prefix := constants.InvoiceNoPrefix
+		if invoice.InvoiceType == constants.InvoiceTypeCreditNote {
+			prefix = constants.CreditNoteNoPrefix
+		}
+		invoice.InvoiceNo = fmt.Sprintf("%s-%06d", prefix, seq)
+
+		m := toModelInvoice(invoice)
+		if err := tx.Create(m).Error; err != nil {
+			r.log.Errorf("Failed to create invoice %s: %v", invoice.InvoiceNo, err)
+			return err
+		}
+		invoice.InvoiceID = m.InvoiceID
+		if len(invoice.Lines) == 0 {
+			return nil
+		}
+		lines := make([]model.InvoiceLine, len(invoice.Lines))
+		for i, l := range invoice.Lines {
+			lines[i] = model.InvoiceLine{
+				InvoiceID:     m.InvoiceID,
+				LineType:      l.LineType,
+				Description:   l.Description,
+				PeriodStart:   timePtr(l.PeriodStart),
+				PeriodEnd:     timePtr(l.PeriodEnd),
+				TaxRate:       l.TaxRate,
+				Inclusive:     l.Inclusive,
+				ReverseCharge: l.ReverseCharge,
+				Amount:        l.Amount,
+			}
+		}
+		if err := tx.Create(&lines).Error; err != nil {
+			r.log.Errorf("Failed to create lines of invoice %s: %v", invoice.InvoiceNo, err)
+			return err
+		}
+		return nil
+	})
+}
+
+// nextInvoiceSeq 获取并递增发票编号序列（需要在事务中调用）
+func (r *invoiceRepo) nextInvoiceSeq(tx *gorm.DB, appID, invoiceType string) (uint64, error) {
+	var seq model.InvoiceSequence
+	err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("app_id = ? AND invoice_type = ?", appID, invoiceType).
+		First(&seq).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		// 应用的第一张发票：插入序列行（并发插入时忽略冲突），再加锁读取
+		if err := tx.Clauses(clause.OnConflict{DoNothing: true}).
+			Create(&model.InvoiceSequence{AppID: appID, InvoiceType: invoiceType, NextValue: 1}).Error; err != nil {
+			return 0, err
+		}
+		err = tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("app_id = ? AND invoice_type = ?", appID, invoiceType).
+			First(&seq).Error
+	}
+	if err != nil {
+		return 0, err
+	}
+	if err := tx.Model(&model.InvoiceSequence{}).
+		Where("app_id = ? AND invoice_type = ?", appID, invoiceType).
+		Update("next_value", seq.NextValue+1).Error; err != nil {
+		return 0, err
+	}
+	return seq.NextValue, nil
+}
+
+// GetInvoice 获取发票（包含明细），不存在时返回 nil
+func (r *invoiceRepo) GetInvoice(ctx context.Context, invoiceID uint64) (*biz.Invoice, error) {
+	var m model.Invoice
+	err := r.data.DB(ctx).First(&m, "invoice_id = ?", invoiceID).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	invoices, err := r.withLines(ctx, []model.Invoice{m})
+	if err != nil {
+		return nil, err
+	}
+	return invoices[0], nil
+}
+
+// ListInvoicesByOrder 获取订单的发票和红字发票（包含明细）
+func (r *invoiceRepo) ListInvoicesByOrder(ctx context.Context, orderID string) ([]*biz.Invoice, error) {
+	var ms []model.Invoice
+	if err := r.data.DB(ctx).Where("order_id = ?", orderID).Order("invoice_id ASC").Find(&ms).Error; err != nil {
+		return nil, err
+	}
+	return r.withLines(ctx, ms)
+}
+
+// ListInvoices 分页获取用户的发票（不包含明细）
+func (r *invoiceRepo) ListInvoices(ctx context.Context, appID, uid string, page, pageSize int) ([]*biz.Invoice, int, error) {
+	query := r.data.DB(ctx).Model(&model.Invoice{}).Where("uid = ?", uid)
+	if appID != "" {
+		query = query.Where("app_id = ?", appID)
+	}
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		r.log.Errorf("Failed to count invoices of user %s: %v", uid, err)
+		return nil, 0, err
+	}
+	var ms []model.Invoice
+	if err := query.Order("issued_at DESC, invoice_id DESC").
+		Offset((page - 1) * pageSize).
+		Limit(pageSize).
+		Find(&ms).Error; err != nil {
+		r.log.Errorf("Failed to list invoices of user %s: %v", uid, err)
+		return nil, 0, err
+	}
+	result := make([]*biz.Invoice, len(ms))
+	for i := range ms {
+		result[i] = toBizInvoice(&ms[i])
+	}
+	return result, int(total), nil
+}
+
+// withLines 批量加载发票明细
+func (r *invoiceRepo) withLines(ctx context.Context, ms []model.Invoice) ([]*biz.Invoice, error) {
+	result := make([]*biz.Invoice, len(ms))
+	if len(ms) == 0 {
+		return result, nil
+	}
+	ids := make([]uint64, len(ms))
+	byID := make(map[uint64]*biz.Invoice, len(ms))
+	for i := range ms {
+		result[i] = toBizInvoice(&ms[i])
+		ids[i] = ms[i].InvoiceID
+		byID[ms[i].InvoiceID] = result[i]
+	}
+	var lines []model.InvoiceLine
+	if err := r.data.DB(ctx).Where("invoice_id IN ?", ids).Order("invoice_line_id ASC").Find(&lines).Error; err != nil {
+		return nil, err
+	}
+	for _, l := range lines {
+		inv := byID[l.InvoiceID]
+		inv.Lines = append(inv.Lines, &biz.InvoiceLine{
+			LineType:      l.LineType,
+			Description:   l.Description,
+			PeriodStart:   timeValue(l.PeriodStart),
+			PeriodEnd:     timeValue(l.PeriodEnd),
+			TaxRate:       l.TaxRate,
+			Inclusive:     l.Inclusive,
+			ReverseCharge: l.ReverseCharge,
+			Amount:        l.Amount,
+		})
+	}
+	return result, nil
+}
+
+func toModelInvoice(inv *biz.Invoice) *model.Invoice {
+	return &model.Invoice{
+		InvoiceID:         inv.InvoiceID,
+		InvoiceNo:         inv.InvoiceNo,
+		InvoiceType:       inv.InvoiceType,
+		AppID:             inv.AppID,
+		UID:               inv.UID,
+		OrderID:           inv.OrderID,
+		OriginalInvoiceNo: inv.OriginalInvoiceNo,
+		PlanID:            inv.PlanID,
+		PlanName:          inv.PlanName,
+		CountryCode:       inv.CountryCode,
+		VatID:             inv.VatID,
+		Currency:          inv.Currency,
+		Subtotal:          inv.Subtotal,
+		Discount:          inv.Discount,
+		ProrationCredit:   inv.ProrationCredit,
+		TaxAmount:         inv.TaxAmount,
+		Total:             inv.Total,
+		PeriodStart:       timePtr(inv.PeriodStart),
+		PeriodEnd:         timePtr(inv.PeriodEnd),
+		IssuedAt:          inv.IssuedAt,
+	}
+}
+
+func toBizInvoice(m *model.Invoice) *biz.Invoice {
+	return &biz.Invoice{
+		InvoiceID:         m.InvoiceID,
+		InvoiceNo:         m.InvoiceNo,
+		InvoiceType:       m.InvoiceType,
+		AppID:             m.AppID,
+		UID:               m.UID,
+		OrderID:           m.OrderID,
+		OriginalInvoiceNo: m.OriginalInvoiceNo,
+		PlanID:            m.PlanID,
+		PlanName:          m.PlanName,
+		CountryCode:       m.CountryCode,
+		VatID:             m.VatID,
+		Currency:          m.Currency,
+		Subtotal:          m.Subtotal,
+		Discount:          m.Discount,
+		ProrationCredit:   m.ProrationCredit,
+		TaxAmount:         m.TaxAmount,
+		Total:             m.Total,
+		PeriodStart:       timeValue(m.PeriodStart),
+		PeriodEnd:         timeValue(m.PeriodEnd),
+		IssuedAt:          m.IssuedAt,
+	}
+}
@@ -0,0 +1,254 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"time"
+	"xinyuan_tech/subscription-service/internal/biz"
+	"xinyuan_tech/subscription-service/internal/constants"
+	"xinyuan_tech/subscription-service/internal/data/model"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// jobRunRepo 定时任务执行记录仓库实现
+type jobRunRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewJobRunRepo 创建定时任务执行记录仓库
+func NewJobRunRepo(data *Data, logger log.Logger) biz.JobRunRepo {
+	return &jobRunRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// CreateJobRun 创建执行记录，同一任务同一计划时间已有记录时返回 false
+func (r *jobRunRepo) CreateJobRun(ctx context.Context, run *biz.JobRun) (bool, error) {
+	now := time.Now().UTC()
+	m := &model.JobRun{
+		JobName:     run.JobName,
+		ScheduledAt: run.ScheduledAt,
+		Trigger:     run.Trigger,
+		Status:      run.Status,
+		Host:        run.Host,
+		StartedAt:   run.StartedAt,
+		FinishedAt:  timePtr(run.FinishedAt),
+		Attempts:    run.Attempts,
+		DryRun:      run.DryRun,
+		Error:       run.Error,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+	result := r.data.DB(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(m)
+	if result.Error != nil {
+		r.log.Errorf("Failed to create run of job %s: %v", run.JobName, result.Error)
+		return false, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return false, nil
+	}
+	run.JobRunID = m.JobRunID
+	return true, nil
+}
+
+// RetryJobRun 将失败的执行记录重新标记为执行中，已被其他实例重试时返回 false
+func (r *jobRunRepo) RetryJobRun(ctx context.Context, run *biz.JobRun) (bool, error) {
+	result := r.data.DB(ctx).Model(&model.JobRun{}).
+		Where("job_run_id = ? AND status = ? AND attempts = ?", run.JobRunID, constants.JobRunStatusFailed, run.Attempts).
+		Updates(map[string]interface{}{
+			"trigger_type": run.Trigger,
+			"status":       run.Status,
+			"host":         run.Host,
+			"started_at":   run.StartedAt,
+			"finished_at":  nil,
+			"error":        "",
+			"attempts":     gorm.Expr("attempts + 1"),
+			"updated_at":   time.Now().UTC(),
+		})
+	if result.Error != nil {
+		r.log.Errorf("Failed to retry job run %d: %v", run.JobRunID, result.Error)
+		return false, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return false, nil
+	}
+	run.Attempts++
+	return true, nil
+}
+
+// UpdateJobRun 更新执行结果
+func (r *jobRunRepo) UpdateJobRun(ctx context.Context, run *biz.JobRun) error {
+	err := r.data.DB(ctx).Model(&model.JobRun{}).
+		Where("job_run_id = ? AND attempts = ?", run.JobRunID, run.Attempts).
+		Updates(map[string]interface{}{
+			"status":        run.Status,
+			"finished_at":   timePtr(run.FinishedAt),
+			"total_count":   run.TotalCount,
+			"success_count": run.SuccessCount,
+			"failed_count":  run.FailedCount,
+			"error":         run.Error,
+			"updated_at":    time.Now().UTC(),
+		}).Error
+	if err != nil {
+		r.log.Errorf("Failed to update job run %d: %v", run.JobRunID, err)
+		return err
+	}
+	return nil
+}
+
+// GetLastJobRun 获取任务计划时间最近的执行记录（不含手动触发）
+func (r *jobRunRepo) GetLastJobRun(ctx context.Context, jobName string) (*biz.JobRun, error) {
+	var m model.JobRun
+	err := r.data.DB(ctx).Where("job_name = ? AND trigger_type <> ?", jobName, constants.JobTriggerManual).
+		Order("scheduled_at DESC").First(&m).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		r.log.Errorf("Failed to get last run of job %s: %v", jobName, err)
+		return nil, err
+	}
+	return toBizJobRun(&m), nil
+}
+
+// ListJobRuns 分页获取执行记录
+func (r *jobRunRepo) ListJobRuns(ctx context.Context, jobName, status string, page, pageSize int) ([]*biz.JobRun, int, error) {
+	query := r.data.DB(ctx).Model(&model.JobRun{})
+	if jobName != "" {
+		query = query.Where("job_name = ?", jobName)
+	}
+	if status != "" {
+		query = query.Where("status = ?", status)
+	}
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		r.log.Errorf("Failed to count job runs: %v", err)
+		return nil, 0, err
+	}
+	var models []model.JobRun
+	if err := query.Order("started_at DESC, job_run_id DESC").
+		Offset((page - 1) * pageSize).Limit(pageSize).
+		Find(&models).Error; err != nil {
+		r.log.Errorf("Failed to list job runs: %v", err)
+		return nil, 0, err
+	}
+	runs := make([]*biz.JobRun, len(models))
+	for i := range models {
+		runs[i] = toBizJobRun(&models[i])
+	}
+	return runs, int(total), nil
+}
+
+// FailStaleJobRuns 将超时仍在执行中的记录标记为失败
+func (r *jobRunRepo) FailStaleJobRuns(ctx context.Context, jobName string, startedBefore time.Time, reason string) (int64, error) {
+	now := time.Now().UTC()
+	result := r.data.DB(ctx).Model(&model.JobRun{}).
+		Where("job_name = ? AND status = ? AND started_at < ?", jobName, constants.JobRunStatusRunning, startedBefore).
+		Updates(map[string]interface{}{
+			"status":      constants.JobRunStatusFailed,
+			"finished_at": now,
+			"error":       reason,
+			"updated_at":  now,
+		})
+	if result.Error != nil {
+		r.log.Errorf("Failed to fail stale runs of job %s: %v", jobName, result.Error)
+		return 0, result.Error
+	}
+	return result.RowsAffected, nil
+}
+
+// GetJobCheckpoint 获取执行记录上保存的任务进度
+func (r *jobRunRepo) GetJobCheckpoint(ctx context.Context, jobName string, scheduledAt time.Time) (string, error) {
+	var m model.JobRun
+	err := r.data.DB(ctx).Select("checkpoint").
+		Where("job_name = ? AND scheduled_at = ?", jobName, scheduledAt).
+		First(&m).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return "", nil
+	}
+	if err != nil {
+		r.log.Errorf("Failed to get checkpoint of job %s: %v", jobName, err)
+		return "", err
+	}
+	return m.Checkpoint, nil
+}
+
+// SaveJobCheckpoint 保存任务进度到执行记录
+func (r *jobRunRepo) SaveJobCheckpoint(ctx context.Context, jobName string, scheduledAt time.Time, checkpoint string) error {
+	err := r.data.DB(ctx).Model(&model.JobRun{}).
+		Where("job_name = ? AND scheduled_at = ?", jobName, scheduledAt).
+		Updates(map[string]interface{}{
+			"checkpoint": checkpoint,
+			"updated_at": time.Now().UTC(),
+		}).Error
+	if err != nil {
+		r.log.Errorf("Failed to save checkpoint of job %s: %v", jobName, err)
+		return err
+	}
+	return nil
+}
+
+// GetJobSetting 获取任务设置
+func (r *jobRunRepo) GetJobSetting(ctx context.Context, jobName string) (*biz.JobSetting, error) {
+	var m model.JobSetting
+	err := r.data.DB(ctx).Where("job_name = ?", jobName).First(&m).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		r.log.Errorf("Failed to get setting of job %s: %v", jobName, err)
+		return nil, err
+	}
+	return &biz.JobSetting{
+		JobName:     m.JobName,
+		Paused:      m.Paused,
+		PauseReason: m.PauseReason,
+		PausedAt:    timeValue(m.PausedAt),
+		UpdatedAt:   m.UpdatedAt,
+	}, nil
+}
+
+// SaveJobSetting 保存任务设置（不存在时创建）
+func (r *jobRunRepo) SaveJobSetting(ctx context.Context, setting *biz.JobSetting) error {
+	m := &model.JobSetting{
+		JobName:     setting.JobName,
+		Paused:      setting.Paused,
+		PauseReason: setting.PauseReason,
+		PausedAt:    timePtr(setting.PausedAt),
+		CreatedAt:   setting.UpdatedAt,
+		UpdatedAt:   setting.UpdatedAt,
+	}
+	err := r.data.DB(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "job_name"}},
+		DoUpdates: clause.AssignmentColumns([]string{"paused", "pause_reason", "paused_at", "updated_at"}),
+	}).Create(m).Error
+	if err != nil {
+		r.log.Errorf("Failed to save setting of job %s: %v", setting.JobName, err)
+		return err
+	}
+	return nil
+}
+
+func toBizJobRun(m *model.JobRun) *biz.JobRun {
+	return &biz.JobRun{
+		JobRunID:     m.JobRunID,
+		JobName:      m.JobName,
+		ScheduledAt:  m.ScheduledAt,
+		Trigger:      m.Trigger,
+		Status:       m.Status,
+		Host:         m.Host,
+		Attempts:     m.Attempts,
+		DryRun:       m.DryRun,
+		StartedAt:    m.StartedAt,
+		FinishedAt:   timeValue(m.FinishedAt),
+		TotalCount:   m.TotalCount,
+		SuccessCount: m.SuccessCount,
+		FailedCount:  m.FailedCount,
+		Error:        m.Error,
+	}
+}
@@ -0,0 +1,254 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"time"
+	"xinyuan_tech/subscription-service/internal/biz"
+	"xinyuan_tech/subscription-service/internal/constants"
+	"xinyuan_tech/subscription-service/internal/data/model"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// metricRepo 订阅指标仓库实现
+type metricRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewMetricRepo 创建订阅指标仓库
+func NewMetricRepo(data *Data, logger log.Logger) biz.MetricRepo {
+	return &metricRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// ListMetricAppIDs 获取有订阅记录的应用
+func (r *metricRepo) ListMetricAppIDs(ctx context.Context) ([]string, error) {
+	var appIDs []string
+	if err := r.data.DB(ctx).Model(&model.UserSubscription{}).
+		Where("app_id <> ''").
+		Distinct("app_id").
+		Order("app_id ASC").
+		Pluck("app_id", &appIDs).Error; err != nil {
+		r.log.Errorf("Failed to list app ids of subscriptions: %v", err)
+		return nil, err
+	}
+	return appIDs, nil
+}
+
+// activeSubscriptionRow 活跃订阅查询结果
+type activeSubscriptionRow struct {
+	UID             string
+	PlanID          string
+	BillingType     string
+	IntervalUnit    string
+	IntervalCount   int
+	DurationDays    int
+	PlanPrice       float64
+	PlanCurrency    string
+	Source          string
+	OrderCurrency   *string
+	OrderAmount     *float64
+	ProrationCredit *float64
+	TaxAmount       *float64
+}
+
+// ListActiveSubscriptionRevenue 获取活跃订阅及其当前生效订单
+// 订单未支付成功（如已全额退款）时按套餐默认价格计算
+func (r *metricRepo) ListActiveSubscriptionRevenue(ctx context.Context, appID string, at time.Time) ([]*biz.ActiveSubscriptionRevenue, error) {
+	var rows []activeSubscriptionRow
+	if err := r.data.DB(ctx).Table("user_subscription AS s").
+		Select(`s.uid, s.plan_id, s.source, p.billing_type, p.interval_unit, p.interval_count, p.duration_days,
+			p.price AS plan_price, p.currency AS plan_currency,
+			o.currency AS order_currency, o.amount AS order_amount, o.proration_credit, o.tax_amount`).
+		Joins("JOIN plan AS p ON p.plan_id = s.plan_id").
+		Joins("LEFT JOIN subscription_order AS o ON o.order_id = s.order_id AND o.payment_status IN ?",
+			[]string{constants.PaymentStatusSuccess, constants.PaymentStatusPartiallyRefunded}).
+		Where("s.app_id = ? AND s.status = ?", appID, constants.StatusActive).
+		Where("s.end_time IS NULL OR s.end_time > ?", at).
+		Scan(&rows).Error; err != nil {
+		r.log.Errorf("Failed to list active subscriptions of app %s: %v", appID, err)
+		return nil, err
+	}
+
+	result := make([]*biz.ActiveSubscriptionRevenue, len(rows))
+	for i, row := range rows {
+		item := &biz.ActiveSubscriptionRevenue{
+			UID:           row.UID,
+			PlanID:        row.PlanID,
+			BillingType:   row.BillingType,
+			IntervalUnit:  row.IntervalUnit,
+			IntervalCount: row.IntervalCount,
+			DurationDays:  row.DurationDays,
+			Currency:      row.PlanCurrency,
+			Amount:        row.PlanPrice,
+			Source:        row.Source,
+		}
+		if row.OrderAmount != nil && row.OrderCurrency != nil && *row.OrderCurrency != "" {
+			item.Currency = *row.OrderCurrency
+			item.Amount = *row.OrderAmount + floatValue(row.ProrationCredit) - floatValue(row.TaxAmount)
+		}
+		result[i] = item
+	}
+	return result, nil
+}
+
+// CountSubscriptionEvents 按订阅历史统计新增、流失和回流用户数
+//   - 新增：首次创建订阅（created）
+//   - 流失：过期或取消（expired、cancelled）
+//   - 回流：续费或升级（renewed、upgraded）前最近一次订阅状态变化为过期或取消
+func (r *metricRepo) CountSubscriptionEvents(ctx context.Context, appID string, from, to time.Time) (*biz.SubscriptionEventCounts, error) {
+	db := r.data.DB(ctx)
+	inRange := func() *gorm.DB {
+		return db.Model(&model.SubscriptionHistory{}).
+			Where("app_id = ? AND created_at >= ? AND created_at < ?", appID, from, to)
+	}
+
+	var newCount, churned, reactivated int64
+	if err := inRange().Where("action = ?", constants.ActionCreated).
+		Distinct("uid").Count(&newCount).Error; err != nil {
+		r.log.Errorf("Failed to count new subscribers of app %s: %v", appID, err)
+		return nil, err
+	}
+	if err := inRange().Where("action IN ?", []string{constants.ActionExpired, constants.ActionCancelled}).
+		Distinct("uid").Count(&churned).Error; err != nil {
+		r.log.Errorf("Failed to count churned subscribers of app %s: %v", appID, err)
+		return nil, err
+	}
+	if err := db.Table("subscription_history AS h").
+		Where("h.app_id = ? AND h.created_at >= ? AND h.created_at < ?", appID, from, to).
+		Where("h.action IN ?", []string{constants.ActionRenewed, constants.ActionUpgraded}).
+		Where(`(SELECT p.action FROM subscription_history AS p
+			WHERE p.app_id = h.app_id AND p.uid = h.uid AND p.subscription_history_id < h.subscription_history_id AND p.action IN ?
+			ORDER BY p.subscription_history_id DESC LIMIT 1) IN ?`,
+			[]string{constants.ActionCreated, constants.ActionRenewed, constants.ActionUpgraded, constants.ActionResumed, constants.ActionExpired, constants.ActionCancelled},
+			[]string{constants.ActionExpired, constants.ActionCancelled}).
+		Distinct("h.uid").Count(&reactivated).Error; err != nil {
+		r.log.Errorf("Failed to count reactivated subscribers of app %s: %v", appID, err)
+		return nil, err
+	}
+	return &biz.SubscriptionEventCounts{
+		New:         int(newCount),
+		Churned:     int(churned),
+		Reactivated: int(reactivated),
+	}, nil
+}
+
+// cohortMemberRow 同期群用户查询结果
+type cohortMemberRow struct {
+	UID               string
+	FirstSubscribedAt time.Time
+}
+
+// ListCohortMembers 获取在 [from, to) 内首次订阅应用的用户（以订阅历史中最早的 created 记录为准）
+func (r *metricRepo) ListCohortMembers(ctx context.Context, appID string, from, to time.Time) ([]*biz.CohortMember, error) {
+	var rows []cohortMemberRow
+	if err := r.data.DB(ctx).Model(&model.SubscriptionHistory{}).
+		Select("uid, MIN(created_at) AS first_subscribed_at").
+		Where("app_id = ? AND action = ?", appID, constants.ActionCreated).
+		Group("uid").
+		Having("MIN(created_at) >= ? AND MIN(created_at) < ?", from, to).
+		Scan(&rows).Error; err != nil {
+		r.log.Errorf("Failed to list cohort members of app %s: %v", appID, err)
+		return nil, err
+	}
+	result := make([]*biz.CohortMember, len(rows))
+	for i, row := range rows {
+		result[i] = &biz.CohortMember{UID: row.UID, FirstSubscribedAt: row.FirstSubscribedAt}
+	}
+	return result, nil
+}
+
+// GetMetricSnapshot 获取应用某天的快照，不存在时返回 nil
+func (r *metricRepo) GetMetricSnapshot(ctx context.Context, appID string, date time.Time) (*biz.MetricSnapshot, error) {
+	var m model.MetricSnapshot
+	err := r.data.DB(ctx).
+		Where("app_id = ? AND snapshot_date = ?", appID, date.Format(constants.DateLayout)).
+		First(&m).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		r.log.Errorf("Failed to get metric snapshot of app %s on %s: %v", appID, date.Format(constants.DateLayout), err)
+		return nil, err
+	}
+	return toBizMetricSnapshot(&m), nil
+}
+
+// SaveMetricSnapshot 保存快照（同一应用同一天已存在时覆盖）
+func (r *metricRepo) SaveMetricSnapshot(ctx context.Context, snapshot *biz.MetricSnapshot) error {
+	m := &model.MetricSnapshot{
+		AppID:                  snapshot.AppID,
+		SnapshotDate:           snapshot.SnapshotDate,
+		ReportingCurrency:      snapshot.ReportingCurrency,
+		ActiveSubscribers:      snapshot.ActiveSubscribers,
+		PayingSubscribers:      snapshot.PayingSubscribers,
+		MRR:                    snapshot.MRR,
+		ARR:                    snapshot.ARR,
+		NewSubscribers:         snapshot.NewSubscribers,
+		ChurnedSubscribers:     snapshot.ChurnedSubscribers,
+		ReactivatedSubscribers: snapshot.ReactivatedSubscribers,
+		StateUnavailable:       snapshot.StateUnavailable,
+		CreatedAt:              snapshot.CreatedAt,
+		UpdatedAt:              snapshot.UpdatedAt,
+	}
+	err := r.data.DB(ctx).Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "app_id"}, {Name: "snapshot_date"}},
+		DoUpdates: clause.AssignmentColumns([]string{
+			"reporting_currency", "active_subscribers", "paying_subscribers", "mrr", "arr",
+			"new_subscribers", "churned_subscribers", "reactivated_subscribers", "state_unavailable", "updated_at",
+		}),
+	}).Create(m).Error
+	if err != nil {
+		r.log.Errorf("Failed to save metric snapshot of app %s on %s: %v", snapshot.AppID, snapshot.SnapshotDate.Format(constants.DateLayout), err)
+		return err
+	}
+	return nil
+}
+
+// ListMetricSnapshots 获取应用在 [from, to] 日期内的快照，按日期升序
+func (r *metricRepo) ListMetricSnapshots(ctx context.Context, appID string, from, to time.Time) ([]*biz.MetricSnapshot, error) {
+	var ms []model.MetricSnapshot
+	if err := r.data.DB(ctx).
+		Where("app_id = ? AND snapshot_date >= ? AND snapshot_date <= ?", appID, from.Format(constants.DateLayout), to.Format(constants.DateLayout)).
+		Order("snapshot_date ASC").
+		Find(&ms).Error; err != nil {
+		r.log.Errorf("Failed to list metric snapshots of app %s: %v", appID, err)
+		return nil, err
+	}
+	result := make([]*biz.MetricSnapshot, len(ms))
+	for i := range ms {
+		result[i] = toBizMetricSnapshot(&ms[i])
+	}
+	return result, nil
+}
+
+func toBizMetricSnapshot(m *model.MetricSnapshot) *biz.MetricSnapshot {
+	return &biz.MetricSnapshot{
+		AppID:                  m.AppID,
+		SnapshotDate:           m.SnapshotDate.UTC(),
+		ReportingCurrency:      m.ReportingCurrency,
+		ActiveSubscribers:      m.ActiveSubscribers,
+		PayingSubscribers:      m.PayingSubscribers,
+		MRR:                    m.MRR,
+		ARR:                    m.ARR,
+		NewSubscribers:         m.NewSubscribers,
+		ChurnedSubscribers:     m.ChurnedSubscribers,
+		ReactivatedSubscribers: m.ReactivatedSubscribers,
+		StateUnavailable:       m.StateUnavailable,
+		CreatedAt:              m.CreatedAt,
+		UpdatedAt:              m.UpdatedAt,
+	}
+}
+
+func floatValue(f *float64) float64 {
+	if f == nil {
+		return 0
+	}
+	return *f
+}
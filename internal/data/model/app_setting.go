@@ -0,0 +1,15 @@
+package model
+
+import "time"
+
+// AppSetting 应用订阅配置模型
+type AppSetting struct {
+	AppID             string    `gorm:"primaryKey;column:app_id;type:varchar(50)"`
+	DefaultFreePlanID string    `gorm:"column:default_free_plan_id;type:varchar(50);not null;default:''"` // 默认免费套餐ID（为空表示不回落）
+	RegionDetection   string    `gorm:"column:region_detection;type:varchar(255);not null;default:''"`    // 地区推断顺序（逗号分隔，为空表示使用全局配置）
+	ReportingCurrency string    `gorm:"column:reporting_currency;type:varchar(10);not null;default:''"`   // 报表币种（为空表示使用全局配置）
+	CreatedAt         time.Time `gorm:"column:created_at;autoCreateTime"`
+	UpdatedAt         time.Time `gorm:"column:updated_at;autoUpdateTime"`
+}
+
+func (AppSetting) TableName() string { return "app_setting" }
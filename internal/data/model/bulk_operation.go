@@ -0,0 +1,31 @@
+package model
+
+import "time"
+
+// BulkOperation 批量操作模型
+type BulkOperation struct {
+	BulkOperationID      uint64     `gorm:"primaryKey;column:bulk_operation_id;autoIncrement"`
+	Type                 string     `gorm:"column:type;type:varchar(20);not null"`                     // extend, migrate_plan
+	AppID                string     `gorm:"column:app_id;type:varchar(50);not null"`                   // 筛选条件：应用
+	PlanID               string     `gorm:"column:plan_id;type:varchar(50);not null;default:''"`       // 筛选条件：套餐（迁移套餐时为原套餐）
+	FilterStatus         string     `gorm:"column:filter_status;type:varchar(20);not null;default:''"` // 筛选条件：订阅状态
+	Days                 int        `gorm:"column:days;not null;default:0"`                            // 延长天数
+	TargetPlanID         string     `gorm:"column:target_plan_id;type:varchar(50);not null;default:''"`
+	OperatorID           string     `gorm:"column:operator_id;type:varchar(36);not null;default:''"`
+	Reason               string     `gorm:"column:reason;type:varchar(255);not null;default:''"`
+	Status               string     `gorm:"column:status;type:varchar(20);not null;default:'pending';index:idx_status"` // pending, running, completed, cancelled
+	MaxSubscriptionID    uint64     `gorm:"column:max_subscription_id;not null;default:0"`                              // 创建时匹配的最大订阅ID
+	CursorSubscriptionID uint64     `gorm:"column:cursor_subscription_id;not null;default:0"`                           // 已处理的最后一个订阅ID
+	TotalCount           int        `gorm:"column:total_count;not null;default:0"`
+	ProcessedCount       int        `gorm:"column:processed_count;not null;default:0"`
+	SuccessCount         int        `gorm:"column:success_count;not null;default:0"`
+	SkippedCount         int        `gorm:"column:skipped_count;not null;default:0"`
+	FailedCount          int        `gorm:"column:failed_count;not null;default:0"`
+	Error                string     `gorm:"column:error;type:varchar(1024);not null;default:''"`
+	StartedAt            *time.Time `gorm:"column:started_at"`
+	FinishedAt           *time.Time `gorm:"column:finished_at"`
+	CreatedAt            time.Time  `gorm:"column:created_at"`
+	UpdatedAt            time.Time  `gorm:"column:updated_at"`
+}
+
+func (BulkOperation) TableName() string { return "bulk_operation" }
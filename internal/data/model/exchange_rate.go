@@ -0,0 +1,16 @@
+package model
+
+import "time"
+
+// ExchangeRate 汇率模型（1 from_currency = rate to_currency）
+type ExchangeRate struct {
+	ExchangeRateID uint64    `gorm:"primaryKey;column:exchange_rate_id;autoIncrement"`
+	FromCurrency   string    `gorm:"column:from_currency;type:varchar(10);not null;uniqueIndex:uk_pair_effective"`
+	ToCurrency     string    `gorm:"column:to_currency;type:varchar(10);not null;uniqueIndex:uk_pair_effective"`
+	Rate           float64   `gorm:"column:rate;type:decimal(18,8);not null"`
+	EffectiveAt    time.Time `gorm:"column:effective_at;not null;uniqueIndex:uk_pair_effective"` // 生效时间
+	Source         string    `gorm:"column:source;type:varchar(20);not null;default:''"`         // 来源：admin, file
+	CreatedAt      time.Time `gorm:"column:created_at"`
+}
+
+func (ExchangeRate) TableName() string { return "exchange_rate" }
@@ -0,0 +1,74 @@
+package model
+
+import (
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// ErrInvoiceImmutable 发票一经开具不可修改或删除
+var ErrInvoiceImmutable = errors.New("invoice is immutable once issued")
+
+// Invoice 发票模型（发票和红字发票）
+type Invoice struct {
+	InvoiceID         uint64     `gorm:"primaryKey;column:invoice_id;autoIncrement"`
+	InvoiceNo         string     `gorm:"column:invoice_no;type:varchar(32);not null;uniqueIndex:uk_app_invoice_no"`
+	InvoiceType       string     `gorm:"column:invoice_type;type:enum('invoice','credit_note');not null"` // invoice-发票, credit_note-红字发票
+	AppID             string     `gorm:"column:app_id;type:varchar(50);not null;uniqueIndex:uk_app_invoice_no;index:idx_app_uid"`
+	UID               string     `gorm:"column:uid;type:varchar(36);not null;index:idx_app_uid"`
+	OrderID           string     `gorm:"column:order_id;type:varchar(64);not null;index:idx_order_id"`
+	OriginalInvoiceNo string     `gorm:"column:original_invoice_no;type:varchar(32);not null;default:''"` // 红字发票冲减的原发票编号
+	PlanID            string     `gorm:"column:plan_id;type:varchar(50);not null"`
+	PlanName          string     `gorm:"column:plan_name;type:varchar(100);not null;default:''"`
+	CountryCode       string     `gorm:"column:country_code;type:varchar(10);not null;default:''"`
+	VatID             string     `gorm:"column:vat_id;type:varchar(20);not null;default:''"`
+	Currency          string     `gorm:"column:currency;type:varchar(10);not null"`
+	Subtotal          float64    `gorm:"column:subtotal;type:decimal(10,2);not null"`
+	Discount          float64    `gorm:"column:discount;type:decimal(10,2);not null;default:0"`
+	ProrationCredit   float64    `gorm:"column:proration_credit;type:decimal(10,2);not null;default:0"`
+	TaxAmount         float64    `gorm:"column:tax_amount;type:decimal(10,2);not null;default:0"`
+	Total             float64    `gorm:"column:total;type:decimal(10,2);not null"`
+	PeriodStart       *time.Time `gorm:"column:period_start"`
+	PeriodEnd         *time.Time `gorm:"column:period_end"`
+	IssuedAt          time.Time  `gorm:"column:issued_at;not null"`
+}
+
+func (Invoice) TableName() string { return "invoice" }
+
+// BeforeUpdate 禁止修改已开具的发票
+func (Invoice) BeforeUpdate(tx *gorm.DB) error { return ErrInvoiceImmutable }
+
+// BeforeDelete 禁止删除已开具的发票
+func (Invoice) BeforeDelete(tx *gorm.DB) error { return ErrInvoiceImmutable }
+
+// InvoiceLine 发票明细模型
+type InvoiceLine struct {
+	ID            uint64     `gorm:"primaryKey;column:invoice_line_id;autoIncrement"`
+	InvoiceID     uint64     `gorm:"column:invoice_id;not null;index:idx_invoice_id"`
+	LineType      string     `gorm:"column:line_type;type:varchar(20);not null"` // plan, discount, proration, tax, refund
+	Description   string     `gorm:"column:description;type:varchar(255);not null;default:''"`
+	PeriodStart   *time.Time `gorm:"column:period_start"`
+	PeriodEnd     *time.Time `gorm:"column:period_end"`
+	TaxRate       float64    `gorm:"column:tax_rate;type:decimal(6,4);not null;default:0"`
+	Inclusive     bool       `gorm:"column:inclusive;not null;default:false"`
+	ReverseCharge bool       `gorm:"column:reverse_charge;not null;default:false"`
+	Amount        float64    `gorm:"column:amount;type:decimal(10,2);not null"`
+}
+
+func (InvoiceLine) TableName() string { return "invoice_line" }
+
+// BeforeUpdate 禁止修改已开具发票的明细
+func (InvoiceLine) BeforeUpdate(tx *gorm.DB) error { return ErrInvoiceImmutable }
+
+// BeforeDelete 禁止删除已开具发票的明细
+func (InvoiceLine) BeforeDelete(tx *gorm.DB) error { return ErrInvoiceImmutable }
+
+// InvoiceSequence 发票编号序列模型（每个应用、每种发票类型一个序列）
+type InvoiceSequence struct {
+	AppID       string `gorm:"primaryKey;column:app_id;type:varchar(50)"`
+	InvoiceType string `gorm:"primaryKey;column:invoice_type;type:varchar(20)"`
+	NextValue   uint64 `gorm:"column:next_value;not null"`
+}
+
+func (InvoiceSequence) TableName() string { return "invoice_sequence" }
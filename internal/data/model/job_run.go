@@ -0,0 +1,26 @@
+package model
+
+import "time"
+
+// JobRun 定时任务执行记录模型
+type JobRun struct {
+	JobRunID     uint64     `gorm:"primaryKey;column:job_run_id;autoIncrement"`
+	JobName      string     `gorm:"column:job_name;type:varchar(64);not null;uniqueIndex:uk_job_scheduled"`
+	ScheduledAt  time.Time  `gorm:"column:scheduled_at;not null;uniqueIndex:uk_job_scheduled"` // 计划执行时间
+	Trigger      string     `gorm:"column:trigger_type;type:varchar(20);not null;default:''"`  // schedule, catch_up, retry, manual
+	Status       string     `gorm:"column:status;type:varchar(20);not null;default:''"`        // running, success, failed, skipped
+	Host         string     `gorm:"column:host;type:varchar(128);not null;default:''"`         // 执行实例
+	Attempts     int        `gorm:"column:attempts;not null;default:1"`                        // 执行次数
+	DryRun       bool       `gorm:"column:dry_run;not null;default:false"`                     // 测试运行
+	StartedAt    time.Time  `gorm:"column:started_at;not null"`
+	FinishedAt   *time.Time `gorm:"column:finished_at"`
+	TotalCount   int        `gorm:"column:total_count;not null;default:0"`
+	SuccessCount int        `gorm:"column:success_count;not null;default:0"`
+	FailedCount  int        `gorm:"column:failed_count;not null;default:0"`
+	Error        string     `gorm:"column:error;type:text"`
+	Checkpoint   string     `gorm:"column:checkpoint;type:text"` // 任务进度（JSON），重试时从进度处继续
+	CreatedAt    time.Time  `gorm:"column:created_at"`
+	UpdatedAt    time.Time  `gorm:"column:updated_at"`
+}
+
+func (JobRun) TableName() string { return "job_run" }
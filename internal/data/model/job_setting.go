@@ -0,0 +1,15 @@
+package model
+
+import "time"
+
+// JobSetting 定时任务设置模型
+type JobSetting struct {
+	JobName     string     `gorm:"primaryKey;column:job_name;type:varchar(64)"`
+	Paused      bool       `gorm:"column:paused;not null;default:false"`                      // 是否暂停计划执行
+	PauseReason string     `gorm:"column:pause_reason;type:varchar(255);not null;default:''"` // 暂停原因
+	PausedAt    *time.Time `gorm:"column:paused_at"`
+	CreatedAt   time.Time  `gorm:"column:created_at"`
+	UpdatedAt   time.Time  `gorm:"column:updated_at"`
+}
+
+func (JobSetting) TableName() string { return "job_setting" }
@@ -0,0 +1,23 @@
+package model
+
+import "time"
+
+// MetricSnapshot 应用订阅指标日快照模型
+type MetricSnapshot struct {
+	MetricSnapshotID       uint64    `gorm:"primaryKey;column:metric_snapshot_id;autoIncrement"`
+	AppID                  string    `gorm:"column:app_id;type:varchar(50);not null;uniqueIndex:uk_app_date"`
+	SnapshotDate           time.Time `gorm:"column:snapshot_date;type:date;not null;uniqueIndex:uk_app_date"` // 快照日期（UTC）
+	ReportingCurrency      string    `gorm:"column:reporting_currency;type:varchar(10);not null;default:''"`  // MRR/ARR 的币种
+	ActiveSubscribers      int       `gorm:"column:active_subscribers;not null;default:0"`                    // 活跃订阅数
+	PayingSubscribers      int       `gorm:"column:paying_subscribers;not null;default:0"`                    // 付费周期订阅数
+	MRR                    float64   `gorm:"column:mrr;type:decimal(14,2);not null;default:0"`                // 月度经常性收入
+	ARR                    float64   `gorm:"column:arr;type:decimal(14,2);not null;default:0"`                // 年度经常性收入
+	NewSubscribers         int       `gorm:"column:new_subscribers;not null;default:0"`                       // 新增用户数
+	ChurnedSubscribers     int       `gorm:"column:churned_subscribers;not null;default:0"`                   // 流失用户数
+	ReactivatedSubscribers int       `gorm:"column:reactivated_subscribers;not null;default:0"`               // 回流用户数
+	StateUnavailable       bool      `gorm:"column:state_unavailable;not null;default:false"`                 // 活跃订阅数和 MRR 不可用（补生成的更早日期）
+	CreatedAt              time.Time `gorm:"column:created_at"`
+	UpdatedAt              time.Time `gorm:"column:updated_at"`
+}
+
+func (MetricSnapshot) TableName() string { return "subscription_metric_snapshot" }
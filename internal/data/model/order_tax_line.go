@@ -0,0 +1,19 @@
+package model
+
+import "time"
+
+// OrderTaxLine 订单税费明细模型
+type OrderTaxLine struct {
+	ID            uint64    `gorm:"primaryKey;column:order_tax_line_id;autoIncrement"`
+	OrderID       string    `gorm:"column:order_id;type:varchar(64);not null;index:idx_order_id"`
+	Name          string    `gorm:"column:name;type:varchar(50);not null"`
+	CountryCode   string    `gorm:"column:country_code;type:varchar(2);not null"`
+	Rate          float64   `gorm:"column:rate;type:decimal(6,4);not null"`
+	Inclusive     bool      `gorm:"column:inclusive;not null;default:false"`
+	ReverseCharge bool      `gorm:"column:reverse_charge;not null;default:false"`
+	TaxableAmount float64   `gorm:"column:taxable_amount;type:decimal(10,2);not null"` // 计税金额（不含税）
+	Amount        float64   `gorm:"column:amount;type:decimal(10,2);not null"`         // 税额
+	CreatedAt     time.Time `gorm:"column:created_at;autoCreateTime"`
+}
+
+func (OrderTaxLine) TableName() string { return "subscription_order_tax_line" }
@@ -0,0 +1,20 @@
+package model
+
+import "time"
+
+// PriceChangeNotice 续费调价通知记录模型
+type PriceChangeNotice struct {
+	ID          uint64    `gorm:"primaryKey;column:price_change_notice_id;autoIncrement"`
+	UID         string    `gorm:"column:uid;type:varchar(36);not null;uniqueIndex:uk_uid_plan_renew,priority:1"`
+	AppID       string    `gorm:"column:app_id;type:varchar(50);not null;default:''"`
+	PlanID      string    `gorm:"column:plan_id;type:varchar(50);not null;uniqueIndex:uk_uid_plan_renew,priority:2"`
+	CountryCode string    `gorm:"column:country_code;type:varchar(10);not null;default:''"`
+	OldPrice    float64   `gorm:"column:old_price;type:decimal(10,2);not null"`
+	OldCurrency string    `gorm:"column:old_currency;type:varchar(10);not null"`
+	NewPrice    float64   `gorm:"column:new_price;type:decimal(10,2);not null"`
+	NewCurrency string    `gorm:"column:new_currency;type:varchar(10);not null"`
+	RenewAt     time.Time `gorm:"column:renew_at;not null;uniqueIndex:uk_uid_plan_renew,priority:3"` // 按新价格续费的时间
+	CreatedAt   time.Time `gorm:"column:created_at;autoCreateTime"`
+}
+
+func (PriceChangeNotice) TableName() string { return "price_change_notice" }
@@ -0,0 +1,23 @@
+package model
+
+import "time"
+
+// RegionGroup 地区组模型
+type RegionGroup struct {
+	GroupCode   string    `gorm:"primaryKey;column:group_code;type:varchar(10)"`
+	Name        string    `gorm:"column:name;type:varchar(100);not null"`
+	Description string    `gorm:"column:description;type:varchar(255);default:''"`
+	CreatedAt   time.Time `gorm:"column:created_at;autoCreateTime"`
+	UpdatedAt   time.Time `gorm:"column:updated_at;autoUpdateTime"`
+}
+
+func (RegionGroup) TableName() string { return "region_group" }
+
+// RegionGroupCountry 地区组成员国家模型（每个国家只能属于一个地区组）
+type RegionGroupCountry struct {
+	ID          uint64 `gorm:"primaryKey;column:region_group_country_id;autoIncrement"`
+	GroupCode   string `gorm:"column:group_code;type:varchar(10);not null;index:idx_group_code"`
+	CountryCode string `gorm:"column:country_code;type:varchar(2);not null;uniqueIndex:uk_country_code"`
+}
+
+func (RegionGroupCountry) TableName() string { return "region_group_country" }
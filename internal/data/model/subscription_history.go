@@ -4,16 +4,21 @@ import "time"
 
 // SubscriptionHistory 订阅历史模型
 type SubscriptionHistory struct {
-	SubscriptionHistoryID uint64    `gorm:"primaryKey;column:subscription_history_id;autoIncrement"`
-	UID                   string    `gorm:"column:uid;type:varchar(36);index"` // 用户ID（字符串 UUID）
-	PlanID                string    `gorm:"column:plan_id"`
-	PlanName              string    `gorm:"column:plan_name"`
-	AppID                 string    `gorm:"column:app_id;not null;index:idx_app_id;index:idx_app_uid"` // 应用ID（冗余字段，便于按app统计和查询）
-	StartTime             time.Time `gorm:"column:start_time"`
-	EndTime               time.Time `gorm:"column:end_time"`
-	Status                string    `gorm:"column:status"`
-	Action                string    `gorm:"column:action;type:enum('created','renewed','upgraded','paused','resumed','cancelled','expired','enabled_auto_renew','disabled_auto_renew')"` // 操作类型
-	CreatedAt             time.Time `gorm:"column:created_at"`
+	SubscriptionHistoryID uint64     `gorm:"primaryKey;column:subscription_history_id;autoIncrement"`
+	UID                   string     `gorm:"column:uid;type:varchar(36);index"` // 用户ID（字符串 UUID）
+	PlanID                string     `gorm:"column:plan_id"`
+	PlanName              string     `gorm:"column:plan_name"`
+	AppID                 string     `gorm:"column:app_id;not null;index:idx_app_id;index:idx_app_uid"` // 应用ID（冗余字段，便于按app统计和查询）
+	StartTime             time.Time  `gorm:"column:start_time"`
+	EndTime               *time.Time `gorm:"column:end_time"` // 终身订阅为 NULL
+	Status                string     `gorm:"column:status"`
+	Action                string     `gorm:"column:action;type:enum('created','renewed','upgraded','paused','resumed','cancelled','expired','enabled_auto_renew','disabled_auto_renew','downgraded_to_free','extended','shortened','granted','plan_change_scheduled')"` // 操作类型
+	ActorType             string     `gorm:"column:actor_type;type:varchar(20);not null;default:''"`                                                                                                                                                                    // 操作人类型：user, admin, cron, payment, system
+	OperatorID            string     `gorm:"column:operator_id;type:varchar(64);not null;default:''"`                                                                                                                                                                   // 操作人ID：用户或管理员的用户ID、定时任务名、支付ID
+	Reason                string     `gorm:"column:reason;type:varchar(255);not null;default:''"`                                                                                                                                                                       // 操作原因
+	RequestID             string     `gorm:"column:request_id;type:varchar(64);not null;default:'';index:idx_request_id"`                                                                                                                                               // 请求ID
+	Changes               string     `gorm:"column:changes;type:text"`                                                                                                                                                                                                  // 字段变更（JSON 数组）
+	CreatedAt             time.Time  `gorm:"column:created_at"`
 }
 
 func (SubscriptionHistory) TableName() string { return "subscription_history" }
@@ -4,14 +4,29 @@ import "time"
 
 // SubscriptionOrder 订单模型
 type SubscriptionOrder struct {
-	OrderID       string    `gorm:"primaryKey;column:order_id"`
-	PaymentID     string    `gorm:"column:payment_id;index"`                   // 支付流水号(payment-service返回的payment_id，用于追溯支付记录)
-	UID           string    `gorm:"column:uid;type:varchar(36);index:idx_uid"` // 用户ID（字符串 UUID）
-	PlanID        string    `gorm:"column:plan_id"`
-	AppID         string    `gorm:"column:app_id;type:varchar(50);index"`
-	Amount        float64   `gorm:"column:amount"`
-	PaymentStatus string    `gorm:"column:payment_status;type:enum('pending','success','failed','closed','refunded','partially_refunded');not null;default:'pending'"` // 支付状态(与payment-service保持一致): pending-待支付(订单已创建，等待支付), success-支付成功, failed-支付失败, closed-订单关闭, refunded-已全额退款, partially_refunded-部分退款
-	CreatedAt     time.Time `gorm:"column:created_at"`
+	OrderID           string     `gorm:"primaryKey;column:order_id"`
+	PaymentID         string     `gorm:"column:payment_id;index"`                   // 支付流水号(payment-service返回的payment_id，用于追溯支付记录)
+	UID               string     `gorm:"column:uid;type:varchar(36);index:idx_uid"` // 用户ID（字符串 UUID）
+	PlanID            string     `gorm:"column:plan_id"`
+	AppID             string     `gorm:"column:app_id;type:varchar(50);index"`
+	CountryCode       string     `gorm:"column:country_code;type:varchar(10);not null;default:''"`      // 定价地区（国家代码，未识别时为 default）
+	Currency          string     `gorm:"column:currency;type:varchar(10);not null;default:''"`          // 币种
+	Subtotal          float64    `gorm:"column:subtotal;type:decimal(10,2);not null;default:0"`         // 套餐价格
+	DiscountAmount    float64    `gorm:"column:discount_amount;type:decimal(10,2);not null;default:0"`  // 优惠金额
+	ProrationCredit   float64    `gorm:"column:proration_credit;type:decimal(10,2);not null;default:0"` // 切换套餐时当前周期未使用部分的抵扣金额
+	CreditOrderID     string     `gorm:"column:credit_order_id;type:varchar(64);not null;default:''"`   // 被抵扣未使用部分的订单（下单时用户订阅的当前生效订单）
+	VatID             string     `gorm:"column:vat_id;type:varchar(20);not null;default:''"`            // 买方 VAT ID（B2B 反向征税）
+	TaxAmount         float64    `gorm:"column:tax_amount;type:decimal(10,2);not null;default:0"`       // 税额
+	Amount            float64    `gorm:"column:amount"`
+	RefundedAmount    float64    `gorm:"column:refunded_amount;type:decimal(10,2);not null;default:0"`                                                                                        // 累计退款金额
+	PaidAt            *time.Time `gorm:"column:paid_at"`                                                                                                                                      // 支付成功时间
+	ReportingCurrency string     `gorm:"column:reporting_currency;type:varchar(10);not null;default:''"`                                                                                      // 支付时应用的报表币种
+	ExchangeRate      float64    `gorm:"column:exchange_rate;type:decimal(18,8);not null;default:0"`                                                                                          // 支付时订单币种到报表币种的汇率（0 表示支付时没有可用汇率）                                                                                                                     // 实付金额
+	PeriodStart       *time.Time `gorm:"column:period_start"`                                                                                                                                 // 本订单购买的订阅周期开始时间（支付成功后记录）
+	PeriodEnd         *time.Time `gorm:"column:period_end"`                                                                                                                                   // 本订单购买的订阅周期结束时间（终身套餐为 NULL）
+	PaymentStatus     string     `gorm:"column:payment_status;type:enum('pending','success','failed','closed','refunded','partially_refunded','refund_required');not null;default:'pending'"` // 支付状态(与payment-service保持一致): pending-待支付(订单已创建，等待支付), success-支付成功, failed-支付失败, closed-订单关闭, refunded-已全额退款, partially_refunded-部分退款, refund_required-已支付但未开通订阅需要退款
+	Source            string     `gorm:"column:source;type:enum('purchase','comp','manual');not null;default:'purchase'"`                                                                     // 订单来源: purchase-购买, comp-赠送, manual-手动开通（赠送和手动开通为零金额记账订单）
+	CreatedAt         time.Time  `gorm:"column:created_at"`
 }
 
 func (SubscriptionOrder) TableName() string { return "subscription_order" }
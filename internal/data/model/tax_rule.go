@@ -0,0 +1,18 @@
+package model
+
+import "time"
+
+// TaxRule 国家税务规则模型
+type TaxRule struct {
+	TaxRuleID     uint64    `gorm:"primaryKey;column:tax_rule_id;autoIncrement"`
+	CountryCode   string    `gorm:"column:country_code;type:varchar(2);not null;uniqueIndex:uk_country_name"`
+	Name          string    `gorm:"column:name;type:varchar(50);not null;uniqueIndex:uk_country_name"`
+	Rate          float64   `gorm:"column:rate;type:decimal(6,4);not null"`
+	Inclusive     bool      `gorm:"column:inclusive;not null;default:false"`      // 定价是否含税
+	ReverseCharge bool      `gorm:"column:reverse_charge;not null;default:false"` // 是否支持 B2B 反向征税
+	Enabled       bool      `gorm:"column:enabled;not null"`
+	CreatedAt     time.Time `gorm:"column:created_at;autoCreateTime"`
+	UpdatedAt     time.Time `gorm:"column:updated_at;autoUpdateTime"`
+}
+
+func (TaxRule) TableName() string { return "tax_rule" }
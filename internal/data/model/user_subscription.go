@@ -4,17 +4,22 @@ import "time"
 
 // UserSubscription 用户订阅模型
 type UserSubscription struct {
-	SubscriptionID uint64    `gorm:"primaryKey;column:subscription_id;autoIncrement"`
-	UID            string    `gorm:"column:uid;type:varchar(36);uniqueIndex;not null"`          // 用户ID（字符串 UUID）
-	PlanID         string    `gorm:"column:plan_id;not null"`                                   // 套餐ID
-	AppID          string    `gorm:"column:app_id;not null;index:idx_app_id;index:idx_app_uid"` // 应用ID（冗余字段，便于按app统计和查询）
-	StartTime      time.Time `gorm:"column:start_time;not null"`
-	EndTime        time.Time `gorm:"column:end_time;not null"`
-	Status         string    `gorm:"column:status;type:enum('active','expired','paused','cancelled');not null;default:'active'"` // 订阅状态: active-活跃(订阅有效中), expired-过期(订阅已过期), paused-暂停(用户主动暂停), cancelled-已取消(用户主动取消)
-	OrderID        string    `gorm:"column:order_id;not null;index"`
-	IsAutoRenew    bool      `gorm:"column:is_auto_renew;default:false"` // 是否自动续费
-	CreatedAt      time.Time `gorm:"column:created_at;autoCreateTime"`
-	UpdatedAt      time.Time `gorm:"column:updated_at;autoUpdateTime"`
+	SubscriptionID uint64     `gorm:"primaryKey;column:subscription_id;autoIncrement"`
+	UID            string     `gorm:"column:uid;type:varchar(36);uniqueIndex;not null"`                                        // 用户ID（字符串 UUID）
+	PlanID         string     `gorm:"column:plan_id;not null;index:idx_app_plan,priority:2"`                                   // 套餐ID
+	AppID          string     `gorm:"column:app_id;not null;index:idx_app_id;index:idx_app_uid;index:idx_app_plan,priority:1"` // 应用ID（冗余字段，便于按app统计和查询）
+	CountryCode    string     `gorm:"column:country_code;type:varchar(10);not null;default:''"`                                // 定价地区（最近一次购买时的国家代码）
+	StartTime      time.Time  `gorm:"column:start_time;not null"`
+	EndTime        *time.Time `gorm:"column:end_time"`                                                                            // 结束时间（终身订阅为 NULL）
+	BillingAnchor  *time.Time `gorm:"column:billing_anchor"`                                                                      // 计费锚点（历史数据可能为空）
+	Status         string     `gorm:"column:status;type:enum('active','expired','paused','cancelled');not null;default:'active'"` // 订阅状态: active-活跃(订阅有效中), expired-过期(订阅已过期), paused-暂停(用户主动暂停), cancelled-已取消(用户主动取消)
+	OrderID        string     `gorm:"column:order_id;not null;index"`
+	IsAutoRenew    bool       `gorm:"column:is_auto_renew;default:false"`                                              // 是否自动续费
+	Source         string     `gorm:"column:source;type:enum('purchase','comp','manual');not null;default:'purchase'"` // 当前周期的来源: purchase-购买, comp-赠送, manual-手动开通
+	NextPlanID     string     `gorm:"column:next_plan_id;type:varchar(50);not null;default:''"`                        // 下次续费时切换到的套餐
+	Version        int        `gorm:"column:version;not null;default:0"`                                               // 版本号（每次更新递增，用于条件更新）
+	CreatedAt      time.Time  `gorm:"column:created_at;autoCreateTime"`
+	UpdatedAt      time.Time  `gorm:"column:updated_at;autoUpdateTime"`
 }
 
 func (UserSubscription) TableName() string { return "user_subscription" }
@@ -0,0 +1,49 @@
+package data
+
+import (
+	"context"
+	"encoding/json"
+	"xinyuan_tech/subscription-service/internal/biz"
+	"xinyuan_tech/subscription-service/internal/constants"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/redis/go-redis/v9"
+)
+
+// redisStreamNotifier 通过 Redis Stream 投递通知事件，由 Notification Service 消费
+type redisStreamNotifier struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewNotifier 创建通知发送器
+func NewNotifier(data *Data, logger log.Logger) biz.Notifier {
+	return &redisStreamNotifier{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// Notify 投递通知事件
+func (n *redisStreamNotifier) Notify(ctx context.Context, notification *biz.Notification) error {
+	payload, err := json.Marshal(notification.Payload)
+	if err != nil {
+		return err
+	}
+	if err := n.data.rdb.XAdd(ctx, &redis.XAddArgs{
+		Stream: constants.NotificationStreamKey,
+		MaxLen: constants.NotificationStreamMaxLen,
+		Approx: true,
+		Values: map[string]interface{}{
+			"type":       notification.Type,
+			"app_id":     notification.AppID,
+			"uid":        notification.UID,
+			"payload":    string(payload),
+			"created_at": notification.CreatedAt.Unix(),
+		},
+	}).Err(); err != nil {
+		n.log.Errorf("Failed to publish %s notification for user %s: %v", notification.Type, notification.UID, err)
+		return err
+	}
+	return nil
+}
@@ -8,11 +8,13 @@ import (
 
 	passportv1 "passport-service/api/passport/v1"
 
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 )
 
 type passportServiceClient struct {
+	conn   *grpc.ClientConn
 	client passportv1.PassportClient
 }
 
@@ -27,16 +29,22 @@ func NewPassportClient(c *conf.Bootstrap) (biz.PassportClient, error) {
 		return &emptyPassportClient{}, nil
 	}
 
-	conn, err := grpc.Dial(addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	// 透传链路追踪上下文到下游服务
+	conn, err := grpc.Dial(addr, grpc.WithTransportCredentials(insecure.NewCredentials()), grpc.WithStatsHandler(otelgrpc.NewClientHandler()))
 	if err != nil {
 		// 如果连接失败，返回空实现（优雅降级）
 		return &emptyPassportClient{}, nil
 	}
 	return &passportServiceClient{
+		conn:   conn,
 		client: passportv1.NewPassportClient(conn),
 	}, nil
 }
 
+func (c *passportServiceClient) clientConn() *grpc.ClientConn {
+	return c.conn
+}
+
 // GetUserCountryCode 获取用户的国家代码
 // TODO: passport-service 的 GetUserRequest.Uid 仍然是 uint64，需要迁移
 // 当前实现：暂时返回空字符串，等待 passport-service 迁移完成
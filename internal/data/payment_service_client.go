@@ -3,17 +3,21 @@ package data
 import (
 	"context"
 	"fmt"
+	"time"
 	"xinyuan_tech/subscription-service/internal/biz"
 	"xinyuan_tech/subscription-service/internal/conf"
 	"xinyuan_tech/subscription-service/internal/constants"
+	"xinyuan_tech/subscription-service/internal/metrics"
 
 	paymentv1 "xinyuan_tech/payment-service/api/payment/v1"
 
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 )
 
 type paymentServiceClient struct {
+	conn   *grpc.ClientConn
 	client paymentv1.PaymentClient
 }
 
@@ -26,15 +30,21 @@ func NewPaymentClient(c *conf.Bootstrap) (biz.PaymentClient, error) {
 		return nil, fmt.Errorf("payment service address is required")
 	}
 
-	conn, err := grpc.Dial(addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	// 透传链路追踪上下文到下游服务
+	conn, err := grpc.Dial(addr, grpc.WithTransportCredentials(insecure.NewCredentials()), grpc.WithStatsHandler(otelgrpc.NewClientHandler()))
 	if err != nil {
 		return nil, err
 	}
 	return &paymentServiceClient{
+		conn:   conn,
 		client: paymentv1.NewPaymentClient(conn),
 	}, nil
 }
 
+func (c *paymentServiceClient) clientConn() *grpc.ClientConn {
+	return c.conn
+}
+
 func (c *paymentServiceClient) CreatePayment(ctx context.Context, orderID string, uid string, amount float64, currency, method, subject, returnURL string) (string, string, string, string, error) {
 	// 验证必填参数
 	if currency == "" {
@@ -64,7 +74,9 @@ func (c *paymentServiceClient) CreatePayment(ctx context.Context, orderID string
 		ReturnUrl: returnURL,
 	}
 
+	start := time.Now()
 	resp, err := c.client.CreatePayment(ctx, req)
+	metrics.PaymentClientDuration.WithLabelValues("CreatePayment", metrics.Result(err)).Observe(time.Since(start).Seconds())
 	if err != nil {
 		return "", "", "", "", err
 	}
@@ -2,7 +2,9 @@ package data
 
 import (
 	"context"
+	"time"
 	"xinyuan_tech/subscription-service/internal/biz"
+	"xinyuan_tech/subscription-service/internal/constants"
 	"xinyuan_tech/subscription-service/internal/data/model"
 
 	"github.com/go-kratos/kratos/v2/log"
@@ -25,7 +27,7 @@ func NewPlanRepo(data *Data, logger log.Logger) biz.PlanRepo {
 // ListPlans 获取所有套餐列表
 func (r *planRepo) ListPlans(ctx context.Context, appID string) ([]*biz.Plan, error) {
 	var models []model.Plan
-	query := r.data.db.WithContext(ctx)
+	query := r.data.DB(ctx)
 	if appID != "" {
 		query = query.Where("app_id = ?", appID)
 	}
@@ -37,15 +39,18 @@ func (r *planRepo) ListPlans(ctx context.Context, appID string) ([]*biz.Plan, er
 	plans := make([]*biz.Plan, len(models))
 	for i, m := range models {
 		plans[i] = &biz.Plan{
-			PlanID:       m.PlanID,
-			AppID:        m.AppID,
-			UID:          m.UID,
-			Name:         m.Name,
-			Description:  m.Description,
-			Price:        m.Price,
-			Currency:     m.Currency,
-			DurationDays: m.DurationDays,
-			Type:         m.Type,
+			PlanID:        m.PlanID,
+			AppID:         m.AppID,
+			UID:           m.UID,
+			Name:          m.Name,
+			Description:   m.Description,
+			Price:         m.Price,
+			Currency:      m.Currency,
+			DurationDays:  m.DurationDays,
+			IntervalUnit:  m.IntervalUnit,
+			IntervalCount: m.IntervalCount,
+			BillingType:   m.BillingType,
+			Type:          m.Type,
 		}
 	}
 	return plans, nil
@@ -54,37 +59,43 @@ func (r *planRepo) ListPlans(ctx context.Context, appID string) ([]*biz.Plan, er
 // GetPlan 根据ID获取套餐
 func (r *planRepo) GetPlan(ctx context.Context, id string) (*biz.Plan, error) {
 	var m model.Plan
-	if err := r.data.db.WithContext(ctx).First(&m, "plan_id = ?", id).Error; err != nil {
+	if err := r.data.DB(ctx).First(&m, "plan_id = ?", id).Error; err != nil {
 		r.log.Errorf("Failed to get plan %s: %v", id, err)
 		return nil, err
 	}
 	return &biz.Plan{
-		PlanID:       m.PlanID,
-		AppID:        m.AppID,
-		UID:          m.UID,
-		Name:         m.Name,
-		Description:  m.Description,
-		Price:        m.Price,
-		Currency:     m.Currency,
-		DurationDays: m.DurationDays,
-		Type:         m.Type,
+		PlanID:        m.PlanID,
+		AppID:         m.AppID,
+		UID:           m.UID,
+		Name:          m.Name,
+		Description:   m.Description,
+		Price:         m.Price,
+		Currency:      m.Currency,
+		DurationDays:  m.DurationDays,
+		IntervalUnit:  m.IntervalUnit,
+		IntervalCount: m.IntervalCount,
+		BillingType:   m.BillingType,
+		Type:          m.Type,
 	}, nil
 }
 
 // CreatePlan 创建套餐
 func (r *planRepo) CreatePlan(ctx context.Context, plan *biz.Plan) error {
 	m := &model.Plan{
-		PlanID:       plan.PlanID,
-		AppID:        plan.AppID,
-		UID:          plan.UID,
-		Name:         plan.Name,
-		Description:  plan.Description,
-		Price:        plan.Price,
-		Currency:     plan.Currency,
-		DurationDays: plan.DurationDays,
-		Type:         plan.Type,
-	}
-	if err := r.data.db.WithContext(ctx).Create(m).Error; err != nil {
+		PlanID:        plan.PlanID,
+		AppID:         plan.AppID,
+		UID:           plan.UID,
+		Name:          plan.Name,
+		Description:   plan.Description,
+		Price:         plan.Price,
+		Currency:      plan.Currency,
+		DurationDays:  plan.DurationDays,
+		IntervalUnit:  plan.IntervalUnit,
+		IntervalCount: plan.IntervalCount,
+		BillingType:   plan.BillingType,
+		Type:          plan.Type,
+	}
+	if err := r.data.DB(ctx).Create(m).Error; err != nil {
 		r.log.Errorf("Failed to create plan: %v", err)
 		return err
 	}
@@ -92,19 +103,35 @@ func (r *planRepo) CreatePlan(ctx context.Context, plan *biz.Plan) error {
 }
 
 // UpdatePlan 更新套餐
+// 计费周期字段（已由 biz 层与原套餐合并）整体写入，包括零值，改为终身套餐时清空；其他字段为空时保持原值
 func (r *planRepo) UpdatePlan(ctx context.Context, plan *biz.Plan) error {
-	m := &model.Plan{
-		PlanID:       plan.PlanID,
-		AppID:        plan.AppID,
-		UID:          plan.UID,
-		Name:         plan.Name,
-		Description:  plan.Description,
-		Price:        plan.Price,
-		Currency:     plan.Currency,
-		DurationDays: plan.DurationDays,
-		Type:         plan.Type,
-	}
-	if err := r.data.db.WithContext(ctx).Model(&model.Plan{}).Where("plan_id = ?", plan.PlanID).Updates(m).Error; err != nil {
+	intervalUnit := plan.IntervalUnit
+	if intervalUnit == "" {
+		// interval_unit 为非空枚举，终身套餐使用列默认值
+		intervalUnit = constants.IntervalDay
+	}
+	updates := map[string]interface{}{
+		"duration_days":  plan.DurationDays,
+		"interval_unit":  intervalUnit,
+		"interval_count": plan.IntervalCount,
+		"billing_type":   plan.BillingType,
+	}
+	for column, value := range map[string]string{
+		"app_id":      plan.AppID,
+		"uid":         plan.UID,
+		"name":        plan.Name,
+		"description": plan.Description,
+		"currency":    plan.Currency,
+		"type":        plan.Type,
+	} {
+		if value != "" {
+			updates[column] = value
+		}
+	}
+	if plan.Price != 0 {
+		updates["price"] = plan.Price
+	}
+	if err := r.data.DB(ctx).Model(&model.Plan{}).Where("plan_id = ?", plan.PlanID).Updates(updates).Error; err != nil {
 		r.log.Errorf("Failed to update plan: %v", err)
 		return err
 	}
@@ -113,17 +140,23 @@ func (r *planRepo) UpdatePlan(ctx context.Context, plan *biz.Plan) error {
 
 // DeletePlan 删除套餐
 func (r *planRepo) DeletePlan(ctx context.Context, id string) error {
-	if err := r.data.db.WithContext(ctx).Delete(&model.Plan{}, "plan_id = ?", id).Error; err != nil {
+	if err := r.data.DB(ctx).Delete(&model.Plan{}, "plan_id = ?", id).Error; err != nil {
 		r.log.Errorf("Failed to delete plan: %v", err)
 		return err
 	}
 	return nil
 }
 
-// GetPlanPricing 根据套餐ID和国家代码获取定价
-func (r *planRepo) GetPlanPricing(ctx context.Context, planID, countryCode string) (*biz.PlanPricing, error) {
+// GetPlanPricing 根据套餐ID和国家代码获取 at 时刻生效的定价
+// 多条定价同时生效时，取 effective_from 最晚的一条（不限开始时间的定价保存为最早时间）
+func (r *planRepo) GetPlanPricing(ctx context.Context, planID, countryCode string, at time.Time) (*biz.PlanPricing, error) {
 	var m model.PlanPricing
-	if err := r.data.db.WithContext(ctx).Where("plan_id = ? AND country_code = ?", planID, countryCode).First(&m).Error; err != nil {
+	if err := r.data.DB(ctx).
+		Where("plan_id = ? AND country_code = ?", planID, countryCode).
+		Where("effective_from <= ?", at).
+		Where("effective_to IS NULL OR effective_to > ?", at).
+		Order("effective_from DESC").
+		First(&m).Error; err != nil {
 		r.log.Warnf("Failed to get plan pricing for %s in country %s: %v", planID, countryCode, err)
 		return nil, err
 	}
@@ -134,13 +167,15 @@ func (r *planRepo) GetPlanPricing(ctx context.Context, planID, countryCode strin
 		CountryCode:   m.CountryCode,
 		Price:         m.Price,
 		Currency:      m.Currency,
+		EffectiveFrom: pricingFromValue(m.EffectiveFrom),
+		EffectiveTo:   timeValue(m.EffectiveTo),
 	}, nil
 }
 
 // ListPlanPricings 获取套餐的所有区域定价
 func (r *planRepo) ListPlanPricings(ctx context.Context, planID string) ([]*biz.PlanPricing, error) {
 	var models []model.PlanPricing
-	if err := r.data.db.WithContext(ctx).Where("plan_id = ?", planID).Find(&models).Error; err != nil {
+	if err := r.data.DB(ctx).Where("plan_id = ?", planID).Order("country_code ASC, effective_from ASC").Find(&models).Error; err != nil {
 		r.log.Errorf("Failed to list plan pricings for %s: %v", planID, err)
 		return nil, err
 	}
@@ -154,6 +189,8 @@ func (r *planRepo) ListPlanPricings(ctx context.Context, planID string) ([]*biz.
 			CountryCode:   m.CountryCode,
 			Price:         m.Price,
 			Currency:      m.Currency,
+			EffectiveFrom: pricingFromValue(m.EffectiveFrom),
+			EffectiveTo:   timeValue(m.EffectiveTo),
 		}
 	}
 	return pricings, nil
@@ -162,7 +199,7 @@ func (r *planRepo) ListPlanPricings(ctx context.Context, planID string) ([]*biz.
 // GetPlanPricingByID 根据 ID 获取区域定价
 func (r *planRepo) GetPlanPricingByID(ctx context.Context, planPricingID uint64) (*biz.PlanPricing, error) {
 	var m model.PlanPricing
-	if err := r.data.db.WithContext(ctx).Where("plan_pricing_id = ?", planPricingID).First(&m).Error; err != nil {
+	if err := r.data.DB(ctx).Where("plan_pricing_id = ?", planPricingID).First(&m).Error; err != nil {
 		r.log.Errorf("Failed to get plan pricing by ID: %v", err)
 		return nil, err
 	}
@@ -173,6 +210,8 @@ func (r *planRepo) GetPlanPricingByID(ctx context.Context, planPricingID uint64)
 		CountryCode:   m.CountryCode,
 		Price:         m.Price,
 		Currency:      m.Currency,
+		EffectiveFrom: pricingFromValue(m.EffectiveFrom),
+		EffectiveTo:   timeValue(m.EffectiveTo),
 	}, nil
 }
 
@@ -190,13 +229,15 @@ func (r *planRepo) CreatePlanPricing(ctx context.Context, pricing *biz.PlanPrici
 	}
 
 	m := &model.PlanPricing{
-		PlanID:      pricing.PlanID,
-		AppID:       appID,
-		CountryCode: pricing.CountryCode,
-		Price:       pricing.Price,
-		Currency:    pricing.Currency,
+		PlanID:        pricing.PlanID,
+		AppID:         appID,
+		CountryCode:   pricing.CountryCode,
+		Price:         pricing.Price,
+		Currency:      pricing.Currency,
+		EffectiveFrom: pricingFrom(pricing.EffectiveFrom),
+		EffectiveTo:   timePtr(pricing.EffectiveTo),
 	}
-	if err := r.data.db.WithContext(ctx).Create(m).Error; err != nil {
+	if err := r.data.DB(ctx).Create(m).Error; err != nil {
 		r.log.Errorf("Failed to create plan pricing: %v", err)
 		return err
 	}
@@ -208,7 +249,7 @@ func (r *planRepo) CreatePlanPricing(ctx context.Context, pricing *biz.PlanPrici
 
 // UpdatePlanPricing 更新区域定价
 func (r *planRepo) UpdatePlanPricing(ctx context.Context, planPricingID uint64, price float64, currency string) error {
-	if err := r.data.db.WithContext(ctx).Model(&model.PlanPricing{}).
+	if err := r.data.DB(ctx).Model(&model.PlanPricing{}).
 		Where("plan_pricing_id = ?", planPricingID).
 		Updates(map[string]interface{}{
 			"price":    price,
@@ -222,9 +263,29 @@ func (r *planRepo) UpdatePlanPricing(ctx context.Context, planPricingID uint64,
 
 // DeletePlanPricing 删除区域定价
 func (r *planRepo) DeletePlanPricing(ctx context.Context, planPricingID uint64) error {
-	if err := r.data.db.WithContext(ctx).Delete(&model.PlanPricing{}, "plan_pricing_id = ?", planPricingID).Error; err != nil {
+	if err := r.data.DB(ctx).Delete(&model.PlanPricing{}, "plan_pricing_id = ?", planPricingID).Error; err != nil {
 		r.log.Errorf("Failed to delete plan pricing: %v", err)
 		return err
 	}
 	return nil
 }
+
+// pricingUnboundedFrom 不限开始时间的定价保存的 effective_from
+// 唯一索引 uk_plan_country_from 中 NULL 互不相等，用 NULL 表示不限时同一套餐地区可以重复创建不限开始时间的定价
+var pricingUnboundedFrom = time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// pricingFrom 将定价生效开始时间转换为保存的值（零值表示不限）
+func pricingFrom(t time.Time) time.Time {
+	if t.IsZero() {
+		return pricingUnboundedFrom
+	}
+	return t
+}
+
+// pricingFromValue 将保存的定价生效开始时间转换为 time.Time（不限开始时间对应零值）
+func pricingFromValue(t time.Time) time.Time {
+	if !t.After(pricingUnboundedFrom) {
+		return time.Time{}
+	}
+	return t
+}
@@ -0,0 +1,59 @@
+package data
+
+import (
+	"context"
+	"time"
+	"xinyuan_tech/subscription-service/internal/biz"
+	"xinyuan_tech/subscription-service/internal/data/model"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm/clause"
+)
+
+// priceChangeNoticeRepo 调价通知记录仓库实现
+type priceChangeNoticeRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewPriceChangeNoticeRepo 创建调价通知记录仓库
+func NewPriceChangeNoticeRepo(data *Data, logger log.Logger) biz.PriceChangeNoticeRepo {
+	return &priceChangeNoticeRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// ExistsNotice 同一用户、套餐、续费时间是否已经通知过
+func (r *priceChangeNoticeRepo) ExistsNotice(ctx context.Context, uid, planID string, renewAt time.Time) (bool, error) {
+	var count int64
+	if err := r.data.DB(ctx).Model(&model.PriceChangeNotice{}).
+		Where("uid = ? AND plan_id = ? AND renew_at = ?", uid, planID, renewAt).
+		Count(&count).Error; err != nil {
+		r.log.Errorf("Failed to check price change notice for user %s: %v", uid, err)
+		return false, err
+	}
+	return count > 0, nil
+}
+
+// CreateNotice 记录调价通知（唯一键冲突时忽略，保证幂等）
+func (r *priceChangeNoticeRepo) CreateNotice(ctx context.Context, notice *biz.PriceChangeNotice) error {
+	m := &model.PriceChangeNotice{
+		UID:         notice.UID,
+		AppID:       notice.AppID,
+		PlanID:      notice.PlanID,
+		CountryCode: notice.CountryCode,
+		OldPrice:    notice.OldPrice,
+		OldCurrency: notice.OldCurrency,
+		NewPrice:    notice.NewPrice,
+		NewCurrency: notice.NewCurrency,
+		RenewAt:     notice.RenewAt,
+		CreatedAt:   notice.CreatedAt,
+	}
+	if err := r.data.DB(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(m).Error; err != nil {
+		r.log.Errorf("Failed to create price change notice for user %s: %v", notice.UID, err)
+		return err
+	}
+	notice.ID = m.ID
+	return nil
+}
@@ -0,0 +1,165 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"xinyuan_tech/subscription-service/internal/biz"
+	"xinyuan_tech/subscription-service/internal/data/model"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+)
+
+// regionGroupRepo 地区组仓库实现
+type regionGroupRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewRegionGroupRepo 创建地区组仓库
+func NewRegionGroupRepo(data *Data, logger log.Logger) biz.RegionGroupRepo {
+	return &regionGroupRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// ListRegionGroups 获取所有地区组（包含成员国家）
+func (r *regionGroupRepo) ListRegionGroups(ctx context.Context) ([]*biz.RegionGroup, error) {
+	var groups []model.RegionGroup
+	if err := r.data.DB(ctx).Order("group_code ASC").Find(&groups).Error; err != nil {
+		r.log.Errorf("Failed to list region groups: %v", err)
+		return nil, err
+	}
+
+	var members []model.RegionGroupCountry
+	if err := r.data.DB(ctx).Order("country_code ASC").Find(&members).Error; err != nil {
+		r.log.Errorf("Failed to list region group countries: %v", err)
+		return nil, err
+	}
+	countries := make(map[string][]string)
+	for _, m := range members {
+		countries[m.GroupCode] = append(countries[m.GroupCode], m.CountryCode)
+	}
+
+	result := make([]*biz.RegionGroup, len(groups))
+	for i, g := range groups {
+		result[i] = toBizRegionGroup(&g, countries[g.GroupCode])
+	}
+	return result, nil
+}
+
+// GetRegionGroup 获取地区组
+func (r *regionGroupRepo) GetRegionGroup(ctx context.Context, groupCode string) (*biz.RegionGroup, error) {
+	var g model.RegionGroup
+	err := r.data.DB(ctx).Where("group_code = ?", groupCode).First(&g).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		r.log.Errorf("Failed to get region group %s: %v", groupCode, err)
+		return nil, err
+	}
+
+	var countries []string
+	if err := r.data.DB(ctx).Model(&model.RegionGroupCountry{}).
+		Where("group_code = ?", groupCode).
+		Order("country_code ASC").
+		Pluck("country_code", &countries).Error; err != nil {
+		r.log.Errorf("Failed to get countries of region group %s: %v", groupCode, err)
+		return nil, err
+	}
+	return toBizRegionGroup(&g, countries), nil
+}
+
+// GetGroupCodeByCountry 获取国家所属的地区组代码
+func (r *regionGroupRepo) GetGroupCodeByCountry(ctx context.Context, countryCode string) (string, error) {
+	var m model.RegionGroupCountry
+	err := r.data.DB(ctx).Where("country_code = ?", countryCode).First(&m).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	return m.GroupCode, nil
+}
+
+// GetGroupCodesByCountries 批量获取国家所属的地区组代码
+func (r *regionGroupRepo) GetGroupCodesByCountries(ctx context.Context, countryCodes []string) (map[string]string, error) {
+	result := make(map[string]string)
+	if len(countryCodes) == 0 {
+		return result, nil
+	}
+	var members []model.RegionGroupCountry
+	if err := r.data.DB(ctx).Where("country_code IN ?", countryCodes).Find(&members).Error; err != nil {
+		r.log.Errorf("Failed to get region groups of countries: %v", err)
+		return nil, err
+	}
+	for _, m := range members {
+		result[m.CountryCode] = m.GroupCode
+	}
+	return result, nil
+}
+
+// SaveRegionGroup 保存地区组并整体替换成员国家
+func (r *regionGroupRepo) SaveRegionGroup(ctx context.Context, group *biz.RegionGroup) error {
+	return r.data.DB(ctx).Transaction(func(tx *gorm.DB) error {
+		m := &model.RegionGroup{
+			GroupCode:   group.GroupCode,
+			Name:        group.Name,
+			Description: group.Description,
+			CreatedAt:   group.CreatedAt,
+			UpdatedAt:   group.UpdatedAt,
+		}
+		if err := tx.Save(m).Error; err != nil {
+			r.log.Errorf("Failed to save region group %s: %v", group.GroupCode, err)
+			return err
+		}
+		if err := tx.Where("group_code = ?", group.GroupCode).Delete(&model.RegionGroupCountry{}).Error; err != nil {
+			r.log.Errorf("Failed to clear countries of region group %s: %v", group.GroupCode, err)
+			return err
+		}
+		if len(group.Countries) == 0 {
+			return nil
+		}
+		members := make([]model.RegionGroupCountry, len(group.Countries))
+		for i, c := range group.Countries {
+			members[i] = model.RegionGroupCountry{GroupCode: group.GroupCode, CountryCode: c}
+		}
+		if err := tx.Create(&members).Error; err != nil {
+			r.log.Errorf("Failed to save countries of region group %s: %v", group.GroupCode, err)
+			return err
+		}
+		return nil
+	})
+}
+
+// DeleteRegionGroup 删除地区组及其成员国家
+func (r *regionGroupRepo) DeleteRegionGroup(ctx context.Context, groupCode string) error {
+	return r.data.DB(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("group_code = ?", groupCode).Delete(&model.RegionGroupCountry{}).Error; err != nil {
+			r.log.Errorf("Failed to delete countries of region group %s: %v", groupCode, err)
+			return err
+		}
+		if err := tx.Where("group_code = ?", groupCode).Delete(&model.RegionGroup{}).Error; err != nil {
+			r.log.Errorf("Failed to delete region group %s: %v", groupCode, err)
+			return err
+		}
+		return nil
+	})
+}
+
+func toBizRegionGroup(m *model.RegionGroup, countries []string) *biz.RegionGroup {
+	if countries == nil {
+		countries = []string{}
+	}
+	return &biz.RegionGroup{
+		GroupCode:   m.GroupCode,
+		Name:        m.Name,
+		Description: m.Description,
+		Countries:   countries,
+		CreatedAt:   m.CreatedAt,
+		UpdatedAt:   m.UpdatedAt,
+	}
+}
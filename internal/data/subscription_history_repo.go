@@ -2,10 +2,12 @@ package data
 
 import (
 	"context"
+	"encoding/json"
 	"xinyuan_tech/subscription-service/internal/biz"
 	"xinyuan_tech/subscription-service/internal/data/model"
 
 	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
 )
 
 // historyRepo 历史记录仓库实现
@@ -24,63 +26,109 @@ func NewSubscriptionHistoryRepo(data *Data, logger log.Logger) biz.SubscriptionH
 
 // AddSubscriptionHistory 添加订阅历史记录
 func (r *historyRepo) AddSubscriptionHistory(ctx context.Context, history *biz.SubscriptionHistory) error {
+	changes, err := json.Marshal(history.Changes)
+	if err != nil {
+		return err
+	}
 	m := &model.SubscriptionHistory{
-		UID:       history.UID,
-		PlanID:    history.PlanID,
-		PlanName:  history.PlanName,
-		AppID:     history.AppID,
-		StartTime: history.StartTime,
-		EndTime:   history.EndTime,
-		Status:    history.Status,
-		Action:    history.Action,
-		CreatedAt: history.CreatedAt,
-	}
-	if err := r.data.db.WithContext(ctx).Create(m).Error; err != nil {
+		UID:        history.UID,
+		PlanID:     history.PlanID,
+		PlanName:   history.PlanName,
+		AppID:      history.AppID,
+		StartTime:  history.StartTime,
+		EndTime:    timePtr(history.EndTime),
+		Status:     history.Status,
+		Action:     history.Action,
+		ActorType:  history.ActorType,
+		OperatorID: history.OperatorID,
+		Reason:     history.Reason,
+		RequestID:  history.RequestID,
+		Changes:    string(changes),
+		CreatedAt:  history.CreatedAt,
+	}
+	if err := r.data.DB(ctx).Create(m).Error; err != nil {
 		r.log.Errorf("Failed to add subscription history for user %d: %v", history.UID, err)
 		return err
 	}
 	return nil
 }
 
-// GetSubscriptionHistory 获取用户订阅历史
-func (r *historyRepo) GetSubscriptionHistory(ctx context.Context, uid string, page, pageSize int) ([]*biz.SubscriptionHistory, int, error) {
-	var models []model.SubscriptionHistory
-	var total int64
-
-	// 获取总数
-	if err := r.data.db.WithContext(ctx).Model(&model.SubscriptionHistory{}).Where("uid = ?", uid).Count(&total).Error; err != nil {
-		r.log.Errorf("Failed to count subscription history for user %s: %v", uid, err)
-		return nil, 0, err
+// ListSubscriptionHistory 按记录ID倒序查询订阅历史（记录ID随创建时间递增），beforeID 大于 0 时只返回ID小于 beforeID 的记录
+func (r *historyRepo) ListSubscriptionHistory(ctx context.Context, filter *biz.HistoryFilter, beforeID uint64, offset, limit int) ([]*biz.SubscriptionHistory, error) {
+	db := r.filterHistory(ctx, filter)
+	if beforeID > 0 {
+		db = db.Where("subscription_history_id < ?", beforeID)
 	}
 
-	// 分页查询
-	offset := (page - 1) * pageSize
-	if err := r.data.db.WithContext(ctx).
-		Where("uid = ?", uid).
-		Order("created_at DESC").
-		Limit(pageSize).
-		Offset(offset).
-		Find(&models).Error; err != nil {
-		r.log.Errorf("Failed to get subscription history for user %s: %v", uid, err)
-		return nil, 0, err
+	var models []model.SubscriptionHistory
+	if err := db.Order("subscription_history_id DESC").Limit(limit).Offset(offset).Find(&models).Error; err != nil {
+		r.log.Errorf("Failed to list subscription history: %v", err)
+		return nil, err
 	}
 
 	// 转换为业务对象
 	items := make([]*biz.SubscriptionHistory, len(models))
-	for i, m := range models {
-		items[i] = &biz.SubscriptionHistory{
-			SubscriptionHistoryID: m.SubscriptionHistoryID,
-			UID:                   m.UID,
-			PlanID:                m.PlanID,
-			PlanName:              m.PlanName,
-			AppID:                 m.AppID,
-			StartTime:             m.StartTime,
-			EndTime:               m.EndTime,
-			Status:                m.Status,
-			Action:                m.Action,
-			CreatedAt:             m.CreatedAt,
-		}
+	for i := range models {
+		items[i] = toBizHistory(&models[i])
+	}
+	return items, nil
+}
+
+// CountSubscriptionHistory 统计满足条件的订阅历史数
+func (r *historyRepo) CountSubscriptionHistory(ctx context.Context, filter *biz.HistoryFilter) (int, error) {
+	var total int64
+	if err := r.filterHistory(ctx, filter).Count(&total).Error; err != nil {
+		r.log.Errorf("Failed to count subscription history: %v", err)
+		return 0, err
 	}
+	return int(total), nil
+}
 
-	return items, int(total), nil
+// filterHistory 订阅历史筛选条件
+func (r *historyRepo) filterHistory(ctx context.Context, filter *biz.HistoryFilter) *gorm.DB {
+	db := r.data.DB(ctx).Model(&model.SubscriptionHistory{})
+	if filter.UID != "" {
+		db = db.Where("uid = ?", filter.UID)
+	}
+	if filter.AppID != "" {
+		db = db.Where("app_id = ?", filter.AppID)
+	}
+	if filter.PlanID != "" {
+		db = db.Where("plan_id = ?", filter.PlanID)
+	}
+	if len(filter.Actions) > 0 {
+		db = db.Where("action IN ?", filter.Actions)
+	}
+	if !filter.From.IsZero() {
+		db = db.Where("created_at >= ?", filter.From)
+	}
+	if !filter.To.IsZero() {
+		db = db.Where("created_at < ?", filter.To)
+	}
+	return db
+}
+
+// toBizHistory 转换为业务对象
+func toBizHistory(m *model.SubscriptionHistory) *biz.SubscriptionHistory {
+	var changes []*biz.HistoryChange
+	if m.Changes != "" {
+		_ = json.Unmarshal([]byte(m.Changes), &changes) // 历史数据没有变更记录
+	}
+	return &biz.SubscriptionHistory{
+		SubscriptionHistoryID: m.SubscriptionHistoryID,
+		UID:                   m.UID,
+		PlanID:                m.PlanID,
+		PlanName:              m.PlanName,
+		AppID:                 m.AppID,
+		StartTime:             m.StartTime,
+		EndTime:               timeValue(m.EndTime),
+		Status:                m.Status,
+		Action:                m.Action,
+		ActorType:             m.ActorType,
+		OperatorID:            m.OperatorID,
+		Reason:                m.Reason,
+		RequestID:             m.RequestID,
+		Changes:               changes,
+		CreatedAt:             m.CreatedAt,
+	}
 }
@@ -2,10 +2,13 @@ package data
 
 import (
 	"context"
+	"time"
 	"xinyuan_tech/subscription-service/internal/biz"
+	"xinyuan_tech/subscription-service/internal/constants"
 	"xinyuan_tech/subscription-service/internal/data/model"
 
 	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
 )
 
 // orderRepo 订单仓库实现
@@ -22,59 +25,228 @@ func NewSubscriptionOrderRepo(data *Data, logger log.Logger) biz.SubscriptionOrd
 	}
 }
 
-// CreateOrder 创建订单
+// CreateOrder 创建订单（同时保存税费明细）
 func (r *orderRepo) CreateOrder(ctx context.Context, order *biz.SubscriptionOrder) error {
-	m := &model.SubscriptionOrder{
-		OrderID:       order.OrderID,
-		PaymentID:     order.PaymentID,
-		UID:           order.UID,
-		PlanID:        order.PlanID,
-		AppID:         order.AppID,
-		Amount:        order.Amount,
-		PaymentStatus: order.PaymentStatus,
-		CreatedAt:     order.CreatedAt,
-	}
-	if err := r.data.db.WithContext(ctx).Create(m).Error; err != nil {
-		r.log.Errorf("Failed to create order %s: %v", order.OrderID, err)
-		return err
-	}
-	return nil
+	m := toModelOrder(order)
+	return r.data.DB(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(m).Error; err != nil {
+			r.log.Errorf("Failed to create order %s: %v", order.OrderID, err)
+			return err
+		}
+		if len(order.TaxLines) == 0 {
+			return nil
+		}
+		lines := make([]model.OrderTaxLine, len(order.TaxLines))
+		for i, l := range order.TaxLines {
+			lines[i] = model.OrderTaxLine{
+				OrderID:       order.OrderID,
+				Name:          l.Name,
+				CountryCode:   l.CountryCode,
+				Rate:          l.Rate,
+				Inclusive:     l.Inclusive,
+				ReverseCharge: l.ReverseCharge,
+				TaxableAmount: l.TaxableAmount,
+				Amount:        l.Amount,
+			}
+		}
+		if err := tx.Create(&lines).Error; err != nil {
+			r.log.Errorf("Failed to create tax lines of order %s: %v", order.OrderID, err)
+			return err
+		}
+		return nil
+	})
 }
 
 // GetOrder 获取订单
 func (r *orderRepo) GetOrder(ctx context.Context, orderID string) (*biz.SubscriptionOrder, error) {
 	var m model.SubscriptionOrder
-	if err := r.data.db.WithContext(ctx).First(&m, "order_id = ?", orderID).Error; err != nil {
+	if err := r.data.DB(ctx).First(&m, "order_id = ?", orderID).Error; err != nil {
 		r.log.Errorf("Failed to get order %s: %v", orderID, err)
 		return nil, err
 	}
-	return &biz.SubscriptionOrder{
-		OrderID:       m.OrderID,
-		PaymentID:     m.PaymentID,
-		UID:           m.UID,
-		PlanID:        m.PlanID,
-		AppID:         m.AppID,
-		Amount:        m.Amount,
-		PaymentStatus: m.PaymentStatus,
-		CreatedAt:     m.CreatedAt,
-	}, nil
+	return toBizOrder(&m), nil
 }
 
 // UpdateOrder 更新订单
 func (r *orderRepo) UpdateOrder(ctx context.Context, order *biz.SubscriptionOrder) error {
-	m := &model.SubscriptionOrder{
-		OrderID:       order.OrderID,
-		PaymentID:     order.PaymentID,
-		UID:           order.UID,
-		PlanID:        order.PlanID,
-		AppID:         order.AppID,
-		Amount:        order.Amount,
-		PaymentStatus: order.PaymentStatus,
-		CreatedAt:     order.CreatedAt,
-	}
-	if err := r.data.db.WithContext(ctx).Save(m).Error; err != nil {
+	m := toModelOrder(order)
+	if err := r.data.DB(ctx).Save(m).Error; err != nil {
 		r.log.Errorf("Failed to update order %s: %v", order.OrderID, err)
 		return err
 	}
 	return nil
 }
+
+// GetOrderTaxLines 获取订单税费明细
+func (r *orderRepo) GetOrderTaxLines(ctx context.Context, orderID string) ([]*biz.TaxLine, error) {
+	var lines []model.OrderTaxLine
+	if err := r.data.DB(ctx).Where("order_id = ?", orderID).Order("order_tax_line_id ASC").Find(&lines).Error; err != nil {
+		r.log.Errorf("Failed to get tax lines of order %s: %v", orderID, err)
+		return nil, err
+	}
+	result := make([]*biz.TaxLine, len(lines))
+	for i, l := range lines {
+		result[i] = &biz.TaxLine{
+			Name:          l.Name,
+			CountryCode:   l.CountryCode,
+			Rate:          l.Rate,
+			Inclusive:     l.Inclusive,
+			ReverseCharge: l.ReverseCharge,
+			TaxableAmount: l.TaxableAmount,
+			Amount:        l.Amount,
+		}
+	}
+	return result, nil
+}
+
+func toModelOrder(order *biz.SubscriptionOrder) *model.SubscriptionOrder {
+	// 未指定来源时按购买处理
+	source := order.Source
+	if source == "" {
+		source = constants.SourcePurchase
+	}
+	return &model.SubscriptionOrder{
+		OrderID:           order.OrderID,
+		PaymentID:         order.PaymentID,
+		UID:               order.UID,
+		PlanID:            order.PlanID,
+		AppID:             order.AppID,
+		CountryCode:       order.CountryCode,
+		Currency:          order.Currency,
+		Subtotal:          order.Subtotal,
+		DiscountAmount:    order.DiscountAmount,
+		ProrationCredit:   order.ProrationCredit,
+		CreditOrderID:     order.CreditOrderID,
+		VatID:             order.VatID,
+		TaxAmount:         order.TaxAmount,
+		Amount:            order.Amount,
+		RefundedAmount:    order.RefundedAmount,
+		PaidAt:            timePtr(order.PaidAt),
+		ReportingCurrency: order.ReportingCurrency,
+		ExchangeRate:      order.ExchangeRate,
+		PeriodStart:       timePtr(order.PeriodStart),
+		PeriodEnd:         timePtr(order.PeriodEnd),
+		PaymentStatus:     order.PaymentStatus,
+		Source:            source,
+		CreatedAt:         order.CreatedAt,
+	}
+}
+
+func toBizOrder(m *model.SubscriptionOrder) *biz.SubscriptionOrder {
+	return &biz.SubscriptionOrder{
+		OrderID:           m.OrderID,
+		PaymentID:         m.PaymentID,
+		UID:               m.UID,
+		PlanID:            m.PlanID,
+		AppID:             m.AppID,
+		CountryCode:       m.CountryCode,
+		Currency:          m.Currency,
+		Subtotal:          m.Subtotal,
+		DiscountAmount:    m.DiscountAmount,
+		ProrationCredit:   m.ProrationCredit,
+		CreditOrderID:     m.CreditOrderID,
+		VatID:             m.VatID,
+		TaxAmount:         m.TaxAmount,
+		Amount:            m.Amount,
+		RefundedAmount:    m.RefundedAmount,
+		PaidAt:            timeValue(m.PaidAt),
+		ReportingCurrency: m.ReportingCurrency,
+		ExchangeRate:      m.ExchangeRate,
+		PeriodStart:       timeValue(m.PeriodStart),
+		PeriodEnd:         timeValue(m.PeriodEnd),
+		PaymentStatus:     m.PaymentStatus,
+		Source:            m.Source,
+		CreatedAt:         m.CreatedAt,
+	}
+}
+
+// revenueRow 收入汇总查询结果
+type revenueRow struct {
+	Currency          string
+	ReportingCurrency string
+	OrderCount        int
+	Amount            float64
+	RefundedAmount    float64
+	TaxAmount         float64
+	ConvertedAmount   float64
+	ConvertedRefunded float64
+	ConvertedTax      float64
+}
+
+// SumRevenue 汇总订单收入
+// 支付时间早于 paid_at 字段上线的订单按创建时间统计；税额按退款比例扣除冲减部分
+func (r *orderRepo) SumRevenue(ctx context.Context, appID string, from, to time.Time) ([]*biz.RevenueSummary, error) {
+	var rows []revenueRow
+	db := r.data.DB(ctx).Model(&model.SubscriptionOrder{}).
+		Select(`currency,
+			CASE WHEN exchange_rate > 0 THEN reporting_currency ELSE '' END AS reporting_currency,
+			COUNT(*) AS order_count,
+			SUM(amount) AS amount,
+			SUM(refunded_amount) AS refunded_amount,
+			SUM(tax_amount * (amount - refunded_amount) / amount) AS tax_amount,
+			SUM(amount * exchange_rate) AS converted_amount,
+			SUM(refunded_amount * exchange_rate) AS converted_refunded,
+			SUM(tax_amount * (amount - refunded_amount) / amount * exchange_rate) AS converted_tax`).
+		Where("payment_status IN ?", []string{constants.PaymentStatusSuccess, constants.PaymentStatusPartiallyRefunded, constants.PaymentStatusRefunded}).
+		Where("amount > 0 AND source = ?", constants.SourcePurchase).
+		Where("COALESCE(paid_at, created_at) >= ? AND COALESCE(paid_at, created_at) < ?", from, to)
+	if appID != "" {
+		db = db.Where("app_id = ?", appID)
+	}
+	if err := db.Group("currency, 2").Scan(&rows).Error; err != nil {
+		r.log.Errorf("Failed to sum revenue of app %s: %v", appID, err)
+		return nil, err
+	}
+	result := make([]*biz.RevenueSummary, len(rows))
+	for i, row := range rows {
+		result[i] = &biz.RevenueSummary{
+			Currency:          row.Currency,
+			ReportingCurrency: row.ReportingCurrency,
+			OrderCount:        row.OrderCount,
+			Amount:            row.Amount,
+			RefundedAmount:    row.RefundedAmount,
+			TaxAmount:         row.TaxAmount,
+			ConvertedAmount:   row.ConvertedAmount,
+			ConvertedRefunded: row.ConvertedRefunded,
+			ConvertedTax:      row.ConvertedTax,
+		}
+	}
+	return result, nil
+}
+
+// ListPaidOrders 获取应用在 from 之后支付的订单
+func (r *orderRepo) ListPaidOrders(ctx context.Context, appID string, from time.Time) ([]*biz.SubscriptionOrder, error) {
+	var ms []model.SubscriptionOrder
+	if err := r.data.DB(ctx).
+		Where("app_id = ? AND amount > 0 AND source = ?", appID, constants.SourcePurchase).
+		Where("payment_status IN ?", []string{constants.PaymentStatusSuccess, constants.PaymentStatusPartiallyRefunded, constants.PaymentStatusRefunded}).
+		Where("COALESCE(paid_at, created_at) >= ?", from).
+		Order("COALESCE(paid_at, created_at) ASC").
+		Find(&ms).Error; err != nil {
+		r.log.Errorf("Failed to list paid orders of app %s: %v", appID, err)
+		return nil, err
+	}
+	result := make([]*biz.SubscriptionOrder, len(ms))
+	for i := range ms {
+		result[i] = toBizOrder(&ms[i])
+	}
+	return result, nil
+}
+
+// ListUserOrders 获取用户最近创建的订单
+func (r *orderRepo) ListUserOrders(ctx context.Context, uid string, limit int) ([]*biz.SubscriptionOrder, error) {
+	var ms []model.SubscriptionOrder
+	if err := r.data.DB(ctx).
+		Where("uid = ?", uid).
+		Order("created_at DESC").
+		Limit(limit).
+		Find(&ms).Error; err != nil {
+		r.log.Errorf("Failed to list orders of user %s: %v", uid, err)
+		return nil, err
+	}
+	result := make([]*biz.SubscriptionOrder, len(ms))
+	for i := range ms {
+		result[i] = toBizOrder(&ms[i])
+	}
+	return result, nil
+}
@@ -0,0 +1,134 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"xinyuan_tech/subscription-service/internal/biz"
+	"xinyuan_tech/subscription-service/internal/data/model"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+)
+
+// taxRuleRepo 税务规则仓库实现
+type taxRuleRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewTaxRuleRepo 创建税务规则仓库
+func NewTaxRuleRepo(data *Data, logger log.Logger) biz.TaxRuleRepo {
+	return &taxRuleRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// ListTaxRules 获取税务规则，countryCode 为空时返回所有国家
+func (r *taxRuleRepo) ListTaxRules(ctx context.Context, countryCode string) ([]*biz.TaxRule, error) {
+	query := r.data.DB(ctx)
+	if countryCode != "" {
+		query = query.Where("country_code = ?", countryCode)
+	}
+	var rules []model.TaxRule
+	if err := query.Order("country_code ASC, tax_rule_id ASC").Find(&rules).Error; err != nil {
+		r.log.Errorf("Failed to list tax rules: %v", err)
+		return nil, err
+	}
+	return toBizTaxRules(rules), nil
+}
+
+// ListEnabledTaxRules 获取国家启用中的税务规则
+func (r *taxRuleRepo) ListEnabledTaxRules(ctx context.Context, countryCode string) ([]*biz.TaxRule, error) {
+	var rules []model.TaxRule
+	if err := r.data.DB(ctx).
+		Where("country_code = ? AND enabled = ?", countryCode, true).
+		Order("tax_rule_id ASC").
+		Find(&rules).Error; err != nil {
+		return nil, err
+	}
+	return toBizTaxRules(rules), nil
+}
+
+// GetTaxRule 获取税务规则，不存在时返回 nil
+func (r *taxRuleRepo) GetTaxRule(ctx context.Context, taxRuleID uint64) (*biz.TaxRule, error) {
+	var m model.TaxRule
+	err := r.data.DB(ctx).First(&m, "tax_rule_id = ?", taxRuleID).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		r.log.Errorf("Failed to get tax rule %d: %v", taxRuleID, err)
+		return nil, err
+	}
+	return toBizTaxRule(&m), nil
+}
+
+// CreateTaxRule 创建税务规则
+func (r *taxRuleRepo) CreateTaxRule(ctx context.Context, rule *biz.TaxRule) error {
+	m := toModelTaxRule(rule)
+	if err := r.data.DB(ctx).Create(m).Error; err != nil {
+		r.log.Errorf("Failed to create tax rule %s/%s: %v", rule.CountryCode, rule.Name, err)
+		return err
+	}
+	rule.TaxRuleID = m.TaxRuleID
+	rule.CreatedAt = m.CreatedAt
+	rule.UpdatedAt = m.UpdatedAt
+	return nil
+}
+
+// UpdateTaxRule 更新税务规则
+func (r *taxRuleRepo) UpdateTaxRule(ctx context.Context, rule *biz.TaxRule) error {
+	m := toModelTaxRule(rule)
+	if err := r.data.DB(ctx).Save(m).Error; err != nil {
+		r.log.Errorf("Failed to update tax rule %d: %v", rule.TaxRuleID, err)
+		return err
+	}
+	rule.UpdatedAt = m.UpdatedAt
+	return nil
+}
+
+// DeleteTaxRule 删除税务规则
+func (r *taxRuleRepo) DeleteTaxRule(ctx context.Context, taxRuleID uint64) error {
+	if err := r.data.DB(ctx).Delete(&model.TaxRule{}, "tax_rule_id = ?", taxRuleID).Error; err != nil {
+		r.log.Errorf("Failed to delete tax rule %d: %v", taxRuleID, err)
+		return err
+	}
+	return nil
+}
+
+func toModelTaxRule(rule *biz.TaxRule) *model.TaxRule {
+	return &model.TaxRule{
+		TaxRuleID:     rule.TaxRuleID,
+		CountryCode:   rule.CountryCode,
+		Name:          rule.Name,
+		Rate:          rule.Rate,
+		Inclusive:     rule.Inclusive,
+		ReverseCharge: rule.ReverseCharge,
+		Enabled:       rule.Enabled,
+		CreatedAt:     rule.CreatedAt,
+		UpdatedAt:     rule.UpdatedAt,
+	}
+}
+
+func toBizTaxRule(m *model.TaxRule) *biz.TaxRule {
+	return &biz.TaxRule{
+		TaxRuleID:     m.TaxRuleID,
+		CountryCode:   m.CountryCode,
+		Name:          m.Name,
+		Rate:          m.Rate,
+		Inclusive:     m.Inclusive,
+		ReverseCharge: m.ReverseCharge,
+		Enabled:       m.Enabled,
+		CreatedAt:     m.CreatedAt,
+		UpdatedAt:     m.UpdatedAt,
+	}
+}
+
+func toBizTaxRules(rules []model.TaxRule) []*biz.TaxRule {
+	result := make([]*biz.TaxRule, len(rules))
+	for i := range rules {
+		result[i] = toBizTaxRule(&rules[i])
+	}
+	return result
+}
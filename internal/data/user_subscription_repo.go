@@ -10,9 +10,11 @@ import (
 	"xinyuan_tech/subscription-service/internal/biz"
 	"xinyuan_tech/subscription-service/internal/constants"
 	"xinyuan_tech/subscription-service/internal/data/model"
+	"xinyuan_tech/subscription-service/internal/metrics"
 
 	"github.com/go-kratos/kratos/v2/log"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 // subscriptionRepo 订阅仓库实现
@@ -30,28 +32,38 @@ func NewUserSubscriptionRepo(data *Data, logger log.Logger) biz.UserSubscription
 }
 
 // GetSubscription 获取用户订阅
+// 在事务中时直接读数据库且不写缓存：缓存可能落后于事务内的修改，事务内读到的未提交状态也不能写入缓存
 func (r *subscriptionRepo) GetSubscription(ctx context.Context, uid string) (*biz.UserSubscription, error) {
-	// 1. 尝试从 Redis 获取
-	cacheKey := fmt.Sprintf("subscription:user:%s", uid)
-	val, err := r.data.rdb.Get(ctx, cacheKey).Result()
-	if err == nil {
-		// 检查是否是空值缓存
-		if val == "null" {
-			return nil, nil
-		}
+	inTx := r.data.InTx(ctx)
 
-		var sub biz.UserSubscription
-		if err := json.Unmarshal([]byte(val), &sub); err == nil {
-			return &sub, nil
+	// 1. 尝试从 Redis 获取
+	cacheKey := subscriptionCacheKey(uid)
+	if !inTx {
+		val, err := r.data.rdb.Get(ctx, cacheKey).Result()
+		if err == nil {
+			// 检查是否是空值缓存
+			if val == "null" {
+				metrics.CacheRequests.WithLabelValues(constants.MetricCacheSubscription, constants.MetricCacheHit).Inc()
+				return nil, nil
+			}
+
+			var sub biz.UserSubscription
+			if err := json.Unmarshal([]byte(val), &sub); err == nil {
+				metrics.CacheRequests.WithLabelValues(constants.MetricCacheSubscription, constants.MetricCacheHit).Inc()
+				return &sub, nil
+			}
 		}
+		metrics.CacheRequests.WithLabelValues(constants.MetricCacheSubscription, constants.MetricCacheMiss).Inc()
 	}
 
 	// 2. 从数据库获取
 	var m model.UserSubscription
-	err = r.data.db.WithContext(ctx).Where("uid = ?", uid).First(&m).Error
+	err := r.data.DB(ctx).Where("uid = ?", uid).First(&m).Error
 	if errors.Is(err, gorm.ErrRecordNotFound) {
 		// 缓存空值,防止缓存穿透
-		r.data.rdb.Set(ctx, cacheKey, "null", constants.NullCacheExpiration)
+		if !inTx {
+			r.data.rdb.Set(ctx, cacheKey, "null", constants.NullCacheExpiration)
+		}
 		return nil, nil
 	}
 	if err != nil {
@@ -59,21 +71,12 @@ func (r *subscriptionRepo) GetSubscription(ctx context.Context, uid string) (*bi
 		return nil, err
 	}
 
-	sub := &biz.UserSubscription{
-		SubscriptionID: m.SubscriptionID,
-		UID:            m.UID,
-		PlanID:         m.PlanID,
-		AppID:          m.AppID,
-		StartTime:      m.StartTime,
-		EndTime:        m.EndTime,
-		Status:         m.Status,
-		OrderID:        m.OrderID,
-		IsAutoRenew:    m.IsAutoRenew,
-		CreatedAt:      m.CreatedAt,
-		UpdatedAt:      m.UpdatedAt,
-	}
+	sub := toBizUserSubscription(&m)
 
 	// 3. 写入 Redis 缓存 (1小时 + 随机时间,防止缓存雪崩)
+	if inTx {
+		return sub, nil
+	}
 	if data, err := json.Marshal(sub); err == nil {
 		// 添加随机过期时间
 		randomSeconds := time.Duration(rand.Intn(constants.CacheRandomMaxSeconds)) * time.Second
@@ -86,7 +89,7 @@ func (r *subscriptionRepo) GetSubscription(ctx context.Context, uid string) (*bi
 	return sub, nil
 }
 
-// SaveSubscription 保存订阅
+// SaveSubscription 保存订阅：新订阅插入，已有订阅按版本号条件更新；同一用户已有订阅或版本号不一致时返回 biz.ErrSubscriptionConflict
 func (r *subscriptionRepo) SaveSubscription(ctx context.Context, sub *biz.UserSubscription) error {
 	// app_id 应该由业务层从 Context 获取并传入，不再通过 plan 表查询获取
 	appID := sub.AppID
@@ -94,37 +97,96 @@ func (r *subscriptionRepo) SaveSubscription(ctx context.Context, sub *biz.UserSu
 		r.log.Warnf("app_id is empty in UserSubscription, this should be set by business layer from Context")
 	}
 
+	// 未指定来源时按购买处理
+	source := sub.Source
+	if source == "" {
+		source = constants.SourcePurchase
+	}
+
 	m := &model.UserSubscription{
 		SubscriptionID: sub.SubscriptionID,
 		UID:            sub.UID,
 		PlanID:         sub.PlanID,
 		AppID:          appID,
+		CountryCode:    sub.CountryCode,
 		StartTime:      sub.StartTime,
-		EndTime:        sub.EndTime,
+		EndTime:        timePtr(sub.EndTime),
+		BillingAnchor:  timePtr(sub.BillingAnchor),
 		Status:         sub.Status,
 		OrderID:        sub.OrderID,
 		IsAutoRenew:    sub.IsAutoRenew,
+		Source:         source,
+		NextPlanID:     sub.NextPlanID,
 		CreatedAt:      sub.CreatedAt,
 		UpdatedAt:      sub.UpdatedAt,
 	}
-	if err := r.data.db.WithContext(ctx).Save(m).Error; err != nil {
-		r.log.Errorf("Failed to save subscription for user %s: %v", sub.UID, err)
-		return err
-	}
-	// 更新 biz 对象的 SubscriptionID（如果是新创建的）
-	sub.SubscriptionID = m.SubscriptionID
-
-	// 删除缓存
-	cacheKey := fmt.Sprintf("subscription:user:%s", sub.UID)
-	if err := r.data.rdb.Del(ctx, cacheKey).Err(); err != nil {
-		r.log.Warnf("Failed to delete cache for user %s: %v", sub.UID, err)
-		// 缓存删除失败不影响主流程,但需要记录
-		// 缓存会在过期时间后自动失效
+	if sub.SubscriptionID == 0 {
+		// 新订阅：同一用户已有订阅（并发创建）时返回冲突
+		m.Version = 1
+		result := r.data.DB(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(m)
+		if result.Error != nil {
+			r.log.Errorf("Failed to create subscription for user %s: %v", sub.UID, result.Error)
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			r.log.Warnf("Subscription of user %s already exists", sub.UID)
+			r.data.rdb.Del(ctx, subscriptionCacheKey(sub.UID))
+			return biz.ErrSubscriptionConflict
+		}
+		sub.SubscriptionID = m.SubscriptionID
+		sub.Source = m.Source
+		sub.Version = m.Version
+	} else {
+		// 已有订阅：只更新版本号与 sub.Version 一致的记录，版本号不一致说明读取后已被其他操作修改
+		if m.UpdatedAt.IsZero() {
+			m.UpdatedAt = time.Now().UTC()
+		}
+		result := r.data.DB(ctx).Model(&model.UserSubscription{}).
+			Where("subscription_id = ? AND version = ?", sub.SubscriptionID, sub.Version).
+			Updates(map[string]interface{}{
+				"plan_id":        m.PlanID,
+				"app_id":         m.AppID,
+				"country_code":   m.CountryCode,
+				"start_time":     m.StartTime,
+				"end_time":       m.EndTime,
+				"billing_anchor": m.BillingAnchor,
+				"status":         m.Status,
+				"order_id":       m.OrderID,
+				"is_auto_renew":  m.IsAutoRenew,
+				"source":         m.Source,
+				"next_plan_id":   m.NextPlanID,
+				"version":        gorm.Expr("version + 1"),
+				"updated_at":     m.UpdatedAt,
+			})
+		if result.Error != nil {
+			r.log.Errorf("Failed to save subscription for user %s: %v", sub.UID, result.Error)
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			// 读取的可能是旧缓存，立即删除缓存，重试时从数据库读取
+			r.log.Warnf("Subscription %d of user %s was modified concurrently (version %d)", sub.SubscriptionID, sub.UID, sub.Version)
+			r.data.rdb.Del(ctx, subscriptionCacheKey(sub.UID))
+			return biz.ErrSubscriptionConflict
+		}
+		sub.Source = m.Source
+		sub.Version++
 	}
 
+	// 删除缓存（在事务中时提交后删除）
+	r.deleteCache(ctx, sub.UID)
 	return nil
 }
 
+// deleteCache 删除用户订阅缓存，在事务中时提交后删除
+// 删除失败不影响主流程，缓存会在过期时间后自动失效
+func (r *subscriptionRepo) deleteCache(ctx context.Context, uid string) {
+	r.data.AfterCommit(ctx, func() {
+		if err := r.data.rdb.Del(ctx, subscriptionCacheKey(uid)).Err(); err != nil {
+			r.log.Warnf("Failed to delete cache for user %s: %v", uid, err)
+		}
+	})
+}
+
 // GetExpiringSubscriptions 获取即将过期的订阅
 func (r *subscriptionRepo) GetExpiringSubscriptions(ctx context.Context, daysBeforeExpiry, page, pageSize int) ([]*biz.UserSubscription, int, error) {
 	var models []model.UserSubscription
@@ -134,8 +196,8 @@ func (r *subscriptionRepo) GetExpiringSubscriptions(ctx context.Context, daysBef
 	expiryDate := now.AddDate(0, 0, daysBeforeExpiry)
 
 	// 获取总数
-	if err := r.data.db.WithContext(ctx).Model(&model.UserSubscription{}).
-		Where("end_time BETWEEN ? AND ? AND status = ?", now, expiryDate, constants.StatusActive).
+	if err := r.data.DB(ctx).Model(&model.UserSubscription{}).
+		Where("end_time IS NOT NULL AND end_time BETWEEN ? AND ? AND status = ?", now, expiryDate, constants.StatusActive).
 		Count(&total).Error; err != nil {
 		r.log.Errorf("Failed to count expiring subscriptions: %v", err)
 		return nil, 0, err
@@ -143,8 +205,8 @@ func (r *subscriptionRepo) GetExpiringSubscriptions(ctx context.Context, daysBef
 
 	// 分页查询
 	offset := (page - 1) * pageSize
-	if err := r.data.db.WithContext(ctx).
-		Where("end_time BETWEEN ? AND ? AND status = ?", now, expiryDate, constants.StatusActive).
+	if err := r.data.DB(ctx).
+		Where("end_time IS NOT NULL AND end_time BETWEEN ? AND ? AND status = ?", now, expiryDate, constants.StatusActive).
 		Order("end_time ASC").
 		Limit(pageSize).
 		Offset(offset).
@@ -155,59 +217,68 @@ func (r *subscriptionRepo) GetExpiringSubscriptions(ctx context.Context, daysBef
 
 	// 转换为业务对象
 	subscriptions := make([]*biz.UserSubscription, len(models))
-	for i, m := range models {
-		subscriptions[i] = &biz.UserSubscription{
-			SubscriptionID: m.SubscriptionID,
-			UID:            m.UID,
-			PlanID:         m.PlanID,
-			StartTime:      m.StartTime,
-			EndTime:        m.EndTime,
-			Status:         m.Status,
-			OrderID:        m.OrderID,
-			IsAutoRenew:    m.IsAutoRenew,
-			CreatedAt:      m.CreatedAt,
-			UpdatedAt:      m.UpdatedAt,
-		}
+	for i := range models {
+		subscriptions[i] = toBizUserSubscription(&models[i])
 	}
 
 	return subscriptions, int(total), nil
 }
 
-// UpdateExpiredSubscriptions 批量更新过期订阅状态
-func (r *subscriptionRepo) UpdateExpiredSubscriptions(ctx context.Context) (int, []string, error) {
-	now := time.Now().UTC()
-
-	// 先查询需要更新的订阅
-	var subscriptions []model.UserSubscription
-	if err := r.data.db.WithContext(ctx).
-		Where("end_time < ? AND status = ?", now, constants.StatusActive).
-		Find(&subscriptions).Error; err != nil {
+// GetExpiredSubscriptionUIDs 获取已过期但状态仍为 active 的订阅用户
+func (r *subscriptionRepo) GetExpiredSubscriptionUIDs(ctx context.Context) ([]string, error) {
+	var uids []string
+	if err := r.data.DB(ctx).Model(&model.UserSubscription{}).
+		Where("end_time IS NOT NULL AND end_time < ? AND status = ?", time.Now().UTC(), constants.StatusActive).
+		Pluck("uid", &uids).Error; err != nil {
 		r.log.Errorf("Failed to query expired subscriptions: %v", err)
-		return 0, nil, err
+		return nil, err
 	}
+	return uids, nil
+}
 
-	if len(subscriptions) == 0 {
-		return 0, []string{}, nil
+// ListExpiredSubscriptions 按 subscription_id 游标获取在 now 之前已过期但状态仍为 active 的订阅
+func (r *subscriptionRepo) ListExpiredSubscriptions(ctx context.Context, now time.Time, afterID uint64, limit int) ([]*biz.UserSubscription, error) {
+	var models []model.UserSubscription
+	// 终身订阅 end_time 为 NULL，永不过期
+	if err := r.data.DB(ctx).
+		Where("end_time IS NOT NULL AND end_time < ? AND status = ? AND subscription_id > ?", now, constants.StatusActive, afterID).
+		Order("subscription_id ASC").
+		Limit(limit).
+		Find(&models).Error; err != nil {
+		r.log.Errorf("Failed to list expired subscriptions: %v", err)
+		return nil, err
 	}
 
-	// 提取 uid 列表
-	uids := make([]string, len(subscriptions))
-	for i, sub := range subscriptions {
-		uids[i] = sub.UID
+	subscriptions := make([]*biz.UserSubscription, len(models))
+	for i := range models {
+		subscriptions[i] = toBizUserSubscription(&models[i])
 	}
+	return subscriptions, nil
+}
 
-	// 批量更新状态
-	result := r.data.db.WithContext(ctx).Model(&model.UserSubscription{}).
-		Where("end_time < ? AND status = ?", now, constants.StatusActive).
-		Update("status", constants.StatusExpired)
-
+// ExpireSubscription 将订阅标记为过期：只更新版本号与 sub.Version 一致、仍为 active 且在 now 之前已过期的记录，
+// 查询后被续费或修改的订阅不会被更新（返回 false）；更新成功后删除缓存（在事务中时提交后删除）
+func (r *subscriptionRepo) ExpireSubscription(ctx context.Context, sub *biz.UserSubscription, now time.Time) (bool, error) {
+	result := r.data.DB(ctx).Model(&model.UserSubscription{}).
+		Where("subscription_id = ? AND version = ? AND status = ? AND end_time IS NOT NULL AND end_time < ?",
+			sub.SubscriptionID, sub.Version, constants.StatusActive, now).
+		Updates(map[string]interface{}{
+			"status":     constants.StatusExpired,
+			"version":    gorm.Expr("version + 1"),
+			"updated_at": now,
+		})
 	if result.Error != nil {
-		r.log.Errorf("Failed to update expired subscriptions: %v", result.Error)
-		return 0, nil, result.Error
+		r.log.Errorf("Failed to expire subscription %d of user %s: %v", sub.SubscriptionID, sub.UID, result.Error)
+		return false, result.Error
 	}
-
-	r.log.Infof("Updated %d expired subscriptions", result.RowsAffected)
-	return int(result.RowsAffected), uids, nil
+	if result.RowsAffected == 0 {
+		return false, nil
+	}
+	sub.Status = constants.StatusExpired
+	sub.Version++
+	sub.UpdatedAt = now
+	r.deleteCache(ctx, sub.UID)
+	return true, nil
 }
 
 // GetAutoRenewSubscriptions 获取需要自动续费的订阅
@@ -217,9 +288,9 @@ func (r *subscriptionRepo) GetAutoRenewSubscriptions(ctx context.Context, daysBe
 	now := time.Now().UTC()
 	expiryDate := now.AddDate(0, 0, daysBeforeExpiry)
 
-	// 查询即将过期且开启了自动续费的订阅
-	if err := r.data.db.WithContext(ctx).
-		Where("end_time BETWEEN ? AND ? AND status = ? AND is_auto_renew = ?",
+	// 查询即将过期且开启了自动续费的订阅（终身订阅 end_time 为 NULL，不参与续费）
+	if err := r.data.DB(ctx).
+		Where("end_time IS NOT NULL AND end_time BETWEEN ? AND ? AND status = ? AND is_auto_renew = ?",
 			now, expiryDate, "active", true).
 		Order("end_time ASC").
 		Find(&models).Error; err != nil {
@@ -229,20 +300,171 @@ func (r *subscriptionRepo) GetAutoRenewSubscriptions(ctx context.Context, daysBe
 
 	// 转换为业务对象
 	subscriptions := make([]*biz.UserSubscription, len(models))
-	for i, m := range models {
-		subscriptions[i] = &biz.UserSubscription{
-			SubscriptionID: m.SubscriptionID,
-			UID:            m.UID,
-			PlanID:         m.PlanID,
-			StartTime:      m.StartTime,
-			EndTime:        m.EndTime,
-			Status:         m.Status,
-			OrderID:        m.OrderID,
-			IsAutoRenew:    m.IsAutoRenew,
-			CreatedAt:      m.CreatedAt,
-			UpdatedAt:      m.UpdatedAt,
-		}
+	for i := range models {
+		subscriptions[i] = toBizUserSubscription(&models[i])
+	}
+
+	return subscriptions, nil
+}
+
+// ListAutoRenewSubscriptions 按 (end_time, subscription_id) 游标分页获取需要自动续费的订阅
+func (r *subscriptionRepo) ListAutoRenewSubscriptions(ctx context.Context, query *biz.AutoRenewQuery) ([]*biz.UserSubscription, error) {
+	var models []model.UserSubscription
+
+	// 即将过期（end_time 在当前时间和截止时间之间）且开启了自动续费的订阅，终身订阅 end_time 为 NULL，不参与续费
+	db := r.data.DB(ctx).
+		Where("end_time IS NOT NULL AND end_time BETWEEN ? AND ? AND status = ? AND is_auto_renew = ?",
+			time.Now().UTC(), query.ExpireBefore, constants.StatusActive, true)
+	if !query.UpdatedBefore.IsZero() {
+		db = db.Where("updated_at < ?", query.UpdatedBefore)
+	}
+	if query.After != nil {
+		db = db.Where("(end_time > ? OR (end_time = ? AND subscription_id > ?))",
+			query.After.EndTime, query.After.EndTime, query.After.SubscriptionID)
+	}
+	if err := db.Order("end_time ASC, subscription_id ASC").Limit(query.Limit).Find(&models).Error; err != nil {
+		r.log.Errorf("Failed to list auto-renew subscriptions: %v", err)
+		return nil, err
+	}
+
+	// 转换为业务对象
+	subscriptions := make([]*biz.UserSubscription, len(models))
+	for i := range models {
+		subscriptions[i] = toBizUserSubscription(&models[i])
+	}
+
+	return subscriptions, nil
+}
+
+// SearchSubscriptions 按条件分页查询订阅
+func (r *subscriptionRepo) SearchSubscriptions(ctx context.Context, query *biz.SubscriptionSearchQuery) ([]*biz.UserSubscription, int, error) {
+	db := r.data.DB(ctx).Model(&model.UserSubscription{})
+	if query.UID != "" {
+		db = db.Where("uid = ?", query.UID)
+	}
+	if query.AppID != "" {
+		db = db.Where("app_id = ?", query.AppID)
+	}
+	if query.PlanID != "" {
+		db = db.Where("plan_id = ?", query.PlanID)
+	}
+	if query.Status != "" {
+		db = db.Where("status = ?", query.Status)
+	}
+	// TimeField 由业务层校验为 user_subscription 的时间列
+	if !query.From.IsZero() {
+		db = db.Where(query.TimeField+" >= ?", query.From)
+	}
+	if !query.To.IsZero() {
+		db = db.Where(query.TimeField+" < ?", query.To)
+	}
+
+	var total int64
+	if err := db.Count(&total).Error; err != nil {
+		r.log.Errorf("Failed to count subscriptions: %v", err)
+		return nil, 0, err
+	}
+
+	var models []model.UserSubscription
+	offset := (query.Page - 1) * query.PageSize
+	if err := db.Order("subscription_id DESC").Limit(query.PageSize).Offset(offset).Find(&models).Error; err != nil {
+		r.log.Errorf("Failed to search subscriptions: %v", err)
+		return nil, 0, err
+	}
+
+	subscriptions := make([]*biz.UserSubscription, len(models))
+	for i := range models {
+		subscriptions[i] = toBizUserSubscription(&models[i])
+	}
+	return subscriptions, int(total), nil
+}
+
+// CountSubscriptions 统计满足批量操作筛选条件的订阅数和最大订阅ID
+func (r *subscriptionRepo) CountSubscriptions(ctx context.Context, filter *biz.SubscriptionFilter) (int, uint64, error) {
+	var row struct {
+		Total int64
+		MaxID uint64
+	}
+	if err := r.filterSubscriptions(ctx, filter).
+		Select("COUNT(*) AS total, COALESCE(MAX(subscription_id), 0) AS max_id").
+		Scan(&row).Error; err != nil {
+		r.log.Errorf("Failed to count subscriptions: %v", err)
+		return 0, 0, err
+	}
+	return int(row.Total), row.MaxID, nil
+}
+
+// ListSubscriptionsByFilter 按 subscription_id 游标获取满足批量操作筛选条件的订阅
+func (r *subscriptionRepo) ListSubscriptionsByFilter(ctx context.Context, filter *biz.SubscriptionFilter, afterID uint64, limit int) ([]*biz.UserSubscription, error) {
+	var models []model.UserSubscription
+	if err := r.filterSubscriptions(ctx, filter).
+		Where("subscription_id > ?", afterID).
+		Order("subscription_id ASC").
+		Limit(limit).
+		Find(&models).Error; err != nil {
+		r.log.Errorf("Failed to list subscriptions: %v", err)
+		return nil, err
 	}
 
+	subscriptions := make([]*biz.UserSubscription, len(models))
+	for i := range models {
+		subscriptions[i] = toBizUserSubscription(&models[i])
+	}
 	return subscriptions, nil
 }
+
+// filterSubscriptions 批量操作筛选条件（终身订阅和免费套餐 end_time 为 NULL，不参与批量操作）
+func (r *subscriptionRepo) filterSubscriptions(ctx context.Context, filter *biz.SubscriptionFilter) *gorm.DB {
+	db := r.data.DB(ctx).Model(&model.UserSubscription{}).
+		Where("app_id = ? AND status = ? AND end_time IS NOT NULL", filter.AppID, filter.Status)
+	if filter.PlanID != "" {
+		db = db.Where("plan_id = ?", filter.PlanID)
+	}
+	if filter.MaxSubscriptionID > 0 {
+		db = db.Where("subscription_id <= ?", filter.MaxSubscriptionID)
+	}
+	return db
+}
+
+// toBizUserSubscription 转换为业务对象
+func toBizUserSubscription(m *model.UserSubscription) *biz.UserSubscription {
+	return &biz.UserSubscription{
+		SubscriptionID: m.SubscriptionID,
+		UID:            m.UID,
+		PlanID:         m.PlanID,
+		AppID:          m.AppID,
+		CountryCode:    m.CountryCode,
+		StartTime:      m.StartTime,
+		EndTime:        timeValue(m.EndTime),
+		BillingAnchor:  timeValue(m.BillingAnchor),
+		Status:         m.Status,
+		OrderID:        m.OrderID,
+		IsAutoRenew:    m.IsAutoRenew,
+		Source:         m.Source,
+		NextPlanID:     m.NextPlanID,
+		Version:        m.Version,
+		CreatedAt:      m.CreatedAt,
+		UpdatedAt:      m.UpdatedAt,
+	}
+}
+
+// subscriptionCacheKey 用户订阅缓存键
+func subscriptionCacheKey(uid string) string {
+	return fmt.Sprintf("subscription:user:%s", uid)
+}
+
+// timePtr 将零值时间转换为 nil（对应数据库 NULL）
+func timePtr(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+	return &t
+}
+
+// timeValue 将可空时间转换为 time.Time（NULL 对应零值）
+func timeValue(t *time.Time) time.Time {
+	if t == nil {
+		return time.Time{}
+	}
+	return *t
+}
@@ -17,6 +17,11 @@ func init() {
 //   02: 订阅生命周期
 //   03: 订单模块
 //   04: 支付模块
+//   05: 地区模块
+//   06: 税务模块
+//   07: 汇率与报表模块
+//   08: 定时任务模块
+//   09: 客服支持模块
 
 // 套餐模块 (130100-130199)
 const (
@@ -26,6 +31,12 @@ const (
 	ErrCodePlanPriceInvalid = 130102
 	// ErrCodePlanPricingNotFound 套餐区域定价不存在错误
 	ErrCodePlanPricingNotFound = 130103
+	// ErrCodePlanIntervalInvalid 套餐计费周期无效错误
+	ErrCodePlanIntervalInvalid = 130104
+	// ErrCodeFreePlanInvalid 套餐不能作为默认免费套餐错误
+	ErrCodeFreePlanInvalid = 130105
+	// ErrCodePricingWindowInvalid 定价生效时间窗口无效错误
+	ErrCodePricingWindowInvalid = 130106
 )
 
 // 订阅生命周期模块 (130200-130299)
@@ -48,6 +59,14 @@ const (
 	ErrCodeCannotResumeStatus = 130208
 	// ErrCodeCannotSetAutoRenew 当前状态无法设置自动续费错误
 	ErrCodeCannotSetAutoRenew = 130209
+	// ErrCodeLifetimeSubscription 已是终身订阅错误（无需续费，也不能购买周期套餐覆盖）
+	ErrCodeLifetimeSubscription = 130210
+	// ErrCodeSubscriptionConflict 订阅已被其他操作修改错误（重试后仍冲突）
+	ErrCodeSubscriptionConflict = 130211
+	// ErrCodeSubscriptionBusy 订阅正在处理中错误（未获得用户订阅锁）
+	ErrCodeSubscriptionBusy = 130212
+	// ErrCodeHistoryQueryInvalid 订阅历史查询条件无效错误（操作类型、时间范围或导出格式）
+	ErrCodeHistoryQueryInvalid = 130213
 )
 
 // 订单模块 (130300-130399)
@@ -58,6 +77,16 @@ const (
 	ErrCodeOrderAlreadyPaid = 130302
 	// ErrCodeOrderCreateFailed 订单创建失败错误
 	ErrCodeOrderCreateFailed = 130303
+	// ErrCodeOrderNotPaid 订单未支付错误（无法退款）
+	ErrCodeOrderNotPaid = 130304
+	// ErrCodeQuoteInvalid 报价 token 无效错误（签名错误或与下单请求不匹配）
+	ErrCodeQuoteInvalid = 130305
+	// ErrCodeQuoteExpired 报价已过期错误（需要重新报价）
+	ErrCodeQuoteExpired = 130306
+	// ErrCodeInvoiceNotFound 发票不存在错误
+	ErrCodeInvoiceNotFound = 130307
+	// ErrCodeInvoiceFormatInvalid 发票格式无效错误
+	ErrCodeInvoiceFormatInvalid = 130308
 )
 
 // 支付模块 (130400-130499)
@@ -67,3 +96,63 @@ const (
 	// ErrCodePaymentInvalidAmount 支付金额无效错误
 	ErrCodePaymentInvalidAmount = 130402
 )
+
+// 地区模块 (130500-130599)
+const (
+	// ErrCodeRegionGroupNotFound 地区组不存在错误
+	ErrCodeRegionGroupNotFound = 130501
+	// ErrCodeRegionCodeInvalid 地区代码无效错误（既不是国家代码也不是已配置的地区组）
+	ErrCodeRegionCodeInvalid = 130502
+	// ErrCodeRegionCountryConflict 国家已属于其他地区组错误
+	ErrCodeRegionCountryConflict = 130503
+	// ErrCodeRegionDetectionInvalid 地区推断来源无效错误
+	ErrCodeRegionDetectionInvalid = 130504
+)
+
+// 税务模块 (130600-130699)
+const (
+	// ErrCodeTaxRuleNotFound 税务规则不存在错误
+	ErrCodeTaxRuleNotFound = 130601
+	// ErrCodeTaxRuleInvalid 税务规则无效错误（国家代码、名称或税率无效）
+	ErrCodeTaxRuleInvalid = 130602
+	// ErrCodeVatIDInvalid VAT ID 格式无效错误
+	ErrCodeVatIDInvalid = 130603
+)
+
+// 汇率与报表模块 (130700-130799)
+const (
+	// ErrCodeExchangeRateInvalid 汇率无效错误（币种代码无效、币种相同或汇率不大于 0）
+	ErrCodeExchangeRateInvalid = 130701
+	// ErrCodeExchangeRateFileInvalid 汇率文件无效错误（未配置、无法读取或格式错误）
+	ErrCodeExchangeRateFileInvalid = 130702
+	// ErrCodeCurrencyInvalid 币种代码无效错误
+	ErrCodeCurrencyInvalid = 130703
+	// ErrCodeReportRangeInvalid 报表时间范围无效错误
+	ErrCodeReportRangeInvalid = 130704
+)
+
+// 定时任务模块 (130800-130899)
+const (
+	// ErrCodeJobNotFound 定时任务不存在错误（未注册或未启用）
+	ErrCodeJobNotFound = 130801
+	// ErrCodeJobRunning 定时任务正在执行错误
+	ErrCodeJobRunning = 130802
+)
+
+// 客服支持模块 (130900-130999)
+const (
+	// ErrCodeAdjustmentInvalid 订阅调整无效错误（调整天数为 0 或超出上限，订阅或赠送的套餐没有结束时间，或缩短后结束时间不晚于开始时间）
+	ErrCodeAdjustmentInvalid = 130901
+	// ErrCodeSearchRangeInvalid 查询时间范围无效错误
+	ErrCodeSearchRangeInvalid = 130902
+	// ErrCodeGrantPlanConflict 赠送套餐冲突错误（用户有其他套餐的未过期订阅）
+	ErrCodeGrantPlanConflict = 130903
+	// ErrCodeGrantSourceInvalid 开通来源无效错误（只能为 comp 或 manual）
+	ErrCodeGrantSourceInvalid = 130904
+	// ErrCodeBulkOperationInvalid 批量操作参数无效错误（类型、筛选条件、天数或目标套餐无效）
+	ErrCodeBulkOperationInvalid = 130905
+	// ErrCodeBulkOperationNotFound 批量操作不存在错误
+	ErrCodeBulkOperationNotFound = 130906
+	// ErrCodeBulkOperationFinished 批量操作已结束错误（已完成或已取消的操作不能取消）
+	ErrCodeBulkOperationFinished = 130907
+)
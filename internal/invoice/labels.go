@@ -0,0 +1,106 @@
+package invoice
+
+import "strings"
+
+// 支持的发票语言
+const (
+	LanguageZhCN = "zh-CN"
+	LanguageEnUS = "en-US"
+)
+
+// labels 发票模板中的本地化文本
+type labels struct {
+	Invoice           string
+	CreditNote        string
+	InvoiceNo         string
+	IssuedAt          string
+	OriginalInvoice   string
+	OrderID           string
+	Seller            string
+	Buyer             string
+	TaxID             string
+	VatID             string
+	Country           string
+	Description       string
+	Period            string
+	Amount            string
+	Subtotal          string
+	Discount          string
+	Proration         string
+	Tax               string
+	Total             string
+	Refund            string
+	Lifetime          string
+	Included          string
+	ReverseCharge     string
+	ReverseChargeNote string
+}
+
+var localizedLabels = map[string]*labels{
+	LanguageZhCN: {
+		Invoice:           "发票",
+		CreditNote:        "红字发票",
+		InvoiceNo:         "发票编号",
+		IssuedAt:          "开票日期",
+		OriginalInvoice:   "原发票编号",
+		OrderID:           "订单号",
+		Seller:            "开票方",
+		Buyer:             "购买方",
+		TaxID:             "税号",
+		VatID:             "VAT 税号",
+		Country:           "国家/地区",
+		Description:       "项目",
+		Period:            "服务周期",
+		Amount:            "金额",
+		Subtotal:          "小计",
+		Discount:          "优惠",
+		Proration:         "套餐切换抵扣",
+		Tax:               "税额",
+		Total:             "合计",
+		Refund:            "退款",
+		Lifetime:          "永久",
+		Included:          "含税",
+		ReverseCharge:     "反向征税",
+		ReverseChargeNote: "本发票适用反向征税，增值税由购买方自行申报缴纳。",
+	},
+	LanguageEnUS: {
+		Invoice:           "Invoice",
+		CreditNote:        "Credit Note",
+		InvoiceNo:         "Invoice No.",
+		IssuedAt:          "Date of Issue",
+		OriginalInvoice:   "Original Invoice No.",
+		OrderID:           "Order ID",
+		Seller:            "Seller",
+		Buyer:             "Bill To",
+		TaxID:             "Tax ID",
+		VatID:             "VAT ID",
+		Country:           "Country",
+		Description:       "Description",
+		Period:            "Service Period",
+		Amount:            "Amount",
+		Subtotal:          "Subtotal",
+		Discount:          "Discount",
+		Proration:         "Plan Change Credit",
+		Tax:               "Tax",
+		Total:             "Total",
+		Refund:            "Refund",
+		Lifetime:          "Lifetime",
+		Included:          "included",
+		ReverseCharge:     "reverse charge",
+		ReverseChargeNote: "Reverse charge: VAT to be accounted for by the recipient.",
+	},
+}
+
+// normalizeLanguage 将 Accept-Language 风格的语言代码规范化为支持的发票语言，不支持时返回空
+func normalizeLanguage(lang string) string {
+	lang = strings.ToLower(strings.TrimSpace(lang))
+	switch {
+	case lang == "":
+		return ""
+	case strings.HasPrefix(lang, "zh"):
+		return LanguageZhCN
+	case strings.HasPrefix(lang, "en"):
+		return LanguageEnUS
+	}
+	return ""
+}
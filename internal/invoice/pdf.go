@@ -0,0 +1,115 @@
+package invoice
+
+import (
+	"bytes"
+
+	"xinyuan_tech/subscription-service/internal/biz"
+
+	"github.com/go-pdf/fpdf"
+)
+
+// pdfFontFamily 配置了 UTF-8 字体时注册的字体名
+const pdfFontFamily = "invoice"
+
+// renderPDF 渲染 PDF 发票
+// PDF 内置字体只支持 Latin-1 字符，配置 UTF-8 字体后才能渲染中文等其他语言
+func (r *Renderer) renderPDF(inv *biz.Invoice, lang string) ([]byte, error) {
+	pdf := fpdf.New("P", "mm", "A4", "")
+	font := "Helvetica"
+	tr := pdf.UnicodeTranslatorFromDescriptor("")
+	if r.pdfFontPath != "" {
+		pdf.AddUTF8Font(pdfFontFamily, "", r.pdfFontPath)
+		font = pdfFontFamily
+		tr = func(s string) string { return s }
+	} else {
+		lang = LanguageEnUS
+	}
+	v := r.newView(inv, lang)
+
+	pdf.SetMargins(20, 20, 20)
+	pdf.AddPage()
+
+	pdf.SetFont(font, "", 20)
+	pdf.CellFormat(0, 12, tr(v.Title), "", 1, "L", false, 0, "")
+	pdf.Ln(2)
+
+	pdf.SetFont(font, "", 10)
+	meta := [][2]string{
+		{v.L.InvoiceNo, v.InvoiceNo},
+		{v.L.IssuedAt, v.IssuedAt},
+	}
+	if v.OriginalInvoiceNo != "" {
+		meta = append(meta, [2]string{v.L.OriginalInvoice, v.OriginalInvoiceNo})
+	}
+	meta = append(meta, [2]string{v.L.OrderID, v.OrderID})
+	for _, m := range meta {
+		pdf.CellFormat(40, 6, tr(m[0]), "", 0, "L", false, 0, "")
+		pdf.CellFormat(0, 6, tr(m[1]), "", 1, "L", false, 0, "")
+	}
+	pdf.Ln(4)
+
+	// 开票方与购买方
+	seller := []string{v.SellerName}
+	if v.SellerAddress != "" {
+		seller = append(seller, v.SellerAddress)
+	}
+	if v.SellerTaxID != "" {
+		seller = append(seller, v.L.TaxID+": "+v.SellerTaxID)
+	}
+	buyer := []string{v.BuyerID}
+	if v.Country != "" {
+		buyer = append(buyer, v.L.Country+": "+v.Country)
+	}
+	if v.VatID != "" {
+		buyer = append(buyer, v.L.VatID+": "+v.VatID)
+	}
+	pdf.CellFormat(85, 6, tr(v.L.Seller), "", 0, "L", false, 0, "")
+	pdf.CellFormat(0, 6, tr(v.L.Buyer), "", 1, "L", false, 0, "")
+	for i := 0; i < len(seller) || i < len(buyer); i++ {
+		left, right := "", ""
+		if i < len(seller) {
+			left = seller[i]
+		}
+		if i < len(buyer) {
+			right = buyer[i]
+		}
+		pdf.CellFormat(85, 6, tr(left), "", 0, "L", false, 0, "")
+		pdf.CellFormat(0, 6, tr(right), "", 1, "L", false, 0, "")
+	}
+	pdf.Ln(6)
+
+	// 明细
+	pdf.CellFormat(85, 8, tr(v.L.Description), "B", 0, "L", false, 0, "")
+	pdf.CellFormat(50, 8, tr(v.L.Period), "B", 0, "L", false, 0, "")
+	pdf.CellFormat(35, 8, tr(v.L.Amount), "B", 1, "R", false, 0, "")
+	for _, line := range v.Lines {
+		pdf.CellFormat(85, 7, tr(line.Description), "B", 0, "L", false, 0, "")
+		pdf.CellFormat(50, 7, tr(line.Period), "B", 0, "L", false, 0, "")
+		pdf.CellFormat(35, 7, tr(line.Amount), "B", 1, "R", false, 0, "")
+	}
+	pdf.Ln(4)
+
+	// 合计
+	for _, t := range v.Totals {
+		border := ""
+		if t.Grand {
+			pdf.SetFont(font, "", 12)
+			border = "T"
+		}
+		pdf.CellFormat(100, 7, "", "", 0, "L", false, 0, "")
+		pdf.CellFormat(35, 7, tr(t.Label), border, 0, "R", false, 0, "")
+		pdf.CellFormat(35, 7, tr(t.Amount), border, 1, "R", false, 0, "")
+	}
+
+	if v.ReverseCharge {
+		pdf.Ln(8)
+		pdf.SetFont(font, "", 9)
+		pdf.MultiCell(0, 5, tr(v.L.ReverseChargeNote), "", "L", false)
+	}
+
+	var buf bytes.Buffer
+	if err := pdf.Output(&buf); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,209 @@
+package invoice
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+	"html/template"
+	"time"
+
+	"xinyuan_tech/subscription-service/internal/biz"
+	"xinyuan_tech/subscription-service/internal/conf"
+	"xinyuan_tech/subscription-service/internal/constants"
+)
+
+//go:embed templates/*.html
+var templateFS embed.FS
+
+var htmlTemplate = template.Must(template.ParseFS(templateFS, "templates/invoice.html"))
+
+// 渲染结果的 Content-Type
+const (
+	ContentTypeHTML = "text/html; charset=utf-8"
+	ContentTypePDF  = "application/pdf"
+)
+
+// Renderer 发票渲染器（HTML 和 PDF）
+type Renderer struct {
+	sellerName    string
+	sellerAddress string
+	sellerTaxID   string
+	pdfFontPath   string
+	defaultLang   string
+}
+
+// NewRenderer 创建发票渲染器
+func NewRenderer(c *conf.Bootstrap) *Renderer {
+	r := &Renderer{defaultLang: LanguageEnUS}
+	if c != nil && c.GetInvoice() != nil {
+		ic := c.GetInvoice()
+		r.sellerName = ic.GetSellerName()
+		r.sellerAddress = ic.GetSellerAddress()
+		r.sellerTaxID = ic.GetSellerTaxId()
+		r.pdfFontPath = ic.GetPdfFontPath()
+		if lang := normalizeLanguage(ic.GetDefaultLanguage()); lang != "" {
+			r.defaultLang = lang
+		}
+	}
+	return r
+}
+
+// Render 按格式（html、pdf）和语言渲染发票，返回内容和 Content-Type
+// lang 不支持时使用默认语言；未配置 UTF-8 字体时 PDF 只能使用英文
+func (r *Renderer) Render(inv *biz.Invoice, format, lang string) ([]byte, string, error) {
+	lang = normalizeLanguage(lang)
+	if lang == "" {
+		lang = r.defaultLang
+	}
+	switch format {
+	case constants.InvoiceFormatHTML:
+		var buf bytes.Buffer
+		if err := htmlTemplate.Execute(&buf, r.newView(inv, lang)); err != nil {
+			return nil, "", err
+		}
+		return buf.Bytes(), ContentTypeHTML, nil
+	case constants.InvoiceFormatPDF:
+		content, err := r.renderPDF(inv, lang)
+		if err != nil {
+			return nil, "", err
+		}
+		return content, ContentTypePDF, nil
+	}
+	return nil, "", fmt.Errorf("unsupported invoice format: %s", format)
+}
+
+// view 模板数据（金额、日期等已按语言格式化）
+type view struct {
+	Lang              string
+	L                 *labels
+	Title             string
+	InvoiceNo         string
+	IssuedAt          string
+	OriginalInvoiceNo string
+	OrderID           string
+	SellerName        string
+	SellerAddress     string
+	SellerTaxID       string
+	BuyerID           string
+	Country           string
+	VatID             string
+	Lines             []viewLine
+	Totals            []viewTotal
+	ReverseCharge     bool
+}
+
+type viewLine struct {
+	Description string
+	Period      string
+	Amount      string
+}
+
+type viewTotal struct {
+	Label  string
+	Amount string
+	Grand  bool
+}
+
+func (r *Renderer) newView(inv *biz.Invoice, lang string) *view {
+	l := localizedLabels[lang]
+	v := &view{
+		Lang:              lang,
+		L:                 l,
+		Title:             l.Invoice,
+		InvoiceNo:         inv.InvoiceNo,
+		IssuedAt:          formatDate(inv.IssuedAt),
+		OriginalInvoiceNo: inv.OriginalInvoiceNo,
+		OrderID:           inv.OrderID,
+		SellerName:        r.sellerName,
+		SellerAddress:     r.sellerAddress,
+		SellerTaxID:       r.sellerTaxID,
+		BuyerID:           inv.UID,
+		Country:           inv.CountryCode,
+		VatID:             inv.VatID,
+	}
+	if inv.InvoiceType == constants.InvoiceTypeCreditNote {
+		v.Title = l.CreditNote
+	}
+
+	for _, line := range inv.Lines {
+		vl := viewLine{Description: line.Description, Amount: formatAmount(line.Amount, inv.Currency)}
+		switch line.LineType {
+		case constants.InvoiceLineTypePlan:
+			vl.Period = formatPeriod(line.PeriodStart, line.PeriodEnd, l)
+		case constants.InvoiceLineTypeRefund:
+			vl.Description = l.Refund + ": " + line.Description
+			vl.Period = formatPeriod(line.PeriodStart, line.PeriodEnd, l)
+		case constants.InvoiceLineTypeDiscount:
+			vl.Description = l.Discount
+		case constants.InvoiceLineTypeProration:
+			vl.Description = l.Proration
+		case constants.InvoiceLineTypeTax:
+			vl.Description = fmt.Sprintf("%s %s%%", line.Description, formatRate(line.TaxRate))
+			if line.ReverseCharge {
+				vl.Description += " (" + l.ReverseCharge + ")"
+				v.ReverseCharge = true
+			} else if line.Inclusive {
+				vl.Description += " (" + l.Included + ")"
+			}
+		}
+		v.Lines = append(v.Lines, vl)
+	}
+
+	v.Totals = append(v.Totals, viewTotal{Label: l.Subtotal, Amount: formatAmount(inv.Subtotal, inv.Currency)})
+	if inv.Discount > 0 {
+		v.Totals = append(v.Totals, viewTotal{Label: l.Discount, Amount: formatAmount(-inv.Discount, inv.Currency)})
+	}
+	if inv.ProrationCredit > 0 {
+		v.Totals = append(v.Totals, viewTotal{Label: l.Proration, Amount: formatAmount(-inv.ProrationCredit, inv.Currency)})
+	}
+	taxLabel := l.Tax
+	if inv.InvoiceType == constants.InvoiceTypeInvoice && taxIncluded(inv.Lines) {
+		taxLabel += " (" + l.Included + ")"
+	}
+	v.Totals = append(v.Totals,
+		viewTotal{Label: taxLabel, Amount: formatAmount(inv.TaxAmount, inv.Currency)},
+		viewTotal{Label: l.Total, Amount: formatAmount(inv.Total, inv.Currency), Grand: true},
+	)
+	return v
+}
+
+// taxIncluded 税费是否全部为含税定价（税额已包含在小计中，不再加收）
+func taxIncluded(lines []*biz.InvoiceLine) bool {
+	included := false
+	for _, line := range lines {
+		if line.LineType != constants.InvoiceLineTypeTax {
+			continue
+		}
+		if !line.Inclusive || line.ReverseCharge {
+			return false
+		}
+		included = true
+	}
+	return included
+}
+
+func formatDate(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.UTC().Format("2006-01-02")
+}
+
+func formatPeriod(start, end time.Time, l *labels) string {
+	if start.IsZero() {
+		return ""
+	}
+	if end.IsZero() {
+		return formatDate(start) + " - " + l.Lifetime
+	}
+	return formatDate(start) + " - " + formatDate(end)
+}
+
+func formatAmount(amount float64, currency string) string {
+	return fmt.Sprintf("%.2f %s", amount, currency)
+}
+
+// formatRate 格式化税率百分比（去掉多余的 0，如 0.19 -> 19，0.075 -> 7.5）
+func formatRate(rate float64) string {
+	return fmt.Sprintf("%g", float64(int64(rate*10000+0.5))/100)
+}
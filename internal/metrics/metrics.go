@@ -0,0 +1,135 @@
+package metrics
+
+import (
+	"net/http"
+	"time"
+
+	"xinyuan_tech/subscription-service/internal/constants"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const namespace = "subscription"
+
+// 业务指标（按应用和套餐统计）
+var (
+	// OrdersCreated 创建的订单数
+	OrdersCreated = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "orders_created_total",
+		Help:      "Number of subscription orders created.",
+	}, []string{"app_id", "plan_id"})
+
+	// Payments 支付结果：success 为支付成功（订单已开通），failed 为调用支付服务或自动续费扣款失败，refund_required 为已支付但未开通需要退款
+	Payments = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "payments_total",
+		Help:      "Number of payments by result.",
+	}, []string{"app_id", "plan_id", "result"})
+
+	// Renewals 已支付的续费（在已有订阅上续期或切换套餐）
+	Renewals = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "renewals_total",
+		Help:      "Number of paid subscription renewals.",
+	}, []string{"app_id", "plan_id"})
+
+	// AutoRenewals 自动续费任务的处理结果：success, failed, skipped（锁被占用、已续费等）
+	AutoRenewals = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "auto_renewals_total",
+		Help:      "Number of auto-renewal attempts by result.",
+	}, []string{"app_id", "plan_id", "result"})
+
+	// Cancellations 用户取消的订阅数
+	Cancellations = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "cancellations_total",
+		Help:      "Number of subscriptions cancelled.",
+	}, []string{"app_id", "plan_id"})
+
+	// Expirations 过期的订阅数
+	Expirations = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "expirations_total",
+		Help:      "Number of subscriptions expired.",
+	}, []string{"app_id", "plan_id"})
+)
+
+// 技术指标
+var (
+	// CacheRequests 缓存读取结果：hit, miss
+	CacheRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "cache_requests_total",
+		Help:      "Number of cache lookups by cache and result.",
+	}, []string{"cache", "result"})
+
+	// PaymentClientDuration 调用支付服务的耗时
+	PaymentClientDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "payment_client_duration_seconds",
+		Help:      "Latency of payment service calls.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"method", "result"})
+
+	// LockContention 获取分布式锁失败（已被其他实例或任务持有）的次数
+	LockContention = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "lock_contention_total",
+		Help:      "Number of distributed lock acquisitions that failed because the lock was held.",
+	}, []string{"lock"})
+
+	// CronJobDuration 定时任务执行耗时
+	CronJobDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "cron_job_duration_seconds",
+		Help:      "Duration of cron job runs.",
+		Buckets:   []float64{0.1, 0.5, 1, 5, 15, 30, 60, 120, 300, 600},
+	}, []string{"job", "result"})
+
+	// CronJobLastSuccess 定时任务最近一次成功完成的时间（Unix 秒）
+	CronJobLastSuccess = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "cron_job_last_success_timestamp_seconds",
+		Help:      "Unix time of the last successful cron job run.",
+	}, []string{"job"})
+)
+
+func init() {
+	prometheus.MustRegister(
+		OrdersCreated,
+		Payments,
+		Renewals,
+		AutoRenewals,
+		Cancellations,
+		Expirations,
+		CacheRequests,
+		PaymentClientDuration,
+		LockContention,
+		CronJobDuration,
+		CronJobLastSuccess,
+	)
+}
+
+// Handler 返回 /metrics 的 HTTP 处理器（包含 Go 运行时和进程指标）
+func Handler() http.Handler {
+	return promhttp.Handler()
+}
+
+// Result 根据错误返回结果标签值
+func Result(err error) string {
+	if err != nil {
+		return constants.MetricResultFailed
+	}
+	return constants.MetricResultSuccess
+}
+
+// ObserveCronJob 记录定时任务的耗时和结果
+func ObserveCronJob(job string, start time.Time, err error) {
+	CronJobDuration.WithLabelValues(job, Result(err)).Observe(time.Since(start).Seconds())
+	if err == nil {
+		CronJobLastSuccess.WithLabelValues(job).SetToCurrentTime()
+	}
+}
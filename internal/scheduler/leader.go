@@ -0,0 +1,75 @@
+package scheduler
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	"xinyuan_tech/subscription-service/internal/constants"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-redsync/redsync/v4"
+)
+
+// LeaderElector 基于 Redis 锁的主节点选举：持有锁的实例为主节点，定期续期；续期失败时放弃主节点身份
+type LeaderElector struct {
+	mutex  *redsync.Mutex
+	leader atomic.Bool
+	log    *log.Helper
+}
+
+// NewLeaderElector 创建主节点选举
+func NewLeaderElector(rs *redsync.Redsync, logger log.Logger) *LeaderElector {
+	return &LeaderElector{
+		mutex: rs.NewMutex(
+			constants.CronLeaderLockKey,
+			redsync.WithExpiry(constants.CronLeaderLockExpiration),
+			redsync.WithTries(1),
+		),
+		log: log.NewHelper(logger),
+	}
+}
+
+// IsLeader 当前实例是否为主节点
+func (e *LeaderElector) IsLeader() bool {
+	return e.leader.Load()
+}
+
+// Run 参与选举直到 ctx 结束，成为主节点时调用 onElected（不能阻塞，否则影响续期）；退出时释放锁
+func (e *LeaderElector) Run(ctx context.Context, onElected func()) {
+	ticker := time.NewTicker(constants.CronLeaderLockExpiration / 3)
+	defer ticker.Stop()
+	for {
+		e.tick(ctx, onElected)
+		select {
+		case <-ctx.Done():
+			if e.leader.Swap(false) {
+				releaseCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+				if _, err := e.mutex.UnlockContext(releaseCtx); err != nil {
+					e.log.Warnf("Failed to release cron leader lock: %v", err)
+				}
+				cancel()
+			}
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (e *LeaderElector) tick(ctx context.Context, onElected func()) {
+	if e.leader.Load() {
+		if ok, err := e.mutex.ExtendContext(ctx); !ok || err != nil {
+			e.leader.Store(false)
+			e.log.Warnf("Lost cron leadership: %v", err)
+		}
+		return
+	}
+	if err := e.mutex.TryLockContext(ctx); err != nil {
+		return
+	}
+	e.leader.Store(true)
+	e.log.Infof("Became cron leader")
+	if onElected != nil {
+		onElected()
+	}
+}
@@ -0,0 +1,440 @@
+package scheduler
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"xinyuan_tech/subscription-service/internal/audit"
+	"xinyuan_tech/subscription-service/internal/biz"
+	"xinyuan_tech/subscription-service/internal/conf"
+	"xinyuan_tech/subscription-service/internal/constants"
+	"xinyuan_tech/subscription-service/internal/errors"
+	"xinyuan_tech/subscription-service/internal/metrics"
+	"xinyuan_tech/subscription-service/internal/telemetry"
+
+	pkgErrors "github.com/gaoyong06/go-pkg/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-redsync/redsync/v4"
+	"github.com/google/uuid"
+	"github.com/robfig/cron/v3"
+)
+
+// Job 定时任务
+type Job struct {
+	Name    string
+	Spec    string        // cron 表达式（支持秒级）
+	Timeout time.Duration // 单次执行超时时间
+	// CatchUpAll 停机期间错过多次计划时逐次补跑（任务结果依赖计划时间，如指标快照）；否则只补跑最近一次
+	CatchUpAll bool
+	// Resumable 任务通过 Checkpoint 保存进度，同一计划失败或中途退出后由主节点重试（从进度处继续）
+	Resumable bool
+	// Run 执行任务，scheduledAt 为本次计划执行时间（补跑时为错过的计划时间，手动触发时为触发时间）；
+	// dryRun 为 true 时只统计将要处理的数据，不修改数据
+	Run func(ctx context.Context, scheduledAt time.Time, dryRun bool) (*biz.JobResult, error)
+
+	schedule cron.Schedule
+	running  atomic.Bool
+}
+
+// JobStatus 定时任务状态
+type JobStatus struct {
+	Job       *Job
+	Setting   *biz.JobSetting
+	LastRun   *biz.JobRun // 最近一次执行（含手动触发），没有时为 nil
+	NextRunAt time.Time
+}
+
+// Scheduler 定时任务调度器
+// 多副本部署时通过 Redis 锁选举主节点，只有主节点按计划执行任务；每次执行记录到 job_run（同一任务同一计划时间只执行一次），
+// 成为主节点时以及之后定期补跑停机期间错过的计划，并重试失败的可续跑任务；同一任务在所有实例中同时只有一个执行
+type Scheduler struct {
+	cron          *cron.Cron
+	parser        cron.Parser
+	jobs          []*Job
+	uc            *biz.JobRunUsecase
+	rs            *redsync.Redsync
+	elector       *LeaderElector
+	catchUpWindow time.Duration
+	log           *log.Helper
+
+	ctx           context.Context // Stop 时取消，用于中止补跑
+	stop          context.CancelFunc
+	electorCancel context.CancelFunc
+	elected       chan struct{} // 成为主节点的通知，立即触发补跑
+	catchUpDone   sync.WaitGroup
+	triggered     sync.WaitGroup // 手动触发的执行
+}
+
+// NewScheduler 创建定时任务调度器
+func NewScheduler(c *conf.Bootstrap, uc *biz.JobRunUsecase, rs *redsync.Redsync, logger log.Logger) *Scheduler {
+	catchUpWindow := constants.DefaultCronCatchUpWindow
+	if c.GetCron().GetCatchUpWindow() != nil {
+		catchUpWindow = c.GetCron().GetCatchUpWindow().AsDuration()
+	}
+	ctx, stop := context.WithCancel(context.Background())
+	return &Scheduler{
+		cron:          cron.New(cron.WithSeconds()),
+		parser:        cron.NewParser(cron.Second | cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow | cron.Descriptor),
+		uc:            uc,
+		rs:            rs,
+		elector:       NewLeaderElector(rs, logger),
+		catchUpWindow: catchUpWindow,
+		log:           log.NewHelper(logger),
+		ctx:           ctx,
+		stop:          stop,
+		elected:       make(chan struct{}, 1),
+	}
+}
+
+// Register 注册定时任务
+func (s *Scheduler) Register(job *Job) error {
+	schedule, err := s.parser.Parse(job.Spec)
+	if err != nil {
+		return err
+	}
+	job.schedule = schedule
+	s.cron.Schedule(schedule, cron.FuncJob(func() {
+		s.execute(job, constants.JobTriggerSchedule, lastDue(schedule, time.Now()))
+	}))
+	s.jobs = append(s.jobs, job)
+	return nil
+}
+
+// Start 启动调度和主节点选举
+func (s *Scheduler) Start() {
+	electorCtx, cancel := context.WithCancel(context.Background())
+	s.electorCancel = cancel
+	s.cron.Start()
+
+	s.catchUpDone.Add(1)
+	go func() {
+		defer s.catchUpDone.Done()
+		ticker := time.NewTicker(constants.CronCatchUpInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-s.ctx.Done():
+				return
+			case <-s.elected:
+				s.catchUp()
+			case <-ticker.C:
+				if s.elector.IsLeader() {
+					s.catchUp()
+				}
+			}
+		}
+	}()
+	go s.elector.Run(electorCtx, func() {
+		select {
+		case s.elected <- struct{}{}:
+		default:
+		}
+	})
+}
+
+// Stop 停止调度，返回的 Context 在执行中的任务结束后关闭；之后释放主节点锁
+func (s *Scheduler) Stop() context.Context {
+	s.stop()
+	ctx, done := context.WithCancel(context.Background())
+	cronCtx := s.cron.Stop()
+	go func() {
+		<-cronCtx.Done()
+		s.catchUpDone.Wait()
+		s.triggered.Wait()
+		if s.electorCancel != nil {
+			s.electorCancel()
+		}
+		done()
+	}()
+	return ctx
+}
+
+// catchUp 补跑各任务停机或没有主节点期间错过的计划（从未执行过的任务不补跑），
+// 没有错过的计划时重试最近一次失败的可续跑任务
+func (s *Scheduler) catchUp() {
+	if s.catchUpWindow <= 0 {
+		return
+	}
+	now := time.Now()
+	for _, job := range s.jobs {
+		if s.ctx.Err() != nil || !s.elector.IsLeader() {
+			return
+		}
+		last, missed, err := s.missedRuns(job, now)
+		if err != nil {
+			s.log.Errorf("Failed to check missed runs of job %s: %v", job.Name, err)
+			continue
+		}
+		if len(missed) > 0 {
+			s.log.Infof("Catching up %d missed runs of job %s", len(missed), job.Name)
+		} else if s.retryable(job, last, now) {
+			s.log.Infof("Retrying job %s scheduled at %s (attempt %d)", job.Name, last.ScheduledAt.Format(time.RFC3339), last.Attempts+1)
+			s.run(job, constants.JobTriggerRetry, last.ScheduledAt, last)
+		}
+		for _, scheduledAt := range missed {
+			if s.ctx.Err() != nil {
+				return
+			}
+			s.execute(job, constants.JobTriggerCatchUp, scheduledAt)
+		}
+	}
+}
+
+// retryable 最近一次执行是否需要重试：可续跑任务在补跑窗口内失败且未超过最多执行次数
+func (s *Scheduler) retryable(job *Job, last *biz.JobRun, now time.Time) bool {
+	return job.Resumable && last != nil &&
+		last.Status == constants.JobRunStatusFailed &&
+		last.Attempts < constants.CronJobMaxAttempts &&
+		last.ScheduledAt.After(now.Add(-s.catchUpWindow))
+}
+
+// missedRuns 获取任务最近一次执行记录和补跑窗口内错过的计划时间（按时间升序）
+func (s *Scheduler) missedRuns(job *Job, now time.Time) (*biz.JobRun, []time.Time, error) {
+	ctx, cancel := context.WithTimeout(s.ctx, 10*time.Second)
+	defer cancel()
+	// 中途退出的实例没有记录结果，标记为失败
+	if err := s.uc.FailStaleJobRuns(ctx, job.Name, job.Timeout); err != nil {
+		return nil, nil, err
+	}
+	last, err := s.uc.GetLastJobRun(ctx, job.Name)
+	if err != nil || last == nil {
+		return nil, nil, err
+	}
+
+	from := last.ScheduledAt
+	if earliest := now.Add(-s.catchUpWindow); from.Before(earliest) {
+		from = earliest
+	}
+	limit := constants.CronCatchUpMaxRuns
+	if !job.CatchUpAll {
+		limit = 1
+	}
+	// 刚到计划时间的执行留给定时触发
+	return last, dueTimes(job.schedule, from, now.Add(-constants.CronCatchUpInterval), limit), nil
+}
+
+// execute 执行任务的一次计划
+func (s *Scheduler) execute(job *Job, trigger string, scheduledAt time.Time) {
+	s.run(job, trigger, scheduledAt, nil)
+}
+
+// run 执行任务，retry 不为 nil 时重试该执行记录：非主节点或任务正在执行时跳过，任务已暂停时记录跳过
+func (s *Scheduler) run(job *Job, trigger string, scheduledAt time.Time, retry *biz.JobRun) {
+	if !s.elector.IsLeader() {
+		s.log.Debugf("Not cron leader, skip job %s", job.Name)
+		return
+	}
+	if s.paused(job, trigger, scheduledAt, retry) {
+		return
+	}
+	release, ok := s.acquire(job)
+	if !ok {
+		s.log.Warnf("Job %s is still running, skip run scheduled at %s", job.Name, scheduledAt.Format(time.RFC3339))
+		return
+	}
+	defer release()
+
+	ctx, cancel := context.WithTimeout(context.Background(), job.Timeout)
+	defer cancel()
+	ctx, span := telemetry.Tracer().Start(jobContext(ctx, job), "cron "+job.Name)
+	defer span.End()
+
+	s.log.Infof("Starting job %s (trigger=%s, scheduled at %s)", job.Name, trigger, scheduledAt.UTC().Format(time.RFC3339))
+	start := time.Now()
+	fn := func(ctx context.Context) (*biz.JobResult, error) {
+		return job.Run(ctx, scheduledAt, false)
+	}
+	var ran bool
+	var err error
+	if retry != nil {
+		ran, err = s.uc.RetryJob(ctx, retry, fn)
+	} else {
+		ran, err = s.uc.RunJob(ctx, job.Name, trigger, scheduledAt, fn)
+	}
+	if !ran && err == nil {
+		return
+	}
+	metrics.ObserveCronJob(job.Name, start, err)
+	if err != nil {
+		s.log.Errorf("Job %s failed after %s: %v", job.Name, time.Since(start), err)
+		return
+	}
+	s.log.Infof("Finished job %s in %s", job.Name, time.Since(start))
+}
+
+// paused 任务已暂停时跳过本次计划：按计划执行和补跑记录为 skipped（之后不再补跑），重试直接跳过
+func (s *Scheduler) paused(job *Job, trigger string, scheduledAt time.Time, retry *biz.JobRun) bool {
+	ctx, cancel := context.WithTimeout(s.ctx, 10*time.Second)
+	defer cancel()
+	setting, err := s.uc.GetJobSetting(ctx, job.Name)
+	if err != nil {
+		// 无法读取设置时按未暂停处理，避免数据库抖动导致任务停止
+		s.log.Warnf("Failed to get setting of job %s: %v", job.Name, err)
+		return false
+	}
+	if !setting.Paused {
+		return false
+	}
+	s.log.Infof("Job %s is paused, skip run scheduled at %s", job.Name, scheduledAt.UTC().Format(time.RFC3339))
+	if retry == nil {
+		if err := s.uc.SkipJob(ctx, job.Name, trigger, scheduledAt, "paused: "+setting.PauseReason); err != nil {
+			s.log.Errorf("Failed to record skipped run of job %s: %v", job.Name, err)
+		}
+	}
+	return true
+}
+
+// acquire 获取任务执行权：本实例没有在执行该任务，且获得任务的分布式执行锁（防止与其他实例的手动触发重叠）
+func (s *Scheduler) acquire(job *Job) (func(), bool) {
+	if !job.running.CompareAndSwap(false, true) {
+		return nil, false
+	}
+	mutex := s.rs.NewMutex(
+		constants.CronJobLockKeyPrefix+job.Name,
+		redsync.WithExpiry(job.Timeout+constants.CronJobStaleGrace),
+		redsync.WithTries(1),
+	)
+	if err := mutex.TryLockContext(s.ctx); err != nil {
+		job.running.Store(false)
+		metrics.LockContention.WithLabelValues(constants.MetricLockCronJob).Inc()
+		return nil, false
+	}
+	return func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if _, err := mutex.UnlockContext(ctx); err != nil {
+			s.log.Warnf("Failed to release lock of job %s: %v", job.Name, err)
+		}
+		job.running.Store(false)
+	}, true
+}
+
+// Trigger 立即执行任务（任意实例均可触发，不要求为主节点；任务暂停时也可以执行）
+// 执行记录创建后在后台执行，返回执行记录，结果通过执行记录查看
+func (s *Scheduler) Trigger(ctx context.Context, name string, dryRun bool) (*biz.JobRun, error) {
+	job := s.job(name)
+	if job == nil {
+		return nil, pkgErrors.NewBizErrorWithLang(ctx, errors.ErrCodeJobNotFound)
+	}
+	release, ok := s.acquire(job)
+	if !ok {
+		return nil, pkgErrors.NewBizErrorWithLang(ctx, errors.ErrCodeJobRunning)
+	}
+	scheduledAt := time.Now()
+	run, err := s.uc.StartJob(ctx, job.Name, constants.JobTriggerManual, scheduledAt, dryRun)
+	if err != nil || run == nil {
+		release()
+		if err == nil {
+			// 同一秒内已触发过
+			err = pkgErrors.NewBizErrorWithLang(ctx, errors.ErrCodeJobRunning)
+		}
+		return nil, err
+	}
+
+	s.log.Infof("Triggered job %s manually (run %d, dryRun=%v)", job.Name, run.JobRunID, dryRun)
+	result := *run
+	s.triggered.Add(1)
+	go func() {
+		defer s.triggered.Done()
+		defer release()
+		runCtx, cancel := context.WithTimeout(context.Background(), job.Timeout)
+		defer cancel()
+		runCtx, span := telemetry.Tracer().Start(jobContext(runCtx, job), "cron "+job.Name)
+		defer span.End()
+
+		start := time.Now()
+		err := s.uc.FinishJob(runCtx, run, func(ctx context.Context) (*biz.JobResult, error) {
+			return job.Run(ctx, scheduledAt, dryRun)
+		})
+		if !dryRun {
+			metrics.ObserveCronJob(job.Name, start, err)
+		}
+		if err != nil {
+			s.log.Errorf("Manually triggered job %s failed after %s: %v", job.Name, time.Since(start), err)
+			return
+		}
+		s.log.Infof("Finished manually triggered job %s in %s", job.Name, time.Since(start))
+	}()
+	return &result, nil
+}
+
+// ListJobs 获取已注册任务的状态
+func (s *Scheduler) ListJobs(ctx context.Context) ([]*JobStatus, error) {
+	now := time.Now()
+	result := make([]*JobStatus, 0, len(s.jobs))
+	for _, job := range s.jobs {
+		setting, err := s.uc.GetJobSetting(ctx, job.Name)
+		if err != nil {
+			return nil, err
+		}
+		runs, _, err := s.uc.ListJobRuns(ctx, job.Name, "", 1, 1)
+		if err != nil {
+			return nil, err
+		}
+		status := &JobStatus{Job: job, Setting: setting, NextRunAt: job.schedule.Next(now)}
+		if len(runs) > 0 {
+			status.LastRun = runs[0]
+		}
+		result = append(result, status)
+	}
+	return result, nil
+}
+
+// PauseJob 暂停任务的计划执行（所有实例生效）
+func (s *Scheduler) PauseJob(ctx context.Context, name, reason string) error {
+	if s.job(name) == nil {
+		return pkgErrors.NewBizErrorWithLang(ctx, errors.ErrCodeJobNotFound)
+	}
+	return s.uc.PauseJob(ctx, name, reason)
+}
+
+// ResumeJob 恢复任务的计划执行
+func (s *Scheduler) ResumeJob(ctx context.Context, name string) error {
+	if s.job(name) == nil {
+		return pkgErrors.NewBizErrorWithLang(ctx, errors.ErrCodeJobNotFound)
+	}
+	return s.uc.ResumeJob(ctx, name)
+}
+
+// IsLeader 当前实例是否为主节点
+func (s *Scheduler) IsLeader() bool {
+	return s.elector.IsLeader()
+}
+
+// job 按名称获取已注册的任务
+func (s *Scheduler) job(name string) *Job {
+	for _, job := range s.jobs {
+		if job.Name == name {
+			return job
+		}
+	}
+	return nil
+}
+
+// lastDue 获取不晚于 now 的最近一次计划时间（用于定时触发时确定本次的计划时间）
+func lastDue(schedule cron.Schedule, now time.Time) time.Time {
+	if due := dueTimes(schedule, now.Add(-time.Minute), now, 1); len(due) > 0 {
+		return due[0]
+	}
+	return now.Truncate(time.Second)
+}
+
+// dueTimes 获取 (from, to] 内最近的 limit 个计划时间（按时间升序）
+func dueTimes(schedule cron.Schedule, from, to time.Time, limit int) []time.Time {
+	var result []time.Time
+	for t := schedule.Next(from); !t.IsZero() && !t.After(to); t = schedule.Next(t) {
+		result = append(result, t)
+		if len(result) > limit {
+			result = result[1:]
+		}
+	}
+	return result
+}
+
+// jobContext 任务执行的 Context：订阅历史的操作人为定时任务，每次执行使用新的请求ID
+func jobContext(ctx context.Context, job *Job) context.Context {
+	ctx = audit.WithActor(ctx, constants.ActorTypeCron, job.Name)
+	return audit.WithRequestID(ctx, uuid.NewString())
+}
@@ -0,0 +1,80 @@
+package server
+
+import (
+	v1 "xinyuan_tech/subscription-service/api/subscription/v1"
+	"xinyuan_tech/subscription-service/internal/auth"
+	"xinyuan_tech/subscription-service/internal/conf"
+	"xinyuan_tech/subscription-service/internal/constants"
+	"xinyuan_tech/subscription-service/internal/service"
+
+	"github.com/gaoyong06/go-pkg/middleware/i18n"
+	"github.com/gaoyong06/go-pkg/middleware/response"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/middleware/recovery"
+	"github.com/go-kratos/kratos/v2/middleware/tracing"
+	"github.com/go-kratos/kratos/v2/middleware/validate"
+	"github.com/go-kratos/kratos/v2/transport/grpc"
+	"github.com/go-kratos/kratos/v2/transport/http"
+)
+
+// NewAdminHTTPServer 创建定时任务管理 HTTP 服务（Cron 服务内网管理端口，不经过 API Gateway，不需要 app_id；仅管理员可用）
+func NewAdminHTTPServer(c *conf.Bootstrap, admin *service.CronAdminService, logger log.Logger) *http.Server {
+	responseConfig := &response.Config{
+		EnableUnifiedResponse: true,
+		IncludeDetailedError:  true,
+		IncludeHost:           true,
+		IncludeTraceId:        true,
+	}
+	errorHandler := response.NewDefaultErrorHandler()
+
+	var opts = []http.ServerOption{
+		http.Middleware(
+			recovery.Recovery(),
+			tracing.Server(),
+			// 提取当前用户ID和角色（X-User-ID、X-User-Role 请求头），管理接口要求管理员
+			auth.Middleware(),
+			validate.Validator(),
+			i18n.Middleware(),
+		),
+		http.ResponseEncoder(response.NewResponseEncoder(errorHandler, responseConfig)),
+		http.ErrorEncoder(response.NewErrorEncoder(errorHandler)),
+		http.Address(constants.DefaultCronAdminHTTPAddr),
+	}
+	if cfg := c.GetCron().GetAdmin().GetHttp(); cfg != nil {
+		if addr := cfg.GetAddr(); addr != "" {
+			opts = append(opts, http.Address(addr))
+		}
+		if timeout := cfg.GetTimeout(); timeout != nil {
+			opts = append(opts, http.Timeout(timeout.AsDuration()))
+		}
+	}
+	srv := http.NewServer(opts...)
+	v1.RegisterSubscriptionAdminHTTPServer(srv, admin)
+	return srv
+}
+
+// NewAdminGRPCServer 创建定时任务管理 gRPC 服务
+func NewAdminGRPCServer(c *conf.Bootstrap, admin *service.CronAdminService, logger log.Logger) *grpc.Server {
+	var opts = []grpc.ServerOption{
+		grpc.Middleware(
+			recovery.Recovery(),
+			tracing.Server(),
+			auth.Middleware(),
+			validate.Validator(),
+			i18n.Middleware(),
+		),
+		grpc.Address(constants.DefaultCronAdminGRPCAddr),
+	}
+	if cfg := c.GetCron().GetAdmin().GetGrpc(); cfg != nil {
+		if addr := cfg.GetAddr(); addr != "" {
+			opts = append(opts, grpc.Address(addr))
+		}
+		if timeout := cfg.GetTimeout(); timeout != nil {
+			opts = append(opts, grpc.Timeout(timeout.AsDuration()))
+		}
+	}
+	srv := grpc.NewServer(opts...)
+	v1.RegisterSubscriptionAdminServer(srv, admin)
+	return srv
+}
@@ -2,27 +2,51 @@ package server
 
 import (
 	v1 "xinyuan_tech/subscription-service/api/subscription/v1"
+	"xinyuan_tech/subscription-service/internal/audit"
+	"xinyuan_tech/subscription-service/internal/auth"
 	"xinyuan_tech/subscription-service/internal/conf"
 	"xinyuan_tech/subscription-service/internal/service"
 
+	"github.com/gaoyong06/go-pkg/middleware/app_id"
+	"github.com/gaoyong06/go-pkg/middleware/developer_id"
 	"github.com/gaoyong06/go-pkg/middleware/i18n"
 
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/go-kratos/kratos/v2/middleware/recovery"
+	"github.com/go-kratos/kratos/v2/middleware/tracing"
 	"github.com/go-kratos/kratos/v2/middleware/validate"
 	"github.com/go-kratos/kratos/v2/transport/grpc"
+	"google.golang.org/grpc/health/grpc_health_v1"
 )
 
 // NewGRPCServer new a gRPC server.
-func NewGRPCServer(c *conf.Bootstrap, sub *service.SubscriptionService, logger log.Logger) *grpc.Server {
+func NewGRPCServer(c *conf.Bootstrap, sub *service.SubscriptionService, support *service.SupportService, hs *service.HealthService, logger log.Logger) *grpc.Server {
 	var opts = []grpc.ServerOption{
+		// 使用自定义的健康检查服务（就绪状态检查依赖）
+		grpc.CustomHealth(),
 		grpc.Middleware(
 			recovery.Recovery(),
+			// 链路追踪
+			tracing.Server(),
+			// 提取当前用户ID和角色（由 API Gateway 鉴权后设置 x-user-id、x-user-role Metadata）
+			auth.Middleware(),
+			// 提取或生成请求ID（x-request-id Metadata，记录到订阅历史）
+			audit.Middleware(),
 			// 添加参数验证中间件
 			validate.Validator(),
 			// 添加 i18n 中间件
 			i18n.Middleware(),
 		),
+		// 服务端流（导出订阅历史）的中间件：导出当前应用的数据，需要提取 app_id 和开发者 ID
+		grpc.StreamInterceptor(streamMiddleware(
+			recovery.Recovery(),
+			tracing.Server(),
+			app_id.Middleware(),
+			developer_id.Middleware(),
+			auth.Middleware(),
+			audit.Middleware(),
+			i18n.Middleware(),
+		)),
 	}
 	if c != nil && c.GetServer() != nil && c.GetServer().GetGrpc() != nil {
 		if addr := c.GetServer().GetGrpc().GetAddr(); addr != "" {
@@ -34,5 +58,7 @@ func NewGRPCServer(c *conf.Bootstrap, sub *service.SubscriptionService, logger l
 	}
 	srv := grpc.NewServer(opts...)
 	v1.RegisterSubscriptionServer(srv, sub)
+	v1.RegisterSubscriptionSupportServer(srv, support)
+	grpc_health_v1.RegisterHealthServer(srv, hs)
 	return srv
 }
@@ -1,24 +1,27 @@
 package server
 
 import (
-	"github.com/gaoyong06/go-pkg/health"
 	"github.com/gaoyong06/go-pkg/middleware/app_id"
 	"github.com/gaoyong06/go-pkg/middleware/developer_id"
 	"github.com/gaoyong06/go-pkg/middleware/i18n"
 	"github.com/gaoyong06/go-pkg/middleware/response"
 
 	v1 "xinyuan_tech/subscription-service/api/subscription/v1"
+	"xinyuan_tech/subscription-service/internal/audit"
+	"xinyuan_tech/subscription-service/internal/auth"
 	"xinyuan_tech/subscription-service/internal/conf"
+	"xinyuan_tech/subscription-service/internal/metrics"
 	"xinyuan_tech/subscription-service/internal/service"
 
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/go-kratos/kratos/v2/middleware/recovery"
+	"github.com/go-kratos/kratos/v2/middleware/tracing"
 	"github.com/go-kratos/kratos/v2/middleware/validate"
 	"github.com/go-kratos/kratos/v2/transport/http"
 )
 
 // NewHTTPServer new an HTTP server.
-func NewHTTPServer(c *conf.Bootstrap, sub *service.SubscriptionService, logger log.Logger) *http.Server {
+func NewHTTPServer(c *conf.Bootstrap, sub *service.SubscriptionService, support *service.SupportService, hs *service.HealthService, logger log.Logger) *http.Server {
 	// 响应中间件配置
 	responseConfig := &response.Config{
 		EnableUnifiedResponse: true,
@@ -33,10 +36,16 @@ func NewHTTPServer(c *conf.Bootstrap, sub *service.SubscriptionService, logger l
 	var opts = []http.ServerOption{
 		http.Middleware(
 			recovery.Recovery(),
+			// 链路追踪（提取上游 trace 上下文，响应中的 trace_id 来自该 span）
+			tracing.Server(),
 			// 添加 app_id 中间件（优先于其他中间件，确保 app_id 在 Context 中可用）
 			app_id.Middleware(),
 			// 添加 developer_id 中间件（提取开发者 ID，由 API Gateway 的 api-key 插件设置）
 			developer_id.Middleware(),
+			// 提取当前用户ID和角色（由 API Gateway 鉴权后设置 X-User-ID、X-User-Role）
+			auth.Middleware(),
+			// 提取或生成请求ID（X-Request-ID，记录到订阅历史）
+			audit.Middleware(),
 			// 添加参数验证中间件
 			validate.Validator(),
 			// 添加 i18n 中间件
@@ -59,11 +68,27 @@ func NewHTTPServer(c *conf.Bootstrap, sub *service.SubscriptionService, logger l
 
 	// 注册业务路由
 	v1.RegisterSubscriptionHTTPServer(srv, sub)
+	v1.RegisterSubscriptionSupportHTTPServer(srv, support)
+	// 订阅历史导出为服务端流，生成的 HTTP 路由不包含该接口，以分块传输返回
+	srv.Route("/").GET("/v1/subscription/app-history/export", exportHistoryHandler(sub, logger))
 
-	// 注册健康检查端点
-	srv.Route("/").GET("/health", func(ctx http.Context) error {
-		return ctx.Result(200, health.NewResponse("subscription-service"))
+	// 注册健康检查端点：/health 和 /health/live 为存活探针，/health/ready 为就绪探针（依赖不可用时返回 503）
+	route := srv.Route("/")
+	liveness := func(ctx http.Context) error {
+		return ctx.Result(200, hs.Liveness(ctx))
+	}
+	route.GET("/health", liveness)
+	route.GET("/health/live", liveness)
+	route.GET("/health/ready", func(ctx http.Context) error {
+		ready, reply := hs.Readiness(ctx)
+		if !ready {
+			return ctx.Result(503, reply)
+		}
+		return ctx.Result(200, reply)
 	})
 
+	// 注册 Prometheus 指标端点
+	srv.Handle("/metrics", metrics.Handler())
+
 	return srv
 }
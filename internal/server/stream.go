@@ -0,0 +1,77 @@
+package server
+
+import (
+	"context"
+	nethttp "net/http"
+
+	v1 "xinyuan_tech/subscription-service/api/subscription/v1"
+	"xinyuan_tech/subscription-service/internal/constants"
+	"xinyuan_tech/subscription-service/internal/service"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/transport/http"
+	"google.golang.org/grpc"
+)
+
+// streamMiddleware 对 gRPC 服务端流执行中间件
+// Kratos 的流中间件不会把中间件设置的 Context 传给服务方法，这里用中间件处理后的 Context 包装流
+func streamMiddleware(m ...middleware.Middleware) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		h := middleware.Chain(m...)(func(ctx context.Context, _ any) (any, error) {
+			return nil, handler(srv, &contextStream{ServerStream: ss, ctx: ctx})
+		})
+		_, err := h(ss.Context(), nil)
+		return err
+	}
+}
+
+// contextStream 使用指定 Context 的服务端流
+type contextStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *contextStream) Context() context.Context { return s.ctx }
+
+// exportHistoryHandler 以分块传输返回订阅历史导出文件（生成的 HTTP 路由不支持服务端流）
+// 开始发送后出错时只能中断响应，客户端收到的文件不完整
+func exportHistoryHandler(sub *service.SubscriptionService, logger log.Logger) http.HandlerFunc {
+	helper := log.NewHelper(logger)
+	return func(ctx http.Context) error {
+		var in v1.ExportSubscriptionHistoryRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, v1.Subscription_ExportSubscriptionHistory_FullMethodName)
+
+		w := ctx.Response()
+		rc := nethttp.NewResponseController(w)
+		started := false
+		h := ctx.Middleware(func(c context.Context, req any) (any, error) {
+			// HTTP 服务超时（秒级）不适用于大范围导出，使用单独的超时；客户端断开后写入失败时停止导出
+			c, cancel := context.WithTimeout(context.WithoutCancel(c), constants.HistoryExportTimeout)
+			defer cancel()
+			return nil, sub.StreamSubscriptionHistory(c, req.(*v1.ExportSubscriptionHistoryRequest), func(chunk *v1.ExportSubscriptionHistoryChunk) error {
+				if !started {
+					w.Header().Set("Content-Type", chunk.ContentType)
+					w.Header().Set("Content-Disposition", `attachment; filename="`+chunk.FileName+`"`)
+					w.WriteHeader(nethttp.StatusOK)
+					started = true
+				}
+				if _, err := w.Write(chunk.Content); err != nil {
+					return err
+				}
+				return rc.Flush()
+			})
+		})
+		if _, err := h(ctx, &in); err != nil {
+			if started {
+				helper.Errorf("Failed to export subscription history: %v", err)
+				return nil
+			}
+			return err
+		}
+		return nil
+	}
+}
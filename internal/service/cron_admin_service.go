@@ -0,0 +1,145 @@
+package service
+
+import (
+	"context"
+
+	pb "xinyuan_tech/subscription-service/api/subscription/v1"
+	"xinyuan_tech/subscription-service/internal/auth"
+	"xinyuan_tech/subscription-service/internal/biz"
+	"xinyuan_tech/subscription-service/internal/constants"
+	"xinyuan_tech/subscription-service/internal/scheduler"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// CronAdminService 定时任务管理服务（由 Cron 服务提供，仅管理员可用）：查看任务、立即执行（支持测试运行）、暂停/恢复计划和查看执行记录
+type CronAdminService struct {
+	pb.UnimplementedSubscriptionAdminServer
+
+	scheduler *scheduler.Scheduler
+	uc        *biz.JobRunUsecase
+}
+
+// NewCronAdminService 创建定时任务管理服务
+func NewCronAdminService(s *scheduler.Scheduler, uc *biz.JobRunUsecase) *CronAdminService {
+	return &CronAdminService{scheduler: s, uc: uc}
+}
+
+// ListJobs 获取已注册的定时任务
+func (s *CronAdminService) ListJobs(ctx context.Context, req *pb.ListJobsRequest) (*pb.ListJobsReply, error) {
+	if _, err := auth.RequireAdmin(ctx); err != nil {
+		return nil, err
+	}
+	statuses, err := s.scheduler.ListJobs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	jobs := make([]*pb.CronJob, 0, len(statuses))
+	for _, st := range statuses {
+		job := &pb.CronJob{
+			Name:           st.Job.Name,
+			Spec:           st.Job.Spec,
+			TimeoutSeconds: int64(st.Job.Timeout.Seconds()),
+			CatchUpAll:     st.Job.CatchUpAll,
+			Resumable:      st.Job.Resumable,
+			NextRunAt:      unixTime(st.NextRunAt),
+			LastRun:        toPbJobRun(st.LastRun),
+		}
+		if st.Setting != nil {
+			job.Paused = st.Setting.Paused
+			job.PauseReason = st.Setting.PauseReason
+			job.PausedAt = unixTime(st.Setting.PausedAt)
+		}
+		jobs = append(jobs, job)
+	}
+	return &pb.ListJobsReply{Jobs: jobs, Leader: s.scheduler.IsLeader()}, nil
+}
+
+// TriggerJob 立即执行定时任务
+func (s *CronAdminService) TriggerJob(ctx context.Context, req *pb.TriggerJobRequest) (*pb.TriggerJobReply, error) {
+	if _, err := auth.RequireAdmin(ctx); err != nil {
+		return nil, err
+	}
+	run, err := s.scheduler.Trigger(ctx, req.Name, req.DryRun)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.TriggerJobReply{Run: toPbJobRun(run)}, nil
+}
+
+// PauseJob 暂停定时任务的计划执行
+func (s *CronAdminService) PauseJob(ctx context.Context, req *pb.PauseJobRequest) (*emptypb.Empty, error) {
+	if _, err := auth.RequireAdmin(ctx); err != nil {
+		return nil, err
+	}
+	if err := s.scheduler.PauseJob(ctx, req.Name, req.Reason); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// ResumeJob 恢复定时任务的计划执行
+func (s *CronAdminService) ResumeJob(ctx context.Context, req *pb.ResumeJobRequest) (*emptypb.Empty, error) {
+	if _, err := auth.RequireAdmin(ctx); err != nil {
+		return nil, err
+	}
+	if err := s.scheduler.ResumeJob(ctx, req.Name); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// ListJobRuns 分页获取定时任务执行记录
+func (s *CronAdminService) ListJobRuns(ctx context.Context, req *pb.ListJobRunsRequest) (*pb.ListJobRunsReply, error) {
+	if _, err := auth.RequireAdmin(ctx); err != nil {
+		return nil, err
+	}
+	page := int(req.Page)
+	pageSize := int(req.PageSize)
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 {
+		pageSize = constants.DefaultPageSize
+	}
+	if pageSize > constants.MaxPageSize {
+		pageSize = constants.MaxPageSize
+	}
+
+	runs, total, err := s.uc.ListJobRuns(ctx, req.JobName, req.Status, page, pageSize)
+	if err != nil {
+		return nil, err
+	}
+	pbRuns := make([]*pb.JobRun, 0, len(runs))
+	for _, run := range runs {
+		pbRuns = append(pbRuns, toPbJobRun(run))
+	}
+	return &pb.ListJobRunsReply{
+		Runs:     pbRuns,
+		Total:    int32(total),
+		Page:     int32(page),
+		PageSize: int32(pageSize),
+	}, nil
+}
+
+func toPbJobRun(run *biz.JobRun) *pb.JobRun {
+	if run == nil {
+		return nil
+	}
+	return &pb.JobRun{
+		JobRunId:     run.JobRunID,
+		JobName:      run.JobName,
+		ScheduledAt:  unixTime(run.ScheduledAt),
+		Trigger:      run.Trigger,
+		Status:       run.Status,
+		Host:         run.Host,
+		Attempts:     int32(run.Attempts),
+		DryRun:       run.DryRun,
+		StartedAt:    unixTime(run.StartedAt),
+		FinishedAt:   unixTime(run.FinishedAt),
+		TotalCount:   int32(run.TotalCount),
+		SuccessCount: int32(run.SuccessCount),
+		FailedCount:  int32(run.FailedCount),
+		Error:        run.Error,
+	}
+}
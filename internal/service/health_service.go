@@ -0,0 +1,83 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	pb "xinyuan_tech/subscription-service/api/subscription/v1"
+	"xinyuan_tech/subscription-service/internal/biz"
+	"xinyuan_tech/subscription-service/internal/constants"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+)
+
+// HealthService 健康检查服务：HTTP 存活/就绪探针和 gRPC 健康检查服务（grpc.health.v1.Health）
+type HealthService struct {
+	grpc_health_v1.UnimplementedHealthServer
+
+	uc   *biz.HealthUsecase
+	name string
+}
+
+// NewHealthService 创建健康检查服务
+func NewHealthService(uc *biz.HealthUsecase) *HealthService {
+	return &HealthService{uc: uc, name: "subscription-service"}
+}
+
+// DependencyStatus 依赖状态（就绪探针响应）
+type DependencyStatus struct {
+	Name      string  `json:"name"`
+	Status    string  `json:"status"`
+	Required  bool    `json:"required"`
+	LatencyMs float64 `json:"latencyMs"`
+	Error     string  `json:"error,omitempty"`
+}
+
+// HealthReply 存活/就绪探针响应
+type HealthReply struct {
+	Status       string              `json:"status"`
+	Service      string              `json:"service"`
+	Dependencies []*DependencyStatus `json:"dependencies,omitempty"`
+}
+
+// Liveness 存活探针：进程可以处理请求即为存活，不检查依赖
+func (s *HealthService) Liveness(ctx context.Context) *HealthReply {
+	return &HealthReply{Status: constants.HealthStatusUp, Service: s.name}
+}
+
+// Readiness 就绪探针：检查 MySQL、Redis 和下游服务，返回是否就绪以及各依赖状态
+func (s *HealthService) Readiness(ctx context.Context) (bool, *HealthReply) {
+	ready, deps := s.uc.Readiness(ctx)
+	reply := &HealthReply{Status: constants.HealthStatusUp, Service: s.name}
+	if !ready {
+		reply.Status = constants.HealthStatusDown
+	}
+	for _, dep := range deps {
+		reply.Dependencies = append(reply.Dependencies, &DependencyStatus{
+			Name:      dep.Name,
+			Status:    dep.Status,
+			Required:  dep.Required,
+			LatencyMs: float64(dep.Latency) / float64(time.Millisecond),
+			Error:     dep.Error,
+		})
+	}
+	return ready, reply
+}
+
+// Check gRPC 健康检查
+// 空服务名表示存活状态；readiness 和订阅服务名表示就绪状态（检查依赖）
+func (s *HealthService) Check(ctx context.Context, req *grpc_health_v1.HealthCheckRequest) (*grpc_health_v1.HealthCheckResponse, error) {
+	switch req.GetService() {
+	case "":
+		return &grpc_health_v1.HealthCheckResponse{Status: grpc_health_v1.HealthCheckResponse_SERVING}, nil
+	case constants.HealthServiceReadiness, pb.Subscription_ServiceDesc.ServiceName:
+		if ready, _ := s.uc.Readiness(ctx); !ready {
+			return &grpc_health_v1.HealthCheckResponse{Status: grpc_health_v1.HealthCheckResponse_NOT_SERVING}, nil
+		}
+		return &grpc_health_v1.HealthCheckResponse{Status: grpc_health_v1.HealthCheckResponse_SERVING}, nil
+	default:
+		return nil, status.Errorf(codes.NotFound, "unknown service %s", req.GetService())
+	}
+}
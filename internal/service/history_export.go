@@ -0,0 +1,180 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"strconv"
+	"time"
+
+	pb "xinyuan_tech/subscription-service/api/subscription/v1"
+	"xinyuan_tech/subscription-service/internal/biz"
+	"xinyuan_tech/subscription-service/internal/constants"
+	"xinyuan_tech/subscription-service/internal/errors"
+
+	pkgErrors "github.com/gaoyong06/go-pkg/errors"
+	"github.com/gaoyong06/go-pkg/middleware/app_id"
+)
+
+// ndjsonContentType NDJSON 导出的 Content-Type
+const ndjsonContentType = "application/x-ndjson; charset=utf-8"
+
+// historyCSVHeader 订阅历史 CSV 导出的表头（changes 列为 JSON 数组）
+var historyCSVHeader = []string{
+	"id", "uid", "app_id", "plan_id", "plan_name", "action", "status", "start_time", "end_time",
+	"actor_type", "operator_id", "reason", "request_id", "changes", "created_at",
+}
+
+// historyRecord 订阅历史导出记录（NDJSON 每行一条，字段与 CSV 表头一致；时间为 UTC RFC3339，零值为空字符串）
+type historyRecord struct {
+	ID         uint64               `json:"id"`
+	UID        string               `json:"uid"`
+	AppID      string               `json:"app_id"`
+	PlanID     string               `json:"plan_id"`
+	PlanName   string               `json:"plan_name"`
+	Action     string               `json:"action"`
+	Status     string               `json:"status"`
+	StartTime  string               `json:"start_time"`
+	EndTime    string               `json:"end_time"`
+	ActorType  string               `json:"actor_type"`
+	OperatorID string               `json:"operator_id"`
+	Reason     string               `json:"reason"`
+	RequestID  string               `json:"request_id"`
+	Changes    []*biz.HistoryChange `json:"changes"`
+	CreatedAt  string               `json:"created_at"`
+}
+
+// ExportSubscriptionHistory 流式导出当前应用的订阅历史记录（gRPC 服务端流）
+func (s *SubscriptionService) ExportSubscriptionHistory(req *pb.ExportSubscriptionHistoryRequest, stream pb.Subscription_ExportSubscriptionHistoryServer) error {
+	return s.StreamSubscriptionHistory(stream.Context(), req, stream.Send)
+}
+
+// StreamSubscriptionHistory 按批读取当前应用的订阅历史，每批编码为一块调用 send 发送（gRPC 服务端流和 HTTP 分块传输共用）
+// 第一块包含 Content-Type 和文件名；没有记录时只发送 CSV 表头（NDJSON 为空内容）
+func (s *SubscriptionService) StreamSubscriptionHistory(ctx context.Context, req *pb.ExportSubscriptionHistoryRequest, send func(*pb.ExportSubscriptionHistoryChunk) error) error {
+	// 获取 app_id（只从 Context，由中间件从 Header 提取）
+	appID := app_id.GetAppIDFromContext(ctx)
+	if appID == "" {
+		return pkgErrors.NewBizErrorWithLang(ctx, pkgErrors.ErrCodeInvalidArgument)
+	}
+	if err := requireAppOperator(ctx); err != nil {
+		return err
+	}
+	contentType := csvContentType
+	switch req.Format {
+	case constants.ReportFormatCSV:
+	case constants.ReportFormatNDJSON:
+		contentType = ndjsonContentType
+	default:
+		return pkgErrors.NewBizErrorWithLang(ctx, errors.ErrCodeHistoryQueryInvalid)
+	}
+
+	filter := &biz.HistoryFilter{
+		UID:     req.Uid,
+		PlanID:  req.PlanId,
+		Actions: req.Actions,
+		From:    fromUnixTime(req.StartTime),
+		To:      fromUnixTime(req.EndTime),
+	}
+	first := &pb.ExportSubscriptionHistoryChunk{
+		ContentType: contentType,
+		FileName:    "subscription-history-" + appID + "-" + time.Now().UTC().Format(constants.DateLayout) + "." + req.Format,
+	}
+	if req.Format == constants.ReportFormatCSV {
+		header, err := writeCSV([][]string{historyCSVHeader})
+		if err != nil {
+			return err
+		}
+		first.Content = header
+	}
+
+	err := s.uc.ExportSubscriptionHistory(ctx, filter, func(items []*biz.SubscriptionHistory) error {
+		content, err := encodeHistory(req.Format, items)
+		if err != nil {
+			return err
+		}
+		if first != nil {
+			// 表头和第一批记录一起发送
+			chunk := first
+			chunk.Content = append(chunk.Content, content...)
+			first = nil
+			return send(chunk)
+		}
+		return send(&pb.ExportSubscriptionHistoryChunk{Content: content})
+	})
+	if err != nil {
+		return err
+	}
+	if first != nil {
+		return send(first)
+	}
+	return nil
+}
+
+// encodeHistory 将一批订阅历史编码为 CSV 行（不含表头）或 NDJSON 行
+func encodeHistory(format string, items []*biz.SubscriptionHistory) ([]byte, error) {
+	var buf bytes.Buffer
+	if format == constants.ReportFormatNDJSON {
+		enc := json.NewEncoder(&buf)
+		for _, item := range items {
+			if err := enc.Encode(toHistoryRecord(item)); err != nil {
+				return nil, err
+			}
+		}
+		return buf.Bytes(), nil
+	}
+
+	w := csv.NewWriter(&buf)
+	for _, item := range items {
+		r := toHistoryRecord(item)
+		changes, err := json.Marshal(r.Changes)
+		if err != nil {
+			return nil, err
+		}
+		if err := w.Write([]string{
+			strconv.FormatUint(r.ID, 10), r.UID, r.AppID, r.PlanID, r.PlanName, r.Action, r.Status, r.StartTime, r.EndTime,
+			r.ActorType, r.OperatorID, r.Reason, r.RequestID, string(changes), r.CreatedAt,
+		}); err != nil {
+			return nil, err
+		}
+	}
+	w.Flush()
+	if err := w.Error(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// toHistoryRecord 转换为导出记录
+func toHistoryRecord(item *biz.SubscriptionHistory) *historyRecord {
+	changes := item.Changes
+	if changes == nil {
+		changes = []*biz.HistoryChange{}
+	}
+	return &historyRecord{
+		ID:         item.SubscriptionHistoryID,
+		UID:        item.UID,
+		AppID:      item.AppID,
+		PlanID:     item.PlanID,
+		PlanName:   item.PlanName,
+		Action:     item.Action,
+		Status:     item.Status,
+		StartTime:  formatExportTime(item.StartTime),
+		EndTime:    formatExportTime(item.EndTime),
+		ActorType:  item.ActorType,
+		OperatorID: item.OperatorID,
+		Reason:     item.Reason,
+		RequestID:  item.RequestID,
+		Changes:    changes,
+		CreatedAt:  formatExportTime(item.CreatedAt),
+	}
+}
+
+// formatExportTime 导出记录中的时间（UTC RFC3339），零值为空字符串
+func formatExportTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
@@ -0,0 +1,87 @@
+package service
+
+import (
+	"bytes"
+	"encoding/csv"
+	"strconv"
+	"xinyuan_tech/subscription-service/internal/biz"
+	"xinyuan_tech/subscription-service/internal/constants"
+)
+
+// csvContentType CSV 报表的 Content-Type
+const csvContentType = "text/csv; charset=utf-8"
+
+// cohortCSV 导出同期群留存报表：每行一个同期群，month_N 列为第 N 个月的留存率（百分比）
+func cohortCSV(report *biz.CohortReport) ([]byte, error) {
+	span := 0
+	for _, row := range report.Cohorts {
+		span = max(span, len(row.Retention))
+	}
+	header := []string{"cohort_month", "size", "revenue", "average_revenue", "currency"}
+	for n := 0; n < span; n++ {
+		header = append(header, "month_"+strconv.Itoa(n))
+	}
+
+	records := [][]string{header}
+	for _, row := range report.Cohorts {
+		record := []string{
+			row.CohortMonth.Format(constants.MonthLayout),
+			strconv.Itoa(row.Size),
+			formatFloat(row.Revenue),
+			formatFloat(row.AverageRevenue),
+			report.ReportingCurrency,
+		}
+		for n := 0; n < span; n++ {
+			if n < len(row.Retention) {
+				record = append(record, formatFloat(row.Retention[n]))
+			} else {
+				record = append(record, "")
+			}
+		}
+		records = append(records, record)
+	}
+	return writeCSV(records)
+}
+
+// ltvCSV 导出套餐 LTV 报表（LTV 未知时预期订阅周期数和 LTV 为空）
+func ltvCSV(report *biz.LTVReport) ([]byte, error) {
+	records := [][]string{{
+		"plan_id", "plan_name", "billing_type", "subscribers", "orders", "average_order_revenue", "ended_periods",
+		"renewal_rate", "churn_rate", "expected_periods", "estimated_ltv", "observed_revenue_per_user", "currency",
+	}}
+	for _, p := range report.Plans {
+		expectedPeriods, estimatedLTV := formatFloat(p.ExpectedPeriods), formatFloat(p.EstimatedLTV)
+		if p.LTVUnknown {
+			expectedPeriods, estimatedLTV = "", ""
+		}
+		records = append(records, []string{
+			p.PlanID,
+			p.PlanName,
+			p.BillingType,
+			strconv.Itoa(p.Subscribers),
+			strconv.Itoa(p.Orders),
+			formatFloat(p.AverageOrderRevenue),
+			strconv.Itoa(p.EndedPeriods),
+			formatFloat(p.RenewalRate),
+			formatFloat(p.ChurnRate),
+			expectedPeriods,
+			estimatedLTV,
+			formatFloat(p.ObservedRevenuePerUser),
+			report.ReportingCurrency,
+		})
+	}
+	return writeCSV(records)
+}
+
+func writeCSV(records [][]string) ([]byte, error) {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	if err := w.WriteAll(records); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func formatFloat(f float64) string {
+	return strconv.FormatFloat(f, 'f', -1, 64)
+}
@@ -1,6 +1,10 @@
 package service
 
-import "github.com/google/wire"
+import (
+	"xinyuan_tech/subscription-service/internal/invoice"
+
+	"github.com/google/wire"
+)
 
 // ProviderSet is service providers.
-var ProviderSet = wire.NewSet(NewSubscriptionService)
+var ProviderSet = wire.NewSet(NewSubscriptionService, NewSupportService, NewHealthService, invoice.NewRenderer)
@@ -2,14 +2,19 @@ package service
 
 import (
 	"context"
+	"time"
 	pb "xinyuan_tech/subscription-service/api/subscription/v1"
+	"xinyuan_tech/subscription-service/internal/audit"
 	"xinyuan_tech/subscription-service/internal/auth"
 	"xinyuan_tech/subscription-service/internal/biz"
 	"xinyuan_tech/subscription-service/internal/constants"
+	"xinyuan_tech/subscription-service/internal/errors"
+	"xinyuan_tech/subscription-service/internal/invoice"
 
 	pkgErrors "github.com/gaoyong06/go-pkg/errors"
 	"github.com/gaoyong06/go-pkg/middleware/app_id"
 	"github.com/gaoyong06/go-pkg/middleware/developer_id"
+	"github.com/gaoyong06/go-pkg/middleware/i18n"
 	pkgUtils "github.com/gaoyong06/go-pkg/utils"
 	"github.com/go-kratos/kratos/v2/transport"
 	"github.com/google/uuid"
@@ -19,12 +24,13 @@ import (
 // SubscriptionService 订阅服务
 type SubscriptionService struct {
 	pb.UnimplementedSubscriptionServer
-	uc *biz.SubscriptionUsecase
+	uc       *biz.SubscriptionUsecase
+	renderer *invoice.Renderer
 }
 
 // NewSubscriptionService 创建订阅服务实例
-func NewSubscriptionService(uc *biz.SubscriptionUsecase) *SubscriptionService {
-	return &SubscriptionService{uc: uc}
+func NewSubscriptionService(uc *biz.SubscriptionUsecase, renderer *invoice.Renderer) *SubscriptionService {
+	return &SubscriptionService{uc: uc, renderer: renderer}
 }
 
 // ListPlans 获取所有订阅套餐列表
@@ -44,14 +50,17 @@ func (s *SubscriptionService) ListPlans(ctx context.Context, req *pb.ListPlansRe
 	pbPlans := make([]*pb.Plan, len(plans))
 	for i, p := range plans {
 		pbPlans[i] = &pb.Plan{
-			PlanId:       p.PlanID,
-			AppId:        p.AppID,
-			Name:         p.Name,
-			Description:  p.Description,
-			Price:        p.Price,
-			Currency:     p.Currency,
-			DurationDays: int32(p.DurationDays),
-			Type:         p.Type,
+			PlanId:        p.PlanID,
+			AppId:         p.AppID,
+			Name:          p.Name,
+			Description:   p.Description,
+			Price:         p.Price,
+			Currency:      p.Currency,
+			DurationDays:  int32(p.DurationDays),
+			Type:          p.Type,
+			IntervalUnit:  p.IntervalUnit,
+			IntervalCount: int32(p.IntervalCount),
+			BillingType:   p.BillingType,
 		}
 	}
 
@@ -73,29 +82,35 @@ func (s *SubscriptionService) CreatePlan(ctx context.Context, req *pb.CreatePlan
 	}
 
 	plan := &biz.Plan{
-		PlanID:       uuid.New().String(),
-		AppID:        appID,
-		UID:          developerID, // 开发者 ID（用户 ID）
-		Name:         req.Name,
-		Description:  req.Description,
-		Price:        req.Price,
-		Currency:     req.Currency,
-		DurationDays: int(req.DurationDays),
-		Type:         req.Type,
+		PlanID:        uuid.New().String(),
+		AppID:         appID,
+		UID:           developerID, // 开发者 ID（用户 ID）
+		Name:          req.Name,
+		Description:   req.Description,
+		Price:         req.Price,
+		Currency:      req.Currency,
+		DurationDays:  int(req.DurationDays),
+		IntervalUnit:  req.IntervalUnit,
+		IntervalCount: int(req.IntervalCount),
+		BillingType:   req.BillingType,
+		Type:          req.Type,
 	}
 	if err := s.uc.CreatePlan(ctx, plan); err != nil {
 		return nil, err
 	}
 	return &pb.CreatePlanReply{
 		Plan: &pb.Plan{
-			PlanId:       plan.PlanID,
-			AppId:        plan.AppID,
-			Name:         plan.Name,
-			Description:  plan.Description,
-			Price:        plan.Price,
-			Currency:     plan.Currency,
-			DurationDays: int32(plan.DurationDays),
-			Type:         plan.Type,
+			PlanId:        plan.PlanID,
+			AppId:         plan.AppID,
+			Name:          plan.Name,
+			Description:   plan.Description,
+			Price:         plan.Price,
+			Currency:      plan.Currency,
+			DurationDays:  int32(plan.DurationDays),
+			Type:          plan.Type,
+			IntervalUnit:  plan.IntervalUnit,
+			IntervalCount: int32(plan.IntervalCount),
+			BillingType:   plan.BillingType,
 		},
 	}, nil
 }
@@ -109,28 +124,34 @@ func (s *SubscriptionService) UpdatePlan(ctx context.Context, req *pb.UpdatePlan
 	}
 
 	plan := &biz.Plan{
-		PlanID:       req.PlanId,
-		AppID:        existing.AppID, // 保留原有的 AppID
-		Name:         req.Name,
-		Description:  req.Description,
-		Price:        req.Price,
-		Currency:     req.Currency,
-		DurationDays: int(req.DurationDays),
-		Type:         req.Type,
+		PlanID:        req.PlanId,
+		AppID:         existing.AppID, // 保留原有的 AppID
+		Name:          req.Name,
+		Description:   req.Description,
+		Price:         req.Price,
+		Currency:      req.Currency,
+		DurationDays:  int(req.DurationDays),
+		IntervalUnit:  req.IntervalUnit,
+		IntervalCount: int(req.IntervalCount),
+		BillingType:   req.BillingType,
+		Type:          req.Type,
 	}
 	if err := s.uc.UpdatePlan(ctx, plan); err != nil {
 		return nil, err
 	}
 	return &pb.UpdatePlanReply{
 		Plan: &pb.Plan{
-			PlanId:       plan.PlanID,
-			AppId:        plan.AppID,
-			Name:         plan.Name,
-			Description:  plan.Description,
-			Price:        plan.Price,
-			Currency:     plan.Currency,
-			DurationDays: int32(plan.DurationDays),
-			Type:         plan.Type,
+			PlanId:        plan.PlanID,
+			AppId:         plan.AppID,
+			Name:          plan.Name,
+			Description:   plan.Description,
+			Price:         plan.Price,
+			Currency:      plan.Currency,
+			DurationDays:  int32(plan.DurationDays),
+			Type:          plan.Type,
+			IntervalUnit:  plan.IntervalUnit,
+			IntervalCount: int32(plan.IntervalCount),
+			BillingType:   plan.BillingType,
 		},
 	}, nil
 }
@@ -158,6 +179,8 @@ func (s *SubscriptionService) ListPlanPricings(ctx context.Context, req *pb.List
 			CountryCode:   p.CountryCode,
 			Price:         p.Price,
 			Currency:      p.Currency,
+			EffectiveFrom: unixTime(p.EffectiveFrom),
+			EffectiveTo:   unixTime(p.EffectiveTo),
 		}
 	}
 
@@ -167,10 +190,12 @@ func (s *SubscriptionService) ListPlanPricings(ctx context.Context, req *pb.List
 // CreatePlanPricing 创建区域定价
 func (s *SubscriptionService) CreatePlanPricing(ctx context.Context, req *pb.CreatePlanPricingRequest) (*pb.CreatePlanPricingReply, error) {
 	pricing := &biz.PlanPricing{
-		PlanID:      req.PlanId,
-		CountryCode: req.CountryCode,
-		Price:       req.Price,
-		Currency:    req.Currency,
+		PlanID:        req.PlanId,
+		CountryCode:   req.CountryCode,
+		Price:         req.Price,
+		Currency:      req.Currency,
+		EffectiveFrom: fromUnixTime(req.EffectiveFrom),
+		EffectiveTo:   fromUnixTime(req.EffectiveTo),
 	}
 	if err := s.uc.CreatePlanPricing(ctx, pricing); err != nil {
 		return nil, err
@@ -182,6 +207,8 @@ func (s *SubscriptionService) CreatePlanPricing(ctx context.Context, req *pb.Cre
 			CountryCode:   pricing.CountryCode,
 			Price:         pricing.Price,
 			Currency:      pricing.Currency,
+			EffectiveFrom: unixTime(pricing.EffectiveFrom),
+			EffectiveTo:   unixTime(pricing.EffectiveTo),
 		},
 	}, nil
 }
@@ -203,6 +230,8 @@ func (s *SubscriptionService) UpdatePlanPricing(ctx context.Context, req *pb.Upd
 			CountryCode:   pricing.CountryCode,
 			Price:         pricing.Price,
 			Currency:      pricing.Currency,
+			EffectiveFrom: unixTime(pricing.EffectiveFrom),
+			EffectiveTo:   unixTime(pricing.EffectiveTo),
 		},
 	}, nil
 }
@@ -215,6 +244,666 @@ func (s *SubscriptionService) DeletePlanPricing(ctx context.Context, req *pb.Del
 	return &pb.DeletePlanPricingReply{PlanPricingId: req.PlanPricingId}, nil
 }
 
+// GetAppSetting 获取应用订阅配置
+func (s *SubscriptionService) GetAppSetting(ctx context.Context, req *pb.GetAppSettingRequest) (*pb.GetAppSettingReply, error) {
+	// 获取 app_id（只从 Context，由中间件从 Header 提取）
+	appID := app_id.GetAppIDFromContext(ctx)
+	if appID == "" {
+		return nil, pkgErrors.NewBizErrorWithLang(ctx, pkgErrors.ErrCodeInvalidArgument)
+	}
+
+	setting, err := s.uc.GetAppSetting(ctx, appID)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetAppSettingReply{
+		Setting: &pb.AppSetting{
+			AppId:             setting.AppID,
+			DefaultFreePlanId: setting.DefaultFreePlanID,
+			RegionDetection:   setting.RegionDetection,
+			ReportingCurrency: setting.ReportingCurrency,
+			UpdatedAt:         unixTime(setting.UpdatedAt),
+		},
+	}, nil
+}
+
+// UpdateAppSetting 更新应用订阅配置
+func (s *SubscriptionService) UpdateAppSetting(ctx context.Context, req *pb.UpdateAppSettingRequest) (*pb.UpdateAppSettingReply, error) {
+	// 获取 app_id（只从 Context，由中间件从 Header 提取）
+	appID := app_id.GetAppIDFromContext(ctx)
+	if appID == "" {
+		return nil, pkgErrors.NewBizErrorWithLang(ctx, pkgErrors.ErrCodeInvalidArgument)
+	}
+
+	setting := &biz.AppSetting{
+		AppID:             appID,
+		DefaultFreePlanID: req.DefaultFreePlanId,
+		RegionDetection:   req.RegionDetection,
+		ReportingCurrency: req.ReportingCurrency,
+	}
+	if err := s.uc.UpdateAppSetting(ctx, setting); err != nil {
+		return nil, err
+	}
+	return &pb.UpdateAppSettingReply{
+		Setting: &pb.AppSetting{
+			AppId:             setting.AppID,
+			DefaultFreePlanId: setting.DefaultFreePlanID,
+			RegionDetection:   setting.RegionDetection,
+			ReportingCurrency: setting.ReportingCurrency,
+			UpdatedAt:         unixTime(setting.UpdatedAt),
+		},
+	}, nil
+}
+
+// ListRegionGroups 获取地区组列表
+func (s *SubscriptionService) ListRegionGroups(ctx context.Context, req *pb.ListRegionGroupsRequest) (*pb.ListRegionGroupsReply, error) {
+	groups, err := s.uc.ListRegionGroups(ctx)
+	if err != nil {
+		return nil, err
+	}
+	pbGroups := make([]*pb.RegionGroup, len(groups))
+	for i, g := range groups {
+		pbGroups[i] = toPbRegionGroup(g)
+	}
+	return &pb.ListRegionGroupsReply{Groups: pbGroups}, nil
+}
+
+// GetRegionGroup 获取地区组
+func (s *SubscriptionService) GetRegionGroup(ctx context.Context, req *pb.GetRegionGroupRequest) (*pb.GetRegionGroupReply, error) {
+	group, err := s.uc.GetRegionGroup(ctx, req.GroupCode)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetRegionGroupReply{Group: toPbRegionGroup(group)}, nil
+}
+
+// SaveRegionGroup 创建或更新地区组
+func (s *SubscriptionService) SaveRegionGroup(ctx context.Context, req *pb.SaveRegionGroupRequest) (*pb.SaveRegionGroupReply, error) {
+	// 地区组由所有应用共用，仅管理员可修改
+	if _, err := auth.RequireAdmin(ctx); err != nil {
+		return nil, err
+	}
+	group := &biz.RegionGroup{
+		GroupCode:   req.GroupCode,
+		Name:        req.Name,
+		Description: req.Description,
+		Countries:   req.Countries,
+	}
+	if err := s.uc.SaveRegionGroup(ctx, group); err != nil {
+		return nil, err
+	}
+	return &pb.SaveRegionGroupReply{Group: toPbRegionGroup(group)}, nil
+}
+
+// DeleteRegionGroup 删除地区组
+func (s *SubscriptionService) DeleteRegionGroup(ctx context.Context, req *pb.DeleteRegionGroupRequest) (*pb.DeleteRegionGroupReply, error) {
+	// 地区组由所有应用共用，仅管理员可修改
+	if _, err := auth.RequireAdmin(ctx); err != nil {
+		return nil, err
+	}
+	if err := s.uc.DeleteRegionGroup(ctx, req.GroupCode); err != nil {
+		return nil, err
+	}
+	return &pb.DeleteRegionGroupReply{GroupCode: req.GroupCode}, nil
+}
+
+// toPbRegionGroup 转换地区组为 protobuf 消息
+func toPbRegionGroup(g *biz.RegionGroup) *pb.RegionGroup {
+	return &pb.RegionGroup{
+		GroupCode:   g.GroupCode,
+		Name:        g.Name,
+		Description: g.Description,
+		Countries:   g.Countries,
+		UpdatedAt:   unixTime(g.UpdatedAt),
+	}
+}
+
+// GetInvoice 获取发票或红字发票
+// format 为 html 或 pdf 时按语言渲染发票内容
+func (s *SubscriptionService) GetInvoice(ctx context.Context, req *pb.GetInvoiceRequest) (*pb.GetInvoiceReply, error) {
+	inv, err := s.uc.GetInvoice(ctx, req.InvoiceId)
+	if err != nil {
+		return nil, err
+	}
+	// 权限验证: 只能查询自己的发票或管理员可以查询所有
+	if err := auth.CheckOwnership(ctx, inv.UID); err != nil {
+		return nil, err
+	}
+
+	reply := &pb.GetInvoiceReply{Invoice: toPbInvoice(inv, true)}
+	switch req.Format {
+	case "":
+		return reply, nil
+	case constants.InvoiceFormatHTML, constants.InvoiceFormatPDF:
+	default:
+		return nil, pkgErrors.NewBizErrorWithLang(ctx, errors.ErrCodeInvoiceFormatInvalid)
+	}
+	lang := req.Language
+	if lang == "" {
+		lang = i18n.Language(ctx)
+	}
+	content, contentType, err := s.renderer.Render(inv, req.Format, lang)
+	if err != nil {
+		return nil, err
+	}
+	reply.Content = content
+	reply.ContentType = contentType
+	reply.FileName = inv.InvoiceNo + "." + req.Format
+	return reply, nil
+}
+
+// ListInvoices 获取用户的发票列表
+func (s *SubscriptionService) ListInvoices(ctx context.Context, req *pb.ListInvoicesRequest) (*pb.ListInvoicesReply, error) {
+	// 权限验证
+	if err := auth.CheckOwnership(ctx, req.Uid); err != nil {
+		return nil, err
+	}
+
+	page := int(req.Page)
+	pageSize := int(req.PageSize)
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 {
+		pageSize = constants.DefaultPageSize
+	}
+	if pageSize > constants.MaxPageSize {
+		pageSize = constants.MaxPageSize
+	}
+
+	items, total, err := s.uc.ListInvoices(ctx, req.Uid, page, pageSize)
+	if err != nil {
+		return nil, err
+	}
+	pbItems := make([]*pb.Invoice, len(items))
+	for i, item := range items {
+		pbItems[i] = toPbInvoice(item, false)
+	}
+	return &pb.ListInvoicesReply{
+		Items:    pbItems,
+		Total:    int32(total),
+		Page:     int32(page),
+		PageSize: int32(pageSize),
+	}, nil
+}
+
+// toPbInvoice 转换发票为 protobuf 消息，withLines 为 true 时包含明细行
+func toPbInvoice(inv *biz.Invoice, withLines bool) *pb.Invoice {
+	result := &pb.Invoice{
+		InvoiceId:         inv.InvoiceID,
+		InvoiceNo:         inv.InvoiceNo,
+		InvoiceType:       inv.InvoiceType,
+		Uid:               inv.UID,
+		OrderId:           inv.OrderID,
+		OriginalInvoiceNo: inv.OriginalInvoiceNo,
+		PlanId:            inv.PlanID,
+		PlanName:          inv.PlanName,
+		CountryCode:       inv.CountryCode,
+		VatId:             inv.VatID,
+		Currency:          inv.Currency,
+		Subtotal:          inv.Subtotal,
+		Discount:          inv.Discount,
+		ProrationCredit:   inv.ProrationCredit,
+		TaxAmount:         inv.TaxAmount,
+		Total:             inv.Total,
+		PeriodStart:       unixTime(inv.PeriodStart),
+		PeriodEnd:         unixTime(inv.PeriodEnd),
+		IssuedAt:          unixTime(inv.IssuedAt),
+	}
+	if !withLines {
+		return result
+	}
+	result.Lines = make([]*pb.InvoiceLine, len(inv.Lines))
+	for i, l := range inv.Lines {
+		result.Lines[i] = &pb.InvoiceLine{
+			LineType:      l.LineType,
+			Description:   l.Description,
+			PeriodStart:   unixTime(l.PeriodStart),
+			PeriodEnd:     unixTime(l.PeriodEnd),
+			TaxRate:       l.TaxRate,
+			Inclusive:     l.Inclusive,
+			ReverseCharge: l.ReverseCharge,
+			Amount:        l.Amount,
+		}
+	}
+	return result
+}
+
+// ListTaxRules 获取税务规则列表
+func (s *SubscriptionService) ListTaxRules(ctx context.Context, req *pb.ListTaxRulesRequest) (*pb.ListTaxRulesReply, error) {
+	rules, err := s.uc.ListTaxRules(ctx, req.CountryCode)
+	if err != nil {
+		return nil, err
+	}
+	pbRules := make([]*pb.TaxRule, len(rules))
+	for i, r := range rules {
+		pbRules[i] = toPbTaxRule(r)
+	}
+	return &pb.ListTaxRulesReply{Rules: pbRules}, nil
+}
+
+// CreateTaxRule 创建税务规则
+func (s *SubscriptionService) CreateTaxRule(ctx context.Context, req *pb.CreateTaxRuleRequest) (*pb.CreateTaxRuleReply, error) {
+	// 税务规则对所有应用生效，仅管理员可修改
+	if _, err := auth.RequireAdmin(ctx); err != nil {
+		return nil, err
+	}
+	rule := &biz.TaxRule{
+		CountryCode:   req.CountryCode,
+		Name:          req.Name,
+		Rate:          req.Rate,
+		Inclusive:     req.Inclusive,
+		ReverseCharge: req.ReverseCharge,
+		Enabled:       req.Enabled,
+	}
+	if err := s.uc.CreateTaxRule(ctx, rule); err != nil {
+		return nil, err
+	}
+	return &pb.CreateTaxRuleReply{Rule: toPbTaxRule(rule)}, nil
+}
+
+// UpdateTaxRule 更新税务规则
+func (s *SubscriptionService) UpdateTaxRule(ctx context.Context, req *pb.UpdateTaxRuleRequest) (*pb.UpdateTaxRuleReply, error) {
+	// 税务规则对所有应用生效，仅管理员可修改
+	if _, err := auth.RequireAdmin(ctx); err != nil {
+		return nil, err
+	}
+	rule := &biz.TaxRule{
+		TaxRuleID:     req.TaxRuleId,
+		Name:          req.Name,
+		Rate:          req.Rate,
+		Inclusive:     req.Inclusive,
+		ReverseCharge: req.ReverseCharge,
+		Enabled:       req.Enabled,
+	}
+	if err := s.uc.UpdateTaxRule(ctx, rule); err != nil {
+		return nil, err
+	}
+	return &pb.UpdateTaxRuleReply{Rule: toPbTaxRule(rule)}, nil
+}
+
+// DeleteTaxRule 删除税务规则
+func (s *SubscriptionService) DeleteTaxRule(ctx context.Context, req *pb.DeleteTaxRuleRequest) (*pb.DeleteTaxRuleReply, error) {
+	// 税务规则对所有应用生效，仅管理员可修改
+	if _, err := auth.RequireAdmin(ctx); err != nil {
+		return nil, err
+	}
+	if err := s.uc.DeleteTaxRule(ctx, req.TaxRuleId); err != nil {
+		return nil, err
+	}
+	return &pb.DeleteTaxRuleReply{TaxRuleId: req.TaxRuleId}, nil
+}
+
+// toPbTaxRule 转换税务规则为 protobuf 消息
+func toPbTaxRule(r *biz.TaxRule) *pb.TaxRule {
+	return &pb.TaxRule{
+		TaxRuleId:     r.TaxRuleID,
+		CountryCode:   r.CountryCode,
+		Name:          r.Name,
+		Rate:          r.Rate,
+		Inclusive:     r.Inclusive,
+		ReverseCharge: r.ReverseCharge,
+		Enabled:       r.Enabled,
+		UpdatedAt:     unixTime(r.UpdatedAt),
+	}
+}
+
+// toPbTaxLines 转换税费明细为 protobuf 消息
+func toPbTaxLines(lines []*biz.TaxLine) []*pb.TaxLine {
+	result := make([]*pb.TaxLine, len(lines))
+	for i, l := range lines {
+		result[i] = &pb.TaxLine{
+			Name:          l.Name,
+			CountryCode:   l.CountryCode,
+			Rate:          l.Rate,
+			Inclusive:     l.Inclusive,
+			ReverseCharge: l.ReverseCharge,
+			TaxableAmount: l.TaxableAmount,
+			Amount:        l.Amount,
+		}
+	}
+	return result
+}
+
+// ListExchangeRates 获取汇率列表
+func (s *SubscriptionService) ListExchangeRates(ctx context.Context, req *pb.ListExchangeRatesRequest) (*pb.ListExchangeRatesReply, error) {
+	page := int(req.Page)
+	pageSize := int(req.PageSize)
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 {
+		pageSize = constants.DefaultPageSize
+	}
+	if pageSize > constants.MaxPageSize {
+		pageSize = constants.MaxPageSize
+	}
+
+	rates, total, err := s.uc.ListExchangeRates(ctx, req.FromCurrency, req.ToCurrency, page, pageSize)
+	if err != nil {
+		return nil, err
+	}
+	items := make([]*pb.ExchangeRate, len(rates))
+	for i, r := range rates {
+		items[i] = &pb.ExchangeRate{
+			ExchangeRateId: r.ExchangeRateID,
+			FromCurrency:   r.FromCurrency,
+			ToCurrency:     r.ToCurrency,
+			Rate:           r.Rate,
+			EffectiveAt:    unixTime(r.EffectiveAt),
+			Source:         r.Source,
+			CreatedAt:      unixTime(r.CreatedAt),
+		}
+	}
+	return &pb.ListExchangeRatesReply{
+		Items:    items,
+		Total:    int32(total),
+		Page:     int32(page),
+		PageSize: int32(pageSize),
+	}, nil
+}
+
+// SaveExchangeRates 保存汇率
+func (s *SubscriptionService) SaveExchangeRates(ctx context.Context, req *pb.SaveExchangeRatesRequest) (*pb.SaveExchangeRatesReply, error) {
+	// 汇率由所有应用的收入报表共用，仅管理员可修改
+	if _, err := auth.RequireAdmin(ctx); err != nil {
+		return nil, err
+	}
+	rates := make([]*biz.ExchangeRate, len(req.Rates))
+	for i, item := range req.Rates {
+		rates[i] = &biz.ExchangeRate{
+			FromCurrency: item.FromCurrency,
+			ToCurrency:   item.ToCurrency,
+			Rate:         item.Rate,
+			EffectiveAt:  fromUnixTime(item.EffectiveAt),
+		}
+	}
+	if err := s.uc.SaveExchangeRates(ctx, rates); err != nil {
+		return nil, err
+	}
+	return &pb.SaveExchangeRatesReply{Count: int32(len(rates))}, nil
+}
+
+// ImportExchangeRates 导入汇率
+func (s *SubscriptionService) ImportExchangeRates(ctx context.Context, req *pb.ImportExchangeRatesRequest) (*pb.ImportExchangeRatesReply, error) {
+	// 汇率由所有应用的收入报表共用，仅管理员可修改
+	if _, err := auth.RequireAdmin(ctx); err != nil {
+		return nil, err
+	}
+	count, err := s.uc.ImportExchangeRates(ctx, req.Content, false)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ImportExchangeRatesReply{Count: int32(count)}, nil
+}
+
+// GetRevenueReport 获取当前应用的收入报表
+func (s *SubscriptionService) GetRevenueReport(ctx context.Context, req *pb.GetRevenueReportRequest) (*pb.GetRevenueReportReply, error) {
+	// 获取 app_id（只从 Context，由中间件从 Header 提取）
+	if app_id.GetAppIDFromContext(ctx) == "" {
+		return nil, pkgErrors.NewBizErrorWithLang(ctx, pkgErrors.ErrCodeInvalidArgument)
+	}
+	if err := requireAppOperator(ctx); err != nil {
+		return nil, err
+	}
+
+	endTime := fromUnixTime(req.EndTime)
+	if endTime.IsZero() {
+		endTime = time.Now().UTC()
+	}
+	startTime := fromUnixTime(req.StartTime)
+	if startTime.IsZero() {
+		startTime = endTime.AddDate(0, 0, -constants.DefaultRevenueReportDays)
+	}
+
+	report, err := s.uc.GetRevenueReport(ctx, startTime, endTime)
+	if err != nil {
+		return nil, err
+	}
+	byCurrency := make([]*pb.CurrencyRevenue, len(report.ByCurrency))
+	for i, c := range report.ByCurrency {
+		byCurrency[i] = &pb.CurrencyRevenue{
+			Currency:       c.Currency,
+			OrderCount:     int32(c.OrderCount),
+			GrossAmount:    c.GrossAmount,
+			RefundedAmount: c.RefundedAmount,
+			TaxAmount:      c.TaxAmount,
+			NetAmount:      c.NetAmount,
+			ConvertedNet:   c.ConvertedNet,
+			Estimated:      c.Estimated,
+		}
+	}
+	return &pb.GetRevenueReportReply{
+		AppId:             report.AppID,
+		ReportingCurrency: report.ReportingCurrency,
+		StartTime:         unixTime(report.StartTime),
+		EndTime:           unixTime(report.EndTime),
+		OrderCount:        int32(report.OrderCount),
+		GrossAmount:       report.GrossAmount,
+		RefundedAmount:    report.RefundedAmount,
+		TaxAmount:         report.TaxAmount,
+		NetAmount:         report.NetAmount,
+		ByCurrency:        byCurrency,
+		Unconverted:       report.Unconverted,
+	}, nil
+}
+
+// GetSubscriptionMetrics 获取当前应用的订阅指标汇总
+func (s *SubscriptionService) GetSubscriptionMetrics(ctx context.Context, req *pb.GetSubscriptionMetricsRequest) (*pb.GetSubscriptionMetricsReply, error) {
+	if app_id.GetAppIDFromContext(ctx) == "" {
+		return nil, pkgErrors.NewBizErrorWithLang(ctx, pkgErrors.ErrCodeInvalidArgument)
+	}
+	if err := requireAppOperator(ctx); err != nil {
+		return nil, err
+	}
+	startDate, endDate, err := metricDateRange(ctx, req.StartDate, req.EndDate)
+	if err != nil {
+		return nil, err
+	}
+
+	metrics, err := s.uc.GetSubscriptionMetrics(ctx, startDate, endDate)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetSubscriptionMetricsReply{
+		AppId:                  metrics.AppID,
+		StartDate:              metrics.StartDate.Format(constants.DateLayout),
+		EndDate:                metrics.EndDate.Format(constants.DateLayout),
+		ReportingCurrency:      metrics.ReportingCurrency,
+		ActiveSubscribers:      int32(metrics.ActiveSubscribers),
+		PayingSubscribers:      int32(metrics.PayingSubscribers),
+		Mrr:                    metrics.MRR,
+		Arr:                    metrics.ARR,
+		StartActiveSubscribers: int32(metrics.StartActiveSubscribers),
+		StartPayingSubscribers: int32(metrics.StartPayingSubscribers),
+		NewSubscribers:         int32(metrics.NewSubscribers),
+		ChurnedSubscribers:     int32(metrics.ChurnedSubscribers),
+		ReactivatedSubscribers: int32(metrics.ReactivatedSubscribers),
+		ChurnRate:              metrics.ChurnRate,
+		RetentionRate:          metrics.RetentionRate,
+		Snapshots:              toPbMetricSnapshots(metrics.Snapshots),
+	}, nil
+}
+
+// ListMetricSnapshots 获取当前应用的订阅指标日快照
+func (s *SubscriptionService) ListMetricSnapshots(ctx context.Context, req *pb.ListMetricSnapshotsRequest) (*pb.ListMetricSnapshotsReply, error) {
+	if app_id.GetAppIDFromContext(ctx) == "" {
+		return nil, pkgErrors.NewBizErrorWithLang(ctx, pkgErrors.ErrCodeInvalidArgument)
+	}
+	if err := requireAppOperator(ctx); err != nil {
+		return nil, err
+	}
+	startDate, endDate, err := metricDateRange(ctx, req.StartDate, req.EndDate)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshots, err := s.uc.ListMetricSnapshots(ctx, startDate, endDate)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ListMetricSnapshotsReply{Snapshots: toPbMetricSnapshots(snapshots)}, nil
+}
+
+// GenerateMetricSnapshots 生成订阅指标日快照
+func (s *SubscriptionService) GenerateMetricSnapshots(ctx context.Context, req *pb.GenerateMetricSnapshotsRequest) (*pb.GenerateMetricSnapshotsReply, error) {
+	// 补生成快照会覆盖指定应用的快照，属于运维操作，仅管理员可用
+	if _, err := auth.RequireAdmin(ctx); err != nil {
+		return nil, err
+	}
+	date := time.Now().UTC().AddDate(0, 0, -1)
+	if req.Date != "" {
+		var err error
+		if date, err = time.Parse(constants.DateLayout, req.Date); err != nil {
+			return nil, pkgErrors.NewBizErrorWithLang(ctx, errors.ErrCodeReportRangeInvalid)
+		}
+	}
+	count, err := s.uc.GenerateMetricSnapshots(ctx, date, req.AppId, false)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GenerateMetricSnapshotsReply{Count: int32(count)}, nil
+}
+
+// GetCohortReport 获取当前应用的月度同期群留存报表
+func (s *SubscriptionService) GetCohortReport(ctx context.Context, req *pb.GetCohortReportRequest) (*pb.GetCohortReportReply, error) {
+	if app_id.GetAppIDFromContext(ctx) == "" {
+		return nil, pkgErrors.NewBizErrorWithLang(ctx, pkgErrors.ErrCodeInvalidArgument)
+	}
+	if err := requireAppOperator(ctx); err != nil {
+		return nil, err
+	}
+	months := int(req.Months)
+	if months <= 0 {
+		months = constants.DefaultReportMonths
+	}
+
+	report, err := s.uc.GetCohortReport(ctx, months)
+	if err != nil {
+		return nil, err
+	}
+	reply := &pb.GetCohortReportReply{
+		AppId:             report.AppID,
+		ReportingCurrency: report.ReportingCurrency,
+		StartMonth:        report.StartMonth.Format(constants.MonthLayout),
+		EndMonth:          report.EndMonth.Format(constants.MonthLayout),
+		Cohorts:           make([]*pb.CohortRow, len(report.Cohorts)),
+		Unconverted:       report.Unconverted,
+	}
+	for i, row := range report.Cohorts {
+		counts := make([]int32, len(row.ActiveCounts))
+		for n, c := range row.ActiveCounts {
+			counts[n] = int32(c)
+		}
+		reply.Cohorts[i] = &pb.CohortRow{
+			CohortMonth:    row.CohortMonth.Format(constants.MonthLayout),
+			Size:           int32(row.Size),
+			ActiveCounts:   counts,
+			Retention:      row.Retention,
+			Revenue:        row.Revenue,
+			AverageRevenue: row.AverageRevenue,
+		}
+	}
+	if req.Format == constants.ReportFormatCSV {
+		if reply.Content, err = cohortCSV(report); err != nil {
+			return nil, err
+		}
+		reply.ContentType = csvContentType
+		reply.FileName = "cohorts-" + report.EndMonth.Format(constants.MonthLayout) + ".csv"
+	}
+	return reply, nil
+}
+
+// GetPlanLTVReport 获取当前应用各套餐的 LTV 估算
+func (s *SubscriptionService) GetPlanLTVReport(ctx context.Context, req *pb.GetPlanLTVReportRequest) (*pb.GetPlanLTVReportReply, error) {
+	if app_id.GetAppIDFromContext(ctx) == "" {
+		return nil, pkgErrors.NewBizErrorWithLang(ctx, pkgErrors.ErrCodeInvalidArgument)
+	}
+	if err := requireAppOperator(ctx); err != nil {
+		return nil, err
+	}
+	months := int(req.Months)
+	if months <= 0 {
+		months = constants.DefaultReportMonths
+	}
+
+	report, err := s.uc.GetPlanLTVReport(ctx, months)
+	if err != nil {
+		return nil, err
+	}
+	reply := &pb.GetPlanLTVReportReply{
+		AppId:             report.AppID,
+		ReportingCurrency: report.ReportingCurrency,
+		StartTime:         unixTime(report.StartTime),
+		Plans:             make([]*pb.PlanLTV, len(report.Plans)),
+		Unconverted:       report.Unconverted,
+	}
+	for i, p := range report.Plans {
+		reply.Plans[i] = &pb.PlanLTV{
+			PlanId:                 p.PlanID,
+			PlanName:               p.PlanName,
+			BillingType:            p.BillingType,
+			Subscribers:            int32(p.Subscribers),
+			Orders:                 int32(p.Orders),
+			AverageOrderRevenue:    p.AverageOrderRevenue,
+			RenewalRate:            p.RenewalRate,
+			ChurnRate:              p.ChurnRate,
+			ExpectedPeriods:        p.ExpectedPeriods,
+			EstimatedLtv:           p.EstimatedLTV,
+			ObservedRevenuePerUser: p.ObservedRevenuePerUser,
+			EndedPeriods:           int32(p.EndedPeriods),
+			LtvUnknown:             p.LTVUnknown,
+		}
+	}
+	if req.Format == constants.ReportFormatCSV {
+		if reply.Content, err = ltvCSV(report); err != nil {
+			return nil, err
+		}
+		reply.ContentType = csvContentType
+		reply.FileName = "plan-ltv-" + time.Now().UTC().Format(constants.DateLayout) + ".csv"
+	}
+	return reply, nil
+}
+
+// metricDateRange 解析指标查询的日期范围：结束日期默认为昨天，开始日期默认为结束日期前 29 天
+func metricDateRange(ctx context.Context, start, end string) (time.Time, time.Time, error) {
+	endDate := time.Now().UTC().AddDate(0, 0, -1)
+	if end != "" {
+		var err error
+		if endDate, err = time.Parse(constants.DateLayout, end); err != nil {
+			return time.Time{}, time.Time{}, pkgErrors.NewBizErrorWithLang(ctx, errors.ErrCodeReportRangeInvalid)
+		}
+	}
+	startDate := endDate.AddDate(0, 0, 1-constants.DefaultMetricRangeDays)
+	if start != "" {
+		var err error
+		if startDate, err = time.Parse(constants.DateLayout, start); err != nil {
+			return time.Time{}, time.Time{}, pkgErrors.NewBizErrorWithLang(ctx, errors.ErrCodeReportRangeInvalid)
+		}
+	}
+	return startDate, endDate, nil
+}
+
+// toPbMetricSnapshots 转换订阅指标快照为 protobuf 消息
+func toPbMetricSnapshots(snapshots []*biz.MetricSnapshot) []*pb.MetricSnapshot {
+	result := make([]*pb.MetricSnapshot, len(snapshots))
+	for i, s := range snapshots {
+		result[i] = &pb.MetricSnapshot{
+			SnapshotDate:           s.SnapshotDate.Format(constants.DateLayout),
+			ReportingCurrency:      s.ReportingCurrency,
+			ActiveSubscribers:      int32(s.ActiveSubscribers),
+			PayingSubscribers:      int32(s.PayingSubscribers),
+			Mrr:                    s.MRR,
+			Arr:                    s.ARR,
+			NewSubscribers:         int32(s.NewSubscribers),
+			ChurnedSubscribers:     int32(s.ChurnedSubscribers),
+			ReactivatedSubscribers: int32(s.ReactivatedSubscribers),
+			StateUnavailable:       s.StateUnavailable,
+		}
+	}
+	return result
+}
+
 // GetMySubscription 获取用户当前订阅信息
 // 查询指定用户的当前订阅状态、套餐信息和有效期
 func (s *SubscriptionService) GetMySubscription(ctx context.Context, req *pb.GetMySubscriptionRequest) (*pb.GetMySubscriptionReply, error) {
@@ -223,22 +912,68 @@ func (s *SubscriptionService) GetMySubscription(ctx context.Context, req *pb.Get
 		return nil, err
 	}
 
-	sub, err := s.uc.GetMySubscription(ctx, req.Uid)
+	entitlement, err := s.uc.GetMySubscription(ctx, req.Uid)
 	if err != nil {
 		return nil, err
 	}
 
+	sub := entitlement.Subscription
+	reply := &pb.GetMySubscriptionReply{IsActive: false, IsFree: entitlement.IsFree}
+	if plan := entitlement.Plan; plan != nil {
+		reply.PlanId = plan.PlanID
+		reply.PlanName = plan.Name
+		reply.PlanType = plan.Type
+		reply.IsLifetime = plan.IsLifetime()
+	}
+	if entitlement.IsFree && (sub == nil || sub.PlanID != reply.PlanId) {
+		// 未订阅或订阅已失效，按免费套餐返回
+		reply.IsActive = true
+		reply.Status = constants.StatusActive
+		return reply, nil
+	}
 	if sub == nil {
-		return &pb.GetMySubscriptionReply{IsActive: false}, nil
+		return reply, nil
 	}
 
-	return &pb.GetMySubscriptionReply{
-		IsActive:  sub.Status == "active",
-		PlanId:    sub.PlanID,
-		StartTime: sub.StartTime.Unix(),
-		EndTime:   sub.EndTime.Unix(),
-		Status:    sub.Status,
-		AutoRenew: sub.IsAutoRenew,
+	reply.IsActive = sub.Status == "active"
+	reply.PlanId = sub.PlanID
+	reply.StartTime = sub.StartTime.Unix()
+	reply.EndTime = unixTime(sub.EndTime)
+	reply.Status = sub.Status
+	reply.AutoRenew = sub.IsAutoRenew
+	return reply, nil
+}
+
+// QuoteSubscription 获取订阅报价
+// 返回定价地区、价格明细和购买后的订阅周期，以及下单时使用的报价 token
+func (s *SubscriptionService) QuoteSubscription(ctx context.Context, req *pb.QuoteSubscriptionRequest) (*pb.QuoteSubscriptionReply, error) {
+	// 权限验证
+	if err := auth.CheckOwnership(ctx, req.Uid); err != nil {
+		return nil, err
+	}
+
+	clientIP, acceptLanguage, xLanguage := regionHints(ctx, req.Region)
+	quote, err := s.uc.QuoteSubscription(ctx, req.Uid, req.PlanId, req.Region, req.VatId, clientIP, acceptLanguage, xLanguage)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.QuoteSubscriptionReply{
+		Region:          quote.Region,
+		RegionSource:    quote.RegionSource,
+		PricingRegion:   quote.PricingRegion,
+		Currency:        quote.Currency,
+		Subtotal:        quote.Subtotal,
+		Discount:        quote.Discount,
+		ProrationCredit: quote.ProrationCredit,
+		Tax:             quote.Tax,
+		Total:           quote.Total,
+		PeriodStart:     unixTime(quote.PeriodStart),
+		PeriodEnd:       unixTime(quote.PeriodEnd),
+		ExpiresAt:       unixTime(quote.ExpiresAt),
+		QuoteToken:      quote.Token,
+		TaxLines:        toPbTaxLines(quote.TaxLines),
+		VatId:           quote.VatID,
 	}, nil
 }
 
@@ -252,36 +987,46 @@ func (s *SubscriptionService) CreateSubscriptionOrder(ctx context.Context, req *
 
 	// 从请求中获取 region，如果为空则自动推断
 	region := req.Region
+	clientIP, acceptLanguage, xLanguage := regionHints(ctx, region)
 
-	// 如果 region 为空，从 HTTP 请求中提取信息用于自动推断
-	var clientIP, acceptLanguage, xLanguage string
-	if region == "" {
-		// 从 kratos transport 中提取 HTTP 信息
-		if tr, ok := transport.FromServerContext(ctx); ok {
-			header := tr.RequestHeader()
-			clientIP = pkgUtils.GetClientIP(ctx)
-			acceptLanguage = header.Get("Accept-Language")
-			xLanguage = header.Get("X-Language")
-		}
-	}
-
-	order, paymentID, payUrl, payCode, payParams, err := s.uc.CreateSubscriptionOrderWithContext(ctx, req.Uid, req.PlanId, req.PaymentMethod, region, clientIP, acceptLanguage, xLanguage)
+	order, paymentID, payUrl, payCode, payParams, err := s.uc.CreateSubscriptionOrderWithContext(ctx, req.Uid, req.PlanId, req.PaymentMethod, region, req.VatId, req.QuoteToken, clientIP, acceptLanguage, xLanguage)
 	if err != nil {
 		return nil, err
 	}
 
 	return &pb.CreateSubscriptionOrderReply{
-		OrderId:   order.OrderID,
-		PaymentId: paymentID,
-		PayUrl:    payUrl,
-		PayCode:   payCode,
-		PayParams: payParams,
+		OrderId:       order.OrderID,
+		PaymentId:     paymentID,
+		PayUrl:        payUrl,
+		PayCode:       payCode,
+		PayParams:     payParams,
+		Amount:        order.Amount,
+		Currency:      order.Currency,
+		PaymentStatus: order.PaymentStatus,
+		TaxAmount:     order.TaxAmount,
+		TaxLines:      toPbTaxLines(order.TaxLines),
 	}, nil
 }
 
+// regionHints 从 HTTP 请求中提取地区推断所需的信息（region 为空时才需要）
+func regionHints(ctx context.Context, region string) (clientIP, acceptLanguage, xLanguage string) {
+	if region != "" {
+		return "", "", ""
+	}
+	// 从 kratos transport 中提取 HTTP 信息
+	if tr, ok := transport.FromServerContext(ctx); ok {
+		header := tr.RequestHeader()
+		clientIP = pkgUtils.GetClientIP(ctx)
+		acceptLanguage = header.Get("Accept-Language")
+		xLanguage = header.Get("X-Language")
+	}
+	return clientIP, acceptLanguage, xLanguage
+}
+
 // HandlePaymentSuccess 处理支付成功回调
 // 接收支付成功通知，更新订单状态，激活或续费用户订阅
 func (s *SubscriptionService) HandlePaymentSuccess(ctx context.Context, req *pb.HandlePaymentSuccessRequest) (*emptypb.Empty, error) {
+	ctx = audit.WithActor(ctx, constants.ActorTypePayment, req.PaymentId)
 	err := s.uc.HandlePaymentSuccess(ctx, req.OrderId, req.Amount)
 	if err != nil {
 		return nil, err
@@ -289,6 +1034,17 @@ func (s *SubscriptionService) HandlePaymentSuccess(ctx context.Context, req *pb.
 	return &emptypb.Empty{}, nil
 }
 
+// HandlePaymentRefund 处理退款回调
+// 接收退款通知，更新订单状态；全额退款时结束订阅并回落到默认免费套餐
+func (s *SubscriptionService) HandlePaymentRefund(ctx context.Context, req *pb.HandlePaymentRefundRequest) (*emptypb.Empty, error) {
+	ctx = audit.WithActor(ctx, constants.ActorTypePayment, req.PaymentId)
+	err := s.uc.HandlePaymentRefund(ctx, req.OrderId, req.Amount)
+	if err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
 // CancelSubscription 取消订阅
 // 用户主动取消订阅，订阅状态变更为已取消
 func (s *SubscriptionService) CancelSubscription(ctx context.Context, req *pb.CancelSubscriptionRequest) (*emptypb.Empty, error) {
@@ -342,43 +1098,72 @@ func (s *SubscriptionService) GetSubscriptionHistory(ctx context.Context, req *p
 		return nil, err
 	}
 
-	page := int(req.Page)
-	pageSize := int(req.PageSize)
-	if page < 1 {
-		page = 1
+	query := &biz.HistoryQuery{
+		HistoryFilter: biz.HistoryFilter{
+			UID:     req.Uid,
+			AppID:   req.AppId,
+			PlanID:  req.PlanId,
+			Actions: req.Actions,
+			From:    fromUnixTime(req.StartTime),
+			To:      fromUnixTime(req.EndTime),
+		},
+		Cursor:   req.Cursor,
+		Page:     int(req.Page),
+		PageSize: int(req.PageSize),
 	}
-	if pageSize < 1 {
-		pageSize = constants.DefaultPageSize
+	items, total, next, err := s.uc.GetSubscriptionHistory(ctx, query)
+	if err != nil {
+		return nil, err
 	}
-	if pageSize > constants.MaxPageSize {
-		pageSize = constants.MaxPageSize
+
+	return &pb.GetSubscriptionHistoryReply{
+		Items:      toPbHistoryItems(items),
+		Total:      int32(total),
+		Page:       int32(query.Page),
+		PageSize:   int32(query.PageSize),
+		NextCursor: next,
+	}, nil
+}
+
+// ListAppSubscriptionHistory 获取当前应用所有用户的订阅历史记录
+// 按创建时间倒序，使用上一页返回的 nextCursor 获取下一页
+func (s *SubscriptionService) ListAppSubscriptionHistory(ctx context.Context, req *pb.ListAppSubscriptionHistoryRequest) (*pb.ListAppSubscriptionHistoryReply, error) {
+	// 获取 app_id（只从 Context，由中间件从 Header 提取）
+	if app_id.GetAppIDFromContext(ctx) == "" {
+		return nil, pkgErrors.NewBizErrorWithLang(ctx, pkgErrors.ErrCodeInvalidArgument)
+	}
+	if err := requireAppOperator(ctx); err != nil {
+		return nil, err
 	}
 
-	items, total, err := s.uc.GetSubscriptionHistory(ctx, req.Uid, page, pageSize)
+	query := &biz.HistoryQuery{
+		HistoryFilter: biz.HistoryFilter{
+			UID:     req.Uid,
+			PlanID:  req.PlanId,
+			Actions: req.Actions,
+			From:    fromUnixTime(req.StartTime),
+			To:      fromUnixTime(req.EndTime),
+		},
+		Cursor:   req.Cursor,
+		PageSize: int(req.PageSize),
+	}
+	items, next, err := s.uc.ListAppSubscriptionHistory(ctx, query)
 	if err != nil {
 		return nil, err
 	}
+	return &pb.ListAppSubscriptionHistoryReply{
+		Items:      toPbHistoryItems(items),
+		NextCursor: next,
+	}, nil
+}
 
-	pbItems := make([]*pb.SubscriptionHistoryItem, len(items))
-	for i, item := range items {
-		pbItems[i] = &pb.SubscriptionHistoryItem{
-			Id:        item.SubscriptionHistoryID,
-			PlanId:    item.PlanID,
-			PlanName:  item.PlanName,
-			StartTime: item.StartTime.Unix(),
-			EndTime:   item.EndTime.Unix(),
-			Status:    item.Status,
-			Action:    item.Action,
-			CreatedAt: item.CreatedAt.Unix(),
-		}
+// requireAppOperator 检查当前调用方是否可以访问整个应用的数据：应用的开发者（由 API Gateway 的 api-key 插件设置 X-Developer-Id）或管理员
+func requireAppOperator(ctx context.Context) error {
+	if developer_id.GetDeveloperIDFromContext(ctx) != "" {
+		return nil
 	}
-
-	return &pb.GetSubscriptionHistoryReply{
-		Items:    pbItems,
-		Total:    int32(total),
-		Page:     int32(page),
-		PageSize: int32(pageSize),
-	}, nil
+	_, err := auth.RequireAdmin(ctx)
+	return err
 }
 
 // SetAutoRenew 设置自动续费
@@ -459,7 +1244,7 @@ func (s *SubscriptionService) GetExpiringSubscriptions(ctx context.Context, req
 // UpdateExpiredSubscriptions 批量更新过期订阅状态
 // 定时任务调用，将已过期的订阅状态更新为expired
 func (s *SubscriptionService) UpdateExpiredSubscriptions(ctx context.Context, req *pb.UpdateExpiredSubscriptionsRequest) (*pb.UpdateExpiredSubscriptionsReply, error) {
-	count, uids, err := s.uc.UpdateExpiredSubscriptions(ctx)
+	count, uids, err := s.uc.UpdateExpiredSubscriptions(ctx, false)
 	if err != nil {
 		return nil, err
 	}
@@ -502,3 +1287,80 @@ func (s *SubscriptionService) ProcessAutoRenewals(ctx context.Context, req *pb.P
 		Results:      pbResults,
 	}, nil
 }
+
+// ProcessPriceChangeNotices 发送续费调价通知
+// 定时任务调用，通知即将按新价格续费的自动续费用户
+func (s *SubscriptionService) ProcessPriceChangeNotices(ctx context.Context, req *pb.ProcessPriceChangeNoticesRequest) (*pb.ProcessPriceChangeNoticesReply, error) {
+	totalCount, notifiedCount, notices, err := s.uc.ProcessPriceChangeNotices(ctx, int(req.DaysBeforeRenewal), req.DryRun)
+	if err != nil {
+		return nil, err
+	}
+
+	pbNotices := make([]*pb.PriceChangeNotice, len(notices))
+	for i, n := range notices {
+		pbNotices[i] = &pb.PriceChangeNotice{
+			Uid:         n.UID,
+			PlanId:      n.PlanID,
+			CountryCode: n.CountryCode,
+			OldPrice:    n.OldPrice,
+			OldCurrency: n.OldCurrency,
+			NewPrice:    n.NewPrice,
+			NewCurrency: n.NewCurrency,
+			RenewAt:     n.RenewAt.Unix(),
+		}
+	}
+
+	return &pb.ProcessPriceChangeNoticesReply{
+		TotalCount:    int32(totalCount),
+		NotifiedCount: int32(notifiedCount),
+		Notices:       pbNotices,
+	}, nil
+}
+
+// toPbHistoryItems 转换订阅历史记录列表
+func toPbHistoryItems(items []*biz.SubscriptionHistory) []*pb.SubscriptionHistoryItem {
+	pbItems := make([]*pb.SubscriptionHistoryItem, len(items))
+	for i, item := range items {
+		pbItems[i] = toPbHistoryItem(item)
+	}
+	return pbItems
+}
+
+// toPbHistoryItem 转换订阅历史记录
+func toPbHistoryItem(item *biz.SubscriptionHistory) *pb.SubscriptionHistoryItem {
+	changes := make([]*pb.HistoryChange, len(item.Changes))
+	for i, c := range item.Changes {
+		changes[i] = &pb.HistoryChange{Field: c.Field, Before: c.Before, After: c.After}
+	}
+	return &pb.SubscriptionHistoryItem{
+		Id:         item.SubscriptionHistoryID,
+		PlanId:     item.PlanID,
+		PlanName:   item.PlanName,
+		StartTime:  item.StartTime.Unix(),
+		EndTime:    unixTime(item.EndTime),
+		Status:     item.Status,
+		Action:     item.Action,
+		CreatedAt:  item.CreatedAt.Unix(),
+		OperatorId: item.OperatorID,
+		Reason:     item.Reason,
+		ActorType:  item.ActorType,
+		RequestId:  item.RequestID,
+		Changes:    changes,
+	}
+}
+
+// unixTime 转换为 Unix 时间戳，零值时间（如终身订阅的结束时间）返回 0
+func unixTime(t time.Time) int64 {
+	if t.IsZero() {
+		return 0
+	}
+	return t.Unix()
+}
+
+// fromUnixTime 将 Unix 时间戳转换为 UTC 时间，0 表示零值时间（不限）
+func fromUnixTime(ts int64) time.Time {
+	if ts <= 0 {
+		return time.Time{}
+	}
+	return time.Unix(ts, 0).UTC()
+}
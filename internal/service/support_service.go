@@ -0,0 +1,300 @@
+package service
+
+import (
+	"context"
+
+	pb "xinyuan_tech/subscription-service/api/subscription/v1"
+	"xinyuan_tech/subscription-service/internal/auth"
+	"xinyuan_tech/subscription-service/internal/biz"
+	"xinyuan_tech/subscription-service/internal/constants"
+)
+
+// SupportService 客服支持服务：查询订阅、查看用户时间线、调整订阅、赠送时长和批量操作（仅管理员可用）
+type SupportService struct {
+	pb.UnimplementedSubscriptionSupportServer
+
+	uc *biz.SubscriptionUsecase
+}
+
+// NewSupportService 创建客服支持服务
+func NewSupportService(uc *biz.SubscriptionUsecase) *SupportService {
+	return &SupportService{uc: uc}
+}
+
+// SearchSubscriptions 按用户、应用、套餐、状态和时间范围查询订阅
+func (s *SupportService) SearchSubscriptions(ctx context.Context, req *pb.SearchSubscriptionsRequest) (*pb.SearchSubscriptionsReply, error) {
+	if _, err := auth.RequireAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	page := int(req.Page)
+	pageSize := int(req.PageSize)
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 {
+		pageSize = constants.DefaultPageSize
+	}
+	if pageSize > constants.MaxPageSize {
+		pageSize = constants.MaxPageSize
+	}
+
+	subs, total, err := s.uc.SearchSubscriptions(ctx, &biz.SubscriptionSearchQuery{
+		UID:       req.Uid,
+		AppID:     req.AppId,
+		PlanID:    req.PlanId,
+		Status:    req.Status,
+		TimeField: req.TimeField,
+		From:      fromUnixTime(req.StartTime),
+		To:        fromUnixTime(req.EndTime),
+		Page:      page,
+		PageSize:  pageSize,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	pbSubs := make([]*pb.SupportSubscription, len(subs))
+	for i, sub := range subs {
+		pbSubs[i] = toPbSupportSubscription(sub)
+	}
+	return &pb.SearchSubscriptionsReply{
+		Subscriptions: pbSubs,
+		Total:         int32(total),
+		Page:          int32(page),
+		PageSize:      int32(pageSize),
+	}, nil
+}
+
+// GetUserTimeline 查看用户的当前订阅、订单、订阅历史和合并后的时间线
+func (s *SupportService) GetUserTimeline(ctx context.Context, req *pb.GetUserTimelineRequest) (*pb.GetUserTimelineReply, error) {
+	if _, err := auth.RequireAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	timeline, err := s.uc.GetUserTimeline(ctx, req.Uid)
+	if err != nil {
+		return nil, err
+	}
+
+	reply := &pb.GetUserTimelineReply{
+		Subscription: toPbSupportSubscription(timeline.Subscription),
+		Orders:       make([]*pb.SupportOrder, len(timeline.Orders)),
+		History:      make([]*pb.SubscriptionHistoryItem, len(timeline.History)),
+		Events:       make([]*pb.TimelineEvent, len(timeline.Events)),
+	}
+	for i, order := range timeline.Orders {
+		reply.Orders[i] = toPbSupportOrder(order)
+	}
+	for i, item := range timeline.History {
+		reply.History[i] = toPbHistoryItem(item)
+	}
+	for i, event := range timeline.Events {
+		reply.Events[i] = &pb.TimelineEvent{
+			Time:       unixTime(event.Time),
+			Type:       event.Type,
+			Action:     event.Action,
+			PlanId:     event.PlanID,
+			OrderId:    event.OrderID,
+			Status:     event.Status,
+			Amount:     event.Amount,
+			Currency:   event.Currency,
+			OperatorId: event.OperatorID,
+			Reason:     event.Reason,
+			ActorType:  event.ActorType,
+		}
+	}
+	return reply, nil
+}
+
+// AdjustSubscription 延长或缩短用户订阅（操作人为当前管理员）
+func (s *SupportService) AdjustSubscription(ctx context.Context, req *pb.AdjustSubscriptionRequest) (*pb.AdjustSubscriptionReply, error) {
+	operatorID, err := auth.RequireAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	sub, err := s.uc.AdjustSubscription(ctx, req.Uid, int(req.Days), operatorID, req.Reason)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.AdjustSubscriptionReply{Subscription: toPbSupportSubscription(sub)}, nil
+}
+
+// GrantSubscription 赠送或手动开通用户订阅（操作人为当前管理员）
+func (s *SupportService) GrantSubscription(ctx context.Context, req *pb.GrantSubscriptionRequest) (*pb.GrantSubscriptionReply, error) {
+	operatorID, err := auth.RequireAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	sub, order, err := s.uc.GrantSubscription(ctx, &biz.GrantRequest{
+		UID:         req.Uid,
+		PlanID:      req.PlanId,
+		Source:      req.Source,
+		Days:        int(req.Days),
+		CreateOrder: req.CreateOrder,
+		OperatorID:  operatorID,
+		Reason:      req.Reason,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GrantSubscriptionReply{
+		Subscription: toPbSupportSubscription(sub),
+		Order:        toPbSupportOrder(order),
+	}, nil
+}
+
+// CreateBulkOperation 创建批量操作（操作人为当前管理员），dryRun 时只返回匹配的订阅数
+func (s *SupportService) CreateBulkOperation(ctx context.Context, req *pb.CreateBulkOperationRequest) (*pb.CreateBulkOperationReply, error) {
+	operatorID, err := auth.RequireAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	op, err := s.uc.CreateBulkOperation(ctx, &biz.BulkOperation{
+		Type:         req.Type,
+		AppID:        req.AppId,
+		PlanID:       req.PlanId,
+		FilterStatus: req.Status,
+		Days:         int(req.Days),
+		TargetPlanID: req.TargetPlanId,
+		OperatorID:   operatorID,
+		Reason:       req.Reason,
+	}, req.DryRun)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.CreateBulkOperationReply{Operation: toPbBulkOperation(op)}, nil
+}
+
+// GetBulkOperation 查询批量操作的执行进度
+func (s *SupportService) GetBulkOperation(ctx context.Context, req *pb.GetBulkOperationRequest) (*pb.GetBulkOperationReply, error) {
+	if _, err := auth.RequireAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	op, err := s.uc.GetBulkOperation(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetBulkOperationReply{Operation: toPbBulkOperation(op)}, nil
+}
+
+// ListBulkOperations 分页查询批量操作
+func (s *SupportService) ListBulkOperations(ctx context.Context, req *pb.ListBulkOperationsRequest) (*pb.ListBulkOperationsReply, error) {
+	if _, err := auth.RequireAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	page := int(req.Page)
+	pageSize := int(req.PageSize)
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 {
+		pageSize = constants.DefaultPageSize
+	}
+	if pageSize > constants.MaxPageSize {
+		pageSize = constants.MaxPageSize
+	}
+
+	ops, total, err := s.uc.ListBulkOperations(ctx, req.AppId, req.Status, page, pageSize)
+	if err != nil {
+		return nil, err
+	}
+	pbOps := make([]*pb.BulkOperation, len(ops))
+	for i, op := range ops {
+		pbOps[i] = toPbBulkOperation(op)
+	}
+	return &pb.ListBulkOperationsReply{
+		Operations: pbOps,
+		Total:      int32(total),
+		Page:       int32(page),
+		PageSize:   int32(pageSize),
+	}, nil
+}
+
+// CancelBulkOperation 取消未结束的批量操作
+func (s *SupportService) CancelBulkOperation(ctx context.Context, req *pb.CancelBulkOperationRequest) (*pb.CancelBulkOperationReply, error) {
+	operatorID, err := auth.RequireAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	op, err := s.uc.CancelBulkOperation(ctx, req.Id, operatorID)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.CancelBulkOperationReply{Operation: toPbBulkOperation(op)}, nil
+}
+
+func toPbSupportSubscription(sub *biz.UserSubscription) *pb.SupportSubscription {
+	if sub == nil {
+		return nil
+	}
+	return &pb.SupportSubscription{
+		SubscriptionId: sub.SubscriptionID,
+		Uid:            sub.UID,
+		PlanId:         sub.PlanID,
+		AppId:          sub.AppID,
+		CountryCode:    sub.CountryCode,
+		Status:         sub.Status,
+		StartTime:      unixTime(sub.StartTime),
+		EndTime:        unixTime(sub.EndTime),
+		AutoRenew:      sub.IsAutoRenew,
+		OrderId:        sub.OrderID,
+		Version:        int32(sub.Version),
+		CreatedAt:      unixTime(sub.CreatedAt),
+		UpdatedAt:      unixTime(sub.UpdatedAt),
+		Source:         sub.Source,
+		NextPlanId:     sub.NextPlanID,
+	}
+}
+
+func toPbSupportOrder(order *biz.SubscriptionOrder) *pb.SupportOrder {
+	if order == nil {
+		return nil
+	}
+	return &pb.SupportOrder{
+		OrderId:        order.OrderID,
+		PaymentId:      order.PaymentID,
+		PlanId:         order.PlanID,
+		AppId:          order.AppID,
+		Currency:       order.Currency,
+		Amount:         order.Amount,
+		RefundedAmount: order.RefundedAmount,
+		PaymentStatus:  order.PaymentStatus,
+		PeriodStart:    unixTime(order.PeriodStart),
+		PeriodEnd:      unixTime(order.PeriodEnd),
+		PaidAt:         unixTime(order.PaidAt),
+		CreatedAt:      unixTime(order.CreatedAt),
+		Source:         order.Source,
+	}
+}
+
+func toPbBulkOperation(op *biz.BulkOperation) *pb.BulkOperation {
+	return &pb.BulkOperation{
+		Id:             op.BulkOperationID,
+		Type:           op.Type,
+		AppId:          op.AppID,
+		PlanId:         op.PlanID,
+		FilterStatus:   op.FilterStatus,
+		Days:           int32(op.Days),
+		TargetPlanId:   op.TargetPlanID,
+		OperatorId:     op.OperatorID,
+		Reason:         op.Reason,
+		Status:         op.Status,
+		TotalCount:     int32(op.TotalCount),
+		ProcessedCount: int32(op.ProcessedCount),
+		SuccessCount:   int32(op.SuccessCount),
+		SkippedCount:   int32(op.SkippedCount),
+		FailedCount:    int32(op.FailedCount),
+		Error:          op.Error,
+		StartedAt:      unixTime(op.StartedAt),
+		FinishedAt:     unixTime(op.FinishedAt),
+		CreatedAt:      unixTime(op.CreatedAt),
+		UpdatedAt:      unixTime(op.UpdatedAt),
+	}
+}
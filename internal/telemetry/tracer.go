@@ -0,0 +1,90 @@
+package telemetry
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"xinyuan_tech/subscription-service/internal/conf"
+	"xinyuan_tech/subscription-service/internal/constants"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// InitTracer 按配置初始化全局 TracerProvider 和 W3C Trace Context 传播器，返回退出时刷新并关闭导出器的函数
+// 未配置导出方式时只设置传播器：仍会透传上游的 trace 上下文，但本服务不记录 span
+func InitTracer(c *conf.Trace, serviceName, version string) (func(), error) {
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+	if c == nil || c.GetExporter() == "" {
+		return func() {}, nil
+	}
+
+	var closer io.Closer
+	var exporter sdktrace.SpanExporter
+	var err error
+	switch c.GetExporter() {
+	case constants.TraceExporterOTLP:
+		opts := []otlptracegrpc.Option{}
+		if c.GetEndpoint() != "" {
+			opts = append(opts, otlptracegrpc.WithEndpoint(c.GetEndpoint()))
+		}
+		if c.GetInsecure() {
+			opts = append(opts, otlptracegrpc.WithInsecure())
+		}
+		exporter, err = otlptracegrpc.New(context.Background(), opts...)
+	case constants.TraceExporterStdout:
+		exporter, err = stdouttrace.New(stdouttrace.WithPrettyPrint())
+	case constants.TraceExporterFile:
+		if c.GetFilePath() == "" {
+			return nil, fmt.Errorf("trace.file_path is required for file exporter")
+		}
+		file, openErr := os.OpenFile(c.GetFilePath(), os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+		if openErr != nil {
+			return nil, openErr
+		}
+		closer = file
+		exporter, err = stdouttrace.New(stdouttrace.WithWriter(file))
+	default:
+		return nil, fmt.Errorf("unsupported trace exporter: %s", c.GetExporter())
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	ratio := c.GetSampleRatio()
+	if ratio <= 0 || ratio > 1 {
+		ratio = 1
+	}
+	tp := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(ratio))),
+		sdktrace.WithResource(resource.NewSchemaless(
+			attribute.String("service.name", serviceName),
+			attribute.String("service.version", version),
+		)),
+	)
+	otel.SetTracerProvider(tp)
+
+	return func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		_ = tp.Shutdown(ctx)
+		if closer != nil {
+			_ = closer.Close()
+		}
+	}, nil
+}
+
+// Tracer 返回服务自身的 Tracer（用于定时任务等没有上游请求的根 span）
+func Tracer() trace.Tracer {
+	return otel.Tracer("xinyuan_tech/subscription-service")
+}
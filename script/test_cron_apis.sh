@@ -8,6 +8,7 @@ RED="\033[0;31m"
 NC="\033[0m"
 
 BASE_URL="http://localhost:8102"
+ADMIN_URL="http://localhost:8113"
 
 echo -e "${GREEN}========================================${NC}"
 echo -e "${GREEN}   测试 Cron 相关 API                  ${NC}"
@@ -40,6 +41,23 @@ echo -e "\n${YELLOW}4. 测试获取即将过期的订阅（30天内）${NC}"
 echo -e "${YELLOW}GET $BASE_URL/v1/subscription/expiring?days_before_expiry=30&page=1&page_size=10${NC}"
 curl -s -X GET "$BASE_URL/v1/subscription/expiring?days_before_expiry=30&page=1&page_size=10" | jq '.'
 
+# 5. 测试获取定时任务列表（Cron 服务管理接口）
+echo -e "\n${YELLOW}5. 测试获取定时任务列表${NC}"
+echo -e "${YELLOW}GET $ADMIN_URL/v1/admin/cron/jobs${NC}"
+curl -s -X GET "$ADMIN_URL/v1/admin/cron/jobs" | jq '.'
+
+# 6. 测试立即执行自动续费任务（dry run）
+echo -e "\n${YELLOW}6. 测试立即执行自动续费任务（dry run）${NC}"
+echo -e "${YELLOW}POST $ADMIN_URL/v1/admin/cron/jobs/auto_renewal/trigger${NC}"
+curl -s -X POST "$ADMIN_URL/v1/admin/cron/jobs/auto_renewal/trigger" \
+  -H "Content-Type: application/json" \
+  -d '{"dryRun": true}' | jq '.'
+
+# 7. 测试获取定时任务执行记录
+echo -e "\n${YELLOW}7. 测试获取定时任务执行记录${NC}"
+echo -e "${YELLOW}GET $ADMIN_URL/v1/admin/cron/runs?page=1&pageSize=10${NC}"
+curl -s -X GET "$ADMIN_URL/v1/admin/cron/runs?page=1&pageSize=10" | jq '.'
+
 echo -e "\n${GREEN}========================================${NC}"
 echo -e "${GREEN}   测试完成                            ${NC}"
 echo -e "${GREEN}========================================${NC}"